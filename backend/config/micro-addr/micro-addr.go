@@ -33,5 +33,9 @@ const (
 	DefaultCommunityServicePort    = 8087
 	DefaultCommunityServiceName    = "community service"
 
+	DefaultNotificationServiceAddrEnv = "NOTIFICATION_SERVICE_ADDR"
+	DefaultNotificationServicePort    = 8088
+	DefaultNotificationServiceName    = "notification service"
+
 	MaxMessageSize = 15 * 1024 * 1024
 )
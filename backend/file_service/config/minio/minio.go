@@ -16,6 +16,7 @@ const (
 	defaultProfileBucketName     = "profiles"
 	defaultStickerBuckerName     = "stickers"
 	defaultAttachmentsBucketName = "attachments"
+	defaultPrivateBucketName     = "private"
 	defaultMinioRootUser         = "admin"
 	defaultMinioRootPassword     = "adminpassword"
 	defaultScheme                = "https"
@@ -28,6 +29,7 @@ type MinioConfig struct {
 	StickerBuckerName      string
 	ProfileBucketName      string
 	AttachmentsBucketName  string
+	PrivateBucketName      string
 	MinioRootUser          string
 	MinioRootPassword      string
 	MinioUseSSL            bool
@@ -63,6 +65,7 @@ func newMinioConfig(config loadableConfig) *MinioConfig {
 		ProfileBucketName:      getenv.GetEnv("MINIO_PROFILE_BUCKET_NAME", defaultProfileBucketName),
 		AttachmentsBucketName:  getenv.GetEnv("MINIO_ATTACHMENTS_BUCKET_NAME", defaultAttachmentsBucketName),
 		StickerBuckerName:      getenv.GetEnv("MINIO_STICKERS_BUCKET_NAME", defaultStickerBuckerName),
+		PrivateBucketName:      getenv.GetEnv("MINIO_PRIVATE_BUCKET_NAME", defaultPrivateBucketName),
 		MinioRootUser:          getenv.GetEnv("MINIO_ROOT_USER", defaultMinioRootUser),
 		MinioRootPassword:      getenv.GetEnv("MINIO_ROOT_PASSWORD", defaultMinioRootPassword),
 		Scheme:                 getenv.GetEnv("MINIO_SCHEME", defaultScheme),
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	file_errors "quickflow/file_service/internal/errors"
 	dto "quickflow/shared/client/file_service"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -19,6 +20,8 @@ type FileUseCase interface {
 	UploadFile(ctx context.Context, fileModel *models.File) (string, error)
 	UploadManyMedia(ctx context.Context, files []*models.File) ([]string, error)
 	DeleteFile(ctx context.Context, filename string) error
+	DownloadPrivateFile(ctx context.Context, filename string) (*models.File, io.Closer, error)
+	DeletePrivateFile(ctx context.Context, filename string) error
 }
 
 type FileServiceServer struct {
@@ -231,3 +234,69 @@ func (s *FileServiceServer) DeleteFile(ctx context.Context, req *pb.DeleteFileRe
 	logger.Info(ctx, "Successfully deleted file")
 	return &pb.DeleteFileResponse{Success: true}, nil
 }
+
+// DownloadPrivateFile sends the file info first and then the content in chunks
+func (s *FileServiceServer) DownloadPrivateFile(req *pb.DownloadPrivateFileRequest, stream pb.FileService_DownloadPrivateFileServer) error {
+	ctx := stream.Context()
+	logger.Info(ctx, "Received DownloadPrivateFile request")
+
+	file, closer, err := s.fileUC.DownloadPrivateFile(ctx, req.FileName)
+	if errors.Is(err, file_errors.ErrFileNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	} else if errors.Is(err, file_errors.ErrInvalidFileName) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		logger.Error(ctx, "Failed to download private file: %v", err)
+		return err
+	}
+	defer closer.Close()
+
+	err = stream.Send(&pb.DownloadPrivateFileResponse{
+		Data: &pb.DownloadPrivateFileResponse_Info{
+			Info: &pb.File{
+				FileName:   file.Name,
+				FileType:   file.MimeType,
+				FileSize:   file.Size,
+				AccessMode: pb.AccessMode_ACCESS_PRIVATE,
+			},
+		},
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to send file info: %v", err)
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := file.Reader.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.DownloadPrivateFileResponse{
+				Data: &pb.DownloadPrivateFileResponse_Chunk{Chunk: buf[:n]},
+			}); err != nil {
+				logger.Error(ctx, "Failed to send chunk: %v", err)
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			logger.Error(ctx, "Failed to read private file: %v", err)
+			return err
+		}
+	}
+}
+
+func (s *FileServiceServer) DeletePrivateFile(ctx context.Context, req *pb.DeletePrivateFileRequest) (*pb.DeleteFileResponse, error) {
+	logger.Info(ctx, "Received DeletePrivateFile request")
+
+	if len(req.FileName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file name is required")
+	}
+
+	if err := s.fileUC.DeletePrivateFile(ctx, req.FileName); err != nil {
+		logger.Error(ctx, "Failed to delete private file: %v", err)
+		return &pb.DeleteFileResponse{Success: false}, err
+	}
+	return &pb.DeleteFileResponse{Success: true}, nil
+}
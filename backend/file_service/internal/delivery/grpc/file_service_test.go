@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"quickflow/file_service/internal/delivery/grpc/mocks"
+	file_errors "quickflow/file_service/internal/errors"
 	dto "quickflow/shared/client/file_service"
 	shared_models "quickflow/shared/models"
 	pb "quickflow/shared/proto/file_service"
@@ -290,6 +291,37 @@ func TestDeleteFile(t *testing.T) {
 	}
 }
 
+func TestDownloadPrivateFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockFileUseCase(ctrl)
+	server := NewFileServiceServer(mockUC)
+	stream := mocks2.NewMockFileService_DownloadPrivateFileServer(ctrl)
+	stream.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+	file := &shared_models.File{Reader: bytes.NewReader([]byte("archive")), Name: "export.zip", Size: 7, MimeType: "application/zip"}
+	mockUC.EXPECT().DownloadPrivateFile(gomock.Any(), "export.zip").Return(file, io.NopCloser(nil), nil)
+
+	var received []byte
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *pb.DownloadPrivateFileResponse) error {
+		if info := resp.GetInfo(); info != nil {
+			assert.Equal(t, "application/zip", info.FileType)
+			assert.Equal(t, int64(7), info.FileSize)
+		}
+		received = append(received, resp.GetChunk()...)
+		return nil
+	}).Times(2)
+
+	require.NoError(t, server.DownloadPrivateFile(&pb.DownloadPrivateFileRequest{FileName: "export.zip"}, stream))
+	assert.Equal(t, "archive", string(received))
+
+	// missing files are reported with the grpc status
+	mockUC.EXPECT().DownloadPrivateFile(gomock.Any(), "missing.zip").Return(nil, nil, file_errors.ErrFileNotFound)
+	err := server.DownloadPrivateFile(&pb.DownloadPrivateFileRequest{FileName: "missing.zip"}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestProtoFileToModel(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	models "quickflow/shared/models"
 	reflect "reflect"
 
@@ -49,6 +50,36 @@ func (mr *MockFileUseCaseMockRecorder) DeleteFile(ctx, filename interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFileUseCase)(nil).DeleteFile), ctx, filename)
 }
 
+// DeletePrivateFile mocks base method.
+func (m *MockFileUseCase) DeletePrivateFile(ctx context.Context, filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateFile", ctx, filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateFile indicates an expected call of DeletePrivateFile.
+func (mr *MockFileUseCaseMockRecorder) DeletePrivateFile(ctx, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateFile", reflect.TypeOf((*MockFileUseCase)(nil).DeletePrivateFile), ctx, filename)
+}
+
+// DownloadPrivateFile mocks base method.
+func (m *MockFileUseCase) DownloadPrivateFile(ctx context.Context, filename string) (*models.File, io.Closer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPrivateFile", ctx, filename)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(io.Closer)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadPrivateFile indicates an expected call of DownloadPrivateFile.
+func (mr *MockFileUseCaseMockRecorder) DownloadPrivateFile(ctx, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPrivateFile", reflect.TypeOf((*MockFileUseCase)(nil).DownloadPrivateFile), ctx, filename)
+}
+
 // UploadFile mocks base method.
 func (m *MockFileUseCase) UploadFile(ctx context.Context, fileModel *models.File) (string, error) {
 	m.ctrl.T.Helper()
@@ -10,4 +10,5 @@ var (
 	ErrUnsupportedFileType = errors.New("unsupported file type")
 	ErrTooManyFiles        = errors.New("too many files")
 	ErrFileIsNil           = errors.New("file is nil")
+	ErrFileNotFound        = errors.New("file not found")
 )
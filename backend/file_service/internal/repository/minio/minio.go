@@ -3,6 +3,7 @@ package minio
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
@@ -10,6 +11,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	minioconfig "quickflow/file_service/config/minio"
+	qf_errors "quickflow/file_service/internal/errors"
 	threadsafeslice "quickflow/pkg/thread-safe-slice"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -21,7 +23,9 @@ type MinioRepository struct {
 	AttachmentsBucketName string
 	ProfileBucketName     string
 	StickerBuckerName     string
-	PublicUrlRoot         string
+	// PrivateBucketName keeps files with no public access, they are read through DownloadPrivateFile
+	PrivateBucketName string
+	PublicUrlRoot     string
 }
 
 func NewMinioRepository(cfg *minioconfig.MinioConfig) (*MinioRepository, error) {
@@ -34,15 +38,18 @@ func NewMinioRepository(cfg *minioconfig.MinioConfig) (*MinioRepository, error)
 		return nil, fmt.Errorf("could not create minio client: %v", err)
 	}
 
-	exists, err := client.BucketExists(context.Background(), cfg.PostsBucketName)
-	if err != nil {
-		return nil, fmt.Errorf("could not check if bucket exists: %v", err)
-	}
-
-	if !exists {
-		err = client.MakeBucket(context.Background(), cfg.PostsBucketName, minio.MakeBucketOptions{})
+	// new buckets have no anonymous access, public ones are opened by the deploy scripts
+	for _, bucketName := range []string{cfg.PostsBucketName, cfg.PrivateBucketName} {
+		exists, err := client.BucketExists(context.Background(), bucketName)
 		if err != nil {
-			return nil, fmt.Errorf("could not create bucket: %v", err)
+			return nil, fmt.Errorf("could not check if bucket exists: %v", err)
+		}
+
+		if !exists {
+			err = client.MakeBucket(context.Background(), bucketName, minio.MakeBucketOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("could not create bucket: %v", err)
+			}
 		}
 	}
 
@@ -52,20 +59,25 @@ func NewMinioRepository(cfg *minioconfig.MinioConfig) (*MinioRepository, error)
 		AttachmentsBucketName: cfg.AttachmentsBucketName,
 		ProfileBucketName:     cfg.ProfileBucketName,
 		StickerBuckerName:     cfg.StickerBuckerName,
+		PrivateBucketName:     cfg.PrivateBucketName,
 		PublicUrlRoot:         fmt.Sprintf("%s://%s", cfg.Scheme, cfg.MinioPublicEndpoint),
 	}, nil
 }
 
 // UploadFile uploads file to MinIO and returns a public URL.
+// Private files have no URL, the name to download them with is returned instead.
 func (m *MinioRepository) UploadFile(ctx context.Context, file *models.File) (string, error) {
 	var err error
 	uuID := uuid.New()
 	fileName := uuID.String() + file.Ext
 
 	var bucketName string
-	if file.DisplayType == models.DisplayTypeSticker {
+	switch {
+	case file.AccessMode == models.AccessPrivate:
+		bucketName = m.PrivateBucketName
+	case file.DisplayType == models.DisplayTypeSticker:
 		bucketName = m.StickerBuckerName
-	} else {
+	default:
 		bucketName = m.PostsBucketName
 	}
 	_, err = m.client.PutObject(ctx, bucketName, fileName, file.Reader, file.Size, minio.PutObjectOptions{
@@ -76,6 +88,11 @@ func (m *MinioRepository) UploadFile(ctx context.Context, file *models.File) (st
 		return "", fmt.Errorf("could not upload file: %v", err)
 	}
 
+	if file.AccessMode == models.AccessPrivate {
+		logger.Info(ctx, "Private file successfully loaded: %v, name: %v", file.Name, fileName)
+		return fileName, nil
+	}
+
 	publicURL := fmt.Sprintf("%s/%s/%s", m.PublicUrlRoot, bucketName, fileName)
 	logger.Info(ctx, "File successfully loaded: %v, url: %v", file.Name, publicURL)
 	return publicURL, nil
@@ -139,3 +156,38 @@ func (m *MinioRepository) DeleteFile(ctx context.Context, fileName string) error
 	}
 	return nil
 }
+
+// DownloadPrivateFile opens a file of the private bucket for reading, the caller closes it when the file is read.
+func (m *MinioRepository) DownloadPrivateFile(ctx context.Context, fileName string) (*models.File, io.Closer, error) {
+	object, err := m.client.GetObject(ctx, m.PrivateBucketName, fileName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get file: %v", err)
+	}
+
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, nil, qf_errors.ErrFileNotFound
+		}
+		return nil, nil, fmt.Errorf("could not get file info: %v", err)
+	}
+
+	file := &models.File{
+		Reader:     object,
+		Name:       fileName,
+		Size:       info.Size,
+		MimeType:   info.ContentType,
+		AccessMode: models.AccessPrivate,
+	}
+	return file, object, nil
+}
+
+// DeletePrivateFile deletes a file from the private bucket, missing files are not an error.
+func (m *MinioRepository) DeletePrivateFile(ctx context.Context, fileName string) error {
+	err := m.client.RemoveObject(ctx, m.PrivateBucketName, fileName, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("could not delete file: %v", err)
+	}
+	return nil
+}
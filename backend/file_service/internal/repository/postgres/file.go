@@ -10,8 +10,8 @@ import (
 
 const (
 	addFileQuery = `
-        INSERT INTO files (file_url, filename)
-        VALUES ($1, $2)
+        INSERT INTO files (file_url, filename, duration_ms, waveform)
+        VALUES ($1, $2, $3, $4)
     `
 )
 
@@ -35,7 +35,8 @@ func (p *PostgresFileRepository) AddFileRecord(ctx context.Context, file *models
 	if file == nil {
 		return errors.New("file cannot be nil")
 	}
-	_, err := p.connPool.ExecContext(ctx, addFileQuery, file.URL, file.Name)
+	durationMs, waveform := voiceMetadata(file)
+	_, err := p.connPool.ExecContext(ctx, addFileQuery, file.URL, file.Name, durationMs, waveform)
 	if err != nil {
 		return err
 	}
@@ -53,7 +54,8 @@ func (p *PostgresFileRepository) AddFilesRecords(ctx context.Context, files []*m
 		if file == nil {
 			return errors.New("file cannot be nil")
 		}
-		_, err := tx.ExecContext(ctx, addFileQuery, file.URL, file.Name)
+		durationMs, waveform := voiceMetadata(file)
+		_, err := tx.ExecContext(ctx, addFileQuery, file.URL, file.Name, durationMs, waveform)
 		if err != nil {
 			return err
 		}
@@ -61,3 +63,11 @@ func (p *PostgresFileRepository) AddFilesRecords(ctx context.Context, files []*m
 
 	return tx.Commit()
 }
+
+// voiceMetadata returns nulls for files that are not voice messages
+func voiceMetadata(file *models.File) (sql.NullInt64, []byte) {
+	if file.Voice == nil {
+		return sql.NullInt64{}, nil
+	}
+	return sql.NullInt64{Int64: file.Voice.Duration.Milliseconds(), Valid: true}, file.Voice.Waveform
+}
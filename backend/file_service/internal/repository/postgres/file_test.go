@@ -8,6 +8,7 @@ import (
 	"quickflow/file_service/internal/repository/postgres"
 	"quickflow/shared/models"
 	"testing"
+	"time"
 )
 
 func TestAddFileRecord_Success(t *testing.T) {
@@ -23,8 +24,8 @@ func TestAddFileRecord_Success(t *testing.T) {
 	file := &models.File{URL: "http://example.com/file1", Name: "file1"}
 
 	// Ожидаем выполнение SQL-запроса
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(file.URL, file.Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(file.URL, file.Name, nil, []byte(nil)).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Вызов метода
@@ -48,8 +49,8 @@ func TestAddFileRecord_SQLQueryError(t *testing.T) {
 	file := &models.File{URL: "http://example.com/file1", Name: "file1"}
 
 	// Ожидаем выполнение SQL-запроса с ошибкой
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(file.URL, file.Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(file.URL, file.Name, nil, []byte(nil)).
 		WillReturnError(errors.New("query error"))
 
 	// Вызов метода
@@ -61,6 +62,35 @@ func TestAddFileRecord_SQLQueryError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestAddFileRecord_Voice(t *testing.T) {
+	// Инициализация мока
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := postgres.NewPostgresFileRepository(db)
+
+	// Тестовые данные
+	file := &models.File{
+		URL:     "http://example.com/voice.ogg",
+		Name:    "voice.ogg",
+		IsVoice: true,
+		Voice:   &models.VoiceMetadata{Duration: 1500 * time.Millisecond, Waveform: []byte{0, 128, 255}},
+	}
+
+	// Ожидаем сохранение длительности и формы волны
+	mock.ExpectExec(`INSERT INTO files`).
+		WithArgs(file.URL, file.Name, int64(1500), []byte{0, 128, 255}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Вызов метода
+	err = repo.AddFileRecord(context.Background(), file)
+
+	// Проверка
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestAddFilesRecords_Success(t *testing.T) {
 	// Инициализация мока
 	db, mock, err := sqlmock.New()
@@ -80,11 +110,11 @@ func TestAddFilesRecords_Success(t *testing.T) {
 	mock.ExpectBegin()
 
 	// Ожидаем выполнение SQL-запросов для каждого файла
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(files[0].URL, files[0].Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(files[0].URL, files[0].Name, nil, []byte(nil)).
 		WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(files[1].URL, files[1].Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(files[1].URL, files[1].Name, nil, []byte(nil)).
 		WillReturnResult(sqlmock.NewResult(2, 1))
 
 	// Ожидаем успешное завершение транзакции
@@ -117,13 +147,13 @@ func TestAddFilesRecords_TxError(t *testing.T) {
 	mock.ExpectBegin()
 
 	// Ожидаем выполнение первого SQL-запроса
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(files[0].URL, files[0].Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(files[0].URL, files[0].Name, nil, []byte(nil)).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Ожидаем, что второй запрос вызовет ошибку
-	mock.ExpectExec(`INSERT INTO files \(file_url, filename\) VALUES \(\$1, \$2\)`).
-		WithArgs(files[1].URL, files[1].Name).
+	mock.ExpectExec(`INSERT INTO files \(file_url, filename, duration_ms, waveform\) VALUES \(\$1, \$2, \$3, \$4\)`).
+		WithArgs(files[1].URL, files[1].Name, nil, []byte(nil)).
 		WillReturnError(errors.New("insert error"))
 
 	// Ожидаем откат транзакции
@@ -25,6 +25,7 @@ import (
 	"quickflow/file_service/internal/repository/minio"
 	"quickflow/file_service/internal/repository/postgres"
 	"quickflow/file_service/internal/usecase"
+	"quickflow/file_service/utils/audio"
 	"quickflow/file_service/utils/validation"
 	"quickflow/metrics"
 	"quickflow/shared/interceptors"
@@ -83,7 +84,7 @@ func main() {
 		log.Fatalf("failed to create minio repository: %v", err)
 	}
 	fileRepo := postgres.NewPostgresFileRepository(db)
-	fileUseCase := usecase.NewFileUseCase(fileStorage, fileRepo, fileValidator)
+	fileUseCase := usecase.NewFileUseCase(fileStorage, fileRepo, fileValidator, audio.NewVoiceAnalyzer())
 
 	fileMetrics := metrics.NewMetrics("QuickFlow")
 
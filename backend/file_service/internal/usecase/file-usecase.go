@@ -1,10 +1,13 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 
 	qf_errors "quickflow/file_service/internal/errors"
+	"quickflow/shared/logger"
 	"quickflow/shared/models"
 )
 
@@ -13,6 +16,8 @@ type FileStorage interface {
 	UploadManyImages(ctx context.Context, files []*models.File) ([]string, error)
 	GetFileURL(ctx context.Context, filename string) (string, error)
 	DeleteFile(ctx context.Context, filename string) error
+	DownloadPrivateFile(ctx context.Context, filename string) (*models.File, io.Closer, error)
+	DeletePrivateFile(ctx context.Context, filename string) error
 }
 
 type FileRepository interface {
@@ -25,18 +30,25 @@ type FileValidator interface {
 	ValidateFiles(files []*models.File) error
 	ValidateFileName(name string) error
 }
+
+type VoiceAnalyzer interface {
+	AnalyzeVoice(ext string, data []byte) (*models.VoiceMetadata, error)
+}
+
 type FileUseCase struct {
-	fileStorage FileStorage
-	fileRepo    FileRepository
-	validator   FileValidator
+	fileStorage   FileStorage
+	fileRepo      FileRepository
+	validator     FileValidator
+	voiceAnalyzer VoiceAnalyzer
 }
 
 // NewFileUseCase creates new file use case.
-func NewFileUseCase(fileStorage FileStorage, fileRepo FileRepository, validator FileValidator) *FileUseCase {
+func NewFileUseCase(fileStorage FileStorage, fileRepo FileRepository, validator FileValidator, voiceAnalyzer VoiceAnalyzer) *FileUseCase {
 	return &FileUseCase{
-		fileStorage: fileStorage,
-		fileRepo:    fileRepo,
-		validator:   validator,
+		fileStorage:   fileStorage,
+		fileRepo:      fileRepo,
+		validator:     validator,
+		voiceAnalyzer: voiceAnalyzer,
 	}
 }
 
@@ -50,6 +62,12 @@ func (f *FileUseCase) UploadFile(ctx context.Context, fileModel *models.File) (s
 		return "", fmt.Errorf("validation.ValidateFile: %w", err)
 	}
 
+	if fileModel.IsVoice {
+		if err = f.fillVoiceMetadata(ctx, fileModel); err != nil {
+			return "", fmt.Errorf("f.fillVoiceMetadata: %w", err)
+		}
+	}
+
 	fileUrl, err := f.fileStorage.UploadFile(ctx, fileModel)
 	if err != nil {
 		return "", fmt.Errorf("f.fileStorage.UploadFile: %w", err)
@@ -63,6 +81,24 @@ func (f *FileUseCase) UploadFile(ctx context.Context, fileModel *models.File) (s
 	return fileUrl, nil
 }
 
+// fillVoiceMetadata computes duration and waveform of the voice message.
+// Recordings that can not be analyzed are uploaded as plain audio.
+func (f *FileUseCase) fillVoiceMetadata(ctx context.Context, fileModel *models.File) error {
+	data, err := io.ReadAll(fileModel.Reader)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+	fileModel.Reader = bytes.NewReader(data)
+
+	voice, err := f.voiceAnalyzer.AnalyzeVoice(fileModel.Ext, data)
+	if err != nil {
+		logger.Warn(ctx, "Failed to analyze voice message %s: %v", fileModel.Name, err)
+		return nil
+	}
+	fileModel.Voice = voice
+	return nil
+}
+
 func (f *FileUseCase) UploadManyMedia(ctx context.Context, files []*models.File) ([]string, error) {
 	// validation
 	err := f.validator.ValidateFiles(files)
@@ -139,3 +175,27 @@ func (f *FileUseCase) DeleteFile(ctx context.Context, filename string) error {
 	}
 	return nil
 }
+
+// DownloadPrivateFile opens the private file by the name returned on upload, the caller closes it when the file is read
+func (f *FileUseCase) DownloadPrivateFile(ctx context.Context, filename string) (*models.File, io.Closer, error) {
+	if err := f.validator.ValidateFileName(filename); err != nil {
+		return nil, nil, fmt.Errorf("validation.ValidateFileName: %w", err)
+	}
+
+	file, closer, err := f.fileStorage.DownloadPrivateFile(ctx, filename)
+	if err != nil {
+		return nil, nil, fmt.Errorf("f.fileStorage.DownloadPrivateFile: %w", err)
+	}
+	return file, closer, nil
+}
+
+func (f *FileUseCase) DeletePrivateFile(ctx context.Context, filename string) error {
+	if err := f.validator.ValidateFileName(filename); err != nil {
+		return fmt.Errorf("validation.ValidateFileName: %w", err)
+	}
+
+	if err := f.fileStorage.DeletePrivateFile(ctx, filename); err != nil {
+		return fmt.Errorf("f.fileStorage.DeletePrivateFile: %w", err)
+	}
+	return nil
+}
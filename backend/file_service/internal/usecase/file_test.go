@@ -1,9 +1,12 @@
 package usecase_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -24,7 +27,7 @@ func TestFileUseCase_UploadFile_Success(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator, mocks.NewMockVoiceAnalyzer(ctrl))
 	file := &models.File{Name: "test.txt", Size: 1024}
 	fileURL := "http://example.com/test.txt"
 
@@ -52,7 +55,7 @@ func TestFileUseCase_UploadFile_FileIsNil(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator, mocks.NewMockVoiceAnalyzer(ctrl))
 
 	// Вызов метода с nil файлом
 	ctx := context.Background()
@@ -74,7 +77,7 @@ func TestFileUseCase_UploadFile_ValidationError(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator, mocks.NewMockVoiceAnalyzer(ctrl))
 
 	// Тестовые данные
 	file := &models.File{Name: "test.txt", Size: 1024}
@@ -92,6 +95,62 @@ func TestFileUseCase_UploadFile_ValidationError(t *testing.T) {
 	assert.Equal(t, "", url)
 }
 
+func TestFileUseCase_UploadFile_Voice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Мокирование зависимостей
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockFileRepo := mocks.NewMockFileRepository(ctrl)
+	mockFileValidator := mocks.NewMockFileValidator(ctrl)
+	mockVoiceAnalyzer := mocks.NewMockVoiceAnalyzer(ctrl)
+
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator, mockVoiceAnalyzer)
+	voice := &models.VoiceMetadata{Duration: time.Second, Waveform: []byte{0, 255}}
+
+	tests := []struct {
+		name       string
+		analyzeErr error
+		wantVoice  *models.VoiceMetadata
+	}{
+		{name: "metadata is saved", wantVoice: voice},
+		{name: "unsupported format is uploaded as audio", analyzeErr: errors.New("unsupported voice message format")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Тестовые данные
+			file := &models.File{
+				Name:        "voice.ogg",
+				Ext:         ".ogg",
+				Size:        5,
+				Reader:      bytes.NewReader([]byte("audio")),
+				DisplayType: models.DisplayTypeAudio,
+				IsVoice:     true,
+			}
+
+			// Настройка моков
+			mockFileValidator.EXPECT().ValidateFile(file).Return(nil)
+			mockVoiceAnalyzer.EXPECT().AnalyzeVoice(".ogg", []byte("audio")).Return(tt.wantVoice, tt.analyzeErr)
+			mockFileStorage.EXPECT().UploadFile(gomock.Any(), file).DoAndReturn(func(_ context.Context, uploaded *models.File) (string, error) {
+				// the recording is read again by the storage
+				content, err := io.ReadAll(uploaded.Reader)
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("audio"), content)
+				return "http://example.com/voice.ogg", nil
+			})
+			mockFileRepo.EXPECT().AddFileRecord(gomock.Any(), file).Return(nil)
+
+			// Вызов метода
+			_, err := fileUseCase.UploadFile(context.Background(), file)
+
+			// Проверки
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVoice, file.Voice)
+		})
+	}
+}
+
 func TestFileUseCase_UploadManyMedia_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -102,7 +161,7 @@ func TestFileUseCase_UploadManyMedia_Success(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, mockFileRepo, mockFileValidator, mocks.NewMockVoiceAnalyzer(ctrl))
 
 	// Тестовые данные
 	files := []*models.File{
@@ -134,7 +193,7 @@ func TestFileUseCase_GetFileURL_Success(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator, nil)
 
 	// Тестовые данные
 	fileName := "test.txt"
@@ -162,7 +221,7 @@ func TestFileUseCase_GetFileURL_ValidationError(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator, nil)
 
 	// Тестовые данные
 	fileName := "test.txt"
@@ -189,7 +248,7 @@ func TestFileUseCase_DeleteFile_Success(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator, nil)
 
 	// Тестовые данные
 	fileName := "test.txt"
@@ -215,7 +274,7 @@ func TestFileUseCase_DeleteFile_ValidationError(t *testing.T) {
 	mockFileValidator := mocks.NewMockFileValidator(ctrl)
 
 	// Инициализация FileUseCase
-	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator)
+	fileUseCase := usecase.NewFileUseCase(mockFileStorage, nil, mockFileValidator, nil)
 
 	// Тестовые данные
 	fileName := "test.txt"
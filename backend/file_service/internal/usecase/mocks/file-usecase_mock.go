@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	models "quickflow/shared/models"
 	reflect "reflect"
 
@@ -49,6 +50,36 @@ func (mr *MockFileStorageMockRecorder) DeleteFile(ctx, filename interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFileStorage)(nil).DeleteFile), ctx, filename)
 }
 
+// DeletePrivateFile mocks base method.
+func (m *MockFileStorage) DeletePrivateFile(ctx context.Context, filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateFile", ctx, filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateFile indicates an expected call of DeletePrivateFile.
+func (mr *MockFileStorageMockRecorder) DeletePrivateFile(ctx, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateFile", reflect.TypeOf((*MockFileStorage)(nil).DeletePrivateFile), ctx, filename)
+}
+
+// DownloadPrivateFile mocks base method.
+func (m *MockFileStorage) DownloadPrivateFile(ctx context.Context, filename string) (*models.File, io.Closer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPrivateFile", ctx, filename)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(io.Closer)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadPrivateFile indicates an expected call of DownloadPrivateFile.
+func (mr *MockFileStorageMockRecorder) DownloadPrivateFile(ctx, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPrivateFile", reflect.TypeOf((*MockFileStorage)(nil).DownloadPrivateFile), ctx, filename)
+}
+
 // GetFileURL mocks base method.
 func (m *MockFileStorage) GetFileURL(ctx context.Context, filename string) (string, error) {
 	m.ctrl.T.Helper()
@@ -209,3 +240,41 @@ func (mr *MockFileValidatorMockRecorder) ValidateFiles(files interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateFiles", reflect.TypeOf((*MockFileValidator)(nil).ValidateFiles), files)
 }
+
+// MockVoiceAnalyzer is a mock of VoiceAnalyzer interface.
+type MockVoiceAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoiceAnalyzerMockRecorder
+}
+
+// MockVoiceAnalyzerMockRecorder is the mock recorder for MockVoiceAnalyzer.
+type MockVoiceAnalyzerMockRecorder struct {
+	mock *MockVoiceAnalyzer
+}
+
+// NewMockVoiceAnalyzer creates a new mock instance.
+func NewMockVoiceAnalyzer(ctrl *gomock.Controller) *MockVoiceAnalyzer {
+	mock := &MockVoiceAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockVoiceAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoiceAnalyzer) EXPECT() *MockVoiceAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeVoice mocks base method.
+func (m *MockVoiceAnalyzer) AnalyzeVoice(ext string, data []byte) (*models.VoiceMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeVoice", ext, data)
+	ret0, _ := ret[0].(*models.VoiceMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeVoice indicates an expected call of AnalyzeVoice.
+func (mr *MockVoiceAnalyzerMockRecorder) AnalyzeVoice(ext, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeVoice", reflect.TypeOf((*MockVoiceAnalyzer)(nil).AnalyzeVoice), ext, data)
+}
@@ -0,0 +1,199 @@
+package audio
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+var (
+	mp3BitratesV1 = [15]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mp3BitratesV2 = [15]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+
+	mp3SampleRates = map[int][3]int{
+		mpegVersion1:  {44100, 48000, 32000},
+		mpegVersion2:  {22050, 24000, 16000},
+		mpegVersion25: {11025, 12000, 8000},
+	}
+)
+
+const (
+	mpegVersion25 = 0
+	mpegVersion2  = 2
+	mpegVersion1  = 3
+
+	mpegLayer3      = 1
+	mpegChannelMono = 3
+)
+
+type mp3Header struct {
+	version    int
+	sampleRate int
+	samples    int
+	length     int
+	channels   int
+	// sideInfo is the offset of side information in the frame
+	sideInfo int
+}
+
+// decodeMp3 walks MPEG layer III frames. The frames are not decoded, the amplitude
+// of every frame is estimated from the quantizer step size its granules are coded with.
+func decodeMp3(data []byte) (*recording, error) {
+	var rec *recording
+	first := true
+	for pos := skipID3v2(data); pos+4 <= len(data); {
+		header, ok := parseMp3Header(data[pos:])
+		// bytes between frames are skipped until the next header with the same sample rate
+		if !ok || (rec != nil && header.sampleRate != rec.sampleRate) || pos+header.length > len(data) {
+			pos++
+			continue
+		}
+		frame := data[pos : pos+header.length]
+		pos += header.length
+
+		if rec == nil {
+			rec = &recording{sampleRate: header.sampleRate}
+		}
+		// encoders put the Xing or VBRI tag into a silent first frame
+		if first {
+			first = false
+			if isMp3TagFrame(frame, header) {
+				continue
+			}
+		}
+		rec.add(int64(header.samples), mp3FrameAmplitude(frame, header))
+	}
+	if rec == nil {
+		return nil, fmt.Errorf("%w: no mp3 frames", ErrMalformedAudio)
+	}
+	return rec, nil
+}
+
+func skipID3v2(data []byte) int {
+	if len(data) < 10 || string(data[0:3]) != "ID3" {
+		return 0
+	}
+	// the size is stored in 7 bits of every byte
+	size := 10 + (int(data[6])<<21 | int(data[7])<<14 | int(data[8])<<7 | int(data[9]))
+	if data[5]&0x10 != 0 {
+		size += 10
+	}
+	return min(size, len(data))
+}
+
+func parseMp3Header(data []byte) (mp3Header, bool) {
+	if data[0] != 0xFF || data[1]&0xE0 != 0xE0 {
+		return mp3Header{}, false
+	}
+	version := int(data[1]>>3) & 3
+	layer := int(data[1]>>1) & 3
+	bitrateIndex := int(data[2] >> 4)
+	sampleRateIndex := int(data[2]>>2) & 3
+	if version == 1 || layer != mpegLayer3 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return mp3Header{}, false
+	}
+
+	header := mp3Header{
+		version:    version,
+		sampleRate: mp3SampleRates[version][sampleRateIndex],
+		samples:    576,
+		channels:   2,
+		sideInfo:   4,
+	}
+	bitrate := mp3BitratesV2[bitrateIndex]
+	if version == mpegVersion1 {
+		header.samples = 1152
+		bitrate = mp3BitratesV1[bitrateIndex]
+	}
+	if int(data[3]>>6) == mpegChannelMono {
+		header.channels = 1
+	}
+	// the crc follows the header when the protection bit is not set
+	if data[1]&1 == 0 {
+		header.sideInfo += 2
+	}
+	padding := int(data[2]>>1) & 1
+	header.length = header.samples/8*bitrate*1000/header.sampleRate + padding
+	return header, true
+}
+
+func (h mp3Header) sideInfoLength() int {
+	switch {
+	case h.version == mpegVersion1 && h.channels == 1:
+		return 17
+	case h.version == mpegVersion1:
+		return 32
+	case h.channels == 1:
+		return 9
+	default:
+		return 17
+	}
+}
+
+func isMp3TagFrame(frame []byte, header mp3Header) bool {
+	tag := header.sideInfo + header.sideInfoLength()
+	if len(frame) >= tag+4 && (bytes.Equal(frame[tag:tag+4], []byte("Xing")) || bytes.Equal(frame[tag:tag+4], []byte("Info"))) {
+		return true
+	}
+	return len(frame) >= 40 && bytes.Equal(frame[36:40], []byte("VBRI"))
+}
+
+// mp3FrameAmplitude reads global gains of the granules from side information.
+// The gain sets the quantizer step, so the coded amplitude grows as 2^(gain/4).
+func mp3FrameAmplitude(frame []byte, header mp3Header) float64 {
+	if len(frame) < header.sideInfo+header.sideInfoLength() {
+		return 0
+	}
+	r := bitReader{data: frame[header.sideInfo:]}
+
+	granules, rest := 1, 34
+	if header.version == mpegVersion1 {
+		granules, rest = 2, 30
+		r.skip(9)
+		if header.channels == 1 {
+			r.skip(5)
+		} else {
+			r.skip(3)
+		}
+		r.skip(4 * header.channels) // scfsi
+	} else {
+		r.skip(8)
+		r.skip(header.channels) // private bits
+	}
+
+	var amplitude float64
+	for gr := 0; gr < granules; gr++ {
+		for ch := 0; ch < header.channels; ch++ {
+			part23Length := r.read(12)
+			r.skip(9) // big values
+			globalGain := r.read(8)
+			r.skip(rest)
+			// granules without huffman data are silent
+			if part23Length > 0 {
+				amplitude = max(amplitude, math.Exp2(float64(globalGain-210)/4))
+			}
+		}
+	}
+	return amplitude
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (b *bitReader) read(n int) int {
+	var value int
+	for i := 0; i < n; i++ {
+		value <<= 1
+		if b.pos/8 < len(b.data) {
+			value |= int(b.data[b.pos/8]>>(7-b.pos%8)) & 1
+		}
+		b.pos++
+	}
+	return value
+}
+
+func (b *bitReader) skip(n int) {
+	b.pos += n
+}
@@ -0,0 +1,130 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	oggPageHeaderSize = 27
+	// opusSampleRate is the rate granule positions and frame sizes of Opus are counted in
+	opusSampleRate = 48000
+	// opusFrameSamples scales packet sizes to bytes per 20 ms
+	opusFrameSamples = 960
+)
+
+var (
+	oggCapturePattern = []byte("OggS")
+	opusHeadMagic     = []byte("OpusHead")
+)
+
+// decodeOggOpus walks packets of the first Opus stream of the Ogg file.
+// Opus is not decoded, the amplitude of every packet is estimated from its size:
+// the encoder spends more bits on louder speech and almost none on silence.
+func decodeOggOpus(data []byte) (*recording, error) {
+	packets, lastGranule, err := readOggPackets(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(packets) < 2 || !bytes.HasPrefix(packets[0], opusHeadMagic) || len(packets[0]) < 19 {
+		return nil, fmt.Errorf("%w: ogg file without opus stream", ErrUnsupportedFormat)
+	}
+	preSkip := int64(binary.LittleEndian.Uint16(packets[0][10:12]))
+
+	rec := &recording{sampleRate: opusSampleRate}
+	// the second packet holds tags
+	for _, packet := range packets[2:] {
+		samples := opusPacketSamples(packet)
+		if samples == 0 {
+			continue
+		}
+		rec.add(int64(samples), float64(len(packet))*opusFrameSamples/float64(samples))
+	}
+
+	// the granule position of the last page is the exact length, the last packet is usually cut
+	if lastGranule > preSkip && lastGranule-preSkip < rec.samples {
+		rec.samples = lastGranule - preSkip
+	}
+	return rec, nil
+}
+
+// readOggPackets joins segments of the pages of the first logical stream into packets
+func readOggPackets(data []byte) ([][]byte, int64, error) {
+	var (
+		packets     [][]byte
+		pending     []byte
+		serial      uint32
+		lastGranule int64
+	)
+	for pos := 0; pos < len(data); {
+		if len(data)-pos < oggPageHeaderSize || !bytes.Equal(data[pos:pos+4], oggCapturePattern) {
+			return nil, 0, fmt.Errorf("%w: invalid ogg page at %d", ErrMalformedAudio, pos)
+		}
+		header := data[pos : pos+oggPageHeaderSize]
+		segments := int(header[26])
+		if len(data)-pos < oggPageHeaderSize+segments {
+			return nil, 0, fmt.Errorf("%w: truncated ogg page", ErrMalformedAudio)
+		}
+		lacing := data[pos+oggPageHeaderSize : pos+oggPageHeaderSize+segments]
+		body := data[pos+oggPageHeaderSize+segments:]
+		pos += oggPageHeaderSize + segments
+
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		if len(packets) == 0 && pending == nil {
+			serial = pageSerial
+		}
+		for _, size := range lacing {
+			if int(size) > len(body) {
+				return nil, 0, fmt.Errorf("%w: truncated ogg page", ErrMalformedAudio)
+			}
+			if pageSerial == serial {
+				pending = append(pending, body[:size]...)
+				// a packet continues on the next segment only after a full one
+				if size < 255 {
+					packets = append(packets, pending)
+					pending = []byte{}
+				}
+			}
+			body = body[size:]
+			pos += int(size)
+		}
+
+		// -1 marks pages where no packet ends
+		if granule := int64(binary.LittleEndian.Uint64(header[6:14])); pageSerial == serial && granule != -1 {
+			lastGranule = granule
+		}
+	}
+	return packets, lastGranule, nil
+}
+
+// opusPacketSamples returns the length of the packet at 48 kHz from its table of contents byte
+func opusPacketSamples(packet []byte) int {
+	if len(packet) == 0 {
+		return 0
+	}
+	toc := packet[0]
+	config := int(toc >> 3)
+
+	var frame int
+	switch {
+	case config < 12: // SILK: 10, 20, 40, 60 ms
+		frame = []int{480, 960, 1920, 2880}[config%4]
+	case config < 16: // hybrid: 10, 20 ms
+		frame = []int{480, 960}[config%2]
+	default: // CELT: 2.5, 5, 10, 20 ms
+		frame = []int{120, 240, 480, 960}[config%4]
+	}
+
+	switch toc & 3 {
+	case 0:
+		return frame
+	case 1, 2:
+		return 2 * frame
+	default:
+		if len(packet) < 2 {
+			return 0
+		}
+		return int(packet[1]&0x3F) * frame
+	}
+}
@@ -0,0 +1,110 @@
+package audio
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"quickflow/shared/models"
+)
+
+// WaveformBars is the number of amplitude values in the waveform of a voice message
+const WaveformBars = 100
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported voice message format")
+	ErrMalformedAudio    = errors.New("malformed audio")
+)
+
+// VoiceAnalyzer computes metadata of voice messages without external decoders.
+// WAV is decoded completely, for MP3 and Ogg/Opus frames are walked without decoding
+// and the waveform is estimated from the loudness information every frame carries.
+type VoiceAnalyzer struct{}
+
+func NewVoiceAnalyzer() *VoiceAnalyzer {
+	return &VoiceAnalyzer{}
+}
+
+// AnalyzeVoice returns duration and waveform of the recording, ext selects its format
+func (v *VoiceAnalyzer) AnalyzeVoice(ext string, data []byte) (*models.VoiceMetadata, error) {
+	var (
+		rec *recording
+		err error
+	)
+	switch strings.ToLower(ext) {
+	case ".wav":
+		rec, err = decodeWav(data)
+	case ".ogg", ".opus":
+		rec, err = decodeOggOpus(data)
+	case ".mp3":
+		rec, err = decodeMp3(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rec.sampleRate <= 0 || rec.samples <= 0 {
+		return nil, ErrMalformedAudio
+	}
+
+	return &models.VoiceMetadata{
+		Duration: rec.duration(),
+		Waveform: rec.waveform(WaveformBars),
+	}, nil
+}
+
+// recording is the length of the audio in samples and amplitudes of its consecutive pieces
+type recording struct {
+	sampleRate int
+	samples    int64
+	pieces     []piece
+}
+
+// piece lasts from its start to the start of the next one, amplitude is on an arbitrary scale
+type piece struct {
+	start     int64
+	amplitude float64
+}
+
+func (r *recording) add(samples int64, amplitude float64) {
+	r.pieces = append(r.pieces, piece{start: r.samples, amplitude: amplitude})
+	r.samples += samples
+}
+
+func (r *recording) duration() time.Duration {
+	return time.Duration(float64(r.samples) / float64(r.sampleRate) * float64(time.Second))
+}
+
+// waveform takes the peak amplitude of every bar and scales them so that the loudest bar is 255
+func (r *recording) waveform(bars int) []byte {
+	levels := make([]float64, bars)
+	for i, p := range r.pieces {
+		end := r.samples
+		if i+1 < len(r.pieces) {
+			end = r.pieces[i+1].start
+		}
+		first, last := r.bar(p.start, bars), r.bar(max(end-1, p.start), bars)
+		for bar := first; bar <= last; bar++ {
+			levels[bar] = max(levels[bar], p.amplitude)
+		}
+	}
+
+	var peak float64
+	for _, level := range levels {
+		peak = max(peak, level)
+	}
+	waveform := make([]byte, bars)
+	if peak == 0 {
+		return waveform
+	}
+	for i, level := range levels {
+		waveform[i] = byte(math.Round(level / peak * 255))
+	}
+	return waveform
+}
+
+func (r *recording) bar(sample int64, bars int) int {
+	return int(min(sample*int64(bars)/r.samples, int64(bars-1)))
+}
@@ -0,0 +1,156 @@
+package audio
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wavFile builds a 16 bit mono wav file, the first half is silent and the second is a sine
+func wavFile(sampleRate, samples int) []byte {
+	data := make([]byte, 2*samples)
+	for i := samples / 2; i < samples; i++ {
+		value := int16(math.Sin(float64(i)/10) * 30000)
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(value))
+	}
+
+	file := []byte("RIFF\x00\x00\x00\x00WAVE")
+	format := make([]byte, 16)
+	binary.LittleEndian.PutUint16(format[0:], wavFormatPCM)
+	binary.LittleEndian.PutUint16(format[2:], 1)
+	binary.LittleEndian.PutUint32(format[4:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(format[8:], uint32(2*sampleRate))
+	binary.LittleEndian.PutUint16(format[12:], 2)
+	binary.LittleEndian.PutUint16(format[14:], 16)
+	file = appendChunk(file, "fmt ", format)
+	return appendChunk(file, "data", data)
+}
+
+func appendChunk(file []byte, id string, body []byte) []byte {
+	file = append(file, id...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(body)))
+	return append(file, body...)
+}
+
+// oggPage builds a page with one packet per segment, packets must be shorter than 255 bytes
+func oggPage(granule int64, packets ...[]byte) []byte {
+	page := append([]byte("OggS"), 0, 0)
+	page = binary.LittleEndian.AppendUint64(page, uint64(granule))
+	page = binary.LittleEndian.AppendUint32(page, 1)
+	page = append(page, make([]byte, 8)...) // sequence number and crc
+	page = append(page, byte(len(packets)))
+	for _, packet := range packets {
+		page = append(page, byte(len(packet)))
+	}
+	for _, packet := range packets {
+		page = append(page, packet...)
+	}
+	return page
+}
+
+func opusFile(packets [][]byte, lastGranule int64) []byte {
+	head := append([]byte("OpusHead"), 1, 1)
+	head = binary.LittleEndian.AppendUint16(head, 312)
+	head = append(head, make([]byte, 7)...)
+
+	file := oggPage(0, head)
+	file = append(file, oggPage(0, []byte("OpusTags"))...)
+	return append(file, oggPage(lastGranule, packets...)...)
+}
+
+// mp3Frame builds an MPEG 1 layer III mono frame at 128 kbit/s and 44.1 kHz
+func mp3Frame(globalGain int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0xC0})
+	sideInfo := frame[4:]
+	// part2_3_length of the first granule starts after 18 bits of the frame side information
+	writeBits(sideInfo, 18, 12, 100)
+	writeBits(sideInfo, 18+21, 8, globalGain)
+	return frame
+}
+
+func writeBits(data []byte, pos, n, value int) {
+	for i := 0; i < n; i++ {
+		if value>>(n-1-i)&1 == 1 {
+			data[(pos+i)/8] |= 1 << (7 - (pos+i)%8)
+		}
+	}
+}
+
+func TestAnalyzeVoice_Wav(t *testing.T) {
+	voice, err := NewVoiceAnalyzer().AnalyzeVoice(".WAV", wavFile(8000, 16000))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2*time.Second, voice.Duration)
+	require.Len(t, voice.Waveform, WaveformBars)
+	assert.Zero(t, voice.Waveform[0])
+	assert.Zero(t, voice.Waveform[WaveformBars/2-1])
+	assert.Greater(t, voice.Waveform[WaveformBars/2], byte(200))
+	assert.Equal(t, byte(255), voice.Waveform[WaveformBars-1])
+}
+
+func TestAnalyzeVoice_OggOpus(t *testing.T) {
+	// 20 ms CELT packets: quiet first, loud then
+	var packets [][]byte
+	for i := 0; i < 100; i++ {
+		size := 3
+		if i >= 50 {
+			size = 120
+		}
+		packet := make([]byte, size)
+		packet[0] = 31 << 3
+		packets = append(packets, packet)
+	}
+
+	voice, err := NewVoiceAnalyzer().AnalyzeVoice(".ogg", opusFile(packets, 100*960-500))
+	require.NoError(t, err)
+
+	assert.InDelta(t, float64(100*960-500-312)/48000, voice.Duration.Seconds(), 1e-6)
+	require.Len(t, voice.Waveform, WaveformBars)
+	assert.Less(t, voice.Waveform[0], byte(10))
+	assert.Equal(t, byte(255), voice.Waveform[WaveformBars-1])
+}
+
+func TestAnalyzeVoice_Mp3(t *testing.T) {
+	file := append([]byte("ID3\x03\x00\x00\x00\x00\x00\x02"), 0, 0)
+	for i := 0; i < 20; i++ {
+		gain := 150
+		if i >= 10 {
+			gain = 190
+		}
+		file = append(file, mp3Frame(gain)...)
+	}
+
+	voice, err := NewVoiceAnalyzer().AnalyzeVoice(".mp3", file)
+	require.NoError(t, err)
+
+	assert.InDelta(t, float64(20*1152)/44100, voice.Duration.Seconds(), 1e-6)
+	require.Len(t, voice.Waveform, WaveformBars)
+	assert.Less(t, voice.Waveform[0], byte(10))
+	assert.Equal(t, byte(255), voice.Waveform[WaveformBars-1])
+}
+
+func TestAnalyzeVoice_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     string
+		data    []byte
+		wantErr error
+	}{
+		{name: "unsupported extension", ext: ".m4a", data: []byte("data"), wantErr: ErrUnsupportedFormat},
+		{name: "not a wav file", ext: ".wav", data: []byte("not a wav file"), wantErr: ErrMalformedAudio},
+		{name: "ogg without opus", ext: ".ogg", data: oggPage(0, []byte("\x01vorbis")), wantErr: ErrUnsupportedFormat},
+		{name: "no mp3 frames", ext: ".mp3", data: make([]byte, 100), wantErr: ErrMalformedAudio},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVoiceAnalyzer().AnalyzeVoice(tt.ext, tt.data)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatExtensible = 0xFFFE
+
+	// wavPiecesPerSecond is the resolution of the waveform before it is downsampled to bars
+	wavPiecesPerSecond = 100
+)
+
+type wavFormat struct {
+	format        uint16
+	channels      int
+	sampleRate    int
+	blockAlign    int
+	bitsPerSample int
+}
+
+// decodeWav reads PCM and float samples of a RIFF/WAVE file
+func decodeWav(data []byte) (*recording, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("%w: not a wav file", ErrMalformedAudio)
+	}
+
+	var (
+		format  *wavFormat
+		samples []byte
+	)
+	for pos := 12; pos+8 <= len(data); {
+		id := string(data[pos : pos+4])
+		body := data[pos+8:]
+		// recorders writing wav as a stream leave the size of the last chunk unset
+		size := min(int(binary.LittleEndian.Uint32(data[pos+4:pos+8])), len(body))
+		body = body[:size]
+
+		switch id {
+		case "fmt ":
+			var err error
+			if format, err = parseWavFormat(body); err != nil {
+				return nil, err
+			}
+		case "data":
+			samples = body
+		}
+		pos += 8 + size + size%2
+	}
+	if format == nil || samples == nil {
+		return nil, fmt.Errorf("%w: wav file without format or data", ErrMalformedAudio)
+	}
+
+	rec := &recording{sampleRate: format.sampleRate}
+	frames := len(samples) / format.blockAlign
+	piece := max(format.sampleRate/wavPiecesPerSecond, 1)
+	for start := 0; start < frames; start += piece {
+		end := min(start+piece, frames)
+		var peak float64
+		for frame := start; frame < end; frame++ {
+			block := samples[frame*format.blockAlign:]
+			for ch := 0; ch < format.channels; ch++ {
+				peak = max(peak, format.amplitude(block[ch*format.blockAlign/format.channels:]))
+			}
+		}
+		rec.add(int64(end-start), peak)
+	}
+	return rec, nil
+}
+
+func parseWavFormat(body []byte) (*wavFormat, error) {
+	if len(body) < 16 {
+		return nil, fmt.Errorf("%w: short wav format chunk", ErrMalformedAudio)
+	}
+	format := &wavFormat{
+		format:        binary.LittleEndian.Uint16(body[0:2]),
+		channels:      int(binary.LittleEndian.Uint16(body[2:4])),
+		sampleRate:    int(binary.LittleEndian.Uint32(body[4:8])),
+		blockAlign:    int(binary.LittleEndian.Uint16(body[12:14])),
+		bitsPerSample: int(binary.LittleEndian.Uint16(body[14:16])),
+	}
+	if format.format == wavFormatExtensible && len(body) >= 26 {
+		format.format = binary.LittleEndian.Uint16(body[24:26])
+	}
+
+	switch {
+	case format.format == wavFormatPCM && format.bitsPerSample%8 == 0 && format.bitsPerSample >= 8 && format.bitsPerSample <= 32:
+	case format.format == wavFormatFloat && format.bitsPerSample == 32:
+	default:
+		return nil, fmt.Errorf("%w: wav format %d with %d bits per sample", ErrUnsupportedFormat, format.format, format.bitsPerSample)
+	}
+	if format.channels == 0 || format.sampleRate == 0 || format.blockAlign < format.channels*format.bitsPerSample/8 {
+		return nil, fmt.Errorf("%w: invalid wav format", ErrMalformedAudio)
+	}
+	return format, nil
+}
+
+// amplitude returns the absolute value of the sample scaled to [0, 1]
+func (f *wavFormat) amplitude(sample []byte) float64 {
+	if f.format == wavFormatFloat {
+		return min(math.Abs(float64(math.Float32frombits(binary.LittleEndian.Uint32(sample)))), 1)
+	}
+
+	switch f.bitsPerSample {
+	case 8:
+		// 8 bit samples are unsigned
+		return math.Abs(float64(int(sample[0])-128)) / 128
+	case 16:
+		return math.Abs(float64(int16(binary.LittleEndian.Uint16(sample)))) / (1 << 15)
+	case 24:
+		value := int32(uint32(sample[0])<<8|uint32(sample[1])<<16|uint32(sample[2])<<24) >> 8
+		return math.Abs(float64(value)) / (1 << 23)
+	default:
+		return math.Abs(float64(int32(binary.LittleEndian.Uint32(sample)))) / (1 << 31)
+	}
+}
@@ -28,6 +28,11 @@ func (f *FileValidator) ValidateFile(file *models.File) error {
         return err
     }
 
+    // only audio can be sent as a voice message
+    if file.IsVoice && file.DisplayType != models.DisplayTypeAudio {
+        return qf_errors.ErrUnsupportedFileType
+    }
+
     switch {
     case file.DisplayType == models.DisplayTypeMedia:
         return f.validateFile(file, f.fileConfig.MaxVideoSize, append(f.fileConfig.AllowedVideoExt, f.fileConfig.AllowedPictureExt...))
@@ -57,6 +57,17 @@ func TestValidateFile(t *testing.T) {
 			},
 			expectedErr: file_errors.ErrUnsupportedFileType,
 		},
+		{
+			name: "voice message that is not audio",
+			file: &models.File{
+				Name:     "sample.jpg",
+				Size:     1024 * 1024, // 1MB
+				Ext:      ".jpg",
+				MimeType: "image/jpeg",
+				IsVoice:  true,
+			},
+			expectedErr: file_errors.ErrUnsupportedFileType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,10 +3,15 @@ package http
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 
 	time2 "quickflow/config/time"
@@ -18,14 +23,43 @@ import (
 )
 
 type ChatUseCase interface {
+	CreateChat(ctx context.Context, userId uuid.UUID, info models.ChatCreationInfo) (*models.Chat, *models.Message, error)
+	AddChatMembers(ctx context.Context, chatId, userId uuid.UUID, memberIds []uuid.UUID) ([]*models.Message, error)
+	RemoveChatMember(ctx context.Context, chatId, userId, memberId uuid.UUID) (*models.Message, error)
+	UpdateChat(ctx context.Context, chatId, userId uuid.UUID, info models.ChatUpdateInfo) (*models.Chat, []*models.Message, error)
+	ChangeChatRole(ctx context.Context, chatId, userId, memberId uuid.UUID, role models.ChatRole) (*models.Message, error)
+	GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error)
+	CreateChatInvite(ctx context.Context, chatId, userId uuid.UUID, info models.ChatInviteCreationInfo) (*models.ChatInvite, error)
+	GetChatInvites(ctx context.Context, chatId, userId uuid.UUID) ([]models.ChatInvite, error)
+	RevokeChatInvite(ctx context.Context, token string, userId uuid.UUID) error
+	GetChatInvitePreview(ctx context.Context, token string) (*models.ChatPreview, error)
+	JoinChatByInvite(ctx context.Context, token string, userId uuid.UUID) (*models.Chat, *models.Message, error)
 	GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error)
-	GetUserChats(ctx context.Context, userId uuid.UUID, numChats int, ts time.Time) ([]models.Chat, error)
+	GetUserChats(ctx context.Context, userId uuid.UUID, numChats int, ts time.Time, archived bool) ([]models.Chat, error)
 	GetPrivateChat(ctx context.Context, userId1, userId2 uuid.UUID) (*models.Chat, error)
-	DeleteChat(ctx context.Context, chatId uuid.UUID) error
+	DeleteChat(ctx context.Context, chatId, userId uuid.UUID) error
 	GetChat(ctx context.Context, chatId uuid.UUID) (*models.Chat, error)
 	JoinChat(ctx context.Context, chatId, userId uuid.UUID) error
 	LeaveChat(ctx context.Context, chatId, userId uuid.UUID) error
 	GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error)
+	MuteChat(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error
+	ArchiveChat(ctx context.Context, chatId, userId uuid.UUID, archived bool) error
+	PinChat(ctx context.Context, chatId, userId uuid.UUID, pinned bool) error
+	ReorderPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error
+	SetChatMessageTTL(ctx context.Context, chatId, userId uuid.UUID, ttl time.Duration) (*models.Message, error)
+	SetChatEncryption(ctx context.Context, chatId, userId uuid.UUID, enabled bool) (*models.Message, error)
+	RequestChatExport(ctx context.Context, chatId, userId uuid.UUID) (*models.ChatExport, error)
+	GetChatExport(ctx context.Context, exportId, userId uuid.UUID) (*models.ChatExport, error)
+}
+
+// PrivateFileService reads files with no public URL, such as chat export archives
+type PrivateFileService interface {
+	DownloadPrivateFile(ctx context.Context, filename string) (*models.File, error)
+}
+
+// IChatWSManager delivers system messages about group chat changes to the participants
+type IChatWSManager interface {
+	NotifyChatMessages(ctx context.Context, messages []*models.Message, receivers []uuid.UUID) error
 }
 
 type ChatHandler struct {
@@ -33,14 +67,18 @@ type ChatHandler struct {
 	messageService MessageService
 	profileUseCase ProfileUseCase
 	connService    IWebSocketConnectionManager
+	chatWSManager  IChatWSManager
+	fileService    PrivateFileService
 }
 
-func NewChatHandler(chatUseCase ChatUseCase, profileUseCase ProfileUseCase, messageService MessageService, connService IWebSocketConnectionManager) *ChatHandler {
+func NewChatHandler(chatUseCase ChatUseCase, profileUseCase ProfileUseCase, messageService MessageService, connService IWebSocketConnectionManager, chatWSManager IChatWSManager, fileService PrivateFileService) *ChatHandler {
 	return &ChatHandler{
 		chatUseCase:    chatUseCase,
 		profileUseCase: profileUseCase,
 		connService:    connService,
 		messageService: messageService,
+		chatWSManager:  chatWSManager,
+		fileService:    fileService,
 	}
 }
 
@@ -52,6 +90,7 @@ func NewChatHandler(chatUseCase ChatUseCase, profileUseCase ProfileUseCase, mess
 // @Produce json
 // @Param ts query string false "Timestamp"
 // @Param chats_count query int true "Number of chats"
+// @Param archived query bool false "Return archived chats instead of the main list"
 // @Success 200 {array} forms.ChatOut "List of chats"
 // @Failure 400 {object} forms.ErrorForm "Invalid data"
 // @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
@@ -79,7 +118,7 @@ func (c *ChatHandler) GetUserChats(w http.ResponseWriter, r *http.Request) {
 	logger.Info(ctx, "Fetching feed for user %s with %d posts with timestamp %v (autogenerated: %t)",
 		user.Username, chatForm.ChatsCount, chatForm.Ts, !r.URL.Query().Has("ts"))
 
-	chats, err := c.chatUseCase.GetUserChats(ctx, user.Id, chatForm.ChatsCount, chatForm.Ts)
+	chats, err := c.chatUseCase.GetUserChats(ctx, user.Id, chatForm.ChatsCount, chatForm.Ts, chatForm.Archived)
 	if err != nil {
 		logger.Error(ctx, "Failed to fetch chats: %v", err)
 		http2.WriteJSONError(w, err)
@@ -114,12 +153,25 @@ func (c *ChatHandler) GetUserChats(w http.ResponseWriter, r *http.Request) {
 		chatsOut forms.ChatsOut
 	)
 	for _, chat := range chats {
+		if chat.Type == models.ChatTypeGroup {
+			numUnreadMessages, err := c.messageService.GetNumUnreadMessages(ctx, chat.ID, user.Id)
+			if err != nil {
+				logger.Error(ctx, "Failed to get number of unread messages: %v", err)
+				http2.WriteJSONError(w, err)
+				return
+			}
+
+			chatOut := forms.ToChatOut(chat, lastMessageSenderInfo[chat.LastMessage.SenderID], nil)
+			chatOut.NumUnreadMessages = numUnreadMessages
+			chatsOut = append(chatsOut, chatOut)
+			continue
+		}
 		if chat.Type != models.ChatTypePrivate {
 			continue
 		}
 
 		if chat.LastMessage.ID != uuid.Nil && chat.LastMessage.SenderID != user.Id {
-			_, isOnline = c.connService.IsConnected(chat.LastMessage.SenderID)
+			isOnline = c.connService.IsOnline(chat.LastMessage.SenderID)
 			lastSeen = lastMessageSenderInfo[chat.LastMessage.SenderID].LastSeen
 			username = lastMessageSenderInfo[chat.LastMessage.SenderID].Username
 		} else {
@@ -130,7 +182,7 @@ func (c *ChatHandler) GetUserChats(w http.ResponseWriter, r *http.Request) {
 				http2.WriteJSONError(w, err)
 				return
 			}
-			_, isOnline = c.connService.IsConnected(otherUser)
+			isOnline = c.connService.IsOnline(otherUser)
 
 			otherUserInfo, err := c.profileUseCase.GetPublicUserInfo(ctx, otherUser)
 			if err != nil {
@@ -215,3 +267,980 @@ func (c *ChatHandler) getOtherPrivateChatParticipant(ctx context.Context, chatId
 	}
 	return uuid.Nil, errors.New("user not found")
 }
+
+// CreateGroupChat godoc
+// @Summary Create group chat
+// @Description Creates group chat with the user and the listed members
+// @Tags Chats
+// @Accept multipart/form-data
+// @Produce json
+// @Param name formData string true "Chat name"
+// @Param avatar formData file false "Chat avatar"
+// @Param members formData []string false "Member IDs"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatOut] "Created chat"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats [post]
+func (c *ChatHandler) CreateGroupChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while creating chat")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+	logger.Info(ctx, "User %s requested group chat creation", user.Username)
+
+	err := r.ParseMultipartForm(15 << 20)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse form: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to parse form", http.StatusBadRequest))
+		return
+	}
+
+	info := models.ChatCreationInfo{
+		Type: models.ChatTypeGroup,
+		Name: r.FormValue("name"),
+	}
+	for _, member := range r.MultipartForm.Value["members"] {
+		memberId, err := uuid.Parse(member)
+		if err != nil {
+			logger.Error(ctx, "Failed to parse member id: %s", err.Error())
+			http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid member ID", http.StatusBadRequest))
+			return
+		}
+		info.MemberIDs = append(info.MemberIDs, memberId)
+	}
+
+	info.Avatar, err = http2.GetFile(r, "avatar")
+	if err != nil {
+		logger.Error(ctx, "Failed to get avatar: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to get avatar", http.StatusBadRequest))
+		return
+	}
+
+	chat, systemMessage, err := c.chatUseCase.CreateChat(ctx, user.Id, info)
+	if err != nil {
+		logger.Error(ctx, "Failed to create chat: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	var systemMessages []*models.Message
+	if systemMessage != nil {
+		chat.LastMessage = *systemMessage
+		systemMessages = append(systemMessages, systemMessage)
+	}
+	c.notifyParticipants(ctx, chat.ID, systemMessages, nil)
+
+	c.writeChat(ctx, w, *chat, user.Id)
+}
+
+// UpdateChat godoc
+// @Summary Update group chat
+// @Description Renames the group chat and/or changes its avatar
+// @Tags Chats
+// @Accept multipart/form-data
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Param name formData string false "New chat name"
+// @Param avatar formData file false "New chat avatar"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatOut] "Updated chat"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id} [put]
+func (c *ChatHandler) UpdateChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while updating chat")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	err = r.ParseMultipartForm(15 << 20)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse form: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to parse form", http.StatusBadRequest))
+		return
+	}
+
+	info := models.ChatUpdateInfo{Name: r.FormValue("name")}
+	info.Avatar, err = http2.GetFile(r, "avatar")
+	if err != nil {
+		logger.Error(ctx, "Failed to get avatar: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to get avatar", http.StatusBadRequest))
+		return
+	}
+
+	chat, systemMessages, err := c.chatUseCase.UpdateChat(ctx, chatId, user.Id, info)
+	if err != nil {
+		logger.Error(ctx, "Failed to update chat: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s updated chat %s", user.Username, chatId)
+
+	if len(systemMessages) != 0 {
+		chat.LastMessage = *systemMessages[len(systemMessages)-1]
+	}
+	c.notifyParticipants(ctx, chatId, systemMessages, nil)
+
+	c.writeChat(ctx, w, *chat, user.Id)
+}
+
+// AddChatMembers godoc
+// @Summary Add group chat members
+// @Description Adds users to the group chat
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Param members body forms.AddChatMembersForm true "Users to add"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 409 {object} forms.ErrorForm "User already in chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/members [post]
+func (c *ChatHandler) AddChatMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while adding chat members")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	var form forms.AddChatMembersForm
+	if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil || len(form.UserIds) == 0 {
+		logger.Error(ctx, "Failed to decode chat members: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode chat members", http.StatusBadRequest))
+		return
+	}
+
+	systemMessages, err := c.chatUseCase.AddChatMembers(ctx, chatId, user.Id, form.UserIds)
+	if err != nil {
+		logger.Error(ctx, "Failed to add chat members: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s added %d members to chat %s", user.Username, len(form.UserIds), chatId)
+
+	c.notifyParticipants(ctx, chatId, systemMessages, nil)
+}
+
+// RemoveChatMember godoc
+// @Summary Remove group chat member
+// @Description Removes the member from the group chat, removing yourself leaves the chat
+// @Tags Chats
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Param user_id path string true "Member ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 404 {object} forms.ErrorForm "Member not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/members/{user_id} [delete]
+func (c *ChatHandler) RemoveChatMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while removing chat member")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+	memberId, err := uuid.Parse(mux.Vars(r)["user_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid user ID", http.StatusBadRequest))
+		return
+	}
+
+	// participants are fetched before removal, so the removed member is notified too
+	participants, err := c.chatUseCase.GetChatParticipants(ctx, chatId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat participants: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	systemMessage, err := c.chatUseCase.RemoveChatMember(ctx, chatId, user.Id, memberId)
+	if err != nil {
+		logger.Error(ctx, "Failed to remove chat member: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s removed member %s from chat %s", user.Username, memberId, chatId)
+
+	c.notifyParticipants(ctx, chatId, []*models.Message{systemMessage}, participants)
+}
+
+// ChangeChatRole godoc
+// @Summary Change chat member role
+// @Description Changes role of the group chat member, only the owner can do it. Passing the owner role transfers the ownership
+// @Tags Chats
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Param user_id path string true "Member ID"
+// @Param role query string true "Role (member, admin, owner)"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not the chat owner"
+// @Failure 404 {object} forms.ErrorForm "Member not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/members/{user_id} [post]
+func (c *ChatHandler) ChangeChatRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while changing chat role")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+	memberId, err := uuid.Parse(mux.Vars(r)["user_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid user ID", http.StatusBadRequest))
+		return
+	}
+
+	var role models.ChatRole
+	switch r.URL.Query().Get("role") {
+	case "member":
+		role = models.ChatRoleMember
+	case "admin":
+		role = models.ChatRoleAdmin
+	case "owner":
+		role = models.ChatRoleOwner
+	default:
+		logger.Error(ctx, "Invalid role provided")
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid role", http.StatusBadRequest))
+		return
+	}
+
+	systemMessage, err := c.chatUseCase.ChangeChatRole(ctx, chatId, user.Id, memberId, role)
+	if err != nil {
+		logger.Error(ctx, "Failed to change chat role: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s made %s %s in chat %s", user.Username, memberId, role, chatId)
+
+	c.notifyParticipants(ctx, chatId, []*models.Message{systemMessage}, nil)
+}
+
+// GetChatParticipants godoc
+// @Summary Get chat participants
+// @Description Returns public info and roles of the chat participants
+// @Tags Chats
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {object} forms.PayloadWrapper[[]forms.ChatMemberOut] "Chat participants"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/participants [get]
+func (c *ChatHandler) GetChatParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching chat participants")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	members, err := c.chatUseCase.GetChatMembers(ctx, chatId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat participants: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	participants := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		participants[i] = member.UserID
+	}
+	if !slices.Contains(participants, user.Id) {
+		http2.WriteJSONError(w, errors2.New("NOT_PARTICIPANT", "User is not a participant in the chat", http.StatusForbidden))
+		return
+	}
+
+	infos, err := c.profileUseCase.GetPublicUsersInfo(ctx, participants)
+	if err != nil {
+		logger.Error(ctx, "Failed to get participants info: %s", err.Error())
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	infoById := make(map[uuid.UUID]models.PublicUserInfo, len(infos))
+	for _, info := range infos {
+		infoById[info.Id] = info
+	}
+
+	participantsOut := make([]forms.ChatMemberOut, 0, len(members))
+	for _, member := range members {
+		info, ok := infoById[member.UserID]
+		if !ok {
+			continue
+		}
+		memberOut := forms.ToChatMemberOut(member, info)
+		if isOnline := c.connService.IsOnline(info.Id); isOnline {
+			memberOut.IsOnline = &isOnline
+		}
+		participantsOut = append(participantsOut, memberOut)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[[]forms.ChatMemberOut]{Payload: participantsOut}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json response: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to encode chat participants: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode chat participants", http.StatusInternalServerError))
+		return
+	}
+}
+
+// CreateChatInvite godoc
+// @Summary Create chat invite link
+// @Description Creates an invite link to the group chat, only admins can do it. Empty expiry and usage limit never run out
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Param invite body forms.CreateChatInviteForm false "Invite expiry and usage limit"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatInviteOut] "Created invite"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not an admin of the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/invites [post]
+func (c *ChatHandler) CreateChatInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while creating chat invite")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	var form forms.CreateChatInviteForm
+	if r.ContentLength != 0 {
+		if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+			logger.Error(ctx, "Failed to decode chat invite form: %v", err)
+			http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode chat invite", http.StatusBadRequest))
+			return
+		}
+	}
+	info, err := form.ToModel()
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	invite, err := c.chatUseCase.CreateChatInvite(ctx, chatId, user.Id, info)
+	if err != nil {
+		logger.Error(ctx, "Failed to create chat invite: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s created invite to chat %s", user.Username, chatId)
+
+	writePayload(ctx, w, forms.ToChatInviteOut(*invite))
+}
+
+// GetChatInvites godoc
+// @Summary Get chat invite links
+// @Description Returns active invite links of the group chat, only admins can see them
+// @Tags Chats
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {object} forms.PayloadWrapper[[]forms.ChatInviteOut] "Active invites"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not an admin of the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/invites [get]
+func (c *ChatHandler) GetChatInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching chat invites")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	invites, err := c.chatUseCase.GetChatInvites(ctx, chatId, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat invites: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	invitesOut := make([]forms.ChatInviteOut, len(invites))
+	for i, invite := range invites {
+		invitesOut[i] = forms.ToChatInviteOut(invite)
+	}
+	writePayload(ctx, w, invitesOut)
+}
+
+// RevokeChatInvite godoc
+// @Summary Revoke chat invite link
+// @Description Deletes the invite link, only admins of its chat can do it
+// @Tags Chats
+// @Param token path string true "Invite token"
+// @Success 200 {string} string "OK"
+// @Failure 403 {object} forms.ErrorForm "User is not an admin of the chat"
+// @Failure 404 {object} forms.ErrorForm "Invite not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/invites/{token} [delete]
+func (c *ChatHandler) RevokeChatInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while revoking chat invite")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	if err := c.chatUseCase.RevokeChatInvite(ctx, mux.Vars(r)["token"], user.Id); err != nil {
+		logger.Error(ctx, "Failed to revoke chat invite: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s revoked chat invite", user.Username)
+}
+
+// GetChatInvitePreview godoc
+// @Summary Get chat by invite link
+// @Description Returns the chat behind the invite link to show it before joining
+// @Tags Chats
+// @Produce json
+// @Param token path string true "Invite token"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatPreviewOut] "Chat preview"
+// @Failure 404 {object} forms.ErrorForm "Invite not found or expired"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/invites/{token} [get]
+func (c *ChatHandler) GetChatInvitePreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	preview, err := c.chatUseCase.GetChatInvitePreview(ctx, mux.Vars(r)["token"])
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat invite preview: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	writePayload(ctx, w, forms.ToChatPreviewOut(*preview))
+}
+
+// JoinChatByInvite godoc
+// @Summary Join chat by invite link
+// @Description Adds the user to the group chat of the invite link
+// @Tags Chats
+// @Produce json
+// @Param token path string true "Invite token"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatOut] "Joined chat"
+// @Failure 404 {object} forms.ErrorForm "Invite not found or expired"
+// @Failure 409 {object} forms.ErrorForm "User already in chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/invites/{token}/join [post]
+func (c *ChatHandler) JoinChatByInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while joining chat by invite")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chat, systemMessage, err := c.chatUseCase.JoinChatByInvite(ctx, mux.Vars(r)["token"], user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to join chat by invite: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s joined chat %s by invite", user.Username, chat.ID)
+
+	c.notifyParticipants(ctx, chat.ID, []*models.Message{systemMessage}, nil)
+	c.writeChat(ctx, w, *chat, user.Id)
+}
+
+// MuteChat godoc
+// @Summary Mute or unmute chat
+// @Description Turns off notifications from the chat for the user until the time, without it the chat is muted forever. Muted chats are not counted as unread
+// @Tags Chats
+// @Accept json
+// @Param chat_id path string true "Chat ID"
+// @Param mute body forms.MuteChatForm false "Mute end"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/mute [post]
+// @Router /api/chats/{chat_id}/mute [delete]
+func (c *ChatHandler) MuteChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while muting chat")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	var mutedUntil *time.Time
+	if r.Method != http.MethodDelete {
+		var form forms.MuteChatForm
+		if r.ContentLength != 0 {
+			if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+				logger.Error(ctx, "Failed to decode mute chat form: %v", err)
+				http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode mute chat form", http.StatusBadRequest))
+				return
+			}
+		}
+		until, err := form.ToMutedUntil()
+		if err != nil {
+			http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, err.Error(), http.StatusBadRequest))
+			return
+		}
+		mutedUntil = &until
+	}
+
+	if err = c.chatUseCase.MuteChat(ctx, chatId, user.Id, mutedUntil); err != nil {
+		logger.Error(ctx, "Failed to change chat mute: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s changed mute of chat %s", user.Username, chatId)
+}
+
+// ArchiveChat godoc
+// @Summary Archive or unarchive chat
+// @Description Moves the chat to the archive of the user or back to the main chat list. Archived chats are unpinned
+// @Tags Chats
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/archive [post]
+// @Router /api/chats/{chat_id}/archive [delete]
+func (c *ChatHandler) ArchiveChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while archiving chat")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	archived := r.Method != http.MethodDelete
+	if err = c.chatUseCase.ArchiveChat(ctx, chatId, user.Id, archived); err != nil {
+		logger.Error(ctx, "Failed to change chat archive: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s changed archive of chat %s to %t", user.Username, chatId, archived)
+}
+
+// PinChat godoc
+// @Summary Pin or unpin chat
+// @Description Pins the chat to the top of the chat list of the user, pinned chats are unarchived. No more than 5 chats can be pinned
+// @Tags Chats
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Too many pinned chats"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/pin [post]
+// @Router /api/chats/{chat_id}/pin [delete]
+func (c *ChatHandler) PinChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while pinning chat")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	pinned := r.Method != http.MethodDelete
+	if err = c.chatUseCase.PinChat(ctx, chatId, user.Id, pinned); err != nil {
+		logger.Error(ctx, "Failed to change chat pin: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s changed pin of chat %s to %t", user.Username, chatId, pinned)
+}
+
+// ReorderPinnedChats godoc
+// @Summary Reorder pinned chats
+// @Description Sets the order of pinned chats, the list must contain all of them
+// @Tags Chats
+// @Accept json
+// @Param chats body forms.ReorderPinnedChatsForm true "Pinned chats in the new order"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/pinned [put]
+func (c *ChatHandler) ReorderPinnedChats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while reordering pinned chats")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.ReorderPinnedChatsForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode reorder pinned chats form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode pinned chats", http.StatusBadRequest))
+		return
+	}
+
+	if err := c.chatUseCase.ReorderPinnedChats(ctx, user.Id, form.ChatIds); err != nil {
+		logger.Error(ctx, "Failed to reorder pinned chats: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s reordered pinned chats", user.Username)
+}
+
+// SetChatMessageTTL godoc
+// @Summary Set disappearing messages timer
+// @Description Makes messages sent to the chat from now on disappear after ttl seconds, zero turns it off. Any participant of a private chat and admins of a group chat can do it
+// @Tags Chats
+// @Accept json
+// @Param chat_id path string true "Chat ID"
+// @Param ttl body forms.SetChatMessageTTLForm true "Message lifetime in seconds"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid ttl"
+// @Failure 403 {object} forms.ErrorForm "User is not allowed to change the timer"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/ttl [put]
+func (c *ChatHandler) SetChatMessageTTL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while setting chat message ttl")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	var form forms.SetChatMessageTTLForm
+	if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode chat message ttl form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode message ttl", http.StatusBadRequest))
+		return
+	}
+
+	ttl := time.Duration(form.TTL) * time.Second
+	systemMessage, err := c.chatUseCase.SetChatMessageTTL(ctx, chatId, user.Id, ttl)
+	if err != nil {
+		logger.Error(ctx, "Failed to set chat message ttl: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s set message ttl of chat %s to %v", user.Username, chatId, ttl)
+
+	c.notifyParticipants(ctx, chatId, []*models.Message{systemMessage}, nil)
+}
+
+// SetChatEncryption godoc
+// @Summary Turn end-to-end encryption on or off
+// @Description Makes the private chat accept only end-to-end encrypted messages from now on or turns it off. Already sent messages are left as is. Any participant can do it
+// @Tags Chats
+// @Accept json
+// @Param chat_id path string true "Chat ID"
+// @Param encryption body forms.SetChatEncryptionForm true "Whether encryption is enabled"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Chat is not private"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant of the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/encryption [put]
+func (c *ChatHandler) SetChatEncryption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while setting chat encryption")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	var form forms.SetChatEncryptionForm
+	if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode chat encryption form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode chat encryption", http.StatusBadRequest))
+		return
+	}
+
+	systemMessage, err := c.chatUseCase.SetChatEncryption(ctx, chatId, user.Id, form.Enabled)
+	if err != nil {
+		logger.Error(ctx, "Failed to set chat encryption: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s set encryption of chat %s to %v", user.Username, chatId, form.Enabled)
+
+	c.notifyParticipants(ctx, chatId, []*models.Message{systemMessage}, nil)
+}
+
+// RequestChatExport godoc
+// @Summary Export chat history
+// @Description Starts building a ZIP archive with messages.json and index.html of the chat history. The user gets the chat_export_finished event once it is done
+// @Tags Chats
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatExportOut] "Pending export"
+// @Failure 400 {object} forms.ErrorForm "Invalid chat ID"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant of the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/export [post]
+func (c *ChatHandler) RequestChatExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while requesting chat export")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid chat ID", http.StatusBadRequest))
+		return
+	}
+
+	export, err := c.chatUseCase.RequestChatExport(ctx, chatId, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to request chat export: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s requested export of chat %s", user.Username, chatId)
+
+	writePayload(ctx, w, forms.ToChatExportOut(*export))
+}
+
+// GetChatExport godoc
+// @Summary Get chat export
+// @Description Returns the status of the chat export requested by the user
+// @Tags Chats
+// @Produce json
+// @Param export_id path string true "Export ID"
+// @Success 200 {object} forms.PayloadWrapper[forms.ChatExportOut] "Chat export"
+// @Failure 400 {object} forms.ErrorForm "Invalid export ID"
+// @Failure 404 {object} forms.ErrorForm "Export not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chat_exports/{export_id} [get]
+func (c *ChatHandler) GetChatExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	export, ok := c.getChatExport(ctx, w, r)
+	if !ok {
+		return
+	}
+	writePayload(ctx, w, forms.ToChatExportOut(*export))
+}
+
+// DownloadChatExport godoc
+// @Summary Download chat export
+// @Description Sends the ZIP archive of the chat history once the export is ready. The archive is private and is available only to the user who requested it
+// @Tags Chats
+// @Produce application/zip
+// @Param export_id path string true "Export ID"
+// @Success 200 {file} file "Archive"
+// @Failure 400 {object} forms.ErrorForm "Invalid export ID"
+// @Failure 404 {object} forms.ErrorForm "Export not found"
+// @Failure 409 {object} forms.ErrorForm "Export is not ready or expired"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chat_exports/{export_id}/download [get]
+func (c *ChatHandler) DownloadChatExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	export, ok := c.getChatExport(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	if export.Status != models.ChatExportReady {
+		http2.WriteJSONError(w, errors2.New("EXPORT_NOT_READY", "Chat export is "+string(export.Status), http.StatusConflict))
+		return
+	}
+
+	archive, err := c.fileService.DownloadPrivateFile(ctx, export.FileURL)
+	if err != nil {
+		logger.Error(ctx, "Failed to download archive of chat export %s: %v", export.ID, err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="chat-%s.zip"`, export.ChatID))
+	w.Header().Set("Content-Length", strconv.FormatInt(archive.Size, 10))
+	// headers are already sent, a failed copy can only be logged
+	if _, err = io.Copy(w, archive.Reader); err != nil {
+		logger.Error(ctx, "Failed to send archive of chat export %s: %v", export.ID, err)
+	}
+}
+
+// getChatExport writes the error and returns false if the export of the current user can't be fetched
+func (c *ChatHandler) getChatExport(ctx context.Context, w http.ResponseWriter, r *http.Request) (*models.ChatExport, bool) {
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching chat export")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return nil, false
+	}
+
+	exportId, err := uuid.Parse(mux.Vars(r)["export_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid export ID", http.StatusBadRequest))
+		return nil, false
+	}
+
+	export, err := c.chatUseCase.GetChatExport(ctx, exportId, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat export: %s", err.Error())
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return nil, false
+	}
+	return export, true
+}
+
+// notifyParticipants sends system messages to the receivers, current participants are used when receivers are empty.
+// The change is already saved, so delivery failures are only logged.
+func (c *ChatHandler) notifyParticipants(ctx context.Context, chatId uuid.UUID, messages []*models.Message, receivers []uuid.UUID) {
+	if len(messages) == 0 {
+		return
+	}
+
+	if len(receivers) == 0 {
+		var err error
+		receivers, err = c.chatUseCase.GetChatParticipants(ctx, chatId)
+		if err != nil {
+			logger.Error(ctx, "Failed to get chat %s participants: %v", chatId, err)
+			return
+		}
+	}
+
+	if err := c.chatWSManager.NotifyChatMessages(ctx, messages, receivers); err != nil {
+		logger.Error(ctx, "Failed to notify chat %s participants: %v", chatId, err)
+	}
+}
+
+func (c *ChatHandler) writeChat(ctx context.Context, w http.ResponseWriter, chat models.Chat, userId uuid.UUID) {
+	var senderInfo models.PublicUserInfo
+	if chat.LastMessage.ID != uuid.Nil {
+		var err error
+		senderInfo, err = c.profileUseCase.GetPublicUserInfo(ctx, chat.LastMessage.SenderID)
+		if err != nil {
+			logger.Error(ctx, "Failed to get last message sender info: %v", err)
+			http2.WriteJSONError(w, err)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[forms.ChatOut]{Payload: forms.ToChatOut(chat, senderInfo, nil)}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json response: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to encode chat %s for user %s: %s", chat.ID, userId, err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode chat", http.StatusInternalServerError))
+		return
+	}
+}
+
+func writePayload[T any](ctx context.Context, w http.ResponseWriter, payload T) {
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[T]{Payload: payload}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json response: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to write response: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode response", http.StatusInternalServerError))
+		return
+	}
+}
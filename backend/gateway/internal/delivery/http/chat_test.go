@@ -3,16 +3,21 @@ package http
 import (
 	"context"
 	"errors"
+	"net/http"
 	"net/http/httptest"
-	"quickflow/shared/logger"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"quickflow/gateway/internal/delivery/http/mocks"
 	"quickflow/shared/models"
-	"sha"
 )
 
 func TestGetUserChats(t *testing.T) {
@@ -26,7 +31,7 @@ func TestGetUserChats(t *testing.T) {
 	mockConnService := mocks.NewMockIWebSocketConnectionManager(ctrl)
 
 	// Инициализация обработчика
-	handler := NewChatHandler(mockChatUseCase, mockProfileUseCase, mockMessageService, mockConnService)
+	handler := NewChatHandler(mockChatUseCase, mockProfileUseCase, mockMessageService, mockConnService, nil, nil)
 
 	// Генерация тестовых данных
 	userID := uuid.New()
@@ -36,13 +41,13 @@ func TestGetUserChats(t *testing.T) {
 
 	// Создание мока для запроса
 	req := httptest.NewRequest("GET", "/api/chats?chats_count=5", nil)
-	req = req.WithContext(context.WithValue(req.Context(), logger.Username, user))
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
 
 	// Мокирование ответа
 	w := httptest.NewRecorder()
 
 	// Мокирование вызова методов
-	mockChatUseCase.EXPECT().GetUserChats(gomock.Any(), userID, chatsCount, gomock.Any()).Return([]models.Chat{}, nil).AnyTimes()
+	mockChatUseCase.EXPECT().GetUserChats(gomock.Any(), userID, chatsCount, gomock.Any(), false).Return([]models.Chat{}, nil).AnyTimes()
 	mockProfileUseCase.EXPECT().GetPublicUsersInfo(gomock.Any(), gomock.Any()).Return([]models.PublicUserInfo{}, nil).AnyTimes()
 	mockMessageService.EXPECT().GetNumUnreadMessages(gomock.Any(), gomock.Any(), userID).Return(0, nil).AnyTimes()
 
@@ -62,7 +67,7 @@ func TestGetUserChats_Error(t *testing.T) {
 	mockConnService := mocks.NewMockIWebSocketConnectionManager(ctrl)
 
 	// Инициализация обработчика
-	handler := NewChatHandler(mockChatUseCase, mockProfileUseCase, mockMessageService, mockConnService)
+	handler := NewChatHandler(mockChatUseCase, mockProfileUseCase, mockMessageService, mockConnService, nil, nil)
 
 	// Генерация тестовых данных
 	userID := uuid.New()
@@ -71,13 +76,13 @@ func TestGetUserChats_Error(t *testing.T) {
 
 	// Создание мока для запроса
 	req := httptest.NewRequest("GET", "/api/chats?chats_count=5", nil)
-	req = req.WithContext(context.WithValue(req.Context(), logger.Username, user))
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
 
 	// Мокирование ответа
 	w := httptest.NewRecorder()
 
 	// Мокирование ошибок
-	mockChatUseCase.EXPECT().GetUserChats(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+	mockChatUseCase.EXPECT().GetUserChats(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 
 	// Вызов обработчика
 	handler.GetUserChats(w, req)
@@ -91,7 +96,7 @@ func TestGetNumUnreadChats(t *testing.T) {
 	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
 
 	// Инициализация обработчика
-	handler := NewChatHandler(mockChatUseCase, nil, nil, nil)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
 
 	// Генерация тестовых данных
 	userID := uuid.New()
@@ -99,7 +104,7 @@ func TestGetNumUnreadChats(t *testing.T) {
 
 	// Создание мока для запроса
 	req := httptest.NewRequest("GET", "/api/chats/unread", nil)
-	req = req.WithContext(context.WithValue(req.Context(), logger.Username, user))
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
 
 	// Мокирование ответа
 	w := httptest.NewRecorder()
@@ -119,7 +124,7 @@ func TestGetNumUnreadChats_Error(t *testing.T) {
 	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
 
 	// Инициализация обработчика
-	handler := NewChatHandler(mockChatUseCase, nil, nil, nil)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
 
 	// Генерация тестовых данных
 	userID := uuid.New()
@@ -127,7 +132,7 @@ func TestGetNumUnreadChats_Error(t *testing.T) {
 
 	// Создание мока для запроса
 	req := httptest.NewRequest("GET", "/api/chats/unread", nil)
-	req = req.WithContext(context.WithValue(req.Context(), logger.Username, user))
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
 
 	// Мокирование ответа
 	w := httptest.NewRecorder()
@@ -138,3 +143,420 @@ func TestGetNumUnreadChats_Error(t *testing.T) {
 	// Вызов обработчика
 	handler.GetNumUnreadChats(w, req)
 }
+
+func TestAddChatMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Мокирование зависимостей
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	// Генерация тестовых данных
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	memberID := uuid.New()
+	systemMessages := []*models.Message{{ID: uuid.New(), ChatID: chatID, SenderID: user.Id}}
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/members",
+		strings.NewReader(`{"user_ids":["`+memberID.String()+`"]}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	// Мокирование вызова методов
+	mockChatUseCase.EXPECT().AddChatMembers(gomock.Any(), chatID, user.Id, []uuid.UUID{memberID}).Return(systemMessages, nil)
+	mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{user.Id, memberID}, nil)
+	mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), systemMessages, []uuid.UUID{user.Id, memberID}).Return(nil)
+
+	handler.AddChatMembers(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRemoveChatMember_NotifiesRemovedMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	memberID := uuid.New()
+	systemMessage := &models.Message{ID: uuid.New(), ChatID: chatID, SenderID: user.Id}
+
+	req := httptest.NewRequest("DELETE", "/api/chats/"+chatID.String()+"/members/"+memberID.String(), nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String(), "user_id": memberID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	// участники запрашиваются до удаления
+	gomock.InOrder(
+		mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{user.Id, memberID}, nil),
+		mockChatUseCase.EXPECT().RemoveChatMember(gomock.Any(), chatID, user.Id, memberID).Return(systemMessage, nil),
+		mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), []*models.Message{systemMessage}, []uuid.UUID{user.Id, memberID}).Return(nil),
+	)
+
+	handler.RemoveChatMember(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetChatParticipants_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	req := httptest.NewRequest("GET", "/api/chats/"+chatID.String()+"/participants", nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().GetChatMembers(gomock.Any(), chatID).
+		Return([]models.ChatMember{{UserID: uuid.New(), Role: models.ChatRoleOwner}}, nil)
+
+	handler.GetChatParticipants(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestChangeChatRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	memberID := uuid.New()
+	systemMessage := &models.Message{ID: uuid.New(), ChatID: chatID, SenderID: user.Id}
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/members/"+memberID.String()+"?role=admin", nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String(), "user_id": memberID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().ChangeChatRole(gomock.Any(), chatID, user.Id, memberID, models.ChatRoleAdmin).Return(systemMessage, nil)
+	mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{user.Id, memberID}, nil)
+	mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), []*models.Message{systemMessage}, []uuid.UUID{user.Id, memberID}).Return(nil)
+
+	handler.ChangeChatRole(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestChangeChatRole_InvalidRole(t *testing.T) {
+	handler := NewChatHandler(nil, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	memberID := uuid.New()
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/members/"+memberID.String()+"?role=king", nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String(), "user_id": memberID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	handler.ChangeChatRole(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCreateChatInvite_InvalidExpiry(t *testing.T) {
+	handler := NewChatHandler(nil, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/invites",
+		strings.NewReader(`{"expires_at":"tomorrow"}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	handler.CreateChatInvite(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestJoinChatByInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chat := &models.Chat{ID: uuid.New(), Name: "group", Type: models.ChatTypeGroup}
+	ownerID := uuid.New()
+	systemMessage := &models.Message{ID: uuid.New(), ChatID: chat.ID, SenderID: user.Id}
+
+	req := httptest.NewRequest("POST", "/api/chats/invites/token/join", nil)
+	req = mux.SetURLVars(req, map[string]string{"token": "token"})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().JoinChatByInvite(gomock.Any(), "token", user.Id).Return(chat, systemMessage, nil)
+	mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chat.ID).Return([]uuid.UUID{ownerID, user.Id}, nil)
+	mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), []*models.Message{systemMessage}, []uuid.UUID{ownerID, user.Id}).Return(nil)
+
+	handler.JoinChatByInvite(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), chat.ID.String())
+}
+
+func TestMuteChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	mockChatUseCase.EXPECT().MuteChat(gomock.Any(), chatID, user.Id, &models.MutedForever).Return(nil)
+	mockChatUseCase.EXPECT().MuteChat(gomock.Any(), chatID, user.Id, nil).Return(nil)
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		req := httptest.NewRequest(method, "/api/chats/"+chatID.String()+"/mute", nil)
+		req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+		req = req.WithContext(context.WithValue(req.Context(), "user", user))
+		w := httptest.NewRecorder()
+
+		handler.MuteChat(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestPinChat_TooManyPinned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/pin", nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().PinChat(gomock.Any(), chatID, user.Id, true).
+		Return(status.Error(codes.InvalidArgument, "no more than 5 chats can be pinned"))
+
+	handler.PinChat(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestReorderPinnedChats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	req := httptest.NewRequest("PUT", "/api/chats/pinned",
+		strings.NewReader(`{"chat_ids":["`+chatIDs[0].String()+`","`+chatIDs[1].String()+`"]}`))
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().ReorderPinnedChats(gomock.Any(), user.Id, chatIDs).Return(nil)
+
+	handler.ReorderPinnedChats(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestSetChatMessageTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	systemMessage := &models.Message{ID: uuid.New(), ChatID: chatID, SenderID: user.Id, Text: "3600"}
+
+	req := httptest.NewRequest("PUT", "/api/chats/"+chatID.String()+"/ttl", strings.NewReader(`{"ttl":3600}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().SetChatMessageTTL(gomock.Any(), chatID, user.Id, time.Hour).Return(systemMessage, nil)
+	mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{user.Id}, nil)
+	mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), []*models.Message{systemMessage}, []uuid.UUID{user.Id}).Return(nil)
+
+	handler.SetChatMessageTTL(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestSetChatMessageTTL_InvalidTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	req := httptest.NewRequest("PUT", "/api/chats/"+chatID.String()+"/ttl", strings.NewReader(`{"ttl":5}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().SetChatMessageTTL(gomock.Any(), chatID, user.Id, 5*time.Second).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid message ttl"))
+
+	handler.SetChatMessageTTL(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSetChatEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockChatWSManager := mocks.NewMockIChatWSManager(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, mockChatWSManager, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	systemMessage := &models.Message{ID: uuid.New(), ChatID: chatID, SenderID: user.Id, Text: "on"}
+
+	req := httptest.NewRequest("PUT", "/api/chats/"+chatID.String()+"/encryption", strings.NewReader(`{"enabled":true}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().SetChatEncryption(gomock.Any(), chatID, user.Id, true).Return(systemMessage, nil)
+	mockChatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{user.Id}, nil)
+	mockChatWSManager.EXPECT().NotifyChatMessages(gomock.Any(), []*models.Message{systemMessage}, []uuid.UUID{user.Id}).Return(nil)
+
+	handler.SetChatEncryption(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestSetChatEncryption_GroupChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+
+	req := httptest.NewRequest("PUT", "/api/chats/"+chatID.String()+"/encryption", strings.NewReader(`{"enabled":true}`))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().SetChatEncryption(gomock.Any(), chatID, user.Id, true).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid chat type"))
+
+	handler.SetChatEncryption(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRequestChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, nil)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	chatID := uuid.New()
+	export := &models.ChatExport{ID: uuid.New(), ChatID: chatID, UserID: user.Id, Status: models.ChatExportPending}
+
+	req := httptest.NewRequest("POST", "/api/chats/"+chatID.String()+"/export", nil)
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockChatUseCase.EXPECT().RequestChatExport(gomock.Any(), chatID, user.Id).Return(export, nil)
+
+	handler.RequestChatExport(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"pending"`)
+}
+
+func TestDownloadChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	mockFileService := mocks.NewMockPrivateFileService(ctrl)
+	handler := NewChatHandler(mockChatUseCase, nil, nil, nil, nil, mockFileService)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	tests := []struct {
+		name         string
+		export       *models.ChatExport
+		fileErr      error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "ready",
+			export:       &models.ChatExport{ID: uuid.New(), ChatID: uuid.New(), Status: models.ChatExportReady, FileURL: "export.zip"},
+			expectedCode: http.StatusOK,
+			expectedBody: "archive",
+		},
+		{
+			name:         "archive deleted",
+			export:       &models.ChatExport{ID: uuid.New(), Status: models.ChatExportReady, FileURL: "export.zip"},
+			fileErr:      status.Error(codes.NotFound, "file not found"),
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "pending",
+			export:       &models.ChatExport{ID: uuid.New(), Status: models.ChatExportPending},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "expired",
+			export:       &models.ChatExport{ID: uuid.New(), Status: models.ChatExportExpired},
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/chat_exports/"+tt.export.ID.String()+"/download", nil)
+			req = mux.SetURLVars(req, map[string]string{"export_id": tt.export.ID.String()})
+			req = req.WithContext(context.WithValue(req.Context(), "user", user))
+			w := httptest.NewRecorder()
+
+			mockChatUseCase.EXPECT().GetChatExport(gomock.Any(), tt.export.ID, user.Id).Return(tt.export, nil)
+			if tt.export.Status == models.ChatExportReady {
+				archive := &models.File{Reader: strings.NewReader("archive"), Size: 7, MimeType: "application/zip"}
+				if tt.fileErr != nil {
+					archive = nil
+				}
+				mockFileService.EXPECT().DownloadPrivateFile(gomock.Any(), tt.export.FileURL).Return(archive, tt.fileErr)
+			}
+
+			handler.DownloadChatExport(w, req)
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+				assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="chat-`+tt.export.ChatID.String()+`.zip"`, w.Header().Get("Content-Disposition"))
+			}
+		})
+	}
+}
@@ -133,6 +133,7 @@ func (c *CommentHandler) AddComment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = c.likeWSHandler.NotifyPostCommented(ctx, user.Id, post.CreatorId, post, newComment)
+	notifyMentions(ctx, c.profileService, c.likeWSHandler, user.Id, post, newComment.Text)
 
 	var commentOut forms.CommentOut
 	commentOut.FromComment(*newComment, publicUserInfo)
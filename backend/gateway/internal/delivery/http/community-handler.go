@@ -36,21 +36,28 @@ type CommunityService interface {
 	GetControlledCommunities(ctx context.Context, userId uuid.UUID, count int, ts time.Time) ([]*models.Community, error)
 }
 
+type WSCommunityHandler interface {
+	NotifyCommunityRoleChanged(ctx context.Context, senderId, receiverId uuid.UUID, community *models.Community, role models.CommunityRole) error
+}
+
 type CommunityHandler struct {
-	communityService CommunityService
-	profileService   ProfileUseCase
-	connService      IWebSocketConnectionManager
-	authService      AuthUseCase
-	policy           *bluemonday.Policy
+	communityService   CommunityService
+	profileService     ProfileUseCase
+	connService        IWebSocketConnectionManager
+	authService        AuthUseCase
+	communityWSHandler WSCommunityHandler
+	policy             *bluemonday.Policy
 }
 
-func NewCommunityHandler(communityService CommunityService, profileService ProfileUseCase, connService IWebSocketConnectionManager, authService AuthUseCase, policy *bluemonday.Policy) *CommunityHandler {
+func NewCommunityHandler(communityService CommunityService, profileService ProfileUseCase, connService IWebSocketConnectionManager,
+	authService AuthUseCase, communityWSHandler WSCommunityHandler, policy *bluemonday.Policy) *CommunityHandler {
 	return &CommunityHandler{
-		communityService: communityService,
-		profileService:   profileService,
-		connService:      connService,
-		authService:      authService,
-		policy:           policy,
+		communityService:   communityService,
+		profileService:     profileService,
+		connService:        connService,
+		authService:        authService,
+		communityWSHandler: communityWSHandler,
+		policy:             policy,
 	}
 }
 
@@ -316,7 +323,7 @@ func (c *CommunityHandler) GetCommunityMembers(w http.ResponseWriter, r *http.Re
 	var membersOut []forms.CommunityMemberOut
 	for _, member := range members {
 		formOut := forms.ToCommunityMemberOut(*member, publicInfoMap[member.UserID])
-		if _, isOnline := c.connService.IsConnected(member.UserID); isOnline {
+		if isOnline := c.connService.IsOnline(member.UserID); isOnline {
 			formOut.IsOnline = &isOnline
 		} else {
 			formOut.IsOnline = nil
@@ -671,6 +678,16 @@ func (c *CommunityHandler) ChangeUserRole(w http.ResponseWriter, r *http.Request
 		return
 	}
 	logger.Info(ctx, "Successfully changed community role")
+
+	// the role is already changed, failed notification does not fail the request
+	community, err := c.communityService.GetCommunityById(ctx, communityId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get community: %s", err.Error())
+		return
+	}
+	if err = c.communityWSHandler.NotifyCommunityRoleChanged(ctx, user.Id, userIdParsed, community, roleParsed); err != nil {
+		logger.Error(ctx, "Failed to notify community role change: %s", err.Error())
+	}
 }
 
 func (c *CommunityHandler) GetControlledCommunities(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+
+	"quickflow/gateway/internal/delivery/http/forms"
+	errors2 "quickflow/gateway/internal/errors"
+	http2 "quickflow/gateway/utils/http"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+type DeviceKeyUseCase interface {
+	UploadDeviceKeys(ctx context.Context, keys models.DeviceKeys) error
+	RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey models.SignedPrekey) error
+	RemoveDevice(ctx context.Context, userId uuid.UUID, deviceId string) error
+	GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]models.DeviceKeys, error)
+}
+
+// DeviceKeyHandler serves the directory of device public keys used by end-to-end encrypted chats
+type DeviceKeyHandler struct {
+	deviceKeyUseCase DeviceKeyUseCase
+}
+
+func NewDeviceKeyHandler(deviceKeyUseCase DeviceKeyUseCase) *DeviceKeyHandler {
+	return &DeviceKeyHandler{deviceKeyUseCase: deviceKeyUseCase}
+}
+
+// UploadDeviceKeys godoc
+// @Summary Upload device keys
+// @Description Registers public keys of the device of the user. Uploading keys of a registered device again is allowed only with the same identity key
+// @Tags Devices
+// @Accept json
+// @Param keys body forms.DeviceKeysForm true "Public keys of the device"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid keys or too many devices"
+// @Failure 409 {object} forms.ErrorForm "Device is registered with another identity key"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/devices/keys [post]
+func (d *DeviceKeyHandler) UploadDeviceKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received UploadDeviceKeys request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while uploading device keys")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.DeviceKeysForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode device keys form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode device keys", http.StatusBadRequest))
+		return
+	}
+
+	if err := d.deviceKeyUseCase.UploadDeviceKeys(ctx, form.ToDeviceKeysModel(user.Id)); err != nil {
+		logger.Error(ctx, "Failed to upload device keys: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s uploaded keys of device %s", user.Username, form.DeviceId)
+}
+
+// RotateSignedPrekey godoc
+// @Summary Rotate signed prekey
+// @Description Replaces the signed prekey of the device of the user, the id of the new prekey must be greater
+// @Tags Devices
+// @Accept json
+// @Param device_id path string true "Device ID"
+// @Param prekey body forms.SignedPrekeyForm true "New signed prekey"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid prekey"
+// @Failure 404 {object} forms.ErrorForm "Device not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/devices/{device_id}/signed_prekey [put]
+func (d *DeviceKeyHandler) RotateSignedPrekey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received RotateSignedPrekey request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while rotating signed prekey")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	deviceId := mux.Vars(r)["device_id"]
+
+	var form forms.SignedPrekeyForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode signed prekey form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode signed prekey", http.StatusBadRequest))
+		return
+	}
+
+	if err := d.deviceKeyUseCase.RotateSignedPrekey(ctx, user.Id, deviceId, form.ToSignedPrekeyModel()); err != nil {
+		logger.Error(ctx, "Failed to rotate signed prekey: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s rotated signed prekey of device %s", user.Username, deviceId)
+}
+
+// RemoveDevice godoc
+// @Summary Remove a device
+// @Description Deletes keys of the device of the user, e.g. a lost one. Messages are no longer encrypted for the device and another device can be registered instead
+// @Tags Devices
+// @Param device_id path string true "Device ID"
+// @Success 200 {string} string "OK"
+// @Failure 404 {object} forms.ErrorForm "Device not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/devices/{device_id} [delete]
+func (d *DeviceKeyHandler) RemoveDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received RemoveDevice request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while removing device")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	deviceId := mux.Vars(r)["device_id"]
+	if err := d.deviceKeyUseCase.RemoveDevice(ctx, user.Id, deviceId); err != nil {
+		logger.Error(ctx, "Failed to remove device: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s removed device %s", user.Username, deviceId)
+}
+
+// GetUserDeviceKeys godoc
+// @Summary Get device keys of a user
+// @Description Public keys of all devices of the user, message keys of encrypted chats are encrypted for each of them
+// @Tags Devices
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {array} forms.DeviceKeysOut "Device keys"
+// @Failure 400 {object} forms.ErrorForm "Invalid user ID"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/users/{user_id}/device_keys [get]
+func (d *DeviceKeyHandler) GetUserDeviceKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetUserDeviceKeys request")
+
+	userId, err := uuid.Parse(mux.Vars(r)["user_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid user ID", http.StatusBadRequest))
+		return
+	}
+
+	devices, err := d.deviceKeyUseCase.GetUserDeviceKeys(ctx, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get device keys: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	writePayload(ctx, w, forms.ToDeviceKeysOut(devices))
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"quickflow/gateway/internal/delivery/http/mocks"
+	"quickflow/shared/models"
+)
+
+func TestUploadDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeviceKeyUseCase := mocks.NewMockDeviceKeyUseCase(ctrl)
+	handler := NewDeviceKeyHandler(mockDeviceKeyUseCase)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	body := `{"device_id":"phone","identity_key":"aWRlbnRpdHk=","signed_prekey":{"id":1,"public_key":"cHJla2V5","signature":"c2lnbmF0dXJl"}}`
+
+	tests := []struct {
+		name       string
+		useCaseErr error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "too many devices", useCaseErr: status.Error(codes.InvalidArgument, "too many devices"), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/devices/keys", strings.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), "user", user))
+			w := httptest.NewRecorder()
+
+			mockDeviceKeyUseCase.EXPECT().UploadDeviceKeys(gomock.Any(), models.DeviceKeys{
+				UserID:       user.Id,
+				DeviceID:     "phone",
+				IdentityKey:  []byte("identity"),
+				SignedPrekey: models.SignedPrekey{ID: 1, PublicKey: []byte("prekey"), Signature: []byte("signature")},
+			}).Return(tt.useCaseErr)
+
+			handler.UploadDeviceKeys(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestRotateSignedPrekey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeviceKeyUseCase := mocks.NewMockDeviceKeyUseCase(ctrl)
+	handler := NewDeviceKeyHandler(mockDeviceKeyUseCase)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	req := httptest.NewRequest("PUT", "/api/devices/laptop/signed_prekey", strings.NewReader(`{"id":2,"public_key":"cHJla2V5","signature":"c2lnbmF0dXJl"}`))
+	req = mux.SetURLVars(req, map[string]string{"device_id": "laptop"})
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	mockDeviceKeyUseCase.EXPECT().RotateSignedPrekey(gomock.Any(), user.Id, "laptop",
+		models.SignedPrekey{ID: 2, PublicKey: []byte("prekey"), Signature: []byte("signature")}).
+		Return(status.Error(codes.NotFound, "device not found"))
+
+	handler.RotateSignedPrekey(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRemoveDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeviceKeyUseCase := mocks.NewMockDeviceKeyUseCase(ctrl)
+	handler := NewDeviceKeyHandler(mockDeviceKeyUseCase)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+
+	tests := []struct {
+		name       string
+		serviceErr error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "unknown device", serviceErr: status.Error(codes.NotFound, "device not found"), wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", "/api/devices/laptop", nil)
+			req = mux.SetURLVars(req, map[string]string{"device_id": "laptop"})
+			req = req.WithContext(context.WithValue(req.Context(), "user", user))
+			w := httptest.NewRecorder()
+
+			mockDeviceKeyUseCase.EXPECT().RemoveDevice(gomock.Any(), user.Id, "laptop").Return(tt.serviceErr)
+
+			handler.RemoveDevice(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestGetUserDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeviceKeyUseCase := mocks.NewMockDeviceKeyUseCase(ctrl)
+	handler := NewDeviceKeyHandler(mockDeviceKeyUseCase)
+
+	userId := uuid.New()
+	req := httptest.NewRequest("GET", "/api/users/"+userId.String()+"/device_keys", nil)
+	req = mux.SetURLVars(req, map[string]string{"user_id": userId.String()})
+	w := httptest.NewRecorder()
+
+	mockDeviceKeyUseCase.EXPECT().GetUserDeviceKeys(gomock.Any(), userId).Return([]models.DeviceKeys{
+		{UserID: userId, DeviceID: "phone", IdentityKey: []byte("identity"), SignedPrekey: models.SignedPrekey{ID: 3}},
+	}, nil)
+
+	handler.GetUserDeviceKeys(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var out struct {
+		Payload []struct {
+			DeviceId    string `json:"device_id"`
+			IdentityKey []byte `json:"identity_key"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+	require.Len(t, out.Payload, 1)
+	assert.Equal(t, "phone", out.Payload[0].DeviceId)
+	assert.Equal(t, []byte("identity"), out.Payload[0].IdentityKey)
+}
@@ -29,6 +29,7 @@ type PostService interface {
 	LikePost(ctx context.Context, postId, userId uuid.UUID) error
 	UnlikePost(ctx context.Context, postId, userId uuid.UUID) error
 	GetPost(ctx context.Context, postId, userId uuid.UUID) (*models.Post, error)
+	VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error)
 }
 
 type FeedHandler struct {
@@ -88,6 +88,17 @@ func (p *FileHandler) AddFiles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	voices, err := http2.GetFiles(r, "voice")
+	if errors.Is(err, http2.TooManyFilesErr) {
+		logger.Error(ctx, "Too many voice messages requested: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Too many voice messages requested", http.StatusBadRequest))
+		return
+	} else if err != nil {
+		logger.Error(ctx, "Failed to get voice messages: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get voice messages", http.StatusBadRequest))
+		return
+	}
+
 	// SendMessage other files
 	otherFiles, err := http2.GetFiles(r, "files")
 	if errors.Is(err, http2.TooManyFilesErr) {
@@ -127,6 +138,17 @@ func (p *FileHandler) AddFiles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// file service computes duration and waveform of voice messages
+	for i := range voices {
+		voices[i].DisplayType = models.DisplayTypeAudio
+		voices[i].IsVoice = true
+	}
+	res.VoiceURLs, err = p.fileService.UploadManyFiles(ctx, voices)
+	if err != nil {
+		http2.WriteJSONError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	out := forms.PayloadWrapper[forms.MessageAttachmentForm]{Payload: res}
 	js, err := out.MarshalJSON()
@@ -51,7 +51,37 @@ func TestFileHandler(t *testing.T) {
 				return req.WithContext(ctx)
 			},
 			mockSetup: func(fs *mocks.MockFileService) {
-				fs.EXPECT().UploadManyFiles(gomock.Any(), gomock.Any()).Return([]string{"url1"}, nil).Times(4)
+				fs.EXPECT().UploadManyFiles(gomock.Any(), gomock.Any()).Return([]string{"url1"}, nil).Times(5)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "AddFiles voice message",
+			method: http.MethodPost,
+			path:   "/files",
+			setupRequest: func() *http.Request {
+				body := &bytes.Buffer{}
+				writer := multipart.NewWriter(body)
+
+				part, _ := writer.CreateFormFile("voice", "voice.ogg")
+				part.Write([]byte("test"))
+
+				writer.Close()
+
+				req := httptest.NewRequest(http.MethodPost, "/files", body)
+				req.Header.Set("Content-Type", writer.FormDataContentType())
+				ctx := context.WithValue(req.Context(), "user", models.User{Username: "testuser"})
+				return req.WithContext(ctx)
+			},
+			mockSetup: func(fs *mocks.MockFileService) {
+				fs.EXPECT().UploadManyFiles(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, files []*models.File) ([]string, error) {
+					for _, file := range files {
+						if !file.IsVoice || file.DisplayType != models.DisplayTypeAudio {
+							return nil, errors.New("voice message is not flagged")
+						}
+					}
+					return make([]string, len(files)), nil
+				}).Times(5)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -15,6 +15,7 @@ import (
 type GetChatsForm struct {
 	ChatsCount int       `json:"chats_count"`
 	Ts         time.Time `json:"ts,omitempty"`
+	Archived   bool      `json:"archived,omitempty"`
 }
 
 //easyjson:json
@@ -32,11 +33,99 @@ type ChatOut struct {
 	LastReadByOther   string      `json:"last_read_by_other,omitempty"`
 	LastReadByMe      string      `json:"last_read_by_me,omitempty"`
 	NumUnreadMessages int         `json:"unread_messages"`
+	// PinnedMessages are ordered from the latest pinned
+	PinnedMessages []PinnedMessageOut `json:"pinned_messages,omitempty"`
+	MutedUntil     string             `json:"muted_until,omitempty"`
+	Archived       bool               `json:"archived,omitempty"`
+	PinOrder       int                `json:"pin_order,omitempty"`
+	// MessageTTL is the lifetime of new messages in seconds, zero if they do not disappear
+	MessageTTL int `json:"message_ttl,omitempty"`
+	// Encrypted chats accept only end-to-end encrypted messages, their previews have no text
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+type PinnedMessageOut struct {
+	Message  *MessagePreviewOut `json:"message"`
+	PinnedBy uuid.UUID          `json:"pinned_by"`
+	PinnedAt string             `json:"pinned_at"`
 }
 
 //easyjson:json
 type ChatsOut []ChatOut
 
+//easyjson:json
+type AddChatMembersForm struct {
+	UserIds []uuid.UUID `json:"user_ids"`
+}
+
+//easyjson:json
+type ChatMemberOut struct {
+	Role string `json:"role"`
+	PublicUserInfoOut
+}
+
+//easyjson:json
+type CreateChatInviteForm struct {
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	UsageLimit int    `json:"usage_limit,omitempty"`
+}
+
+//easyjson:json
+type ChatInviteOut struct {
+	Token      string `json:"token"`
+	ChatId     string `json:"chat_id"`
+	CreatorId  string `json:"creator_id"`
+	CreatedAt  string `json:"created_at"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	UsageLimit int    `json:"usage_limit,omitempty"`
+	UsageCount int    `json:"usage_count"`
+}
+
+//easyjson:json
+type ChatPreviewOut struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	AvatarURL  string `json:"avatar_url,omitempty"`
+	NumMembers int    `json:"members_count"`
+}
+
+// MuteChatForm mutes the chat forever when MutedUntil is empty
+//
+//easyjson:json
+type MuteChatForm struct {
+	MutedUntil string `json:"muted_until,omitempty"`
+}
+
+//easyjson:json
+type ReorderPinnedChatsForm struct {
+	ChatIds []uuid.UUID `json:"chat_ids"`
+}
+
+// SetChatMessageTTLForm sets the lifetime of new messages in seconds, zero turns disappearing messages off
+//
+//easyjson:json
+type SetChatMessageTTLForm struct {
+	TTL int `json:"ttl"`
+}
+
+// SetChatEncryptionForm turns end-to-end encryption of the private chat on or off
+//
+//easyjson:json
+type SetChatEncryptionForm struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ChatExportOut is the archive of the chat history, it is downloaded by id once Status is ready
+//
+//easyjson:json
+type ChatExportOut struct {
+	ID        string `json:"id"`
+	ChatId    string `json:"chat_id"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 //easyjson:json
 type GetNumUnreadChatsForm struct {
 	ChatsCount int `json:"chats_count"`
@@ -70,6 +159,13 @@ func (g *GetChatsForm) GetParams(values url.Values) error {
 		ts = time.Now()
 	}
 	g.Ts = ts
+
+	if values.Has("archived") {
+		g.Archived, err = strconv.ParseBool(values.Get("archived"))
+		if err != nil {
+			return errors.New("failed to parse archived")
+		}
+	}
 	return nil
 }
 
@@ -82,12 +178,14 @@ func ToChatOut(chat models.Chat, lastMessageSenderInfo models.PublicUserInfo, pr
 	}
 
 	chatOut := ChatOut{
-		ID:        chat.ID.String(),
-		Name:      chat.Name,
-		CreatedAt: chat.CreatedAt.Format(time2.TimeStampLayout),
-		UpdatedAt: chat.UpdatedAt.Format(time2.TimeStampLayout),
-		AvatarURL: chat.AvatarURL,
-		Type:      chatType,
+		ID:         chat.ID.String(),
+		Name:       chat.Name,
+		CreatedAt:  chat.CreatedAt.Format(time2.TimeStampLayout),
+		UpdatedAt:  chat.UpdatedAt.Format(time2.TimeStampLayout),
+		AvatarURL:  chat.AvatarURL,
+		Type:       chatType,
+		MessageTTL: int(chat.MessageTTL / time.Second),
+		Encrypted:  chat.Encrypted,
 	}
 
 	if chat.LastReadByOther != nil {
@@ -96,10 +194,18 @@ func ToChatOut(chat models.Chat, lastMessageSenderInfo models.PublicUserInfo, pr
 	if chat.LastReadByMe != nil {
 		chatOut.LastReadByMe = chat.LastReadByMe.Format(time2.TimeStampLayout)
 	}
+	chatOut.setUserSettings(chat)
 	if chat.LastMessage.ID != uuid.Nil {
 		msg := ToMessageOut(chat.LastMessage, lastMessageSenderInfo)
 		chatOut.LastMessage = &msg
 	}
+	for _, pinned := range chat.PinnedMessages {
+		chatOut.PinnedMessages = append(chatOut.PinnedMessages, PinnedMessageOut{
+			Message:  ToMessagePreviewOut(pinned.Message),
+			PinnedBy: pinned.PinnedBy,
+			PinnedAt: pinned.PinnedAt.Format(time2.TimeStampLayout),
+		})
+	}
 
 	if chat.Type == models.ChatTypePrivate && privateChatOnlineStatus != nil {
 		chatOut.IsOnline = &privateChatOnlineStatus.Activity.IsOnline
@@ -125,12 +231,14 @@ func ToChatsOut(chats []models.Chat, lastMessageSenderInfo map[uuid.UUID]models.
 		}
 
 		chatOut := ChatOut{
-			ID:        chat.ID.String(),
-			Name:      chat.Name,
-			CreatedAt: chat.CreatedAt.Format(time2.TimeStampLayout),
-			UpdatedAt: chat.UpdatedAt.Format(time2.TimeStampLayout),
-			AvatarURL: chat.AvatarURL,
-			Type:      chatType,
+			ID:         chat.ID.String(),
+			Name:       chat.Name,
+			CreatedAt:  chat.CreatedAt.Format(time2.TimeStampLayout),
+			UpdatedAt:  chat.UpdatedAt.Format(time2.TimeStampLayout),
+			AvatarURL:  chat.AvatarURL,
+			Type:       chatType,
+			MessageTTL: int(chat.MessageTTL / time.Second),
+			Encrypted:  chat.Encrypted,
 		}
 		if chat.LastReadByOther != nil {
 			chatOut.LastReadByOther = chat.LastReadByOther.Format(time2.TimeStampLayout)
@@ -138,6 +246,7 @@ func ToChatsOut(chats []models.Chat, lastMessageSenderInfo map[uuid.UUID]models.
 		if chat.LastReadByMe != nil {
 			chatOut.LastReadByMe = chat.LastReadByMe.Format(time2.TimeStampLayout)
 		}
+		chatOut.setUserSettings(chat)
 		if chat.LastMessage.ID != uuid.Nil {
 			msg := ToMessageOut(chat.LastMessage, lastMessageSenderInfo[chat.LastMessage.SenderID])
 			chatOut.LastMessage = &msg
@@ -156,3 +265,78 @@ func ToChatsOut(chats []models.Chat, lastMessageSenderInfo map[uuid.UUID]models.
 	}
 	return chatsOut
 }
+
+// setUserSettings fills chat list settings of the requesting user, expired mute is not shown
+func (c *ChatOut) setUserSettings(chat models.Chat) {
+	if chat.IsMuted(time.Now()) {
+		c.MutedUntil = chat.MutedUntil.Format(time2.TimeStampLayout)
+	}
+	c.Archived = chat.Archived
+	c.PinOrder = chat.PinOrder
+}
+
+// ToMutedUntil converts empty time to the far future so that the chat is muted forever
+func (f *MuteChatForm) ToMutedUntil() (time.Time, error) {
+	if len(f.MutedUntil) == 0 {
+		return models.MutedForever, nil
+	}
+	mutedUntil, err := time.Parse(time2.TimeStampLayout, f.MutedUntil)
+	if err != nil {
+		return time.Time{}, errors.New("failed to parse muted_until")
+	}
+	return mutedUntil, nil
+}
+
+func ToChatMemberOut(member models.ChatMember, info models.PublicUserInfo) ChatMemberOut {
+	return ChatMemberOut{
+		Role:              string(member.Role),
+		PublicUserInfoOut: PublicUserInfoToOut(info, ""),
+	}
+}
+
+// ToModel leaves expiry empty for invites that never expire
+func (f *CreateChatInviteForm) ToModel() (models.ChatInviteCreationInfo, error) {
+	info := models.ChatInviteCreationInfo{UsageLimit: f.UsageLimit}
+	if len(f.ExpiresAt) != 0 {
+		expiresAt, err := time.Parse(time2.TimeStampLayout, f.ExpiresAt)
+		if err != nil {
+			return models.ChatInviteCreationInfo{}, errors.New("failed to parse expires_at")
+		}
+		info.ExpiresAt = &expiresAt
+	}
+	return info, nil
+}
+
+func ToChatInviteOut(invite models.ChatInvite) ChatInviteOut {
+	out := ChatInviteOut{
+		Token:      invite.Token,
+		ChatId:     invite.ChatID.String(),
+		CreatorId:  invite.CreatorID.String(),
+		CreatedAt:  invite.CreatedAt.Format(time2.TimeStampLayout),
+		UsageLimit: invite.UsageLimit,
+		UsageCount: invite.UsageCount,
+	}
+	if invite.ExpiresAt != nil {
+		out.ExpiresAt = invite.ExpiresAt.Format(time2.TimeStampLayout)
+	}
+	return out
+}
+
+func ToChatExportOut(export models.ChatExport) ChatExportOut {
+	return ChatExportOut{
+		ID:        export.ID.String(),
+		ChatId:    export.ChatID.String(),
+		Status:    string(export.Status),
+		CreatedAt: export.CreatedAt.Format(time2.TimeStampLayout),
+		UpdatedAt: export.UpdatedAt.Format(time2.TimeStampLayout),
+	}
+}
+
+func ToChatPreviewOut(preview models.ChatPreview) ChatPreviewOut {
+	return ChatPreviewOut{
+		ID:         preview.Chat.ID.String(),
+		Name:       preview.Chat.Name,
+		AvatarURL:  preview.Chat.AvatarURL,
+		NumMembers: preview.NumMembers,
+	}
+}
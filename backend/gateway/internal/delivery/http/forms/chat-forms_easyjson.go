@@ -4,9 +4,11 @@ package forms
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	models "quickflow/shared/models"
 )
 
 // suppress unused package warning
@@ -17,7 +19,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *PrivateChatInfo) {
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *SetChatMessageTTLForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -36,10 +38,8 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.
 			continue
 		}
 		switch key {
-		case "username":
-			out.Username = string(in.String())
-		case "activity":
-			(out.Activity).UnmarshalEasyJSON(in)
+		case "ttl":
+			out.TTL = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -50,53 +50,42 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in PrivateChatInfo) {
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in SetChatMessageTTLForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Username != "" {
-		const prefix string = ",\"username\":"
-		first = false
+	{
+		const prefix string = ",\"ttl\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Username))
-	}
-	if true {
-		const prefix string = ",\"activity\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(in.Activity).MarshalEasyJSON(out)
+		out.Int(int(in.TTL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PrivateChatInfo) MarshalJSON() ([]byte, error) {
+func (v SetChatMessageTTLForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PrivateChatInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetChatMessageTTLForm) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PrivateChatInfo) UnmarshalJSON(data []byte) error {
+func (v *SetChatMessageTTLForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PrivateChatInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetChatMessageTTLForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms(l, v)
 }
-func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *GetNumUnreadChatsForm) {
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *SetChatEncryptionForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -115,8 +104,8 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 			continue
 		}
 		switch key {
-		case "chats_count":
-			out.ChatsCount = int(in.Int())
+		case "enabled":
+			out.Enabled = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -127,108 +116,142 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in GetNumUnreadChatsForm) {
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in SetChatEncryptionForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chats_count\":"
+		const prefix string = ",\"enabled\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatsCount))
+		out.Bool(bool(in.Enabled))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetNumUnreadChatsForm) MarshalJSON() ([]byte, error) {
+func (v SetChatEncryptionForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetNumUnreadChatsForm) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetChatEncryptionForm) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetNumUnreadChatsForm) UnmarshalJSON(data []byte) error {
+func (v *SetChatEncryptionForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetNumUnreadChatsForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetChatEncryptionForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
 }
-func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *ChatsOut) {
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *ReorderPinnedChatsForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
 		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(ChatsOut, 0, 0)
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ChatIds = nil
 			} else {
-				*out = ChatsOut{}
+				in.Delim('[')
+				if out.ChatIds == nil {
+					if !in.IsDelim(']') {
+						out.ChatIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ChatIds = []uuid.UUID{}
+					}
+				} else {
+					out.ChatIds = (out.ChatIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v1).UnmarshalText(data))
+					}
+					out.ChatIds = append(out.ChatIds, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v1 ChatOut
-			(v1).UnmarshalEasyJSON(in)
-			*out = append(*out, v1)
-			in.WantComma()
+		default:
+			in.SkipRecursive()
 		}
-		in.Delim(']')
+		in.WantComma()
 	}
+	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in ChatsOut) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
-				out.RawByte(',')
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in ReorderPinnedChatsForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_ids\":"
+		out.RawString(prefix[1:])
+		if in.ChatIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.ChatIds {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v3).MarshalText())
 			}
-			(v3).MarshalEasyJSON(out)
+			out.RawByte(']')
 		}
-		out.RawByte(']')
 	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatsOut) MarshalJSON() ([]byte, error) {
+func (v ReorderPinnedChatsForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatsOut) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ReorderPinnedChatsForm) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatsOut) UnmarshalJSON(data []byte) error {
+func (v *ReorderPinnedChatsForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatsOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ReorderPinnedChatsForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms2(l, v)
 }
-func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *ChatOut) {
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *PrivateChatInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -247,48 +270,10 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "created_at":
-			out.CreatedAt = string(in.String())
-		case "updated_at":
-			out.UpdatedAt = string(in.String())
-		case "avatar_url":
-			out.AvatarURL = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "last_message":
-			if in.IsNull() {
-				in.Skip()
-				out.LastMessage = nil
-			} else {
-				if out.LastMessage == nil {
-					out.LastMessage = new(MessageOut)
-				}
-				(*out.LastMessage).UnmarshalEasyJSON(in)
-			}
-		case "online":
-			if in.IsNull() {
-				in.Skip()
-				out.IsOnline = nil
-			} else {
-				if out.IsOnline == nil {
-					out.IsOnline = new(bool)
-				}
-				*out.IsOnline = bool(in.Bool())
-			}
-		case "last_seen":
-			out.LastSeen = string(in.String())
 		case "username":
 			out.Username = string(in.String())
-		case "last_read_by_other":
-			out.LastReadByOther = string(in.String())
-		case "last_read_by_me":
-			out.LastReadByMe = string(in.String())
-		case "unread_messages":
-			out.NumUnreadMessages = int(in.Int())
+		case "activity":
+			(out.Activity).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -299,98 +284,1236 @@ func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in ChatOut) {
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in PrivateChatInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	if in.Name != "" {
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.String(string(in.CreatedAt))
-	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.String(string(in.UpdatedAt))
-	}
-	if in.AvatarURL != "" {
-		const prefix string = ",\"avatar_url\":"
-		out.RawString(prefix)
-		out.String(string(in.AvatarURL))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	if in.LastMessage != nil {
-		const prefix string = ",\"last_message\":"
-		out.RawString(prefix)
-		(*in.LastMessage).MarshalEasyJSON(out)
-	}
-	if in.IsOnline != nil {
-		const prefix string = ",\"online\":"
-		out.RawString(prefix)
-		out.Bool(bool(*in.IsOnline))
-	}
-	if in.LastSeen != "" {
-		const prefix string = ",\"last_seen\":"
-		out.RawString(prefix)
-		out.String(string(in.LastSeen))
-	}
 	if in.Username != "" {
 		const prefix string = ",\"username\":"
-		out.RawString(prefix)
+		first = false
+		out.RawString(prefix[1:])
 		out.String(string(in.Username))
 	}
-	if in.LastReadByOther != "" {
-		const prefix string = ",\"last_read_by_other\":"
-		out.RawString(prefix)
-		out.String(string(in.LastReadByOther))
-	}
-	if in.LastReadByMe != "" {
-		const prefix string = ",\"last_read_by_me\":"
-		out.RawString(prefix)
-		out.String(string(in.LastReadByMe))
-	}
-	{
-		const prefix string = ",\"unread_messages\":"
-		out.RawString(prefix)
-		out.Int(int(in.NumUnreadMessages))
+	if true {
+		const prefix string = ",\"activity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Activity).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatOut) MarshalJSON() ([]byte, error) {
+func (v PrivateChatInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatOut) MarshalEasyJSON(w *jwriter.Writer) {
+func (v PrivateChatInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatOut) UnmarshalJSON(data []byte) error {
+func (v *PrivateChatInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *PrivateChatInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms3(l, v)
 }
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer.Lexer, out *MuteChatForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "muted_until":
+			out.MutedUntil = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwriter.Writer, in MuteChatForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.MutedUntil != "" {
+		const prefix string = ",\"muted_until\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.MutedUntil))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MuteChatForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MuteChatForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MuteChatForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MuteChatForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms4(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer.Lexer, out *GetNumUnreadChatsForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chats_count":
+			out.ChatsCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwriter.Writer, in GetNumUnreadChatsForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chats_count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatsCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetNumUnreadChatsForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetNumUnreadChatsForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetNumUnreadChatsForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetNumUnreadChatsForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms5(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms6(in *jlexer.Lexer, out *CreateChatInviteForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "expires_at":
+			out.ExpiresAt = string(in.String())
+		case "usage_limit":
+			out.UsageLimit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms6(out *jwriter.Writer, in CreateChatInviteForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ExpiresAt != "" {
+		const prefix string = ",\"expires_at\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ExpiresAt))
+	}
+	if in.UsageLimit != 0 {
+		const prefix string = ",\"usage_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.UsageLimit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateChatInviteForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateChatInviteForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateChatInviteForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateChatInviteForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms6(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms7(in *jlexer.Lexer, out *ChatsOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ChatsOut, 0, 0)
+			} else {
+				*out = ChatsOut{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 ChatOut
+			(v4).UnmarshalEasyJSON(in)
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms7(out *jwriter.Writer, in ChatsOut) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			(v6).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatsOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatsOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatsOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatsOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms7(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms8(in *jlexer.Lexer, out *ChatPreviewOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
+		case "members_count":
+			out.NumMembers = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms8(out *jwriter.Writer, in ChatPreviewOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	{
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.NumMembers))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatPreviewOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatPreviewOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatPreviewOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatPreviewOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms8(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms9(in *jlexer.Lexer, out *ChatOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "last_message":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessage = nil
+			} else {
+				if out.LastMessage == nil {
+					out.LastMessage = new(MessageOut)
+				}
+				(*out.LastMessage).UnmarshalEasyJSON(in)
+			}
+		case "online":
+			if in.IsNull() {
+				in.Skip()
+				out.IsOnline = nil
+			} else {
+				if out.IsOnline == nil {
+					out.IsOnline = new(bool)
+				}
+				*out.IsOnline = bool(in.Bool())
+			}
+		case "last_seen":
+			out.LastSeen = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "last_read_by_other":
+			out.LastReadByOther = string(in.String())
+		case "last_read_by_me":
+			out.LastReadByMe = string(in.String())
+		case "unread_messages":
+			out.NumUnreadMessages = int(in.Int())
+		case "pinned_messages":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedMessages = nil
+			} else {
+				in.Delim('[')
+				if out.PinnedMessages == nil {
+					if !in.IsDelim(']') {
+						out.PinnedMessages = make([]PinnedMessageOut, 0, 1)
+					} else {
+						out.PinnedMessages = []PinnedMessageOut{}
+					}
+				} else {
+					out.PinnedMessages = (out.PinnedMessages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 PinnedMessageOut
+					easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms10(in, &v7)
+					out.PinnedMessages = append(out.PinnedMessages, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "muted_until":
+			out.MutedUntil = string(in.String())
+		case "archived":
+			out.Archived = bool(in.Bool())
+		case "pin_order":
+			out.PinOrder = int(in.Int())
+		case "message_ttl":
+			out.MessageTTL = int(in.Int())
+		case "encrypted":
+			out.Encrypted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms9(out *jwriter.Writer, in ChatOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.LastMessage != nil {
+		const prefix string = ",\"last_message\":"
+		out.RawString(prefix)
+		(*in.LastMessage).MarshalEasyJSON(out)
+	}
+	if in.IsOnline != nil {
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.IsOnline))
+	}
+	if in.LastSeen != "" {
+		const prefix string = ",\"last_seen\":"
+		out.RawString(prefix)
+		out.String(string(in.LastSeen))
+	}
+	if in.Username != "" {
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	if in.LastReadByOther != "" {
+		const prefix string = ",\"last_read_by_other\":"
+		out.RawString(prefix)
+		out.String(string(in.LastReadByOther))
+	}
+	if in.LastReadByMe != "" {
+		const prefix string = ",\"last_read_by_me\":"
+		out.RawString(prefix)
+		out.String(string(in.LastReadByMe))
+	}
+	{
+		const prefix string = ",\"unread_messages\":"
+		out.RawString(prefix)
+		out.Int(int(in.NumUnreadMessages))
+	}
+	if len(in.PinnedMessages) != 0 {
+		const prefix string = ",\"pinned_messages\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.PinnedMessages {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms10(out, v9)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MutedUntil != "" {
+		const prefix string = ",\"muted_until\":"
+		out.RawString(prefix)
+		out.String(string(in.MutedUntil))
+	}
+	if in.Archived {
+		const prefix string = ",\"archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Archived))
+	}
+	if in.PinOrder != 0 {
+		const prefix string = ",\"pin_order\":"
+		out.RawString(prefix)
+		out.Int(int(in.PinOrder))
+	}
+	if in.MessageTTL != 0 {
+		const prefix string = ",\"message_ttl\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessageTTL))
+	}
+	if in.Encrypted {
+		const prefix string = ",\"encrypted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Encrypted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms9(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms10(in *jlexer.Lexer, out *PinnedMessageOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(MessagePreviewOut)
+				}
+				easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms11(in, out.Message)
+			}
+		case "pinned_by":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PinnedBy).UnmarshalText(data))
+			}
+		case "pinned_at":
+			out.PinnedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms10(out *jwriter.Writer, in PinnedMessageOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms11(out, *in.Message)
+		}
+	}
+	{
+		const prefix string = ",\"pinned_by\":"
+		out.RawString(prefix)
+		out.RawText((in.PinnedBy).MarshalText())
+	}
+	{
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.String(string(in.PinnedAt))
+	}
+	out.RawByte('}')
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms11(in *jlexer.Lexer, out *MessagePreviewOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "sender_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SenderId).UnmarshalText(data))
+			}
+		case "sender":
+			if in.IsNull() {
+				in.Skip()
+				out.Sender = nil
+			} else {
+				if out.Sender == nil {
+					out.Sender = new(PublicUserInfoOut)
+				}
+				(*out.Sender).UnmarshalEasyJSON(in)
+			}
+		case "text":
+			out.Text = string(in.String())
+		case "attachment_type":
+			out.AttachmentType = string(in.String())
+		case "deleted":
+			out.Deleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms11(out *jwriter.Writer, in MessagePreviewOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SenderId).MarshalText())
+	}
+	if in.Sender != nil {
+		const prefix string = ",\"sender\":"
+		out.RawString(prefix)
+		(*in.Sender).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.AttachmentType != "" {
+		const prefix string = ",\"attachment_type\":"
+		out.RawString(prefix)
+		out.String(string(in.AttachmentType))
+	}
+	if in.Deleted {
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	out.RawByte('}')
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms12(in *jlexer.Lexer, out *ChatMemberOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "role":
+			out.Role = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
+		case "firstname":
+			out.FirstName = string(in.String())
+		case "lastname":
+			out.LastName = string(in.String())
+		case "online":
+			if in.IsNull() {
+				in.Skip()
+				out.IsOnline = nil
+			} else {
+				if out.IsOnline == nil {
+					out.IsOnline = new(bool)
+				}
+				*out.IsOnline = bool(in.Bool())
+			}
+		case "relation":
+			out.Relation = models.UserRelation(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms12(out *jwriter.Writer, in ChatMemberOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	{
+		const prefix string = ",\"firstname\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastname\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	if in.IsOnline != nil {
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.IsOnline))
+	}
+	if in.Relation != "" {
+		const prefix string = ",\"relation\":"
+		out.RawString(prefix)
+		out.String(string(in.Relation))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatMemberOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatMemberOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatMemberOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatMemberOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms12(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms13(in *jlexer.Lexer, out *ChatInviteOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "chat_id":
+			out.ChatId = string(in.String())
+		case "creator_id":
+			out.CreatorId = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "expires_at":
+			out.ExpiresAt = string(in.String())
+		case "usage_limit":
+			out.UsageLimit = int(in.Int())
+		case "usage_count":
+			out.UsageCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms13(out *jwriter.Writer, in ChatInviteOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ChatId))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	if in.ExpiresAt != "" {
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpiresAt))
+	}
+	if in.UsageLimit != 0 {
+		const prefix string = ",\"usage_limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.UsageLimit))
+	}
+	{
+		const prefix string = ",\"usage_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.UsageCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatInviteOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatInviteOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatInviteOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatInviteOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms13(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms14(in *jlexer.Lexer, out *ChatExportOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "chat_id":
+			out.ChatId = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms14(out *jwriter.Writer, in ChatExportOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ChatId))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChatExportOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChatExportOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChatExportOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChatExportOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms14(l, v)
+}
+func easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms15(in *jlexer.Lexer, out *AddChatMembersForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIds = nil
+			} else {
+				in.Delim('[')
+				if out.UserIds == nil {
+					if !in.IsDelim(']') {
+						out.UserIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.UserIds = []uuid.UUID{}
+					}
+				} else {
+					out.UserIds = (out.UserIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v10).UnmarshalText(data))
+					}
+					out.UserIds = append(out.UserIds, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms15(out *jwriter.Writer, in AddChatMembersForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.UserIds {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v12).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddChatMembersForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddChatMembersForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson44a4302cEncodeQuickflowGatewayInternalDeliveryHttpForms15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddChatMembersForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddChatMembersForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson44a4302cDecodeQuickflowGatewayInternalDeliveryHttpForms15(l, v)
+}
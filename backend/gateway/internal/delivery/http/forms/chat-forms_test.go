@@ -70,6 +70,26 @@ func TestGetChatsForm_GetParams(t *testing.T) {
 				Ts:         time.Now(), // will be set to current time
 			},
 		},
+		{
+			name: "archived chats",
+			values: url.Values{
+				"chats_count": []string{"10"},
+				"archived":    []string{"true"},
+			},
+			expected: GetChatsForm{
+				ChatsCount: 10,
+				Ts:         time.Now(), // will be set to current time
+				Archived:   true,
+			},
+		},
+		{
+			name: "invalid archived",
+			values: url.Values{
+				"chats_count": []string{"10"},
+				"archived":    []string{"maybe"},
+			},
+			expectedErr: errors.New("failed to parse archived"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -83,6 +103,7 @@ func TestGetChatsForm_GetParams(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expected.ChatsCount, form.ChatsCount)
+				assert.Equal(t, tt.expected.Archived, form.Archived)
 
 				// For timestamp, we can't compare directly due to possible minor time differences
 				if tt.values.Has("ts") && tt.values.Get("ts") != "invalid" {
@@ -415,3 +436,129 @@ func TestPrivateChatInfo_JSON(t *testing.T) {
 		assert.Equal(t, info, newInfo)
 	})
 }
+
+func TestCreateChatInviteForm_ToModel(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name     string
+		form     CreateChatInviteForm
+		expected models.ChatInviteCreationInfo
+		wantErr  bool
+	}{
+		{
+			name:     "unlimited",
+			form:     CreateChatInviteForm{},
+			expected: models.ChatInviteCreationInfo{},
+		},
+		{
+			name: "expiry and usage limit",
+			form: CreateChatInviteForm{
+				ExpiresAt:  expiresAt.Format(time2.TimeStampLayout),
+				UsageLimit: 10,
+			},
+			expected: models.ChatInviteCreationInfo{ExpiresAt: &expiresAt, UsageLimit: 10},
+		},
+		{
+			name:    "invalid expiry",
+			form:    CreateChatInviteForm{ExpiresAt: "tomorrow"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := tt.form.ToModel()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.UsageLimit, info.UsageLimit)
+			if tt.expected.ExpiresAt == nil {
+				assert.Nil(t, info.ExpiresAt)
+			} else {
+				assert.True(t, tt.expected.ExpiresAt.Equal(*info.ExpiresAt))
+			}
+		})
+	}
+}
+
+func TestToChatInviteOut(t *testing.T) {
+	createdAt := time.Now()
+	invite := models.ChatInvite{
+		Token:      "token",
+		ChatID:     uuid.New(),
+		CreatorID:  uuid.New(),
+		CreatedAt:  createdAt,
+		UsageCount: 2,
+	}
+
+	out := ToChatInviteOut(invite)
+	assert.Equal(t, ChatInviteOut{
+		Token:      "token",
+		ChatId:     invite.ChatID.String(),
+		CreatorId:  invite.CreatorID.String(),
+		CreatedAt:  createdAt.Format(time2.TimeStampLayout),
+		UsageCount: 2,
+	}, out)
+}
+
+func TestToChatOut_PinnedMessages(t *testing.T) {
+	pinnedAt := time.Now()
+	pinnedBy := uuid.New()
+	pinned := models.PinnedMessage{
+		Message:  models.MessagePreview{ID: uuid.New(), SenderID: uuid.New(), Text: "meet at 6"},
+		PinnedBy: pinnedBy,
+		PinnedAt: pinnedAt,
+	}
+
+	out := ToChatOut(models.Chat{ID: uuid.New(), PinnedMessages: []models.PinnedMessage{pinned}}, models.PublicUserInfo{}, nil)
+
+	assert.Equal(t, []PinnedMessageOut{
+		{
+			Message:  ToMessagePreviewOut(pinned.Message),
+			PinnedBy: pinnedBy,
+			PinnedAt: pinnedAt.Format(time2.TimeStampLayout),
+		},
+	}, out.PinnedMessages)
+
+	assert.Nil(t, ToChatOut(models.Chat{ID: uuid.New()}, models.PublicUserInfo{}, nil).PinnedMessages)
+}
+
+func TestToChatOut_UserSettings(t *testing.T) {
+	mutedUntil := time.Now().Add(time.Hour)
+	expired := time.Now().Add(-time.Hour)
+
+	out := ToChatOut(models.Chat{ID: uuid.New(), MutedUntil: &mutedUntil, PinOrder: 2}, models.PublicUserInfo{}, nil)
+	assert.Equal(t, mutedUntil.Format(time2.TimeStampLayout), out.MutedUntil)
+	assert.Equal(t, 2, out.PinOrder)
+	assert.False(t, out.Archived)
+
+	out = ToChatOut(models.Chat{ID: uuid.New(), MutedUntil: &expired, Archived: true}, models.PublicUserInfo{}, nil)
+	assert.Empty(t, out.MutedUntil)
+	assert.True(t, out.Archived)
+}
+
+func TestMuteChatForm_ToMutedUntil(t *testing.T) {
+	mutedUntil := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	until, err := (&MuteChatForm{}).ToMutedUntil()
+	assert.NoError(t, err)
+	assert.Equal(t, models.MutedForever, until)
+
+	until, err = (&MuteChatForm{MutedUntil: mutedUntil.Format(time2.TimeStampLayout)}).ToMutedUntil()
+	assert.NoError(t, err)
+	assert.True(t, mutedUntil.Equal(until))
+
+	_, err = (&MuteChatForm{MutedUntil: "tomorrow"}).ToMutedUntil()
+	assert.Error(t, err)
+}
+
+func TestToChatOut_MessageTTL(t *testing.T) {
+	out := ToChatOut(models.Chat{ID: uuid.New(), MessageTTL: 24 * time.Hour}, models.PublicUserInfo{}, nil)
+	assert.Equal(t, 86400, out.MessageTTL)
+
+	outs := ToChatsOut([]models.Chat{{ID: uuid.New()}}, nil, nil)
+	assert.Zero(t, outs[0].MessageTTL)
+}
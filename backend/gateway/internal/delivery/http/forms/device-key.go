@@ -0,0 +1,66 @@
+package forms
+
+import (
+	"github.com/google/uuid"
+
+	time_config "quickflow/config/time"
+	"quickflow/shared/models"
+)
+
+//easyjson:json
+type SignedPrekeyForm struct {
+	Id        int    `json:"id"`
+	PublicKey []byte `json:"public_key"`
+	Signature []byte `json:"signature"`
+}
+
+func (f *SignedPrekeyForm) ToSignedPrekeyModel() models.SignedPrekey {
+	return models.SignedPrekey{
+		ID:        f.Id,
+		PublicKey: f.PublicKey,
+		Signature: f.Signature,
+	}
+}
+
+// DeviceKeysForm registers public keys of a device of the user, keys are base64 encoded
+//
+//easyjson:json
+type DeviceKeysForm struct {
+	DeviceId     string           `json:"device_id"`
+	IdentityKey  []byte           `json:"identity_key"`
+	SignedPrekey SignedPrekeyForm `json:"signed_prekey"`
+}
+
+func (f *DeviceKeysForm) ToDeviceKeysModel(userId uuid.UUID) models.DeviceKeys {
+	return models.DeviceKeys{
+		UserID:       userId,
+		DeviceID:     f.DeviceId,
+		IdentityKey:  f.IdentityKey,
+		SignedPrekey: f.SignedPrekey.ToSignedPrekeyModel(),
+	}
+}
+
+//easyjson:json
+type DeviceKeysOut struct {
+	DeviceId     string           `json:"device_id"`
+	IdentityKey  []byte           `json:"identity_key"`
+	SignedPrekey SignedPrekeyForm `json:"signed_prekey"`
+	UpdatedAt    string           `json:"updated_at"`
+}
+
+func ToDeviceKeysOut(devices []models.DeviceKeys) []DeviceKeysOut {
+	out := make([]DeviceKeysOut, 0, len(devices))
+	for _, device := range devices {
+		out = append(out, DeviceKeysOut{
+			DeviceId:    device.DeviceID,
+			IdentityKey: device.IdentityKey,
+			SignedPrekey: SignedPrekeyForm{
+				Id:        device.SignedPrekey.ID,
+				PublicKey: device.SignedPrekey.PublicKey,
+				Signature: device.SignedPrekey.Signature,
+			},
+			UpdatedAt: device.UpdatedAt.Format(time_config.TimeStampLayout),
+		})
+	}
+	return out
+}
@@ -0,0 +1,286 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package forms
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *SignedPrekeyForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int(in.Int())
+		case "public_key":
+			if in.IsNull() {
+				in.Skip()
+				out.PublicKey = nil
+			} else {
+				out.PublicKey = in.Bytes()
+			}
+		case "signature":
+			if in.IsNull() {
+				in.Skip()
+				out.Signature = nil
+			} else {
+				out.Signature = in.Bytes()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in SignedPrekeyForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Id))
+	}
+	{
+		const prefix string = ",\"public_key\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.PublicKey)
+	}
+	{
+		const prefix string = ",\"signature\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Signature)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SignedPrekeyForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SignedPrekeyForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SignedPrekeyForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SignedPrekeyForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms(l, v)
+}
+func easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *DeviceKeysOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "identity_key":
+			if in.IsNull() {
+				in.Skip()
+				out.IdentityKey = nil
+			} else {
+				out.IdentityKey = in.Bytes()
+			}
+		case "signed_prekey":
+			(out.SignedPrekey).UnmarshalEasyJSON(in)
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in DeviceKeysOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"identity_key\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.IdentityKey)
+	}
+	{
+		const prefix string = ",\"signed_prekey\":"
+		out.RawString(prefix)
+		(in.SignedPrekey).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeviceKeysOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeviceKeysOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeviceKeysOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeviceKeysOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
+}
+func easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *DeviceKeysForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "identity_key":
+			if in.IsNull() {
+				in.Skip()
+				out.IdentityKey = nil
+			} else {
+				out.IdentityKey = in.Bytes()
+			}
+		case "signed_prekey":
+			(out.SignedPrekey).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in DeviceKeysForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"identity_key\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.IdentityKey)
+	}
+	{
+		const prefix string = ",\"signed_prekey\":"
+		out.RawString(prefix)
+		(in.SignedPrekey).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeviceKeysForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeviceKeysForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCe7a3020EncodeQuickflowGatewayInternalDeliveryHttpForms2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeviceKeysForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeviceKeysForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCe7a3020DecodeQuickflowGatewayInternalDeliveryHttpForms2(l, v)
+}
@@ -28,6 +28,7 @@ type PostForm struct {
 	IsRepost    bool      `json:"is_repost,omitempty"`
 	CreatorId   uuid.UUID `json:"author_id,omitempty"`
 	CreatorType string    `json:"author_type,omitempty"`
+	Poll        *PollForm `json:"poll,omitempty"`
 }
 
 func ParseCreatorType(creatorType string) (models.PostCreatorType, error) {
@@ -94,6 +95,12 @@ func (p *PostForm) ToPostModel(userId uuid.UUID) (models.Post, error) {
 	postModel.UpdatedAt = time.Now()
 	postModel.Files = attachments
 	postModel.IsRepost = p.IsRepost
+	if p.Poll != nil {
+		var err error
+		if postModel.Poll, err = p.Poll.ToPollModel(); err != nil {
+			return models.Post{}, err
+		}
+	}
 
 	return postModel, nil
 }
@@ -165,6 +172,7 @@ type PostOut struct {
 	IsRepost     bool        `json:"is_repost"`
 	IsLiked      bool        `json:"is_liked"`
 	LastComment  *CommentOut `json:"last_comment,omitempty"`
+	Poll         *PollOut    `json:"poll,omitempty"`
 }
 
 //easyjson:json
@@ -205,6 +213,7 @@ func (p *PostOut) FromPost(post models.Post) {
 	p.CommentCount = post.CommentCount
 	p.IsRepost = post.IsRepost
 	p.IsLiked = post.IsLiked
+	p.Poll = ToPollOut(post.Poll)
 }
 
 //easyjson:json
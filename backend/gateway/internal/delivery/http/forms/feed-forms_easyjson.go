@@ -4,6 +4,7 @@ package forms
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -423,7 +424,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 				in.Delim('[')
 				if out.MediaURLs == nil {
 					if !in.IsDelim(']') {
-						out.MediaURLs = make([]FileOut, 0, 2)
+						out.MediaURLs = make([]FileOut, 0, 1)
 					} else {
 						out.MediaURLs = []FileOut{}
 					}
@@ -446,7 +447,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 				in.Delim('[')
 				if out.AudioURLs == nil {
 					if !in.IsDelim(']') {
-						out.AudioURLs = make([]FileOut, 0, 2)
+						out.AudioURLs = make([]FileOut, 0, 1)
 					} else {
 						out.AudioURLs = []FileOut{}
 					}
@@ -469,7 +470,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 				in.Delim('[')
 				if out.FileURLs == nil {
 					if !in.IsDelim(']') {
-						out.FileURLs = make([]FileOut, 0, 2)
+						out.FileURLs = make([]FileOut, 0, 1)
 					} else {
 						out.FileURLs = []FileOut{}
 					}
@@ -492,7 +493,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 				in.Delim('[')
 				if out.StickerURLs == nil {
 					if !in.IsDelim(']') {
-						out.StickerURLs = make([]FileOut, 0, 2)
+						out.StickerURLs = make([]FileOut, 0, 1)
 					} else {
 						out.StickerURLs = []FileOut{}
 					}
@@ -529,7 +530,17 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 				if out.LastComment == nil {
 					out.LastComment = new(CommentOut)
 				}
-				(*out.LastComment).UnmarshalEasyJSON(in)
+				easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in, out.LastComment)
+			}
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(PollOut)
+				}
+				easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(in, out.Poll)
 			}
 		default:
 			in.SkipRecursive()
@@ -665,7 +676,12 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwrit
 	if in.LastComment != nil {
 		const prefix string = ",\"last_comment\":"
 		out.RawString(prefix)
-		(*in.LastComment).MarshalEasyJSON(out)
+		easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out, *in.LastComment)
+	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(out, *in.Poll)
 	}
 	out.RawByte('}')
 }
@@ -693,6 +709,511 @@ func (v *PostOut) UnmarshalJSON(data []byte) error {
 func (v *PostOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms3(l, v)
 }
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(in *jlexer.Lexer, out *PollOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]PollOptionOut, 0, 1)
+					} else {
+						out.Options = []PollOptionOut{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 PollOptionOut
+					easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(in, &v25)
+					out.Options = append(out.Options, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "voter_count":
+			out.VoterCount = int(in.Int())
+		case "voted":
+			if in.IsNull() {
+				in.Skip()
+				out.Voted = nil
+			} else {
+				in.Delim('[')
+				if out.Voted == nil {
+					if !in.IsDelim(']') {
+						out.Voted = make([]int, 0, 8)
+					} else {
+						out.Voted = []int{}
+					}
+				} else {
+					out.Voted = (out.Voted)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 int
+					v26 = int(in.Int())
+					out.Voted = append(out.Voted, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "results_hidden":
+			out.ResultsHidden = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(out *jwriter.Writer, in PollOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Options {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(out, v28)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	{
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	{
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"voter_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoterCount))
+	}
+	if len(in.Voted) != 0 {
+		const prefix string = ",\"voted\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.Voted {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v30))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ResultsHidden {
+		const prefix string = ",\"results_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ResultsHidden))
+	}
+	out.RawByte('}')
+}
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(in *jlexer.Lexer, out *PollOptionOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "vote_count":
+			out.VoteCount = int(in.Int())
+		case "voters":
+			if in.IsNull() {
+				in.Skip()
+				out.Voters = nil
+			} else {
+				in.Delim('[')
+				if out.Voters == nil {
+					if !in.IsDelim(']') {
+						out.Voters = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Voters = []uuid.UUID{}
+					}
+				} else {
+					out.Voters = (out.Voters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v31).UnmarshalText(data))
+					}
+					out.Voters = append(out.Voters, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(out *jwriter.Writer, in PollOptionOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"vote_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoteCount))
+	}
+	if len(in.Voters) != 0 {
+		const prefix string = ",\"voters\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v32, v33 := range in.Voters {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v33).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer.Lexer, out *CommentOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		case "media":
+			if in.IsNull() {
+				in.Skip()
+				out.Media = nil
+			} else {
+				in.Delim('[')
+				if out.Media == nil {
+					if !in.IsDelim(']') {
+						out.Media = make([]FileOut, 0, 1)
+					} else {
+						out.Media = []FileOut{}
+					}
+				} else {
+					out.Media = (out.Media)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 FileOut
+					easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in, &v34)
+					out.Media = append(out.Media, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "audio":
+			if in.IsNull() {
+				in.Skip()
+				out.Audio = nil
+			} else {
+				in.Delim('[')
+				if out.Audio == nil {
+					if !in.IsDelim(']') {
+						out.Audio = make([]FileOut, 0, 1)
+					} else {
+						out.Audio = []FileOut{}
+					}
+				} else {
+					out.Audio = (out.Audio)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v35 FileOut
+					easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in, &v35)
+					out.Audio = append(out.Audio, v35)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "files":
+			if in.IsNull() {
+				in.Skip()
+				out.Files = nil
+			} else {
+				in.Delim('[')
+				if out.Files == nil {
+					if !in.IsDelim(']') {
+						out.Files = make([]FileOut, 0, 1)
+					} else {
+						out.Files = []FileOut{}
+					}
+				} else {
+					out.Files = (out.Files)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v36 FileOut
+					easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in, &v36)
+					out.Files = append(out.Files, v36)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "stickers":
+			if in.IsNull() {
+				in.Skip()
+				out.Stickers = nil
+			} else {
+				in.Delim('[')
+				if out.Stickers == nil {
+					if !in.IsDelim(']') {
+						out.Stickers = make([]FileOut, 0, 1)
+					} else {
+						out.Stickers = []FileOut{}
+					}
+				} else {
+					out.Stickers = (out.Stickers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 FileOut
+					easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in, &v37)
+					out.Stickers = append(out.Stickers, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "author":
+			(out.Creator).UnmarshalEasyJSON(in)
+		case "post_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PostId).UnmarshalText(data))
+			}
+		case "like_count":
+			out.LikeCount = int(in.Int())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwriter.Writer, in CommentOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	if len(in.Media) != 0 {
+		const prefix string = ",\"media\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v38, v39 := range in.Media {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms4(out, v39)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Audio) != 0 {
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v40, v41 := range in.Audio {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms4(out, v41)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Files) != 0 {
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v42, v43 := range in.Files {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms4(out, v43)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Stickers) != 0 {
+		const prefix string = ",\"stickers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v44, v45 := range in.Stickers {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms4(out, v45)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Creator).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.RawText((in.PostId).MarshalText())
+	}
+	{
+		const prefix string = ",\"like_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.LikeCount))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	out.RawByte('}')
+}
 func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer.Lexer, out *FileOut) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -716,6 +1237,31 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 			out.URL = string(in.String())
 		case "name":
 			out.Name = string(in.String())
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "waveform":
+			if in.IsNull() {
+				in.Skip()
+				out.Waveform = nil
+			} else {
+				in.Delim('[')
+				if out.Waveform == nil {
+					if !in.IsDelim(']') {
+						out.Waveform = make([]int, 0, 8)
+					} else {
+						out.Waveform = []int{}
+					}
+				} else {
+					out.Waveform = (out.Waveform)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 int
+					v46 = int(in.Int())
+					out.Waveform = append(out.Waveform, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -740,9 +1286,28 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	if len(in.Waveform) != 0 {
+		const prefix string = ",\"waveform\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v47, v48 := range in.Waveform {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v48))
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
-func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer.Lexer, out *PostForm) {
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms8(in *jlexer.Lexer, out *PostForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -779,9 +1344,9 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 					out.Media = (out.Media)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v25 string
-					v25 = string(in.String())
-					out.Media = append(out.Media, v25)
+					var v49 string
+					v49 = string(in.String())
+					out.Media = append(out.Media, v49)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -802,9 +1367,9 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 					out.Audio = (out.Audio)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v26 string
-					v26 = string(in.String())
-					out.Audio = append(out.Audio, v26)
+					var v50 string
+					v50 = string(in.String())
+					out.Audio = append(out.Audio, v50)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -825,9 +1390,9 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 					out.File = (out.File)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v27 string
-					v27 = string(in.String())
-					out.File = append(out.File, v27)
+					var v51 string
+					v51 = string(in.String())
+					out.File = append(out.File, v51)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -848,9 +1413,9 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 					out.Stickers = (out.Stickers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v28 string
-					v28 = string(in.String())
-					out.Stickers = append(out.Stickers, v28)
+					var v52 string
+					v52 = string(in.String())
+					out.Stickers = append(out.Stickers, v52)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -863,6 +1428,16 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 			}
 		case "author_type":
 			out.CreatorType = string(in.String())
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(PollForm)
+				}
+				easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms9(in, out.Poll)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -873,7 +1448,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwriter.Writer, in PostForm) {
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms8(out *jwriter.Writer, in PostForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -893,11 +1468,11 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v29, v30 := range in.Media {
-				if v29 > 0 {
+			for v53, v54 := range in.Media {
+				if v53 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v30))
+				out.String(string(v54))
 			}
 			out.RawByte(']')
 		}
@@ -912,11 +1487,11 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v31, v32 := range in.Audio {
-				if v31 > 0 {
+			for v55, v56 := range in.Audio {
+				if v55 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v32))
+				out.String(string(v56))
 			}
 			out.RawByte(']')
 		}
@@ -931,11 +1506,11 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v33, v34 := range in.File {
-				if v33 > 0 {
+			for v57, v58 := range in.File {
+				if v57 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v34))
+				out.String(string(v58))
 			}
 			out.RawByte(']')
 		}
@@ -950,11 +1525,11 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v35, v36 := range in.Stickers {
-				if v35 > 0 {
+			for v59, v60 := range in.Stickers {
+				if v59 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v36))
+				out.String(string(v60))
 			}
 			out.RawByte(']')
 		}
@@ -989,33 +1564,152 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		}
 		out.String(string(in.CreatorType))
 	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms9(out, *in.Poll)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PostForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(&w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostForm) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms5(w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(&r, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms5(l, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms8(l, v)
+}
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms9(in *jlexer.Lexer, out *PollForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 string
+					v61 = string(in.String())
+					out.Options = append(out.Options, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms9(out *jwriter.Writer, in PollForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Options {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v63))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MultipleChoice {
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	if in.Anonymous {
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	if in.ResultsVisibility != "" {
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
 }
-func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(in *jlexer.Lexer, out *File) {
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms10(in *jlexer.Lexer, out *File) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1053,7 +1747,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(out *jwriter.Writer, in File) {
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms10(out *jwriter.Writer, in File) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1073,27 +1767,27 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(out *jwrit
 // MarshalJSON supports json.Marshaler interface
 func (v File) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(&w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v File) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms6(w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *File) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(&r, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *File) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms6(l, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms10(l, v)
 }
-func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(in *jlexer.Lexer, out *FeedForm) {
+func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms11(in *jlexer.Lexer, out *FeedForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1126,7 +1820,7 @@ func easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(out *jwriter.Writer, in FeedForm) {
+func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms11(out *jwriter.Writer, in FeedForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1146,23 +1840,23 @@ func easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(out *jwrit
 // MarshalJSON supports json.Marshaler interface
 func (v FeedForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(&w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FeedForm) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms7(w, v)
+	easyjsonAdacf256EncodeQuickflowGatewayInternalDeliveryHttpForms11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FeedForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(&r, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FeedForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms7(l, v)
+	easyjsonAdacf256DecodeQuickflowGatewayInternalDeliveryHttpForms11(l, v)
 }
@@ -6,4 +6,6 @@ type MessageAttachmentForm struct {
 	AudioURLs   []string `json:"audio"`
 	FileURLs    []string `json:"files"`
 	StickerURLs []string `json:"stickers,omitempty"`
+	// VoiceURLs are sent in messages as audio, their duration and waveform come with the message
+	VoiceURLs []string `json:"voice,omitempty"`
 }
@@ -128,6 +128,29 @@ func easyjson8ceb9162DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.
 				}
 				in.Delim(']')
 			}
+		case "voice":
+			if in.IsNull() {
+				in.Skip()
+				out.VoiceURLs = nil
+			} else {
+				in.Delim('[')
+				if out.VoiceURLs == nil {
+					if !in.IsDelim(']') {
+						out.VoiceURLs = make([]string, 0, 4)
+					} else {
+						out.VoiceURLs = []string{}
+					}
+				} else {
+					out.VoiceURLs = (out.VoiceURLs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.VoiceURLs = append(out.VoiceURLs, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -149,11 +172,11 @@ func easyjson8ceb9162EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwrite
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.MediaURLs {
-				if v5 > 0 {
+			for v6, v7 := range in.MediaURLs {
+				if v6 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v6))
+				out.String(string(v7))
 			}
 			out.RawByte(']')
 		}
@@ -165,11 +188,11 @@ func easyjson8ceb9162EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwrite
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v7, v8 := range in.AudioURLs {
-				if v7 > 0 {
+			for v8, v9 := range in.AudioURLs {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v8))
+				out.String(string(v9))
 			}
 			out.RawByte(']')
 		}
@@ -181,11 +204,11 @@ func easyjson8ceb9162EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwrite
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v9, v10 := range in.FileURLs {
-				if v9 > 0 {
+			for v10, v11 := range in.FileURLs {
+				if v10 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v10))
+				out.String(string(v11))
 			}
 			out.RawByte(']')
 		}
@@ -195,11 +218,25 @@ func easyjson8ceb9162EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwrite
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v11, v12 := range in.StickerURLs {
-				if v11 > 0 {
+			for v12, v13 := range in.StickerURLs {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v13))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.VoiceURLs) != 0 {
+		const prefix string = ",\"voice\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.VoiceURLs {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.String(string(v15))
 			}
 			out.RawByte(']')
 		}
@@ -2,8 +2,10 @@ package forms
 
 import (
 	"errors"
+	"html"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,10 +14,15 @@ import (
 	"quickflow/shared/models"
 )
 
+// GetMessagesForm loads messages older than Ts, newer than After if it is set,
+// or MessagesCount messages on each side of the anchor message
+//
 //easyjson:json
 type GetMessagesForm struct {
-	MessagesCount int       `json:"messages_count"`
-	Ts            time.Time `json:"ts,omitempty"`
+	MessagesCount int        `json:"messages_count"`
+	Ts            time.Time  `json:"ts,omitempty"`
+	After         *time.Time `json:"after,omitempty"`
+	AnchorId      uuid.UUID  `json:"anchor_id,omitempty"`
 }
 
 func (m *GetMessagesForm) GetParams(values url.Values) error {
@@ -40,19 +47,106 @@ func (m *GetMessagesForm) GetParams(values url.Values) error {
 		ts = time.Now()
 	}
 	m.Ts = ts
+
+	if values.Has("after") {
+		after, err := time.Parse(time2.TimeStampLayout, values.Get("after"))
+		if err != nil {
+			return errors.New("failed to parse after")
+		}
+		// timestamps are sent with milliseconds, so the message the cursor was taken from
+		// is newer than the parsed time and would be returned again
+		after = after.Add(time.Millisecond - time.Microsecond)
+		m.After = &after
+	}
+	if values.Has("anchor_id") {
+		m.AnchorId, err = uuid.Parse(values.Get("anchor_id"))
+		if err != nil {
+			return errors.New("failed to parse anchor_id")
+		}
+	}
 	return nil
 }
 
+// SearchMessagesForm searches in all chats of the user unless chat_id is given,
+// the next page is requested with to set to created_at of the last found message
+type SearchMessagesForm struct {
+	ToSearch string
+	ChatId   uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	Count    int
+}
+
+func (s *SearchMessagesForm) GetParams(values url.Values) error {
+	if !values.Has("string") {
+		return errors.New("string parameter missing")
+	}
+	if !values.Has("count") {
+		return errors.New("count parameter missing")
+	}
+	s.ToSearch = values.Get("string")
+
+	count, err := strconv.ParseInt(values.Get("count"), 10, 64)
+	if err != nil {
+		return errors.New("failed to parse count")
+	}
+	s.Count = int(count)
+
+	if values.Has("chat_id") {
+		s.ChatId, err = uuid.Parse(values.Get("chat_id"))
+		if err != nil {
+			return errors.New("failed to parse chat_id")
+		}
+	}
+	if values.Has("from") {
+		from, err := time.Parse(time2.TimeStampLayout, values.Get("from"))
+		if err != nil {
+			return errors.New("failed to parse from")
+		}
+		s.From = &from
+	}
+	if values.Has("to") {
+		to, err := time.Parse(time2.TimeStampLayout, values.Get("to"))
+		if err != nil {
+			return errors.New("failed to parse to")
+		}
+		s.To = &to
+	}
+	return nil
+}
+
+func (s *SearchMessagesForm) ToSearchQuery() models.MessageSearchQuery {
+	return models.MessageSearchQuery{
+		Text:   s.ToSearch,
+		ChatID: s.ChatId,
+		From:   s.From,
+		To:     s.To,
+		Limit:  s.Count,
+	}
+}
+
 type FileOut struct {
 	URL  string `json:"url"`
 	Name string `json:"name,omitempty"`
+
+	// Duration in milliseconds and Waveform from 0 to 255 are set for voice messages
+	Duration int64 `json:"duration,omitempty"`
+	Waveform []int `json:"waveform,omitempty"`
 }
 
 func ToFileOut(file models.File) FileOut {
-	return FileOut{
+	out := FileOut{
 		URL:  file.URL,
 		Name: file.Name,
 	}
+	if file.Voice != nil {
+		out.Duration = file.Voice.Duration.Milliseconds()
+		out.Waveform = make([]int, len(file.Voice.Waveform))
+		for i, amplitude := range file.Voice.Waveform {
+			out.Waveform[i] = int(amplitude)
+		}
+	}
+	return out
 }
 
 //easyjson:json
@@ -68,6 +162,84 @@ type MessageOut struct {
 
 	Sender PublicUserInfoOut `json:"sender"`
 	ChatId uuid.UUID         `json:"chat_id"`
+	Seq    int64             `json:"seq,omitempty"`
+
+	Edited   bool   `json:"edited"`
+	EditedAt string `json:"edited_at,omitempty"`
+
+	ReplyTo       *MessagePreviewOut `json:"reply_to,omitempty"`
+	ForwardedFrom *ForwardedFromOut  `json:"forwarded_from,omitempty"`
+
+	Reactions []ReactionOut `json:"reactions,omitempty"`
+
+	System *SystemInfoOut `json:"system,omitempty"`
+
+	Encrypted *EncryptedContentOut `json:"encrypted,omitempty"`
+
+	Poll *PollOut `json:"poll,omitempty"`
+}
+
+// EncryptedContentOut is the end-to-end encrypted text, every device decrypts the message key from its envelope
+type EncryptedContentOut struct {
+	Ciphertext []byte           `json:"ciphertext"`
+	Envelopes  []KeyEnvelopeOut `json:"envelopes"`
+}
+
+// KeyEnvelopeOut is the message key encrypted for one device of the recipient
+type KeyEnvelopeOut struct {
+	RecipientId uuid.UUID `json:"recipient_id"`
+	DeviceId    string    `json:"device_id"`
+	Key         []byte    `json:"key"`
+}
+
+// SystemInfoOut marks messages about chat changes, UserId is the added or removed member
+type SystemInfoOut struct {
+	Action string    `json:"action"`
+	UserId uuid.UUID `json:"user_id,omitempty"`
+}
+
+// ReactionOut is the number of users who left the emoji, Reacted is set when the requesting user is one of them
+type ReactionOut struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
+
+// ForwardedFromOut points to the original message author, sender info is filled by the caller
+type ForwardedFromOut struct {
+	SenderId uuid.UUID          `json:"sender_id"`
+	Sender   *PublicUserInfoOut `json:"sender,omitempty"`
+	ChatId   uuid.UUID          `json:"chat_id"`
+}
+
+// DeletedMessagePlaceholder replaces text of the quoted message that was deleted
+const DeletedMessagePlaceholder = "message deleted"
+
+type MessagePreviewOut struct {
+	ID             uuid.UUID          `json:"id"`
+	SenderId       uuid.UUID          `json:"sender_id,omitempty"`
+	Sender         *PublicUserInfoOut `json:"sender,omitempty"`
+	Text           string             `json:"text"`
+	AttachmentType string             `json:"attachment_type,omitempty"`
+	Deleted        bool               `json:"deleted,omitempty"`
+}
+
+// ToMessagePreviewOut converts the quoted message, sender info is filled by the caller
+func ToMessagePreviewOut(preview models.MessagePreview) *MessagePreviewOut {
+	if preview.Deleted {
+		return &MessagePreviewOut{
+			ID:      preview.ID,
+			Text:    DeletedMessagePlaceholder,
+			Deleted: true,
+		}
+	}
+
+	return &MessagePreviewOut{
+		ID:             preview.ID,
+		SenderId:       preview.SenderID,
+		Text:           preview.Text,
+		AttachmentType: string(preview.AttachmentType),
+	}
 }
 
 func ToMessageOut(message models.Message, info models.PublicUserInfo) MessageOut {
@@ -88,6 +260,53 @@ func ToMessageOut(message models.Message, info models.PublicUserInfo) MessageOut
 		}
 	}
 
+	var editedAt string
+	if message.EditedAt != nil {
+		editedAt = message.EditedAt.Format(time2.TimeStampLayout)
+	}
+
+	var replyTo *MessagePreviewOut
+	if message.ReplyTo != nil {
+		replyTo = ToMessagePreviewOut(*message.ReplyTo)
+	}
+
+	var forwardedFrom *ForwardedFromOut
+	if message.ForwardedFrom != nil {
+		forwardedFrom = &ForwardedFromOut{
+			SenderId: message.ForwardedFrom.SenderID,
+			ChatId:   message.ForwardedFrom.ChatID,
+		}
+	}
+
+	var reactions []ReactionOut
+	for _, reaction := range message.Reactions {
+		reactions = append(reactions, ReactionOut{
+			Emoji:   reaction.Emoji,
+			Count:   reaction.Count,
+			Reacted: reaction.Reacted,
+		})
+	}
+
+	var system *SystemInfoOut
+	if message.System != nil {
+		system = &SystemInfoOut{
+			Action: string(message.System.Action),
+			UserId: message.System.UserID,
+		}
+	}
+
+	var encrypted *EncryptedContentOut
+	if message.Encrypted != nil {
+		encrypted = &EncryptedContentOut{Ciphertext: message.Encrypted.Ciphertext}
+		for _, envelope := range message.Encrypted.Envelopes {
+			encrypted.Envelopes = append(encrypted.Envelopes, KeyEnvelopeOut{
+				RecipientId: envelope.RecipientID,
+				DeviceId:    envelope.DeviceID,
+				Key:         envelope.Key,
+			})
+		}
+	}
+
 	return MessageOut{
 		ID:          message.ID,
 		Text:        message.Text,
@@ -100,13 +319,41 @@ func ToMessageOut(message models.Message, info models.PublicUserInfo) MessageOut
 
 		Sender: PublicUserInfoToOut(info, ""),
 		ChatId: message.ChatID,
+		Seq:    message.Seq,
+
+		Edited:   message.EditedAt != nil,
+		EditedAt: editedAt,
+
+		ReplyTo:       replyTo,
+		ForwardedFrom: forwardedFrom,
+
+		Reactions: reactions,
+
+		System: system,
+
+		Encrypted: encrypted,
+
+		Poll: ToPollOut(message.Poll),
 	}
 }
 
 func ToMessagesOut(messages []*models.Message, usersInfo map[uuid.UUID]models.PublicUserInfo) []MessageOut {
 	var messagesOut []MessageOut
 	for _, message := range messages {
-		messagesOut = append(messagesOut, ToMessageOut(*message, usersInfo[message.SenderID]))
+		messageOut := ToMessageOut(*message, usersInfo[message.SenderID])
+		if messageOut.ReplyTo != nil && !messageOut.ReplyTo.Deleted {
+			if info, ok := usersInfo[messageOut.ReplyTo.SenderId]; ok {
+				sender := PublicUserInfoToOut(info, "")
+				messageOut.ReplyTo.Sender = &sender
+			}
+		}
+		if messageOut.ForwardedFrom != nil {
+			if info, ok := usersInfo[messageOut.ForwardedFrom.SenderId]; ok {
+				sender := PublicUserInfoToOut(info, "")
+				messageOut.ForwardedFrom.Sender = &sender
+			}
+		}
+		messagesOut = append(messagesOut, messageOut)
 	}
 
 	return messagesOut
@@ -114,8 +361,10 @@ func ToMessagesOut(messages []*models.Message, usersInfo map[uuid.UUID]models.Pu
 
 //easyjson:json
 type MessagesOut struct {
-	Messages   []MessageOut `json:"messages"`
-	LastReadTs string       `json:"last_read_ts,omitempty"`
+	Messages    []MessageOut `json:"messages"`
+	LastReadTs  string       `json:"last_read_ts,omitempty"`
+	OlderCursor string       `json:"older_cursor,omitempty"`
+	NewerCursor string       `json:"newer_cursor,omitempty"`
 }
 
 //easyjson:json
@@ -127,10 +376,17 @@ type MessageForm struct {
 	File       []string  `form:"files" json:"files,omitempty"`
 	Stickers   []string  `form:"stickers" json:"stickers,omitempty"`
 	ReceiverId uuid.UUID `json:"receiver_id,omitempty"`
+	ReplyToId  uuid.UUID `json:"reply_to_id,omitempty"`
 	SenderId   uuid.UUID `json:"-"`
+
+	// Ciphertext and Envelopes are sent to end-to-end encrypted chats instead of text and attachments
+	Ciphertext []byte           `json:"ciphertext,omitempty"`
+	Envelopes  []KeyEnvelopeOut `json:"envelopes,omitempty"`
+
+	Poll *PollForm `json:"poll,omitempty"`
 }
 
-func (f *MessageForm) ToMessageModel() models.Message {
+func (f *MessageForm) ToMessageModel() (models.Message, error) {
 	var attachments []*models.File
 	for _, file := range f.Media {
 		attachments = append(attachments, &models.File{
@@ -160,6 +416,26 @@ func (f *MessageForm) ToMessageModel() models.Message {
 		})
 	}
 
+	var encrypted *models.EncryptedContent
+	if len(f.Ciphertext) > 0 {
+		encrypted = &models.EncryptedContent{Ciphertext: f.Ciphertext}
+		for _, envelope := range f.Envelopes {
+			encrypted.Envelopes = append(encrypted.Envelopes, models.KeyEnvelope{
+				RecipientID: envelope.RecipientId,
+				DeviceID:    envelope.DeviceId,
+				Key:         envelope.Key,
+			})
+		}
+	}
+
+	var poll *models.Poll
+	if f.Poll != nil {
+		var err error
+		if poll, err = f.Poll.ToPollModel(); err != nil {
+			return models.Message{}, err
+		}
+	}
+
 	return models.Message{
 		ID:          uuid.New(),
 		Text:        f.Text,
@@ -169,5 +445,95 @@ func (f *MessageForm) ToMessageModel() models.Message {
 		ReceiverID:  f.ReceiverId,
 		SenderID:    f.SenderId,
 		ChatID:      f.ChatId,
+		ReplyToID:   f.ReplyToId,
+		Encrypted:   encrypted,
+		Poll:        poll,
+	}, nil
+}
+
+// ScheduledMessageForm is a message to be sent at SendAt, chat and receiver are ignored on update
+//
+//easyjson:json
+type ScheduledMessageForm struct {
+	MessageForm
+	SendAt string `json:"send_at"`
+}
+
+func (f *ScheduledMessageForm) ToScheduledMessageModel() (models.ScheduledMessage, error) {
+	sendAt, err := time.Parse(time2.TimeStampLayout, f.SendAt)
+	if err != nil {
+		return models.ScheduledMessage{}, errors.New("failed to parse send_at")
+	}
+	message, err := f.ToMessageModel()
+	if err != nil {
+		return models.ScheduledMessage{}, err
+	}
+	return models.ScheduledMessage{
+		Message: message,
+		SendAt:  sendAt,
+	}, nil
+}
+
+//easyjson:json
+type ScheduledMessageOut struct {
+	Message MessageOut `json:"message"`
+	SendAt  string     `json:"send_at"`
+}
+
+// ToScheduledMessagesOut converts messages of one sender, only the sender can see them
+func ToScheduledMessagesOut(scheduledMessages []models.ScheduledMessage, senderInfo models.PublicUserInfo) []ScheduledMessageOut {
+	out := make([]ScheduledMessageOut, len(scheduledMessages))
+	for i, scheduled := range scheduledMessages {
+		out[i] = ScheduledMessageOut{
+			Message: ToMessageOut(scheduled.Message, senderInfo),
+			SendAt:  scheduled.SendAt.Format(time2.TimeStampLayout),
+		}
+	}
+	return out
+}
+
+// MessageReceiptOut tells when the participant got and read the message, times are empty until it happens
+type MessageReceiptOut struct {
+	User        PublicUserInfoOut `json:"user"`
+	DeliveredAt string            `json:"delivered_at,omitempty"`
+	ReadAt      string            `json:"read_at,omitempty"`
+}
+
+func ToMessageReceiptsOut(receipts []models.MessageReceipt, usersInfo map[uuid.UUID]models.PublicUserInfo) []MessageReceiptOut {
+	out := make([]MessageReceiptOut, len(receipts))
+	for i, receipt := range receipts {
+		out[i].User = PublicUserInfoToOut(usersInfo[receipt.UserID], "")
+		out[i].User.ID = receipt.UserID.String()
+		if receipt.DeliveredAt != nil {
+			out[i].DeliveredAt = receipt.DeliveredAt.Format(time2.TimeStampLayout)
+		}
+		if receipt.ReadAt != nil {
+			out[i].ReadAt = receipt.ReadAt.Format(time2.TimeStampLayout)
+		}
+	}
+	return out
+}
+
+type MessageSearchResultOut struct {
+	Message MessageOut `json:"message"`
+	Snippet string     `json:"snippet"`
+}
+
+// snippetReplacer wraps matched words of the already escaped snippet into mark tags
+var snippetReplacer = strings.NewReplacer(models.SearchMatchStart, "<mark>", models.SearchMatchEnd, "</mark>")
+
+// ToSnippetOut escapes the snippet text so the only markup in it is the highlighting of matches
+func ToSnippetOut(snippet string) string {
+	return snippetReplacer.Replace(html.EscapeString(snippet))
+}
+
+func ToMessageSearchResultsOut(results []models.MessageSearchResult, usersInfo map[uuid.UUID]models.PublicUserInfo) []MessageSearchResultOut {
+	out := make([]MessageSearchResultOut, len(results))
+	for i := range results {
+		out[i] = MessageSearchResultOut{
+			Message: ToMessagesOut([]*models.Message{&results[i].Message}, usersInfo)[0],
+			Snippet: ToSnippetOut(results[i].Snippet),
+		}
 	}
+	return out
 }
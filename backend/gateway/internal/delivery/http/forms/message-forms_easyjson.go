@@ -4,10 +4,12 @@ package forms
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
 	models "quickflow/shared/models"
+	time "time"
 )
 
 // suppress unused package warning
@@ -18,7 +20,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *MessagesOut) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *ScheduledMessageOut) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -37,31 +39,10 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.
 			continue
 		}
 		switch key {
-		case "messages":
-			if in.IsNull() {
-				in.Skip()
-				out.Messages = nil
-			} else {
-				in.Delim('[')
-				if out.Messages == nil {
-					if !in.IsDelim(']') {
-						out.Messages = make([]MessageOut, 0, 0)
-					} else {
-						out.Messages = []MessageOut{}
-					}
-				} else {
-					out.Messages = (out.Messages)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 MessageOut
-					(v1).UnmarshalEasyJSON(in)
-					out.Messages = append(out.Messages, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "last_read_ts":
-			out.LastReadTs = string(in.String())
+		case "message":
+			(out.Message).UnmarshalEasyJSON(in)
+		case "send_at":
+			out.SendAt = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -72,58 +53,47 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in MessagesOut) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in ScheduledMessageOut) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"messages\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Messages {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				(v3).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(in.Message).MarshalEasyJSON(out)
 	}
-	if in.LastReadTs != "" {
-		const prefix string = ",\"last_read_ts\":"
+	{
+		const prefix string = ",\"send_at\":"
 		out.RawString(prefix)
-		out.String(string(in.LastReadTs))
+		out.String(string(in.SendAt))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MessagesOut) MarshalJSON() ([]byte, error) {
+func (v ScheduledMessageOut) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MessagesOut) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ScheduledMessageOut) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MessagesOut) UnmarshalJSON(data []byte) error {
+func (v *ScheduledMessageOut) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MessagesOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ScheduledMessageOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms(l, v)
 }
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *MessageOut) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *ScheduledMessageForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -142,35 +112,33 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 			continue
 		}
 		switch key {
-		case "id":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ID).UnmarshalText(data))
-			}
+		case "send_at":
+			out.SendAt = string(in.String())
 		case "text":
 			out.Text = string(in.String())
-		case "created_at":
-			out.CreatedAt = string(in.String())
-		case "updated_at":
-			out.UpdatedAt = string(in.String())
+		case "chat_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ChatId).UnmarshalText(data))
+			}
 		case "media":
 			if in.IsNull() {
 				in.Skip()
-				out.MediaURLs = nil
+				out.Media = nil
 			} else {
 				in.Delim('[')
-				if out.MediaURLs == nil {
+				if out.Media == nil {
 					if !in.IsDelim(']') {
-						out.MediaURLs = make([]FileOut, 0, 2)
+						out.Media = make([]string, 0, 4)
 					} else {
-						out.MediaURLs = []FileOut{}
+						out.Media = []string{}
 					}
 				} else {
-					out.MediaURLs = (out.MediaURLs)[:0]
+					out.Media = (out.Media)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 FileOut
-					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v4)
-					out.MediaURLs = append(out.MediaURLs, v4)
+					var v1 string
+					v1 = string(in.String())
+					out.Media = append(out.Media, v1)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -178,22 +146,22 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 		case "audio":
 			if in.IsNull() {
 				in.Skip()
-				out.AudioURLs = nil
+				out.Audio = nil
 			} else {
 				in.Delim('[')
-				if out.AudioURLs == nil {
+				if out.Audio == nil {
 					if !in.IsDelim(']') {
-						out.AudioURLs = make([]FileOut, 0, 2)
+						out.Audio = make([]string, 0, 4)
 					} else {
-						out.AudioURLs = []FileOut{}
+						out.Audio = []string{}
 					}
 				} else {
-					out.AudioURLs = (out.AudioURLs)[:0]
+					out.Audio = (out.Audio)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 FileOut
-					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v5)
-					out.AudioURLs = append(out.AudioURLs, v5)
+					var v2 string
+					v2 = string(in.String())
+					out.Audio = append(out.Audio, v2)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -201,22 +169,22 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 		case "files":
 			if in.IsNull() {
 				in.Skip()
-				out.FileURLs = nil
+				out.File = nil
 			} else {
 				in.Delim('[')
-				if out.FileURLs == nil {
+				if out.File == nil {
 					if !in.IsDelim(']') {
-						out.FileURLs = make([]FileOut, 0, 2)
+						out.File = make([]string, 0, 4)
 					} else {
-						out.FileURLs = []FileOut{}
+						out.File = []string{}
 					}
 				} else {
-					out.FileURLs = (out.FileURLs)[:0]
+					out.File = (out.File)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v6 FileOut
-					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v6)
-					out.FileURLs = append(out.FileURLs, v6)
+					var v3 string
+					v3 = string(in.String())
+					out.File = append(out.File, v3)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -224,28 +192,1392 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 		case "stickers":
 			if in.IsNull() {
 				in.Skip()
-				out.StickerUrls = nil
+				out.Stickers = nil
 			} else {
 				in.Delim('[')
-				if out.StickerUrls == nil {
+				if out.Stickers == nil {
 					if !in.IsDelim(']') {
-						out.StickerUrls = make([]FileOut, 0, 2)
+						out.Stickers = make([]string, 0, 4)
 					} else {
-						out.StickerUrls = []FileOut{}
+						out.Stickers = []string{}
 					}
 				} else {
-					out.StickerUrls = (out.StickerUrls)[:0]
+					out.Stickers = (out.Stickers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Stickers = append(out.Stickers, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "receiver_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ReceiverId).UnmarshalText(data))
+			}
+		case "reply_to_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ReplyToId).UnmarshalText(data))
+			}
+		case "ciphertext":
+			if in.IsNull() {
+				in.Skip()
+				out.Ciphertext = nil
+			} else {
+				out.Ciphertext = in.Bytes()
+			}
+		case "envelopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Envelopes = nil
+			} else {
+				in.Delim('[')
+				if out.Envelopes == nil {
+					if !in.IsDelim(']') {
+						out.Envelopes = make([]KeyEnvelopeOut, 0, 1)
+					} else {
+						out.Envelopes = []KeyEnvelopeOut{}
+					}
+				} else {
+					out.Envelopes = (out.Envelopes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 FileOut
-					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v7)
-					out.StickerUrls = append(out.StickerUrls, v7)
+					var v6 KeyEnvelopeOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v6)
+					out.Envelopes = append(out.Envelopes, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(PollForm)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in, out.Poll)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in ScheduledMessageForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"send_at\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SendAt))
+	}
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if true {
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ChatId).MarshalText())
+	}
+	if len(in.Media) != 0 {
+		const prefix string = ",\"media\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.Media {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Audio) != 0 {
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.Audio {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.File) != 0 {
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.File {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Stickers) != 0 {
+		const prefix string = ",\"stickers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v13, v14 := range in.Stickers {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v14))
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"receiver_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ReceiverId).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"reply_to_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ReplyToId).MarshalText())
+	}
+	if len(in.Ciphertext) != 0 {
+		const prefix string = ",\"ciphertext\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Ciphertext)
+	}
+	if len(in.Envelopes) != 0 {
+		const prefix string = ",\"envelopes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.Envelopes {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v18)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out, *in.Poll)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ScheduledMessageForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ScheduledMessageForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ScheduledMessageForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ScheduledMessageForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *PollForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 string
+					v19 = string(in.String())
+					out.Options = append(out.Options, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in PollForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Options {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MultipleChoice {
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	if in.Anonymous {
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	if in.ResultsVisibility != "" {
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *KeyEnvelopeOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recipient_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.RecipientId).UnmarshalText(data))
+			}
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "key":
+			if in.IsNull() {
+				in.Skip()
+				out.Key = nil
+			} else {
+				out.Key = in.Bytes()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in KeyEnvelopeOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recipient_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.RecipientId).MarshalText())
+	}
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Key)
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer.Lexer, out *MessagesOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]MessageOut, 0, 0)
+					} else {
+						out.Messages = []MessageOut{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 MessageOut
+					(v25).UnmarshalEasyJSON(in)
+					out.Messages = append(out.Messages, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "last_read_ts":
+			out.LastReadTs = string(in.String())
+		case "older_cursor":
+			out.OlderCursor = string(in.String())
+		case "newer_cursor":
+			out.NewerCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwriter.Writer, in MessagesOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Messages {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.LastReadTs != "" {
+		const prefix string = ",\"last_read_ts\":"
+		out.RawString(prefix)
+		out.String(string(in.LastReadTs))
+	}
+	if in.OlderCursor != "" {
+		const prefix string = ",\"older_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.OlderCursor))
+	}
+	if in.NewerCursor != "" {
+		const prefix string = ",\"newer_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NewerCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessagesOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessagesOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessagesOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessagesOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(l, v)
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer.Lexer, out *MessageOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "text":
+			out.Text = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		case "media":
+			if in.IsNull() {
+				in.Skip()
+				out.MediaURLs = nil
+			} else {
+				in.Delim('[')
+				if out.MediaURLs == nil {
+					if !in.IsDelim(']') {
+						out.MediaURLs = make([]FileOut, 0, 1)
+					} else {
+						out.MediaURLs = []FileOut{}
+					}
+				} else {
+					out.MediaURLs = (out.MediaURLs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 FileOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms6(in, &v28)
+					out.MediaURLs = append(out.MediaURLs, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "audio":
+			if in.IsNull() {
+				in.Skip()
+				out.AudioURLs = nil
+			} else {
+				in.Delim('[')
+				if out.AudioURLs == nil {
+					if !in.IsDelim(']') {
+						out.AudioURLs = make([]FileOut, 0, 1)
+					} else {
+						out.AudioURLs = []FileOut{}
+					}
+				} else {
+					out.AudioURLs = (out.AudioURLs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 FileOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms6(in, &v29)
+					out.AudioURLs = append(out.AudioURLs, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "files":
+			if in.IsNull() {
+				in.Skip()
+				out.FileURLs = nil
+			} else {
+				in.Delim('[')
+				if out.FileURLs == nil {
+					if !in.IsDelim(']') {
+						out.FileURLs = make([]FileOut, 0, 1)
+					} else {
+						out.FileURLs = []FileOut{}
+					}
+				} else {
+					out.FileURLs = (out.FileURLs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 FileOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms6(in, &v30)
+					out.FileURLs = append(out.FileURLs, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "stickers":
+			if in.IsNull() {
+				in.Skip()
+				out.StickerUrls = nil
+			} else {
+				in.Delim('[')
+				if out.StickerUrls == nil {
+					if !in.IsDelim(']') {
+						out.StickerUrls = make([]FileOut, 0, 1)
+					} else {
+						out.StickerUrls = []FileOut{}
+					}
+				} else {
+					out.StickerUrls = (out.StickerUrls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 FileOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms6(in, &v31)
+					out.StickerUrls = append(out.StickerUrls, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sender":
+			easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms7(in, &out.Sender)
+		case "chat_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ChatId).UnmarshalText(data))
+			}
+		case "seq":
+			out.Seq = int64(in.Int64())
+		case "edited":
+			out.Edited = bool(in.Bool())
+		case "edited_at":
+			out.EditedAt = string(in.String())
+		case "reply_to":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyTo = nil
+			} else {
+				if out.ReplyTo == nil {
+					out.ReplyTo = new(MessagePreviewOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms8(in, out.ReplyTo)
+			}
+		case "forwarded_from":
+			if in.IsNull() {
+				in.Skip()
+				out.ForwardedFrom = nil
+			} else {
+				if out.ForwardedFrom == nil {
+					out.ForwardedFrom = new(ForwardedFromOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms9(in, out.ForwardedFrom)
+			}
+		case "reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Reactions = nil
+			} else {
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]ReactionOut, 0, 2)
+					} else {
+						out.Reactions = []ReactionOut{}
+					}
+				} else {
+					out.Reactions = (out.Reactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 ReactionOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms10(in, &v32)
+					out.Reactions = append(out.Reactions, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "system":
+			if in.IsNull() {
+				in.Skip()
+				out.System = nil
+			} else {
+				if out.System == nil {
+					out.System = new(SystemInfoOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms11(in, out.System)
+			}
+		case "encrypted":
+			if in.IsNull() {
+				in.Skip()
+				out.Encrypted = nil
+			} else {
+				if out.Encrypted == nil {
+					out.Encrypted = new(EncryptedContentOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms12(in, out.Encrypted)
+			}
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(PollOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms13(in, out.Poll)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwriter.Writer, in MessageOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	if len(in.MediaURLs) != 0 {
+		const prefix string = ",\"media\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v33, v34 := range in.MediaURLs {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms6(out, v34)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AudioURLs) != 0 {
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v35, v36 := range in.AudioURLs {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms6(out, v36)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.FileURLs) != 0 {
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v37, v38 := range in.FileURLs {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms6(out, v38)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.StickerUrls) != 0 {
+		const prefix string = ",\"stickers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v39, v40 := range in.StickerUrls {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms6(out, v40)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sender\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms7(out, in.Sender)
+	}
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ChatId).MarshalText())
+	}
+	if in.Seq != 0 {
+		const prefix string = ",\"seq\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Seq))
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Edited))
+	}
+	if in.EditedAt != "" {
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.String(string(in.EditedAt))
+	}
+	if in.ReplyTo != nil {
+		const prefix string = ",\"reply_to\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms8(out, *in.ReplyTo)
+	}
+	if in.ForwardedFrom != nil {
+		const prefix string = ",\"forwarded_from\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms9(out, *in.ForwardedFrom)
+	}
+	if len(in.Reactions) != 0 {
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v41, v42 := range in.Reactions {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms10(out, v42)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.System != nil {
+		const prefix string = ",\"system\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms11(out, *in.System)
+	}
+	if in.Encrypted != nil {
+		const prefix string = ",\"encrypted\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms12(out, *in.Encrypted)
+	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms13(out, *in.Poll)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(l, v)
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms13(in *jlexer.Lexer, out *PollOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]PollOptionOut, 0, 1)
+					} else {
+						out.Options = []PollOptionOut{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 PollOptionOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms14(in, &v43)
+					out.Options = append(out.Options, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "voter_count":
+			out.VoterCount = int(in.Int())
+		case "voted":
+			if in.IsNull() {
+				in.Skip()
+				out.Voted = nil
+			} else {
+				in.Delim('[')
+				if out.Voted == nil {
+					if !in.IsDelim(']') {
+						out.Voted = make([]int, 0, 8)
+					} else {
+						out.Voted = []int{}
+					}
+				} else {
+					out.Voted = (out.Voted)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 int
+					v44 = int(in.Int())
+					out.Voted = append(out.Voted, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "results_hidden":
+			out.ResultsHidden = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms13(out *jwriter.Writer, in PollOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v45, v46 := range in.Options {
+				if v45 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms14(out, v46)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	{
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	{
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"voter_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoterCount))
+	}
+	if len(in.Voted) != 0 {
+		const prefix string = ",\"voted\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v47, v48 := range in.Voted {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v48))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ResultsHidden {
+		const prefix string = ",\"results_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ResultsHidden))
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms14(in *jlexer.Lexer, out *PollOptionOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "vote_count":
+			out.VoteCount = int(in.Int())
+		case "voters":
+			if in.IsNull() {
+				in.Skip()
+				out.Voters = nil
+			} else {
+				in.Delim('[')
+				if out.Voters == nil {
+					if !in.IsDelim(']') {
+						out.Voters = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Voters = []uuid.UUID{}
+					}
+				} else {
+					out.Voters = (out.Voters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v49).UnmarshalText(data))
+					}
+					out.Voters = append(out.Voters, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms14(out *jwriter.Writer, in PollOptionOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"vote_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoteCount))
+	}
+	if len(in.Voters) != 0 {
+		const prefix string = ",\"voters\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v50, v51 := range in.Voters {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v51).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms12(in *jlexer.Lexer, out *EncryptedContentOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ciphertext":
+			if in.IsNull() {
+				in.Skip()
+				out.Ciphertext = nil
+			} else {
+				out.Ciphertext = in.Bytes()
+			}
+		case "envelopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Envelopes = nil
+			} else {
+				in.Delim('[')
+				if out.Envelopes == nil {
+					if !in.IsDelim(']') {
+						out.Envelopes = make([]KeyEnvelopeOut, 0, 1)
+					} else {
+						out.Envelopes = []KeyEnvelopeOut{}
+					}
+				} else {
+					out.Envelopes = (out.Envelopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 KeyEnvelopeOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v53)
+					out.Envelopes = append(out.Envelopes, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms12(out *jwriter.Writer, in EncryptedContentOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ciphertext\":"
+		out.RawString(prefix[1:])
+		out.Base64Bytes(in.Ciphertext)
+	}
+	{
+		const prefix string = ",\"envelopes\":"
+		out.RawString(prefix)
+		if in.Envelopes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.Envelopes {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v57)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms11(in *jlexer.Lexer, out *SystemInfoOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "user_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms11(out *jwriter.Writer, in SystemInfoOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	if true {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms10(in *jlexer.Lexer, out *ReactionOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "emoji":
+			out.Emoji = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
+		case "reacted":
+			out.Reacted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms10(out *jwriter.Writer, in ReactionOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"emoji\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Emoji))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"reacted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reacted))
+	}
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms9(in *jlexer.Lexer, out *ForwardedFromOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sender_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SenderId).UnmarshalText(data))
+			}
 		case "sender":
-			easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in, &out.Sender)
+			if in.IsNull() {
+				in.Skip()
+				out.Sender = nil
+			} else {
+				if out.Sender == nil {
+					out.Sender = new(PublicUserInfoOut)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms7(in, out.Sender)
+			}
 		case "chat_id":
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.ChatId).UnmarshalText(data))
@@ -260,129 +1592,117 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in MessageOut) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms9(out *jwriter.Writer, in ForwardedFromOut) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if true {
-		const prefix string = ",\"id\":"
-		first = false
-		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
-	}
 	{
-		const prefix string = ",\"text\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Text))
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.SenderId).MarshalText())
 	}
-	{
-		const prefix string = ",\"created_at\":"
+	if in.Sender != nil {
+		const prefix string = ",\"sender\":"
 		out.RawString(prefix)
-		out.String(string(in.CreatedAt))
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms7(out, *in.Sender)
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix)
-		out.String(string(in.UpdatedAt))
+		out.RawText((in.ChatId).MarshalText())
 	}
-	if len(in.MediaURLs) != 0 {
-		const prefix string = ",\"media\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v8, v9 := range in.MediaURLs {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v9)
-			}
-			out.RawByte(']')
+	out.RawByte('}')
+}
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms8(in *jlexer.Lexer, out *MessagePreviewOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	if len(in.AudioURLs) != 0 {
-		const prefix string = ",\"audio\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v10, v11 := range in.AudioURLs {
-				if v10 > 0 {
-					out.RawByte(',')
-				}
-				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v11)
-			}
-			out.RawByte(']')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
 		}
-	}
-	if len(in.FileURLs) != 0 {
-		const prefix string = ",\"files\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v12, v13 := range in.FileURLs {
-				if v12 > 0 {
-					out.RawByte(',')
-				}
-				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v13)
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
 			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.StickerUrls) != 0 {
-		const prefix string = ",\"stickers\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v14, v15 := range in.StickerUrls {
-				if v14 > 0 {
-					out.RawByte(',')
+		case "sender_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SenderId).UnmarshalText(data))
+			}
+		case "sender":
+			if in.IsNull() {
+				in.Skip()
+				out.Sender = nil
+			} else {
+				if out.Sender == nil {
+					out.Sender = new(PublicUserInfoOut)
 				}
-				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v15)
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms7(in, out.Sender)
 			}
-			out.RawByte(']')
+		case "text":
+			out.Text = string(in.String())
+		case "attachment_type":
+			out.AttachmentType = string(in.String())
+		case "deleted":
+			out.Deleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms8(out *jwriter.Writer, in MessagePreviewOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SenderId).MarshalText())
+	}
+	if in.Sender != nil {
 		const prefix string = ",\"sender\":"
 		out.RawString(prefix)
-		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out, in.Sender)
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms7(out, *in.Sender)
 	}
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix)
-		out.RawText((in.ChatId).MarshalText())
+		out.String(string(in.Text))
+	}
+	if in.AttachmentType != "" {
+		const prefix string = ",\"attachment_type\":"
+		out.RawString(prefix)
+		out.String(string(in.AttachmentType))
+	}
+	if in.Deleted {
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v MessageOut) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MessageOut) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *MessageOut) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MessageOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
-}
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *PublicUserInfoOut) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms7(in *jlexer.Lexer, out *PublicUserInfoOut) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -433,7 +1753,7 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in PublicUserInfoOut) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms7(out *jwriter.Writer, in PublicUserInfoOut) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -474,7 +1794,7 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwrit
 	}
 	out.RawByte('}')
 }
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *FileOut) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms6(in *jlexer.Lexer, out *FileOut) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -497,6 +1817,31 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer
 			out.URL = string(in.String())
 		case "name":
 			out.Name = string(in.String())
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "waveform":
+			if in.IsNull() {
+				in.Skip()
+				out.Waveform = nil
+			} else {
+				in.Delim('[')
+				if out.Waveform == nil {
+					if !in.IsDelim(']') {
+						out.Waveform = make([]int, 0, 8)
+					} else {
+						out.Waveform = []int{}
+					}
+				} else {
+					out.Waveform = (out.Waveform)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 int
+					v58 = int(in.Int())
+					out.Waveform = append(out.Waveform, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -507,7 +1852,7 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in FileOut) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms6(out *jwriter.Writer, in FileOut) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -521,9 +1866,28 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwrit
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	if len(in.Waveform) != 0 {
+		const prefix string = ",\"waveform\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v59, v60 := range in.Waveform {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v60))
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer.Lexer, out *MessageForm) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms15(in *jlexer.Lexer, out *MessageForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -564,9 +1928,9 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 					out.Media = (out.Media)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Media = append(out.Media, v16)
+					var v61 string
+					v61 = string(in.String())
+					out.Media = append(out.Media, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -587,9 +1951,9 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 					out.Audio = (out.Audio)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v17 string
-					v17 = string(in.String())
-					out.Audio = append(out.Audio, v17)
+					var v62 string
+					v62 = string(in.String())
+					out.Audio = append(out.Audio, v62)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -610,9 +1974,9 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 					out.File = (out.File)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v18 string
-					v18 = string(in.String())
-					out.File = append(out.File, v18)
+					var v63 string
+					v63 = string(in.String())
+					out.File = append(out.File, v63)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -633,9 +1997,9 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 					out.Stickers = (out.Stickers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 string
-					v19 = string(in.String())
-					out.Stickers = append(out.Stickers, v19)
+					var v64 string
+					v64 = string(in.String())
+					out.Stickers = append(out.Stickers, v64)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -644,6 +2008,50 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.ReceiverId).UnmarshalText(data))
 			}
+		case "reply_to_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ReplyToId).UnmarshalText(data))
+			}
+		case "ciphertext":
+			if in.IsNull() {
+				in.Skip()
+				out.Ciphertext = nil
+			} else {
+				out.Ciphertext = in.Bytes()
+			}
+		case "envelopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Envelopes = nil
+			} else {
+				in.Delim('[')
+				if out.Envelopes == nil {
+					if !in.IsDelim(']') {
+						out.Envelopes = make([]KeyEnvelopeOut, 0, 1)
+					} else {
+						out.Envelopes = []KeyEnvelopeOut{}
+					}
+				} else {
+					out.Envelopes = (out.Envelopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v66 KeyEnvelopeOut
+					easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v66)
+					out.Envelopes = append(out.Envelopes, v66)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(PollForm)
+				}
+				easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in, out.Poll)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -654,7 +2062,7 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwriter.Writer, in MessageForm) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms15(out *jwriter.Writer, in MessageForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -684,11 +2092,11 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v20, v21 := range in.Media {
-				if v20 > 0 {
+			for v67, v68 := range in.Media {
+				if v67 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v21))
+				out.String(string(v68))
 			}
 			out.RawByte(']')
 		}
@@ -703,11 +2111,11 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v22, v23 := range in.Audio {
-				if v22 > 0 {
+			for v69, v70 := range in.Audio {
+				if v69 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v23))
+				out.String(string(v70))
 			}
 			out.RawByte(']')
 		}
@@ -722,11 +2130,11 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v24, v25 := range in.File {
-				if v24 > 0 {
+			for v71, v72 := range in.File {
+				if v71 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v25))
+				out.String(string(v72))
 			}
 			out.RawByte(']')
 		}
@@ -741,11 +2149,11 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		}
 		{
 			out.RawByte('[')
-			for v26, v27 := range in.Stickers {
-				if v26 > 0 {
+			for v73, v74 := range in.Stickers {
+				if v73 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v27))
+				out.String(string(v74))
 			}
 			out.RawByte(']')
 		}
@@ -760,33 +2168,82 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwrit
 		}
 		out.RawText((in.ReceiverId).MarshalText())
 	}
+	if true {
+		const prefix string = ",\"reply_to_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.ReplyToId).MarshalText())
+	}
+	if len(in.Ciphertext) != 0 {
+		const prefix string = ",\"ciphertext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Base64Bytes(in.Ciphertext)
+	}
+	if len(in.Envelopes) != 0 {
+		const prefix string = ",\"envelopes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v77, v78 := range in.Envelopes {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v78)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out, *in.Poll)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v MessageForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(&w, v)
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageForm) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms4(w, v)
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(&r, v)
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms4(l, v)
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms15(l, v)
 }
-func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer.Lexer, out *GetMessagesForm) {
+func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms16(in *jlexer.Lexer, out *GetMessagesForm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -811,6 +2268,22 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Ts).UnmarshalJSON(data))
 			}
+		case "after":
+			if in.IsNull() {
+				in.Skip()
+				out.After = nil
+			} else {
+				if out.After == nil {
+					out.After = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.After).UnmarshalJSON(data))
+				}
+			}
+		case "anchor_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AnchorId).UnmarshalText(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -821,7 +2294,7 @@ func easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwriter.Writer, in GetMessagesForm) {
+func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms16(out *jwriter.Writer, in GetMessagesForm) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -835,29 +2308,39 @@ func easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(out *jwrit
 		out.RawString(prefix)
 		out.Raw((in.Ts).MarshalJSON())
 	}
+	if in.After != nil {
+		const prefix string = ",\"after\":"
+		out.RawString(prefix)
+		out.Raw((*in.After).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"anchor_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AnchorId).MarshalText())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v GetMessagesForm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(&w, v)
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetMessagesForm) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms5(w, v)
+	easyjson8575bbc1EncodeQuickflowGatewayInternalDeliveryHttpForms16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetMessagesForm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(&r, v)
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetMessagesForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms5(l, v)
+	easyjson8575bbc1DecodeQuickflowGatewayInternalDeliveryHttpForms16(l, v)
 }
@@ -100,6 +100,32 @@ func TestGetMessagesForm_GetParams(t *testing.T) {
 	}
 }
 
+func TestGetMessagesForm_GetParams_AnchorAndAfter(t *testing.T) {
+	anchorId := uuid.New()
+	after := time.Date(2025, 3, 1, 12, 30, 15, 123_000_000, time.UTC)
+
+	var form GetMessagesForm
+	err := form.GetParams(url.Values{
+		"messages_count": []string{"20"},
+		"anchor_id":      []string{anchorId.String()},
+		"after":          []string{after.Format(time2.TimeStampLayout)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, anchorId, form.AnchorId)
+	if assert.NotNil(t, form.After) {
+		// the message the cursor was taken from is not returned again
+		assert.True(t, form.After.Equal(after.Add(999*time.Microsecond)))
+	}
+
+	err = form.GetParams(url.Values{"messages_count": []string{"20"}, "anchor_id": []string{"invalid"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse anchor_id")
+
+	err = form.GetParams(url.Values{"messages_count": []string{"20"}, "after": []string{"invalid"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse after")
+}
+
 func TestToFileOut(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -126,6 +152,19 @@ func TestToFileOut(t *testing.T) {
 				URL: "http://example.com/image.jpg",
 			},
 		},
+		{
+			name: "Voice message",
+			file: models.File{
+				URL:     "http://example.com/voice.ogg",
+				IsVoice: true,
+				Voice:   &models.VoiceMetadata{Duration: 1500 * time.Millisecond, Waveform: []byte{0, 128, 255}},
+			},
+			expected: FileOut{
+				URL:      "http://example.com/voice.ogg",
+				Duration: 1500,
+				Waveform: []int{0, 128, 255},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -280,6 +319,55 @@ func TestToMessagesOut(t *testing.T) {
 	assert.Equal(t, "Jane", result[1].Sender.FirstName)
 }
 
+func TestToMessagesOut_ReplyTo(t *testing.T) {
+	chatID := uuid.New()
+	senderID := uuid.New()
+	quotedSenderID := uuid.New()
+	quotedID := uuid.New()
+	deletedID := uuid.New()
+
+	userInfoMap := map[uuid.UUID]models.PublicUserInfo{
+		senderID:       {Id: senderID, Firstname: "John"},
+		quotedSenderID: {Id: quotedSenderID, Firstname: "Jane"},
+	}
+
+	messages := []*models.Message{
+		{
+			ID:        uuid.New(),
+			Text:      "Reply",
+			SenderID:  senderID,
+			ChatID:    chatID,
+			ReplyToID: quotedID,
+			ReplyTo: &models.MessagePreview{
+				ID:             quotedID,
+				SenderID:       quotedSenderID,
+				Text:           "Original",
+				AttachmentType: models.DisplayTypeMedia,
+			},
+		},
+		{
+			ID:        uuid.New(),
+			Text:      "Reply to deleted",
+			SenderID:  senderID,
+			ChatID:    chatID,
+			ReplyToID: deletedID,
+			ReplyTo:   &models.MessagePreview{ID: deletedID, Deleted: true},
+		},
+	}
+
+	result := ToMessagesOut(messages, userInfoMap)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, quotedID, result[0].ReplyTo.ID)
+	assert.Equal(t, "Original", result[0].ReplyTo.Text)
+	assert.Equal(t, string(models.DisplayTypeMedia), result[0].ReplyTo.AttachmentType)
+	assert.Equal(t, "Jane", result[0].ReplyTo.Sender.FirstName)
+
+	assert.True(t, result[1].ReplyTo.Deleted)
+	assert.Equal(t, DeletedMessagePlaceholder, result[1].ReplyTo.Text)
+	assert.Nil(t, result[1].ReplyTo.Sender)
+}
+
 func TestMessageForm_ToMessageModel(t *testing.T) {
 	chatID := uuid.New()
 	receiverID := uuid.New()
@@ -326,8 +414,9 @@ func TestMessageForm_ToMessageModel(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := tt.form.ToMessageModel()
+			result, err := tt.form.ToMessageModel()
 
+			assert.NoError(t, err)
 			assert.NotEqual(t, uuid.Nil, result.ID)
 			assert.Equal(t, tt.expected.Text, result.Text)
 			assert.Equal(t, tt.expected.ChatID, result.ChatID)
@@ -342,3 +431,106 @@ func TestMessageForm_ToMessageModel(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageForm_ToMessageModel_Encrypted(t *testing.T) {
+	recipientID := uuid.New()
+	form := MessageForm{
+		ChatId:     uuid.New(),
+		Ciphertext: []byte("ciphertext"),
+		Envelopes:  []KeyEnvelopeOut{{RecipientId: recipientID, DeviceId: "phone", Key: []byte("key")}},
+	}
+
+	message, err := form.ToMessageModel()
+
+	assert.NoError(t, err)
+	assert.Empty(t, message.Text)
+	assert.Equal(t, &models.EncryptedContent{
+		Ciphertext: []byte("ciphertext"),
+		Envelopes:  []models.KeyEnvelope{{RecipientID: recipientID, DeviceID: "phone", Key: []byte("key")}},
+	}, message.Encrypted)
+
+	out := ToMessageOut(message, models.PublicUserInfo{})
+	assert.Equal(t, &EncryptedContentOut{Ciphertext: form.Ciphertext, Envelopes: form.Envelopes}, out.Encrypted)
+}
+
+func TestSearchMessagesForm_GetParams(t *testing.T) {
+	chatId := uuid.New()
+	to := time.Now().Format(time2.TimeStampLayout)
+
+	tests := []struct {
+		name        string
+		values      url.Values
+		expectError bool
+		errMessage  string
+	}{
+		{
+			name: "Valid params",
+			values: url.Values{
+				"string":  []string{"report"},
+				"count":   []string{"20"},
+				"chat_id": []string{chatId.String()},
+				"to":      []string{to},
+			},
+		},
+		{
+			name:        "Missing string",
+			values:      url.Values{"count": []string{"20"}},
+			expectError: true,
+			errMessage:  "string parameter missing",
+		},
+		{
+			name:        "Missing count",
+			values:      url.Values{"string": []string{"report"}},
+			expectError: true,
+			errMessage:  "count parameter missing",
+		},
+		{
+			name: "Invalid chat_id",
+			values: url.Values{
+				"string":  []string{"report"},
+				"count":   []string{"20"},
+				"chat_id": []string{"invalid"},
+			},
+			expectError: true,
+			errMessage:  "failed to parse chat_id",
+		},
+		{
+			name: "Invalid from",
+			values: url.Values{
+				"string": []string{"report"},
+				"count":  []string{"20"},
+				"from":   []string{"yesterday"},
+			},
+			expectError: true,
+			errMessage:  "failed to parse from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form SearchMessagesForm
+			err := form.GetParams(tt.values)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+				return
+			}
+			assert.NoError(t, err)
+
+			query := form.ToSearchQuery()
+			assert.Equal(t, "report", query.Text)
+			assert.Equal(t, 20, query.Limit)
+			assert.Equal(t, chatId, query.ChatID)
+			assert.Nil(t, query.From)
+			if assert.NotNil(t, query.To) {
+				assert.Equal(t, to, query.To.Format(time2.TimeStampLayout))
+			}
+		})
+	}
+}
+
+func TestToSnippetOut(t *testing.T) {
+	snippet := "<b>the</b> " + models.SearchMatchStart + "report" + models.SearchMatchEnd + " & more"
+	assert.Equal(t, "&lt;b&gt;the&lt;/b&gt; <mark>report</mark> &amp; more", ToSnippetOut(snippet))
+}
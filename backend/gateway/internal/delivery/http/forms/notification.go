@@ -0,0 +1,102 @@
+package forms
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	time2 "quickflow/config/time"
+	"quickflow/shared/models"
+)
+
+// NotificationOut is an item of the notification list, target_id is the post, comment, community or user of the event.
+// Likes of the same target are grouped: actor is the latest one, actors are a few latest ones and actor_count counts all of them
+//
+//easyjson:json
+type NotificationOut struct {
+	Id         uuid.UUID           `json:"id"`
+	Type       string              `json:"type"`
+	Actor      *PublicUserInfoOut  `json:"actor,omitempty"`
+	Actors     []PublicUserInfoOut `json:"actors,omitempty"`
+	ActorCount int                 `json:"actor_count"`
+	TargetId   uuid.UUID           `json:"target_id"`
+	Content    string              `json:"content,omitempty"`
+	IsRead     bool                `json:"is_read"`
+	CreatedAt  string              `json:"created_at"`
+	UpdatedAt  string              `json:"updated_at"`
+}
+
+//easyjson:json
+type NotificationsOut []NotificationOut
+
+// GetNotificationsForm is a page of notifications older than Ts, LastId tells apart the ones updated at the same time
+type GetNotificationsForm struct {
+	PaginationForm
+	LastId uuid.UUID
+}
+
+func (f *GetNotificationsForm) GetParams(values url.Values) error {
+	if err := f.PaginationForm.GetParams(values); err != nil {
+		return err
+	}
+	if values.Has("last_id") {
+		var err error
+		f.LastId, err = uuid.Parse(values.Get("last_id"))
+		if err != nil {
+			return errors.New("failed to parse last_id")
+		}
+	}
+	return nil
+}
+
+// MarkNotificationsReadForm lists notifications the user has seen
+//
+//easyjson:json
+type MarkNotificationsReadForm struct {
+	Ids []uuid.UUID `json:"ids"`
+}
+
+//easyjson:json
+type UnreadCountOut struct {
+	Count int64 `json:"count"`
+}
+
+// NotificationActorIds lists actors of the notifications once, their info is needed to show the list
+func NotificationActorIds(notifications []models.Notification) []uuid.UUID {
+	var actorIds []uuid.UUID
+	seen := make(map[uuid.UUID]struct{})
+	for _, notification := range notifications {
+		for _, actorId := range append([]uuid.UUID{notification.ActorId}, notification.ActorIds...) {
+			if _, ok := seen[actorId]; !ok {
+				seen[actorId] = struct{}{}
+				actorIds = append(actorIds, actorId)
+			}
+		}
+	}
+	return actorIds
+}
+
+// ToNotificationOut leaves out actors whose info is unknown
+func ToNotificationOut(notification models.Notification, actors map[uuid.UUID]models.PublicUserInfo) NotificationOut {
+	out := NotificationOut{
+		Id:         notification.Id,
+		Type:       string(notification.Type),
+		ActorCount: notification.ActorCount,
+		TargetId:   notification.TargetId,
+		Content:    notification.Content,
+		IsRead:     notification.IsRead,
+		CreatedAt:  notification.CreatedAt.Format(time2.TimeStampLayout),
+		UpdatedAt:  notification.UpdatedAt.Format(time2.TimeStampLayout),
+	}
+	if actor, ok := actors[notification.ActorId]; ok {
+		info := PublicUserInfoToOut(actor, "")
+		out.Actor = &info
+	}
+	for _, actorId := range notification.ActorIds {
+		if actor, ok := actors[actorId]; ok {
+			out.Actors = append(out.Actors, PublicUserInfoToOut(actor, ""))
+		}
+	}
+	return out
+}
@@ -0,0 +1,516 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package forms
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	models "quickflow/shared/models"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *UnreadCountOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in UnreadCountOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnreadCountOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnreadCountOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnreadCountOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnreadCountOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms(l, v)
+}
+func easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *NotificationsOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(NotificationsOut, 0, 0)
+			} else {
+				*out = NotificationsOut{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 NotificationOut
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in NotificationsOut) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationsOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationsOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationsOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationsOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
+}
+func easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *NotificationOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "actor":
+			if in.IsNull() {
+				in.Skip()
+				out.Actor = nil
+			} else {
+				if out.Actor == nil {
+					out.Actor = new(PublicUserInfoOut)
+				}
+				easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in, out.Actor)
+			}
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]PublicUserInfoOut, 0, 0)
+					} else {
+						out.Actors = []PublicUserInfoOut{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 PublicUserInfoOut
+					easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in, &v4)
+					out.Actors = append(out.Actors, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "actor_count":
+			out.ActorCount = int(in.Int())
+		case "target_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.TargetId).UnmarshalText(data))
+			}
+		case "content":
+			out.Content = string(in.String())
+		case "is_read":
+			out.IsRead = bool(in.Bool())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in NotificationOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Actor != nil {
+		const prefix string = ",\"actor\":"
+		out.RawString(prefix)
+		easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out, *in.Actor)
+	}
+	if len(in.Actors) != 0 {
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Actors {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"actor_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ActorCount))
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		out.RawText((in.TargetId).MarshalText())
+	}
+	if in.Content != "" {
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"is_read\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRead))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms2(l, v)
+}
+func easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *PublicUserInfoOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
+		case "firstname":
+			out.FirstName = string(in.String())
+		case "lastname":
+			out.LastName = string(in.String())
+		case "online":
+			if in.IsNull() {
+				in.Skip()
+				out.IsOnline = nil
+			} else {
+				if out.IsOnline == nil {
+					out.IsOnline = new(bool)
+				}
+				*out.IsOnline = bool(in.Bool())
+			}
+		case "relation":
+			out.Relation = models.UserRelation(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in PublicUserInfoOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	{
+		const prefix string = ",\"firstname\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastname\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	if in.IsOnline != nil {
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.IsOnline))
+	}
+	if in.Relation != "" {
+		const prefix string = ",\"relation\":"
+		out.RawString(prefix)
+		out.String(string(in.Relation))
+	}
+	out.RawByte('}')
+}
+func easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms4(in *jlexer.Lexer, out *MarkNotificationsReadForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Ids = nil
+			} else {
+				in.Delim('[')
+				if out.Ids == nil {
+					if !in.IsDelim(']') {
+						out.Ids = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Ids = []uuid.UUID{}
+					}
+				} else {
+					out.Ids = (out.Ids)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v7).UnmarshalText(data))
+					}
+					out.Ids = append(out.Ids, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms4(out *jwriter.Writer, in MarkNotificationsReadForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ids\":"
+		out.RawString(prefix[1:])
+		if in.Ids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Ids {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v9).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarkNotificationsReadForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarkNotificationsReadForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeQuickflowGatewayInternalDeliveryHttpForms4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarkNotificationsReadForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarkNotificationsReadForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeQuickflowGatewayInternalDeliveryHttpForms4(l, v)
+}
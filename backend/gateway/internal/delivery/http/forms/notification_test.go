@@ -0,0 +1,77 @@
+package forms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	time2 "quickflow/config/time"
+	"quickflow/shared/models"
+)
+
+func TestToNotificationOut(t *testing.T) {
+	actorId := uuid.New()
+	createdAt := time.Now()
+	notification := models.Notification{
+		Id:        uuid.New(),
+		UserId:    uuid.New(),
+		ActorId:   actorId,
+		Type:      models.NotificationPostCommented,
+		TargetId:  uuid.New(),
+		Content:   "nice",
+		CreatedAt: createdAt,
+	}
+
+	out := ToNotificationOut(notification, map[uuid.UUID]models.PublicUserInfo{
+		actorId: {Id: actorId, Username: "anna"},
+	})
+	assert.Equal(t, notification.Id, out.Id)
+	assert.Equal(t, "post_commented", out.Type)
+	assert.Equal(t, notification.TargetId, out.TargetId)
+	assert.Equal(t, "nice", out.Content)
+	assert.False(t, out.IsRead)
+	assert.Equal(t, createdAt.Format(time2.TimeStampLayout), out.CreatedAt)
+	if assert.NotNil(t, out.Actor) {
+		assert.Equal(t, "anna", out.Actor.Username)
+	}
+
+	out = ToNotificationOut(notification, nil)
+	assert.Nil(t, out.Actor)
+}
+
+func TestToNotificationOut_Grouped(t *testing.T) {
+	latestId, firstId, unknownId := uuid.New(), uuid.New(), uuid.New()
+	notification := models.Notification{
+		Id:         uuid.New(),
+		ActorId:    latestId,
+		ActorIds:   []uuid.UUID{latestId, unknownId, firstId},
+		ActorCount: 13,
+		Type:       models.NotificationPostLiked,
+		TargetId:   uuid.New(),
+		CreatedAt:  time.Now().Add(-time.Hour),
+		UpdatedAt:  time.Now(),
+	}
+
+	out := ToNotificationOut(notification, map[uuid.UUID]models.PublicUserInfo{
+		latestId: {Id: latestId, Username: "anna"},
+		firstId:  {Id: firstId, Username: "boris"},
+	})
+	assert.Equal(t, 13, out.ActorCount)
+	assert.Equal(t, notification.UpdatedAt.Format(time2.TimeStampLayout), out.UpdatedAt)
+	if assert.Len(t, out.Actors, 2) {
+		assert.Equal(t, "anna", out.Actors[0].Username)
+		assert.Equal(t, "boris", out.Actors[1].Username)
+	}
+}
+
+func TestNotificationActorIds(t *testing.T) {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	actorIds := NotificationActorIds([]models.Notification{
+		{ActorId: first, ActorIds: []uuid.UUID{first, second}},
+		{ActorId: third, ActorIds: []uuid.UUID{third, first}},
+	})
+	assert.Equal(t, []uuid.UUID{first, second, third}, actorIds)
+}
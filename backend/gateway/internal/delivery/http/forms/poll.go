@@ -0,0 +1,108 @@
+package forms
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	time2 "quickflow/config/time"
+	"quickflow/shared/models"
+)
+
+// PollForm creates a poll attached to a post or a message, results are visible to everyone if visibility is empty
+//
+//easyjson:json
+type PollForm struct {
+	Question          string   `json:"question"`
+	Options           []string `json:"options"`
+	MultipleChoice    bool     `json:"multiple_choice,omitempty"`
+	Anonymous         bool     `json:"anonymous,omitempty"`
+	ResultsVisibility string   `json:"results_visibility,omitempty"`
+	ClosesAt          string   `json:"closes_at,omitempty"`
+}
+
+func (f *PollForm) ToPollModel() (*models.Poll, error) {
+	poll := &models.Poll{
+		Question:          f.Question,
+		MultipleChoice:    f.MultipleChoice,
+		Anonymous:         f.Anonymous,
+		ResultsVisibility: models.PollResultsVisibility(f.ResultsVisibility),
+	}
+	if len(f.ResultsVisibility) == 0 {
+		poll.ResultsVisibility = models.PollResultsAlways
+	}
+	for _, option := range f.Options {
+		poll.Options = append(poll.Options, models.PollOption{Text: option})
+	}
+
+	if len(f.ClosesAt) != 0 {
+		closesAt, err := time.Parse(time2.TimeStampLayout, f.ClosesAt)
+		if err != nil {
+			return nil, errors.New("failed to parse closes_at")
+		}
+		poll.ClosesAt = &closesAt
+	}
+	return poll, nil
+}
+
+// PollVoteForm replaces the vote of the user, options are indexes and an empty list retracts the vote
+//
+//easyjson:json
+type PollVoteForm struct {
+	Options []int `json:"options"`
+}
+
+// PollOut is the poll as seen by the user, counts and voters are empty while ResultsHidden is set
+//
+//easyjson:json
+type PollOut struct {
+	Id                uuid.UUID       `json:"id"`
+	Question          string          `json:"question"`
+	Options           []PollOptionOut `json:"options"`
+	MultipleChoice    bool            `json:"multiple_choice"`
+	Anonymous         bool            `json:"anonymous"`
+	ResultsVisibility string          `json:"results_visibility"`
+	ClosesAt          string          `json:"closes_at,omitempty"`
+	Closed            bool            `json:"closed"`
+	VoterCount        int             `json:"voter_count"`
+	Voted             []int           `json:"voted,omitempty"`
+	ResultsHidden     bool            `json:"results_hidden,omitempty"`
+}
+
+// PollOptionOut lists voters only in public polls
+type PollOptionOut struct {
+	Text      string      `json:"text"`
+	VoteCount int         `json:"vote_count"`
+	Voters    []uuid.UUID `json:"voters,omitempty"`
+}
+
+func ToPollOut(poll *models.Poll) *PollOut {
+	if poll == nil {
+		return nil
+	}
+
+	out := &PollOut{
+		Id:                poll.ID,
+		Question:          poll.Question,
+		Options:           make([]PollOptionOut, 0, len(poll.Options)),
+		MultipleChoice:    poll.MultipleChoice,
+		Anonymous:         poll.Anonymous,
+		ResultsVisibility: string(poll.ResultsVisibility),
+		Closed:            poll.IsClosed(time.Now()),
+		VoterCount:        poll.VoterCount,
+		Voted:             poll.Voted,
+		ResultsHidden:     poll.ResultsHidden,
+	}
+	if poll.ClosesAt != nil {
+		out.ClosesAt = poll.ClosesAt.Format(time2.TimeStampLayout)
+	}
+	for _, option := range poll.Options {
+		out.Options = append(out.Options, PollOptionOut{
+			Text:      option.Text,
+			VoteCount: option.VoteCount,
+			Voters:    option.Voters,
+		})
+	}
+	return out
+}
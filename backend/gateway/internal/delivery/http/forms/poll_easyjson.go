@@ -0,0 +1,539 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package forms
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms(in *jlexer.Lexer, out *PollVoteForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]int, 0, 8)
+					} else {
+						out.Options = []int{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int
+					v1 = int(in.Int())
+					out.Options = append(out.Options, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms(out *jwriter.Writer, in PollVoteForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix[1:])
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Options {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollVoteForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollVoteForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollVoteForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollVoteForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms(l, v)
+}
+func easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms1(in *jlexer.Lexer, out *PollOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]PollOptionOut, 0, 1)
+					} else {
+						out.Options = []PollOptionOut{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 PollOptionOut
+					easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms2(in, &v4)
+					out.Options = append(out.Options, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "voter_count":
+			out.VoterCount = int(in.Int())
+		case "voted":
+			if in.IsNull() {
+				in.Skip()
+				out.Voted = nil
+			} else {
+				in.Delim('[')
+				if out.Voted == nil {
+					if !in.IsDelim(']') {
+						out.Voted = make([]int, 0, 8)
+					} else {
+						out.Voted = []int{}
+					}
+				} else {
+					out.Voted = (out.Voted)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 int
+					v5 = int(in.Int())
+					out.Voted = append(out.Voted, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "results_hidden":
+			out.ResultsHidden = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms1(out *jwriter.Writer, in PollOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Options {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms2(out, v7)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	{
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	{
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"voter_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoterCount))
+	}
+	if len(in.Voted) != 0 {
+		const prefix string = ",\"voted\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Voted {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ResultsHidden {
+		const prefix string = ",\"results_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ResultsHidden))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollOut) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollOut) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollOut) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollOut) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
+}
+func easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *PollOptionOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "vote_count":
+			out.VoteCount = int(in.Int())
+		case "voters":
+			if in.IsNull() {
+				in.Skip()
+				out.Voters = nil
+			} else {
+				in.Delim('[')
+				if out.Voters == nil {
+					if !in.IsDelim(']') {
+						out.Voters = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Voters = []uuid.UUID{}
+					}
+				} else {
+					out.Voters = (out.Voters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v10).UnmarshalText(data))
+					}
+					out.Voters = append(out.Voters, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in PollOptionOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"vote_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoteCount))
+	}
+	if len(in.Voters) != 0 {
+		const prefix string = ",\"voters\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Voters {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v12).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *PollForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.Options = append(out.Options, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple_choice":
+			out.MultipleChoice = bool(in.Bool())
+		case "anonymous":
+			out.Anonymous = bool(in.Bool())
+		case "results_visibility":
+			out.ResultsVisibility = string(in.String())
+		case "closes_at":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in PollForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Options {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MultipleChoice {
+		const prefix string = ",\"multiple_choice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultipleChoice))
+	}
+	if in.Anonymous {
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	if in.ResultsVisibility != "" {
+		const prefix string = ",\"results_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultsVisibility))
+	}
+	if in.ClosesAt != "" {
+		const prefix string = ",\"closes_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB24b5487EncodeQuickflowGatewayInternalDeliveryHttpForms3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB24b5487DecodeQuickflowGatewayInternalDeliveryHttpForms3(l, v)
+}
@@ -0,0 +1,79 @@
+package forms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	time2 "quickflow/config/time"
+	"quickflow/shared/models"
+)
+
+func TestPollForm_ToPollModel(t *testing.T) {
+	closesAt := time.Now().Add(time.Hour).Format(time2.TimeStampLayout)
+
+	tests := []struct {
+		name       string
+		form       PollForm
+		visibility models.PollResultsVisibility
+		closes     bool
+		wantErr    bool
+	}{
+		{
+			name:       "defaults to visible results",
+			form:       PollForm{Question: "Lunch?", Options: []string{"Yes", "No"}},
+			visibility: models.PollResultsAlways,
+		},
+		{
+			name:       "closes at",
+			form:       PollForm{Question: "Lunch?", Options: []string{"Yes", "No"}, ResultsVisibility: "after_close", ClosesAt: closesAt},
+			visibility: models.PollResultsAfterClose,
+			closes:     true,
+		},
+		{
+			name:    "invalid closes at",
+			form:    PollForm{Question: "Lunch?", Options: []string{"Yes", "No"}, ClosesAt: "tomorrow"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, err := tt.form.ToPollModel()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.form.Question, poll.Question)
+			assert.Equal(t, []models.PollOption{{Text: "Yes"}, {Text: "No"}}, poll.Options)
+			assert.Equal(t, tt.visibility, poll.ResultsVisibility)
+			assert.Equal(t, tt.closes, poll.ClosesAt != nil)
+		})
+	}
+}
+
+func TestToPollOut(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	voter := uuid.New()
+	poll := &models.Poll{
+		ID:                uuid.New(),
+		Question:          "Lunch?",
+		Options:           []models.PollOption{{Text: "Yes", VoteCount: 1, Voters: []uuid.UUID{voter}}, {Text: "No"}},
+		ResultsVisibility: models.PollResultsAlways,
+		ClosesAt:          &past,
+		VoterCount:        1,
+		Voted:             []int{0},
+	}
+
+	out := ToPollOut(poll)
+
+	assert.Nil(t, ToPollOut(nil))
+	assert.True(t, out.Closed)
+	assert.Equal(t, []int{0}, out.Voted)
+	assert.Equal(t, []PollOptionOut{{Text: "Yes", VoteCount: 1, Voters: []uuid.UUID{voter}}, {Text: "No"}}, out.Options)
+}
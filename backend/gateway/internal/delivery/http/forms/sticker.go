@@ -70,3 +70,23 @@ func ToStickerPacksOut(stickerPacks []*models.StickerPack) []StickerPackOut {
 	}
 	return res
 }
+
+// ReorderStickerPacksForm lists all packs of the user's library in the new order
+//
+//easyjson:json
+type ReorderStickerPacksForm struct {
+	PackIds []uuid.UUID `json:"pack_ids"`
+}
+
+//easyjson:json
+type FavouriteStickerForm struct {
+	StickerURL string `json:"sticker_url"`
+}
+
+func ToStickerURLs(stickers []*models.File) []string {
+	urls := make([]string, 0, len(stickers))
+	for _, sticker := range stickers {
+		urls = append(urls, sticker.URL)
+	}
+	return urls
+}
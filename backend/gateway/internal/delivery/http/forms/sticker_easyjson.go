@@ -4,6 +4,7 @@ package forms
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -259,3 +260,169 @@ func (v *StickerPackForm) UnmarshalJSON(data []byte) error {
 func (v *StickerPackForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms1(l, v)
 }
+func easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms2(in *jlexer.Lexer, out *ReorderStickerPacksForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pack_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.PackIds = nil
+			} else {
+				in.Delim('[')
+				if out.PackIds == nil {
+					if !in.IsDelim(']') {
+						out.PackIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.PackIds = []uuid.UUID{}
+					}
+				} else {
+					out.PackIds = (out.PackIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v7).UnmarshalText(data))
+					}
+					out.PackIds = append(out.PackIds, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms2(out *jwriter.Writer, in ReorderStickerPacksForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pack_ids\":"
+		out.RawString(prefix[1:])
+		if in.PackIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.PackIds {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v9).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReorderStickerPacksForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReorderStickerPacksForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReorderStickerPacksForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReorderStickerPacksForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms2(l, v)
+}
+func easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms3(in *jlexer.Lexer, out *FavouriteStickerForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sticker_url":
+			out.StickerURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms3(out *jwriter.Writer, in FavouriteStickerForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sticker_url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.StickerURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FavouriteStickerForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FavouriteStickerForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA68a6153EncodeQuickflowGatewayInternalDeliveryHttpForms3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FavouriteStickerForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FavouriteStickerForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA68a6153DecodeQuickflowGatewayInternalDeliveryHttpForms3(l, v)
+}
@@ -107,7 +107,7 @@ func (f *FriendHandler) GetFriends(w http.ResponseWriter, r *http.Request) {
 
     var friendsOnline []bool
     for _, friend := range friendsInfo {
-        _, isOnline := f.ConnService.IsConnected(friend.Id)
+        isOnline := f.ConnService.IsOnline(friend.Id)
         friendsOnline = append(friendsOnline, isOnline)
     }
 
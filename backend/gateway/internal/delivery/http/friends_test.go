@@ -25,14 +25,17 @@ func TestFriendsHandler_GetFriends(t *testing.T) {
 
 	mockFriendsUseCase := mocks.NewMockFriendsUseCase(ctrl)
 	mockWS := wsMocks.NewMockIWebSocketConnectionManager(ctrl)
-	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS)
+	mockFriendsWS := mocks.NewMockIFriendsWSManager(ctrl)
+	mockFriendsWS.EXPECT().NotifyFriendRequestSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockFriendsWS.EXPECT().NotifyFriendRequestAccepted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS, mockFriendsWS)
 
 	t.Run("OK (Current User)", func(t *testing.T) {
 		userID := uuid.New()
 		mockFriendsUseCase.EXPECT().
 			GetFriendsInfo(gomock.Any(), userID.String(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return([]models.FriendInfo{}, 0, nil)
-		mockWS.EXPECT().IsConnected(gomock.Any()).Return(nil, false).AnyTimes()
+		mockWS.EXPECT().IsOnline(gomock.Any()).Return(false).AnyTimes()
 
 		req := httptest.NewRequest(http.MethodGet, "/api/friends", nil)
 		ctx := context.WithValue(req.Context(), "user", models.User{Id: userID, Username: "testuser"})
@@ -49,7 +52,7 @@ func TestFriendsHandler_GetFriends(t *testing.T) {
 		mockFriendsUseCase.EXPECT().
 			GetFriendsInfo(gomock.Any(), targetUserID.String(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return([]models.FriendInfo{}, 0, nil)
-		mockWS.EXPECT().IsConnected(gomock.Any()).Return(nil, false).AnyTimes()
+		mockWS.EXPECT().IsOnline(gomock.Any()).Return(false).AnyTimes()
 
 		req := httptest.NewRequest(http.MethodGet, "/api/friends?user_id="+targetUserID.String(), nil)
 		ctx := context.WithValue(req.Context(), "user", models.User{Id: userID, Username: "testuser"})
@@ -90,7 +93,10 @@ func TestFriendsHandler_SendFriendRequest(t *testing.T) {
 
 	mockFriendsUseCase := mocks.NewMockFriendsUseCase(ctrl)
 	mockWS := wsMocks.NewMockIWebSocketConnectionManager(ctrl)
-	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS)
+	mockFriendsWS := mocks.NewMockIFriendsWSManager(ctrl)
+	mockFriendsWS.EXPECT().NotifyFriendRequestSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockFriendsWS.EXPECT().NotifyFriendRequestAccepted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS, mockFriendsWS)
 
 	userID := uuid.New()
 	receiverID := uuid.New()
@@ -169,7 +175,10 @@ func TestFriendsHandler_AcceptFriendRequest(t *testing.T) {
 
 	mockFriendsUseCase := mocks.NewMockFriendsUseCase(ctrl)
 	mockWS := wsMocks.NewMockIWebSocketConnectionManager(ctrl)
-	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS)
+	mockFriendsWS := mocks.NewMockIFriendsWSManager(ctrl)
+	mockFriendsWS.EXPECT().NotifyFriendRequestSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockFriendsWS.EXPECT().NotifyFriendRequestAccepted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS, mockFriendsWS)
 
 	userID := uuid.New()
 	receiverID := uuid.New()
@@ -237,7 +246,10 @@ func TestFriendsHandler_DeleteFriend(t *testing.T) {
 
 	mockFriendsUseCase := mocks.NewMockFriendsUseCase(ctrl)
 	mockWS := wsMocks.NewMockIWebSocketConnectionManager(ctrl)
-	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS)
+	mockFriendsWS := mocks.NewMockIFriendsWSManager(ctrl)
+	mockFriendsWS.EXPECT().NotifyFriendRequestSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockFriendsWS.EXPECT().NotifyFriendRequestAccepted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS, mockFriendsWS)
 
 	userID := uuid.New()
 	friendID := uuid.New()
@@ -305,7 +317,10 @@ func TestFriendsHandler_Unfollow(t *testing.T) {
 
 	mockFriendsUseCase := mocks.NewMockFriendsUseCase(ctrl)
 	mockWS := wsMocks.NewMockIWebSocketConnectionManager(ctrl)
-	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS)
+	mockFriendsWS := mocks.NewMockIFriendsWSManager(ctrl)
+	mockFriendsWS.EXPECT().NotifyFriendRequestSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockFriendsWS.EXPECT().NotifyFriendRequestAccepted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	handler := http2.NewFriendsHandler(mockFriendsUseCase, mockWS, mockFriendsWS)
 
 	userID := uuid.New()
 	friendID := uuid.New()
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"quickflow/gateway/internal/delivery/http/forms"
 	errors2 "quickflow/gateway/internal/errors"
 	http2 "quickflow/gateway/utils/http"
+	"quickflow/gateway/utils/validation"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 )
@@ -41,6 +43,8 @@ func NewMessageHandler(messageUseCase MessageService, authUseCase AuthUseCase, p
 // @Param chat_id path string true "Chat ID"
 // @Param posts_count query int true "Number of messages"
 // @Param ts query string false "Timestamp"
+// @Param after query string false "Load messages newer than the timestamp"
+// @Param anchor_id query string false "Load messages around the message, with cursors in both directions"
 // @Success 200 {array} forms.MessageOut "List of messages"
 // @Failure 400 {object} forms.ErrorForm "Invalid data"
 // @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
@@ -80,7 +84,23 @@ func (m *MessageHandler) GetMessagesForChat(w http.ResponseWriter, r *http.Reque
 
 	logger.Info(ctx, "Fetching feed for user %s with %d posts with timestamp %v (autogenerated: %t)", user.Username, messageForm.MessagesCount, messageForm.Ts, !r.URL.Query().Has("ts"))
 
-	messages, err := m.messageUseCase.GetMessagesForChat(ctx, chatId, messageForm.MessagesCount, messageForm.Ts, user.Id)
+	var (
+		messages []*models.Message
+		page     *models.MessagesPage
+	)
+	if messageForm.AnchorId != uuid.Nil {
+		page, err = m.messageUseCase.GetMessagesAround(ctx, chatId, messageForm.AnchorId, messageForm.MessagesCount, user.Id)
+		if err == nil {
+			messages = make([]*models.Message, len(page.Messages))
+			for i := range page.Messages {
+				messages[i] = &page.Messages[i]
+			}
+		}
+	} else if messageForm.After != nil {
+		messages, err = m.messageUseCase.GetMessagesForChatNewer(ctx, chatId, messageForm.MessagesCount, *messageForm.After, user.Id)
+	} else {
+		messages, err = m.messageUseCase.GetMessagesForChat(ctx, chatId, messageForm.MessagesCount, messageForm.Ts, user.Id)
+	}
 	if err != nil {
 		err := errors2.FromGRPCError(err)
 		logger.Error(ctx, "Failed to fetch messages: %v", err)
@@ -90,23 +110,12 @@ func (m *MessageHandler) GetMessagesForChat(w http.ResponseWriter, r *http.Reque
 
 	logger.Info(ctx, "Fetched %d messages for user %s", len(messages), user.Username)
 
-	senderIds := make([]uuid.UUID, 0, len(messages))
-	for _, message := range messages {
-		senderIds = append(senderIds, message.SenderID)
-	}
-
-	publicInfoMap := make(map[uuid.UUID]models.PublicUserInfo)
-	if len(senderIds) != 0 {
-		publicInfo, err := m.profileUseCase.GetPublicUsersInfo(ctx, senderIds)
-		if err != nil {
-			err := errors2.FromGRPCError(err)
-			logger.Error(ctx, "Error while fetching last messages users info: %v", err)
-			http2.WriteJSONError(w, err)
-			return
-		}
-		for _, info := range publicInfo {
-			publicInfoMap[info.Id] = info
-		}
+	publicInfoMap, err := m.getSendersInfo(ctx, messages)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Error while fetching last messages users info: %v", err)
+		http2.WriteJSONError(w, err)
+		return
 	}
 
 	getLastReadTs, err := m.messageUseCase.GetLastReadTs(ctx, chatId, user.Id)
@@ -117,6 +126,12 @@ func (m *MessageHandler) GetMessagesForChat(w http.ResponseWriter, r *http.Reque
 	if err == nil {
 		out.LastReadTs = getLastReadTs.Format(time2.TimeStampLayout)
 	}
+	if page != nil && page.OlderCursor != nil {
+		out.OlderCursor = page.OlderCursor.Format(time2.TimeStampLayout)
+	}
+	if page != nil && page.NewerCursor != nil {
+		out.NewerCursor = page.NewerCursor.Format(time2.TimeStampLayout)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	if _, err := easyjson.MarshalToWriter(out, w); err != nil {
@@ -125,3 +140,350 @@ func (m *MessageHandler) GetMessagesForChat(w http.ResponseWriter, r *http.Reque
 		return
 	}
 }
+
+// SearchMessages finds messages by text in all chats of the user or in one chat
+// @Summary Search messages
+// @Description Full-text search in messages of the user chats, the newest first. Matches in snippet are wrapped in mark tags
+// @Tags Messages
+// @Produce json
+// @Param string query string true "Text to search"
+// @Param count query int true "Number of results"
+// @Param chat_id query string false "Chat ID"
+// @Param from query string false "Messages created at or after"
+// @Param to query string false "Messages created before"
+// @Success 200 {array} forms.MessageSearchResultOut "Found messages"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/messages/search [get]
+func (m *MessageHandler) SearchMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while searching messages")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var searchForm forms.SearchMessagesForm
+	if err := searchForm.GetParams(r.URL.Query()); err != nil {
+		logger.Error(ctx, "Failed to parse query params: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to parse query params", http.StatusBadRequest))
+		return
+	}
+
+	results, err := m.messageUseCase.SearchMessages(ctx, user.Id, searchForm.ToSearchQuery())
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to search messages: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	messages := make([]*models.Message, len(results))
+	for i := range results {
+		messages[i] = &results[i].Message
+	}
+	publicInfoMap, err := m.getSendersInfo(ctx, messages)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Error while fetching found messages users info: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	writePayload(ctx, w, forms.ToMessageSearchResultsOut(results, publicInfoMap))
+}
+
+// GetMessageReceipts returns who of the chat participants got and read the message
+// @Summary Get message receipts
+// @Description Delivery and read times of the message for every participant except the sender, empty until it happens
+// @Tags Messages
+// @Produce json
+// @Param message_id path string true "Message ID"
+// @Success 200 {array} forms.MessageReceiptOut "Receipts"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 404 {object} forms.ErrorForm "Message not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/messages/{message_id}/receipts [get]
+func (m *MessageHandler) GetMessageReceipts(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching message receipts")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	messageId, err := uuid.Parse(mux.Vars(r)["message_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "message_id is not valid", http.StatusBadRequest))
+		return
+	}
+
+	receipts, err := m.messageUseCase.GetMessageReceipts(ctx, messageId, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to fetch message receipts: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	usersInfo := make(map[uuid.UUID]models.PublicUserInfo)
+	if len(receipts) != 0 {
+		userIds := make([]uuid.UUID, len(receipts))
+		for i, receipt := range receipts {
+			userIds[i] = receipt.UserID
+		}
+		publicInfo, err := m.profileUseCase.GetPublicUsersInfo(ctx, userIds)
+		if err != nil {
+			err := errors2.FromGRPCError(err)
+			logger.Error(ctx, "Error while fetching receipts users info: %v", err)
+			http2.WriteJSONError(w, err)
+			return
+		}
+		for _, info := range publicInfo {
+			usersInfo[info.Id] = info
+		}
+	}
+
+	writePayload(ctx, w, forms.ToMessageReceiptsOut(receipts, usersInfo))
+}
+
+// getSendersInfo loads authors of the messages and of the messages they quote or forward
+func (m *MessageHandler) getSendersInfo(ctx context.Context, messages []*models.Message) (map[uuid.UUID]models.PublicUserInfo, error) {
+	senderIds := make([]uuid.UUID, 0, len(messages))
+	for _, message := range messages {
+		senderIds = append(senderIds, message.SenderID)
+		if message.ReplyTo != nil && !message.ReplyTo.Deleted {
+			senderIds = append(senderIds, message.ReplyTo.SenderID)
+		}
+		if message.ForwardedFrom != nil {
+			senderIds = append(senderIds, message.ForwardedFrom.SenderID)
+		}
+	}
+
+	publicInfoMap := make(map[uuid.UUID]models.PublicUserInfo)
+	if len(senderIds) == 0 {
+		return publicInfoMap, nil
+	}
+	publicInfo, err := m.profileUseCase.GetPublicUsersInfo(ctx, senderIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range publicInfo {
+		publicInfoMap[info.Id] = info
+	}
+	return publicInfoMap, nil
+}
+
+// ScheduleMessage saves a message to be sent later
+// @Summary Schedule message
+// @Description Saves the message to be sent to the chat, or to the private chat with receiver_id, at send_at
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param message body forms.ScheduledMessageForm true "Message and its send time"
+// @Success 200 {object} forms.ScheduledMessageOut "Scheduled message"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not a participant in the chat"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/scheduled_messages [post]
+func (m *MessageHandler) ScheduleMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while scheduling message")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.ScheduledMessageForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode scheduled message form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode message", http.StatusBadRequest))
+		return
+	}
+	if form.ChatId == uuid.Nil && form.ReceiverId == uuid.Nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "chat_id or receiver_id is required", http.StatusBadRequest))
+		return
+	}
+
+	form.SenderId = user.Id
+	scheduled, ok := m.toScheduledMessage(ctx, w, &form)
+	if !ok {
+		return
+	}
+
+	saved, err := m.messageUseCase.ScheduleMessage(ctx, &scheduled, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to schedule message: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	logger.Info(ctx, "User %s scheduled message %s at %v", user.Username, saved.Message.ID, saved.SendAt)
+
+	m.writeScheduledMessage(ctx, w, user, *saved)
+}
+
+// GetScheduledMessages returns messages the user scheduled in the chat
+// @Summary Get scheduled messages
+// @Description Messages the user scheduled in the chat, the earliest first
+// @Tags Messages
+// @Produce json
+// @Param chat_id path string true "Chat ID"
+// @Success 200 {array} forms.ScheduledMessageOut "Scheduled messages"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/chats/{chat_id}/scheduled_messages [get]
+func (m *MessageHandler) GetScheduledMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching scheduled messages")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	chatId, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "chat_id is not valid", http.StatusBadRequest))
+		return
+	}
+
+	scheduledMessages, err := m.messageUseCase.GetScheduledMessages(ctx, chatId, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to fetch scheduled messages: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	senderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to get user %s public info: %v", user.Id, err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	writePayload(ctx, w, forms.ToScheduledMessagesOut(scheduledMessages, senderInfo))
+}
+
+// UpdateScheduledMessage changes the scheduled message
+// @Summary Update scheduled message
+// @Description Changes text, attachments and send time of the message that is not sent yet, chat_id and receiver_id are ignored
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param message_id path string true "Scheduled message ID"
+// @Param message body forms.ScheduledMessageForm true "Message and its send time"
+// @Success 200 {object} forms.ScheduledMessageOut "Scheduled message"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not the sender"
+// @Failure 404 {object} forms.ErrorForm "Message is already sent or cancelled"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/scheduled_messages/{message_id} [put]
+func (m *MessageHandler) UpdateScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while updating scheduled message")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	messageId, err := uuid.Parse(mux.Vars(r)["message_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "message_id is not valid", http.StatusBadRequest))
+		return
+	}
+
+	var form forms.ScheduledMessageForm
+	if err = easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to decode scheduled message form: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to decode message", http.StatusBadRequest))
+		return
+	}
+
+	form.SenderId = user.Id
+	scheduled, ok := m.toScheduledMessage(ctx, w, &form)
+	if !ok {
+		return
+	}
+	scheduled.Message.ID = messageId
+
+	updated, err := m.messageUseCase.UpdateScheduledMessage(ctx, &scheduled, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to update scheduled message: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	logger.Info(ctx, "User %s updated scheduled message %s", user.Username, messageId)
+
+	m.writeScheduledMessage(ctx, w, user, *updated)
+}
+
+// CancelScheduledMessage deletes the scheduled message before it is sent
+// @Summary Cancel scheduled message
+// @Tags Messages
+// @Param message_id path string true "Scheduled message ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 403 {object} forms.ErrorForm "User is not the sender"
+// @Failure 404 {object} forms.ErrorForm "Message is already sent or cancelled"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/scheduled_messages/{message_id} [delete]
+func (m *MessageHandler) CancelScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := http2.SetRequestId(r.Context())
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while cancelling scheduled message")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	messageId, err := uuid.Parse(mux.Vars(r)["message_id"])
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "message_id is not valid", http.StatusBadRequest))
+		return
+	}
+
+	if err = m.messageUseCase.CancelScheduledMessage(ctx, messageId, user.Id); err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to cancel scheduled message: %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	logger.Info(ctx, "User %s cancelled scheduled message %s", user.Username, messageId)
+}
+
+// toScheduledMessage converts and validates the form, writing the error when it is invalid
+func (m *MessageHandler) toScheduledMessage(ctx context.Context, w http.ResponseWriter, form *forms.ScheduledMessageForm) (models.ScheduledMessage, bool) {
+	scheduled, err := form.ToScheduledMessageModel()
+	if err != nil {
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, err.Error(), http.StatusBadRequest))
+		return models.ScheduledMessage{}, false
+	}
+	if err = validation.ValidateMessage(scheduled.Message); err != nil {
+		logger.Info(ctx, "Invalid scheduled message: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, err.Error(), http.StatusBadRequest))
+		return models.ScheduledMessage{}, false
+	}
+	return scheduled, true
+}
+
+func (m *MessageHandler) writeScheduledMessage(ctx context.Context, w http.ResponseWriter, user models.User, scheduled models.ScheduledMessage) {
+	senderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, user.Id)
+	if err != nil {
+		err := errors2.FromGRPCError(err)
+		logger.Error(ctx, "Failed to get user %s public info: %v", user.Id, err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	writePayload(ctx, w, forms.ToScheduledMessagesOut([]models.ScheduledMessage{scheduled}, senderInfo)[0])
+}
@@ -24,20 +24,39 @@ import (
 type MessageService interface {
 	GetMessageById(ctx context.Context, messageId uuid.UUID) (*models.Message, error)
 	GetMessagesForChat(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time, userId uuid.UUID) ([]*models.Message, error)
+	GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, numMessages int, after time.Time, userId uuid.UUID) ([]*models.Message, error)
+	GetMessagesAround(ctx context.Context, chatId, anchorId uuid.UUID, numMessages int, userId uuid.UUID) (*models.MessagesPage, error)
 	SendMessage(ctx context.Context, message *models.Message, userId uuid.UUID) (*models.Message, error)
-	DeleteMessage(ctx context.Context, messageId uuid.UUID) error
+	UpdateMessage(ctx context.Context, message *models.Message, userId uuid.UUID) (*models.Message, int64, error)
+	ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]*models.Message, error)
+	DeleteMessage(ctx context.Context, messageId, userId uuid.UUID) (int64, error)
+	AddReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error)
+	RemoveReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error)
+	PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error)
+	UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error)
+	VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error)
+	SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error)
+	ScheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error)
+	GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error)
+	UpdateScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error
 	GetLastReadTs(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (time.Time, error)
-	UpdateLastReadTs(ctx context.Context, chatId uuid.UUID, userId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) error
+	UpdateLastReadTs(ctx context.Context, chatId, userId, messageId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) (int64, error)
+	UpdateLastDeliveredTs(ctx context.Context, chatId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) (bool, error)
+	GetMessageReceipts(ctx context.Context, messageId, userAuthId uuid.UUID) ([]models.MessageReceipt, error)
 	GetNumUnreadMessages(ctx context.Context, chatId, userId uuid.UUID) (int, error)
+	GetChatEventsSince(ctx context.Context, chatId uuid.UUID, seq int64, limit int, userAuthId uuid.UUID) ([]models.ChatEvent, error)
 }
 
 type CommandHandler func(ctx context.Context, user models.User, payload json.RawMessage) error
 
 // IWebSocketConnectionManager интерфейс для управления соединениями
 type IWebSocketConnectionManager interface {
-	AddConnection(userId uuid.UUID, conn *websocket.Conn)
-	RemoveAndCloseConnection(userId uuid.UUID)
-	IsConnected(userId uuid.UUID) (*websocket.Conn, bool)
+	AddConnection(userId, connId uuid.UUID, conn *websocket.Conn)
+	RemoveAndCloseConnection(userId, connId uuid.UUID)
+	IsConnected(userId, connId uuid.UUID) (*websocket.Conn, bool)
+	IsOnline(userId uuid.UUID) bool
+	WriteToConnection(userId, connId uuid.UUID, messageType int, data []byte) error
 }
 
 type IWebSocketRouter interface {
@@ -83,7 +102,14 @@ func (m *MessageListenerWS) HandleMessages(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	conn, found := m.WebSocketManager.IsConnected(user.Id)
+	connId, ok := ctx.Value("wsConnId").(uuid.UUID)
+	if !ok {
+		logger.Error(ctx, "Failed to get WebSocket connection id from context")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get WebSocket connection id", http.StatusInternalServerError))
+		return
+	}
+
+	conn, found := m.WebSocketManager.IsConnected(user.Id, connId)
 	if !found {
 		logger.Error(ctx, "WebSocket connection not found for user: %s", user.Id)
 		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "WebSocket connection not found", http.StatusInternalServerError))
@@ -114,20 +140,25 @@ func (m *MessageListenerWS) HandleMessages(w http.ResponseWriter, r *http.Reques
 
 		if err := json.Unmarshal(msg, &messageRequest); err != nil {
 			logger.Error(ctx, "Failed to unmarshal WS message: %v", err)
-			writeErrorToWS(conn, fmt.Sprintf("Invalid message format: %v", err))
+			m.writeErrorToWS(user.Id, connId, fmt.Sprintf("Invalid message format: %v", err))
 			continue
 		}
 
 		if err := m.WebSocketRouter.Route(ctx, messageRequest.Type, user, messageRequest.Payload); err != nil {
 			logger.Error(ctx, "Failed to route WS message: %v", err)
-			writeErrorToWS(conn, fmt.Sprintf("Failed to process message: %v", err))
+			m.writeErrorToWS(user.Id, connId, fmt.Sprintf("Failed to process message: %v", err))
 			continue
 		}
 	}
 }
 
-func writeErrorToWS(conn *websocket.Conn, errMsg string) {
-	if err := conn.WriteJSON(forms.ErrorForm{ErrorCode: errMsg}); err != nil {
+func (m *MessageListenerWS) writeErrorToWS(userId, connId uuid.UUID, errMsg string) {
+	out, err := json.Marshal(forms.ErrorForm{ErrorCode: errMsg})
+	if err != nil {
+		log.Printf("Failed to marshal WS error message: %v", err)
+		return
+	}
+	if err = m.WebSocketManager.WriteToConnection(userId, connId, websocket.TextMessage, out); err != nil {
 		log.Printf("Failed to send WS error message: %v", err)
 	}
 }
@@ -2,9 +2,12 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	time2 "quickflow/config/time"
 	"quickflow/gateway/internal/delivery/http/forms"
 	"quickflow/gateway/internal/delivery/http/mocks"
 	errors2 "quickflow/gateway/internal/errors"
@@ -136,3 +140,261 @@ func TestMessageHandler_GetMessagesForChat(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageHandler_GetMessagesForChat_Anchor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatID := uuid.New()
+	userID := uuid.New()
+	anchor := models.Message{ID: uuid.New(), ChatID: chatID, SenderID: userID, CreatedAt: time.Now()}
+	olderCursor := anchor.CreatedAt.Add(-time.Hour)
+
+	// Создаем моки
+	mockMessageService := mocks.NewMockMessageService(ctrl)
+	mockProfileUseCase := mocks.NewMockProfileUseCase(ctrl)
+	mockMessageService.EXPECT().GetMessagesAround(gomock.Any(), chatID, anchor.ID, 20, userID).
+		Return(&models.MessagesPage{Messages: []models.Message{anchor}, OlderCursor: &olderCursor}, nil)
+	mockProfileUseCase.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{userID}).
+		Return([]models.PublicUserInfo{{Id: userID, Username: "testuser"}}, nil)
+	mockMessageService.EXPECT().GetLastReadTs(gomock.Any(), chatID, userID).Return(time.Time{}, errors.New("not read"))
+
+	handler := &MessageHandler{
+		messageUseCase: mockMessageService,
+		profileUseCase: mockProfileUseCase,
+	}
+
+	query := url.Values{"messages_count": []string{"20"}, "anchor_id": []string{anchor.ID.String()}}
+	req := httptest.NewRequest(http.MethodGet, "/api/chats/"+chatID.String()+"/messages?"+query.Encode(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: userID, Username: "testuser"}))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+	rr := httptest.NewRecorder()
+
+	handler.GetMessagesForChat(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var out forms.MessagesOut
+	assert.NoError(t, easyjson.Unmarshal(rr.Body.Bytes(), &out))
+	if assert.Len(t, out.Messages, 1) {
+		assert.Equal(t, anchor.ID, out.Messages[0].ID)
+	}
+	assert.NotEmpty(t, out.OlderCursor)
+	assert.Empty(t, out.NewerCursor)
+}
+
+func TestMessageHandler_SearchMessages(t *testing.T) {
+	userID := uuid.New()
+	senderID := uuid.New()
+	testUser := models.User{Id: userID, Username: "testuser"}
+	found := models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: senderID, Text: "the report is ready", Seq: 5}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*mocks.MockMessageService, *mocks.MockProfileUseCase)
+		expectedStatus int
+	}{
+		{
+			name:           "missing search string",
+			query:          "count=20",
+			setupMocks:     func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "success",
+			query: "string=report&count=20",
+			setupMocks: func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {
+				ms.EXPECT().SearchMessages(gomock.Any(), userID, models.MessageSearchQuery{Text: "report", Limit: 20}).
+					Return([]models.MessageSearchResult{{
+						Message: found,
+						Snippet: "the " + models.SearchMatchStart + "report" + models.SearchMatchEnd,
+					}}, nil)
+				pu.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{senderID}).
+					Return([]models.PublicUserInfo{{Id: senderID, Username: "sender"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Создаем моки
+			mockMessageService := mocks.NewMockMessageService(ctrl)
+			mockProfileUseCase := mocks.NewMockProfileUseCase(ctrl)
+			tt.setupMocks(mockMessageService, mockProfileUseCase)
+
+			handler := &MessageHandler{
+				messageUseCase: mockMessageService,
+				profileUseCase: mockProfileUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/messages/search?"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), "user", testUser))
+			rr := httptest.NewRecorder()
+
+			handler.SearchMessages(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var out forms.PayloadWrapper[[]forms.MessageSearchResultOut]
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &out))
+				if assert.Len(t, out.Payload, 1) {
+					assert.Equal(t, found.ID, out.Payload[0].Message.ID)
+					assert.Equal(t, "sender", out.Payload[0].Message.Sender.Username)
+					assert.Equal(t, "the <mark>report</mark>", out.Payload[0].Snippet)
+				}
+			}
+		})
+	}
+}
+
+func TestMessageHandler_ScheduleMessage(t *testing.T) {
+	userID := uuid.New()
+	chatID := uuid.New()
+	testUser := models.User{Id: userID, Username: "testuser"}
+	sendAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*mocks.MockMessageService, *mocks.MockProfileUseCase)
+		expectedStatus int
+	}{
+		{
+			name:           "no chat and receiver",
+			body:           `{"text":"hi","send_at":"` + sendAt.Format(time2.TimeStampLayout) + `"}`,
+			setupMocks:     func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid send_at",
+			body:           `{"text":"hi","chat_id":"` + chatID.String() + `","send_at":"tomorrow"}`,
+			setupMocks:     func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "success",
+			body: `{"text":"hi","chat_id":"` + chatID.String() + `","send_at":"` + sendAt.Format(time2.TimeStampLayout) + `"}`,
+			setupMocks: func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {
+				ms.EXPECT().ScheduleMessage(gomock.Any(), gomock.Any(), userID).
+					DoAndReturn(func(_ context.Context, scheduled *models.ScheduledMessage, _ uuid.UUID) (*models.ScheduledMessage, error) {
+						assert.Equal(t, chatID, scheduled.Message.ChatID)
+						assert.Equal(t, userID, scheduled.Message.SenderID)
+						assert.True(t, sendAt.Equal(scheduled.SendAt))
+						return scheduled, nil
+					})
+				pu.EXPECT().GetPublicUserInfo(gomock.Any(), userID).Return(models.PublicUserInfo{Id: userID, Username: "testuser"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "not a participant",
+			body: `{"text":"hi","chat_id":"` + chatID.String() + `","send_at":"` + sendAt.Format(time2.TimeStampLayout) + `"}`,
+			setupMocks: func(ms *mocks.MockMessageService, pu *mocks.MockProfileUseCase) {
+				ms.EXPECT().ScheduleMessage(gomock.Any(), gomock.Any(), userID).
+					Return(nil, errors2.New("NOT_PARTICIPANT", "not a participant", http.StatusForbidden))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Создаем моки
+			mockMessageService := mocks.NewMockMessageService(ctrl)
+			mockProfileUseCase := mocks.NewMockProfileUseCase(ctrl)
+			tt.setupMocks(mockMessageService, mockProfileUseCase)
+
+			handler := &MessageHandler{
+				messageUseCase: mockMessageService,
+				profileUseCase: mockProfileUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/scheduled_messages", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), "user", testUser))
+			rr := httptest.NewRecorder()
+
+			handler.ScheduleMessage(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var out forms.PayloadWrapper[forms.ScheduledMessageOut]
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &out))
+				assert.Equal(t, "hi", out.Payload.Message.Text)
+				assert.Equal(t, sendAt.Format(time2.TimeStampLayout), out.Payload.SendAt)
+			}
+		})
+	}
+}
+
+func TestMessageHandler_CancelScheduledMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	messageID := uuid.New()
+
+	mockMessageService := mocks.NewMockMessageService(ctrl)
+	mockMessageService.EXPECT().CancelScheduledMessage(gomock.Any(), messageID, userID).Return(nil)
+
+	handler := &MessageHandler{messageUseCase: mockMessageService}
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/scheduled_messages/"+messageID.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: userID, Username: "testuser"}))
+	req = mux.SetURLVars(req, map[string]string{"message_id": messageID.String()})
+	rr := httptest.NewRecorder()
+
+	handler.CancelScheduledMessage(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMessageHandler_GetMessageReceipts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	readerID := uuid.New()
+	receiverID := uuid.New()
+	messageID := uuid.New()
+	readAt := time.Now()
+
+	// Создаем моки
+	mockMessageService := mocks.NewMockMessageService(ctrl)
+	mockProfileUseCase := mocks.NewMockProfileUseCase(ctrl)
+	mockMessageService.EXPECT().GetMessageReceipts(gomock.Any(), messageID, userID).
+		Return([]models.MessageReceipt{
+			{UserID: readerID, DeliveredAt: &readAt, ReadAt: &readAt},
+			{UserID: receiverID},
+		}, nil)
+	mockProfileUseCase.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{readerID, receiverID}).
+		Return([]models.PublicUserInfo{{Id: readerID, Username: "reader"}}, nil)
+
+	handler := &MessageHandler{
+		messageUseCase: mockMessageService,
+		profileUseCase: mockProfileUseCase,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/messages/"+messageID.String()+"/receipts", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: userID, Username: "testuser"}))
+	req = mux.SetURLVars(req, map[string]string{"message_id": messageID.String()})
+	rr := httptest.NewRecorder()
+
+	handler.GetMessageReceipts(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var out forms.PayloadWrapper[[]forms.MessageReceiptOut]
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &out))
+	if assert.Len(t, out.Payload, 2) {
+		assert.Equal(t, "reader", out.Payload[0].User.Username)
+		assert.Equal(t, readAt.Format(time2.TimeStampLayout), out.Payload[0].ReadAt)
+		assert.Equal(t, receiverID.String(), out.Payload[1].User.ID)
+		assert.Empty(t, out.Payload[1].DeliveredAt)
+	}
+}
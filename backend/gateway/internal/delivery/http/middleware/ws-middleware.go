@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 
 	http2 "quickflow/gateway/internal/delivery/http"
@@ -43,12 +44,17 @@ func WebSocketMiddleware(connManager http2.IWebSocketConnectionManager, handler
 
 			logger.Info(context.Background(), "[MIDDLEWARE] WebSocket connection established")
 
+			// у пользователя может быть несколько соединений (вкладки, устройства),
+			// поэтому каждое соединение получает свой идентификатор
+			connId := uuid.New()
+
 			// Устанавливаем WebSocket соединение и пользователя в контекст запроса
 			ctx := context.WithValue(r.Context(), "wsConn", conn)
+			ctx = context.WithValue(ctx, "wsConnId", connId)
 			ctx = context.WithValue(ctx, "user", user)
 			r = r.WithContext(ctx)
 
-			connManager.AddConnection(user.Id, conn)
+			connManager.AddConnection(user.Id, connId, conn)
 
 			// Обрабатываем ping/pong сообщения
 			handler.Handle(ctx, conn)
@@ -56,7 +62,7 @@ func WebSocketMiddleware(connManager http2.IWebSocketConnectionManager, handler
 			// Передаем управление следующему обработчику
 			defer func() {
 				logger.Info(context.Background(), "[MIDDLEWARE] Closing WebSocket connection")
-				connManager.RemoveAndCloseConnection(user.Id)
+				connManager.RemoveAndCloseConnection(user.Id, connId)
 			}()
 			next.ServeHTTP(w, r)
 		})
@@ -228,3 +228,40 @@ func (mr *MockCommunityServiceMockRecorder) UpdateCommunity(ctx, community, user
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommunity", reflect.TypeOf((*MockCommunityService)(nil).UpdateCommunity), ctx, community, userId)
 }
+
+// MockWSCommunityHandler is a mock of WSCommunityHandler interface.
+type MockWSCommunityHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWSCommunityHandlerMockRecorder
+}
+
+// MockWSCommunityHandlerMockRecorder is the mock recorder for MockWSCommunityHandler.
+type MockWSCommunityHandlerMockRecorder struct {
+	mock *MockWSCommunityHandler
+}
+
+// NewMockWSCommunityHandler creates a new mock instance.
+func NewMockWSCommunityHandler(ctrl *gomock.Controller) *MockWSCommunityHandler {
+	mock := &MockWSCommunityHandler{ctrl: ctrl}
+	mock.recorder = &MockWSCommunityHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWSCommunityHandler) EXPECT() *MockWSCommunityHandlerMockRecorder {
+	return m.recorder
+}
+
+// NotifyCommunityRoleChanged mocks base method.
+func (m *MockWSCommunityHandler) NotifyCommunityRoleChanged(ctx context.Context, senderId, receiverId uuid.UUID, community *models.Community, role models.CommunityRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyCommunityRoleChanged", ctx, senderId, receiverId, community, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyCommunityRoleChanged indicates an expected call of NotifyCommunityRoleChanged.
+func (mr *MockWSCommunityHandlerMockRecorder) NotifyCommunityRoleChanged(ctx, senderId, receiverId, community, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyCommunityRoleChanged", reflect.TypeOf((*MockWSCommunityHandler)(nil).NotifyCommunityRoleChanged), ctx, senderId, receiverId, community, role)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//gateway/internal/delivery/http/device-key-handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "quickflow/shared/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockDeviceKeyUseCase is a mock of DeviceKeyUseCase interface.
+type MockDeviceKeyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceKeyUseCaseMockRecorder
+}
+
+// MockDeviceKeyUseCaseMockRecorder is the mock recorder for MockDeviceKeyUseCase.
+type MockDeviceKeyUseCaseMockRecorder struct {
+	mock *MockDeviceKeyUseCase
+}
+
+// NewMockDeviceKeyUseCase creates a new mock instance.
+func NewMockDeviceKeyUseCase(ctrl *gomock.Controller) *MockDeviceKeyUseCase {
+	mock := &MockDeviceKeyUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeviceKeyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceKeyUseCase) EXPECT() *MockDeviceKeyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetUserDeviceKeys mocks base method.
+func (m *MockDeviceKeyUseCase) GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]models.DeviceKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDeviceKeys", ctx, userId)
+	ret0, _ := ret[0].([]models.DeviceKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDeviceKeys indicates an expected call of GetUserDeviceKeys.
+func (mr *MockDeviceKeyUseCaseMockRecorder) GetUserDeviceKeys(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDeviceKeys", reflect.TypeOf((*MockDeviceKeyUseCase)(nil).GetUserDeviceKeys), ctx, userId)
+}
+
+// RemoveDevice mocks base method.
+func (m *MockDeviceKeyUseCase) RemoveDevice(ctx context.Context, userId uuid.UUID, deviceId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDevice", ctx, userId, deviceId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDevice indicates an expected call of RemoveDevice.
+func (mr *MockDeviceKeyUseCaseMockRecorder) RemoveDevice(ctx, userId, deviceId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDevice", reflect.TypeOf((*MockDeviceKeyUseCase)(nil).RemoveDevice), ctx, userId, deviceId)
+}
+
+// RotateSignedPrekey mocks base method.
+func (m *MockDeviceKeyUseCase) RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey models.SignedPrekey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSignedPrekey", ctx, userId, deviceId, prekey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSignedPrekey indicates an expected call of RotateSignedPrekey.
+func (mr *MockDeviceKeyUseCaseMockRecorder) RotateSignedPrekey(ctx, userId, deviceId, prekey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSignedPrekey", reflect.TypeOf((*MockDeviceKeyUseCase)(nil).RotateSignedPrekey), ctx, userId, deviceId, prekey)
+}
+
+// UploadDeviceKeys mocks base method.
+func (m *MockDeviceKeyUseCase) UploadDeviceKeys(ctx context.Context, keys models.DeviceKeys) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadDeviceKeys", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadDeviceKeys indicates an expected call of UploadDeviceKeys.
+func (mr *MockDeviceKeyUseCaseMockRecorder) UploadDeviceKeys(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDeviceKeys", reflect.TypeOf((*MockDeviceKeyUseCase)(nil).UploadDeviceKeys), ctx, keys)
+}
@@ -168,3 +168,18 @@ func (mr *MockPostServiceMockRecorder) UpdatePost(ctx, update, userId interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostService)(nil).UpdatePost), ctx, update, userId)
 }
+
+// VotePoll mocks base method.
+func (m *MockPostService) VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", ctx, postId, userId, options)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockPostServiceMockRecorder) VotePoll(ctx, postId, userId, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockPostService)(nil).VotePoll), ctx, postId, userId, options)
+}
@@ -136,3 +136,54 @@ func (mr *MockFriendsUseCaseMockRecorder) Unfollow(ctx, userID, friendID interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockFriendsUseCase)(nil).Unfollow), ctx, userID, friendID)
 }
+
+// MockIFriendsWSManager is a mock of IFriendsWSManager interface.
+type MockIFriendsWSManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFriendsWSManagerMockRecorder
+}
+
+// MockIFriendsWSManagerMockRecorder is the mock recorder for MockIFriendsWSManager.
+type MockIFriendsWSManagerMockRecorder struct {
+	mock *MockIFriendsWSManager
+}
+
+// NewMockIFriendsWSManager creates a new mock instance.
+func NewMockIFriendsWSManager(ctrl *gomock.Controller) *MockIFriendsWSManager {
+	mock := &MockIFriendsWSManager{ctrl: ctrl}
+	mock.recorder = &MockIFriendsWSManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFriendsWSManager) EXPECT() *MockIFriendsWSManagerMockRecorder {
+	return m.recorder
+}
+
+// NotifyFriendRequestAccepted mocks base method.
+func (m *MockIFriendsWSManager) NotifyFriendRequestAccepted(ctx context.Context, senderId, receiverId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyFriendRequestAccepted", ctx, senderId, receiverId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyFriendRequestAccepted indicates an expected call of NotifyFriendRequestAccepted.
+func (mr *MockIFriendsWSManagerMockRecorder) NotifyFriendRequestAccepted(ctx, senderId, receiverId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFriendRequestAccepted", reflect.TypeOf((*MockIFriendsWSManager)(nil).NotifyFriendRequestAccepted), ctx, senderId, receiverId)
+}
+
+// NotifyFriendRequestSent mocks base method.
+func (m *MockIFriendsWSManager) NotifyFriendRequestSent(ctx context.Context, senderId, receiverId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyFriendRequestSent", ctx, senderId, receiverId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyFriendRequestSent indicates an expected call of NotifyFriendRequestSent.
+func (mr *MockIFriendsWSManagerMockRecorder) NotifyFriendRequestSent(ctx, senderId, receiverId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFriendRequestSent", reflect.TypeOf((*MockIFriendsWSManager)(nil).NotifyFriendRequestSent), ctx, senderId, receiverId)
+}
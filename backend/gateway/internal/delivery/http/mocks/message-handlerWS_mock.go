@@ -39,18 +39,79 @@ func (m *MockMessageService) EXPECT() *MockMessageServiceMockRecorder {
 	return m.recorder
 }
 
-// DeleteMessage mocks base method.
-func (m *MockMessageService) DeleteMessage(ctx context.Context, messageId uuid.UUID) error {
+// AddReaction mocks base method.
+func (m *MockMessageService) AddReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, messageId, emoji, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageServiceMockRecorder) AddReaction(ctx, messageId, emoji, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageService)(nil).AddReaction), ctx, messageId, emoji, userId)
+}
+
+// CancelScheduledMessage mocks base method.
+func (m *MockMessageService) CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageId)
+	ret := m.ctrl.Call(m, "CancelScheduledMessage", ctx, id, userId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CancelScheduledMessage indicates an expected call of CancelScheduledMessage.
+func (mr *MockMessageServiceMockRecorder) CancelScheduledMessage(ctx, id, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledMessage", reflect.TypeOf((*MockMessageService)(nil).CancelScheduledMessage), ctx, id, userId)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockMessageService) DeleteMessage(ctx context.Context, messageId, userId uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // DeleteMessage indicates an expected call of DeleteMessage.
-func (mr *MockMessageServiceMockRecorder) DeleteMessage(ctx, messageId interface{}) *gomock.Call {
+func (mr *MockMessageServiceMockRecorder) DeleteMessage(ctx, messageId, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageService)(nil).DeleteMessage), ctx, messageId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageService)(nil).DeleteMessage), ctx, messageId, userId)
+}
+
+// ForwardMessages mocks base method.
+func (m *MockMessageService) ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardMessages", ctx, messageIds, chatId, receiverId, userId)
+	ret0, _ := ret[0].([]*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForwardMessages indicates an expected call of ForwardMessages.
+func (mr *MockMessageServiceMockRecorder) ForwardMessages(ctx, messageIds, chatId, receiverId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardMessages", reflect.TypeOf((*MockMessageService)(nil).ForwardMessages), ctx, messageIds, chatId, receiverId, userId)
+}
+
+// GetChatEventsSince mocks base method.
+func (m *MockMessageService) GetChatEventsSince(ctx context.Context, chatId uuid.UUID, seq int64, limit int, userAuthId uuid.UUID) ([]models.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatEventsSince", ctx, chatId, seq, limit, userAuthId)
+	ret0, _ := ret[0].([]models.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEventsSince indicates an expected call of GetChatEventsSince.
+func (mr *MockMessageServiceMockRecorder) GetChatEventsSince(ctx, chatId, seq, limit, userAuthId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEventsSince", reflect.TypeOf((*MockMessageService)(nil).GetChatEventsSince), ctx, chatId, seq, limit, userAuthId)
 }
 
 // GetLastReadTs mocks base method.
@@ -83,6 +144,36 @@ func (mr *MockMessageServiceMockRecorder) GetMessageById(ctx, messageId interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockMessageService)(nil).GetMessageById), ctx, messageId)
 }
 
+// GetMessageReceipts mocks base method.
+func (m *MockMessageService) GetMessageReceipts(ctx context.Context, messageId, userAuthId uuid.UUID) ([]models.MessageReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReceipts", ctx, messageId, userAuthId)
+	ret0, _ := ret[0].([]models.MessageReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReceipts indicates an expected call of GetMessageReceipts.
+func (mr *MockMessageServiceMockRecorder) GetMessageReceipts(ctx, messageId, userAuthId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReceipts", reflect.TypeOf((*MockMessageService)(nil).GetMessageReceipts), ctx, messageId, userAuthId)
+}
+
+// GetMessagesAround mocks base method.
+func (m *MockMessageService) GetMessagesAround(ctx context.Context, chatId, anchorId uuid.UUID, numMessages int, userId uuid.UUID) (*models.MessagesPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesAround", ctx, chatId, anchorId, numMessages, userId)
+	ret0, _ := ret[0].(*models.MessagesPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesAround indicates an expected call of GetMessagesAround.
+func (mr *MockMessageServiceMockRecorder) GetMessagesAround(ctx, chatId, anchorId, numMessages, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesAround", reflect.TypeOf((*MockMessageService)(nil).GetMessagesAround), ctx, chatId, anchorId, numMessages, userId)
+}
+
 // GetMessagesForChat mocks base method.
 func (m *MockMessageService) GetMessagesForChat(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time, userId uuid.UUID) ([]*models.Message, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +189,21 @@ func (mr *MockMessageServiceMockRecorder) GetMessagesForChat(ctx, chatId, numMes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChat", reflect.TypeOf((*MockMessageService)(nil).GetMessagesForChat), ctx, chatId, numMessages, timestamp, userId)
 }
 
+// GetMessagesForChatNewer mocks base method.
+func (m *MockMessageService) GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, numMessages int, after time.Time, userId uuid.UUID) ([]*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesForChatNewer", ctx, chatId, numMessages, after, userId)
+	ret0, _ := ret[0].([]*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesForChatNewer indicates an expected call of GetMessagesForChatNewer.
+func (mr *MockMessageServiceMockRecorder) GetMessagesForChatNewer(ctx, chatId, numMessages, after, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChatNewer", reflect.TypeOf((*MockMessageService)(nil).GetMessagesForChatNewer), ctx, chatId, numMessages, after, userId)
+}
+
 // GetNumUnreadMessages mocks base method.
 func (m *MockMessageService) GetNumUnreadMessages(ctx context.Context, chatId, userId uuid.UUID) (int, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +219,83 @@ func (mr *MockMessageServiceMockRecorder) GetNumUnreadMessages(ctx, chatId, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadMessages", reflect.TypeOf((*MockMessageService)(nil).GetNumUnreadMessages), ctx, chatId, userId)
 }
 
+// GetScheduledMessages mocks base method.
+func (m *MockMessageService) GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMessages", ctx, chatId, userId)
+	ret0, _ := ret[0].([]models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessages indicates an expected call of GetScheduledMessages.
+func (mr *MockMessageServiceMockRecorder) GetScheduledMessages(ctx, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessages", reflect.TypeOf((*MockMessageService)(nil).GetScheduledMessages), ctx, chatId, userId)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageService) PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageServiceMockRecorder) PinMessage(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageService)(nil).PinMessage), ctx, messageId, userId)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageService) RemoveReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, messageId, emoji, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageServiceMockRecorder) RemoveReaction(ctx, messageId, emoji, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageService)(nil).RemoveReaction), ctx, messageId, emoji, userId)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockMessageService) ScheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMessage", ctx, scheduled, userId)
+	ret0, _ := ret[0].(*models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockMessageServiceMockRecorder) ScheduleMessage(ctx, scheduled, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockMessageService)(nil).ScheduleMessage), ctx, scheduled, userId)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageService) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userId, query)
+	ret0, _ := ret[0].([]models.MessageSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageServiceMockRecorder) SearchMessages(ctx, userId, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageService)(nil).SearchMessages), ctx, userId, query)
+}
+
 // SendMessage mocks base method.
 func (m *MockMessageService) SendMessage(ctx context.Context, message *models.Message, userId uuid.UUID) (*models.Message, error) {
 	m.ctrl.T.Helper()
@@ -128,18 +311,96 @@ func (mr *MockMessageServiceMockRecorder) SendMessage(ctx, message, userId inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockMessageService)(nil).SendMessage), ctx, message, userId)
 }
 
+// UnpinMessage mocks base method.
+func (m *MockMessageService) UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageServiceMockRecorder) UnpinMessage(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageService)(nil).UnpinMessage), ctx, messageId, userId)
+}
+
+// UpdateLastDeliveredTs mocks base method.
+func (m *MockMessageService) UpdateLastDeliveredTs(ctx context.Context, chatId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastDeliveredTs", ctx, chatId, timestamp, userAuthId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastDeliveredTs indicates an expected call of UpdateLastDeliveredTs.
+func (mr *MockMessageServiceMockRecorder) UpdateLastDeliveredTs(ctx, chatId, timestamp, userAuthId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastDeliveredTs", reflect.TypeOf((*MockMessageService)(nil).UpdateLastDeliveredTs), ctx, chatId, timestamp, userAuthId)
+}
+
 // UpdateLastReadTs mocks base method.
-func (m *MockMessageService) UpdateLastReadTs(ctx context.Context, chatId, userId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) error {
+func (m *MockMessageService) UpdateLastReadTs(ctx context.Context, chatId, userId, messageId uuid.UUID, timestamp time.Time, userAuthId uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, chatId, userId, timestamp, userAuthId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, chatId, userId, messageId, timestamp, userAuthId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateLastReadTs indicates an expected call of UpdateLastReadTs.
-func (mr *MockMessageServiceMockRecorder) UpdateLastReadTs(ctx, chatId, userId, timestamp, userAuthId interface{}) *gomock.Call {
+func (mr *MockMessageServiceMockRecorder) UpdateLastReadTs(ctx, chatId, userId, messageId, timestamp, userAuthId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageService)(nil).UpdateLastReadTs), ctx, chatId, userId, timestamp, userAuthId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageService)(nil).UpdateLastReadTs), ctx, chatId, userId, messageId, timestamp, userAuthId)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockMessageService) UpdateMessage(ctx context.Context, message *models.Message, userId uuid.UUID) (*models.Message, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ctx, message, userId)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageServiceMockRecorder) UpdateMessage(ctx, message, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageService)(nil).UpdateMessage), ctx, message, userId)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockMessageService) UpdateScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", ctx, scheduled, userId)
+	ret0, _ := ret[0].(*models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockMessageServiceMockRecorder) UpdateScheduledMessage(ctx, scheduled, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockMessageService)(nil).UpdateScheduledMessage), ctx, scheduled, userId)
+}
+
+// VotePoll mocks base method.
+func (m *MockMessageService) VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", ctx, messageId, options, userId)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockMessageServiceMockRecorder) VotePoll(ctx, messageId, options, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockMessageService)(nil).VotePoll), ctx, messageId, options, userId)
 }
 
 // MockIWebSocketConnectionManager is a mock of IWebSocketConnectionManager interface.
@@ -166,42 +427,70 @@ func (m *MockIWebSocketConnectionManager) EXPECT() *MockIWebSocketConnectionMana
 }
 
 // AddConnection mocks base method.
-func (m *MockIWebSocketConnectionManager) AddConnection(userId uuid.UUID, conn *websocket.Conn) {
+func (m *MockIWebSocketConnectionManager) AddConnection(userId, connId uuid.UUID, conn *websocket.Conn) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "AddConnection", userId, conn)
+	m.ctrl.Call(m, "AddConnection", userId, connId, conn)
 }
 
 // AddConnection indicates an expected call of AddConnection.
-func (mr *MockIWebSocketConnectionManagerMockRecorder) AddConnection(userId, conn interface{}) *gomock.Call {
+func (mr *MockIWebSocketConnectionManagerMockRecorder) AddConnection(userId, connId, conn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConnection", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).AddConnection), userId, conn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConnection", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).AddConnection), userId, connId, conn)
 }
 
 // IsConnected mocks base method.
-func (m *MockIWebSocketConnectionManager) IsConnected(userId uuid.UUID) (*websocket.Conn, bool) {
+func (m *MockIWebSocketConnectionManager) IsConnected(userId, connId uuid.UUID) (*websocket.Conn, bool) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsConnected", userId)
+	ret := m.ctrl.Call(m, "IsConnected", userId, connId)
 	ret0, _ := ret[0].(*websocket.Conn)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
 
 // IsConnected indicates an expected call of IsConnected.
-func (mr *MockIWebSocketConnectionManagerMockRecorder) IsConnected(userId interface{}) *gomock.Call {
+func (mr *MockIWebSocketConnectionManagerMockRecorder) IsConnected(userId, connId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsConnected", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).IsConnected), userId, connId)
+}
+
+// IsOnline mocks base method.
+func (m *MockIWebSocketConnectionManager) IsOnline(userId uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOnline", userId)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsOnline indicates an expected call of IsOnline.
+func (mr *MockIWebSocketConnectionManagerMockRecorder) IsOnline(userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsConnected", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).IsConnected), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOnline", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).IsOnline), userId)
 }
 
 // RemoveAndCloseConnection mocks base method.
-func (m *MockIWebSocketConnectionManager) RemoveAndCloseConnection(userId uuid.UUID) {
+func (m *MockIWebSocketConnectionManager) RemoveAndCloseConnection(userId, connId uuid.UUID) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RemoveAndCloseConnection", userId)
+	m.ctrl.Call(m, "RemoveAndCloseConnection", userId, connId)
 }
 
 // RemoveAndCloseConnection indicates an expected call of RemoveAndCloseConnection.
-func (mr *MockIWebSocketConnectionManagerMockRecorder) RemoveAndCloseConnection(userId interface{}) *gomock.Call {
+func (mr *MockIWebSocketConnectionManagerMockRecorder) RemoveAndCloseConnection(userId, connId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAndCloseConnection", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).RemoveAndCloseConnection), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAndCloseConnection", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).RemoveAndCloseConnection), userId, connId)
+}
+
+// WriteToConnection mocks base method.
+func (m *MockIWebSocketConnectionManager) WriteToConnection(userId, connId uuid.UUID, messageType int, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteToConnection", userId, connId, messageType, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteToConnection indicates an expected call of WriteToConnection.
+func (mr *MockIWebSocketConnectionManagerMockRecorder) WriteToConnection(userId, connId, messageType, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteToConnection", reflect.TypeOf((*MockIWebSocketConnectionManager)(nil).WriteToConnection), userId, connId, messageType, data)
 }
 
 // MockIWebSocketRouter is a mock of IWebSocketRouter interface.
@@ -227,10 +516,9 @@ func (m *MockIWebSocketRouter) EXPECT() *MockIWebSocketRouterMockRecorder {
 	return m.recorder
 }
 
-// RegisterHandler mocks base method.
-
 type CommandHandler func(ctx context.Context, user models.User, payload json.RawMessage) error
 
+// RegisterHandler mocks base method.
 func (m *MockIWebSocketRouter) RegisterHandler(command string, handler CommandHandler) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "RegisterHandler", command, handler)
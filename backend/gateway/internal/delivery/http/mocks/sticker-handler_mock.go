@@ -36,6 +36,20 @@ func (m *MockStickerUseCase) EXPECT() *MockStickerUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddFavouriteSticker mocks base method.
+func (m *MockStickerUseCase) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavouriteSticker", ctx, userId, stickerURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavouriteSticker indicates an expected call of AddFavouriteSticker.
+func (mr *MockStickerUseCaseMockRecorder) AddFavouriteSticker(ctx, userId, stickerURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavouriteSticker", reflect.TypeOf((*MockStickerUseCase)(nil).AddFavouriteSticker), ctx, userId, stickerURL)
+}
+
 // AddStickerPack mocks base method.
 func (m *MockStickerUseCase) AddStickerPack(ctx context.Context, stickerPack *models.StickerPack) (*models.StickerPack, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +79,36 @@ func (mr *MockStickerUseCaseMockRecorder) DeleteStickerPack(ctx, userId, packId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStickerPack", reflect.TypeOf((*MockStickerUseCase)(nil).DeleteStickerPack), ctx, userId, packId)
 }
 
+// GetFavouriteStickers mocks base method.
+func (m *MockStickerUseCase) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavouriteStickers", ctx, userId)
+	ret0, _ := ret[0].([]*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavouriteStickers indicates an expected call of GetFavouriteStickers.
+func (mr *MockStickerUseCaseMockRecorder) GetFavouriteStickers(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavouriteStickers", reflect.TypeOf((*MockStickerUseCase)(nil).GetFavouriteStickers), ctx, userId)
+}
+
+// GetRecentStickers mocks base method.
+func (m *MockStickerUseCase) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentStickers", ctx, userId)
+	ret0, _ := ret[0].([]*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentStickers indicates an expected call of GetRecentStickers.
+func (mr *MockStickerUseCaseMockRecorder) GetRecentStickers(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentStickers", reflect.TypeOf((*MockStickerUseCase)(nil).GetRecentStickers), ctx, userId)
+}
+
 // GetStickerPack mocks base method.
 func (m *MockStickerUseCase) GetStickerPack(ctx context.Context, packId uuid.UUID) (*models.StickerPack, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +153,74 @@ func (mr *MockStickerUseCaseMockRecorder) GetStickerPacks(ctx, userId, count, of
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickerPacks", reflect.TypeOf((*MockStickerUseCase)(nil).GetStickerPacks), ctx, userId, count, offset)
 }
+
+// GetUserStickerPacks mocks base method.
+func (m *MockStickerUseCase) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]*models.StickerPack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStickerPacks", ctx, userId)
+	ret0, _ := ret[0].([]*models.StickerPack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStickerPacks indicates an expected call of GetUserStickerPacks.
+func (mr *MockStickerUseCaseMockRecorder) GetUserStickerPacks(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStickerPacks", reflect.TypeOf((*MockStickerUseCase)(nil).GetUserStickerPacks), ctx, userId)
+}
+
+// InstallStickerPack mocks base method.
+func (m *MockStickerUseCase) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallStickerPack", ctx, userId, packId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallStickerPack indicates an expected call of InstallStickerPack.
+func (mr *MockStickerUseCaseMockRecorder) InstallStickerPack(ctx, userId, packId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallStickerPack", reflect.TypeOf((*MockStickerUseCase)(nil).InstallStickerPack), ctx, userId, packId)
+}
+
+// RemoveFavouriteSticker mocks base method.
+func (m *MockStickerUseCase) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavouriteSticker", ctx, userId, stickerURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavouriteSticker indicates an expected call of RemoveFavouriteSticker.
+func (mr *MockStickerUseCaseMockRecorder) RemoveFavouriteSticker(ctx, userId, stickerURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavouriteSticker", reflect.TypeOf((*MockStickerUseCase)(nil).RemoveFavouriteSticker), ctx, userId, stickerURL)
+}
+
+// ReorderUserStickerPacks mocks base method.
+func (m *MockStickerUseCase) ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderUserStickerPacks", ctx, userId, packIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderUserStickerPacks indicates an expected call of ReorderUserStickerPacks.
+func (mr *MockStickerUseCaseMockRecorder) ReorderUserStickerPacks(ctx, userId, packIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserStickerPacks", reflect.TypeOf((*MockStickerUseCase)(nil).ReorderUserStickerPacks), ctx, userId, packIds)
+}
+
+// UninstallStickerPack mocks base method.
+func (m *MockStickerUseCase) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallStickerPack", ctx, userId, packId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallStickerPack indicates an expected call of UninstallStickerPack.
+func (mr *MockStickerUseCaseMockRecorder) UninstallStickerPack(ctx, userId, packId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallStickerPack", reflect.TypeOf((*MockStickerUseCase)(nil).UninstallStickerPack), ctx, userId, packId)
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+
+	"quickflow/gateway/internal/delivery/http/forms"
+	errors2 "quickflow/gateway/internal/errors"
+	http2 "quickflow/gateway/utils/http"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+type NotificationService interface {
+	SaveNotification(ctx context.Context, notification models.Notification) (*models.Notification, bool, error)
+	GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error)
+	MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error
+	MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error
+	GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error)
+}
+
+type NotificationHandler struct {
+	notificationService NotificationService
+	profileService      ProfileUseCase
+}
+
+func NewNotificationHandler(notificationService NotificationService, profileService ProfileUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		profileService:      profileService,
+	}
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Fetches notifications of the user updated before ts, the latest first. The updated_at and id of the last one are the cursor of the next page
+// @Tags Notifications
+// @Produce json
+// @Param count query int true "Number of notifications"
+// @Param ts query string false "Cursor"
+// @Param last_id query string false "Id of the last notification of the previous page"
+// @Success 200 {array} forms.NotificationOut "Notifications"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/notifications [get]
+func (n *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetNotifications request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching notifications")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.GetNotificationsForm
+	if err := form.GetParams(r.URL.Query()); err != nil {
+		logger.Error(ctx, "Failed to parse query params: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to parse query params", http.StatusBadRequest))
+		return
+	}
+
+	notifications, err := n.notificationService.GetNotifications(ctx, user.Id, form.Count, form.Ts, form.LastId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get notifications: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	actorIds := forms.NotificationActorIds(notifications)
+	actors := make(map[uuid.UUID]models.PublicUserInfo, len(actorIds))
+	if len(actorIds) > 0 {
+		infos, err := n.profileService.GetPublicUsersInfo(ctx, actorIds)
+		if err != nil {
+			logger.Error(ctx, "Failed to get public info of notification actors: %v", err)
+			http2.WriteJSONError(w, errors2.FromGRPCError(err))
+			return
+		}
+		for _, info := range infos {
+			actors[info.Id] = info
+		}
+	}
+
+	notificationsOut := make(forms.NotificationsOut, 0, len(notifications))
+	for _, notification := range notifications {
+		notificationsOut = append(notificationsOut, forms.ToNotificationOut(notification, actors))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[forms.NotificationsOut]{Payload: notificationsOut}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json payload %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to encode notifications output %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode notifications output", http.StatusInternalServerError))
+	}
+}
+
+// GetUnreadCount godoc
+// @Summary Get the number of unread notifications
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} forms.UnreadCountOut "Unread notifications"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/notifications/unread_count [get]
+func (n *NotificationHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetUnreadCount request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while counting unread notifications")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	count, err := n.notificationService.GetUnreadCount(ctx, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get unread notifications count: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[forms.UnreadCountOut]{Payload: forms.UnreadCountOut{Count: count}}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json payload %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to encode unread count output %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode unread count output", http.StatusInternalServerError))
+	}
+}
+
+// MarkNotificationsRead godoc
+// @Summary Mark notifications read
+// @Description Marks the listed notifications of the user read, ids of other users' notifications are ignored
+// @Tags Notifications
+// @Accept json
+// @Param notifications body forms.MarkNotificationsReadForm true "Seen notifications"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/notifications/read [post]
+func (n *NotificationHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received MarkNotificationsRead request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while marking notifications read")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.MarkNotificationsReadForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to parse request body: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid request body", http.StatusBadRequest))
+		return
+	}
+
+	if err := n.notificationService.MarkNotificationsRead(ctx, user.Id, form.Ids); err != nil {
+		logger.Error(ctx, "Failed to mark notifications read: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications read
+// @Tags Notifications
+// @Success 204 {string} string "No Content"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/notifications/read_all [post]
+func (n *NotificationHandler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received MarkAllNotificationsRead request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while marking all notifications read")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	if err := n.notificationService.MarkAllNotificationsRead(ctx, user.Id); err != nil {
+		logger.Error(ctx, "Failed to mark all notifications read: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/gateway/internal/delivery/http/mocks"
+	"quickflow/shared/models"
+)
+
+func TestGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationService := mocks.NewMockNotificationService(ctrl)
+	mockProfileService := mocks.NewMockProfileUseCase(ctrl)
+	handler := NewNotificationHandler(mockNotificationService, mockProfileService)
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	actorId, postId := uuid.New(), uuid.New()
+	notifications := []models.Notification{
+		{Id: uuid.New(), UserId: user.Id, ActorId: actorId, Type: models.NotificationPostLiked, TargetId: postId, CreatedAt: time.Now()},
+		{Id: uuid.New(), UserId: user.Id, ActorId: actorId, Type: models.NotificationPostCommented, TargetId: postId, Content: "nice", IsRead: true, CreatedAt: time.Now()},
+	}
+
+	mockNotificationService.EXPECT().GetNotifications(gomock.Any(), user.Id, 2, gomock.Any(), uuid.Nil).Return(notifications, nil)
+	mockProfileService.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{actorId}).
+		Return([]models.PublicUserInfo{{Id: actorId, Username: "anna"}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/notifications?count=2", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	handler.GetNotifications(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var out forms.PayloadWrapper[forms.NotificationsOut]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+	require.Len(t, out.Payload, 2)
+	assert.Equal(t, string(models.NotificationPostLiked), out.Payload[0].Type)
+	assert.Equal(t, "anna", out.Payload[0].Actor.Username)
+	assert.Equal(t, "nice", out.Payload[1].Content)
+	assert.True(t, out.Payload[1].IsRead)
+}
+
+func TestGetNotifications_NextPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationService := mocks.NewMockNotificationService(ctrl)
+	handler := NewNotificationHandler(mockNotificationService, mocks.NewMockProfileUseCase(ctrl))
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	lastId := uuid.New()
+	ts := time.Date(2025, 3, 1, 12, 30, 0, 125000000, time.UTC)
+
+	mockNotificationService.EXPECT().GetNotifications(gomock.Any(), user.Id, 2, ts, lastId).Return(nil, nil)
+
+	req := httptest.NewRequest("GET", "/api/notifications?count=2&ts=2025-03-01T12:30:00.125Z&last_id="+lastId.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	handler.GetNotifications(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req = httptest.NewRequest("GET", "/api/notifications?count=2&last_id=invalid", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w = httptest.NewRecorder()
+
+	handler.GetNotifications(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetNotifications_InvalidCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewNotificationHandler(mocks.NewMockNotificationService(ctrl), mocks.NewMockProfileUseCase(ctrl))
+
+	req := httptest.NewRequest("GET", "/api/notifications", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: uuid.New()}))
+	w := httptest.NewRecorder()
+
+	handler.GetNotifications(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationService := mocks.NewMockNotificationService(ctrl)
+	handler := NewNotificationHandler(mockNotificationService, mocks.NewMockProfileUseCase(ctrl))
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		body       string
+		serviceErr error
+		wantCall   bool
+		wantStatus int
+	}{
+		{name: "success", body: `{"ids":["` + id.String() + `"]}`, wantCall: true, wantStatus: http.StatusNoContent},
+		{name: "invalid body", body: `{"ids":`, wantStatus: http.StatusBadRequest},
+		{name: "service error", body: `{"ids":["` + id.String() + `"]}`, serviceErr: status.Error(codes.InvalidArgument, "invalid id"), wantCall: true, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantCall {
+				mockNotificationService.EXPECT().MarkNotificationsRead(gomock.Any(), user.Id, []uuid.UUID{id}).Return(tt.serviceErr)
+			}
+
+			req := httptest.NewRequest("POST", "/api/notifications/read", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), "user", user))
+			w := httptest.NewRecorder()
+
+			handler.MarkNotificationsRead(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationService := mocks.NewMockNotificationService(ctrl)
+	handler := NewNotificationHandler(mockNotificationService, mocks.NewMockProfileUseCase(ctrl))
+
+	user := models.User{Id: uuid.New(), Username: "testuser"}
+	mockNotificationService.EXPECT().GetUnreadCount(gomock.Any(), user.Id).Return(int64(3), nil)
+
+	req := httptest.NewRequest("GET", "/api/notifications/unread_count", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	w := httptest.NewRecorder()
+
+	handler.GetUnreadCount(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"payload":{"count":3}}`, w.Body.String())
+}
@@ -13,6 +13,7 @@ import (
 	"quickflow/gateway/internal/delivery/http/forms"
 	errors2 "quickflow/gateway/internal/errors"
 	"quickflow/gateway/pkg/sanitizer"
+	"quickflow/gateway/utils"
 	http2 "quickflow/gateway/utils/http"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -22,6 +23,8 @@ type WSLikeHandler interface {
 	NotifyPostLiked(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post) error
 	NotifyCommentLiked(ctx context.Context, senderId, receiverId uuid.UUID, comment *models.Comment) error
 	NotifyPostCommented(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post, comment *models.Comment) error
+	NotifyPostPollUpdated(ctx context.Context, post *models.Post, receivers ...uuid.UUID) error
+	NotifyMentioned(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post, text string) error
 }
 
 type PostHandler struct {
@@ -90,7 +93,7 @@ func (p *PostHandler) AddPost(w http.ResponseWriter, r *http.Request) {
 	// Sanitize post content
 	sanitizer.SanitizePost(&postForm, p.policy)
 
-	if len(postForm.Text)+len(postForm.Media)+len(postForm.Audio)+len(postForm.File) == 0 {
+	if len(postForm.Text)+len(postForm.Media)+len(postForm.Audio)+len(postForm.File) == 0 && postForm.Poll == nil {
 		logger.Error(ctx, "empty post content")
 		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "empty post content", http.StatusBadRequest))
 		return
@@ -111,6 +114,7 @@ func (p *PostHandler) AddPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	logger.Info(ctx, "Successfully added post")
+	notifyMentions(ctx, p.profileUseCase, p.likeWSHandler, user.Id, newPost, newPost.Desc)
 
 	// Prepare the post output
 	var postOut forms.PostOut
@@ -158,6 +162,20 @@ func (p *PostHandler) AddPost(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// notifyMentions notifies users mentioned in the text, unknown usernames are skipped
+func notifyMentions(ctx context.Context, profileUseCase ProfileUseCase, notifier WSLikeHandler, senderId uuid.UUID, post *models.Post, text string) {
+	for _, username := range utils.ExtractMentions(text) {
+		profile, err := profileUseCase.GetProfileByUsername(ctx, username)
+		if err != nil {
+			logger.Info(ctx, "Skipping mention of %s: %v", username, err)
+			continue
+		}
+		if err = notifier.NotifyMentioned(ctx, senderId, profile.UserId, post, text); err != nil {
+			logger.Error(ctx, "Failed to notify %s about mention: %v", username, err)
+		}
+	}
+}
+
 // DeletePost удаляет пост
 // @Summary Удалить пост
 // @Description Удаляет пост из ленты
@@ -361,6 +379,83 @@ func (p *PostHandler) LikePost(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// VotePoll голосует в опросе поста
+// @Summary Проголосовать в опросе
+// @Description Заменяет голос пользователя в опросе поста, пустой список вариантов отзывает голос
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param post_id path string true "Идентификатор поста"
+// @Param vote body forms.PollVoteForm true "Номера выбранных вариантов"
+// @Success 200 {object} forms.PollOut "Опрос с результатами"
+// @Failure 400 {object} forms.ErrorForm "Некорректные данные"
+// @Failure 404 {object} forms.ErrorForm "Опрос не найден"
+// @Failure 500 {object} forms.ErrorForm "Ошибка сервера"
+// @Router /api/posts/{post_id}/poll/vote [post]
+func (p *PostHandler) VotePoll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while voting in poll")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	postId, err := uuid.Parse(mux.Vars(r)["post_id"])
+	if err != nil {
+		logger.Error(ctx, "Failed to parse post ID: %s", err.Error())
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Failed to parse post ID", http.StatusBadRequest))
+		return
+	}
+
+	var voteForm forms.PollVoteForm
+	if err = easyjson.UnmarshalFromReader(r.Body, &voteForm); err != nil {
+		logger.Error(ctx, "Failed to decode request body for poll vote %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Bad request body", http.StatusBadRequest))
+		return
+	}
+
+	logger.Info(ctx, "User %s voted in poll of post %s", user.Username, postId.String())
+
+	post, err := p.postUseCase.VotePoll(ctx, postId, user.Id, voteForm.Options)
+	if err != nil {
+		logger.Error(ctx, "Failed to vote in poll: %s", err.Error())
+		http2.WriteJSONError(w, err)
+		return
+	}
+
+	// the author and public voters see the tallies live
+	var receivers []uuid.UUID
+	if post.CreatorType == models.PostUser && post.CreatorId != user.Id {
+		receivers = append(receivers, post.CreatorId)
+	}
+	seen := map[uuid.UUID]struct{}{user.Id: {}, post.CreatorId: {}}
+	for _, option := range post.Poll.Options {
+		for _, voter := range option.Voters {
+			if _, ok := seen[voter]; !ok {
+				seen[voter] = struct{}{}
+				receivers = append(receivers, voter)
+			}
+		}
+	}
+	if err = p.likeWSHandler.NotifyPostPollUpdated(ctx, post, receivers...); err != nil {
+		logger.Error(ctx, "Failed to notify poll update: %s", err.Error())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	out := forms.PayloadWrapper[*forms.PollOut]{Payload: forms.ToPollOut(post.Poll)}
+	js, err := out.MarshalJSON()
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal json payload %v", err)
+		http2.WriteJSONError(w, err)
+		return
+	}
+	if _, err = w.Write(js); err != nil {
+		logger.Error(ctx, "Failed to encode poll output %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to encode poll output", http.StatusInternalServerError))
+	}
+}
+
 func (p *PostHandler) UnlikePost(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	user, ok := ctx.Value("user").(models.User)
@@ -76,7 +76,7 @@ func (p *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.Info(ctx, "Profile of %s was successfully fetched", userRequested)
 
-	_, isOnline := p.connService.IsConnected(profileInfo.UserId)
+	isOnline := p.connService.IsOnline(profileInfo.UserId)
 
 	var relation = models.RelationNone
 	var chatId *uuid.UUID
@@ -272,7 +272,7 @@ func (p *ProfileHandler) GetMyProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.Info(ctx, "Profile of %s was successfully fetched", user.Username)
 
-	_, isOnline := p.connService.IsConnected(profileInfo.UserId)
+	isOnline := p.connService.IsOnline(profileInfo.UserId)
 
 	var relation = models.RelationNone
 	var chatId *uuid.UUID
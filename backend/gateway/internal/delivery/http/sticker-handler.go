@@ -23,6 +23,14 @@ type StickerUseCase interface {
 	GetStickerPackByName(ctx context.Context, packName string) (*models.StickerPack, error)
 	GetStickerPacks(ctx context.Context, userId uuid.UUID, count, offset int) ([]*models.StickerPack, error)
 	DeleteStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]*models.StickerPack, error)
+	ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error
+	GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
+	AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error
+	RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error
+	GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
 }
 
 type StickerHandler struct {
@@ -290,3 +298,257 @@ func (s *StickerHandler) DeleteStickerPack(w http.ResponseWriter, r *http.Reques
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// GetMyStickerPacks godoc
+// @Summary Get the user's sticker library
+// @Description Fetches sticker packs installed by the user in their order
+// @Tags Stickers
+// @Produce json
+// @Success 200 {array} forms.StickerPackOut "Installed sticker packs"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my [get]
+func (s *StickerHandler) GetMyStickerPacks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetMyStickerPacks request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching installed sticker packs")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	stickerPacks, err := s.stickerUseCase.GetUserStickerPacks(ctx, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch installed StickerPacks: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	writePayload(ctx, w, forms.ToStickerPacksOut(stickerPacks))
+}
+
+// InstallStickerPack godoc
+// @Summary Install a sticker pack
+// @Description Adds the sticker pack to the end of the user's library
+// @Tags Stickers
+// @Param pack_id path string true "Sticker Pack ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 404 {object} forms.ErrorForm "Sticker Pack not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/{pack_id} [post]
+func (s *StickerHandler) InstallStickerPack(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received InstallStickerPack request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while installing sticker pack")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	packId, err := uuid.Parse(mux.Vars(r)["pack_id"])
+	if err != nil {
+		logger.Error(ctx, "Invalid Sticker Pack ID:  %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid Sticker Pack ID", http.StatusBadRequest))
+		return
+	}
+
+	if err = s.stickerUseCase.InstallStickerPack(ctx, user.Id, packId); err != nil {
+		logger.Error(ctx, "Failed to install StickerPack %s: %v", packId.String(), err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+}
+
+// UninstallStickerPack godoc
+// @Summary Uninstall a sticker pack
+// @Description Removes the sticker pack from the user's library
+// @Tags Stickers
+// @Param pack_id path string true "Sticker Pack ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 404 {object} forms.ErrorForm "Sticker Pack is not installed"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/{pack_id} [delete]
+func (s *StickerHandler) UninstallStickerPack(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received UninstallStickerPack request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while uninstalling sticker pack")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	packId, err := uuid.Parse(mux.Vars(r)["pack_id"])
+	if err != nil {
+		logger.Error(ctx, "Invalid Sticker Pack ID:  %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid Sticker Pack ID", http.StatusBadRequest))
+		return
+	}
+
+	if err = s.stickerUseCase.UninstallStickerPack(ctx, user.Id, packId); err != nil {
+		logger.Error(ctx, "Failed to uninstall StickerPack %s: %v", packId.String(), err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+}
+
+// ReorderMyStickerPacks godoc
+// @Summary Reorder the user's sticker library
+// @Description Sets the order of installed sticker packs, the list must contain all of them
+// @Tags Stickers
+// @Accept json
+// @Param packs body forms.ReorderStickerPacksForm true "Installed sticker packs in the new order"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my [put]
+func (s *StickerHandler) ReorderMyStickerPacks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received ReorderMyStickerPacks request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while reordering sticker packs")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.ReorderStickerPacksForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil {
+		logger.Error(ctx, "Failed to parse request body: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid request body", http.StatusBadRequest))
+		return
+	}
+
+	if err := s.stickerUseCase.ReorderUserStickerPacks(ctx, user.Id, form.PackIds); err != nil {
+		logger.Error(ctx, "Failed to reorder StickerPacks: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+}
+
+// GetRecentStickers godoc
+// @Summary Get recently sent stickers
+// @Description Fetches stickers the user sent lately, the latest first
+// @Tags Stickers
+// @Produce json
+// @Success 200 {array} string "Sticker URLs"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/recent [get]
+func (s *StickerHandler) GetRecentStickers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetRecentStickers request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching recent stickers")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	stickers, err := s.stickerUseCase.GetRecentStickers(ctx, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch recent stickers: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	writePayload(ctx, w, forms.ToStickerURLs(stickers))
+}
+
+// GetFavouriteStickers godoc
+// @Summary Get favourite stickers
+// @Description Fetches favourite stickers of the user, the last added first
+// @Tags Stickers
+// @Produce json
+// @Success 200 {array} string "Sticker URLs"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/favourites [get]
+func (s *StickerHandler) GetFavouriteStickers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received GetFavouriteStickers request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while fetching favourite stickers")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	stickers, err := s.stickerUseCase.GetFavouriteStickers(ctx, user.Id)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch favourite stickers: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+
+	writePayload(ctx, w, forms.ToStickerURLs(stickers))
+}
+
+// AddFavouriteSticker godoc
+// @Summary Add a favourite sticker
+// @Description Marks the sticker as a favourite of the user
+// @Tags Stickers
+// @Accept json
+// @Param sticker body forms.FavouriteStickerForm true "Sticker URL"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data or too many favourites"
+// @Failure 404 {object} forms.ErrorForm "Sticker not found"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/favourites [post]
+func (s *StickerHandler) AddFavouriteSticker(w http.ResponseWriter, r *http.Request) {
+	s.changeFavouriteSticker(w, r, true)
+}
+
+// RemoveFavouriteSticker godoc
+// @Summary Remove a favourite sticker
+// @Description Removes the sticker from favourites of the user
+// @Tags Stickers
+// @Accept json
+// @Param sticker body forms.FavouriteStickerForm true "Sticker URL"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} forms.ErrorForm "Invalid data"
+// @Failure 404 {object} forms.ErrorForm "Sticker is not a favourite"
+// @Failure 500 {object} forms.ErrorForm "Server error"
+// @Router /api/sticker_packs/my/favourites [delete]
+func (s *StickerHandler) RemoveFavouriteSticker(w http.ResponseWriter, r *http.Request) {
+	s.changeFavouriteSticker(w, r, false)
+}
+
+func (s *StickerHandler) changeFavouriteSticker(w http.ResponseWriter, r *http.Request, favourite bool) {
+	ctx := r.Context()
+	logger.Info(ctx, "Received change favourite sticker request")
+
+	user, ok := ctx.Value("user").(models.User)
+	if !ok {
+		logger.Error(ctx, "Failed to get user from context while changing favourite sticker")
+		http2.WriteJSONError(w, errors2.New(errors2.InternalErrorCode, "Failed to get user from context", http.StatusInternalServerError))
+		return
+	}
+
+	var form forms.FavouriteStickerForm
+	if err := easyjson.UnmarshalFromReader(r.Body, &form); err != nil || len(form.StickerURL) == 0 {
+		logger.Error(ctx, "Failed to parse request body: %v", err)
+		http2.WriteJSONError(w, errors2.New(errors2.BadRequestErrorCode, "Invalid request body", http.StatusBadRequest))
+		return
+	}
+
+	var err error
+	if favourite {
+		err = s.stickerUseCase.AddFavouriteSticker(ctx, user.Id, form.StickerURL)
+	} else {
+		err = s.stickerUseCase.RemoveFavouriteSticker(ctx, user.Id, form.StickerURL)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to change favourite sticker: %v", err)
+		http2.WriteJSONError(w, errors2.FromGRPCError(err))
+		return
+	}
+	logger.Info(ctx, "User %s changed favourite sticker to %t", user.Username, favourite)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"quickflow/gateway/internal/delivery/http/forms"
@@ -46,6 +47,46 @@ func (m *MockStickerUseCase) DeleteStickerPack(ctx context.Context, userId, pack
 	return args.Error(0)
 }
 
+func (m *MockStickerUseCase) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	args := m.Called(ctx, userId, packId)
+	return args.Error(0)
+}
+
+func (m *MockStickerUseCase) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	args := m.Called(ctx, userId, packId)
+	return args.Error(0)
+}
+
+func (m *MockStickerUseCase) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]*models.StickerPack, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]*models.StickerPack), args.Error(1)
+}
+
+func (m *MockStickerUseCase) ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	args := m.Called(ctx, userId, packIds)
+	return args.Error(0)
+}
+
+func (m *MockStickerUseCase) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]*models.File), args.Error(1)
+}
+
+func (m *MockStickerUseCase) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	args := m.Called(ctx, userId, stickerURL)
+	return args.Error(0)
+}
+
+func (m *MockStickerUseCase) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	args := m.Called(ctx, userId, stickerURL)
+	return args.Error(0)
+}
+
+func (m *MockStickerUseCase) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]*models.File), args.Error(1)
+}
+
 func TestStickerHandler_AddStickerPack(t *testing.T) {
 	usecase := new(MockStickerUseCase)
 	handler := NewStickerHandler(usecase, bluemonday.NewPolicy())
@@ -215,3 +256,58 @@ func TestStickerHandler_ErrorCases(t *testing.T) {
 		})
 	}
 }
+
+func TestStickerHandler_ReorderMyStickerPacks(t *testing.T) {
+	usecase := new(MockStickerUseCase)
+	handler := NewStickerHandler(usecase, bluemonday.NewPolicy())
+
+	userID := uuid.New()
+	packIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	usecase.On("ReorderUserStickerPacks", mock.Anything, userID, packIDs).
+		Return(nil)
+
+	body, _ := easyjson.Marshal(forms.ReorderStickerPacksForm{PackIds: packIDs})
+
+	req := httptest.NewRequest("PUT", "/api/sticker_packs/my", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: userID}))
+
+	rec := httptest.NewRecorder()
+	handler.ReorderMyStickerPacks(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	usecase.AssertExpectations(t)
+}
+
+func TestStickerHandler_GetFavouriteStickers(t *testing.T) {
+	usecase := new(MockStickerUseCase)
+	handler := NewStickerHandler(usecase, bluemonday.NewPolicy())
+
+	userID := uuid.New()
+
+	usecase.On("GetFavouriteStickers", mock.Anything, userID).
+		Return([]*models.File{{URL: "cat.webp", DisplayType: models.DisplayTypeSticker}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/sticker_packs/my/favourites", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: userID}))
+
+	rec := httptest.NewRecorder()
+	handler.GetFavouriteStickers(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"payload":["cat.webp"]}`, rec.Body.String())
+}
+
+func TestStickerHandler_AddFavouriteSticker_EmptyURL(t *testing.T) {
+	usecase := new(MockStickerUseCase)
+	handler := NewStickerHandler(usecase, bluemonday.NewPolicy())
+
+	req := httptest.NewRequest("POST", "/api/sticker_packs/my/favourites", bytes.NewReader([]byte(`{"sticker_url":""}`)))
+	req = req.WithContext(context.WithValue(req.Context(), "user", models.User{Id: uuid.New()}))
+
+	rec := httptest.NewRecorder()
+	handler.AddFavouriteSticker(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	usecase.AssertNotCalled(t, "AddFavouriteSticker", mock.Anything, mock.Anything, mock.Anything)
+}
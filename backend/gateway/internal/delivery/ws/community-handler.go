@@ -0,0 +1,60 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"quickflow/gateway/internal/delivery/http"
+	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/shared/models"
+)
+
+type CommunityEvent string
+
+const (
+	CommunityEventRoleChanged CommunityEvent = "community_role_changed"
+)
+
+type InternalWSCommunityHandler struct {
+	notifier
+}
+
+func NewInternalWSCommunityHandler(eventBus EventBus, notificationService http.NotificationService, profileService http.ProfileUseCase) *InternalWSCommunityHandler {
+	return &InternalWSCommunityHandler{
+		notifier: notifier{eventBus: eventBus, notificationService: notificationService, profileService: profileService},
+	}
+}
+
+// NotifyCommunityRoleChanged tells the member that the sender gave them a new role in the community
+func (c *InternalWSCommunityHandler) NotifyCommunityRoleChanged(ctx context.Context, senderId, receiverId uuid.UUID, community *models.Community, role models.CommunityRole) error {
+	senderProfileInfo, err := c.profileService.GetPublicUserInfo(ctx, senderId)
+	if err != nil {
+		return fmt.Errorf("failed to get sender profile info: %w", err)
+	}
+
+	out := struct {
+		CommunityId uuid.UUID               `json:"community_id"`
+		Nickname    string                  `json:"nickname"`
+		Role        string                  `json:"role"`
+		User        forms.PublicUserInfoOut `json:"user"`
+	}{
+		CommunityId: community.ID,
+		Nickname:    community.NickName,
+		Role:        string(role),
+		User:        forms.PublicUserInfoToOut(senderProfileInfo, ""),
+	}
+
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationCommunityRoleChanged,
+		TargetId: community.ID,
+		Content:  string(role),
+	}
+	if err = c.notify(ctx, notification, string(CommunityEventRoleChanged), out); err != nil {
+		return fmt.Errorf("failed to notify community role changed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package ws
+
+import (
+	"context"
+
+	"quickflow/shared/eventbus"
+)
+
+// EventBus distributes WS events between gateway instances,
+// every instance delivers received events to the users connected to it
+type EventBus interface {
+	Publish(ctx context.Context, event eventbus.Event) error
+	Subscribe(ctx context.Context, handler eventbus.Handler) error
+}
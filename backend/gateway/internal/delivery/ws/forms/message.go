@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+
+	"quickflow/gateway/internal/delivery/http/forms"
 )
 
 type MessageRequest struct {
@@ -21,6 +23,15 @@ type NotifyMessageRead struct {
 	MessageId uuid.UUID `json:"message_id"`
 	Timestamp string    `json:"ts"`
 	SenderId  uuid.UUID `json:"sender_id"`
+	Seq       int64     `json:"seq"`
+}
+
+// NotifyMessageDelivered tells the sender that messages of the chat created up to Timestamp reached the user
+type NotifyMessageDelivered struct {
+	ChatId    uuid.UUID `json:"chat_id"`
+	MessageId uuid.UUID `json:"message_id"`
+	Timestamp string    `json:"ts"`
+	UserId    uuid.UUID `json:"user_id"`
 }
 
 //easyjson:json
@@ -31,8 +42,81 @@ type DeleteMessagePayload struct {
 type NotifyDeleteMessage struct {
 	ChatId    uuid.UUID `json:"chat_id"`
 	MessageId uuid.UUID `json:"message_id"`
+	Seq       int64     `json:"seq"`
+}
+
+type ReactionPayload struct {
+	MessageId uuid.UUID `json:"message_id"`
+	Emoji     string    `json:"emoji"`
+}
+
+// PollVotePayload replaces the vote of the user in the poll of the message, no options retract the vote
+type PollVotePayload struct {
+	MessageId uuid.UUID `json:"message_id"`
+	Options   []int     `json:"options"`
+}
+
+type NotifyPollUpdate struct {
+	ChatId    uuid.UUID      `json:"chat_id"`
+	MessageId uuid.UUID      `json:"message_id"`
+	Poll      *forms.PollOut `json:"poll"`
+}
+
+type NotifyReaction struct {
+	ChatId    uuid.UUID `json:"chat_id"`
+	MessageId uuid.UUID `json:"message_id"`
+	UserId    uuid.UUID `json:"user_id"`
+	Emoji     string    `json:"emoji"`
+	Seq       int64     `json:"seq"`
+}
+
+type PinPayload struct {
+	MessageId uuid.UUID `json:"message_id"`
+}
+
+type NotifyPin struct {
+	ChatId    uuid.UUID `json:"chat_id"`
+	MessageId uuid.UUID `json:"message_id"`
+	UserId    uuid.UUID `json:"user_id"`
+	Seq       int64     `json:"seq"`
+}
+
+type EditMessagePayload struct {
+	MessageId uuid.UUID `json:"message_id"`
+	Text      string    `json:"text,omitempty"`
+	Media     []string  `json:"media,omitempty"`
+	Audio     []string  `json:"audio,omitempty"`
+	File      []string  `json:"files,omitempty"`
+	Stickers  []string  `json:"stickers,omitempty"`
+}
+
+type ForwardMessagesPayload struct {
+	MessageIds []uuid.UUID `json:"message_ids"`
+	ChatId     uuid.UUID   `json:"chat_id,omitempty"`
+	ReceiverId uuid.UUID   `json:"receiver_id,omitempty"`
+}
+
+type NotifyEditMessage struct {
+	Message forms.MessageOut `json:"message"`
+	Seq     int64            `json:"seq"`
 }
 
 type DeleteChatPayload struct {
 	ChatId uuid.UUID `json:"chat_id"`
 }
+
+type ResumeChat struct {
+	ChatId  uuid.UUID `json:"chat_id"`
+	LastSeq int64     `json:"last_seq"`
+}
+
+// ResumePayload carries the last event sequence number the client has seen in every chat.
+// Events are replayed with their seq, live events received during the replay
+// are delivered after it, so the client should skip the ones it already has.
+type ResumePayload struct {
+	Chats []ResumeChat `json:"chats"`
+}
+
+type NotifyResumeDone struct {
+	Chats []ResumeChat `json:"chats"`
+}
@@ -0,0 +1,17 @@
+package forms
+
+import "github.com/google/uuid"
+
+type TypingPayload struct {
+	ChatId uuid.UUID `json:"chat_id"`
+}
+
+type NotifyTyping struct {
+	ChatId uuid.UUID `json:"chat_id"`
+	UserId uuid.UUID `json:"user_id"`
+}
+
+type NotifyPresence struct {
+	UserId   uuid.UUID `json:"user_id"`
+	LastSeen string    `json:"last_seen,omitempty"`
+}
@@ -2,14 +2,13 @@ package ws
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
-	"github.com/gorilla/websocket"
 
 	"quickflow/gateway/internal/delivery/http"
 	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/shared/models"
 )
 
 type FriendEvent string
@@ -20,29 +19,28 @@ const (
 )
 
 type InternalWSFriendsHandler struct {
-	connManager    *WSConnectionManager
-	profileService http.ProfileUseCase
+	notifier
 }
 
-func NewInternalWSFriendsHandler(wsConnectionManager *WSConnectionManager, profileService http.ProfileUseCase) *InternalWSFriendsHandler {
+func NewInternalWSFriendsHandler(eventBus EventBus, notificationService http.NotificationService, profileService http.ProfileUseCase) *InternalWSFriendsHandler {
 	return &InternalWSFriendsHandler{
-		connManager:    wsConnectionManager,
-		profileService: profileService,
+		notifier: notifier{eventBus: eventBus, notificationService: notificationService, profileService: profileService},
 	}
 }
 
 func (f *InternalWSFriendsHandler) NotifyFriendRequestSent(ctx context.Context, senderId, receiverId uuid.UUID) error {
-	_, exists := f.connManager.IsConnected(receiverId)
-	if !exists {
-		return nil
-	}
-
 	senderProfileInfo, err := f.profileService.GetPublicUserInfo(ctx, senderId)
 	if err != nil {
 		return fmt.Errorf("failed to get sender profile info: %w", err)
 	}
 
-	err = f.notifyFriendEvent(ctx, forms.PublicUserInfoToOut(senderProfileInfo, ""), receiverId, FriendEventRequestSent)
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationFriendRequest,
+		TargetId: senderId,
+	}
+	err = f.notify(ctx, notification, string(FriendEventRequestSent), forms.PublicUserInfoToOut(senderProfileInfo, ""))
 	if err != nil {
 		return fmt.Errorf("failed to notify friend request sent: %w", err)
 	}
@@ -50,42 +48,20 @@ func (f *InternalWSFriendsHandler) NotifyFriendRequestSent(ctx context.Context,
 }
 
 func (f *InternalWSFriendsHandler) NotifyFriendRequestAccepted(ctx context.Context, senderId, receiverId uuid.UUID) error {
-	_, exists := f.connManager.IsConnected(receiverId)
-	if !exists {
-		return nil
-	}
-
 	senderProfileInfo, err := f.profileService.GetPublicUserInfo(ctx, senderId)
 	if err != nil {
 		return fmt.Errorf("failed to get sender profile info: %w", err)
 	}
 
-	err = f.notifyFriendEvent(ctx, forms.PublicUserInfoToOut(senderProfileInfo, ""), receiverId, FriendEventRequestAccepted)
-	if err != nil {
-		return fmt.Errorf("failed to notify friend request accepted: %w", err)
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationFriendAccepted,
+		TargetId: senderId,
 	}
-	return nil
-}
-
-func (f *InternalWSFriendsHandler) notifyFriendEvent(_ context.Context, read interface{}, receiver uuid.UUID, eventType FriendEvent) error {
-	conn, exists := f.connManager.IsConnected(receiver)
-	if !exists {
-		return nil
-	}
-
-	out := struct {
-		Type string      `json:"type"`
-		Data interface{} `json:"payload"`
-	}{string(eventType), read}
-
-	msgJSON, err := json.Marshal(out)
+	err = f.notify(ctx, notification, string(FriendEventRequestAccepted), forms.PublicUserInfoToOut(senderProfileInfo, ""))
 	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
-	}
-
-	err = conn.WriteMessage(websocket.TextMessage, msgJSON)
-	if err != nil {
-		return fmt.Errorf("failed to send message: %w", err)
+		return fmt.Errorf("failed to notify friend request accepted: %w", err)
 	}
 	return nil
 }
@@ -2,54 +2,45 @@ package ws
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
+
 	"quickflow/gateway/internal/delivery/http/forms"
 	"quickflow/shared/models"
 
 	"github.com/google/uuid"
-	"github.com/gorilla/websocket"
 
 	"quickflow/gateway/internal/delivery/http"
+	"quickflow/shared/eventbus"
 )
 
 type PostEvent string
 
 const (
-	PostLiked     PostEvent = "post_liked"
-	CommentLiked  PostEvent = "comment_liked"
-	PostCommented PostEvent = "post_commented"
+	PostLiked       PostEvent = "post_liked"
+	CommentLiked    PostEvent = "comment_liked"
+	PostCommented   PostEvent = "post_commented"
+	PostPollUpdated PostEvent = "post_poll_update"
+	PostMention     PostEvent = "mention"
 )
 
 type InternalWSPostHandler struct {
-	connManager    *WSConnectionManager
-	profileService http.ProfileUseCase
+	notifier
 }
 
-func NewInternalWSPostHandler(wsConnectionManager *WSConnectionManager, profileService http.ProfileUseCase) *InternalWSPostHandler {
+func NewInternalWSPostHandler(eventBus EventBus, notificationService http.NotificationService, profileService http.ProfileUseCase) *InternalWSPostHandler {
 	return &InternalWSPostHandler{
-		connManager:    wsConnectionManager,
-		profileService: profileService,
+		notifier: notifier{eventBus: eventBus, notificationService: notificationService, profileService: profileService},
 	}
 }
 
-func (f *InternalWSPostHandler) notifyLikeEvent(_ context.Context, read interface{}, receiver uuid.UUID, eventType PostEvent) error {
-	conn, exists := f.connManager.IsConnected(receiver)
-	if !exists {
-		return nil
-	}
-
-	out := struct {
-		Type string      `json:"type"`
-		Data interface{} `json:"payload"`
-	}{string(eventType), read}
-
-	msgJSON, err := json.Marshal(out)
+func (f *InternalWSPostHandler) notifyLikeEvent(ctx context.Context, read interface{}, receiver uuid.UUID, eventType PostEvent) error {
+	event, err := eventbus.NewEvent(string(eventType), read, receiver)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	err = conn.WriteMessage(websocket.TextMessage, msgJSON)
+	err = f.eventBus.Publish(ctx, event)
 	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
@@ -57,8 +48,8 @@ func (f *InternalWSPostHandler) notifyLikeEvent(_ context.Context, read interfac
 }
 
 func (f *InternalWSPostHandler) NotifyPostLiked(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post) error {
-	_, exists := f.connManager.IsConnected(receiverId)
-	if !exists {
+	// community posts have no single author to notify
+	if post.CreatorType == models.PostCommunity {
 		return nil
 	}
 
@@ -78,19 +69,19 @@ func (f *InternalWSPostHandler) NotifyPostLiked(ctx context.Context, senderId, r
 		User: forms.PublicUserInfoToOut(senderProfileInfo, ""),
 	}
 
-	err = f.notifyLikeEvent(ctx, out, receiverId, PostLiked)
-	if err != nil {
-		return fmt.Errorf("failed to notify friend request sent: %w", err)
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationPostLiked,
+		TargetId: post.Id,
+	}
+	if err = f.notify(ctx, notification, string(PostLiked), out); err != nil {
+		return fmt.Errorf("failed to notify post liked: %w", err)
 	}
 	return nil
 }
 
 func (f *InternalWSPostHandler) NotifyCommentLiked(ctx context.Context, senderId, receiverId uuid.UUID, comment *models.Comment) error {
-	_, exists := f.connManager.IsConnected(receiverId)
-	if !exists {
-		return nil
-	}
-
 	senderProfileInfo, err := f.profileService.GetPublicUserInfo(ctx, senderId)
 	if err != nil {
 		return fmt.Errorf("failed to get sender profile info: %w", err)
@@ -112,16 +103,21 @@ func (f *InternalWSPostHandler) NotifyCommentLiked(ctx context.Context, senderId
 		User:    forms.PublicUserInfoToOut(senderProfileInfo, ""),
 	}
 
-	err = f.notifyLikeEvent(ctx, out, receiverId, CommentLiked)
-	if err != nil {
-		return fmt.Errorf("failed to notify friend request accepted: %w", err)
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationCommentLiked,
+		TargetId: comment.Id,
+		Content:  comment.Text,
+	}
+	if err = f.notify(ctx, notification, string(CommentLiked), out); err != nil {
+		return fmt.Errorf("failed to notify comment liked: %w", err)
 	}
 	return nil
 }
 
 func (f *InternalWSPostHandler) NotifyPostCommented(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post, comment *models.Comment) error {
-	_, exists := f.connManager.IsConnected(receiverId)
-	if !exists {
+	if post.CreatorType == models.PostCommunity {
 		return nil
 	}
 
@@ -144,9 +140,71 @@ func (f *InternalWSPostHandler) NotifyPostCommented(ctx context.Context, senderI
 		Comment: commentOut,
 	}
 
-	err = f.notifyLikeEvent(ctx, out, receiverId, PostCommented)
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationPostCommented,
+		TargetId: post.Id,
+		Content:  comment.Text,
+	}
+	if err = f.notify(ctx, notification, string(PostCommented), out); err != nil {
+		return fmt.Errorf("failed to notify post commented: %w", err)
+	}
+	return nil
+}
+
+// NotifyPostPollUpdated sends the public tallies of the post poll to the receivers
+func (f *InternalWSPostHandler) NotifyPostPollUpdated(ctx context.Context, post *models.Post, receivers ...uuid.UUID) error {
+	if post.Poll == nil || len(receivers) == 0 {
+		return nil
+	}
+	poll := post.Poll.PublicView(time.Now())
+
+	out := struct {
+		PostId uuid.UUID      `json:"post_id"`
+		Poll   *forms.PollOut `json:"poll"`
+	}{
+		PostId: post.Id,
+		Poll:   forms.ToPollOut(&poll),
+	}
+
+	for _, receiver := range receivers {
+		if err := f.notifyLikeEvent(ctx, out, receiver, PostPollUpdated); err != nil {
+			return fmt.Errorf("failed to notify post poll update: %w", err)
+		}
+	}
+	return nil
+}
+
+// NotifyMentioned tells the receiver they were mentioned in the post or in a comment to it, text is where the mention is
+func (f *InternalWSPostHandler) NotifyMentioned(ctx context.Context, senderId, receiverId uuid.UUID, post *models.Post, text string) error {
+	senderProfileInfo, err := f.profileService.GetPublicUserInfo(ctx, senderId)
 	if err != nil {
-		return fmt.Errorf("failed to notify friend request accepted: %w", err)
+		return fmt.Errorf("failed to get sender profile info: %w", err)
+	}
+
+	var postOut forms.PostOut
+	postOut.FromPost(*post)
+
+	out := struct {
+		Post forms.PostOut           `json:"post"`
+		User forms.PublicUserInfoOut `json:"user"`
+		Text string                  `json:"text"`
+	}{
+		Post: postOut,
+		User: forms.PublicUserInfoToOut(senderProfileInfo, ""),
+		Text: text,
+	}
+
+	notification := models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationMention,
+		TargetId: post.Id,
+		Content:  text,
+	}
+	if err = f.notify(ctx, notification, string(PostMention), out); err != nil {
+		return fmt.Errorf("failed to notify mention: %w", err)
 	}
 	return nil
 }
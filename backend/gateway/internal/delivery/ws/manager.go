@@ -1,44 +1,278 @@
 package ws
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
 )
 
 type MessageEvent string
 
+// WriteTimeout bounds a single write, so that a client that stopped reading
+// does not block delivery to the other users of the instance
+const WriteTimeout = 10 * time.Second
+
+// connection wraps a single websocket with a write lock,
+// gorilla/websocket does not support concurrent writers
+type connection struct {
+	conn         *websocket.Conn
+	mu           sync.Mutex
+	writeTimeout time.Duration
+
+	// while the connection is held live events are queued in pending,
+	// so that replayed events reach the client first
+	holds   int
+	pending [][]byte
+}
+
+func (c *connection) write(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.writeLocked(messageType, data)
+}
+
+// writeLocked must be called with mu held. Once a write times out the connection is broken
+// and the next writes fail at once, so a stalled client delays delivery only once.
+func (c *connection) writeLocked(messageType int, data []byte) error {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// deliver writes live event to the connection or queues it if the connection is held
+func (c *connection) deliver(data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.holds > 0 {
+		c.pending = append(c.pending, data)
+		return nil
+	}
+	return c.writeLocked(websocket.TextMessage, data)
+}
+
+func (c *connection) hold() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.holds++
+}
+
+// release flushes queued events once the last hold is released
+func (c *connection) release() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.holds > 0 {
+		c.holds--
+	}
+	if c.holds > 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, data := range c.pending {
+		if err := c.writeLocked(websocket.TextMessage, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.pending = nil
+	return errors.Join(errs...)
+}
+
+// PresenceListener is notified when the user opens the first connection or closes the last one.
+// Connections are counted per gateway instance, the listener must not block the WS handshake.
+type PresenceListener interface {
+	UserOnline(ctx context.Context, userId uuid.UUID)
+	UserOffline(ctx context.Context, userId uuid.UUID)
+}
+
+// DeliveryListener is notified when an event from the bus was written to connections of the receiver
+type DeliveryListener interface {
+	EventDelivered(ctx context.Context, userId uuid.UUID, event eventbus.Event)
+}
+
 type WSConnectionManager struct {
-	Connections map[uuid.UUID]*websocket.Conn
+	// Connections maps user id to all of the user's live connections keyed by connection id
+	Connections map[uuid.UUID]map[uuid.UUID]*connection
 	mu          sync.RWMutex
+
+	writeTimeout time.Duration
+
+	presence PresenceListener
+	delivery DeliveryListener
 }
 
 func NewWSConnectionManager() *WSConnectionManager {
 	return &WSConnectionManager{
-		Connections: make(map[uuid.UUID]*websocket.Conn),
+		Connections:  make(map[uuid.UUID]map[uuid.UUID]*connection),
+		writeTimeout: WriteTimeout,
 	}
 }
 
+// SetPresenceListener sets listener of users going online and offline, must be called before connections are added
+func (wm *WSConnectionManager) SetPresenceListener(listener PresenceListener) {
+	wm.presence = listener
+}
+
+// SetDeliveryListener sets listener of delivered events, must be called before events are delivered
+func (wm *WSConnectionManager) SetDeliveryListener(listener DeliveryListener) {
+	wm.delivery = listener
+}
+
 // AddConnection adds a new user connection to the manager
-func (wm *WSConnectionManager) AddConnection(userId uuid.UUID, conn *websocket.Conn) {
+func (wm *WSConnectionManager) AddConnection(userId, connId uuid.UUID, conn *websocket.Conn) {
 	wm.mu.Lock()
-	wm.Connections[userId] = conn
+	userConns, exists := wm.Connections[userId]
+	if !exists {
+		userConns = make(map[uuid.UUID]*connection)
+		wm.Connections[userId] = userConns
+	}
+	userConns[connId] = &connection{conn: conn, writeTimeout: wm.writeTimeout}
+	cameOnline := len(userConns) == 1
 	wm.mu.Unlock()
+
+	if cameOnline && wm.presence != nil {
+		wm.presence.UserOnline(context.Background(), userId)
+	}
 }
 
 // RemoveAndCloseConnection removes a user connection from the manager and closes it
-func (wm *WSConnectionManager) RemoveAndCloseConnection(userId uuid.UUID) {
+func (wm *WSConnectionManager) RemoveAndCloseConnection(userId, connId uuid.UUID) {
 	wm.mu.Lock()
-	if _, exists := wm.Connections[userId]; exists {
+	userConns, exists := wm.Connections[userId]
+	if !exists {
+		wm.mu.Unlock()
+		return
+	}
+	c, exists := userConns[connId]
+	if exists {
+		delete(userConns, connId)
+	}
+	wentOffline := exists && len(userConns) == 0
+	if len(userConns) == 0 {
 		delete(wm.Connections, userId)
 	}
 	wm.mu.Unlock()
+
+	if c != nil {
+		_ = c.conn.Close()
+	}
+	if wentOffline && wm.presence != nil {
+		wm.presence.UserOffline(context.Background(), userId)
+	}
+}
+
+// IsConnected returns the connection with given id if it is still registered for the user
+func (wm *WSConnectionManager) IsConnected(userId, connId uuid.UUID) (*websocket.Conn, bool) {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+	c, exists := wm.Connections[userId][connId]
+	if !exists {
+		return nil, false
+	}
+	return c.conn, true
+}
+
+// IsOnline reports whether the user has at least one live connection
+func (wm *WSConnectionManager) IsOnline(userId uuid.UUID) bool {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+	return len(wm.Connections[userId]) > 0
 }
 
-func (wm *WSConnectionManager) IsConnected(userId uuid.UUID) (*websocket.Conn, bool) {
+func (wm *WSConnectionManager) getConnection(userId, connId uuid.UUID) (*connection, error) {
 	wm.mu.RLock()
 	defer wm.mu.RUnlock()
-	conn, exists := wm.Connections[userId]
-	return conn, exists
+	c, exists := wm.Connections[userId][connId]
+	if !exists {
+		return nil, fmt.Errorf("connection %s of user %s not found", connId, userId)
+	}
+	return c, nil
+}
+
+// WriteToConnection writes data to a single connection of the user, bypassing hold
+func (wm *WSConnectionManager) WriteToConnection(userId, connId uuid.UUID, messageType int, data []byte) error {
+	c, err := wm.getConnection(userId, connId)
+	if err != nil {
+		return err
+	}
+	return c.write(messageType, data)
+}
+
+// HoldConnection postpones delivery of live events to the connection until ReleaseConnection
+func (wm *WSConnectionManager) HoldConnection(userId, connId uuid.UUID) error {
+	c, err := wm.getConnection(userId, connId)
+	if err != nil {
+		return err
+	}
+	c.hold()
+	return nil
+}
+
+// ReleaseConnection sends live events queued while the connection was held and resumes live delivery
+func (wm *WSConnectionManager) ReleaseConnection(userId, connId uuid.UUID) error {
+	c, err := wm.getConnection(userId, connId)
+	if err != nil {
+		return err
+	}
+	return c.release()
+}
+
+// SendToUser writes data to every live connection of the user, held connections get it after release.
+// Returns nil if the user is not connected.
+func (wm *WSConnectionManager) SendToUser(userId uuid.UUID, data []byte) error {
+	wm.mu.RLock()
+	conns := make([]*connection, 0, len(wm.Connections[userId]))
+	for _, c := range wm.Connections[userId] {
+		conns = append(conns, c)
+	}
+	wm.mu.RUnlock()
+
+	var errs []error
+	for _, c := range conns {
+		if err := c.deliver(data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// marshalWSMessage builds message in the format clients receive events in
+func marshalWSMessage(eventType string, payload json.RawMessage) ([]byte, error) {
+	out := struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"payload"`
+	}{eventType, payload}
+
+	return json.Marshal(out)
+}
+
+// DeliverEvent sends event to those of its receivers that are connected to this instance
+func (wm *WSConnectionManager) DeliverEvent(ctx context.Context, event eventbus.Event) error {
+	msgJSON, err := marshalWSMessage(event.Type, event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	for _, receiver := range event.Receivers {
+		if !wm.IsOnline(receiver) {
+			continue
+		}
+		if err = wm.SendToUser(receiver, msgJSON); err != nil {
+			logger.Error(ctx, "Failed to deliver event %s to user %s: %v", event.Type, receiver, err)
+			continue
+		}
+		if wm.delivery != nil {
+			wm.delivery.EventDelivered(ctx, receiver, event)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,263 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/gateway/internal/delivery/http/mocks"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/models"
+)
+
+// newTestConnPair returns server side connection and client side connection
+func newTestConnPair(t *testing.T) (*websocket.Conn, *websocket.Conn) {
+	t.Helper()
+
+	serverConns := make(chan *websocket.Conn, 1)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		serverConns <- conn
+	}))
+	t.Cleanup(srv.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	return <-serverConns, client
+}
+
+func TestWSConnectionManager_MultipleConnections(t *testing.T) {
+	manager := NewWSConnectionManager()
+	userId := uuid.New()
+
+	serverConn1, client1 := newTestConnPair(t)
+	serverConn2, client2 := newTestConnPair(t)
+	connId1, connId2 := uuid.New(), uuid.New()
+
+	assert.False(t, manager.IsOnline(userId))
+
+	manager.AddConnection(userId, connId1, serverConn1)
+	manager.AddConnection(userId, connId2, serverConn2)
+	assert.True(t, manager.IsOnline(userId))
+
+	conn, found := manager.IsConnected(userId, connId1)
+	assert.True(t, found)
+	assert.Equal(t, serverConn1, conn)
+
+	require.NoError(t, manager.SendToUser(userId, []byte(`{"type":"test"}`)))
+	for _, client := range []*websocket.Conn{client1, client2} {
+		_ = client.SetReadDeadline(time.Now().Add(time.Second))
+		_, msg, err := client.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, `{"type":"test"}`, string(msg))
+	}
+
+	manager.RemoveAndCloseConnection(userId, connId1)
+	_, found = manager.IsConnected(userId, connId1)
+	assert.False(t, found)
+	assert.True(t, manager.IsOnline(userId))
+
+	manager.RemoveAndCloseConnection(userId, connId2)
+	assert.False(t, manager.IsOnline(userId))
+	assert.NoError(t, manager.SendToUser(userId, []byte("ignored")))
+}
+
+func TestWSConnectionManager_WriteToUnknownConnection(t *testing.T) {
+	manager := NewWSConnectionManager()
+	err := manager.WriteToConnection(uuid.New(), uuid.New(), websocket.TextMessage, []byte("data"))
+	assert.Error(t, err)
+}
+
+func TestWSConnectionManager_DeliverEvent(t *testing.T) {
+	manager := NewWSConnectionManager()
+	online, offline := uuid.New(), uuid.New()
+
+	serverConn, client := newTestConnPair(t)
+	manager.AddConnection(online, uuid.New(), serverConn)
+
+	event, err := eventbus.NewEvent(MessageEventRead, map[string]string{"chat_id": "1"}, online, offline)
+	require.NoError(t, err)
+	require.NoError(t, manager.DeliverEvent(context.Background(), event))
+
+	_ = client.SetReadDeadline(time.Now().Add(time.Second))
+	_, msg, err := client.ReadMessage()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"message_read","payload":{"chat_id":"1"}}`, string(msg))
+}
+
+func TestWSConnectionManager_StalledClient(t *testing.T) {
+	manager := NewWSConnectionManager()
+	manager.writeTimeout = 50 * time.Millisecond
+	stalled, other := uuid.New(), uuid.New()
+
+	// the client of the stalled user never reads, so its socket buffers fill up
+	stalledConn, _ := newTestConnPair(t)
+	manager.AddConnection(stalled, uuid.New(), stalledConn)
+	otherConn, otherClient := newTestConnPair(t)
+	manager.AddConnection(other, uuid.New(), otherConn)
+
+	data := []byte(strings.Repeat("x", 1<<20))
+	done := make(chan error, 1)
+	go func() {
+		for {
+			if err := manager.SendToUser(stalled, data); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("write to the stalled client was not timed out")
+	}
+
+	require.NoError(t, manager.SendToUser(other, []byte("hello")))
+	_ = otherClient.SetReadDeadline(time.Now().Add(time.Second))
+	_, msg, err := otherClient.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(msg))
+}
+
+type deliveryRecorder struct {
+	delivered []uuid.UUID
+}
+
+func (d *deliveryRecorder) EventDelivered(_ context.Context, userId uuid.UUID, _ eventbus.Event) {
+	d.delivered = append(d.delivered, userId)
+}
+
+func TestWSConnectionManager_DeliverEvent_NotifiesListener(t *testing.T) {
+	manager := NewWSConnectionManager()
+	recorder := &deliveryRecorder{}
+	manager.SetDeliveryListener(recorder)
+	online, offline := uuid.New(), uuid.New()
+
+	serverConn, _ := newTestConnPair(t)
+	manager.AddConnection(online, uuid.New(), serverConn)
+
+	event, err := eventbus.NewEvent(MessageEventSend, map[string]string{"chat_id": "1"}, online, offline)
+	require.NoError(t, err)
+	require.NoError(t, manager.DeliverEvent(context.Background(), event))
+
+	assert.Equal(t, []uuid.UUID{online}, recorder.delivered)
+}
+
+func TestWSConnectionManager_HoldConnection(t *testing.T) {
+	manager := NewWSConnectionManager()
+	userId, connId := uuid.New(), uuid.New()
+
+	serverConn, client := newTestConnPair(t)
+	manager.AddConnection(userId, connId, serverConn)
+
+	require.NoError(t, manager.HoldConnection(userId, connId))
+	require.NoError(t, manager.SendToUser(userId, []byte(`{"type":"live"}`)))
+	require.NoError(t, manager.WriteToConnection(userId, connId, websocket.TextMessage, []byte(`{"type":"replayed"}`)))
+	require.NoError(t, manager.ReleaseConnection(userId, connId))
+
+	for _, expected := range []string{`{"type":"replayed"}`, `{"type":"live"}`} {
+		_ = client.SetReadDeadline(time.Now().Add(time.Second))
+		_, msg, err := client.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(msg))
+	}
+
+	assert.Error(t, manager.HoldConnection(userId, uuid.New()))
+}
+
+// memoryPresenceCounter plays the shared counter of several gateway instances
+type memoryPresenceCounter struct {
+	mu     sync.Mutex
+	counts map[uuid.UUID]int64
+	calls  int
+}
+
+func (c *memoryPresenceCounter) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+func (c *memoryPresenceCounter) Incr(_ context.Context, userId uuid.UUID) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	c.counts[userId]++
+	return c.counts[userId], nil
+}
+
+func (c *memoryPresenceCounter) Decr(_ context.Context, userId uuid.UUID) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	c.counts[userId]--
+	return c.counts[userId], nil
+}
+
+func TestWSConnectionManager_Presence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	friendsService := mocks.NewMockFriendsUseCase(ctrl)
+	bus := newRecordingBus()
+	counter := &memoryPresenceCounter{counts: make(map[uuid.UUID]int64)}
+
+	// two gateway instances share the counter
+	presenceA := NewInternalWSPresenceHandler(bus, friendsService, counter)
+	presenceB := NewInternalWSPresenceHandler(bus, friendsService, counter)
+	go presenceA.Run(ctx)
+	go presenceB.Run(ctx)
+	managerA, managerB := NewWSConnectionManager(), NewWSConnectionManager()
+	managerA.SetPresenceListener(presenceA)
+	managerB.SetPresenceListener(presenceB)
+
+	userId, friendId := uuid.New(), uuid.New()
+	friendsService.EXPECT().GetFriendsInfo(gomock.Any(), userId.String(), "100", "0", "all").
+		Return([]models.FriendInfo{{Id: friendId}}, 1, nil).Times(2)
+
+	serverConn1, _ := newTestConnPair(t)
+	serverConn2, _ := newTestConnPair(t)
+	serverConn3, _ := newTestConnPair(t)
+	connId1, connId2, connId3 := uuid.New(), uuid.New(), uuid.New()
+
+	managerA.AddConnection(userId, connId1, serverConn1)
+	online := bus.next(t)
+	assert.Equal(t, string(PresenceEventOnline), online.Type)
+	assert.Equal(t, []uuid.UUID{friendId}, online.Receivers)
+
+	// more connections on this and another instance and closing some of them do not change presence
+	managerA.AddConnection(userId, connId2, serverConn2)
+	managerB.AddConnection(userId, connId3, serverConn3)
+	managerA.RemoveAndCloseConnection(userId, connId1)
+	managerB.RemoveAndCloseConnection(userId, connId3)
+	require.Eventually(t, func() bool { return counter.callCount() == 3 }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, bus.events)
+
+	managerA.RemoveAndCloseConnection(userId, connId2)
+	offline := bus.next(t)
+	assert.Equal(t, string(PresenceEventOffline), offline.Type)
+	assert.Equal(t, []uuid.UUID{friendId}, offline.Receivers)
+
+	// unknown connection is not reported
+	managerA.RemoveAndCloseConnection(userId, connId2)
+	assert.Empty(t, bus.events)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
@@ -14,6 +16,7 @@ import (
 	"quickflow/gateway/internal/delivery/http/forms"
 	forms2 "quickflow/gateway/internal/delivery/ws/forms"
 	"quickflow/gateway/utils/validation"
+	"quickflow/shared/eventbus"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 )
@@ -21,23 +24,95 @@ import (
 const (
 	MessageEventRead    = "message_read"
 	MessageEventDeleted = "message_delete"
+	MessageEventEdited  = "message_edit"
 	ChatEventDeleted    = "chat_delete"
 	MessageEventSend    = "message"
+
+	MessageEventDelivered = "message_delivered"
+
+	ReactionEventAdded   = "reaction_add"
+	ReactionEventRemoved = "reaction_remove"
+
+	PinEventAdded   = "message_pin"
+	PinEventRemoved = "message_unpin"
+
+	PollVoteCommand = "poll_vote"
+	PollEventUpdate = "poll_update"
+
+	ForwardCommand  = "message_forward"
+	ResumeCommand   = "resume"
+	ResumeEventDone = "resume_done"
 )
 
+// resumePageSize is the number of events fetched from messenger at once during replay
+const resumePageSize = 100
+
+const (
+	// deliveryWorkers report delivered messages to messenger in parallel
+	deliveryWorkers = 4
+	// deliveryQueueSize bounds the number of user chats waiting for a delivery report,
+	// reports that do not fit are dropped, the next message of the chat covers them
+	deliveryQueueSize = 1024
+	// deliveryTimeout bounds reporting of a single user chat
+	deliveryTimeout = 10 * time.Second
+)
+
+type deliveryKey struct {
+	userId uuid.UUID
+	chatId uuid.UUID
+}
+
+type deliveredMessage struct {
+	message   forms.MessageOut
+	createdAt time.Time
+}
+
+// pendingDelivery keeps the latest delivered message of the chat for every sender,
+// the newest of them is reported to messenger and covers the older ones
+type pendingDelivery struct {
+	latest  time.Time
+	senders map[uuid.UUID]deliveredMessage
+}
+
+func (p *pendingDelivery) add(senderId uuid.UUID, delivered deliveredMessage) {
+	if delivered.createdAt.After(p.latest) {
+		p.latest = delivered.createdAt
+	}
+	if last, ok := p.senders[senderId]; !ok || delivered.createdAt.After(last.createdAt) {
+		p.senders[senderId] = delivered
+	}
+}
+
+// ResumableConnections allows to write missed events to the connection before live ones
+type ResumableConnections interface {
+	HoldConnection(userId, connId uuid.UUID) error
+	ReleaseConnection(userId, connId uuid.UUID) error
+	WriteToConnection(userId, connId uuid.UUID, messageType int, data []byte) error
+	IsOnline(userId uuid.UUID) bool
+	DeliverEvent(ctx context.Context, event eventbus.Event) error
+}
+
 type InternalWSMessageHandler struct {
-	WSConnectionManager *WSConnectionManager
-	MessageUseCase      http2.MessageService
-	profileUseCase      http2.ProfileUseCase
-	ChatUseCase         http2.ChatUseCase
+	eventBus       EventBus
+	connManager    ResumableConnections
+	MessageUseCase http2.MessageService
+	profileUseCase http2.ProfileUseCase
+	ChatUseCase    http2.ChatUseCase
+
+	deliveryMu        sync.Mutex
+	pendingDeliveries map[deliveryKey]*pendingDelivery
+	deliveryQueue     chan deliveryKey
 }
 
-func NewInternalWSMessageHandler(wsConnManager *WSConnectionManager, messageUseCase http2.MessageService, profileUseCase http2.ProfileUseCase, chatUseCase http2.ChatUseCase) *InternalWSMessageHandler {
+func NewInternalWSMessageHandler(eventBus EventBus, connManager ResumableConnections, messageUseCase http2.MessageService, profileUseCase http2.ProfileUseCase, chatUseCase http2.ChatUseCase) *InternalWSMessageHandler {
 	return &InternalWSMessageHandler{
-		WSConnectionManager: wsConnManager,
-		MessageUseCase:      messageUseCase,
-		profileUseCase:      profileUseCase,
-		ChatUseCase:         chatUseCase,
+		eventBus:          eventBus,
+		connManager:       connManager,
+		MessageUseCase:    messageUseCase,
+		profileUseCase:    profileUseCase,
+		ChatUseCase:       chatUseCase,
+		pendingDeliveries: make(map[deliveryKey]*pendingDelivery),
+		deliveryQueue:     make(chan deliveryKey, deliveryQueueSize),
 	}
 }
 
@@ -46,7 +121,8 @@ func (m *InternalWSMessageHandler) SendMessage(ctx context.Context, user models.
 	if err := json.Unmarshal(payload, &messageForm); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
-	if len(messageForm.Text)+len(messageForm.Media)+len(messageForm.Audio)+len(messageForm.File)+len(messageForm.Stickers) == 0 {
+	if len(messageForm.Text)+len(messageForm.Media)+len(messageForm.Audio)+len(messageForm.File)+len(messageForm.Stickers)+len(messageForm.Ciphertext) == 0 &&
+		messageForm.Poll == nil {
 		return fmt.Errorf("message cannot be empty")
 	}
 
@@ -56,13 +132,15 @@ func (m *InternalWSMessageHandler) SendMessage(ctx context.Context, user models.
 		return fmt.Errorf("chatId and receiverId cannot be both nil")
 	}
 
-	message := messageForm.ToMessageModel()
-	if err := validation.ValidateMessage(message); err != nil {
+	message, err := messageForm.ToMessageModel()
+	if err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	if err = validation.ValidateMessage(message); err != nil {
 		logger.Error(ctx, "Invalid message: %v", err)
 		return fmt.Errorf("invalid message: %w", err)
 	}
 
-	var err error
 	newMessage, err := m.MessageUseCase.SendMessage(ctx, &message, user.Id)
 	if err != nil {
 		log.Println("Failed to save message:", err)
@@ -91,13 +169,145 @@ func (m *InternalWSMessageHandler) SendMessage(ctx context.Context, user models.
 
 // SendMessageToChat sends a message to all participants in a chat
 func (m *InternalWSMessageHandler) sendMessageToChat(ctx context.Context, message models.Message, publicSenderInfo models.PublicUserInfo, chatParticipants []uuid.UUID) error {
-	for _, user := range chatParticipants {
-		err := m.notifyMessageEvent(ctx, forms.ToMessageOut(message, publicSenderInfo), user, MessageEventSend)
+	messageOut, err := m.toMessageOut(ctx, message, publicSenderInfo)
+	if err != nil {
+		return err
+	}
+	return m.notifyMessageEvent(ctx, messageOut, MessageEventSend, chatParticipants...)
+}
+
+// toMessageOut converts the message with authors of the quoted and forwarded messages
+func (m *InternalWSMessageHandler) toMessageOut(ctx context.Context, message models.Message, publicSenderInfo models.PublicUserInfo) (forms.MessageOut, error) {
+	messageOut := forms.ToMessageOut(publicMessage(message), publicSenderInfo)
+	if messageOut.ReplyTo != nil && !messageOut.ReplyTo.Deleted {
+		replySenderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, messageOut.ReplyTo.SenderId)
 		if err != nil {
-			log.Println("Failed to send message to user:", user, err)
+			return forms.MessageOut{}, fmt.Errorf("failed to get public info of replied message sender: %w", err)
 		}
+		replySender := forms.PublicUserInfoToOut(replySenderInfo, "")
+		messageOut.ReplyTo.Sender = &replySender
 	}
+	if messageOut.ForwardedFrom != nil {
+		originalSenderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, messageOut.ForwardedFrom.SenderId)
+		if err != nil {
+			return forms.MessageOut{}, fmt.Errorf("failed to get public info of forwarded message sender: %w", err)
+		}
+		originalSender := forms.PublicUserInfoToOut(originalSenderInfo, "")
+		messageOut.ForwardedFrom.Sender = &originalSender
+	}
+	return messageOut, nil
+}
 
+// publicMessage drops votes and hidden results of the user who fetched the message before it is sent to the whole chat
+func publicMessage(message models.Message) models.Message {
+	if message.Poll != nil {
+		poll := message.Poll.PublicView(time.Now())
+		message.Poll = &poll
+	}
+	return message
+}
+
+// DeliverScheduledMessage delivers a scheduled message sent by messenger as a new one.
+// Every gateway instance gets the messenger event, so the message is written only to local connections
+func (m *InternalWSMessageHandler) DeliverScheduledMessage(ctx context.Context, event eventbus.Event) error {
+	if event.Type != eventbus.ScheduledMessageSent {
+		return nil
+	}
+
+	var receivers []uuid.UUID
+	for _, receiver := range event.Receivers {
+		if m.connManager.IsOnline(receiver) {
+			receivers = append(receivers, receiver)
+		}
+	}
+	if len(receivers) == 0 {
+		return nil
+	}
+
+	var payload eventbus.ScheduledMessageSentPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	message, err := m.MessageUseCase.GetMessageById(ctx, payload.MessageID)
+	if err != nil {
+		return fmt.Errorf("failed to get scheduled message: %w", err)
+	}
+	publicSenderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, message.SenderID)
+	if err != nil {
+		return fmt.Errorf("failed to get public sender info: %w", err)
+	}
+	messageOut, err := m.toMessageOut(ctx, *message, publicSenderInfo)
+	if err != nil {
+		return err
+	}
+
+	messageEvent, err := eventbus.NewEvent(MessageEventSend, messageOut, receivers...)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return m.connManager.DeliverEvent(ctx, messageEvent)
+}
+
+// NotifyChatMessages delivers messages created outside of the websocket, like system messages about group chat changes
+func (m *InternalWSMessageHandler) NotifyChatMessages(ctx context.Context, messages []*models.Message, receivers []uuid.UUID) error {
+	senders := make(map[uuid.UUID]models.PublicUserInfo)
+	for _, message := range messages {
+		senderInfo, ok := senders[message.SenderID]
+		if !ok {
+			var err error
+			senderInfo, err = m.profileUseCase.GetPublicUserInfo(ctx, message.SenderID)
+			if err != nil {
+				return fmt.Errorf("failed to get public sender info: %w", err)
+			}
+			senders[message.SenderID] = senderInfo
+		}
+
+		if err := m.sendMessageToChat(ctx, *message, senderInfo, receivers); err != nil {
+			return fmt.Errorf("failed to send message to chat: %w", err)
+		}
+	}
+	return nil
+}
+
+// ForwardMessages copies messages to another chat and delivers the copies as new messages
+func (m *InternalWSMessageHandler) ForwardMessages(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.ForwardMessagesPayload
+
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if len(payload.MessageIds) == 0 {
+		return fmt.Errorf("messageIds are empty")
+	}
+	if payload.ChatId == uuid.Nil && payload.ReceiverId == uuid.Nil {
+		return fmt.Errorf("chatId and receiverId cannot be both nil")
+	}
+
+	messages, err := m.MessageUseCase.ForwardMessages(ctx, payload.MessageIds, payload.ChatId, payload.ReceiverId, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to forward messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	publicSenderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get public sender info: %w", err)
+	}
+	// all copies are in the same chat
+	chatParticipants, err := m.ChatUseCase.GetChatParticipants(ctx, messages[0].ChatID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+
+	for _, message := range messages {
+		if err = m.sendMessageToChat(ctx, *message, publicSenderInfo, chatParticipants); err != nil {
+			return fmt.Errorf("failed to send message to chat: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -117,43 +327,138 @@ func (m *InternalWSMessageHandler) MarkMessageRead(ctx context.Context, user mod
 		return fmt.Errorf("failed to get message by id: %w", err)
 	}
 
-	err = m.MessageUseCase.UpdateLastReadTs(ctx, payload.ChatId, user.Id, msg.CreatedAt, user.Id)
+	seq, err := m.MessageUseCase.UpdateLastReadTs(ctx, payload.ChatId, user.Id, payload.MessageId, msg.CreatedAt, user.Id)
 	if err != nil {
 		return fmt.Errorf("failed to update last message read: %w", err)
 	}
 
-	// send message to message author
+	// send message to message author and to other devices of the reader
 	messageReadForm := forms2.NotifyMessageRead{
 		MessageId: payload.MessageId,
 		Timestamp: msg.CreatedAt.Format(time2.TimeStampLayout),
 		ChatId:    payload.ChatId,
 		SenderId:  user.Id,
+		Seq:       seq,
 	}
 
-	err = m.notifyMessageEvent(ctx, messageReadForm, msg.SenderID, MessageEventRead)
+	receivers := []uuid.UUID{msg.SenderID}
+	if msg.SenderID != user.Id {
+		receivers = append(receivers, user.Id)
+	}
+	err = m.notifyMessageEvent(ctx, messageReadForm, MessageEventRead, receivers...)
 	if err != nil {
 		return fmt.Errorf("failed to notify message read: %w", err)
 	}
 	return nil
 }
 
-func (m *InternalWSMessageHandler) notifyMessageEvent(_ context.Context, read interface{}, receiver uuid.UUID, eventType MessageEvent) error {
-	conn, exists := m.WSConnectionManager.IsConnected(receiver)
-	if !exists {
-		return nil
+// EventDelivered records that a new message reached the receiver and tells the sender about it.
+// Events are delivered one by one, so messenger is called in background by RunDeliveries,
+// messages delivered to the same user chat meanwhile are reported at once
+func (m *InternalWSMessageHandler) EventDelivered(ctx context.Context, userId uuid.UUID, event eventbus.Event) {
+	if event.Type != MessageEventSend {
+		return
+	}
+
+	var message forms.MessageOut
+	if err := json.Unmarshal(event.Payload, &message); err != nil {
+		logger.Error(ctx, "Failed to unmarshal delivered message: %v", err)
+		return
+	}
+	senderId, err := uuid.Parse(message.Sender.ID)
+	if err != nil || senderId == userId {
+		return
+	}
+	createdAt, err := time.Parse(time2.TimeStampLayout, message.CreatedAt)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse creation time of delivered message %s: %v", message.ID, err)
+		return
+	}
+
+	m.enqueueDelivery(ctx, deliveryKey{userId: userId, chatId: message.ChatId}, senderId,
+		deliveredMessage{message: message, createdAt: createdAt})
+}
+
+// enqueueDelivery merges the message into the pending report of the user chat, only a new report takes a place in the queue
+func (m *InternalWSMessageHandler) enqueueDelivery(ctx context.Context, key deliveryKey, senderId uuid.UUID, delivered deliveredMessage) {
+	m.deliveryMu.Lock()
+	defer m.deliveryMu.Unlock()
+
+	if pending, ok := m.pendingDeliveries[key]; ok {
+		pending.add(senderId, delivered)
+		return
+	}
+
+	select {
+	case m.deliveryQueue <- key:
+		pending := &pendingDelivery{senders: make(map[uuid.UUID]deliveredMessage)}
+		pending.add(senderId, delivered)
+		m.pendingDeliveries[key] = pending
+	default:
+		logger.Error(ctx, "Delivery queue is full, message %s delivered to user %s is not reported", delivered.message.ID, key.userId)
+	}
+}
+
+// RunDeliveries reports delivered messages until ctx is done
+func (m *InternalWSMessageHandler) RunDeliveries(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < deliveryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case key := <-m.deliveryQueue:
+					m.reportDelivery(ctx, key)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (m *InternalWSMessageHandler) reportDelivery(ctx context.Context, key deliveryKey) {
+	m.deliveryMu.Lock()
+	pending := m.pendingDeliveries[key]
+	delete(m.pendingDeliveries, key)
+	m.deliveryMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	updated, err := m.MessageUseCase.UpdateLastDeliveredTs(ctx, key.chatId, pending.latest, key.userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to update last delivered message of user %s in chat %s: %v", key.userId, key.chatId, err)
+		return
+	}
+	// the user got these messages on another instance too, the senders were told already
+	if !updated {
+		return
 	}
 
-	out := struct {
-		Type string      `json:"type"`
-		Data interface{} `json:"payload"`
-	}{string(eventType), read}
+	for senderId, delivered := range pending.senders {
+		notification := forms2.NotifyMessageDelivered{
+			ChatId:    delivered.message.ChatId,
+			MessageId: delivered.message.ID,
+			Timestamp: delivered.message.CreatedAt,
+			UserId:    key.userId,
+		}
+		if err = m.notifyMessageEvent(ctx, notification, MessageEventDelivered, senderId); err != nil {
+			logger.Error(ctx, "Failed to notify message %s delivered: %v", delivered.message.ID, err)
+		}
+	}
+}
 
-	msgJSON, err := json.Marshal(out)
+// notifyMessageEvent publishes event to the bus, every gateway instance delivers it to the receivers connected to it
+func (m *InternalWSMessageHandler) notifyMessageEvent(ctx context.Context, read interface{}, eventType MessageEvent, receivers ...uuid.UUID) error {
+	event, err := eventbus.NewEvent(string(eventType), read, receivers...)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	err = conn.WriteMessage(websocket.TextMessage, msgJSON)
+	err = m.eventBus.Publish(ctx, event)
 	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
@@ -176,11 +481,8 @@ func (m *InternalWSMessageHandler) DeleteMessage(ctx context.Context, user model
 		return fmt.Errorf("failed to get message by id: %w", err)
 	}
 
-	if msg.SenderID != user.Id {
-		return fmt.Errorf("user did not send the message")
-	}
-
-	err = m.MessageUseCase.DeleteMessage(ctx, payload.MessageId)
+	// messenger allows admins of group chats to delete messages of others
+	seq, err := m.MessageUseCase.DeleteMessage(ctx, payload.MessageId, user.Id)
 	if err != nil {
 		return fmt.Errorf("failed to delete message: %w", err)
 	}
@@ -194,15 +496,226 @@ func (m *InternalWSMessageHandler) DeleteMessage(ctx context.Context, user model
 	response := forms2.NotifyDeleteMessage{
 		MessageId: payload.MessageId,
 		ChatId:    msg.ChatID,
+		Seq:       seq,
+	}
+
+	err = m.notifyMessageEvent(ctx, response, MessageEventDeleted, participants...)
+	if err != nil {
+		return fmt.Errorf("failed to notify message read: %w", err)
+	}
+
+	return nil
+}
+
+func (m *InternalWSMessageHandler) EditMessage(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.EditMessagePayload
+
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if payload.MessageId == uuid.Nil {
+		return fmt.Errorf("messageId is empty")
+	}
+	if len(payload.Text)+len(payload.Media)+len(payload.Audio)+len(payload.File)+len(payload.Stickers) == 0 {
+		return fmt.Errorf("message cannot be empty")
 	}
 
+	messageForm := forms.MessageForm{
+		Text:     payload.Text,
+		Media:    payload.Media,
+		Audio:    payload.Audio,
+		File:     payload.File,
+		Stickers: payload.Stickers,
+		SenderId: user.Id,
+	}
+	message, err := messageForm.ToMessageModel()
+	if err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	message.ID = payload.MessageId
+	if err = validation.ValidateMessage(message); err != nil {
+		logger.Error(ctx, "Invalid message: %v", err)
+		return fmt.Errorf("invalid message: %w", err)
+	}
+
+	// ownership is checked by messenger
+	updatedMessage, seq, err := m.MessageUseCase.UpdateMessage(ctx, &message, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update message: %w", err)
+	}
+
+	publicSenderInfo, err := m.profileUseCase.GetPublicUserInfo(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get public sender info: %w", err)
+	}
+
+	participants, err := m.ChatUseCase.GetChatParticipants(ctx, updatedMessage.ChatID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+
+	messageOut, err := m.toMessageOut(ctx, *updatedMessage, publicSenderInfo)
+	if err != nil {
+		return err
+	}
+	response := forms2.NotifyEditMessage{
+		Message: messageOut,
+		Seq:     seq,
+	}
+
+	err = m.notifyMessageEvent(ctx, response, MessageEventEdited, participants...)
+	if err != nil {
+		return fmt.Errorf("failed to notify message edit: %w", err)
+	}
+
+	return nil
+}
+
+func (m *InternalWSMessageHandler) AddReaction(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	return m.handleReaction(ctx, user, jsonPayload, ReactionEventAdded, m.MessageUseCase.AddReaction)
+}
+
+func (m *InternalWSMessageHandler) RemoveReaction(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	return m.handleReaction(ctx, user, jsonPayload, ReactionEventRemoved, m.MessageUseCase.RemoveReaction)
+}
+
+// handleReaction applies the reaction change and notifies all chat participants about it
+func (m *InternalWSMessageHandler) handleReaction(ctx context.Context, user models.User, jsonPayload json.RawMessage, eventType MessageEvent,
+	apply func(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error)) error {
+	var payload forms2.ReactionPayload
+
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if payload.MessageId == uuid.Nil {
+		return fmt.Errorf("messageId is empty")
+	}
+	if len(payload.Emoji) == 0 {
+		return fmt.Errorf("emoji is empty")
+	}
+
+	// emoji and access to the message are checked by messenger
+	chatId, seq, err := apply(ctx, payload.MessageId, payload.Emoji, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update reaction: %w", err)
+	}
+
+	participants, err := m.ChatUseCase.GetChatParticipants(ctx, chatId)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+
+	response := forms2.NotifyReaction{
+		ChatId:    chatId,
+		MessageId: payload.MessageId,
+		UserId:    user.Id,
+		Emoji:     payload.Emoji,
+		Seq:       seq,
+	}
+
+	err = m.notifyMessageEvent(ctx, response, eventType, participants...)
+	if err != nil {
+		return fmt.Errorf("failed to notify reaction: %w", err)
+	}
+
+	return nil
+}
+
+// VotePoll replaces the vote of the user in the poll of the message.
+// The voter gets the poll with their choice, other participants get the public tallies.
+func (m *InternalWSMessageHandler) VotePoll(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.PollVotePayload
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if payload.MessageId == uuid.Nil {
+		return fmt.Errorf("messageId is empty")
+	}
+
+	// options and access to the message are checked by messenger
+	message, err := m.MessageUseCase.VotePoll(ctx, payload.MessageId, payload.Options, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to vote in poll: %w", err)
+	}
+
+	participants, err := m.ChatUseCase.GetChatParticipants(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+	others := make([]uuid.UUID, 0, len(participants))
 	for _, participant := range participants {
-		err := m.notifyMessageEvent(ctx, response, participant, MessageEventDeleted)
-		if err != nil {
-			return fmt.Errorf("failed to notify message read: %w", err)
+		if participant != user.Id {
+			others = append(others, participant)
 		}
 	}
 
+	response := forms2.NotifyPollUpdate{
+		ChatId:    message.ChatID,
+		MessageId: message.ID,
+		Poll:      forms.ToPollOut(message.Poll),
+	}
+	if err = m.notifyMessageEvent(ctx, response, PollEventUpdate, user.Id); err != nil {
+		return fmt.Errorf("failed to notify poll update: %w", err)
+	}
+
+	if len(others) == 0 {
+		return nil
+	}
+	response.Poll = forms.ToPollOut(publicMessage(*message).Poll)
+	if err = m.notifyMessageEvent(ctx, response, PollEventUpdate, others...); err != nil {
+		return fmt.Errorf("failed to notify poll update: %w", err)
+	}
+
+	return nil
+}
+
+func (m *InternalWSMessageHandler) PinMessage(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	return m.handlePin(ctx, user, jsonPayload, PinEventAdded, m.MessageUseCase.PinMessage)
+}
+
+func (m *InternalWSMessageHandler) UnpinMessage(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	return m.handlePin(ctx, user, jsonPayload, PinEventRemoved, m.MessageUseCase.UnpinMessage)
+}
+
+// handlePin applies the pin change and notifies all chat participants about it
+func (m *InternalWSMessageHandler) handlePin(ctx context.Context, user models.User, jsonPayload json.RawMessage, eventType MessageEvent,
+	apply func(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error)) error {
+	var payload forms2.PinPayload
+
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if payload.MessageId == uuid.Nil {
+		return fmt.Errorf("messageId is empty")
+	}
+
+	// rights to pin in the chat are checked by messenger
+	chatId, seq, err := apply(ctx, payload.MessageId, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update pin: %w", err)
+	}
+
+	participants, err := m.ChatUseCase.GetChatParticipants(ctx, chatId)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+
+	response := forms2.NotifyPin{
+		ChatId:    chatId,
+		MessageId: payload.MessageId,
+		UserId:    user.Id,
+		Seq:       seq,
+	}
+
+	err = m.notifyMessageEvent(ctx, response, eventType, participants...)
+	if err != nil {
+		return fmt.Errorf("failed to notify pin: %w", err)
+	}
+
 	return nil
 }
 
@@ -217,34 +730,171 @@ func (m *InternalWSMessageHandler) DeleteChat(ctx context.Context, user models.U
 		return fmt.Errorf("chatId is empty")
 	}
 
+	// participants are gone with the chat, so they are fetched first
 	participants, err := m.ChatUseCase.GetChatParticipants(ctx, payload.ChatId)
 	if err != nil {
 		return fmt.Errorf("failed to get chat participants: %w", err)
 	}
 
-	// check if user in participants
-	var flag bool
-	for _, participant := range participants {
-		if participant == user.Id {
-			flag = true
-			break
-		}
+	// messenger checks that the user may delete the chat, group chats only by the owner
+	err = m.ChatUseCase.DeleteChat(ctx, payload.ChatId, user.Id)
+	if err != nil {
+		return fmt.Errorf("failed to delete chat: %w", err)
 	}
 
-	if !flag {
-		return fmt.Errorf("user not found in chat")
+	err = m.notifyMessageEvent(ctx, payload, ChatEventDeleted, participants...)
+	if err != nil {
+		return fmt.Errorf("failed to notify message read: %w", err)
 	}
+	return nil
+}
 
-	err = m.ChatUseCase.DeleteChat(ctx, payload.ChatId)
-	if err != nil {
-		return fmt.Errorf("failed to delete message: %w", err)
+// Resume replays events the client missed in the given chats to the connection the command came from,
+// live events for the connection are postponed until the replay is over
+func (m *InternalWSMessageHandler) Resume(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.ResumePayload
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	for _, participant := range participants {
-		err := m.notifyMessageEvent(ctx, payload, participant, ChatEventDeleted)
+	connId, ok := ctx.Value("wsConnId").(uuid.UUID)
+	if !ok {
+		return fmt.Errorf("failed to get connection id from context")
+	}
+
+	if err := m.connManager.HoldConnection(user.Id, connId); err != nil {
+		return fmt.Errorf("failed to hold connection: %w", err)
+	}
+	defer func() {
+		if err := m.connManager.ReleaseConnection(user.Id, connId); err != nil {
+			logger.Error(ctx, "Failed to release connection %s of user %s: %v", connId, user.Id, err)
+		}
+	}()
+
+	senders := make(map[uuid.UUID]models.PublicUserInfo)
+	done := forms2.NotifyResumeDone{Chats: make([]forms2.ResumeChat, 0, len(payload.Chats))}
+	for _, chat := range payload.Chats {
+		if chat.ChatId == uuid.Nil {
+			return fmt.Errorf("chatId is empty")
+		}
+
+		lastSeq, err := m.replayChat(ctx, user, connId, chat.ChatId, chat.LastSeq, senders)
+		if err != nil {
+			return fmt.Errorf("failed to replay chat %s: %w", chat.ChatId, err)
+		}
+		done.Chats = append(done.Chats, forms2.ResumeChat{ChatId: chat.ChatId, LastSeq: lastSeq})
+	}
+
+	return m.writeToConnection(user.Id, connId, ResumeEventDone, done)
+}
+
+// replayChat writes events of the chat after seq to the connection and returns seq of the last one
+func (m *InternalWSMessageHandler) replayChat(ctx context.Context, user models.User, connId uuid.UUID,
+	chatId uuid.UUID, seq int64, senders map[uuid.UUID]models.PublicUserInfo) (int64, error) {
+	for {
+		events, err := m.MessageUseCase.GetChatEventsSince(ctx, chatId, seq, resumePageSize, user.Id)
 		if err != nil {
-			return fmt.Errorf("failed to notify message read: %w", err)
+			return seq, fmt.Errorf("failed to get chat events: %w", err)
 		}
+
+		for _, event := range events {
+			seq = event.Seq
+
+			var (
+				eventType string
+				out       interface{}
+			)
+			switch event.Type {
+			case models.ChatEventMessageSent, models.ChatEventMessageEdited:
+				if event.Message == nil {
+					continue
+				}
+				sender, found := senders[event.Message.SenderID]
+				if !found {
+					sender, err = m.profileUseCase.GetPublicUserInfo(ctx, event.Message.SenderID)
+					if err != nil {
+						return seq, fmt.Errorf("failed to get public sender info: %w", err)
+					}
+					senders[event.Message.SenderID] = sender
+				}
+				messageOut, err := m.toMessageOut(ctx, *event.Message, sender)
+				if err != nil {
+					return seq, err
+				}
+				if event.Type == models.ChatEventMessageSent {
+					eventType, out = MessageEventSend, messageOut
+				} else {
+					eventType, out = MessageEventEdited, forms2.NotifyEditMessage{
+						Message: messageOut,
+						Seq:     event.Seq,
+					}
+				}
+			case models.ChatEventMessageDeleted:
+				eventType, out = MessageEventDeleted, forms2.NotifyDeleteMessage{
+					ChatId:    event.ChatID,
+					MessageId: event.MessageID,
+					Seq:       event.Seq,
+				}
+			case models.ChatEventMessageRead:
+				// own reads are replayed too, they may come from another device of the user
+				eventType, out = MessageEventRead, forms2.NotifyMessageRead{
+					MessageId: event.MessageID,
+					ChatId:    event.ChatID,
+					Timestamp: event.CreatedAt.Format(time2.TimeStampLayout),
+					SenderId:  event.UserID,
+					Seq:       event.Seq,
+				}
+			case models.ChatEventReactionAdded, models.ChatEventReactionRemoved:
+				eventType = ReactionEventAdded
+				if event.Type == models.ChatEventReactionRemoved {
+					eventType = ReactionEventRemoved
+				}
+				out = forms2.NotifyReaction{
+					ChatId:    event.ChatID,
+					MessageId: event.MessageID,
+					UserId:    event.UserID,
+					Emoji:     event.Reaction,
+					Seq:       event.Seq,
+				}
+			case models.ChatEventMessagePinned, models.ChatEventMessageUnpinned:
+				eventType = PinEventAdded
+				if event.Type == models.ChatEventMessageUnpinned {
+					eventType = PinEventRemoved
+				}
+				out = forms2.NotifyPin{
+					ChatId:    event.ChatID,
+					MessageId: event.MessageID,
+					UserId:    event.UserID,
+					Seq:       event.Seq,
+				}
+			default:
+				continue
+			}
+
+			if err = m.writeToConnection(user.Id, connId, eventType, out); err != nil {
+				return seq, err
+			}
+		}
+
+		if len(events) < resumePageSize {
+			return seq, nil
+		}
+	}
+}
+
+func (m *InternalWSMessageHandler) writeToConnection(userId, connId uuid.UUID, eventType string, payload interface{}) error {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	msgJSON, err := marshalWSMessage(eventType, payloadJSON)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	if err = m.connManager.WriteToConnection(userId, connId, websocket.TextMessage, msgJSON); err != nil {
+		return fmt.Errorf("failed to write to connection: %w", err)
 	}
 	return nil
 }
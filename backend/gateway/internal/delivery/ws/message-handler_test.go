@@ -0,0 +1,109 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	time2 "quickflow/config/time"
+	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/gateway/internal/delivery/http/mocks"
+	forms2 "quickflow/gateway/internal/delivery/ws/forms"
+	"quickflow/shared/eventbus"
+)
+
+func newMessageEvent(t *testing.T, chatId, senderId uuid.UUID, createdAt time.Time, receiver uuid.UUID) (eventbus.Event, forms.MessageOut) {
+	t.Helper()
+	message := forms.MessageOut{
+		ID:        uuid.New(),
+		ChatId:    chatId,
+		CreatedAt: createdAt.Format(time2.TimeStampLayout),
+		Sender:    forms.PublicUserInfoOut{ID: senderId.String()},
+	}
+	event, err := eventbus.NewEvent(MessageEventSend, message, receiver)
+	require.NoError(t, err)
+	return event, message
+}
+
+func TestInternalWSMessageHandler_EventDeliveredMergesReports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	messageUseCase := mocks.NewMockMessageService(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSMessageHandler(bus, nil, messageUseCase, nil, nil)
+
+	userId, chatId := uuid.New(), uuid.New()
+	first, second := uuid.New(), uuid.New()
+	now := time.Now().Truncate(time.Millisecond)
+
+	// messages delivered before the worker runs are reported together
+	event1, _ := newMessageEvent(t, chatId, first, now, userId)
+	event2, message2 := newMessageEvent(t, chatId, second, now.Add(time.Second), userId)
+	event3, message3 := newMessageEvent(t, chatId, first, now.Add(2*time.Second), userId)
+	for _, event := range []eventbus.Event{event1, event2, event3} {
+		handler.EventDelivered(context.Background(), userId, event)
+	}
+
+	messageUseCase.EXPECT().UpdateLastDeliveredTs(gomock.Any(), chatId, gomock.Any(), userId).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, ts time.Time, _ uuid.UUID) (bool, error) {
+			assert.True(t, now.Add(2*time.Second).Equal(ts))
+			return true, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go handler.RunDeliveries(ctx)
+
+	// every sender is told about the latest of its messages
+	delivered := map[string]uuid.UUID{}
+	for i := 0; i < 2; i++ {
+		event := bus.next(t)
+		assert.Equal(t, MessageEventDelivered, event.Type)
+		require.Len(t, event.Receivers, 1)
+
+		var payload forms2.NotifyMessageDelivered
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		assert.Equal(t, userId, payload.UserId)
+		delivered[event.Receivers[0].String()] = payload.MessageId
+	}
+	assert.Equal(t, map[string]uuid.UUID{first.String(): message3.ID, second.String(): message2.ID}, delivered)
+}
+
+func TestInternalWSMessageHandler_EventDeliveredOnAnotherInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	messageUseCase := mocks.NewMockMessageService(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSMessageHandler(bus, nil, messageUseCase, nil, nil)
+
+	userId, chatId := uuid.New(), uuid.New()
+	event, _ := newMessageEvent(t, chatId, uuid.New(), time.Now(), userId)
+	handler.EventDelivered(context.Background(), userId, event)
+
+	// the other instance of the user reported the message first
+	reported := make(chan struct{})
+	messageUseCase.EXPECT().UpdateLastDeliveredTs(gomock.Any(), chatId, gomock.Any(), userId).
+		DoAndReturn(func(context.Context, uuid.UUID, time.Time, uuid.UUID) (bool, error) {
+			close(reported)
+			return false, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go handler.RunDeliveries(ctx)
+
+	<-reported
+	select {
+	case event := <-bus.events:
+		t.Fatalf("sender was notified again: %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
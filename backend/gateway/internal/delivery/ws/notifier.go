@@ -0,0 +1,92 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"quickflow/gateway/internal/delivery/http"
+	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// NotificationUpdated replaces the event of a notification that was grouped with an existing one,
+// the payload is the whole updated notification
+const NotificationUpdated = "notification_updated"
+
+// notifier saves the notification before pushing the event, so users who are offline find it in their list later.
+// The WS event is only the live delivery of the saved notification and carries its notification_id,
+// it is pushed without the id even if saving fails.
+type notifier struct {
+	eventBus            EventBus
+	notificationService http.NotificationService
+	profileService      http.ProfileUseCase
+}
+
+func (n *notifier) notify(ctx context.Context, notification models.Notification, eventType string, payload interface{}) error {
+	// nobody is notified about their own actions
+	if notification.UserId == notification.ActorId {
+		return nil
+	}
+
+	saved, grouped, err := n.notificationService.SaveNotification(ctx, notification)
+	switch {
+	case err != nil:
+		// the live push does not depend on the notification service, users who are online get it anyway
+		logger.Error(ctx, "Failed to save notification %s for user %s: %v", notification.Type, notification.UserId, err)
+	case grouped:
+		eventType = NotificationUpdated
+		if payload, err = n.notificationOut(ctx, saved); err != nil {
+			return err
+		}
+	default:
+		if payload, err = withNotificationId(payload, saved.Id); err != nil {
+			return err
+		}
+	}
+
+	event, err := eventbus.NewEvent(eventType, payload, notification.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	if err = n.eventBus.Publish(ctx, event); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return nil
+}
+
+// withNotificationId adds notification_id to the event payload, so that the client can update the item
+// when a notification_updated event with the same id comes later
+func withNotificationId(payload interface{}, notificationId uuid.UUID) (json.RawMessage, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("notification payload is not an object: %w", err)
+	}
+	if fields["notification_id"], err = json.Marshal(notificationId); err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return json.Marshal(fields)
+}
+
+func (n *notifier) notificationOut(ctx context.Context, notification *models.Notification) (forms.NotificationOut, error) {
+	infos, err := n.profileService.GetPublicUsersInfo(ctx, forms.NotificationActorIds([]models.Notification{*notification}))
+	if err != nil {
+		return forms.NotificationOut{}, fmt.Errorf("failed to get actors profile info: %w", err)
+	}
+
+	actors := make(map[uuid.UUID]models.PublicUserInfo, len(infos))
+	for _, info := range infos {
+		actors[info.Id] = info
+	}
+	return forms.ToNotificationOut(*notification, actors), nil
+}
@@ -0,0 +1,140 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/gateway/internal/delivery/http/forms"
+	"quickflow/gateway/internal/delivery/http/mocks"
+	"quickflow/shared/models"
+)
+
+func TestInternalWSCommunityHandler_NotifyCommunityRoleChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationService := mocks.NewMockNotificationService(ctrl)
+	profileService := mocks.NewMockProfileUseCase(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSCommunityHandler(bus, notificationService, profileService)
+
+	senderId, receiverId := uuid.New(), uuid.New()
+	community := &models.Community{ID: uuid.New(), NickName: "gophers"}
+
+	profileService.EXPECT().GetPublicUserInfo(gomock.Any(), senderId).
+		Return(models.PublicUserInfo{Id: senderId, Username: "admin"}, nil)
+	notificationService.EXPECT().SaveNotification(gomock.Any(), models.Notification{
+		UserId:   receiverId,
+		ActorId:  senderId,
+		Type:     models.NotificationCommunityRoleChanged,
+		TargetId: community.ID,
+		Content:  string(models.CommunityRoleAdmin),
+	}).Return(&models.Notification{Id: uuid.New()}, false, nil)
+
+	err := handler.NotifyCommunityRoleChanged(context.Background(), senderId, receiverId, community, models.CommunityRoleAdmin)
+	require.NoError(t, err)
+
+	event := bus.next(t)
+	assert.Equal(t, string(CommunityEventRoleChanged), event.Type)
+	assert.Equal(t, []uuid.UUID{receiverId}, event.Receivers)
+}
+
+func TestNotifier_Notify(t *testing.T) {
+	userId, actorId := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name         string
+		notification models.Notification
+		saveErr      error
+		grouped      bool
+		wantSave     bool
+		wantEvent    string
+	}{
+		{
+			name:         "saved and pushed",
+			notification: models.Notification{UserId: userId, ActorId: actorId, Type: models.NotificationFriendRequest, TargetId: actorId},
+			wantSave:     true,
+			wantEvent:    "friend_request",
+		},
+		{
+			name:         "grouped",
+			notification: models.Notification{UserId: userId, ActorId: actorId, Type: models.NotificationPostLiked, TargetId: uuid.New()},
+			grouped:      true,
+			wantSave:     true,
+			wantEvent:    NotificationUpdated,
+		},
+		{
+			name:         "own action",
+			notification: models.Notification{UserId: userId, ActorId: userId, Type: models.NotificationPostLiked, TargetId: uuid.New()},
+		},
+		{
+			name:         "pushed when save fails",
+			notification: models.Notification{UserId: userId, ActorId: actorId, Type: models.NotificationFriendRequest, TargetId: actorId},
+			saveErr:      errors.New("unavailable"),
+			wantSave:     true,
+			wantEvent:    "friend_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationService := mocks.NewMockNotificationService(ctrl)
+			profileService := mocks.NewMockProfileUseCase(ctrl)
+			bus := newRecordingBus()
+			n := notifier{eventBus: bus, notificationService: notificationService, profileService: profileService}
+
+			saved := tt.notification
+			saved.Id = uuid.New()
+			saved.ActorIds = []uuid.UUID{actorId}
+			saved.ActorCount = 1
+			if tt.grouped {
+				saved.ActorIds = append(saved.ActorIds, userId)
+				saved.ActorCount = 12
+				profileService.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{actorId, userId}).
+					Return([]models.PublicUserInfo{{Id: actorId, Username: "anna"}}, nil)
+			}
+			if tt.wantSave {
+				notificationService.EXPECT().SaveNotification(gomock.Any(), tt.notification).
+					Return(&saved, tt.grouped, tt.saveErr)
+			}
+
+			err := n.notify(context.Background(), tt.notification, "friend_request", map[string]string{"ok": "true"})
+			require.NoError(t, err)
+
+			if len(tt.wantEvent) != 0 {
+				event := bus.next(t)
+				assert.Equal(t, tt.wantEvent, event.Type)
+				assert.Equal(t, []uuid.UUID{userId}, event.Receivers)
+				var fields map[string]json.RawMessage
+				require.NoError(t, json.Unmarshal(event.Payload, &fields))
+				switch {
+				case tt.saveErr != nil:
+					assert.NotContains(t, fields, "notification_id")
+				case !tt.grouped:
+					assert.JSONEq(t, `"`+saved.Id.String()+`"`, string(fields["notification_id"]))
+					assert.JSONEq(t, `"true"`, string(fields["ok"]))
+				}
+				if tt.grouped {
+					var out forms.NotificationOut
+					require.NoError(t, json.Unmarshal(event.Payload, &out))
+					assert.Equal(t, saved.Id, out.Id)
+					assert.Equal(t, 12, out.ActorCount)
+					require.Len(t, out.Actors, 1)
+					assert.Equal(t, "anna", out.Actors[0].Username)
+				}
+			} else {
+				assert.Empty(t, bus.events)
+			}
+		})
+	}
+}
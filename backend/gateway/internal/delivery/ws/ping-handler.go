@@ -29,7 +29,8 @@ func (wm *PingHandlerWS) Handle(ctx context.Context, conn *websocket.Conn) {
 	go func() {
 		for {
 			time.Sleep(30 * time.Second) // отправка ping каждые 30 секунд
-			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			// WriteControl is safe to call concurrently with other writers
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
 				logger.Info(ctx, "Failed to send ping: %v", err)
 				return
 			}
@@ -0,0 +1,171 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	time2 "quickflow/config/time"
+	"quickflow/gateway/internal/delivery/http"
+	forms2 "quickflow/gateway/internal/delivery/ws/forms"
+	"quickflow/gateway/utils/validation"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+)
+
+type PresenceEvent string
+
+const (
+	PresenceEventOnline  PresenceEvent = "user_online"
+	PresenceEventOffline PresenceEvent = "user_offline"
+)
+
+const (
+	// friendsPageSize is the number of friends fetched at once when presence is broadcast
+	friendsPageSize = 100
+	// presenceWorkers process presence changes in parallel, changes of one user always go to the same worker
+	presenceWorkers = 8
+	// presenceTimeout bounds counting and broadcasting of a single presence change
+	presenceTimeout = 10 * time.Second
+)
+
+// PresenceCounter counts gateway instances the user is connected to
+type PresenceCounter interface {
+	Incr(ctx context.Context, userId uuid.UUID) (int64, error)
+	Decr(ctx context.Context, userId uuid.UUID) (int64, error)
+}
+
+type presenceChange struct {
+	userId uuid.UUID
+	online bool
+}
+
+// InternalWSPresenceHandler notifies friends of the user when the user goes online or offline.
+// Connection manager reports the first and the last connection on this instance, the handler counts instances,
+// so that friends are notified only when the user connects to the first instance or leaves the last one.
+// Changes are processed in the background by Run, the WS handshake does not wait for the friends service.
+type InternalWSPresenceHandler struct {
+	eventBus       EventBus
+	friendsService http.FriendsUseCase
+	counter        PresenceCounter
+
+	queues []chan presenceChange
+}
+
+func NewInternalWSPresenceHandler(eventBus EventBus, friendsService http.FriendsUseCase, counter PresenceCounter) *InternalWSPresenceHandler {
+	queues := make([]chan presenceChange, presenceWorkers)
+	for i := range queues {
+		queues[i] = make(chan presenceChange, 256)
+	}
+
+	return &InternalWSPresenceHandler{
+		eventBus:       eventBus,
+		friendsService: friendsService,
+		counter:        counter,
+		queues:         queues,
+	}
+}
+
+func (p *InternalWSPresenceHandler) UserOnline(_ context.Context, userId uuid.UUID) {
+	p.enqueue(presenceChange{userId: userId, online: true})
+}
+
+func (p *InternalWSPresenceHandler) UserOffline(_ context.Context, userId uuid.UUID) {
+	p.enqueue(presenceChange{userId: userId, online: false})
+}
+
+// enqueue keeps changes of the user in order, otherwise a quick reconnect could be counted in reverse
+func (p *InternalWSPresenceHandler) enqueue(change presenceChange) {
+	p.queues[int(change.userId[len(change.userId)-1])%len(p.queues)] <- change
+}
+
+// Run processes presence changes until ctx is done
+func (p *InternalWSPresenceHandler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, queue := range p.queues {
+		wg.Add(1)
+		go func(queue <-chan presenceChange) {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case change := <-queue:
+					p.handleChange(ctx, change)
+				}
+			}
+		}(queue)
+	}
+	wg.Wait()
+}
+
+func (p *InternalWSPresenceHandler) handleChange(ctx context.Context, change presenceChange) {
+	ctx, cancel := context.WithTimeout(ctx, presenceTimeout)
+	defer cancel()
+
+	if change.online {
+		n, err := p.counter.Incr(ctx, change.userId)
+		if err != nil || n != 1 {
+			return
+		}
+		err = p.notifyPresenceEvent(ctx, forms2.NotifyPresence{UserId: change.userId}, change.userId, PresenceEventOnline)
+		if err != nil {
+			logger.Error(ctx, "Failed to notify user %s online: %v", change.userId, err)
+		}
+		return
+	}
+
+	n, err := p.counter.Decr(ctx, change.userId)
+	if err != nil || n > 0 {
+		return
+	}
+	payload := forms2.NotifyPresence{
+		UserId:   change.userId,
+		LastSeen: time.Now().Format(time2.TimeStampLayout),
+	}
+	if err = p.notifyPresenceEvent(ctx, payload, change.userId, PresenceEventOffline); err != nil {
+		logger.Error(ctx, "Failed to notify user %s offline: %v", change.userId, err)
+	}
+}
+
+func (p *InternalWSPresenceHandler) notifyPresenceEvent(ctx context.Context, payload interface{}, userId uuid.UUID, eventType PresenceEvent) error {
+	friends, err := p.getFriendIds(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to get friends: %w", err)
+	}
+	if len(friends) == 0 {
+		return nil
+	}
+
+	event, err := eventbus.NewEvent(string(eventType), payload, friends...)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	err = p.eventBus.Publish(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return nil
+}
+
+func (p *InternalWSPresenceHandler) getFriendIds(ctx context.Context, userId uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for offset := 0; ; offset += friendsPageSize {
+		friends, total, err := p.friendsService.GetFriendsInfo(ctx, userId.String(),
+			strconv.Itoa(friendsPageSize), strconv.Itoa(offset), validation.TypeAll)
+		if err != nil {
+			return nil, err
+		}
+		for _, friend := range friends {
+			ids = append(ids, friend.Id)
+		}
+		if len(friends) < friendsPageSize || len(ids) >= total {
+			return ids, nil
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"quickflow/gateway/internal/delivery/http"
+	forms2 "quickflow/gateway/internal/delivery/ws/forms"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+type TypingEvent string
+
+const (
+	TypingEventStart TypingEvent = "typing_start"
+	TypingEventStop  TypingEvent = "typing_stop"
+)
+
+// TypingTimeout is the time after which typing stops unless the client repeats typing_start
+const TypingTimeout = 5 * time.Second
+
+type typingKey struct {
+	chatId uuid.UUID
+	userId uuid.UUID
+}
+
+// typingState keeps receivers of typing_start, so that typing_stop reaches the same users
+type typingState struct {
+	timer     *time.Timer
+	receivers []uuid.UUID
+}
+
+// InternalWSTypingHandler fans out typing indicators to other chat participants.
+// Typing started through this gateway instance expires here after the timeout.
+type InternalWSTypingHandler struct {
+	eventBus    EventBus
+	chatUseCase http.ChatUseCase
+	timeout     time.Duration
+
+	mu     sync.Mutex
+	typing map[typingKey]*typingState
+}
+
+func NewInternalWSTypingHandler(eventBus EventBus, chatUseCase http.ChatUseCase, timeout time.Duration) *InternalWSTypingHandler {
+	return &InternalWSTypingHandler{
+		eventBus:    eventBus,
+		chatUseCase: chatUseCase,
+		timeout:     timeout,
+		typing:      make(map[typingKey]*typingState),
+	}
+}
+
+func (t *InternalWSTypingHandler) StartTyping(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.TypingPayload
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	if payload.ChatId == uuid.Nil {
+		return fmt.Errorf("chatId is empty")
+	}
+
+	key := typingKey{chatId: payload.ChatId, userId: user.Id}
+
+	// repeated typing_start only prolongs the indicator
+	t.mu.Lock()
+	if state, ok := t.typing[key]; ok {
+		state.timer.Reset(t.timeout)
+		t.mu.Unlock()
+		return nil
+	}
+	t.mu.Unlock()
+
+	participants, err := t.chatUseCase.GetChatParticipants(ctx, payload.ChatId)
+	if err != nil {
+		return fmt.Errorf("failed to get chat participants: %w", err)
+	}
+	if !slices.Contains(participants, user.Id) {
+		return fmt.Errorf("user is not a participant of the chat")
+	}
+	receivers := slices.DeleteFunc(participants, func(id uuid.UUID) bool { return id == user.Id })
+
+	t.mu.Lock()
+	if _, ok := t.typing[key]; ok {
+		t.mu.Unlock()
+		return nil
+	}
+	state := &typingState{receivers: receivers}
+	state.timer = time.AfterFunc(t.timeout, func() {
+		if t.remove(key, state) {
+			t.notifyTypingEvent(context.Background(), key, receivers, TypingEventStop)
+		}
+	})
+	t.typing[key] = state
+	t.mu.Unlock()
+
+	t.notifyTypingEvent(ctx, key, receivers, TypingEventStart)
+	return nil
+}
+
+func (t *InternalWSTypingHandler) StopTyping(ctx context.Context, user models.User, jsonPayload json.RawMessage) error {
+	var payload forms2.TypingPayload
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	if payload.ChatId == uuid.Nil {
+		return fmt.Errorf("chatId is empty")
+	}
+
+	key := typingKey{chatId: payload.ChatId, userId: user.Id}
+
+	t.mu.Lock()
+	state, ok := t.typing[key]
+	t.mu.Unlock()
+	if !ok || !t.remove(key, state) {
+		return nil
+	}
+	state.timer.Stop()
+
+	t.notifyTypingEvent(ctx, key, state.receivers, TypingEventStop)
+	return nil
+}
+
+// remove deletes state if it is still the current one for the key, reports whether it was deleted
+func (t *InternalWSTypingHandler) remove(key typingKey, state *typingState) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.typing[key] != state {
+		return false
+	}
+	delete(t.typing, key)
+	return true
+}
+
+func (t *InternalWSTypingHandler) notifyTypingEvent(ctx context.Context, key typingKey, receivers []uuid.UUID, eventType TypingEvent) {
+	if len(receivers) == 0 {
+		return
+	}
+
+	payload := forms2.NotifyTyping{
+		ChatId: key.chatId,
+		UserId: key.userId,
+	}
+	event, err := eventbus.NewEvent(string(eventType), payload, receivers...)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal %s event: %v", eventType, err)
+		return
+	}
+	if err = t.eventBus.Publish(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to publish %s event: %v", eventType, err)
+	}
+}
@@ -0,0 +1,123 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/gateway/internal/delivery/http/mocks"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/models"
+)
+
+// recordingBus collects published events
+type recordingBus struct {
+	events chan eventbus.Event
+}
+
+func newRecordingBus() *recordingBus {
+	return &recordingBus{events: make(chan eventbus.Event, 10)}
+}
+
+func (b *recordingBus) Publish(_ context.Context, event eventbus.Event) error {
+	b.events <- event
+	return nil
+}
+
+func (b *recordingBus) Subscribe(ctx context.Context, _ eventbus.Handler) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (b *recordingBus) next(t *testing.T) eventbus.Event {
+	t.Helper()
+	select {
+	case event := <-b.events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("event was not published")
+		return eventbus.Event{}
+	}
+}
+
+func TestInternalWSTypingHandler_Expires(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatUseCase := mocks.NewMockChatUseCase(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSTypingHandler(bus, chatUseCase, 50*time.Millisecond)
+
+	user := models.User{Id: uuid.New()}
+	other := uuid.New()
+	chatId := uuid.New()
+	payload, err := json.Marshal(map[string]uuid.UUID{"chat_id": chatId})
+	require.NoError(t, err)
+
+	chatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatId).Return([]uuid.UUID{user.Id, other}, nil)
+
+	require.NoError(t, handler.StartTyping(context.Background(), user, payload))
+	// repeated start only prolongs typing
+	require.NoError(t, handler.StartTyping(context.Background(), user, payload))
+
+	started := bus.next(t)
+	assert.Equal(t, string(TypingEventStart), started.Type)
+	assert.Equal(t, []uuid.UUID{other}, started.Receivers)
+	assert.JSONEq(t, `{"chat_id":"`+chatId.String()+`","user_id":"`+user.Id.String()+`"}`, string(started.Payload))
+
+	stopped := bus.next(t)
+	assert.Equal(t, string(TypingEventStop), stopped.Type)
+	assert.Equal(t, []uuid.UUID{other}, stopped.Receivers)
+
+	// explicit stop after expiration is not broadcast again
+	require.NoError(t, handler.StopTyping(context.Background(), user, payload))
+	assert.Empty(t, bus.events)
+}
+
+func TestInternalWSTypingHandler_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatUseCase := mocks.NewMockChatUseCase(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSTypingHandler(bus, chatUseCase, time.Minute)
+
+	user := models.User{Id: uuid.New()}
+	other := uuid.New()
+	chatId := uuid.New()
+	payload, err := json.Marshal(map[string]uuid.UUID{"chat_id": chatId})
+	require.NoError(t, err)
+
+	chatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatId).Return([]uuid.UUID{user.Id, other}, nil)
+
+	require.NoError(t, handler.StartTyping(context.Background(), user, payload))
+	assert.Equal(t, string(TypingEventStart), bus.next(t).Type)
+
+	require.NoError(t, handler.StopTyping(context.Background(), user, payload))
+	assert.Equal(t, string(TypingEventStop), bus.next(t).Type)
+	assert.Empty(t, handler.typing)
+}
+
+func TestInternalWSTypingHandler_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatUseCase := mocks.NewMockChatUseCase(ctrl)
+	bus := newRecordingBus()
+	handler := NewInternalWSTypingHandler(bus, chatUseCase, time.Minute)
+
+	chatId := uuid.New()
+	payload, err := json.Marshal(map[string]uuid.UUID{"chat_id": chatId})
+	require.NoError(t, err)
+
+	chatUseCase.EXPECT().GetChatParticipants(gomock.Any(), chatId).Return([]uuid.UUID{uuid.New()}, nil)
+
+	assert.Error(t, handler.StartTyping(context.Background(), models.User{Id: uuid.New()}, payload))
+	assert.Empty(t, bus.events)
+}
@@ -13,8 +13,9 @@ import (
 type IWebSocketManager interface {
 	SendMessageToUser(ctx context.Context, userId uuid.UUID, message forms.MessageOut) error
 	SendMessageToChat(ctx context.Context, message models.Message, publicSenderInfo models.PublicUserInfo, chatParticipants []models.User) error
-	IsConnected(userId uuid.UUID) (*websocket.Conn, bool)
+	IsConnected(userId, connId uuid.UUID) (*websocket.Conn, bool)
+	IsOnline(userId uuid.UUID) bool
 	HandlePing(conn *websocket.Conn)
-	AddConnection(userId uuid.UUID, conn *websocket.Conn)
-	RemoveAndCloseConnection(userId uuid.UUID)
+	AddConnection(userId, connId uuid.UUID, conn *websocket.Conn)
+	RemoveAndCloseConnection(userId, connId uuid.UUID)
 }
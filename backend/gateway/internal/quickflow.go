@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -8,6 +9,7 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -16,14 +18,17 @@ import (
 	qfhttp "quickflow/gateway/internal/delivery/http"
 	"quickflow/gateway/internal/delivery/http/middleware"
 	"quickflow/gateway/internal/delivery/ws"
+	redisRepo "quickflow/gateway/internal/repository/redis"
 	"quickflow/metrics"
 	"quickflow/shared/client/community_service"
 	"quickflow/shared/client/feedback_service"
 	"quickflow/shared/client/file_service"
 	friendsService "quickflow/shared/client/friends_service"
 	"quickflow/shared/client/messenger_service"
+	"quickflow/shared/client/notification_service"
 	postService "quickflow/shared/client/post_service"
 	userService "quickflow/shared/client/user_service"
+	"quickflow/shared/eventbus"
 	"quickflow/shared/interceptors"
 	getEnv "quickflow/utils/get-env"
 )
@@ -95,6 +100,16 @@ func Run(cfg *config.Config) error {
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(addr.MaxMessageSize)),
 	)
 
+	grpcConnNotificationService, err := grpc.NewClient(
+		getEnv.GetServiceAddr(addr.DefaultNotificationServiceAddrEnv, addr.DefaultNotificationServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(interceptors.RequestIDClientInterceptor()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(addr.MaxMessageSize)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to notification service: %w", err)
+	}
+
 	// services
 	fileService, err := getFileService()
 	UserService := userService.NewUserClient(grpcConnUserService)
@@ -107,12 +122,35 @@ func Run(cfg *config.Config) error {
 	communityService := community_service.NewCommunityServiceClient(grcpConnCommunityService)
 	commentService := postService.NewCommentClient(grpcConnPostService)
 	stickerService := messenger_service.NewStickerServiceClient(grpcConnMessengerService)
+	deviceKeyService := userService.NewDeviceKeyClient(grpcConnUserService)
+	notificationService := notification_service.NewNotificationClient(grpcConnNotificationService)
 
 	connManager := ws.NewWSConnectionManager()
+
+	// ws events go through redis so that every gateway replica can deliver them to its own connections
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisConfig.GetURL(),
+		Password: cfg.RedisConfig.GetPass(),
+	})
+	eventBus := eventbus.NewRedisEventBus(redisClient, eventbus.DefaultChannelPrefix)
+
 	wsRouter := ws.NewWebSocketRouter()
-	wsMessageHander := ws.NewInternalWSMessageHandler(connManager, messageService, profileService, chatService)
-	wsFriendHandler := ws.NewInternalWSFriendsHandler(connManager, profileService)
-	wsLikeHandler := ws.NewInternalWSPostHandler(connManager, profileService)
+	wsMessageHander := ws.NewInternalWSMessageHandler(eventBus, connManager, messageService, profileService, chatService)
+	go wsMessageHander.RunDeliveries(context.Background())
+	// scheduled messages are sent by messenger itself, the gateway turns them into ordinary message events
+	messengerEventBus := eventbus.NewRedisEventBus(redisClient, eventbus.MessengerChannelPrefix)
+	go eventbus.KeepSubscribed(context.Background(), messengerEventBus, wsMessageHander.DeliverScheduledMessage,
+		eventbus.DefaultMinBackoff, eventbus.DefaultMaxBackoff)
+	wsFriendHandler := ws.NewInternalWSFriendsHandler(eventBus, notificationService, profileService)
+	wsLikeHandler := ws.NewInternalWSPostHandler(eventBus, notificationService, profileService)
+	wsCommunityHandler := ws.NewInternalWSCommunityHandler(eventBus, notificationService, profileService)
+	wsTypingHandler := ws.NewInternalWSTypingHandler(eventBus, chatService, ws.TypingTimeout)
+	presenceHandler := ws.NewInternalWSPresenceHandler(eventBus, FriendsService, redisRepo.NewRedisPresenceCounter(redisClient))
+	go presenceHandler.Run(context.Background())
+	connManager.SetPresenceListener(presenceHandler)
+	connManager.SetDeliveryListener(wsMessageHander)
+	go eventbus.KeepSubscribed(context.Background(), eventBus, connManager.DeliverEvent,
+		eventbus.DefaultMinBackoff, eventbus.DefaultMaxBackoff)
 	pingHandler := ws.NewPingHandlerWS()
 
 	sanitizerPolicy := bluemonday.UGCPolicy()
@@ -123,12 +161,14 @@ func Run(cfg *config.Config) error {
 	newCommentHandler := qfhttp.NewCommentHandler(commentService, profileService, PostService, wsLikeHandler, sanitizerPolicy)
 	newProfileHandler := qfhttp.NewProfileHandler(profileService, FriendsService, UserService, chatService, connManager, sanitizerPolicy)
 	newMessageHandler := qfhttp.NewMessageHandler(messageService, UserService, profileService, sanitizerPolicy)
-	newChatHandler := qfhttp.NewChatHandler(chatService, profileService, messageService, connManager)
+	newChatHandler := qfhttp.NewChatHandler(chatService, profileService, messageService, connManager, wsMessageHander, fileService)
 	newFriendsHandler := qfhttp.NewFriendsHandler(FriendsService, connManager, wsFriendHandler)
 	newSearchHandler := qfhttp.NewSearchHandler(UserService, communityService, profileService)
-	newCommunityHandler := qfhttp.NewCommunityHandler(communityService, profileService, connManager, UserService, sanitizerPolicy)
+	newCommunityHandler := qfhttp.NewCommunityHandler(communityService, profileService, connManager, UserService, wsCommunityHandler, sanitizerPolicy)
 	newFileHandler := qfhttp.NewFileHandler(fileService, sanitizerPolicy)
 	newStickerHandler := qfhttp.NewStickerHandler(stickerService, sanitizerPolicy)
+	newDeviceKeyHandler := qfhttp.NewDeviceKeyHandler(deviceKeyService)
+	newNotificationHandler := qfhttp.NewNotificationHandler(notificationService, profileService)
 
 	CSRFHandler := qfhttp.NewCSRFHandler()
 	FeedbackHandler := qfhttp.NewFeedbackHandler(feedbackService, profileService, sanitizerPolicy)
@@ -137,7 +177,17 @@ func Run(cfg *config.Config) error {
 	wsRouter.RegisterHandler(ws.MessageEventSend, wsMessageHander.SendMessage)
 	wsRouter.RegisterHandler(ws.MessageEventRead, wsMessageHander.MarkMessageRead)
 	wsRouter.RegisterHandler(ws.MessageEventDeleted, wsMessageHander.DeleteMessage)
+	wsRouter.RegisterHandler(ws.MessageEventEdited, wsMessageHander.EditMessage)
+	wsRouter.RegisterHandler(ws.ForwardCommand, wsMessageHander.ForwardMessages)
+	wsRouter.RegisterHandler(ws.ReactionEventAdded, wsMessageHander.AddReaction)
+	wsRouter.RegisterHandler(ws.ReactionEventRemoved, wsMessageHander.RemoveReaction)
+	wsRouter.RegisterHandler(ws.PinEventAdded, wsMessageHander.PinMessage)
+	wsRouter.RegisterHandler(ws.PinEventRemoved, wsMessageHander.UnpinMessage)
+	wsRouter.RegisterHandler(ws.PollVoteCommand, wsMessageHander.VotePoll)
 	wsRouter.RegisterHandler(ws.ChatEventDeleted, wsMessageHander.DeleteChat)
+	wsRouter.RegisterHandler(ws.ResumeCommand, wsMessageHander.Resume)
+	wsRouter.RegisterHandler(string(ws.TypingEventStart), wsTypingHandler.StartTyping)
+	wsRouter.RegisterHandler(string(ws.TypingEventStop), wsTypingHandler.StopTyping)
 
 	newMessageHandlerWS := qfhttp.NewMessageListenerWS(profileService, connManager, wsRouter, sanitizerPolicy)
 
@@ -181,6 +231,7 @@ func Run(cfg *config.Config) error {
 
 	protectedPost.HandleFunc("/comments/{comment_id:[0-9a-fA-F-]{36}}", newCommentHandler.UpdateComment).Methods(http.MethodPut)
 	protectedPost.HandleFunc("/posts/{post_id:[0-9a-fA-F-]{36}}/like", newPostHandler.LikePost).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/posts/{post_id:[0-9a-fA-F-]{36}}/poll/vote", newPostHandler.VotePoll).Methods(http.MethodPost)
 	protectedPost.HandleFunc("/comments/{comment_id:[0-9a-fA-F-]{36}}/like", newCommentHandler.LikeComment).Methods(http.MethodPost)
 	protectedPost.HandleFunc("/profile", newProfileHandler.UpdateProfile).Methods(http.MethodPost)
 	protectedPost.HandleFunc("/follow", newFriendsHandler.SendFriendRequest).Methods(http.MethodPost)
@@ -195,12 +246,37 @@ func Run(cfg *config.Config) error {
 	protectedPost.HandleFunc("/upload", newFileHandler.AddFiles).Methods(http.MethodPost)
 	protectedPost.HandleFunc("/posts/{post_id:[0-9a-fA-F-]{36}}/comment", newCommentHandler.AddComment).Methods(http.MethodPost)
 	protectedPost.HandleFunc("/sticker_packs/add", newStickerHandler.AddStickerPack).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/sticker_packs/my", newStickerHandler.ReorderMyStickerPacks).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/sticker_packs/my/{pack_id:[0-9a-fA-F-]{36}}", newStickerHandler.InstallStickerPack).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/sticker_packs/my/favourites", newStickerHandler.AddFavouriteSticker).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/devices/keys", newDeviceKeyHandler.UploadDeviceKeys).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/devices/{device_id}/signed_prekey", newDeviceKeyHandler.RotateSignedPrekey).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/notifications/read", newNotificationHandler.MarkNotificationsRead).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/notifications/read_all", newNotificationHandler.MarkAllNotificationsRead).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats", newChatHandler.CreateGroupChat).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}", newChatHandler.UpdateChat).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/members", newChatHandler.AddChatMembers).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/members/{user_id:[0-9a-fA-F-]{36}}", newChatHandler.ChangeChatRole).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/invites", newChatHandler.CreateChatInvite).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/invites/{token:[A-Za-z0-9_-]+}/join", newChatHandler.JoinChatByInvite).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/mute", newChatHandler.MuteChat).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/archive", newChatHandler.ArchiveChat).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/pin", newChatHandler.PinChat).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/chats/pinned", newChatHandler.ReorderPinnedChats).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/ttl", newChatHandler.SetChatMessageTTL).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/encryption", newChatHandler.SetChatEncryption).Methods(http.MethodPut)
+	protectedPost.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/export", newChatHandler.RequestChatExport).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/scheduled_messages", newMessageHandler.ScheduleMessage).Methods(http.MethodPost)
+	protectedPost.HandleFunc("/scheduled_messages/{message_id:[0-9a-fA-F-]{36}}", newMessageHandler.UpdateScheduledMessage).Methods(http.MethodPut)
 
 	protectedGet := apiGetRouter.PathPrefix("/").Subrouter()
 	protectedGet.Use(middleware.SessionMiddleware(UserService))
 	protectedGet.HandleFunc("/feed", newFeedHandler.GetFeed).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/recommendations", newFeedHandler.GetRecommendations).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/messages", newMessageHandler.GetMessagesForChat).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/messages/search", newMessageHandler.SearchMessages).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/messages/{message_id:[0-9a-fA-F-]{36}}/receipts", newMessageHandler.GetMessageReceipts).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/scheduled_messages", newMessageHandler.GetScheduledMessages).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/chats", newChatHandler.GetUserChats).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/friends", newFriendsHandler.GetFriends).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/csrf", CSRFHandler.GetCSRF).Methods(http.MethodGet)
@@ -218,9 +294,20 @@ func Run(cfg *config.Config) error {
 	protectedGet.HandleFunc("/posts/{post_id:[0-9a-fA-F-]{36}}", newPostHandler.GetPost).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/posts/{post_id:[0-9a-fA-F-]{36}}/comments", newCommentHandler.FetchCommentsForPost).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/chats/unread", newChatHandler.GetNumUnreadChats).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/participants", newChatHandler.GetChatParticipants).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/invites", newChatHandler.GetChatInvites).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chats/invites/{token:[A-Za-z0-9_-]+}", newChatHandler.GetChatInvitePreview).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chat_exports/{export_id:[0-9a-fA-F-]{36}}", newChatHandler.GetChatExport).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/chat_exports/{export_id:[0-9a-fA-F-]{36}}/download", newChatHandler.DownloadChatExport).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/sticker_packs/my", newStickerHandler.GetMyStickerPacks).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/users/{user_id:[0-9a-fA-F-]{36}}/device_keys", newDeviceKeyHandler.GetUserDeviceKeys).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/sticker_packs/my/recent", newStickerHandler.GetRecentStickers).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/sticker_packs/my/favourites", newStickerHandler.GetFavouriteStickers).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/sticker_packs/{pack_id:[0-9a-fA-F-]{36}}", newStickerHandler.GetStickerPack).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/sticker_packs", newStickerHandler.GetStickerPacks).Methods(http.MethodGet)
 	protectedGet.HandleFunc("/sticker_packs/{pack_name}", newStickerHandler.GetStickerPackByName).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/notifications", newNotificationHandler.GetNotifications).Methods(http.MethodGet)
+	protectedGet.HandleFunc("/notifications/unread_count", newNotificationHandler.GetUnreadCount).Methods(http.MethodGet)
 
 	wsProtected := protectedGet.PathPrefix("/").Subrouter()
 	wsProtected.Use(middleware.WebSocketMiddleware(connManager, pingHandler))
@@ -237,6 +324,15 @@ func Run(cfg *config.Config) error {
 	apiDeleteRouter.HandleFunc("/communities/{id:[0-9a-fA-F-]{36}}", newCommunityHandler.DeleteCommunity).Methods(http.MethodDelete)
 	apiDeleteRouter.HandleFunc("/comments/{comment_id:[0-9a-fA-F-]{36}}", newCommentHandler.DeleteComment).Methods(http.MethodDelete)
 	apiDeleteRouter.HandleFunc("/sticker_packs/{pack_id:[0-9a-fA-F-]{36}}", newStickerHandler.DeleteStickerPack).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/sticker_packs/my/{pack_id:[0-9a-fA-F-]{36}}", newStickerHandler.UninstallStickerPack).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/sticker_packs/my/favourites", newStickerHandler.RemoveFavouriteSticker).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/members/{user_id:[0-9a-fA-F-]{36}}", newChatHandler.RemoveChatMember).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/chats/invites/{token:[A-Za-z0-9_-]+}", newChatHandler.RevokeChatInvite).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/mute", newChatHandler.MuteChat).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/archive", newChatHandler.ArchiveChat).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/chats/{chat_id:[0-9a-fA-F-]{36}}/pin", newChatHandler.PinChat).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/scheduled_messages/{message_id:[0-9a-fA-F-]{36}}", newMessageHandler.CancelScheduledMessage).Methods(http.MethodDelete)
+	apiDeleteRouter.HandleFunc("/devices/{device_id}", newDeviceKeyHandler.RemoveDevice).Methods(http.MethodDelete)
 
 	server := http.Server{
 		Addr:         cfg.ServerConfig.Addr,
@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"quickflow/shared/logger"
+)
+
+const (
+	presenceKeyPrefix = "ws:presence:"
+
+	// PresenceTTL bounds how long a count left by a crashed gateway instance keeps the user online,
+	// it is refreshed every time the user connects
+	PresenceTTL = 24 * time.Hour
+)
+
+var (
+	incrPresenceScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return n
+`)
+	// the key is removed once the last instance is gone, so the count never goes below zero
+	decrPresenceScript = redis.NewScript(`
+local n = redis.call('DECR', KEYS[1])
+if n <= 0 then
+    redis.call('DEL', KEYS[1])
+end
+return n
+`)
+)
+
+// RedisPresenceCounter counts gateway instances the user is connected to,
+// so that presence changes only when the user connects to the first one or leaves the last one
+type RedisPresenceCounter struct {
+	rdb *redis.Client
+}
+
+func NewRedisPresenceCounter(rdb *redis.Client) *RedisPresenceCounter {
+	return &RedisPresenceCounter{rdb: rdb}
+}
+
+func presenceKey(userId uuid.UUID) string {
+	return presenceKeyPrefix + userId.String()
+}
+
+// Incr returns the number of instances the user is connected to including the current one
+func (r *RedisPresenceCounter) Incr(ctx context.Context, userId uuid.UUID) (int64, error) {
+	n, err := incrPresenceScript.Run(ctx, r.rdb, []string{presenceKey(userId)}, int(PresenceTTL.Seconds())).Int64()
+	if err != nil {
+		logger.Error(ctx, "Failed to count connection of user %s: %v", userId, err)
+		return 0, fmt.Errorf("incr presence: %w", err)
+	}
+	return n, nil
+}
+
+// Decr returns the number of instances the user is still connected to, zero or less means none
+func (r *RedisPresenceCounter) Decr(ctx context.Context, userId uuid.UUID) (int64, error) {
+	n, err := decrPresenceScript.Run(ctx, r.rdb, []string{presenceKey(userId)}).Int64()
+	if err != nil {
+		logger.Error(ctx, "Failed to count disconnection of user %s: %v", userId, err)
+		return 0, fmt.Errorf("decr presence: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisPresenceCounter(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	counter := NewRedisPresenceCounter(db)
+	userId := uuid.New()
+	key := []string{"ws:presence:" + userId.String()}
+
+	mock.ExpectEvalSha(incrPresenceScript.Hash(), key, int(PresenceTTL.Seconds())).SetVal(int64(2))
+	n, err := counter.Incr(context.Background(), userId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	mock.ExpectEvalSha(decrPresenceScript.Hash(), key).SetVal(int64(0))
+	n, err = counter.Decr(context.Background(), userId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	mock.ExpectEvalSha(decrPresenceScript.Hash(), key).SetErr(errors.New("connection refused"))
+	_, err = counter.Decr(context.Background(), userId)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
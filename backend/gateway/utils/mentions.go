@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"strings"
+)
+
+const (
+	mentionSymbols = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ._0123456789"
+	// MaxMentions limits how many users one text can notify
+	MaxMentions = 10
+)
+
+// ExtractMentions returns usernames mentioned as @username in the text, each once and in order of appearance.
+// An @ inside a word such as an email address is not a mention.
+func ExtractMentions(text string) []string {
+	var mentions []string
+	seen := make(map[string]struct{})
+
+	runes := []rune(text)
+	for i := 0; i < len(runes) && len(mentions) < MaxMentions; i++ {
+		if runes[i] != '@' || (i > 0 && strings.ContainsRune(mentionSymbols, runes[i-1])) {
+			continue
+		}
+
+		j := i + 1
+		for j < len(runes) && strings.ContainsRune(mentionSymbols, runes[j]) {
+			j++
+		}
+		// a dot right after the username ends the sentence
+		username := strings.TrimRight(string(runes[i+1:j]), ".")
+		i = j - 1
+
+		if len(username) == 0 || username[0] == '_' || username[0] == '.' {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		mentions = append(mentions, username)
+	}
+	return mentions
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "no mentions", text: "hello world", expected: nil},
+		{name: "single", text: "@anna look", expected: []string{"anna"}},
+		{name: "end of sentence", text: "Thanks, @anna.", expected: []string{"anna"}},
+		{name: "dots inside", text: "cc @john.doe_1!", expected: []string{"john.doe_1"}},
+		{name: "repeated", text: "@anna @bob @anna", expected: []string{"anna", "bob"}},
+		{name: "email is not a mention", text: "write to anna@mail.ru", expected: nil},
+		{name: "bare at", text: "meet @ 5pm", expected: nil},
+		{name: "invalid first symbol", text: "@_anna @.bob", expected: nil},
+		{name: "after punctuation", text: "(@anna)", expected: []string{"anna"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractMentions(tt.text))
+		})
+	}
+}
+
+func TestExtractMentions_Limit(t *testing.T) {
+	var text strings.Builder
+	for i := 0; i < MaxMentions+5; i++ {
+		fmt.Fprintf(&text, "@user%d ", i)
+	}
+
+	assert.Len(t, ExtractMentions(text.String()), MaxMentions)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func ValidateMessage(message models.Message) error {
-	if len(message.Text) == 0 && len(message.Attachments) == 0 {
+	if len(message.Text) == 0 && len(message.Attachments) == 0 && message.Encrypted == nil && message.Poll == nil {
 		return errors.New("message cannot be empty")
 	}
 	// TODO make clean, move to config
@@ -36,6 +36,24 @@ func TestValidateMessage(t *testing.T) {
 			},
 			expected: errors.New("message cannot be empty"),
 		},
+		{
+			name: "encrypted message without text",
+			input: models.Message{
+				ChatID:    validUUID,
+				SenderID:  validUUID,
+				Encrypted: &models.EncryptedContent{Ciphertext: []byte("ciphertext")},
+			},
+			expected: nil,
+		},
+		{
+			name: "poll without text",
+			input: models.Message{
+				ChatID:   validUUID,
+				SenderID: validUUID,
+				Poll:     &models.Poll{Question: "Lunch?"},
+			},
+			expected: nil,
+		},
 		{
 			name: "message too long",
 			input: models.Message{
@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
+	"quickflow/shared/client/file_service"
 	dto "quickflow/shared/client/messenger_service"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -12,24 +14,46 @@ import (
 )
 
 type ChatUseCase interface {
-	CreateChat(ctx context.Context, chatInfo models.ChatCreationInfo) (models.Chat, error)
+	CreateChat(ctx context.Context, userId uuid.UUID, chatInfo models.ChatCreationInfo) (models.Chat, *models.Message, error)
+	AddChatMembers(ctx context.Context, chatId, userId uuid.UUID, memberIds []uuid.UUID) ([]models.Message, error)
+	RemoveChatMember(ctx context.Context, chatId, userId, memberId uuid.UUID) (models.Message, error)
+	UpdateChat(ctx context.Context, chatId, userId uuid.UUID, info models.ChatUpdateInfo) (models.Chat, []models.Message, error)
+	GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error)
+	ChangeChatRole(ctx context.Context, chatId, userId, memberId uuid.UUID, role models.ChatRole) (models.Message, error)
+	CreateChatInvite(ctx context.Context, chatId, userId uuid.UUID, info models.ChatInviteCreationInfo) (models.ChatInvite, error)
+	GetChatInvites(ctx context.Context, chatId, userId uuid.UUID) ([]models.ChatInvite, error)
+	RevokeChatInvite(ctx context.Context, token string, userId uuid.UUID) error
+	GetChatInvitePreview(ctx context.Context, token string) (models.ChatPreview, error)
+	JoinChatByInvite(ctx context.Context, token string, userId uuid.UUID) (models.Chat, models.Message, error)
 	GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error)
-	GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error)
+	GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error)
 	GetPrivateChat(ctx context.Context, userId1, userId2 uuid.UUID) (models.Chat, error)
-	DeleteChat(ctx context.Context, chatId uuid.UUID) error
+	DeleteChat(ctx context.Context, chatId, userId uuid.UUID) error
 	GetChat(ctx context.Context, chatId uuid.UUID) (models.Chat, error)
 	JoinChat(ctx context.Context, chatId, userId uuid.UUID) error
 	LeaveChat(ctx context.Context, chatId, userId uuid.UUID) error
 	GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error)
+	MuteChat(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error
+	ArchiveChat(ctx context.Context, chatId, userId uuid.UUID, archived bool) error
+	PinChat(ctx context.Context, chatId, userId uuid.UUID, pinned bool) error
+	ReorderPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error
+	SetChatMessageTTL(ctx context.Context, chatId, userId uuid.UUID, ttl time.Duration) (models.Message, error)
+	SetChatEncryption(ctx context.Context, chatId, userId uuid.UUID, enabled bool) (models.Message, error)
+}
+
+type ChatExportUseCase interface {
+	RequestChatExport(ctx context.Context, chatId, userId uuid.UUID) (models.ChatExport, error)
+	GetChatExport(ctx context.Context, exportId, userId uuid.UUID) (models.ChatExport, error)
 }
 
 type ChatServiceServer struct {
 	pb.UnimplementedChatServiceServer
-	chatUseCase ChatUseCase
+	chatUseCase       ChatUseCase
+	chatExportUseCase ChatExportUseCase
 }
 
-func NewChatServiceServer(chatUseCase ChatUseCase) *ChatServiceServer {
-	return &ChatServiceServer{chatUseCase: chatUseCase}
+func NewChatServiceServer(chatUseCase ChatUseCase, chatExportUseCase ChatExportUseCase) *ChatServiceServer {
+	return &ChatServiceServer{chatUseCase: chatUseCase, chatExportUseCase: chatExportUseCase}
 }
 
 func (c *ChatServiceServer) GetUserChats(ctx context.Context, req *pb.GetUserChatsRequest) (*pb.GetUserChatsResponse, error) {
@@ -41,7 +65,7 @@ func (c *ChatServiceServer) GetUserChats(ctx context.Context, req *pb.GetUserCha
 		return nil, err
 	}
 
-	chats, err := c.chatUseCase.GetUserChats(ctx, userId)
+	chats, err := c.chatUseCase.GetUserChats(ctx, userId, req.Archived)
 	if err != nil {
 		logger.Error(ctx, "GetUserChats failed: %v", err)
 		return nil, err
@@ -56,14 +80,121 @@ func (c *ChatServiceServer) CreateChat(ctx context.Context, req *pb.CreateChatRe
 
 	chatInfo := dto.MapProtoCreationInfoToModel(req.ChatInfo)
 
-	chat, err := c.chatUseCase.CreateChat(ctx, chatInfo)
+	// private chats are created without a creator
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		userId = uuid.Nil
+	}
+
+	chat, systemMessage, err := c.chatUseCase.CreateChat(ctx, userId, chatInfo)
 	if err != nil {
 		logger.Error(ctx, "CreateChat failed: %v", err)
 		return nil, err
 	}
 
+	resp := &pb.CreateChatResponse{Chat: dto.MapChatToProto(chat)}
+	if systemMessage != nil {
+		resp.SystemMessage = dto.MapMessageToProto(*systemMessage)
+	}
+
 	logger.Info(ctx, "Successfully created chat")
-	return &pb.CreateChatResponse{Chat: dto.MapChatToProto(chat)}, nil
+	return resp, nil
+}
+
+func (c *ChatServiceServer) AddChatMembers(ctx context.Context, req *pb.AddChatMembersRequest) (*pb.AddChatMembersResponse, error) {
+	logger.Info(ctx, "Received AddChatMembers request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	memberIds := make([]uuid.UUID, len(req.MemberIds))
+	for i, id := range req.MemberIds {
+		memberIds[i], err = uuid.Parse(id)
+		if err != nil {
+			logger.Error(ctx, "Invalid MemberId: %v", err)
+			return nil, err
+		}
+	}
+
+	messages, err := c.chatUseCase.AddChatMembers(ctx, chatId, userId, memberIds)
+	if err != nil {
+		logger.Error(ctx, "AddChatMembers failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully added chat members")
+	return &pb.AddChatMembersResponse{SystemMessages: dto.MapMessagesToProto(messages)}, nil
+}
+
+func (c *ChatServiceServer) RemoveChatMember(ctx context.Context, req *pb.RemoveChatMemberRequest) (*pb.RemoveChatMemberResponse, error) {
+	logger.Info(ctx, "Received RemoveChatMember request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	memberId, err := uuid.Parse(req.MemberId)
+	if err != nil {
+		logger.Error(ctx, "Invalid MemberId: %v", err)
+		return nil, err
+	}
+
+	message, err := c.chatUseCase.RemoveChatMember(ctx, chatId, userId, memberId)
+	if err != nil {
+		logger.Error(ctx, "RemoveChatMember failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully removed chat member")
+	return &pb.RemoveChatMemberResponse{SystemMessage: dto.MapMessageToProto(message)}, nil
+}
+
+func (c *ChatServiceServer) UpdateChat(ctx context.Context, req *pb.UpdateChatRequest) (*pb.UpdateChatResponse, error) {
+	logger.Info(ctx, "Received UpdateChat request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	chat, messages, err := c.chatUseCase.UpdateChat(ctx, chatId, userId, models.ChatUpdateInfo{
+		Name:   req.Name,
+		Avatar: file_service.ProtoFileToModel(req.Avatar),
+	})
+	if err != nil {
+		logger.Error(ctx, "UpdateChat failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully updated chat")
+	return &pb.UpdateChatResponse{
+		Chat:           dto.MapChatToProto(chat),
+		SystemMessages: dto.MapMessagesToProto(messages),
+	}, nil
 }
 
 func (c *ChatServiceServer) GetChatParticipants(ctx context.Context, req *pb.GetChatParticipantsRequest) (*pb.GetChatParticipantsResponse, error) {
@@ -123,8 +254,13 @@ func (c *ChatServiceServer) DeleteChat(ctx context.Context, req *pb.DeleteChatRe
 		logger.Error(ctx, "Invalid ChatId: %v", err)
 		return nil, err
 	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
 
-	err = c.chatUseCase.DeleteChat(ctx, chatId)
+	err = c.chatUseCase.DeleteChat(ctx, chatId, userId)
 	if err != nil {
 		logger.Error(ctx, "DeleteChat failed: %v", err)
 		return nil, err
@@ -221,3 +357,361 @@ func (c *ChatServiceServer) GetNumUnreadChats(ctx context.Context, req *pb.GetNu
 	logger.Info(ctx, "Successfully fetched number of unread chats")
 	return &pb.GetNumUnreadChatsResponse{NumChats: int32(numUnread)}, nil
 }
+
+func (c *ChatServiceServer) GetChatMembers(ctx context.Context, req *pb.GetChatMembersRequest) (*pb.GetChatMembersResponse, error) {
+	logger.Info(ctx, "Received GetChatMembers request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	members, err := c.chatUseCase.GetChatMembers(ctx, chatId)
+	if err != nil {
+		logger.Error(ctx, "GetChatMembers failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully fetched chat members")
+	return &pb.GetChatMembersResponse{Members: dto.MapChatMembersToProto(members)}, nil
+}
+
+func (c *ChatServiceServer) ChangeChatRole(ctx context.Context, req *pb.ChangeChatRoleRequest) (*pb.ChangeChatRoleResponse, error) {
+	logger.Info(ctx, "Received ChangeChatRole request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	memberId, err := uuid.Parse(req.MemberId)
+	if err != nil {
+		logger.Error(ctx, "Invalid MemberId: %v", err)
+		return nil, err
+	}
+
+	message, err := c.chatUseCase.ChangeChatRole(ctx, chatId, userId, memberId, models.ChatRole(req.Role))
+	if err != nil {
+		logger.Error(ctx, "ChangeChatRole failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully changed chat role")
+	return &pb.ChangeChatRoleResponse{SystemMessage: dto.MapMessageToProto(message)}, nil
+}
+
+func (c *ChatServiceServer) CreateChatInvite(ctx context.Context, req *pb.CreateChatInviteRequest) (*pb.CreateChatInviteResponse, error) {
+	logger.Info(ctx, "Received CreateChatInvite request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	info := models.ChatInviteCreationInfo{UsageLimit: int(req.UsageLimit)}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		info.ExpiresAt = &expiresAt
+	}
+
+	invite, err := c.chatUseCase.CreateChatInvite(ctx, chatId, userId, info)
+	if err != nil {
+		logger.Error(ctx, "CreateChatInvite failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully created chat invite")
+	return &pb.CreateChatInviteResponse{Invite: dto.MapChatInviteToProto(invite)}, nil
+}
+
+func (c *ChatServiceServer) GetChatInvites(ctx context.Context, req *pb.GetChatInvitesRequest) (*pb.GetChatInvitesResponse, error) {
+	logger.Info(ctx, "Received GetChatInvites request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	invites, err := c.chatUseCase.GetChatInvites(ctx, chatId, userId)
+	if err != nil {
+		logger.Error(ctx, "GetChatInvites failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully fetched chat invites")
+	return &pb.GetChatInvitesResponse{Invites: dto.MapChatInvitesToProto(invites)}, nil
+}
+
+func (c *ChatServiceServer) RevokeChatInvite(ctx context.Context, req *pb.RevokeChatInviteRequest) (*pb.RevokeChatInviteResponse, error) {
+	logger.Info(ctx, "Received RevokeChatInvite request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	if err = c.chatUseCase.RevokeChatInvite(ctx, req.Token, userId); err != nil {
+		logger.Error(ctx, "RevokeChatInvite failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully revoked chat invite")
+	return &pb.RevokeChatInviteResponse{Success: true}, nil
+}
+
+func (c *ChatServiceServer) GetChatInvitePreview(ctx context.Context, req *pb.GetChatInvitePreviewRequest) (*pb.GetChatInvitePreviewResponse, error) {
+	logger.Info(ctx, "Received GetChatInvitePreview request")
+
+	preview, err := c.chatUseCase.GetChatInvitePreview(ctx, req.Token)
+	if err != nil {
+		logger.Error(ctx, "GetChatInvitePreview failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully fetched chat invite preview")
+	return &pb.GetChatInvitePreviewResponse{
+		Chat:       dto.MapChatToProto(preview.Chat),
+		NumMembers: int32(preview.NumMembers),
+	}, nil
+}
+
+func (c *ChatServiceServer) JoinChatByInvite(ctx context.Context, req *pb.JoinChatByInviteRequest) (*pb.JoinChatByInviteResponse, error) {
+	logger.Info(ctx, "Received JoinChatByInvite request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	chat, message, err := c.chatUseCase.JoinChatByInvite(ctx, req.Token, userId)
+	if err != nil {
+		logger.Error(ctx, "JoinChatByInvite failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully joined chat by invite")
+	return &pb.JoinChatByInviteResponse{
+		Chat:          dto.MapChatToProto(chat),
+		SystemMessage: dto.MapMessageToProto(message),
+	}, nil
+}
+
+func (c *ChatServiceServer) MuteChat(ctx context.Context, req *pb.MuteChatRequest) (*pb.MuteChatResponse, error) {
+	logger.Info(ctx, "Received MuteChat request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	var mutedUntil *time.Time
+	if req.MutedUntil != nil {
+		tm := req.MutedUntil.AsTime()
+		mutedUntil = &tm
+	}
+	if err = c.chatUseCase.MuteChat(ctx, chatId, userId, mutedUntil); err != nil {
+		logger.Error(ctx, "MuteChat failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully changed chat mute")
+	return &pb.MuteChatResponse{Success: true}, nil
+}
+
+func (c *ChatServiceServer) ArchiveChat(ctx context.Context, req *pb.ArchiveChatRequest) (*pb.ArchiveChatResponse, error) {
+	logger.Info(ctx, "Received ArchiveChat request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	if err = c.chatUseCase.ArchiveChat(ctx, chatId, userId, req.Archived); err != nil {
+		logger.Error(ctx, "ArchiveChat failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully changed chat archive")
+	return &pb.ArchiveChatResponse{Success: true}, nil
+}
+
+func (c *ChatServiceServer) PinChat(ctx context.Context, req *pb.PinChatRequest) (*pb.PinChatResponse, error) {
+	logger.Info(ctx, "Received PinChat request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	if err = c.chatUseCase.PinChat(ctx, chatId, userId, req.Pinned); err != nil {
+		logger.Error(ctx, "PinChat failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully changed chat pin")
+	return &pb.PinChatResponse{Success: true}, nil
+}
+
+func (c *ChatServiceServer) ReorderPinnedChats(ctx context.Context, req *pb.ReorderPinnedChatsRequest) (*pb.ReorderPinnedChatsResponse, error) {
+	logger.Info(ctx, "Received ReorderPinnedChats request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+	chatIds := make([]uuid.UUID, len(req.ChatIds))
+	for i := range req.ChatIds {
+		chatIds[i], err = uuid.Parse(req.ChatIds[i])
+		if err != nil {
+			logger.Error(ctx, "Invalid ChatId: %v", err)
+			return nil, err
+		}
+	}
+
+	if err = c.chatUseCase.ReorderPinnedChats(ctx, userId, chatIds); err != nil {
+		logger.Error(ctx, "ReorderPinnedChats failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully reordered pinned chats")
+	return &pb.ReorderPinnedChatsResponse{Success: true}, nil
+}
+
+func (c *ChatServiceServer) SetChatMessageTTL(ctx context.Context, req *pb.SetChatMessageTTLRequest) (*pb.SetChatMessageTTLResponse, error) {
+	logger.Info(ctx, "Received SetChatMessageTTL request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	message, err := c.chatUseCase.SetChatMessageTTL(ctx, chatId, userId, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		logger.Error(ctx, "SetChatMessageTTL failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully set chat message ttl")
+	return &pb.SetChatMessageTTLResponse{SystemMessage: dto.MapMessageToProto(message)}, nil
+}
+
+func (c *ChatServiceServer) SetChatEncryption(ctx context.Context, req *pb.SetChatEncryptionRequest) (*pb.SetChatEncryptionResponse, error) {
+	logger.Info(ctx, "Received SetChatEncryption request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	message, err := c.chatUseCase.SetChatEncryption(ctx, chatId, userId, req.Enabled)
+	if err != nil {
+		logger.Error(ctx, "SetChatEncryption failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully set chat encryption")
+	return &pb.SetChatEncryptionResponse{SystemMessage: dto.MapMessageToProto(message)}, nil
+}
+
+func (c *ChatServiceServer) RequestChatExport(ctx context.Context, req *pb.RequestChatExportRequest) (*pb.RequestChatExportResponse, error) {
+	logger.Info(ctx, "Received RequestChatExport request")
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ChatId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	export, err := c.chatExportUseCase.RequestChatExport(ctx, chatId, userId)
+	if err != nil {
+		logger.Error(ctx, "RequestChatExport failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully requested chat export")
+	return &pb.RequestChatExportResponse{Export: dto.MapChatExportToProto(export)}, nil
+}
+
+func (c *ChatServiceServer) GetChatExport(ctx context.Context, req *pb.GetChatExportRequest) (*pb.GetChatExportResponse, error) {
+	logger.Info(ctx, "Received GetChatExport request")
+
+	exportId, err := uuid.Parse(req.ExportId)
+	if err != nil {
+		logger.Error(ctx, "Invalid ExportId: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, err
+	}
+
+	export, err := c.chatExportUseCase.GetChatExport(ctx, exportId, userId)
+	if err != nil {
+		logger.Error(ctx, "GetChatExport failed: %v", err)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully fetched chat export")
+	return &pb.GetChatExportResponse{Export: dto.MapChatExportToProto(export)}, nil
+}
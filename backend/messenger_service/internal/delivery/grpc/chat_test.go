@@ -6,7 +6,11 @@ import (
 	"github.com/google/uuid"
 	"quickflow/messenger_service/internal/delivery/grpc/mocks"
 	"quickflow/shared/models"
+	pb "quickflow/shared/proto/messenger_service"
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestCreateChat(t *testing.T) {
@@ -18,10 +22,10 @@ func TestCreateChat(t *testing.T) {
 	expectedChat := models.Chat{ID: uuid.New(), Name: "Test Chat"}
 
 	// Настройка мока
-	mockChatUseCase.EXPECT().CreateChat(context.Background(), chatInfo).Return(expectedChat, nil)
+	mockChatUseCase.EXPECT().CreateChat(context.Background(), uuid.Nil, chatInfo).Return(expectedChat, nil, nil)
 
 	// Ваша логика теста
-	chat, err := mockChatUseCase.CreateChat(context.Background(), chatInfo)
+	chat, _, err := mockChatUseCase.CreateChat(context.Background(), uuid.Nil, chatInfo)
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -35,16 +39,22 @@ func TestDeleteChat(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
-	chatID := uuid.New()
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
 
 	// Настройка мока
-	mockChatUseCase.EXPECT().DeleteChat(context.Background(), chatID).Return(nil)
+	mockChatUseCase.EXPECT().DeleteChat(context.Background(), chatID, userID).Return(nil)
 
 	// Ваша логика теста
-	err := mockChatUseCase.DeleteChat(context.Background(), chatID)
+	_, err := server.DeleteChat(context.Background(), &pb.DeleteChatRequest{ChatId: chatID.String(), UserId: userID.String()})
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
+
+	_, err = server.DeleteChat(context.Background(), &pb.DeleteChatRequest{ChatId: chatID.String(), UserId: "invalid"})
+	if err == nil {
+		t.Errorf("expected error for invalid user id")
+	}
 }
 
 func TestGetChat(t *testing.T) {
@@ -123,10 +133,10 @@ func TestGetUserChats(t *testing.T) {
 	}
 
 	// Настройка мока
-	mockChatUseCase.EXPECT().GetUserChats(context.Background(), userID).Return(expectedChats, nil)
+	mockChatUseCase.EXPECT().GetUserChats(context.Background(), userID, false).Return(expectedChats, nil)
 
 	// Ваша логика теста
-	chats, err := mockChatUseCase.GetUserChats(context.Background(), userID)
+	chats, err := mockChatUseCase.GetUserChats(context.Background(), userID, false)
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -170,3 +180,349 @@ func TestLeaveChat(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestChatServiceServer_AddChatMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID, memberID := uuid.New(), uuid.New(), uuid.New()
+	systemMessage := models.Message{
+		ID:       uuid.New(),
+		ChatID:   chatID,
+		SenderID: userID,
+		System:   &models.SystemInfo{Action: models.SystemActionMemberAdded, UserID: memberID},
+	}
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		AddChatMembers(gomock.Any(), chatID, userID, []uuid.UUID{memberID}).
+		Return([]models.Message{systemMessage}, nil)
+
+	resp, err := server.AddChatMembers(context.Background(), &pb.AddChatMembersRequest{
+		ChatId:    chatID.String(),
+		UserId:    userID.String(),
+		MemberIds: []string{memberID.String()},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.SystemMessages) != 1 || resp.SystemMessages[0].System.UserId != memberID.String() {
+		t.Errorf("unexpected system messages %v", resp.SystemMessages)
+	}
+
+	// невалидный id участника не доходит до usecase
+	_, err = server.AddChatMembers(context.Background(), &pb.AddChatMembersRequest{
+		ChatId:    chatID.String(),
+		UserId:    userID.String(),
+		MemberIds: []string{"invalid"},
+	})
+	if err == nil {
+		t.Error("expected error for invalid member id")
+	}
+}
+
+func TestChatServiceServer_UpdateChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		UpdateChat(gomock.Any(), chatID, userID, models.ChatUpdateInfo{Name: "New name"}).
+		Return(models.Chat{ID: chatID, Name: "New name"}, nil, nil)
+
+	resp, err := server.UpdateChat(context.Background(), &pb.UpdateChatRequest{
+		ChatId: chatID.String(),
+		UserId: userID.String(),
+		Name:   "New name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Chat.Name != "New name" {
+		t.Errorf("expected renamed chat, got %v", resp.Chat)
+	}
+}
+
+func TestChatServiceServer_ChangeChatRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID, memberID := uuid.New(), uuid.New(), uuid.New()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		ChangeChatRole(gomock.Any(), chatID, userID, memberID, models.ChatRoleAdmin).
+		Return(models.Message{
+			ID:       uuid.New(),
+			ChatID:   chatID,
+			SenderID: userID,
+			Text:     "admin",
+			System:   &models.SystemInfo{Action: models.SystemActionRoleChanged, UserID: memberID},
+		}, nil)
+
+	resp, err := server.ChangeChatRole(context.Background(), &pb.ChangeChatRoleRequest{
+		ChatId:   chatID.String(),
+		UserId:   userID.String(),
+		MemberId: memberID.String(),
+		Role:     "admin",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.SystemMessage.System.UserId != memberID.String() {
+		t.Errorf("expected role change of %v, got %v", memberID, resp.SystemMessage.System)
+	}
+
+	if _, err = server.ChangeChatRole(context.Background(), &pb.ChangeChatRoleRequest{
+		ChatId: chatID.String(),
+		UserId: userID.String(),
+	}); err == nil {
+		t.Error("expected error for invalid member id")
+	}
+}
+
+func TestChatServiceServer_CreateChatInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		CreateChatInvite(gomock.Any(), chatID, userID, models.ChatInviteCreationInfo{ExpiresAt: &expiresAt, UsageLimit: 3}).
+		Return(models.ChatInvite{Token: "token", ChatID: chatID, CreatorID: userID, ExpiresAt: &expiresAt, UsageLimit: 3}, nil)
+
+	resp, err := server.CreateChatInvite(context.Background(), &pb.CreateChatInviteRequest{
+		ChatId:     chatID.String(),
+		UserId:     userID.String(),
+		ExpiresAt:  timestamppb.New(expiresAt),
+		UsageLimit: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Invite.Token != "token" || resp.Invite.UsageLimit != 3 {
+		t.Errorf("unexpected invite %v", resp.Invite)
+	}
+}
+
+func TestChatServiceServer_JoinChatByInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		JoinChatByInvite(gomock.Any(), "token", userID).
+		Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, models.Message{
+			ID:       uuid.New(),
+			ChatID:   chatID,
+			SenderID: userID,
+			System:   &models.SystemInfo{Action: models.SystemActionMemberJoined, UserID: userID},
+		}, nil)
+
+	resp, err := server.JoinChatByInvite(context.Background(), &pb.JoinChatByInviteRequest{
+		Token:  "token",
+		UserId: userID.String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Chat.Id != chatID.String() || resp.SystemMessage.System.Action != string(models.SystemActionMemberJoined) {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestChatServiceServer_MuteChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+	mutedUntil := time.Now().Add(time.Hour).UTC()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().MuteChat(gomock.Any(), chatID, userID, &mutedUntil).Return(nil)
+	mockChatUseCase.EXPECT().MuteChat(gomock.Any(), chatID, userID, nil).Return(nil)
+
+	for _, req := range []*pb.MuteChatRequest{
+		{ChatId: chatID.String(), UserId: userID.String(), MutedUntil: timestamppb.New(mutedUntil)},
+		{ChatId: chatID.String(), UserId: userID.String()},
+	} {
+		resp, err := server.MuteChat(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !resp.Success {
+			t.Errorf("expected success")
+		}
+	}
+}
+
+func TestChatServiceServer_ReorderPinnedChats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	userID := uuid.New()
+	chatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().ReorderPinnedChats(gomock.Any(), userID, chatIDs).Return(nil)
+
+	resp, err := server.ReorderPinnedChats(context.Background(), &pb.ReorderPinnedChatsRequest{
+		UserId:  userID.String(),
+		ChatIds: []string{chatIDs[0].String(), chatIDs[1].String()},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Errorf("expected success")
+	}
+
+	_, err = server.ReorderPinnedChats(context.Background(), &pb.ReorderPinnedChatsRequest{
+		UserId:  userID.String(),
+		ChatIds: []string{"invalid"},
+	})
+	if err == nil {
+		t.Errorf("expected error for invalid chat id")
+	}
+}
+
+func TestChatServiceServer_SetChatMessageTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		SetChatMessageTTL(gomock.Any(), chatID, userID, time.Hour).
+		Return(models.Message{
+			ID:       uuid.New(),
+			ChatID:   chatID,
+			SenderID: userID,
+			Text:     "3600",
+			System:   &models.SystemInfo{Action: models.SystemActionMessageTTLChanged},
+		}, nil)
+
+	resp, err := server.SetChatMessageTTL(context.Background(), &pb.SetChatMessageTTLRequest{
+		ChatId:     chatID.String(),
+		UserId:     userID.String(),
+		TtlSeconds: 3600,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.SystemMessage.Text != "3600" {
+		t.Errorf("expected ttl change to 3600, got %v", resp.SystemMessage.Text)
+	}
+
+	if _, err = server.SetChatMessageTTL(context.Background(), &pb.SetChatMessageTTLRequest{
+		ChatId: "invalid",
+		UserId: userID.String(),
+	}); err == nil {
+		t.Error("expected error for invalid chat id")
+	}
+}
+
+func TestChatServiceServer_SetChatEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatUseCase := mocks.NewMockChatUseCase(ctrl)
+	server := NewChatServiceServer(mockChatUseCase, nil)
+	chatID, userID := uuid.New(), uuid.New()
+
+	// Настройка мока
+	mockChatUseCase.EXPECT().
+		SetChatEncryption(gomock.Any(), chatID, userID, true).
+		Return(models.Message{
+			ID:       uuid.New(),
+			ChatID:   chatID,
+			SenderID: userID,
+			Text:     "on",
+			System:   &models.SystemInfo{Action: models.SystemActionEncryptionChanged},
+		}, nil)
+
+	resp, err := server.SetChatEncryption(context.Background(), &pb.SetChatEncryptionRequest{
+		ChatId:  chatID.String(),
+		UserId:  userID.String(),
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.SystemMessage.Text != "on" {
+		t.Errorf("expected encryption turned on, got %v", resp.SystemMessage.Text)
+	}
+
+	if _, err = server.SetChatEncryption(context.Background(), &pb.SetChatEncryptionRequest{
+		ChatId: chatID.String(),
+		UserId: "invalid",
+	}); err == nil {
+		t.Error("expected error for invalid user id")
+	}
+}
+
+func TestChatServiceServer_ChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatExportUseCase := mocks.NewMockChatExportUseCase(ctrl)
+	server := NewChatServiceServer(nil, mockChatExportUseCase)
+	chatID, userID := uuid.New(), uuid.New()
+	export := models.ChatExport{ID: uuid.New(), ChatID: chatID, UserID: userID, Status: models.ChatExportReady, FileURL: "export.zip"}
+
+	// Настройка мока
+	mockChatExportUseCase.EXPECT().RequestChatExport(gomock.Any(), chatID, userID).Return(export, nil)
+	mockChatExportUseCase.EXPECT().GetChatExport(gomock.Any(), export.ID, userID).Return(export, nil)
+
+	requested, err := server.RequestChatExport(context.Background(), &pb.RequestChatExportRequest{
+		ChatId: chatID.String(),
+		UserId: userID.String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requested.Export.Id != export.ID.String() {
+		t.Errorf("expected export %v, got %v", export.ID, requested.Export.Id)
+	}
+
+	fetched, err := server.GetChatExport(context.Background(), &pb.GetChatExportRequest{
+		ExportId: export.ID.String(),
+		UserId:   userID.String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fetched.Export.Status != "ready" || fetched.Export.FileUrl != "export.zip" {
+		t.Errorf("expected ready export, got %v", fetched.Export)
+	}
+
+	if _, err = server.GetChatExport(context.Background(), &pb.GetChatExportRequest{
+		ExportId: "invalid",
+		UserId:   userID.String(),
+	}); err == nil {
+		t.Error("expected error for invalid export id")
+	}
+}
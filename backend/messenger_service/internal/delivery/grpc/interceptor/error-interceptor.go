@@ -63,6 +63,27 @@ func ErrorInterceptor(
 	case errors.Is(err, messenger_errors.ErrNotParticipant) || errors.Is(err, messenger_errors.ErrNotOwnerOfStickerPack):
 		return nil, statusWithDetails(codes.PermissionDenied, err.Error(), "NOT_PARTICIPANT")
 
+	case errors.Is(err, messenger_errors.ErrNotChatAdmin):
+		return nil, statusWithDetails(codes.PermissionDenied, err.Error(), "NOT_CHAT_ADMIN")
+
+	case errors.Is(err, messenger_errors.ErrOwnerCannotLeave):
+		return nil, statusWithDetails(codes.PermissionDenied, err.Error(), "OWNER_CANNOT_LEAVE")
+
+	case errors.Is(err, messenger_errors.ErrInvalidChatRole):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_ROLE")
+
+	case errors.Is(err, messenger_errors.ErrInvalidChatInvite):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_INVITE")
+
+	case errors.Is(err, messenger_errors.ErrChatInviteExpired):
+		return nil, statusWithDetails(codes.NotFound, err.Error(), "CHAT_INVITE_EXPIRED")
+
+	case errors.Is(err, messenger_errors.ErrNotSender):
+		return nil, statusWithDetails(codes.PermissionDenied, err.Error(), "NOT_SENDER")
+
+	case errors.Is(err, messenger_errors.ErrSystemMessage):
+		return nil, statusWithDetails(codes.PermissionDenied, err.Error(), "SYSTEM_MESSAGE")
+
 	case errors.Is(err, messenger_errors.ErrInvalidChatCreationInfo):
 		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_CREATION_INFO")
 
@@ -72,6 +93,63 @@ func ErrorInterceptor(
 	case errors.Is(err, messenger_errors.ErrInvalidChatType):
 		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_TYPE")
 
+	case errors.Is(err, messenger_errors.ErrInvalidChatUpdateInfo):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_UPDATE_INFO")
+
+	case errors.Is(err, messenger_errors.ErrInvalidChatMembers):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_CHAT_MEMBERS")
+
+	case errors.Is(err, messenger_errors.ErrInvalidReplyTo):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_REPLY_TO")
+
+	case errors.Is(err, messenger_errors.ErrInvalidForward):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_FORWARD")
+
+	case errors.Is(err, messenger_errors.ErrAlreadyReacted):
+		return nil, statusWithDetails(codes.AlreadyExists, err.Error(), "ALREADY_REACTED")
+
+	case errors.Is(err, messenger_errors.ErrAlreadyPinned):
+		return nil, statusWithDetails(codes.AlreadyExists, err.Error(), "ALREADY_PINNED")
+
+	case errors.Is(err, messenger_errors.ErrInvalidSearchQuery):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_SEARCH_QUERY")
+
+	case errors.Is(err, messenger_errors.ErrInvalidSendAt):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_SEND_AT")
+
+	case errors.Is(err, messenger_errors.ErrChatEncrypted):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "CHAT_ENCRYPTED")
+
+	case errors.Is(err, messenger_errors.ErrInvalidEncrypted):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_ENCRYPTED_MESSAGE")
+
+	case errors.Is(err, messenger_errors.ErrInvalidPoll):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_POLL")
+
+	case errors.Is(err, messenger_errors.ErrInvalidPollVote):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_POLL_VOTE")
+
+	case errors.Is(err, messenger_errors.ErrPollClosed):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "POLL_CLOSED")
+
+	case errors.Is(err, messenger_errors.ErrTooManyPinnedChats):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "TOO_MANY_PINNED_CHATS")
+
+	case errors.Is(err, messenger_errors.ErrInvalidPinnedChats):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_PINNED_CHATS")
+
+	case errors.Is(err, messenger_errors.ErrInvalidMessageTTL):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_MESSAGE_TTL")
+
+	case errors.Is(err, messenger_errors.ErrInvalidStickerPackOrder):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_STICKER_PACK_ORDER")
+
+	case errors.Is(err, messenger_errors.ErrTooManyFavouriteStickers):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "TOO_MANY_FAVOURITE_STICKERS")
+
+	case errors.Is(err, messenger_errors.ErrInvalidReaction):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_REACTION")
+
 	case errors.Is(err, messenger_errors.ErrInvalidNumMessages):
 		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_NUM_MESSAGES")
 
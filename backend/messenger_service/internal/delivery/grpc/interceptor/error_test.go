@@ -55,6 +55,181 @@ func TestErrorInterceptor(t *testing.T) {
 			expectedMsg:    message_errors.ErrInvalidChatType.Error(),
 			expectedReason: "INVALID_CHAT_TYPE",
 		},
+		{
+			name:           "ErrInvalidChatUpdateInfo",
+			err:            message_errors.ErrInvalidChatUpdateInfo,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidChatUpdateInfo.Error(),
+			expectedReason: "INVALID_CHAT_UPDATE_INFO",
+		},
+		{
+			name:           "ErrInvalidChatMembers",
+			err:            message_errors.ErrInvalidChatMembers,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidChatMembers.Error(),
+			expectedReason: "INVALID_CHAT_MEMBERS",
+		},
+		{
+			name:           "ErrNotChatAdmin",
+			err:            message_errors.ErrNotChatAdmin,
+			expectedCode:   codes.PermissionDenied,
+			expectedMsg:    message_errors.ErrNotChatAdmin.Error(),
+			expectedReason: "NOT_CHAT_ADMIN",
+		},
+		{
+			name:           "ErrOwnerCannotLeave",
+			err:            message_errors.ErrOwnerCannotLeave,
+			expectedCode:   codes.PermissionDenied,
+			expectedMsg:    message_errors.ErrOwnerCannotLeave.Error(),
+			expectedReason: "OWNER_CANNOT_LEAVE",
+		},
+		{
+			name:           "ErrInvalidChatRole",
+			err:            message_errors.ErrInvalidChatRole,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidChatRole.Error(),
+			expectedReason: "INVALID_CHAT_ROLE",
+		},
+		{
+			name:           "ErrInvalidChatInvite",
+			err:            message_errors.ErrInvalidChatInvite,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidChatInvite.Error(),
+			expectedReason: "INVALID_CHAT_INVITE",
+		},
+		{
+			name:           "ErrChatInviteExpired",
+			err:            message_errors.ErrChatInviteExpired,
+			expectedCode:   codes.NotFound,
+			expectedMsg:    message_errors.ErrChatInviteExpired.Error(),
+			expectedReason: "CHAT_INVITE_EXPIRED",
+		},
+		{
+			name:           "ErrInvalidReplyTo",
+			err:            message_errors.ErrInvalidReplyTo,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidReplyTo.Error(),
+			expectedReason: "INVALID_REPLY_TO",
+		},
+		{
+			name:           "ErrInvalidForward",
+			err:            message_errors.ErrInvalidForward,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidForward.Error(),
+			expectedReason: "INVALID_FORWARD",
+		},
+		{
+			name:           "ErrAlreadyReacted",
+			err:            message_errors.ErrAlreadyReacted,
+			expectedCode:   codes.AlreadyExists,
+			expectedMsg:    message_errors.ErrAlreadyReacted.Error(),
+			expectedReason: "ALREADY_REACTED",
+		},
+		{
+			name:           "ErrAlreadyPinned",
+			err:            message_errors.ErrAlreadyPinned,
+			expectedCode:   codes.AlreadyExists,
+			expectedMsg:    message_errors.ErrAlreadyPinned.Error(),
+			expectedReason: "ALREADY_PINNED",
+		},
+		{
+			name:           "ErrInvalidSearchQuery",
+			err:            message_errors.ErrInvalidSearchQuery,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidSearchQuery.Error(),
+			expectedReason: "INVALID_SEARCH_QUERY",
+		},
+		{
+			name:           "ErrInvalidSendAt",
+			err:            message_errors.ErrInvalidSendAt,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidSendAt.Error(),
+			expectedReason: "INVALID_SEND_AT",
+		},
+		{
+			name:           "ErrSystemMessage",
+			err:            message_errors.ErrSystemMessage,
+			expectedCode:   codes.PermissionDenied,
+			expectedMsg:    message_errors.ErrSystemMessage.Error(),
+			expectedReason: "SYSTEM_MESSAGE",
+		},
+		{
+			name:           "ErrChatEncrypted",
+			err:            message_errors.ErrChatEncrypted,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrChatEncrypted.Error(),
+			expectedReason: "CHAT_ENCRYPTED",
+		},
+		{
+			name:           "ErrInvalidEncrypted",
+			err:            message_errors.ErrInvalidEncrypted,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidEncrypted.Error(),
+			expectedReason: "INVALID_ENCRYPTED_MESSAGE",
+		},
+		{
+			name:           "ErrInvalidPoll",
+			err:            message_errors.ErrInvalidPoll,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidPoll.Error(),
+			expectedReason: "INVALID_POLL",
+		},
+		{
+			name:           "ErrInvalidPollVote",
+			err:            message_errors.ErrInvalidPollVote,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidPollVote.Error(),
+			expectedReason: "INVALID_POLL_VOTE",
+		},
+		{
+			name:           "ErrPollClosed",
+			err:            message_errors.ErrPollClosed,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrPollClosed.Error(),
+			expectedReason: "POLL_CLOSED",
+		},
+		{
+			name:           "ErrTooManyPinnedChats",
+			err:            message_errors.ErrTooManyPinnedChats,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrTooManyPinnedChats.Error(),
+			expectedReason: "TOO_MANY_PINNED_CHATS",
+		},
+		{
+			name:           "ErrInvalidPinnedChats",
+			err:            message_errors.ErrInvalidPinnedChats,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidPinnedChats.Error(),
+			expectedReason: "INVALID_PINNED_CHATS",
+		},
+		{
+			name:           "ErrInvalidMessageTTL",
+			err:            message_errors.ErrInvalidMessageTTL,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidMessageTTL.Error(),
+			expectedReason: "INVALID_MESSAGE_TTL",
+		},
+		{
+			name:           "ErrInvalidStickerPackOrder",
+			err:            message_errors.ErrInvalidStickerPackOrder,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidStickerPackOrder.Error(),
+			expectedReason: "INVALID_STICKER_PACK_ORDER",
+		},
+		{
+			name:           "ErrTooManyFavouriteStickers",
+			err:            message_errors.ErrTooManyFavouriteStickers,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrTooManyFavouriteStickers.Error(),
+			expectedReason: "TOO_MANY_FAVOURITE_STICKERS",
+		},
+		{
+			name:           "ErrInvalidReaction",
+			err:            message_errors.ErrInvalidReaction,
+			expectedCode:   codes.InvalidArgument,
+			expectedMsg:    message_errors.ErrInvalidReaction.Error(),
+			expectedReason: "INVALID_REACTION",
+		},
 		{
 			name:           "ErrInvalidNumMessages",
 			err:            message_errors.ErrInvalidNumMessages,
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	dto "quickflow/shared/client/messenger_service"
+	poll_mapper "quickflow/shared/client/poll"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
@@ -18,20 +19,44 @@ import (
 type MessageUseCase interface {
 	GetMessageById(ctx context.Context, messageId uuid.UUID) (models.Message, error)
 	GetMessagesForChatOlder(ctx context.Context, chatId uuid.UUID, userId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error)
+	GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, userId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error)
+	GetMessagesAround(ctx context.Context, chatId, anchorId, userId uuid.UUID, numMessages int) (models.MessagesPage, error)
 	SaveMessage(ctx context.Context, message models.Message) (*models.Message, error)
-	DeleteMessage(ctx context.Context, messageId uuid.UUID) error
+	UpdateMessage(ctx context.Context, message models.Message, userId uuid.UUID) (*models.Message, int64, error)
+	ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]models.Message, error)
+	AddReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error)
+	RemoveReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error)
+	PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error)
+	UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error)
+	VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error)
+	SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error)
+	DeleteMessage(ctx context.Context, messageId, userId uuid.UUID) (int64, error)
 	GetLastReadTs(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (*time.Time, error)
-	UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId uuid.UUID, userId uuid.UUID) error
+	UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error)
+	UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error)
+	GetMessageReceipts(ctx context.Context, messageId, userId uuid.UUID) ([]models.MessageReceipt, error)
 	GetNumUnreadMessages(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (int, error)
+	GetChatEventsSince(ctx context.Context, chatId uuid.UUID, userId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error)
+}
+
+type ScheduledMessageUseCase interface {
+	ScheduleMessage(ctx context.Context, scheduled models.ScheduledMessage) (models.ScheduledMessage, error)
+	GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error)
+	UpdateScheduledMessage(ctx context.Context, update models.ScheduledMessage, userId uuid.UUID) (models.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error
 }
 
 type MessageServiceServer struct {
 	pb.UnimplementedMessageServiceServer
-	MessageUseCase MessageUseCase
+	MessageUseCase          MessageUseCase
+	ScheduledMessageUseCase ScheduledMessageUseCase
 }
 
-func NewMessageServiceServer(messageUseCase MessageUseCase) *MessageServiceServer {
-	return &MessageServiceServer{MessageUseCase: messageUseCase}
+func NewMessageServiceServer(messageUseCase MessageUseCase, scheduledMessageUseCase ScheduledMessageUseCase) *MessageServiceServer {
+	return &MessageServiceServer{
+		MessageUseCase:          messageUseCase,
+		ScheduledMessageUseCase: scheduledMessageUseCase,
+	}
 }
 
 func (m *MessageServiceServer) GetMessagesForChat(ctx context.Context, req *pb.GetMessagesForChatRequest) (*pb.GetMessagesForChatResponse, error) {
@@ -48,7 +73,35 @@ func (m *MessageServiceServer) GetMessagesForChat(ctx context.Context, req *pb.G
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
-	messages, err := m.MessageUseCase.GetMessagesForChatOlder(ctx, chatId, userId, int(req.MessagesNum), req.UpdatedAt.AsTime())
+	if len(req.AnchorId) != 0 {
+		anchorId, err := uuid.Parse(req.AnchorId)
+		if err != nil {
+			logger.Error(ctx, "Invalid anchorId: %v", err)
+			return nil, err
+		}
+
+		page, err := m.MessageUseCase.GetMessagesAround(ctx, chatId, anchorId, userId, int(req.MessagesNum))
+		if err != nil {
+			logger.Error(ctx, "Failed to get messages around %v: %v", anchorId, err)
+			return nil, err
+		}
+
+		resp := &pb.GetMessagesForChatResponse{Messages: dto.MapMessagesToProto(page.Messages)}
+		if page.OlderCursor != nil {
+			resp.OlderCursor = timestamppb.New(*page.OlderCursor)
+		}
+		if page.NewerCursor != nil {
+			resp.NewerCursor = timestamppb.New(*page.NewerCursor)
+		}
+		return resp, nil
+	}
+
+	var messages []models.Message
+	if req.After != nil {
+		messages, err = m.MessageUseCase.GetMessagesForChatNewer(ctx, chatId, userId, int(req.MessagesNum), req.After.AsTime())
+	} else {
+		messages, err = m.MessageUseCase.GetMessagesForChatOlder(ctx, chatId, userId, int(req.MessagesNum), req.UpdatedAt.AsTime())
+	}
 	if err != nil {
 		logger.Error(ctx, "Failed to get messages: %v", err)
 		return nil, err
@@ -98,6 +151,215 @@ func (m *MessageServiceServer) SendMessage(ctx context.Context, req *pb.SendMess
 	return &pb.SendMessageResponse{Message: dto.MapMessageToProto(*savedMessage)}, nil
 }
 
+func (m *MessageServiceServer) UpdateMessage(ctx context.Context, req *pb.UpdateMessageRequest) (*pb.UpdateMessageResponse, error) {
+	logger.Info(ctx, "UpdateMessage request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	message, err := dto.MapProtoToMessage(req.Message)
+	if err != nil {
+		logger.Error(ctx, "Failed to map proto to message: %v", err)
+		return nil, err
+	}
+	if message == nil {
+		return nil, status.Error(codes.InvalidArgument, "message is empty")
+	}
+
+	updatedMessage, seq, err := m.MessageUseCase.UpdateMessage(ctx, *message, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to update message: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateMessageResponse{Message: dto.MapMessageToProto(*updatedMessage), Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) ForwardMessages(ctx context.Context, req *pb.ForwardMessagesRequest) (*pb.ForwardMessagesResponse, error) {
+	logger.Info(ctx, "ForwardMessages request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	messageIds := make([]uuid.UUID, 0, len(req.MessageIds))
+	for _, id := range req.MessageIds {
+		messageId, err := uuid.Parse(id)
+		if err != nil {
+			logger.Error(ctx, "Invalid messageId: %v", err)
+			return nil, status.Error(codes.InvalidArgument, "invalid message id")
+		}
+		messageIds = append(messageIds, messageId)
+	}
+
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		chatId = uuid.Nil
+	}
+	receiverId, err := uuid.Parse(req.ReceiverId)
+	if err != nil {
+		receiverId = uuid.Nil
+	}
+	if chatId == uuid.Nil && receiverId == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "chatId and receiverId cannot be both empty")
+	}
+
+	messages, err := m.MessageUseCase.ForwardMessages(ctx, messageIds, chatId, receiverId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to forward messages: %v", err)
+		return nil, err
+	}
+
+	return &pb.ForwardMessagesResponse{Messages: dto.MapMessagesToProto(messages)}, nil
+}
+
+func (m *MessageServiceServer) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	logger.Info(ctx, "AddReaction request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, err
+	}
+
+	chatId, seq, err := m.MessageUseCase.AddReaction(ctx, messageId, userId, req.Emoji)
+	if err != nil {
+		logger.Error(ctx, "Failed to add reaction: %v", err)
+		return nil, err
+	}
+
+	return &pb.AddReactionResponse{ChatId: chatId.String(), Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	logger.Info(ctx, "RemoveReaction request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, err
+	}
+
+	chatId, seq, err := m.MessageUseCase.RemoveReaction(ctx, messageId, userId, req.Emoji)
+	if err != nil {
+		logger.Error(ctx, "Failed to remove reaction: %v", err)
+		return nil, err
+	}
+
+	return &pb.RemoveReactionResponse{ChatId: chatId.String(), Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.PinMessageResponse, error) {
+	logger.Info(ctx, "PinMessage request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, err
+	}
+
+	chatId, seq, err := m.MessageUseCase.PinMessage(ctx, messageId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to pin message: %v", err)
+		return nil, err
+	}
+
+	return &pb.PinMessageResponse{ChatId: chatId.String(), Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*pb.UnpinMessageResponse, error) {
+	logger.Info(ctx, "UnpinMessage request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, err
+	}
+
+	chatId, seq, err := m.MessageUseCase.UnpinMessage(ctx, messageId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to unpin message: %v", err)
+		return nil, err
+	}
+
+	return &pb.UnpinMessageResponse{ChatId: chatId.String(), Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) VotePoll(ctx context.Context, req *pb.VotePollRequest) (*pb.VotePollResponse, error) {
+	logger.Info(ctx, "VotePoll request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid message id")
+	}
+
+	message, err := m.MessageUseCase.VotePoll(ctx, messageId, poll_mapper.ProtoOptionsToModel(req.Options), userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to vote in poll: %v", err)
+		return nil, err
+	}
+
+	return &pb.VotePollResponse{Message: dto.MapMessageToProto(*message)}, nil
+}
+
+func (m *MessageServiceServer) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	logger.Info(ctx, "SearchMessages request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	query := models.MessageSearchQuery{Text: req.Query, Limit: int(req.Limit)}
+	if len(req.ChatId) != 0 {
+		query.ChatID, err = uuid.Parse(req.ChatId)
+		if err != nil {
+			logger.Error(ctx, "Invalid chatId: %v", err)
+			return nil, err
+		}
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		query.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		query.To = &to
+	}
+
+	results, err := m.MessageUseCase.SearchMessages(ctx, userId, query)
+	if err != nil {
+		logger.Error(ctx, "Failed to search messages: %v", err)
+		return nil, err
+	}
+
+	return &pb.SearchMessagesResponse{Results: dto.MapMessageSearchResultsToProto(results)}, nil
+}
+
 func (m *MessageServiceServer) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.DeleteMessageResponse, error) {
 	logger.Info(ctx, "DeleteMessage request received")
 	messageId, err := uuid.Parse(req.MessageId)
@@ -106,13 +368,19 @@ func (m *MessageServiceServer) DeleteMessage(ctx context.Context, req *pb.Delete
 		return nil, err
 	}
 
-	err = m.MessageUseCase.DeleteMessage(ctx, messageId)
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, err
+	}
+
+	seq, err := m.MessageUseCase.DeleteMessage(ctx, messageId, userId)
 	if err != nil {
 		logger.Error(ctx, "Failed to delete message: %v", err)
 		return nil, err
 	}
 
-	return &pb.DeleteMessageResponse{Success: true}, nil
+	return &pb.DeleteMessageResponse{Success: true, Seq: seq}, nil
 }
 
 func (m *MessageServiceServer) UpdateLastReadTs(ctx context.Context, req *pb.UpdateLastReadTsRequest) (*pb.UpdateLastReadTsResponse, error) {
@@ -129,13 +397,68 @@ func (m *MessageServiceServer) UpdateLastReadTs(ctx context.Context, req *pb.Upd
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
-	err = m.MessageUseCase.UpdateLastReadTs(ctx, req.LastReadTimestamp.AsTime(), chatId, userId)
+	// older clients do not send the read message
+	var messageId uuid.UUID
+	if len(req.MessageId) != 0 {
+		messageId, err = uuid.Parse(req.MessageId)
+		if err != nil {
+			logger.Error(ctx, "Invalid messageId: %v", err)
+			return nil, err
+		}
+	}
+
+	seq, err := m.MessageUseCase.UpdateLastReadTs(ctx, req.LastReadTimestamp.AsTime(), chatId, userId, messageId)
 	if err != nil {
 		logger.Error(ctx, "Failed to update last read timestamp: %v", err)
 		return nil, err
 	}
 
-	return &pb.UpdateLastReadTsResponse{Success: true}, nil
+	return &pb.UpdateLastReadTsResponse{Success: true, Seq: seq}, nil
+}
+
+func (m *MessageServiceServer) UpdateLastDeliveredTs(ctx context.Context, req *pb.UpdateLastDeliveredTsRequest) (*pb.UpdateLastDeliveredTsResponse, error) {
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid chatId: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid chat id")
+	}
+
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	updated, err := m.MessageUseCase.UpdateLastDeliveredTs(ctx, req.LastDeliveredTimestamp.AsTime(), chatId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to update last delivered timestamp: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateLastDeliveredTsResponse{Success: true, Updated: updated}, nil
+}
+
+func (m *MessageServiceServer) GetMessageReceipts(ctx context.Context, req *pb.GetMessageReceiptsRequest) (*pb.GetMessageReceiptsResponse, error) {
+	logger.Info(ctx, "GetMessageReceipts request received")
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid messageId: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid message id")
+	}
+
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	receipts, err := m.MessageUseCase.GetMessageReceipts(ctx, messageId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get message receipts: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetMessageReceiptsResponse{Receipts: dto.MapMessageReceiptsToProto(receipts)}, nil
 }
 
 func (m *MessageServiceServer) GetLastReadTs(ctx context.Context, req *pb.GetLastReadTsRequest) (*pb.GetLastReadTsResponse, error) {
@@ -190,3 +513,118 @@ func (m *MessageServiceServer) GetNumUnreadMessages(ctx context.Context, req *pb
 
 	return &pb.GetNumUnreadMessagesResponse{NumMessages: int32(numUnreadMessages)}, nil
 }
+
+func (m *MessageServiceServer) GetChatEventsSince(ctx context.Context, req *pb.GetChatEventsSinceRequest) (*pb.GetChatEventsSinceResponse, error) {
+	logger.Info(ctx, "GetChatEventsSince request received")
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid chatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	events, err := m.MessageUseCase.GetChatEventsSince(ctx, chatId, userId, req.Seq, int(req.Limit))
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat events: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetChatEventsSinceResponse{Events: dto.MapChatEventsToProto(events)}, nil
+}
+
+func (m *MessageServiceServer) ScheduleMessage(ctx context.Context, req *pb.ScheduleMessageRequest) (*pb.ScheduleMessageResponse, error) {
+	logger.Info(ctx, "ScheduleMessage request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	scheduled, err := dto.MapProtoToScheduledMessage(req.ScheduledMessage)
+	if err != nil {
+		logger.Error(ctx, "Failed to map proto to scheduled message: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scheduled.Message.SenderID = userId
+
+	saved, err := m.ScheduledMessageUseCase.ScheduleMessage(ctx, *scheduled)
+	if err != nil {
+		logger.Error(ctx, "Failed to schedule message: %v", err)
+		return nil, err
+	}
+
+	return &pb.ScheduleMessageResponse{ScheduledMessage: dto.MapScheduledMessageToProto(saved)}, nil
+}
+
+func (m *MessageServiceServer) GetScheduledMessages(ctx context.Context, req *pb.GetScheduledMessagesRequest) (*pb.GetScheduledMessagesResponse, error) {
+	logger.Info(ctx, "GetScheduledMessages request received")
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		logger.Error(ctx, "Invalid chatId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	scheduledMessages, err := m.ScheduledMessageUseCase.GetScheduledMessages(ctx, chatId, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get scheduled messages: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetScheduledMessagesResponse{ScheduledMessages: dto.MapScheduledMessagesToProto(scheduledMessages)}, nil
+}
+
+func (m *MessageServiceServer) UpdateScheduledMessage(ctx context.Context, req *pb.UpdateScheduledMessageRequest) (*pb.UpdateScheduledMessageResponse, error) {
+	logger.Info(ctx, "UpdateScheduledMessage request received")
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	update, err := dto.MapProtoToScheduledMessage(req.ScheduledMessage)
+	if err != nil {
+		logger.Error(ctx, "Failed to map proto to scheduled message: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := m.ScheduledMessageUseCase.UpdateScheduledMessage(ctx, *update, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to update scheduled message: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateScheduledMessageResponse{ScheduledMessage: dto.MapScheduledMessageToProto(updated)}, nil
+}
+
+func (m *MessageServiceServer) CancelScheduledMessage(ctx context.Context, req *pb.CancelScheduledMessageRequest) (*pb.CancelScheduledMessageResponse, error) {
+	logger.Info(ctx, "CancelScheduledMessage request received")
+	id, err := uuid.Parse(req.ScheduledMessageId)
+	if err != nil {
+		logger.Error(ctx, "Invalid scheduledMessageId: %v", err)
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserAuthId)
+	if err != nil {
+		logger.Error(ctx, "Invalid userAuthId: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if err = m.ScheduledMessageUseCase.CancelScheduledMessage(ctx, id, userId); err != nil {
+		logger.Error(ctx, "Failed to cancel scheduled message: %v", err)
+		return nil, err
+	}
+
+	return &pb.CancelScheduledMessageResponse{Success: true}, nil
+}
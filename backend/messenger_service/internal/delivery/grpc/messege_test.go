@@ -24,7 +24,7 @@ func TestMessageServiceServer(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUseCase := mocks.NewMockMessageUseCase(ctrl)
-	server := NewMessageServiceServer(mockUseCase)
+	server := NewMessageServiceServer(mockUseCase, nil)
 
 	ctx := context.Background()
 	now := time.Now()
@@ -66,6 +66,41 @@ func TestMessageServiceServer(t *testing.T) {
 				Messages: testProtoMessages,
 			},
 		},
+		{
+			name: "GetMessagesForChat - Newer",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					GetMessagesForChatNewer(ctx, testMessage.ChatID, testMessage.SenderID, 10, gomock.Any()).
+					Return(testMessages, nil)
+			},
+			req: &pb.GetMessagesForChatRequest{
+				ChatId:      testMessage.ChatID.String(),
+				MessagesNum: 10,
+				After:       timestamppb.New(now.Add(-time.Hour)),
+				UserAuthId:  testMessage.SenderID.String(),
+			},
+			wantResp: &pb.GetMessagesForChatResponse{
+				Messages: testProtoMessages,
+			},
+		},
+		{
+			name: "GetMessagesForChat - Around Anchor",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					GetMessagesAround(ctx, testMessage.ChatID, testMessage.ID, testMessage.SenderID, 10).
+					Return(models.MessagesPage{Messages: testMessages, OlderCursor: &now}, nil)
+			},
+			req: &pb.GetMessagesForChatRequest{
+				ChatId:      testMessage.ChatID.String(),
+				MessagesNum: 10,
+				AnchorId:    testMessage.ID.String(),
+				UserAuthId:  testMessage.SenderID.String(),
+			},
+			wantResp: &pb.GetMessagesForChatResponse{
+				Messages:    testProtoMessages,
+				OlderCursor: timestamppb.New(now),
+			},
+		},
 		{
 			name: "GetMessagesForChat - Invalid ChatID",
 			req: &pb.GetMessagesForChatRequest{
@@ -188,19 +223,195 @@ func TestMessageServiceServer(t *testing.T) {
 			wantErr: true,
 		},
 
+		// ForwardMessages tests
+		{
+			name: "ForwardMessages - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					ForwardMessages(ctx, []uuid.UUID{testMessage.ID}, testMessage.ChatID, uuid.Nil, testMessage.SenderID).
+					Return(testMessages, nil)
+			},
+			req: &pb.ForwardMessagesRequest{
+				MessageIds: []string{testMessage.ID.String()},
+				ChatId:     testMessage.ChatID.String(),
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.ForwardMessagesResponse{
+				Messages: testProtoMessages,
+			},
+		},
+		{
+			name: "ForwardMessages - Invalid MessageID",
+			req: &pb.ForwardMessagesRequest{
+				MessageIds: []string{"invalid"},
+				ChatId:     testMessage.ChatID.String(),
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ForwardMessages - No Target",
+			req: &pb.ForwardMessagesRequest{
+				MessageIds: []string{testMessage.ID.String()},
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantErr: true,
+		},
+		// AddReaction tests
+		{
+			name: "AddReaction - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					AddReaction(ctx, testMessage.ID, testMessage.SenderID, "👍").
+					Return(testMessage.ChatID, int64(4), nil)
+			},
+			req: &pb.AddReactionRequest{
+				MessageId:  testMessage.ID.String(),
+				Emoji:      "👍",
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.AddReactionResponse{
+				ChatId: testMessage.ChatID.String(),
+				Seq:    4,
+			},
+		},
+		{
+			name: "AddReaction - Invalid MessageID",
+			req: &pb.AddReactionRequest{
+				MessageId:  "invalid",
+				Emoji:      "👍",
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantErr: true,
+		},
+		// RemoveReaction tests
+		{
+			name: "RemoveReaction - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					RemoveReaction(ctx, testMessage.ID, testMessage.SenderID, "👍").
+					Return(testMessage.ChatID, int64(5), nil)
+			},
+			req: &pb.RemoveReactionRequest{
+				MessageId:  testMessage.ID.String(),
+				Emoji:      "👍",
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.RemoveReactionResponse{
+				ChatId: testMessage.ChatID.String(),
+				Seq:    5,
+			},
+		},
+		// PinMessage tests
+		{
+			name: "PinMessage - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					PinMessage(ctx, testMessage.ID, testMessage.SenderID).
+					Return(testMessage.ChatID, int64(6), nil)
+			},
+			req: &pb.PinMessageRequest{
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.PinMessageResponse{
+				ChatId: testMessage.ChatID.String(),
+				Seq:    6,
+			},
+		},
+		{
+			name: "PinMessage - Invalid UserAuthID",
+			req: &pb.PinMessageRequest{
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: "invalid",
+			},
+			wantErr:     true,
+			expectedErr: status.Error(codes.Unauthenticated, "user not found in context"),
+		},
+		// UnpinMessage tests
+		{
+			name: "UnpinMessage - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					UnpinMessage(ctx, testMessage.ID, testMessage.SenderID).
+					Return(testMessage.ChatID, int64(7), nil)
+			},
+			req: &pb.UnpinMessageRequest{
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.UnpinMessageResponse{
+				ChatId: testMessage.ChatID.String(),
+				Seq:    7,
+			},
+		},
+		// VotePoll tests
+		{
+			name: "VotePoll - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					VotePoll(ctx, testMessage.ID, []int{0, 2}, testMessage.SenderID).
+					Return(&testMessage, nil)
+			},
+			req: &pb.VotePollRequest{
+				MessageId:  testMessage.ID.String(),
+				Options:    []int32{0, 2},
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.VotePollResponse{Message: testProtoMessage},
+		},
+		{
+			name: "VotePoll - Invalid MessageID",
+			req: &pb.VotePollRequest{
+				MessageId:  "invalid",
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantErr:     true,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid message id"),
+		},
+		// SearchMessages tests
+		{
+			name: "SearchMessages - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					SearchMessages(ctx, testMessage.SenderID, models.MessageSearchQuery{Text: "test", ChatID: testMessage.ChatID, Limit: 20}).
+					Return([]models.MessageSearchResult{{Message: testMessage, Snippet: "test"}}, nil)
+			},
+			req: &pb.SearchMessagesRequest{
+				UserAuthId: testMessage.SenderID.String(),
+				Query:      "test",
+				ChatId:     testMessage.ChatID.String(),
+				Limit:      20,
+			},
+			wantResp: &pb.SearchMessagesResponse{
+				Results: []*pb.MessageSearchResult{{Message: testProtoMessage, Snippet: "test"}},
+			},
+		},
+		{
+			name: "SearchMessages - Invalid UserAuthID",
+			req: &pb.SearchMessagesRequest{
+				UserAuthId: "invalid",
+				Query:      "test",
+				Limit:      20,
+			},
+			wantErr:     true,
+			expectedErr: status.Error(codes.Unauthenticated, "user not found in context"),
+		},
 		// DeleteMessage tests
 		{
 			name: "DeleteMessage - Success",
 			mockSetup: func() {
 				mockUseCase.EXPECT().
-					DeleteMessage(ctx, testMessage.ID).
-					Return(nil)
+					DeleteMessage(ctx, testMessage.ID, testMessage.SenderID).
+					Return(int64(2), nil)
 			},
 			req: &pb.DeleteMessageRequest{
-				MessageId: testMessage.ID.String(),
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: testMessage.SenderID.String(),
 			},
 			wantResp: &pb.DeleteMessageResponse{
 				Success: true,
+				Seq:     2,
 			},
 		},
 		{
@@ -214,11 +425,12 @@ func TestMessageServiceServer(t *testing.T) {
 			name: "DeleteMessage - UseCase Error",
 			mockSetup: func() {
 				mockUseCase.EXPECT().
-					DeleteMessage(ctx, testMessage.ID).
-					Return(errors.New("usecase error"))
+					DeleteMessage(ctx, testMessage.ID, testMessage.SenderID).
+					Return(int64(0), errors.New("usecase error"))
 			},
 			req: &pb.DeleteMessageRequest{
-				MessageId: testMessage.ID.String(),
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: testMessage.SenderID.String(),
 			},
 			wantErr: true,
 		},
@@ -228,17 +440,19 @@ func TestMessageServiceServer(t *testing.T) {
 			name: "UpdateLastReadTs - Success",
 			mockSetup: func() {
 				mockUseCase.EXPECT().
-					UpdateLastReadTs(ctx, gomock.Any(), testMessage.ChatID, testMessage.SenderID).
-					Return(nil)
+					UpdateLastReadTs(ctx, gomock.Any(), testMessage.ChatID, testMessage.SenderID, testMessage.ID).
+					Return(int64(3), nil)
 			},
 			req: &pb.UpdateLastReadTsRequest{
 				ChatId:            testMessage.ChatID.String(),
 				UserAuthId:        testMessage.SenderID.String(),
 				UserId:            testMessage.SenderID.String(),
 				LastReadTimestamp: timestamppb.New(now),
+				MessageId:         testMessage.ID.String(),
 			},
 			wantResp: &pb.UpdateLastReadTsResponse{
 				Success: true,
+				Seq:     3,
 			},
 		},
 		{
@@ -266,8 +480,8 @@ func TestMessageServiceServer(t *testing.T) {
 			name: "UpdateLastReadTs - UseCase Error",
 			mockSetup: func() {
 				mockUseCase.EXPECT().
-					UpdateLastReadTs(ctx, gomock.Any(), testMessage.ChatID, testMessage.SenderID).
-					Return(errors.New("usecase error"))
+					UpdateLastReadTs(ctx, gomock.Any(), testMessage.ChatID, testMessage.SenderID, uuid.Nil).
+					Return(int64(0), errors.New("usecase error"))
 			},
 			req: &pb.UpdateLastReadTsRequest{
 				ChatId:            testMessage.ChatID.String(),
@@ -278,6 +492,56 @@ func TestMessageServiceServer(t *testing.T) {
 			wantErr: true,
 		},
 
+		// UpdateLastDeliveredTs tests
+		{
+			name: "UpdateLastDeliveredTs - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					UpdateLastDeliveredTs(ctx, gomock.Any(), testMessage.ChatID, testMessage.SenderID).
+					Return(true, nil)
+			},
+			req: &pb.UpdateLastDeliveredTsRequest{
+				ChatId:                 testMessage.ChatID.String(),
+				UserAuthId:             testMessage.SenderID.String(),
+				LastDeliveredTimestamp: timestamppb.New(now),
+			},
+			wantResp: &pb.UpdateLastDeliveredTsResponse{Success: true, Updated: true},
+		},
+		{
+			name: "UpdateLastDeliveredTs - Invalid ChatID",
+			req: &pb.UpdateLastDeliveredTsRequest{
+				ChatId:                 "invalid",
+				UserAuthId:             testMessage.SenderID.String(),
+				LastDeliveredTimestamp: timestamppb.New(now),
+			},
+			wantErr: true,
+		},
+
+		// GetMessageReceipts tests
+		{
+			name: "GetMessageReceipts - Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					GetMessageReceipts(ctx, testMessage.ID, testMessage.SenderID).
+					Return([]models.MessageReceipt{{UserID: testMessage.SenderID, ReadAt: &now}}, nil)
+			},
+			req: &pb.GetMessageReceiptsRequest{
+				MessageId:  testMessage.ID.String(),
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantResp: &pb.GetMessageReceiptsResponse{
+				Receipts: []*pb.MessageReceipt{{UserId: testMessage.SenderID.String(), ReadAt: timestamppb.New(now)}},
+			},
+		},
+		{
+			name: "GetMessageReceipts - Invalid MessageID",
+			req: &pb.GetMessageReceiptsRequest{
+				MessageId:  "invalid",
+				UserAuthId: testMessage.SenderID.String(),
+			},
+			wantErr: true,
+		},
+
 		// GetLastReadTs tests
 		{
 			name: "GetLastReadTs - Success",
@@ -402,10 +666,28 @@ func TestMessageServiceServer(t *testing.T) {
 				resp, err = server.SendMessage(ctx, req)
 			case *pb.GetMessageByIdRequest:
 				resp, err = server.GetMessageById(ctx, req)
+			case *pb.ForwardMessagesRequest:
+				resp, err = server.ForwardMessages(ctx, req)
+			case *pb.AddReactionRequest:
+				resp, err = server.AddReaction(ctx, req)
+			case *pb.RemoveReactionRequest:
+				resp, err = server.RemoveReaction(ctx, req)
+			case *pb.PinMessageRequest:
+				resp, err = server.PinMessage(ctx, req)
+			case *pb.UnpinMessageRequest:
+				resp, err = server.UnpinMessage(ctx, req)
+			case *pb.VotePollRequest:
+				resp, err = server.VotePoll(ctx, req)
+			case *pb.SearchMessagesRequest:
+				resp, err = server.SearchMessages(ctx, req)
 			case *pb.DeleteMessageRequest:
 				resp, err = server.DeleteMessage(ctx, req)
 			case *pb.UpdateLastReadTsRequest:
 				resp, err = server.UpdateLastReadTs(ctx, req)
+			case *pb.UpdateLastDeliveredTsRequest:
+				resp, err = server.UpdateLastDeliveredTs(ctx, req)
+			case *pb.GetMessageReceiptsRequest:
+				resp, err = server.GetMessageReceipts(ctx, req)
 			case *pb.GetLastReadTsRequest:
 				resp, err = server.GetLastReadTs(ctx, req)
 			case *pb.GetNumUnreadMessagesRequest:
@@ -424,3 +706,65 @@ func TestMessageServiceServer(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageServiceServer_ScheduledMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockScheduledUseCase := mocks.NewMockScheduledMessageUseCase(ctrl)
+	server := NewMessageServiceServer(nil, mockScheduledUseCase)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	userID := uuid.New()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: userID, Text: "hello", CreatedAt: now, UpdatedAt: now},
+		SendAt:  now.Add(time.Hour),
+	}
+
+	t.Run("ScheduleMessage sets the sender", func(t *testing.T) {
+		request := scheduled
+		request.Message.SenderID = uuid.Nil
+		mockScheduledUseCase.EXPECT().ScheduleMessage(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, got models.ScheduledMessage) (models.ScheduledMessage, error) {
+				assert.Equal(t, userID, got.Message.SenderID)
+				assert.Equal(t, scheduled.SendAt, got.SendAt)
+				return scheduled, nil
+			})
+
+		resp, err := server.ScheduleMessage(ctx, &pb.ScheduleMessageRequest{
+			ScheduledMessage: dto.MapScheduledMessageToProto(request),
+			UserAuthId:       userID.String(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, scheduled.Message.ID.String(), resp.ScheduledMessage.Message.Id)
+	})
+
+	t.Run("ScheduleMessage without message", func(t *testing.T) {
+		_, err := server.ScheduleMessage(ctx, &pb.ScheduleMessageRequest{UserAuthId: userID.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("GetScheduledMessages", func(t *testing.T) {
+		mockScheduledUseCase.EXPECT().GetScheduledMessages(ctx, scheduled.Message.ChatID, userID).
+			Return([]models.ScheduledMessage{scheduled}, nil)
+
+		resp, err := server.GetScheduledMessages(ctx, &pb.GetScheduledMessagesRequest{
+			ChatId:     scheduled.Message.ChatID.String(),
+			UserAuthId: userID.String(),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.ScheduledMessages, 1)
+	})
+
+	t.Run("CancelScheduledMessage", func(t *testing.T) {
+		expectedErr := errors.New("not found")
+		mockScheduledUseCase.EXPECT().CancelScheduledMessage(ctx, scheduled.Message.ID, userID).Return(expectedErr)
+
+		_, err := server.CancelScheduledMessage(ctx, &pb.CancelScheduledMessageRequest{
+			ScheduledMessageId: scheduled.Message.ID.String(),
+			UserAuthId:         userID.String(),
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+}
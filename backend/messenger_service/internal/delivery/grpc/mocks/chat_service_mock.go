@@ -8,6 +8,7 @@ import (
 	context "context"
 	models "quickflow/shared/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,33 +37,93 @@ func (m *MockChatUseCase) EXPECT() *MockChatUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddChatMembers mocks base method.
+func (m *MockChatUseCase) AddChatMembers(ctx context.Context, chatId, userId uuid.UUID, memberIds []uuid.UUID) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMembers", ctx, chatId, userId, memberIds)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChatMembers indicates an expected call of AddChatMembers.
+func (mr *MockChatUseCaseMockRecorder) AddChatMembers(ctx, chatId, userId, memberIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMembers", reflect.TypeOf((*MockChatUseCase)(nil).AddChatMembers), ctx, chatId, userId, memberIds)
+}
+
+// ArchiveChat mocks base method.
+func (m *MockChatUseCase) ArchiveChat(ctx context.Context, chatId, userId uuid.UUID, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveChat", ctx, chatId, userId, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveChat indicates an expected call of ArchiveChat.
+func (mr *MockChatUseCaseMockRecorder) ArchiveChat(ctx, chatId, userId, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChat", reflect.TypeOf((*MockChatUseCase)(nil).ArchiveChat), ctx, chatId, userId, archived)
+}
+
+// ChangeChatRole mocks base method.
+func (m *MockChatUseCase) ChangeChatRole(ctx context.Context, chatId, userId, memberId uuid.UUID, role models.ChatRole) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeChatRole", ctx, chatId, userId, memberId, role)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeChatRole indicates an expected call of ChangeChatRole.
+func (mr *MockChatUseCaseMockRecorder) ChangeChatRole(ctx, chatId, userId, memberId, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeChatRole", reflect.TypeOf((*MockChatUseCase)(nil).ChangeChatRole), ctx, chatId, userId, memberId, role)
+}
+
 // CreateChat mocks base method.
-func (m *MockChatUseCase) CreateChat(ctx context.Context, chatInfo models.ChatCreationInfo) (models.Chat, error) {
+func (m *MockChatUseCase) CreateChat(ctx context.Context, userId uuid.UUID, chatInfo models.ChatCreationInfo) (models.Chat, *models.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateChat", ctx, chatInfo)
+	ret := m.ctrl.Call(m, "CreateChat", ctx, userId, chatInfo)
 	ret0, _ := ret[0].(models.Chat)
+	ret1, _ := ret[1].(*models.Message)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateChat indicates an expected call of CreateChat.
+func (mr *MockChatUseCaseMockRecorder) CreateChat(ctx, userId, chatInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatUseCase)(nil).CreateChat), ctx, userId, chatInfo)
+}
+
+// CreateChatInvite mocks base method.
+func (m *MockChatUseCase) CreateChatInvite(ctx context.Context, chatId, userId uuid.UUID, info models.ChatInviteCreationInfo) (models.ChatInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChatInvite", ctx, chatId, userId, info)
+	ret0, _ := ret[0].(models.ChatInvite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateChat indicates an expected call of CreateChat.
-func (mr *MockChatUseCaseMockRecorder) CreateChat(ctx, chatInfo interface{}) *gomock.Call {
+// CreateChatInvite indicates an expected call of CreateChatInvite.
+func (mr *MockChatUseCaseMockRecorder) CreateChatInvite(ctx, chatId, userId, info interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatUseCase)(nil).CreateChat), ctx, chatInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatInvite", reflect.TypeOf((*MockChatUseCase)(nil).CreateChatInvite), ctx, chatId, userId, info)
 }
 
 // DeleteChat mocks base method.
-func (m *MockChatUseCase) DeleteChat(ctx context.Context, chatId uuid.UUID) error {
+func (m *MockChatUseCase) DeleteChat(ctx context.Context, chatId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteChat", ctx, chatId)
+	ret := m.ctrl.Call(m, "DeleteChat", ctx, chatId, userId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteChat indicates an expected call of DeleteChat.
-func (mr *MockChatUseCaseMockRecorder) DeleteChat(ctx, chatId interface{}) *gomock.Call {
+func (mr *MockChatUseCaseMockRecorder) DeleteChat(ctx, chatId, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatUseCase)(nil).DeleteChat), ctx, chatId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatUseCase)(nil).DeleteChat), ctx, chatId, userId)
 }
 
 // GetChat mocks base method.
@@ -80,6 +141,51 @@ func (mr *MockChatUseCaseMockRecorder) GetChat(ctx, chatId interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatUseCase)(nil).GetChat), ctx, chatId)
 }
 
+// GetChatInvitePreview mocks base method.
+func (m *MockChatUseCase) GetChatInvitePreview(ctx context.Context, token string) (models.ChatPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvitePreview", ctx, token)
+	ret0, _ := ret[0].(models.ChatPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvitePreview indicates an expected call of GetChatInvitePreview.
+func (mr *MockChatUseCaseMockRecorder) GetChatInvitePreview(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvitePreview", reflect.TypeOf((*MockChatUseCase)(nil).GetChatInvitePreview), ctx, token)
+}
+
+// GetChatInvites mocks base method.
+func (m *MockChatUseCase) GetChatInvites(ctx context.Context, chatId, userId uuid.UUID) ([]models.ChatInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvites", ctx, chatId, userId)
+	ret0, _ := ret[0].([]models.ChatInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatUseCaseMockRecorder) GetChatInvites(ctx, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatUseCase)(nil).GetChatInvites), ctx, chatId, userId)
+}
+
+// GetChatMembers mocks base method.
+func (m *MockChatUseCase) GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatMembers", ctx, chatId)
+	ret0, _ := ret[0].([]models.ChatMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMembers indicates an expected call of GetChatMembers.
+func (mr *MockChatUseCaseMockRecorder) GetChatMembers(ctx, chatId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMembers", reflect.TypeOf((*MockChatUseCase)(nil).GetChatMembers), ctx, chatId)
+}
+
 // GetChatParticipants mocks base method.
 func (m *MockChatUseCase) GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -126,18 +232,18 @@ func (mr *MockChatUseCaseMockRecorder) GetPrivateChat(ctx, userId1, userId2 inte
 }
 
 // GetUserChats mocks base method.
-func (m *MockChatUseCase) GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error) {
+func (m *MockChatUseCase) GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserChats", ctx, userId)
+	ret := m.ctrl.Call(m, "GetUserChats", ctx, userId, archived)
 	ret0, _ := ret[0].([]models.Chat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserChats indicates an expected call of GetUserChats.
-func (mr *MockChatUseCaseMockRecorder) GetUserChats(ctx, userId interface{}) *gomock.Call {
+func (mr *MockChatUseCaseMockRecorder) GetUserChats(ctx, userId, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChatUseCase)(nil).GetUserChats), ctx, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChatUseCase)(nil).GetUserChats), ctx, userId, archived)
 }
 
 // JoinChat mocks base method.
@@ -154,6 +260,22 @@ func (mr *MockChatUseCaseMockRecorder) JoinChat(ctx, chatId, userId interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChat", reflect.TypeOf((*MockChatUseCase)(nil).JoinChat), ctx, chatId, userId)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatUseCase) JoinChatByInvite(ctx context.Context, token string, userId uuid.UUID) (models.Chat, models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChatByInvite", ctx, token, userId)
+	ret0, _ := ret[0].(models.Chat)
+	ret1, _ := ret[1].(models.Message)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatUseCaseMockRecorder) JoinChatByInvite(ctx, token, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatUseCase)(nil).JoinChatByInvite), ctx, token, userId)
+}
+
 // LeaveChat mocks base method.
 func (m *MockChatUseCase) LeaveChat(ctx context.Context, chatId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -167,3 +289,173 @@ func (mr *MockChatUseCaseMockRecorder) LeaveChat(ctx, chatId, userId interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChat", reflect.TypeOf((*MockChatUseCase)(nil).LeaveChat), ctx, chatId, userId)
 }
+
+// MuteChat mocks base method.
+func (m *MockChatUseCase) MuteChat(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteChat", ctx, chatId, userId, mutedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MuteChat indicates an expected call of MuteChat.
+func (mr *MockChatUseCaseMockRecorder) MuteChat(ctx, chatId, userId, mutedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteChat", reflect.TypeOf((*MockChatUseCase)(nil).MuteChat), ctx, chatId, userId, mutedUntil)
+}
+
+// PinChat mocks base method.
+func (m *MockChatUseCase) PinChat(ctx context.Context, chatId, userId uuid.UUID, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinChat", ctx, chatId, userId, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinChat indicates an expected call of PinChat.
+func (mr *MockChatUseCaseMockRecorder) PinChat(ctx, chatId, userId, pinned interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinChat", reflect.TypeOf((*MockChatUseCase)(nil).PinChat), ctx, chatId, userId, pinned)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockChatUseCase) RemoveChatMember(ctx context.Context, chatId, userId, memberId uuid.UUID) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChatMember", ctx, chatId, userId, memberId)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatUseCaseMockRecorder) RemoveChatMember(ctx, chatId, userId, memberId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatUseCase)(nil).RemoveChatMember), ctx, chatId, userId, memberId)
+}
+
+// ReorderPinnedChats mocks base method.
+func (m *MockChatUseCase) ReorderPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderPinnedChats", ctx, userId, chatIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderPinnedChats indicates an expected call of ReorderPinnedChats.
+func (mr *MockChatUseCaseMockRecorder) ReorderPinnedChats(ctx, userId, chatIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderPinnedChats", reflect.TypeOf((*MockChatUseCase)(nil).ReorderPinnedChats), ctx, userId, chatIds)
+}
+
+// RevokeChatInvite mocks base method.
+func (m *MockChatUseCase) RevokeChatInvite(ctx context.Context, token string, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeChatInvite", ctx, token, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeChatInvite indicates an expected call of RevokeChatInvite.
+func (mr *MockChatUseCaseMockRecorder) RevokeChatInvite(ctx, token, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeChatInvite", reflect.TypeOf((*MockChatUseCase)(nil).RevokeChatInvite), ctx, token, userId)
+}
+
+// SetChatEncryption mocks base method.
+func (m *MockChatUseCase) SetChatEncryption(ctx context.Context, chatId, userId uuid.UUID, enabled bool) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatEncryption", ctx, chatId, userId, enabled)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatEncryption indicates an expected call of SetChatEncryption.
+func (mr *MockChatUseCaseMockRecorder) SetChatEncryption(ctx, chatId, userId, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatEncryption", reflect.TypeOf((*MockChatUseCase)(nil).SetChatEncryption), ctx, chatId, userId, enabled)
+}
+
+// SetChatMessageTTL mocks base method.
+func (m *MockChatUseCase) SetChatMessageTTL(ctx context.Context, chatId, userId uuid.UUID, ttl time.Duration) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatMessageTTL", ctx, chatId, userId, ttl)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatMessageTTL indicates an expected call of SetChatMessageTTL.
+func (mr *MockChatUseCaseMockRecorder) SetChatMessageTTL(ctx, chatId, userId, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMessageTTL", reflect.TypeOf((*MockChatUseCase)(nil).SetChatMessageTTL), ctx, chatId, userId, ttl)
+}
+
+// UpdateChat mocks base method.
+func (m *MockChatUseCase) UpdateChat(ctx context.Context, chatId, userId uuid.UUID, info models.ChatUpdateInfo) (models.Chat, []models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChat", ctx, chatId, userId, info)
+	ret0, _ := ret[0].(models.Chat)
+	ret1, _ := ret[1].([]models.Message)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateChat indicates an expected call of UpdateChat.
+func (mr *MockChatUseCaseMockRecorder) UpdateChat(ctx, chatId, userId, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatUseCase)(nil).UpdateChat), ctx, chatId, userId, info)
+}
+
+// MockChatExportUseCase is a mock of ChatExportUseCase interface.
+type MockChatExportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatExportUseCaseMockRecorder
+}
+
+// MockChatExportUseCaseMockRecorder is the mock recorder for MockChatExportUseCase.
+type MockChatExportUseCaseMockRecorder struct {
+	mock *MockChatExportUseCase
+}
+
+// NewMockChatExportUseCase creates a new mock instance.
+func NewMockChatExportUseCase(ctrl *gomock.Controller) *MockChatExportUseCase {
+	mock := &MockChatExportUseCase{ctrl: ctrl}
+	mock.recorder = &MockChatExportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatExportUseCase) EXPECT() *MockChatExportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetChatExport mocks base method.
+func (m *MockChatExportUseCase) GetChatExport(ctx context.Context, exportId, userId uuid.UUID) (models.ChatExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatExport", ctx, exportId, userId)
+	ret0, _ := ret[0].(models.ChatExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatExport indicates an expected call of GetChatExport.
+func (mr *MockChatExportUseCaseMockRecorder) GetChatExport(ctx, exportId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatExport", reflect.TypeOf((*MockChatExportUseCase)(nil).GetChatExport), ctx, exportId, userId)
+}
+
+// RequestChatExport mocks base method.
+func (m *MockChatExportUseCase) RequestChatExport(ctx context.Context, chatId, userId uuid.UUID) (models.ChatExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestChatExport", ctx, chatId, userId)
+	ret0, _ := ret[0].(models.ChatExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestChatExport indicates an expected call of RequestChatExport.
+func (mr *MockChatExportUseCaseMockRecorder) RequestChatExport(ctx, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestChatExport", reflect.TypeOf((*MockChatExportUseCase)(nil).RequestChatExport), ctx, chatId, userId)
+}
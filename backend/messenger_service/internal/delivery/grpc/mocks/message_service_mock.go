@@ -37,18 +37,65 @@ func (m *MockMessageUseCase) EXPECT() *MockMessageUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockMessageUseCase) AddReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, messageId, userId, emoji)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageUseCaseMockRecorder) AddReaction(ctx, messageId, userId, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageUseCase)(nil).AddReaction), ctx, messageId, userId, emoji)
+}
+
 // DeleteMessage mocks base method.
-func (m *MockMessageUseCase) DeleteMessage(ctx context.Context, messageId uuid.UUID) error {
+func (m *MockMessageUseCase) DeleteMessage(ctx context.Context, messageId, userId uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteMessage indicates an expected call of DeleteMessage.
-func (mr *MockMessageUseCaseMockRecorder) DeleteMessage(ctx, messageId interface{}) *gomock.Call {
+func (mr *MockMessageUseCaseMockRecorder) DeleteMessage(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageUseCase)(nil).DeleteMessage), ctx, messageId, userId)
+}
+
+// ForwardMessages mocks base method.
+func (m *MockMessageUseCase) ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardMessages", ctx, messageIds, chatId, receiverId, userId)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForwardMessages indicates an expected call of ForwardMessages.
+func (mr *MockMessageUseCaseMockRecorder) ForwardMessages(ctx, messageIds, chatId, receiverId, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageUseCase)(nil).DeleteMessage), ctx, messageId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardMessages", reflect.TypeOf((*MockMessageUseCase)(nil).ForwardMessages), ctx, messageIds, chatId, receiverId, userId)
+}
+
+// GetChatEventsSince mocks base method.
+func (m *MockMessageUseCase) GetChatEventsSince(ctx context.Context, chatId, userId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatEventsSince", ctx, chatId, userId, seq, limit)
+	ret0, _ := ret[0].([]models.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEventsSince indicates an expected call of GetChatEventsSince.
+func (mr *MockMessageUseCaseMockRecorder) GetChatEventsSince(ctx, chatId, userId, seq, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEventsSince", reflect.TypeOf((*MockMessageUseCase)(nil).GetChatEventsSince), ctx, chatId, userId, seq, limit)
 }
 
 // GetLastReadTs mocks base method.
@@ -81,6 +128,51 @@ func (mr *MockMessageUseCaseMockRecorder) GetMessageById(ctx, messageId interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockMessageUseCase)(nil).GetMessageById), ctx, messageId)
 }
 
+// GetMessageReceipts mocks base method.
+func (m *MockMessageUseCase) GetMessageReceipts(ctx context.Context, messageId, userId uuid.UUID) ([]models.MessageReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReceipts", ctx, messageId, userId)
+	ret0, _ := ret[0].([]models.MessageReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReceipts indicates an expected call of GetMessageReceipts.
+func (mr *MockMessageUseCaseMockRecorder) GetMessageReceipts(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReceipts", reflect.TypeOf((*MockMessageUseCase)(nil).GetMessageReceipts), ctx, messageId, userId)
+}
+
+// GetMessagesAround mocks base method.
+func (m *MockMessageUseCase) GetMessagesAround(ctx context.Context, chatId, anchorId, userId uuid.UUID, numMessages int) (models.MessagesPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesAround", ctx, chatId, anchorId, userId, numMessages)
+	ret0, _ := ret[0].(models.MessagesPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesAround indicates an expected call of GetMessagesAround.
+func (mr *MockMessageUseCaseMockRecorder) GetMessagesAround(ctx, chatId, anchorId, userId, numMessages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesAround", reflect.TypeOf((*MockMessageUseCase)(nil).GetMessagesAround), ctx, chatId, anchorId, userId, numMessages)
+}
+
+// GetMessagesForChatNewer mocks base method.
+func (m *MockMessageUseCase) GetMessagesForChatNewer(ctx context.Context, chatId, userId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesForChatNewer", ctx, chatId, userId, numMessages, timestamp)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesForChatNewer indicates an expected call of GetMessagesForChatNewer.
+func (mr *MockMessageUseCaseMockRecorder) GetMessagesForChatNewer(ctx, chatId, userId, numMessages, timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChatNewer", reflect.TypeOf((*MockMessageUseCase)(nil).GetMessagesForChatNewer), ctx, chatId, userId, numMessages, timestamp)
+}
+
 // GetMessagesForChatOlder mocks base method.
 func (m *MockMessageUseCase) GetMessagesForChatOlder(ctx context.Context, chatId, userId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +203,38 @@ func (mr *MockMessageUseCaseMockRecorder) GetNumUnreadMessages(ctx, chatId, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadMessages", reflect.TypeOf((*MockMessageUseCase)(nil).GetNumUnreadMessages), ctx, chatId, userId)
 }
 
+// PinMessage mocks base method.
+func (m *MockMessageUseCase) PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageUseCaseMockRecorder) PinMessage(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageUseCase)(nil).PinMessage), ctx, messageId, userId)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageUseCase) RemoveReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, messageId, userId, emoji)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageUseCaseMockRecorder) RemoveReaction(ctx, messageId, userId, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageUseCase)(nil).RemoveReaction), ctx, messageId, userId, emoji)
+}
+
 // SaveMessage mocks base method.
 func (m *MockMessageUseCase) SaveMessage(ctx context.Context, message models.Message) (*models.Message, error) {
 	m.ctrl.T.Helper()
@@ -126,16 +250,176 @@ func (mr *MockMessageUseCaseMockRecorder) SaveMessage(ctx, message interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockMessageUseCase)(nil).SaveMessage), ctx, message)
 }
 
+// SearchMessages mocks base method.
+func (m *MockMessageUseCase) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userId, query)
+	ret0, _ := ret[0].([]models.MessageSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageUseCaseMockRecorder) SearchMessages(ctx, userId, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageUseCase)(nil).SearchMessages), ctx, userId, query)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockMessageUseCase) UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", ctx, messageId, userId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageUseCaseMockRecorder) UnpinMessage(ctx, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageUseCase)(nil).UnpinMessage), ctx, messageId, userId)
+}
+
+// UpdateLastDeliveredTs mocks base method.
+func (m *MockMessageUseCase) UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastDeliveredTs", ctx, timestamp, chatId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastDeliveredTs indicates an expected call of UpdateLastDeliveredTs.
+func (mr *MockMessageUseCaseMockRecorder) UpdateLastDeliveredTs(ctx, timestamp, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastDeliveredTs", reflect.TypeOf((*MockMessageUseCase)(nil).UpdateLastDeliveredTs), ctx, timestamp, chatId, userId)
+}
+
 // UpdateLastReadTs mocks base method.
-func (m *MockMessageUseCase) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) error {
+func (m *MockMessageUseCase) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, timestamp, chatId, userId, messageId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastReadTs indicates an expected call of UpdateLastReadTs.
+func (mr *MockMessageUseCaseMockRecorder) UpdateLastReadTs(ctx, timestamp, chatId, userId, messageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageUseCase)(nil).UpdateLastReadTs), ctx, timestamp, chatId, userId, messageId)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockMessageUseCase) UpdateMessage(ctx context.Context, message models.Message, userId uuid.UUID) (*models.Message, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ctx, message, userId)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageUseCaseMockRecorder) UpdateMessage(ctx, message, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageUseCase)(nil).UpdateMessage), ctx, message, userId)
+}
+
+// VotePoll mocks base method.
+func (m *MockMessageUseCase) VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", ctx, messageId, options, userId)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockMessageUseCaseMockRecorder) VotePoll(ctx, messageId, options, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockMessageUseCase)(nil).VotePoll), ctx, messageId, options, userId)
+}
+
+// MockScheduledMessageUseCase is a mock of ScheduledMessageUseCase interface.
+type MockScheduledMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledMessageUseCaseMockRecorder
+}
+
+// MockScheduledMessageUseCaseMockRecorder is the mock recorder for MockScheduledMessageUseCase.
+type MockScheduledMessageUseCaseMockRecorder struct {
+	mock *MockScheduledMessageUseCase
+}
+
+// NewMockScheduledMessageUseCase creates a new mock instance.
+func NewMockScheduledMessageUseCase(ctrl *gomock.Controller) *MockScheduledMessageUseCase {
+	mock := &MockScheduledMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockScheduledMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledMessageUseCase) EXPECT() *MockScheduledMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledMessage mocks base method.
+func (m *MockScheduledMessageUseCase) CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, timestamp, chatId, userId)
+	ret := m.ctrl.Call(m, "CancelScheduledMessage", ctx, id, userId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateLastReadTs indicates an expected call of UpdateLastReadTs.
-func (mr *MockMessageUseCaseMockRecorder) UpdateLastReadTs(ctx, timestamp, chatId, userId interface{}) *gomock.Call {
+// CancelScheduledMessage indicates an expected call of CancelScheduledMessage.
+func (mr *MockScheduledMessageUseCaseMockRecorder) CancelScheduledMessage(ctx, id, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledMessage", reflect.TypeOf((*MockScheduledMessageUseCase)(nil).CancelScheduledMessage), ctx, id, userId)
+}
+
+// GetScheduledMessages mocks base method.
+func (m *MockScheduledMessageUseCase) GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMessages", ctx, chatId, userId)
+	ret0, _ := ret[0].([]models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessages indicates an expected call of GetScheduledMessages.
+func (mr *MockScheduledMessageUseCaseMockRecorder) GetScheduledMessages(ctx, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessages", reflect.TypeOf((*MockScheduledMessageUseCase)(nil).GetScheduledMessages), ctx, chatId, userId)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockScheduledMessageUseCase) ScheduleMessage(ctx context.Context, scheduled models.ScheduledMessage) (models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMessage", ctx, scheduled)
+	ret0, _ := ret[0].(models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockScheduledMessageUseCaseMockRecorder) ScheduleMessage(ctx, scheduled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockScheduledMessageUseCase)(nil).ScheduleMessage), ctx, scheduled)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockScheduledMessageUseCase) UpdateScheduledMessage(ctx context.Context, update models.ScheduledMessage, userId uuid.UUID) (models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", ctx, update, userId)
+	ret0, _ := ret[0].(models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockScheduledMessageUseCaseMockRecorder) UpdateScheduledMessage(ctx, update, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageUseCase)(nil).UpdateLastReadTs), ctx, timestamp, chatId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockScheduledMessageUseCase)(nil).UpdateScheduledMessage), ctx, update, userId)
 }
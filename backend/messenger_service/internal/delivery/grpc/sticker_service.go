@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"quickflow/shared/client/file_service"
 	dto "quickflow/shared/client/messenger_service"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -18,6 +19,14 @@ type StickerServiceUseCase interface {
 	GetStickerPackByName(ctx context.Context, packName string) (models.StickerPack, error)
 	GetStickerPacks(ctx context.Context, userId uuid.UUID, count, offset int) ([]models.StickerPack, error)
 	DeleteStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]models.StickerPack, error)
+	ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error
+	GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
+	AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error
+	RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error
+	GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
 }
 
 type StickerServiceServer struct {
@@ -138,3 +147,184 @@ func (s *StickerServiceServer) GetStickerPackByName(ctx context.Context, req *pb
 		StickerPack: dto.MapStickerPackToProto(&stickerPack),
 	}, nil
 }
+
+func (s *StickerServiceServer) InstallStickerPack(ctx context.Context, req *pb.InstallStickerPackRequest) (*pb.InstallStickerPackResponse, error) {
+	logger.Info(ctx, "Received InstallStickerPack request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	packId, err := uuid.Parse(req.PackId)
+	if err != nil {
+		logger.Error(ctx, "Invalid sticker pack ID: %v", err)
+		return nil, fmt.Errorf("invalid sticker pack ID: %w", err)
+	}
+
+	if err = s.stickerUseCase.InstallStickerPack(ctx, userId, packId); err != nil {
+		logger.Error(ctx, "Failed to install sticker pack: %v", err)
+		return nil, fmt.Errorf("failed to install sticker pack: %w", err)
+	}
+
+	return &pb.InstallStickerPackResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StickerServiceServer) UninstallStickerPack(ctx context.Context, req *pb.UninstallStickerPackRequest) (*pb.UninstallStickerPackResponse, error) {
+	logger.Info(ctx, "Received UninstallStickerPack request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	packId, err := uuid.Parse(req.PackId)
+	if err != nil {
+		logger.Error(ctx, "Invalid sticker pack ID: %v", err)
+		return nil, fmt.Errorf("invalid sticker pack ID: %w", err)
+	}
+
+	if err = s.stickerUseCase.UninstallStickerPack(ctx, userId, packId); err != nil {
+		logger.Error(ctx, "Failed to uninstall sticker pack: %v", err)
+		return nil, fmt.Errorf("failed to uninstall sticker pack: %w", err)
+	}
+
+	return &pb.UninstallStickerPackResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StickerServiceServer) GetUserStickerPacks(ctx context.Context, req *pb.GetUserStickerPacksRequest) (*pb.GetUserStickerPacksResponse, error) {
+	logger.Info(ctx, "Received GetUserStickerPacks request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	stickerPacks, err := s.stickerUseCase.GetUserStickerPacks(ctx, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get user sticker packs: %v", err)
+		return nil, fmt.Errorf("failed to get user sticker packs: %w", err)
+	}
+
+	var protoStickerPacks []*pb.StickerPack
+	for _, pack := range stickerPacks {
+		protoStickerPacks = append(protoStickerPacks, dto.MapStickerPackToProto(&pack))
+	}
+
+	return &pb.GetUserStickerPacksResponse{
+		StickerPacks: protoStickerPacks,
+	}, nil
+}
+
+func (s *StickerServiceServer) ReorderUserStickerPacks(ctx context.Context, req *pb.ReorderUserStickerPacksRequest) (*pb.ReorderUserStickerPacksResponse, error) {
+	logger.Info(ctx, "Received ReorderUserStickerPacks request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	packIds := make([]uuid.UUID, len(req.PackIds))
+	for i := range req.PackIds {
+		packIds[i], err = uuid.Parse(req.PackIds[i])
+		if err != nil {
+			logger.Error(ctx, "Invalid sticker pack ID: %v", err)
+			return nil, fmt.Errorf("invalid sticker pack ID: %w", err)
+		}
+	}
+
+	if err = s.stickerUseCase.ReorderUserStickerPacks(ctx, userId, packIds); err != nil {
+		logger.Error(ctx, "Failed to reorder user sticker packs: %v", err)
+		return nil, fmt.Errorf("failed to reorder user sticker packs: %w", err)
+	}
+
+	return &pb.ReorderUserStickerPacksResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StickerServiceServer) GetRecentStickers(ctx context.Context, req *pb.GetRecentStickersRequest) (*pb.GetRecentStickersResponse, error) {
+	logger.Info(ctx, "Received GetRecentStickers request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	stickers, err := s.stickerUseCase.GetRecentStickers(ctx, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get recent stickers: %v", err)
+		return nil, fmt.Errorf("failed to get recent stickers: %w", err)
+	}
+
+	return &pb.GetRecentStickersResponse{
+		Stickers: file_service.ModelFilesToProto(stickers),
+	}, nil
+}
+
+func (s *StickerServiceServer) AddFavouriteSticker(ctx context.Context, req *pb.AddFavouriteStickerRequest) (*pb.AddFavouriteStickerResponse, error) {
+	logger.Info(ctx, "Received AddFavouriteSticker request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	if err = s.stickerUseCase.AddFavouriteSticker(ctx, userId, req.StickerUrl); err != nil {
+		logger.Error(ctx, "Failed to add favourite sticker: %v", err)
+		return nil, fmt.Errorf("failed to add favourite sticker: %w", err)
+	}
+
+	return &pb.AddFavouriteStickerResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StickerServiceServer) RemoveFavouriteSticker(ctx context.Context, req *pb.RemoveFavouriteStickerRequest) (*pb.RemoveFavouriteStickerResponse, error) {
+	logger.Info(ctx, "Received RemoveFavouriteSticker request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	if err = s.stickerUseCase.RemoveFavouriteSticker(ctx, userId, req.StickerUrl); err != nil {
+		logger.Error(ctx, "Failed to remove favourite sticker: %v", err)
+		return nil, fmt.Errorf("failed to remove favourite sticker: %w", err)
+	}
+
+	return &pb.RemoveFavouriteStickerResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StickerServiceServer) GetFavouriteStickers(ctx context.Context, req *pb.GetFavouriteStickersRequest) (*pb.GetFavouriteStickersResponse, error) {
+	logger.Info(ctx, "Received GetFavouriteStickers request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid UserId: %v", err)
+		return nil, fmt.Errorf("invalid UserId: %w", err)
+	}
+
+	stickers, err := s.stickerUseCase.GetFavouriteStickers(ctx, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get favourite stickers: %v", err)
+		return nil, fmt.Errorf("failed to get favourite stickers: %w", err)
+	}
+
+	return &pb.GetFavouriteStickersResponse{
+		Stickers: file_service.ModelFilesToProto(stickers),
+	}, nil
+}
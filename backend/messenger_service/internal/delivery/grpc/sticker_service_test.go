@@ -440,3 +440,102 @@ func TestGetStickerPackByName(t *testing.T) {
 		})
 	}
 }
+
+func TestReorderUserStickerPacks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockStickerServiceUseCase(ctrl)
+	server := NewStickerServiceServer(mockUseCase)
+
+	ctx := context.Background()
+	userId := uuid.New()
+	packIds := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		req       *pb.ReorderUserStickerPacksRequest
+		wantResp  *pb.ReorderUserStickerPacksResponse
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					ReorderUserStickerPacks(ctx, userId, packIds).
+					Return(nil)
+			},
+			req: &pb.ReorderUserStickerPacksRequest{
+				UserId:  userId.String(),
+				PackIds: []string{packIds[0].String(), packIds[1].String()},
+			},
+			wantResp: &pb.ReorderUserStickerPacksResponse{
+				Success: true,
+			},
+		},
+		{
+			name: "Invalid Pack ID",
+			req: &pb.ReorderUserStickerPacksRequest{
+				UserId:  userId.String(),
+				PackIds: []string{"invalid-id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "UseCase Error",
+			mockSetup: func() {
+				mockUseCase.EXPECT().
+					ReorderUserStickerPacks(ctx, userId, packIds).
+					Return(errors.New("usecase error"))
+			},
+			req: &pb.ReorderUserStickerPacksRequest{
+				UserId:  userId.String(),
+				PackIds: []string{packIds[0].String(), packIds[1].String()},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mockSetup != nil {
+				tt.mockSetup()
+			}
+
+			resp, err := server.ReorderUserStickerPacks(ctx, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			}
+		})
+	}
+}
+
+func TestGetFavouriteStickers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockStickerServiceUseCase(ctrl)
+	server := NewStickerServiceServer(mockUseCase)
+
+	ctx := context.Background()
+	userId := uuid.New()
+	stickers := []*models.File{{URL: "cat.webp", DisplayType: models.DisplayTypeSticker}}
+
+	mockUseCase.EXPECT().
+		GetFavouriteStickers(ctx, userId).
+		Return(stickers, nil)
+
+	resp, err := server.GetFavouriteStickers(ctx, &pb.GetFavouriteStickersRequest{UserId: userId.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Stickers, 1)
+	assert.Equal(t, "cat.webp", resp.Stickers[0].Url)
+
+	_, err = server.GetFavouriteStickers(ctx, &pb.GetFavouriteStickersRequest{UserId: "invalid-id"})
+	assert.Error(t, err)
+}
@@ -10,6 +10,20 @@ var (
 	ErrInvalidNumMessages = fmt.Errorf("numMessages must be greater than 0")
 	ErrNotParticipant     = fmt.Errorf("user is not a participant in the chat")
 	ErrNotFound           = errors.New("not found")
+	ErrNotSender          = fmt.Errorf("user is not the sender of the message")
+	ErrInvalidReplyTo     = fmt.Errorf("replied message does not belong to the chat")
+	ErrInvalidForward     = fmt.Errorf("1 to 100 messages must be forwarded to a chat or to another user")
+	ErrAlreadyReacted     = fmt.Errorf("user already left this reaction")
+	ErrInvalidReaction    = fmt.Errorf("reaction must be a single emoji")
+	ErrAlreadyPinned      = fmt.Errorf("message is already pinned")
+	ErrInvalidSearchQuery = fmt.Errorf("search query must be 1 to 256 characters, with 1 to 100 results and a valid date range")
+	ErrInvalidSendAt      = fmt.Errorf("scheduled message must be sent in the future, no later than a year from now")
+	ErrChatEncrypted      = fmt.Errorf("end-to-end encrypted chat accepts only new encrypted messages")
+	ErrInvalidEncrypted   = fmt.Errorf("encrypted message must be sent to an encrypted chat with key envelopes for its participants")
+	ErrInvalidPoll        = fmt.Errorf("poll must have a question, 2 to 10 options and a close time in the future, it can not be scheduled")
+	ErrInvalidPollVote    = fmt.Errorf("vote must choose existing options, only one unless the poll is multiple choice")
+	ErrPollClosed         = fmt.Errorf("poll is closed")
+	ErrSystemMessage      = fmt.Errorf("system messages can not be edited or deleted")
 )
 
 // Error chats
@@ -17,8 +31,20 @@ var (
 	ErrInvalidChatCreationInfo = fmt.Errorf("invalid chat creation info")
 	ErrAlreadyInChat           = fmt.Errorf("user already in chat")
 	ErrInvalidChatType         = fmt.Errorf("invalid chat type")
+	ErrInvalidChatUpdateInfo   = fmt.Errorf("invalid chat update info")
+	ErrInvalidChatMembers      = fmt.Errorf("no members to add")
+	ErrNotChatAdmin            = fmt.Errorf("user has no rights to manage the chat")
+	ErrInvalidChatRole         = fmt.Errorf("invalid chat role")
+	ErrOwnerCannotLeave        = fmt.Errorf("owner has to pass the ownership before leaving the chat")
+	ErrInvalidChatInvite       = fmt.Errorf("invite expiry must be in the future and usage limit must not be negative")
+	ErrChatInviteExpired       = fmt.Errorf("invite link is expired or has reached its usage limit")
+	ErrTooManyPinnedChats      = fmt.Errorf("no more than 5 chats can be pinned")
+	ErrInvalidPinnedChats      = fmt.Errorf("new order must list every pinned chat exactly once")
+	ErrInvalidMessageTTL       = fmt.Errorf("invalid message ttl")
 )
 
 var (
-	ErrNotOwnerOfStickerPack = fmt.Errorf("user is not the owner of the sticker pack")
+	ErrNotOwnerOfStickerPack    = fmt.Errorf("user is not the owner of the sticker pack")
+	ErrInvalidStickerPackOrder  = fmt.Errorf("new order must list every installed sticker pack exactly once")
+	ErrTooManyFavouriteStickers = fmt.Errorf("no more than 50 stickers can be favourite")
 )
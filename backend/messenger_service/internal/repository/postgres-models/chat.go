@@ -1,6 +1,9 @@
 package postgres_models
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"quickflow/shared/models"
@@ -15,6 +18,11 @@ type ChatPostgres struct {
 	UpdatedAt       pgtype.Timestamptz
 	LastReadByOther pgtype.Timestamptz
 	LastReadByMe    pgtype.Timestamptz
+	MutedUntil      pgtype.Timestamptz
+	Archived        pgtype.Bool
+	PinOrder        pgtype.Int4
+	MessageTTL      pgtype.Int4
+	Encrypted       pgtype.Bool
 	Messages        []MessagePostgres
 }
 
@@ -35,6 +43,14 @@ func (c *ChatPostgres) ToChat() *models.Chat {
 		tm := c.LastReadByMe.Time
 		chat.LastReadByMe = &tm
 	}
+	if c.MutedUntil.Valid {
+		tm := c.MutedUntil.Time
+		chat.MutedUntil = &tm
+	}
+	chat.Archived = c.Archived.Bool
+	chat.PinOrder = int(c.PinOrder.Int32)
+	chat.MessageTTL = time.Duration(c.MessageTTL.Int32) * time.Second
+	chat.Encrypted = c.Encrypted.Bool
 	return chat
 }
 
@@ -63,6 +79,47 @@ func ModelToPostgres(chat *models.Chat) *ChatPostgres {
 	return chatPostgres
 }
 
+type ChatInvitePostgres struct {
+	Token      pgtype.Text
+	ChatID     pgtype.UUID
+	CreatorID  pgtype.UUID
+	CreatedAt  pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+	UsageLimit pgtype.Int4
+	UsageCount pgtype.Int4
+}
+
+func (i *ChatInvitePostgres) ToChatInvite() models.ChatInvite {
+	invite := models.ChatInvite{
+		Token:      i.Token.String,
+		ChatID:     i.ChatID.Bytes,
+		CreatorID:  i.CreatorID.Bytes,
+		CreatedAt:  i.CreatedAt.Time,
+		UsageLimit: int(i.UsageLimit.Int32),
+		UsageCount: int(i.UsageCount.Int32),
+	}
+	if i.ExpiresAt.Valid {
+		tm := i.ExpiresAt.Time
+		invite.ExpiresAt = &tm
+	}
+	return invite
+}
+
+func FromChatInvite(invite models.ChatInvite) ChatInvitePostgres {
+	res := ChatInvitePostgres{
+		Token:      pgtype.Text{String: invite.Token, Valid: true},
+		ChatID:     pgtype.UUID{Bytes: invite.ChatID, Valid: true},
+		CreatorID:  pgtype.UUID{Bytes: invite.CreatorID, Valid: invite.CreatorID != uuid.Nil},
+		CreatedAt:  pgtype.Timestamptz{Time: invite.CreatedAt, Valid: true},
+		UsageLimit: pgtype.Int4{Int32: int32(invite.UsageLimit), Valid: invite.UsageLimit > 0},
+		UsageCount: pgtype.Int4{Int32: int32(invite.UsageCount), Valid: true},
+	}
+	if invite.ExpiresAt != nil {
+		res.ExpiresAt = pgtype.Timestamptz{Time: *invite.ExpiresAt, Valid: true}
+	}
+	return res
+}
+
 func getStringIfValid(s pgtype.Text) string {
 	if s.Valid {
 		return s.String
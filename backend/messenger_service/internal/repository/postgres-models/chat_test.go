@@ -133,3 +133,42 @@ func TestModelToPostgres(t *testing.T) {
 		})
 	}
 }
+
+func TestChatInvitePostgres_RoundTrip(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	tests := []struct {
+		name  string
+		input models.ChatInvite
+	}{
+		{
+			name: "Invite with expiry and usage limit",
+			input: models.ChatInvite{
+				Token:      "token",
+				ChatID:     uuid.New(),
+				CreatorID:  uuid.New(),
+				CreatedAt:  time.Now(),
+				ExpiresAt:  &expiresAt,
+				UsageLimit: 10,
+				UsageCount: 3,
+			},
+		},
+		{
+			name: "Unlimited invite",
+			input: models.ChatInvite{
+				Token:     "token",
+				ChatID:    uuid.New(),
+				CreatorID: uuid.New(),
+				CreatedAt: time.Now(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pg := FromChatInvite(tt.input)
+			assert.Equal(t, tt.input.ExpiresAt != nil, pg.ExpiresAt.Valid)
+			assert.Equal(t, tt.input.UsageLimit > 0, pg.UsageLimit.Valid)
+			assert.Equal(t, tt.input, pg.ToChatInvite())
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package postgres_models
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"quickflow/shared/models"
@@ -10,6 +13,9 @@ type PostgresFile struct {
 	URL         pgtype.Text
 	DisplayType pgtype.Text
 	Name        pgtype.Text
+	// DurationMs and Waveform are set for voice messages only
+	DurationMs pgtype.Int4
+	Waveform   []byte
 }
 
 func (f *PostgresFile) ToFile() *models.File {
@@ -27,6 +33,14 @@ func (f *PostgresFile) ToFile() *models.File {
 	} else {
 		file.Name = ""
 	}
+
+	if f.DurationMs.Valid {
+		file.IsVoice = true
+		file.Voice = &models.VoiceMetadata{
+			Duration: time.Duration(f.DurationMs.Int32) * time.Millisecond,
+			Waveform: f.Waveform,
+		}
+	}
 	return &file
 }
 
@@ -38,19 +52,85 @@ type MessagePostgres struct {
 	Attachments []PostgresFile
 	SenderID    pgtype.UUID
 	ChatID      pgtype.UUID
+	Seq         pgtype.Int8
+	EditedAt    pgtype.Timestamptz
+	ReplyToID   pgtype.UUID
+
+	ForwardedFromSenderID pgtype.UUID
+	ForwardedFromChatID   pgtype.UUID
+
+	SystemAction pgtype.Text
+	SystemUserID pgtype.UUID
+
+	// Ciphertext is nil for plain messages
+	Ciphertext []byte
+}
+
+// MessagePreviewPostgres is a row of the quoted message, not valid ID means the message was deleted
+type MessagePreviewPostgres struct {
+	ID             pgtype.UUID
+	SenderID       pgtype.UUID
+	Text           pgtype.Text
+	AttachmentType pgtype.Text
+}
+
+func (p *MessagePreviewPostgres) ToMessagePreview() *models.MessagePreview {
+	return &models.MessagePreview{
+		ID:             p.ID.Bytes,
+		SenderID:       p.SenderID.Bytes,
+		Text:           p.Text.String,
+		AttachmentType: models.DisplayType(p.AttachmentType.String),
+	}
+}
+
+type PinnedMessagePostgres struct {
+	ChatID   pgtype.UUID
+	PinnedBy pgtype.UUID
+	PinnedAt pgtype.Timestamptz
+	Message  MessagePreviewPostgres
+}
+
+func (p *PinnedMessagePostgres) ToPinnedMessage() models.PinnedMessage {
+	return models.PinnedMessage{
+		Message:  *p.Message.ToMessagePreview(),
+		PinnedBy: p.PinnedBy.Bytes,
+		PinnedAt: p.PinnedAt.Time,
+	}
 }
 
 func (m *MessagePostgres) ToMessage() models.Message {
 	var attSlice []*models.File
 
 	for _, att := range m.Attachments {
-		if !att.DisplayType.Valid {
-			attSlice = append(attSlice, &models.File{URL: att.URL.String, DisplayType: models.DisplayTypeFile, Name: att.Name.String})
-		} else {
-			attSlice = append(attSlice, &models.File{URL: att.URL.String, DisplayType: models.DisplayType(att.DisplayType.String), Name: att.Name.String})
+		attSlice = append(attSlice, att.ToFile())
+	}
+
+	var editedAt *time.Time
+	if m.EditedAt.Valid {
+		editedAt = &m.EditedAt.Time
+	}
+
+	var forwardedFrom *models.ForwardedFrom
+	if m.ForwardedFromSenderID.Valid {
+		forwardedFrom = &models.ForwardedFrom{
+			SenderID: m.ForwardedFromSenderID.Bytes,
+			ChatID:   m.ForwardedFromChatID.Bytes,
+		}
+	}
+
+	var system *models.SystemInfo
+	if m.SystemAction.Valid {
+		system = &models.SystemInfo{
+			Action: models.SystemAction(m.SystemAction.String),
+			UserID: m.SystemUserID.Bytes,
 		}
 	}
 
+	var encrypted *models.EncryptedContent
+	if m.Ciphertext != nil {
+		encrypted = &models.EncryptedContent{Ciphertext: m.Ciphertext}
+	}
+
 	return models.Message{
 		ID:          m.ID.Bytes,
 		Text:        m.Text.String,
@@ -59,6 +139,13 @@ func (m *MessagePostgres) ToMessage() models.Message {
 		Attachments: attSlice,
 		SenderID:    m.SenderID.Bytes,
 		ChatID:      m.ChatID.Bytes,
+		Seq:         m.Seq.Int64,
+		EditedAt:    editedAt,
+		ReplyToID:   m.ReplyToID.Bytes,
+
+		ForwardedFrom: forwardedFrom,
+		System:        system,
+		Encrypted:     encrypted,
 	}
 }
 
@@ -73,6 +160,29 @@ func FromMessage(message models.Message) MessagePostgres {
 		attSlice = append(attSlice, pgfile)
 	}
 
+	var editedAt pgtype.Timestamptz
+	if message.EditedAt != nil {
+		editedAt = pgtype.Timestamptz{Time: *message.EditedAt, Valid: true}
+	}
+
+	var forwardedFromSenderID, forwardedFromChatID pgtype.UUID
+	if message.ForwardedFrom != nil {
+		forwardedFromSenderID = pgtype.UUID{Bytes: message.ForwardedFrom.SenderID, Valid: true}
+		forwardedFromChatID = pgtype.UUID{Bytes: message.ForwardedFrom.ChatID, Valid: true}
+	}
+
+	var systemAction pgtype.Text
+	var systemUserID pgtype.UUID
+	if message.System != nil {
+		systemAction = pgtype.Text{String: string(message.System.Action), Valid: true}
+		systemUserID = pgtype.UUID{Bytes: message.System.UserID, Valid: message.System.UserID != uuid.Nil}
+	}
+
+	var ciphertext []byte
+	if message.Encrypted != nil {
+		ciphertext = message.Encrypted.Ciphertext
+	}
+
 	return MessagePostgres{
 		ID:          pgtype.UUID{Bytes: message.ID, Valid: true},
 		Text:        pgtype.Text{String: message.Text, Valid: true},
@@ -81,5 +191,53 @@ func FromMessage(message models.Message) MessagePostgres {
 		Attachments: attSlice,
 		SenderID:    pgtype.UUID{Bytes: message.SenderID, Valid: true},
 		ChatID:      pgtype.UUID{Bytes: message.ChatID, Valid: true},
+		Seq:         pgtype.Int8{Int64: message.Seq, Valid: message.Seq != 0},
+		EditedAt:    editedAt,
+		ReplyToID:   pgtype.UUID{Bytes: message.ReplyToID, Valid: message.ReplyToID != uuid.Nil},
+
+		ForwardedFromSenderID: forwardedFromSenderID,
+		ForwardedFromChatID:   forwardedFromChatID,
+
+		SystemAction: systemAction,
+		SystemUserID: systemUserID,
+
+		Ciphertext: ciphertext,
+	}
+}
+
+type ChatEventPostgres struct {
+	Seq       pgtype.Int8
+	Type      pgtype.Text
+	ChatID    pgtype.UUID
+	MessageID pgtype.UUID
+	UserID    pgtype.UUID
+	CreatedAt pgtype.Timestamptz
+	Reaction  pgtype.Text
+}
+
+func (e *ChatEventPostgres) ToChatEvent() models.ChatEvent {
+	return models.ChatEvent{
+		Seq:       e.Seq.Int64,
+		Type:      models.ChatEventType(e.Type.String),
+		ChatID:    e.ChatID.Bytes,
+		MessageID: e.MessageID.Bytes,
+		UserID:    e.UserID.Bytes,
+		CreatedAt: e.CreatedAt.Time,
+		Reaction:  e.Reaction.String,
+	}
+}
+
+type ReactionCountPostgres struct {
+	MessageID pgtype.UUID
+	Emoji     pgtype.Text
+	Count     pgtype.Int8
+	Reacted   pgtype.Bool
+}
+
+func (r *ReactionCountPostgres) ToReactionCount() models.ReactionCount {
+	return models.ReactionCount{
+		Emoji:   r.Emoji.String,
+		Count:   int(r.Count.Int64),
+		Reacted: r.Reacted.Bool,
 	}
 }
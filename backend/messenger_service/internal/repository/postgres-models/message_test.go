@@ -144,3 +144,22 @@ func TestFromMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMessagePostgres_SystemRoundTrip(t *testing.T) {
+	memberId := uuid.New()
+	message := models.Message{
+		ID:       uuid.New(),
+		ChatID:   uuid.New(),
+		SenderID: uuid.New(),
+		System:   &models.SystemInfo{Action: models.SystemActionMemberAdded, UserID: memberId},
+	}
+
+	got := FromMessage(message)
+	assert.Equal(t, pgtype.Text{String: "member_added", Valid: true}, got.SystemAction)
+	assert.Equal(t, pgtype.UUID{Bytes: memberId, Valid: true}, got.SystemUserID)
+	assert.Equal(t, message.System, got.ToMessage().System)
+
+	message.System = nil
+	got = FromMessage(message)
+	assert.Nil(t, got.ToMessage().System)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -19,23 +20,34 @@ const (
         INSERT INTO chat (id, name, avatar_url, type, created_at, updated_at)
         VALUES ($1, $2, $3, $4, $5, $6)
         RETURNING id
+`
+	insertChatUserQuery = `
+        INSERT INTO chat_user (chat_id, user_id)
+        VALUES ($1, $2)
 `
 	getUserChatsQuery = `
-        SELECT c.id, c.name, c.avatar_url, c.type, c.created_at, c.updated_at, cu.last_read
+        SELECT c.id, c.name, c.avatar_url, c.type, c.created_at, c.updated_at, cu.last_read,
+               cu.muted_until, cu.archived, cu.pin_order, c.message_ttl, c.encrypted
         FROM chat c
         join chat_user cu on c.id = cu.chat_id
-        WHERE cu.user_id = $1
-        ORDER BY c.updated_at DESC
+        WHERE cu.user_id = $1 AND cu.archived = $2
+        ORDER BY cu.pin_order NULLS LAST, c.updated_at DESC
+`
+
+	updateChatQuery = `
+        UPDATE chat
+        SET name = $2, avatar_url = $3, updated_at = $4
+        WHERE id = $1
 `
 
 	getChatQuery = `
-		SELECT id, name, avatar_url, type, created_at, updated_at
+		SELECT id, name, avatar_url, type, created_at, updated_at, message_ttl, encrypted
 		FROM chat
 		WHERE id = $1
 `
 
 	getPrivateChatQuery = `
-		SELECT id, name, avatar_url, type, created_at, updated_at
+		SELECT id, name, avatar_url, type, created_at, updated_at, message_ttl, encrypted
 		FROM chat
 		WHERE type = $1 AND id in
 			(select cu1.chat_id 
@@ -54,12 +66,43 @@ const (
 		WHERE cu.chat_id = $1
 `
 
+	getChatMembersQuery = `
+		SELECT cu.user_id, cu.role
+		FROM chat_user cu
+		WHERE cu.chat_id = $1
+`
+
+	getChatRoleQuery = `
+		SELECT role
+		FROM chat_user
+		WHERE chat_id = $1 AND user_id = $2
+`
+
+	setChatRoleQuery = `
+		UPDATE chat_user
+		SET role = $3
+		WHERE chat_id = $1 AND user_id = $2
+`
+
+	setChatMessageTTLQuery = `
+		UPDATE chat
+		SET message_ttl = $2
+		WHERE id = $1
+`
+
+	setChatEncryptedQuery = `
+		UPDATE chat
+		SET encrypted = $2
+		WHERE id = $1
+`
+
 	getNumUnreadChatsQuery = `
 	SELECT COUNT(DISTINCT cu.chat_id)
 	FROM chat_user cu
 	JOIN chat c ON cu.chat_id = c.id
 	JOIN message m ON c.id = m.chat_id
 	WHERE cu.user_id = $1
+    AND (cu.muted_until IS NULL OR cu.muted_until <= now())
     AND (cu.last_read IS NULL OR cu.last_read::timestamptz(3) < c.updated_at::timestamptz(3))
 	AND (
 	    select m.sender_id
@@ -109,9 +152,52 @@ func (c *ChatRepository) CreateChat(ctx context.Context, chat models.Chat) error
 	return nil
 }
 
-func (c *ChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error) {
+func (c *ChatRepository) UpdateChat(ctx context.Context, chat models.Chat) error {
+	res, err := c.ConnPool.ExecContext(ctx, updateChatQuery, chat.ID, chat.Name, chat.AvatarURL, chat.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to update chat %v in database: %s", chat.ID, err.Error())
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// SetChatMessageTTL sets the lifetime of messages sent to the chat from now on, zero turns it off
+func (c *ChatRepository) SetChatMessageTTL(ctx context.Context, chatId uuid.UUID, ttl time.Duration) error {
+	res, err := c.ConnPool.ExecContext(ctx, setChatMessageTTLQuery, chatId,
+		pgtype.Int4{Int32: int32(ttl / time.Second), Valid: ttl > 0})
+	if err != nil {
+		logger.Error(ctx, "Unable to set message ttl of chat %v: %s", chatId, err.Error())
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// SetChatEncrypted switches end-to-end encryption of the chat, already sent messages are left as is
+func (c *ChatRepository) SetChatEncrypted(ctx context.Context, chatId uuid.UUID, encrypted bool) error {
+	res, err := c.ConnPool.ExecContext(ctx, setChatEncryptedQuery, chatId, encrypted)
+	if err != nil {
+		logger.Error(ctx, "Unable to set encryption of chat %v: %s", chatId, err.Error())
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// GetUserChats returns archived or not archived chats of the user, pinned chats first
+func (c *ChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error) {
 	var chats []models.Chat
-	rows, err := c.ConnPool.QueryContext(ctx, getUserChatsQuery, userId)
+	rows, err := c.ConnPool.QueryContext(ctx, getUserChatsQuery, userId, archived)
 	if err != nil {
 		logger.Error(ctx, "Unable to get user %v chats from database: %s", userId, err.Error())
 		return nil, err
@@ -121,7 +207,8 @@ func (c *ChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID) ([]
 	var chatPostgres pgmodels.ChatPostgres
 
 	for rows.Next() {
-		err = rows.Scan(&chatPostgres.Id, &chatPostgres.Name, &chatPostgres.AvatarURL, &chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt, &chatPostgres.LastReadByMe)
+		err = rows.Scan(&chatPostgres.Id, &chatPostgres.Name, &chatPostgres.AvatarURL, &chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt, &chatPostgres.LastReadByMe,
+			&chatPostgres.MutedUntil, &chatPostgres.Archived, &chatPostgres.PinOrder, &chatPostgres.MessageTTL, &chatPostgres.Encrypted)
 		if err != nil {
 			logger.Error(ctx, "Unable to scan chat from database for user %v: %s", userId, err.Error())
 			return nil, err
@@ -159,7 +246,7 @@ func (c *ChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID) ([]
 
 func (c *ChatRepository) GetChat(ctx context.Context, chatId uuid.UUID) (models.Chat, error) {
 	var chatPostgres pgmodels.ChatPostgres
-	err := c.ConnPool.QueryRowContext(ctx, getChatQuery, chatId).Scan(&chatPostgres.Id, &chatPostgres.Name, &chatPostgres.AvatarURL, &chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt)
+	err := c.ConnPool.QueryRowContext(ctx, getChatQuery, chatId).Scan(&chatPostgres.Id, &chatPostgres.Name, &chatPostgres.AvatarURL, &chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt, &chatPostgres.MessageTTL, &chatPostgres.Encrypted)
 	if errors.Is(err, sql.ErrNoRows) {
 		logger.Error(ctx, "Chat with id %s not found", chatId)
 		return models.Chat{}, messenger_errors.ErrNotFound
@@ -177,7 +264,7 @@ func (c *ChatRepository) GetPrivateChat(ctx context.Context, requester, companio
 	var chatPostgres pgmodels.ChatPostgres
 	err := c.ConnPool.QueryRowContext(ctx, getPrivateChatQuery, models.ChatTypePrivate, requester, companion).
 		Scan(&chatPostgres.Id, &chatPostgres.Name, &chatPostgres.AvatarURL,
-			&chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt)
+			&chatPostgres.Type, &chatPostgres.CreatedAt, &chatPostgres.UpdatedAt, &chatPostgres.MessageTTL, &chatPostgres.Encrypted)
 	if errors.Is(err, sql.ErrNoRows) {
 		logger.Error(ctx, "Private chat between %s and %s not found", requester, companion)
 		return models.Chat{}, messenger_errors.ErrNotFound
@@ -239,7 +326,7 @@ func (c *ChatRepository) IsParticipant(ctx context.Context, chatId, userId uuid.
 }
 
 func (c *ChatRepository) JoinChat(ctx context.Context, chatId, userId uuid.UUID) error {
-	_, err := c.ConnPool.ExecContext(ctx, "INSERT INTO chat_user (chat_id, user_id) VALUES ($1, $2)", chatId, userId)
+	_, err := c.ConnPool.ExecContext(ctx, insertChatUserQuery, chatId, userId)
 	if err != nil {
 		logger.Error(ctx, "Unable to add user %v to chat %v: %s", userId, chatId, err.Error())
 		return err
@@ -291,6 +378,64 @@ func (c *ChatRepository) GetChatParticipants(ctx context.Context, chatId uuid.UU
 	return users, nil
 }
 
+// GetChatMembers returns participants of the chat with their roles
+func (c *ChatRepository) GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error) {
+	rows, err := c.ConnPool.QueryContext(ctx, getChatMembersQuery, chatId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get chat %v members from database: %s", chatId, err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []models.ChatMember
+	for rows.Next() {
+		var (
+			userId pgtype.UUID
+			role   string
+		)
+		if err = rows.Scan(&userId, &role); err != nil {
+			logger.Error(ctx, "Unable to scan member of chat %v: %s", chatId, err.Error())
+			return nil, err
+		}
+		members = append(members, models.ChatMember{UserID: userId.Bytes, Role: models.ChatRole(role)})
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error(ctx, "Error while iterating over chat %v members: %s", chatId, err.Error())
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return nil, messenger_errors.ErrNotFound
+	}
+	return members, nil
+}
+
+// GetChatRole returns ErrNotFound if the user is not a participant of the chat
+func (c *ChatRepository) GetChatRole(ctx context.Context, chatId, userId uuid.UUID) (models.ChatRole, error) {
+	var role string
+	err := c.ConnPool.QueryRowContext(ctx, getChatRoleQuery, chatId, userId).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", messenger_errors.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to get role of user %v in chat %v: %s", userId, chatId, err.Error())
+		return "", err
+	}
+	return models.ChatRole(role), nil
+}
+
+func (c *ChatRepository) SetChatRole(ctx context.Context, chatId, userId uuid.UUID, role models.ChatRole) error {
+	res, err := c.ConnPool.ExecContext(ctx, setChatRoleQuery, chatId, userId, string(role))
+	if err != nil {
+		logger.Error(ctx, "Unable to set role %v of user %v in chat %v: %s", role, userId, chatId, err.Error())
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
 func (c *ChatRepository) GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error) {
 	var count int
 	err := c.ConnPool.QueryRowContext(ctx, getNumUnreadChatsQuery, userId).Scan(&count)
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	insertChatExportQuery = `
+		INSERT INTO chat_export (id, chat_id, user_id, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+	getChatExportQuery = `
+		SELECT id, chat_id, user_id, status, file_url, created_at, updated_at
+		FROM chat_export
+		WHERE id = $1
+`
+
+	// skips exports taken by other exporters, an export whose lease ended is taken again
+	claimPendingChatExportsQuery = `
+		UPDATE chat_export
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id
+			FROM chat_export
+			WHERE status = 'pending' AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, chat_id, user_id, status, file_url, created_at, updated_at
+`
+
+	getExpiredChatExportsQuery = `
+		SELECT id, chat_id, user_id, status, file_url, created_at, updated_at
+		FROM chat_export
+		WHERE status = 'ready' AND updated_at < $1
+		ORDER BY updated_at
+		LIMIT $2
+`
+
+	finishChatExportQuery = `
+		UPDATE chat_export
+		SET status = $2, file_url = $3, updated_at = $4, locked_until = NULL
+		WHERE id = $1
+`
+)
+
+// SaveChatExport stores the export request, the exporter builds the archive later
+func (c *ChatRepository) SaveChatExport(ctx context.Context, export models.ChatExport) error {
+	_, err := c.ConnPool.ExecContext(ctx, insertChatExportQuery,
+		export.ID, export.ChatID, export.UserID, string(export.Status), export.CreatedAt, export.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to save chat export %v: %s", export.ID, err.Error())
+		return fmt.Errorf("unable to save chat export: %w", err)
+	}
+	return nil
+}
+
+func (c *ChatRepository) GetChatExport(ctx context.Context, id uuid.UUID) (models.ChatExport, error) {
+	export, err := scanChatExport(c.ConnPool.QueryRowContext(ctx, getChatExportQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ChatExport{}, messenger_errors.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to get chat export %v: %s", id, err.Error())
+		return models.ChatExport{}, fmt.Errorf("unable to get chat export: %w", err)
+	}
+	return export, nil
+}
+
+// ClaimPendingChatExports takes up to limit pending exports for building until lockedUntil.
+// Exports not finished by then are taken again, so a crashed exporter does not lose them.
+func (c *ChatRepository) ClaimPendingChatExports(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ChatExport, error) {
+	rows, err := c.ConnPool.QueryContext(ctx, claimPendingChatExportsQuery, now, lockedUntil, limit)
+	if err != nil {
+		logger.Error(ctx, "Unable to claim pending chat exports: %s", err.Error())
+		return nil, fmt.Errorf("unable to claim pending chat exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []models.ChatExport
+	for rows.Next() {
+		export, err := scanChatExport(rows)
+		if err != nil {
+			logger.Error(ctx, "Unable to scan pending chat export: %s", err.Error())
+			return nil, fmt.Errorf("unable to scan pending chat export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	return exports, rows.Err()
+}
+
+// FinishChatExport sets the final status of the export and releases its lease
+func (c *ChatRepository) FinishChatExport(ctx context.Context, id uuid.UUID, status models.ChatExportStatus, fileURL string, now time.Time) error {
+	url := pgtype.Text{String: fileURL, Valid: len(fileURL) != 0}
+	if _, err := c.ConnPool.ExecContext(ctx, finishChatExportQuery, id, string(status), url, now); err != nil {
+		logger.Error(ctx, "Unable to finish chat export %v: %s", id, err.Error())
+		return fmt.Errorf("unable to finish chat export: %w", err)
+	}
+	return nil
+}
+
+// GetExpiredChatExports returns up to limit ready exports built before the given time, the oldest first
+func (c *ChatRepository) GetExpiredChatExports(ctx context.Context, before time.Time, limit int) ([]models.ChatExport, error) {
+	rows, err := c.ConnPool.QueryContext(ctx, getExpiredChatExportsQuery, before, limit)
+	if err != nil {
+		logger.Error(ctx, "Unable to get expired chat exports: %s", err.Error())
+		return nil, fmt.Errorf("unable to get expired chat exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []models.ChatExport
+	for rows.Next() {
+		export, err := scanChatExport(rows)
+		if err != nil {
+			logger.Error(ctx, "Unable to scan expired chat export: %s", err.Error())
+			return nil, fmt.Errorf("unable to scan expired chat export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	return exports, rows.Err()
+}
+
+func scanChatExport(row rowScanner) (models.ChatExport, error) {
+	var export models.ChatExport
+	var status string
+	var fileURL pgtype.Text
+	err := row.Scan(&export.ID, &export.ChatID, &export.UserID, &status, &fileURL, &export.CreatedAt, &export.UpdatedAt)
+	if err != nil {
+		return models.ChatExport{}, err
+	}
+	export.Status = models.ChatExportStatus(status)
+	export.FileURL = fileURL.String
+	return export, nil
+}
@@ -0,0 +1,137 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+var chatExportColumns = []string{"id", "chat_id", "user_id", "status", "file_url", "created_at", "updated_at"}
+
+func TestGetChatExport(t *testing.T) {
+	ctx := context.Background()
+	id, missingID, chatID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id, chat_id, user_id, status, file_url`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(chatExportColumns).
+			AddRow(id, chatID, userID, "ready", "export.zip", now, now))
+	mock.ExpectQuery(`SELECT id, chat_id, user_id, status, file_url`).
+		WithArgs(missingID).
+		WillReturnRows(sqlmock.NewRows(chatExportColumns))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	export, err := repo.GetChatExport(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, models.ChatExport{
+		ID:        id,
+		ChatID:    chatID,
+		UserID:    userID,
+		Status:    models.ChatExportReady,
+		FileURL:   "export.zip",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, export)
+
+	_, err = repo.GetChatExport(ctx, missingID)
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimPendingChatExports(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	lockedUntil := now.Add(time.Minute)
+	id, chatID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`UPDATE chat_export\s+SET locked_until`).
+		WithArgs(now, lockedUntil, 10).
+		WillReturnRows(sqlmock.NewRows(chatExportColumns).
+			AddRow(id, chatID, userID, "pending", nil, now, now))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	claimed, err := repo.ClaimPendingChatExports(ctx, now, lockedUntil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []models.ChatExport{{
+		ID:        id,
+		ChatID:    chatID,
+		UserID:    userID,
+		Status:    models.ChatExportPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}}, claimed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetExpiredChatExports(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	id, chatID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`WHERE status = 'ready' AND updated_at < \$1`).
+		WithArgs(before, 10).
+		WillReturnRows(sqlmock.NewRows(chatExportColumns).
+			AddRow(id, chatID, userID, "ready", "export.zip", before.Add(-time.Hour), before.Add(-time.Minute)))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	expired, err := repo.GetExpiredChatExports(ctx, before, 10)
+	require.NoError(t, err)
+	require.Equal(t, []models.ChatExport{{
+		ID:        id,
+		ChatID:    chatID,
+		UserID:    userID,
+		Status:    models.ChatExportReady,
+		FileURL:   "export.zip",
+		CreatedAt: before.Add(-time.Hour),
+		UpdatedAt: before.Add(-time.Minute),
+	}}, expired)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFinishChatExport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	id := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE chat_export\s+SET status`).
+		WithArgs(id, "ready", "export.zip", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE chat_export\s+SET status`).
+		WithArgs(id, "failed", nil, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	require.NoError(t, repo.FinishChatExport(ctx, id, models.ChatExportReady, "export.zip", now))
+	require.NoError(t, repo.FinishChatExport(ctx, id, models.ChatExportFailed, "", now))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
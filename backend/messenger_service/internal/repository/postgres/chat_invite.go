@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	pgmodels "quickflow/messenger_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	insertChatInviteQuery = `
+		INSERT INTO chat_invite (token, chat_id, creator_id, created_at, expires_at, usage_limit, usage_count)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+	getChatInviteQuery = `
+		SELECT token, chat_id, creator_id, created_at, expires_at, usage_limit, usage_count
+		FROM chat_invite
+		WHERE token = $1
+`
+
+	getActiveChatInvitesQuery = `
+		SELECT token, chat_id, creator_id, created_at, expires_at, usage_limit, usage_count
+		FROM chat_invite
+		WHERE chat_id = $1
+		  AND (expires_at IS NULL OR expires_at > now())
+		  AND (usage_limit IS NULL OR usage_count < usage_limit)
+		ORDER BY created_at DESC
+`
+
+	// useChatInviteQuery checks and counts the usage in one statement, so concurrent joins can't exceed the limit
+	useChatInviteQuery = `
+		UPDATE chat_invite
+		SET usage_count = usage_count + 1
+		WHERE token = $1
+		  AND (expires_at IS NULL OR expires_at > now())
+		  AND (usage_limit IS NULL OR usage_count < usage_limit)
+`
+
+	// a concurrent join of the same user inserts nothing and the invite use is not counted
+	joinChatByInviteQuery = `
+		INSERT INTO chat_user (chat_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (chat_id, user_id) DO NOTHING
+`
+
+	deleteChatInviteQuery = `
+		DELETE FROM chat_invite
+		WHERE token = $1
+`
+)
+
+func (c *ChatRepository) CreateChatInvite(ctx context.Context, invite models.ChatInvite) error {
+	pgInvite := pgmodels.FromChatInvite(invite)
+	_, err := c.ConnPool.ExecContext(ctx, insertChatInviteQuery,
+		pgInvite.Token, pgInvite.ChatID, pgInvite.CreatorID, pgInvite.CreatedAt,
+		pgInvite.ExpiresAt, pgInvite.UsageLimit, pgInvite.UsageCount,
+	)
+	if err != nil {
+		logger.Error(ctx, "Unable to save invite to chat %v: %s", invite.ChatID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetChatInvite returns the invite even if it is no longer active
+func (c *ChatRepository) GetChatInvite(ctx context.Context, token string) (models.ChatInvite, error) {
+	var pgInvite pgmodels.ChatInvitePostgres
+	err := c.ConnPool.QueryRowContext(ctx, getChatInviteQuery, token).Scan(
+		&pgInvite.Token, &pgInvite.ChatID, &pgInvite.CreatorID, &pgInvite.CreatedAt,
+		&pgInvite.ExpiresAt, &pgInvite.UsageLimit, &pgInvite.UsageCount,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ChatInvite{}, messenger_errors.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to get chat invite from database: %s", err.Error())
+		return models.ChatInvite{}, err
+	}
+	return pgInvite.ToChatInvite(), nil
+}
+
+// GetChatInvites returns not expired and not exhausted invites of the chat, newest first
+func (c *ChatRepository) GetChatInvites(ctx context.Context, chatId uuid.UUID) ([]models.ChatInvite, error) {
+	rows, err := c.ConnPool.QueryContext(ctx, getActiveChatInvitesQuery, chatId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get invites of chat %v from database: %s", chatId, err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []models.ChatInvite
+	for rows.Next() {
+		var pgInvite pgmodels.ChatInvitePostgres
+		err = rows.Scan(
+			&pgInvite.Token, &pgInvite.ChatID, &pgInvite.CreatorID, &pgInvite.CreatedAt,
+			&pgInvite.ExpiresAt, &pgInvite.UsageLimit, &pgInvite.UsageCount,
+		)
+		if err != nil {
+			logger.Error(ctx, "Unable to scan invite of chat %v: %s", chatId, err.Error())
+			return nil, err
+		}
+		invites = append(invites, pgInvite.ToChatInvite())
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error(ctx, "Error while iterating over chat %v invites: %s", chatId, err.Error())
+		return nil, err
+	}
+	return invites, nil
+}
+
+// JoinChatByInvite adds the user to the chat and counts one more join through the invite in one transaction.
+// Returns ErrAlreadyInChat if the user is a member already and ErrChatInviteExpired if the invite is expired or exhausted.
+func (c *ChatRepository) JoinChatByInvite(ctx context.Context, token string, chatId, userId uuid.UUID) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, joinChatByInviteQuery, chatId, userId)
+	if err != nil {
+		logger.Error(ctx, "Unable to add user %v to chat %v: %s", userId, chatId, err.Error())
+		return err
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrAlreadyInChat
+	}
+
+	res, err = tx.ExecContext(ctx, useChatInviteQuery, token)
+	if err != nil {
+		logger.Error(ctx, "Unable to use chat invite: %s", err.Error())
+		return err
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrChatInviteExpired
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit transaction: %v", err)
+		return fmt.Errorf("unable to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (c *ChatRepository) DeleteChatInvite(ctx context.Context, token string) error {
+	res, err := c.ConnPool.ExecContext(ctx, deleteChatInviteQuery, token)
+	if err != nil {
+		logger.Error(ctx, "Unable to delete chat invite: %s", err.Error())
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
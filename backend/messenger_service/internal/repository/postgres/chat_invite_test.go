@@ -0,0 +1,142 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+var chatInviteColumns = []string{"token", "chat_id", "creator_id", "created_at", "expires_at", "usage_limit", "usage_count"}
+
+func TestGetChatInvite(t *testing.T) {
+	ctx := context.Background()
+	chatID, creatorID := uuid.New(), uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+	expiresAt := time.Now().Add(time.Hour)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT token, chat_id`).
+		WithArgs("token").
+		WillReturnRows(sqlmock.NewRows(chatInviteColumns).
+			AddRow("token", chatID, creatorID, createdAt, expiresAt, 5, 2))
+	mock.ExpectQuery(`SELECT token, chat_id`).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(chatInviteColumns))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	invite, err := repo.GetChatInvite(ctx, "token")
+	require.NoError(t, err)
+	require.Equal(t, models.ChatInvite{
+		Token:      "token",
+		ChatID:     chatID,
+		CreatorID:  creatorID,
+		CreatedAt:  createdAt,
+		ExpiresAt:  &expiresAt,
+		UsageLimit: 5,
+		UsageCount: 2,
+	}, invite)
+
+	_, err = repo.GetChatInvite(ctx, "missing")
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetChatInvites(t *testing.T) {
+	ctx := context.Background()
+	chatID, creatorID := uuid.New(), uuid.New()
+	createdAt := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT token, chat_id`).
+		WithArgs(chatID).
+		WillReturnRows(sqlmock.NewRows(chatInviteColumns).
+			AddRow("unlimited", chatID, creatorID, createdAt, nil, nil, 7))
+
+	repo := postgres.NewPostgresChatRepository(db)
+	invites, err := repo.GetChatInvites(ctx, chatID)
+	require.NoError(t, err)
+	require.Equal(t, []models.ChatInvite{{
+		Token:      "unlimited",
+		ChatID:     chatID,
+		CreatorID:  creatorID,
+		CreatedAt:  createdAt,
+		UsageCount: 7,
+	}}, invites)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJoinChatByInvite(t *testing.T) {
+	ctx := context.Background()
+	chatID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		joinedRows int64
+		usedRows   int64
+		wantErr    error
+	}{
+		{name: "success", joinedRows: 1, usedRows: 1},
+		{name: "already in chat", joinedRows: 0, wantErr: messenger_errors.ErrAlreadyInChat},
+		{name: "expired or exhausted", joinedRows: 1, usedRows: 0, wantErr: messenger_errors.ErrChatInviteExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO chat_user`).
+				WithArgs(chatID, userID).
+				WillReturnResult(sqlmock.NewResult(0, tt.joinedRows))
+			if tt.joinedRows > 0 {
+				mock.ExpectExec(`UPDATE chat_invite`).
+					WithArgs("token").
+					WillReturnResult(sqlmock.NewResult(0, tt.usedRows))
+			}
+			if tt.wantErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresChatRepository(db)
+			err = repo.JoinChatByInvite(ctx, "token", chatID, userID)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteChatInvite(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM chat_invite`).
+		WithArgs("token").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := postgres.NewPostgresChatRepository(db)
+	err = repo.DeleteChatInvite(ctx, "token")
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	insertChatMemberQuery = `
+        INSERT INTO chat_user (chat_id, user_id, role)
+        VALUES ($1, $2, $3)
+`
+	deleteChatMemberQuery = `
+        DELETE FROM chat_user
+        WHERE chat_id = $1 AND user_id = $2
+`
+)
+
+// CreateGroupChat saves the group chat with its members and the system message announcing it in one transaction
+func (c *ChatRepository) CreateGroupChat(ctx context.Context, chat models.Chat, members []models.ChatMember, message models.Message) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, insertChatQuery, chat.ID, chat.Name, chat.AvatarURL, chat.Type, chat.CreatedAt, chat.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to save group chat %v to database: %v", chat.ID, err)
+		return fmt.Errorf("unable to save chat to database: %w", err)
+	}
+	for _, member := range members {
+		if err = insertChatMember(ctx, tx, chat.ID, member); err != nil {
+			return err
+		}
+	}
+	if err = saveMessage(ctx, tx, message); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit chat %v: %v", chat.ID, err)
+		return fmt.Errorf("unable to commit chat: %w", err)
+	}
+	return nil
+}
+
+// AddChatMembers adds the users to the chat together with the system messages about them in one transaction
+func (c *ChatRepository) AddChatMembers(ctx context.Context, chatId uuid.UUID, memberIds []uuid.UUID, messages []models.Message) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, memberId := range memberIds {
+		member := models.ChatMember{UserID: memberId, Role: models.ChatRoleMember}
+		if err = insertChatMember(ctx, tx, chatId, member); err != nil {
+			return err
+		}
+	}
+	for _, message := range messages {
+		if err = saveMessage(ctx, tx, message); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit members of chat %v: %v", chatId, err)
+		return fmt.Errorf("unable to commit chat members: %w", err)
+	}
+	return nil
+}
+
+// RemoveChatMember saves the system message and removes the member from the chat in one transaction.
+// Returns ErrNotFound if the user is not a member of the chat.
+func (c *ChatRepository) RemoveChatMember(ctx context.Context, chatId, memberId uuid.UUID, message models.Message) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// message is written before leaving, so the member still gets it on history replay
+	if err = saveMessage(ctx, tx, message); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, deleteChatMemberQuery, chatId, memberId)
+	if err != nil {
+		logger.Error(ctx, "Unable to remove user %v from chat %v: %v", memberId, chatId, err)
+		return fmt.Errorf("unable to remove user from chat: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit removal of user %v from chat %v: %v", memberId, chatId, err)
+		return fmt.Errorf("unable to commit chat member removal: %w", err)
+	}
+	return nil
+}
+
+// SetChatRoles sets roles of the members together with the system message about the change in one transaction.
+// Returns ErrNotFound if any of the users is not a member of the chat.
+func (c *ChatRepository) SetChatRoles(ctx context.Context, chatId uuid.UUID, members []models.ChatMember, message models.Message) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, member := range members {
+		res, err := tx.ExecContext(ctx, setChatRoleQuery, chatId, member.UserID, string(member.Role))
+		if err != nil {
+			logger.Error(ctx, "Unable to set role %v of user %v in chat %v: %v", member.Role, member.UserID, chatId, err)
+			return fmt.Errorf("unable to set chat role: %w", err)
+		}
+		if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+			return messenger_errors.ErrNotFound
+		}
+	}
+	if err = saveMessage(ctx, tx, message); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit roles in chat %v: %v", chatId, err)
+		return fmt.Errorf("unable to commit chat roles: %w", err)
+	}
+	return nil
+}
+
+func insertChatMember(ctx context.Context, tx *sql.Tx, chatId uuid.UUID, member models.ChatMember) error {
+	_, err := tx.ExecContext(ctx, insertChatMemberQuery, chatId, member.UserID, string(member.Role))
+	if err != nil {
+		logger.Error(ctx, "Unable to add user %v to chat %v: %v", member.UserID, chatId, err)
+		return fmt.Errorf("unable to add user to chat: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+func expectSystemMessage(mock sqlmock.Sqlmock, seq int64) *sqlmock.ExpectedExec {
+	mock.ExpectQuery(`update chat\s+set last_seq`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(seq))
+	return mock.ExpectExec(`INSERT INTO message \(`)
+}
+
+func TestCreateGroupChat(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	chat := models.Chat{ID: uuid.New(), Name: "group", Type: models.ChatTypeGroup, CreatedAt: now, UpdatedAt: now}
+	ownerID, memberID := uuid.New(), uuid.New()
+	members := []models.ChatMember{
+		{UserID: ownerID, Role: models.ChatRoleOwner},
+		{UserID: memberID, Role: models.ChatRoleMember},
+	}
+	message := models.Message{ID: uuid.New(), ChatID: chat.ID, SenderID: ownerID, CreatedAt: now, UpdatedAt: now,
+		System: &models.SystemInfo{Action: models.SystemActionChatCreated}}
+
+	tests := []struct {
+		name        string
+		failMessage bool
+	}{
+		{name: "saved with members and message"},
+		{name: "nothing saved when the message fails", failMessage: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO chat \(`).
+				WithArgs(chat.ID, chat.Name, chat.AvatarURL, chat.Type, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO chat_user`).WithArgs(chat.ID, ownerID, "owner").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO chat_user`).WithArgs(chat.ID, memberID, "member").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			insert := expectSystemMessage(mock, 1)
+			if tt.failMessage {
+				insert.WillReturnError(errors.New("connection lost"))
+				mock.ExpectRollback()
+			} else {
+				insert.WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresChatRepository(db)
+			err = repo.CreateGroupChat(ctx, chat, members, message)
+			if tt.failMessage {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRemoveChatMember(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	chatID, userID, memberID := uuid.New(), uuid.New(), uuid.New()
+	message := models.Message{ID: uuid.New(), ChatID: chatID, SenderID: userID, CreatedAt: now, UpdatedAt: now,
+		System: &models.SystemInfo{Action: models.SystemActionMemberRemoved, UserID: memberID}}
+
+	tests := []struct {
+		name        string
+		deletedRows int64
+		wantErr     error
+	}{
+		{name: "success", deletedRows: 1},
+		{name: "not member", deletedRows: 0, wantErr: messenger_errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			expectSystemMessage(mock, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`DELETE FROM chat_user`).
+				WithArgs(chatID, memberID).
+				WillReturnResult(sqlmock.NewResult(0, tt.deletedRows))
+			if tt.wantErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresChatRepository(db)
+			err = repo.RemoveChatMember(ctx, chatID, memberID, message)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSetChatRoles(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	chatID, ownerID, adminID := uuid.New(), uuid.New(), uuid.New()
+	members := []models.ChatMember{
+		{UserID: adminID, Role: models.ChatRoleOwner},
+		{UserID: ownerID, Role: models.ChatRoleAdmin},
+	}
+	message := models.Message{ID: uuid.New(), ChatID: chatID, SenderID: ownerID, CreatedAt: now, UpdatedAt: now,
+		System: &models.SystemInfo{Action: models.SystemActionRoleChanged, UserID: adminID}}
+
+	tests := []struct {
+		name        string
+		updatedRows int64
+		wantErr     error
+	}{
+		{name: "ownership passed", updatedRows: 1},
+		{name: "old owner left", updatedRows: 0, wantErr: messenger_errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE chat_user`).WithArgs(chatID, adminID, "owner").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`UPDATE chat_user`).WithArgs(chatID, ownerID, "admin").
+				WillReturnResult(sqlmock.NewResult(0, tt.updatedRows))
+			if tt.wantErr != nil {
+				mock.ExpectRollback()
+			} else {
+				expectSystemMessage(mock, 9).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresChatRepository(db)
+			err = repo.SetChatRoles(ctx, chatID, members, message)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
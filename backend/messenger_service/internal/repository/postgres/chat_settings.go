@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/logger"
+)
+
+const (
+	setChatMutedQuery = `
+		UPDATE chat_user
+		SET muted_until = $3
+		WHERE chat_id = $1 AND user_id = $2
+`
+	// archived chats are not kept among pinned ones
+	setChatArchivedQuery = `
+		UPDATE chat_user
+		SET archived = $3, pin_order = CASE WHEN $3 THEN NULL ELSE pin_order END
+		WHERE chat_id = $1 AND user_id = $2
+`
+	getPinnedChatsQuery = `
+		SELECT chat_id
+		FROM chat_user
+		WHERE user_id = $1 AND pin_order IS NOT NULL
+		ORDER BY pin_order
+`
+	unpinAllChatsQuery = `
+		UPDATE chat_user
+		SET pin_order = NULL
+		WHERE user_id = $1 AND pin_order IS NOT NULL
+`
+	// a pinned chat is taken out of the archive
+	setChatPinOrderQuery = `
+		UPDATE chat_user
+		SET pin_order = $3, archived = false
+		WHERE chat_id = $1 AND user_id = $2
+`
+)
+
+// SetChatMuted turns off notifications from the chat for the user until the time, nil turns them back on
+func (c *ChatRepository) SetChatMuted(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error {
+	var until pgtype.Timestamptz
+	if mutedUntil != nil {
+		until = pgtype.Timestamptz{Time: *mutedUntil, Valid: true}
+	}
+
+	res, err := c.ConnPool.ExecContext(ctx, setChatMutedQuery, pgtype.UUID{Bytes: chatId, Valid: true},
+		pgtype.UUID{Bytes: userId, Valid: true}, until)
+	if err != nil {
+		logger.Error(ctx, "Unable to mute chat %v for user %v: %v", chatId, userId, err)
+		return fmt.Errorf("unable to mute chat: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// SetChatArchived moves the chat to the archive of the user or back, archiving also unpins the chat
+func (c *ChatRepository) SetChatArchived(ctx context.Context, chatId, userId uuid.UUID, archived bool) error {
+	res, err := c.ConnPool.ExecContext(ctx, setChatArchivedQuery, pgtype.UUID{Bytes: chatId, Valid: true},
+		pgtype.UUID{Bytes: userId, Valid: true}, archived)
+	if err != nil {
+		logger.Error(ctx, "Unable to archive chat %v for user %v: %v", chatId, userId, err)
+		return fmt.Errorf("unable to archive chat: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// GetPinnedChats returns chats pinned by the user in their order
+func (c *ChatRepository) GetPinnedChats(ctx context.Context, userId uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := c.ConnPool.QueryContext(ctx, getPinnedChatsQuery, pgtype.UUID{Bytes: userId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to get pinned chats of user %v: %v", userId, err)
+		return nil, fmt.Errorf("unable to get pinned chats: %w", err)
+	}
+	defer rows.Close()
+
+	var chatIds []uuid.UUID
+	for rows.Next() {
+		var chatId pgtype.UUID
+		if err = rows.Scan(&chatId); err != nil {
+			logger.Error(ctx, "Unable to scan pinned chat: %v", err)
+			return nil, fmt.Errorf("unable to scan pinned chat: %w", err)
+		}
+		chatIds = append(chatIds, chatId.Bytes)
+	}
+	return chatIds, rows.Err()
+}
+
+// SetPinnedChats replaces pinned chats of the user with the given ones in the given order
+func (c *ChatRepository) SetPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error {
+	tx, err := c.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, unpinAllChatsQuery, pgtype.UUID{Bytes: userId, Valid: true}); err != nil {
+		logger.Error(ctx, "Unable to unpin chats of user %v: %v", userId, err)
+		return fmt.Errorf("unable to unpin chats: %w", err)
+	}
+
+	for i, chatId := range chatIds {
+		res, err := tx.ExecContext(ctx, setChatPinOrderQuery, pgtype.UUID{Bytes: chatId, Valid: true},
+			pgtype.UUID{Bytes: userId, Valid: true}, i+1)
+		if err != nil {
+			logger.Error(ctx, "Unable to pin chat %v for user %v: %v", chatId, userId, err)
+			return fmt.Errorf("unable to pin chat: %w", err)
+		}
+		if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+			return messenger_errors.ErrNotFound
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit transaction: %v", err)
+		return fmt.Errorf("unable to commit transaction: %w", err)
+	}
+	return nil
+}
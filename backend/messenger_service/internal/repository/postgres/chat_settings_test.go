@@ -0,0 +1,116 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+)
+
+func TestSetChatMuted(t *testing.T) {
+	ctx := context.Background()
+	chatID, userID := uuid.New(), uuid.New()
+	mutedUntil := time.Now().Add(time.Hour)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE chat_user\s+SET muted_until`).
+		WithArgs(chatID, userID, mutedUntil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE chat_user\s+SET muted_until`).
+		WithArgs(chatID, userID, nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	require.NoError(t, repo.SetChatMuted(ctx, chatID, userID, &mutedUntil))
+	require.ErrorIs(t, repo.SetChatMuted(ctx, chatID, userID, nil), messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPinnedChats(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	chatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT chat_id\s+FROM chat_user`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(chatIDs[0]).AddRow(chatIDs[1]))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	pinned, err := repo.GetPinnedChats(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, chatIDs, pinned)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetPinnedChats(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	chatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name        string
+		mock        func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name: "rewrites order",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE chat_user\s+SET pin_order = NULL`).
+					WithArgs(userID).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`UPDATE chat_user\s+SET pin_order = \$3`).
+					WithArgs(chatIDs[0], userID, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE chat_user\s+SET pin_order = \$3`).
+					WithArgs(chatIDs[1], userID, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a participant",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE chat_user\s+SET pin_order = NULL`).
+					WithArgs(userID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`UPDATE chat_user\s+SET pin_order = \$3`).
+					WithArgs(chatIDs[0], userID, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: messenger_errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mock(mock)
+			repo := postgres.NewPostgresChatRepository(db)
+
+			err = repo.SetPinnedChats(ctx, userID, chatIDs)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	messenger_errors "quickflow/messenger_service/internal/errors"
 	"quickflow/messenger_service/internal/repository/postgres"
 	"quickflow/shared/models"
 	"testing"
@@ -109,3 +110,125 @@ func TestCreateChat(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateChat(t *testing.T) {
+	ctx := context.Background()
+	chat := models.Chat{
+		ID:        uuid.New(),
+		Name:      "Renamed",
+		AvatarURL: "https://img",
+		Type:      models.ChatTypeGroup,
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock sqlmock.Sqlmock)
+		wantErr   error
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE chat`).
+					WithArgs(chat.ID, chat.Name, chat.AvatarURL, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "chat not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE chat`).
+					WithArgs(chat.ID, chat.Name, chat.AvatarURL, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: messenger_errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewPostgresChatRepository(db)
+			err = repo.UpdateChat(ctx, chat)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetChatRole(t *testing.T) {
+	ctx := context.Background()
+	chatID, userID := uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT role`).
+		WithArgs(chatID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("admin"))
+	mock.ExpectQuery(`SELECT role`).
+		WithArgs(chatID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"role"}))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	role, err := repo.GetChatRole(ctx, chatID, userID)
+	require.NoError(t, err)
+	require.Equal(t, models.ChatRoleAdmin, role)
+
+	_, err = repo.GetChatRole(ctx, chatID, userID)
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetChatMembers(t *testing.T) {
+	ctx := context.Background()
+	chatID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT cu.user_id, cu.role`).
+		WithArgs(chatID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "role"}).
+			AddRow(ownerID, "owner").
+			AddRow(memberID, "member"))
+
+	repo := postgres.NewPostgresChatRepository(db)
+	members, err := repo.GetChatMembers(ctx, chatID)
+	require.NoError(t, err)
+	require.Equal(t, []models.ChatMember{
+		{UserID: ownerID, Role: models.ChatRoleOwner},
+		{UserID: memberID, Role: models.ChatRoleMember},
+	}, members)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetChatEncrypted(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE chat\s+SET encrypted`).
+		WithArgs(chatID, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE chat\s+SET encrypted`).
+		WithArgs(chatID, false).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := postgres.NewPostgresChatRepository(db)
+
+	require.NoError(t, repo.SetChatEncrypted(ctx, chatID, true))
+	require.ErrorIs(t, repo.SetChatEncrypted(ctx, chatID, false), messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
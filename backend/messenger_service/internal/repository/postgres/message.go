@@ -19,15 +19,34 @@ import (
 
 const (
 	getMessagesForChatOlderQuery = `
-        SELECT id, chat_id, sender_id, text, created_at, updated_at
+        SELECT id, chat_id, sender_id, text, created_at, updated_at, seq, edited_at, reply_to_id,
+               forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext
         FROM message
         WHERE chat_id = $1 AND created_at < $2
         ORDER BY created_at desc 
         LIMIT $3
     `
 
+	getMessagesForChatNewerQuery = `
+        SELECT id, chat_id, sender_id, text, created_at, updated_at, seq, edited_at, reply_to_id,
+               forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext
+        FROM message
+        WHERE chat_id = $1 AND created_at > $2
+        ORDER BY created_at
+        LIMIT $3
+    `
+
+	getMessagesForChatAfterSeqQuery = `
+        SELECT id, chat_id, sender_id, text, created_at, updated_at, seq, edited_at, reply_to_id,
+               forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext
+        FROM message
+        WHERE chat_id = $1 AND seq > $2
+        ORDER BY seq
+        LIMIT $3
+    `
+
 	getFilesQuery = `
-        SELECT mf.file_url, mf.file_type, f.filename
+        SELECT mf.file_url, mf.file_type, f.filename, f.duration_ms, f.waveform
         FROM message_file mf 
             left join files f 
             on mf.file_url = f.file_url
@@ -39,15 +58,147 @@ const (
 	VALUES ($1, $2, $3)
 	`
 
+	// messages of chats with ttl get their expiry at creation, later ttl changes do not affect them
 	saveMessageQuery = `
-        INSERT INTO message (id, chat_id, sender_id, text, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO message (id, chat_id, sender_id, text, created_at, updated_at, seq, reply_to_id,
+                             forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+                $5 + make_interval(secs => (SELECT message_ttl FROM chat WHERE id = $2)))
+`
+	saveKeyEnvelopeQuery = `
+        insert into message_key_envelope (message_id, recipient_id, device_id, envelope)
+        values ($1, $2, $3, $4)
+`
+	getKeyEnvelopesQuery = `
+        select recipient_id, device_id, envelope
+        from message_key_envelope
+        where message_id = $1
+        order by recipient_id, device_id
+`
+	getMessageByIdQuery = `
+        SELECT id, chat_id, sender_id, text, created_at, updated_at, seq, edited_at, reply_to_id,
+               forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext
+        FROM message
+        WHERE id = $1
+`
+	getMessagesByIdsQuery = `
+        SELECT id, chat_id, sender_id, text, created_at, updated_at, seq, edited_at, reply_to_id,
+               forwarded_from_sender_id, forwarded_from_chat_id, system_action, system_user_id, ciphertext
+        FROM message
+        WHERE id = any($1)
+`
+	getFilesOfMessagesQuery = `
+        SELECT mf.message_id, mf.file_url, mf.file_type, f.filename, f.duration_ms, f.waveform
+        FROM message_file mf
+            left join files f
+            on mf.file_url = f.file_url
+        WHERE mf.message_id = any($1)
+        ORDER BY mf.id
+`
+	getKeyEnvelopesOfMessagesQuery = `
+        select message_id, recipient_id, device_id, envelope
+        from message_key_envelope
+        where message_id = any($1)
+        order by message_id, recipient_id, device_id
+`
+	getReplyPreviewQuery = `
+        select m.id, m.sender_id, left(m.text, $2),
+               (select mf.file_type from message_file mf where mf.message_id = m.id order by mf.id limit 1)
+        from message m
+        where m.id = $1
+`
+	getReplyPreviewsQuery = `
+        select m.id, m.sender_id, left(m.text, $2),
+               (select mf.file_type from message_file mf where mf.message_id = m.id order by mf.id limit 1)
+        from message m
+        where m.id = any($1)
+`
+	nextMessageSeqQuery = `
+        update chat
+        set last_seq = last_seq + 1, updated_at = $2
+        where id = $1
+        returning last_seq
+`
+	nextEventSeqQuery = `
+        update chat
+        set last_seq = last_seq + 1
+        where id = $1
+        returning last_seq
+`
+	saveChatEventQuery = `
+        insert into message_event (chat_id, seq, type, message_id, user_id, created_at, reaction)
+        values ($1, $2, $3, $4, $5, $6, $7)
+`
+	addReactionQuery = `
+        insert into message_reaction (message_id, user_id, emoji, created_at)
+        values ($1, $2, $3, $4)
+        on conflict do nothing
+`
+	removeReactionQuery = `
+        delete from message_reaction
+        where message_id = $1 and user_id = $2 and emoji = $3
+`
+	// reactions are ordered by the first time the emoji was used on the message
+	getReactionsQuery = `
+        select message_id, emoji, count(*), bool_or(user_id = $2)
+        from message_reaction
+        where message_id = any($1)
+        group by message_id, emoji
+        order by message_id, min(created_at)
+`
+	updateMessageQuery = `
+        update message
+        set text = $2, updated_at = $3, edited_at = $3
+        where id = $1
+        returning chat_id
+`
+	deleteFilesQuery = `
+        delete from message_file
+        where message_id = $1
+`
+	deleteMessageQuery = `
+        delete from message
+        where id = $1
+        returning chat_id
+`
+	// messages carry their own seq, other events are stored in message_event,
+	// both share the same per chat counter so the union has no duplicate seq
+	getChatEventsSinceQuery = `
+        select seq, type, chat_id, message_id, user_id, created_at, reaction
+        from (
+            select m.seq, 'message' as type, m.chat_id, m.id as message_id, m.sender_id as user_id, m.created_at, null as reaction
+            from message m
+            where m.chat_id = $1 and m.seq > $2
+            union all
+            select e.seq, e.type, e.chat_id, e.message_id, e.user_id, e.created_at, e.reaction
+            from message_event e
+            where e.chat_id = $1 and e.seq > $2
+        ) events
+        order by seq
+        limit $3
 `
 	markReadQuery = `
         update chat_user
-        set last_read = $3
+        set last_read = $3, read_at = now()
         where chat_id = $1 and user_id = $2;
         
+`
+	// delivery only moves forward, events may reach the user out of order
+	markDeliveredQuery = `
+        update chat_user
+        set last_delivered = $3, delivered_at = now()
+        where chat_id = $1 and user_id = $2 and (last_delivered is null or last_delivered < $3)
+`
+	// reading a message implies it was delivered, even if the delivery was not reported
+	getMessageReceiptsQuery = `
+        select user_id,
+               case when last_delivered::timestamptz(3) >= $2::timestamptz(3) then coalesce(delivered_at, last_delivered)
+                    when last_read::timestamptz(3) >= $2::timestamptz(3) then coalesce(read_at, last_read)
+               end,
+               case when last_read::timestamptz(3) >= $2::timestamptz(3) then coalesce(read_at, last_read) end
+        from chat_user
+        where chat_id = $1 and user_id != $3
+        order by id
 `
 	getLastReadMessageQuery = `
 		select max(last_read) 
@@ -60,7 +211,8 @@ const (
         select * from message m 
         where m.chat_id = $1
     )
-    select c.id, c.chat_id, c.sender_id, c.text, c.created_at, c.updated_at
+    select c.id, c.chat_id, c.sender_id, c.text, c.created_at, c.updated_at, c.seq, c.edited_at, c.reply_to_id,
+           c.forwarded_from_sender_id, c.forwarded_from_chat_id, c.system_action, c.system_user_id, c.ciphertext
     from (select * from otv) c
     where c.created_at = (
         select max(created_at) 
@@ -75,6 +227,9 @@ const (
 `
 )
 
+// replyPreviewTextLen is the max number of characters of the quoted message text in the reply preview
+const replyPreviewTextLen = 100
+
 type MessageRepository struct {
 	connPool *sql.DB
 }
@@ -97,15 +252,66 @@ func (m *MessageRepository) GetMessagesForChatOlder(ctx context.Context, chatId
 	if err != nil {
 		return nil, err
 	}
+
+	messages, err := m.scanMessages(ctx, rows)
+	if err != nil {
+		logger.Error(ctx, "Unable to get messages from database for chat %v, numMessages %v, timestamp %v: %v",
+			chatId, numMessages, timestamp, err)
+		return nil, err
+	}
+	slices.Reverse(messages)
+	logger.Info(ctx, "Fetched %d messages for chat %s", len(messages), chatId)
+
+	return messages, nil
+}
+
+// GetMessagesForChatNewer returns messages created after the timestamp, the oldest first
+func (m *MessageRepository) GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID,
+	numMessages int, timestamp time.Time) ([]models.Message, error) {
+	rows, err := m.connPool.QueryContext(ctx, getMessagesForChatNewerQuery, pgtype.UUID{Bytes: chatId, Valid: true},
+		pgtype.Timestamptz{Time: timestamp, Valid: true}, numMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := m.scanMessages(ctx, rows)
+	if err != nil {
+		logger.Error(ctx, "Unable to get newer messages from database for chat %v, numMessages %v, timestamp %v: %v",
+			chatId, numMessages, timestamp, err)
+		return nil, err
+	}
+	logger.Info(ctx, "Fetched %d newer messages for chat %s", len(messages), chatId)
+
+	return messages, nil
+}
+
+// GetMessagesForChatAfterSeq returns messages with seq greater than the given one in the order they were sent.
+// Unlike created_at, seq is unique in the chat, so paging by it skips no messages sent at the same time.
+func (m *MessageRepository) GetMessagesForChatAfterSeq(ctx context.Context, chatId uuid.UUID, numMessages int, seq int64) ([]models.Message, error) {
+	rows, err := m.connPool.QueryContext(ctx, getMessagesForChatAfterSeqQuery, pgtype.UUID{Bytes: chatId, Valid: true}, seq, numMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := m.scanMessages(ctx, rows)
+	if err != nil {
+		logger.Error(ctx, "Unable to get messages from database for chat %v after seq %v: %v", chatId, seq, err)
+		return nil, err
+	}
+	return messages, nil
+}
+
+// scanMessages reads messages in the order of the rows and loads their files and reply previews
+func (m *MessageRepository) scanMessages(ctx context.Context, rows *sql.Rows) ([]models.Message, error) {
 	defer rows.Close()
 
 	var messages []models.Message
 	for rows.Next() {
 		var messagePostgres pgmodels.MessagePostgres
 		if err := rows.Scan(&messagePostgres.ID, &messagePostgres.ChatID, &messagePostgres.SenderID,
-			&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt); err != nil {
-			logger.Error(ctx, "Unable to scan message from database for chat %v, numMessages %v, timestamp %v: %v",
-				chatId, numMessages, timestamp, err)
+			&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt, &messagePostgres.Seq, &messagePostgres.EditedAt, &messagePostgres.ReplyToID,
+			&messagePostgres.ForwardedFromSenderID, &messagePostgres.ForwardedFromChatID,
+			&messagePostgres.SystemAction, &messagePostgres.SystemUserID, &messagePostgres.Ciphertext); err != nil {
 			return nil, err
 		}
 
@@ -117,7 +323,7 @@ func (m *MessageRepository) GetMessagesForChatOlder(ctx context.Context, chatId
 		}
 		for files.Next() {
 			var pgfile pgmodels.PostgresFile
-			err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name)
+			err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name, &pgfile.DurationMs, &pgfile.Waveform)
 			if err != nil {
 				logger.Error(ctx, "Unable to scan file URL for message %v: %v", messagePostgres.ID, err)
 				return nil, err
@@ -127,58 +333,492 @@ func (m *MessageRepository) GetMessagesForChatOlder(ctx context.Context, chatId
 		}
 		files.Close()
 
-		messages = slices.Insert(messages, 0, message)
-	}
-	logger.Info(ctx, "Fetched %d messages for chat %s", len(messages), chatId)
+		if err = m.fillKeyEnvelopes(ctx, &message); err != nil {
+			return nil, err
+		}
+		if err = m.fillReplyPreview(ctx, &message); err != nil {
+			return nil, err
+		}
 
+		messages = append(messages, message)
+	}
 	return messages, nil
 }
 
+// SaveMessage saves message and assigns it the next sequence number of the chat
 func (m *MessageRepository) SaveMessage(ctx context.Context, message models.Message) error {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = saveMessage(ctx, tx, message); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit message %v: %v", message.ID, err)
+		return fmt.Errorf("unable to commit message: %w", err)
+	}
+	return nil
+}
+
+// SaveMessages saves all messages or none of them. If newChat is not nil, the chat is created
+// with the participants in the same transaction, so a failed save does not leave an empty chat.
+func (m *MessageRepository) SaveMessages(ctx context.Context, messages []models.Message, newChat *models.Chat, participants []uuid.UUID) error {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if newChat != nil {
+		_, err = tx.ExecContext(ctx, insertChatQuery, newChat.ID, nil, nil, newChat.Type, newChat.CreatedAt, newChat.UpdatedAt)
+		if err != nil {
+			logger.Error(ctx, "Unable to save chat %v to database: %v", newChat.ID, err)
+			return fmt.Errorf("unable to save chat to database: %w", err)
+		}
+		for _, userId := range participants {
+			_, err = tx.ExecContext(ctx, insertChatUserQuery, newChat.ID, userId)
+			if err != nil {
+				logger.Error(ctx, "Unable to add user %v to chat %v: %v", userId, newChat.ID, err)
+				return fmt.Errorf("unable to add user to chat: %w", err)
+			}
+		}
+	}
+
+	for _, message := range messages {
+		if err = saveMessage(ctx, tx, message); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit %d messages: %v", len(messages), err)
+		return fmt.Errorf("unable to commit messages: %w", err)
+	}
+	return nil
+}
+
+// saveMessage stores the message with its files, key envelopes and poll in the transaction
+func saveMessage(ctx context.Context, tx *sql.Tx, message models.Message) error {
 	messagePostgres := pgmodels.FromMessage(message)
-	_, err := m.connPool.ExecContext(ctx, saveMessageQuery,
+
+	// locks the chat row, so concurrent senders get consecutive numbers
+	err := tx.QueryRowContext(ctx, nextMessageSeqQuery, messagePostgres.ChatID, messagePostgres.UpdatedAt).
+		Scan(&messagePostgres.Seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error(ctx, "Chat %v of message %v not found", messagePostgres.ChatID, messagePostgres.ID)
+		return messenger_service.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to update chat seq: %v", err)
+		return fmt.Errorf("unable to update chat seq: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, saveMessageQuery,
 		messagePostgres.ID, messagePostgres.ChatID, messagePostgres.SenderID,
-		messagePostgres.Text, messagePostgres.CreatedAt, messagePostgres.UpdatedAt)
+		messagePostgres.Text, messagePostgres.CreatedAt, messagePostgres.UpdatedAt, messagePostgres.Seq, messagePostgres.ReplyToID,
+		messagePostgres.ForwardedFromSenderID, messagePostgres.ForwardedFromChatID,
+		messagePostgres.SystemAction, messagePostgres.SystemUserID, messagePostgres.Ciphertext)
 	if err != nil {
 		logger.Error(ctx, "Unable to save message %v to database: %s", messagePostgres.ID, err.Error())
 		return fmt.Errorf("unable to save message to database: %w", err)
 	}
+	if message.Encrypted != nil {
+		for _, envelope := range message.Encrypted.Envelopes {
+			_, err = tx.ExecContext(ctx, saveKeyEnvelopeQuery, messagePostgres.ID,
+				pgtype.UUID{Bytes: envelope.RecipientID, Valid: true}, envelope.DeviceID, envelope.Key)
+			if err != nil {
+				logger.Error(ctx, "Unable to save key envelope of message %v for device %s: %v", messagePostgres.ID, envelope.DeviceID, err)
+				return fmt.Errorf("unable to save key envelope to database: %w", err)
+			}
+		}
+	}
 	for _, file := range messagePostgres.Attachments {
-		_, err = m.connPool.ExecContext(ctx, saveFilesQuery,
+		_, err = tx.ExecContext(ctx, saveFilesQuery,
 			messagePostgres.ID, file.URL, file.DisplayType)
 		if err != nil {
 			logger.Error(ctx, "Unable to save file URL %v for message %v to database: %s", file.URL, messagePostgres.ID, err.Error())
 			return fmt.Errorf("unable to save file URL to database: %w", err)
 		}
 	}
+	if message.Poll != nil {
+		if err = savePoll(ctx, tx, message.ID, message.Poll); err != nil {
+			return err
+		}
+	}
+	// forwarded stickers were not picked by the sender
+	if message.ForwardedFrom == nil {
+		if err = touchRecentStickers(ctx, tx, message.SenderID, message.Attachments, message.CreatedAt); err != nil {
+			return err
+		}
+	}
 
-	_, err = m.connPool.ExecContext(ctx, `update chat set updated_at = $1 where id = $2`,
-		messagePostgres.UpdatedAt, messagePostgres.ChatID)
+	return nil
+}
+
+// UpdateMessage replaces text and attachments of the message and returns sequence number of the edit event
+func (m *MessageRepository) UpdateMessage(ctx context.Context, message models.Message) (int64, error) {
+	messagePostgres := pgmodels.FromMessage(message)
+
+	tx, err := m.connPool.BeginTx(ctx, nil)
 	if err != nil {
-		logger.Error(ctx, "Unable to update chat updated_at: %v", err)
-		return fmt.Errorf("unable to update chat updated_at: %w", err)
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
 	}
-	return nil
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, updateMessageQuery,
+		messagePostgres.ID, messagePostgres.Text, messagePostgres.UpdatedAt).Scan(&messagePostgres.ChatID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, messenger_service.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to update message %v: %v", messagePostgres.ID, err)
+		return 0, fmt.Errorf("unable to update message in database: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, deleteFilesQuery, messagePostgres.ID)
+	if err != nil {
+		logger.Error(ctx, "Unable to delete files of message %v: %v", messagePostgres.ID, err)
+		return 0, fmt.Errorf("unable to delete message files from database: %w", err)
+	}
+	for _, file := range messagePostgres.Attachments {
+		_, err = tx.ExecContext(ctx, saveFilesQuery,
+			messagePostgres.ID, file.URL, file.DisplayType)
+		if err != nil {
+			logger.Error(ctx, "Unable to save file URL %v for message %v to database: %s", file.URL, messagePostgres.ID, err.Error())
+			return 0, fmt.Errorf("unable to save file URL to database: %w", err)
+		}
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventMessageEdited,
+		ChatID:    messagePostgres.ChatID.Bytes,
+		MessageID: message.ID,
+		UserID:    message.SenderID,
+		CreatedAt: message.UpdatedAt,
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save edit event of message %v: %v", message.ID, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit update of message %v: %v", message.ID, err)
+		return 0, fmt.Errorf("unable to commit message update: %w", err)
+	}
+	return seq, nil
 }
 
-func (m *MessageRepository) DeleteMessage(ctx context.Context, messageId uuid.UUID) error {
-	_, err := m.connPool.ExecContext(ctx, "DELETE FROM message WHERE id = $1", messageId)
+// saveChatEvent assigns the next sequence number of the chat to the event and stores it
+func (m *MessageRepository) saveChatEvent(ctx context.Context, tx *sql.Tx, event models.ChatEvent) (int64, error) {
+	var seq int64
+	err := tx.QueryRowContext(ctx, nextEventSeqQuery, pgtype.UUID{Bytes: event.ChatID, Valid: true}).Scan(&seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, messenger_service.ErrNotFound
+	} else if err != nil {
+		return 0, fmt.Errorf("unable to update chat seq: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, saveChatEventQuery,
+		pgtype.UUID{Bytes: event.ChatID, Valid: true}, seq, string(event.Type),
+		pgtype.UUID{Bytes: event.MessageID, Valid: event.MessageID != uuid.Nil},
+		pgtype.UUID{Bytes: event.UserID, Valid: event.UserID != uuid.Nil},
+		pgtype.Timestamptz{Time: event.CreatedAt, Valid: true},
+		pgtype.Text{String: event.Reaction, Valid: len(event.Reaction) != 0})
 	if err != nil {
-		logger.Error(ctx, "Unable to delete message %v from database: %s", messageId, err.Error())
-		return fmt.Errorf("unable to delete message from database: %w", err)
+		return 0, fmt.Errorf("unable to save chat event: %w", err)
 	}
-	return nil
+	return seq, nil
 }
-func (m *MessageRepository) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId uuid.UUID, userId uuid.UUID) error {
-	_, err := m.connPool.ExecContext(ctx, markReadQuery, chatId, userId, pgtype.Timestamptz{Time: timestamp, Valid: true})
+
+// DeleteMessage deletes and unpins message, returns sequence number of the deletion event
+func (m *MessageRepository) DeleteMessage(ctx context.Context, messageId uuid.UUID) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// pinned message is unpinned together with the deletion
+	if _, err = tx.ExecContext(ctx, unpinDeletedMessageQuery, messageId); err != nil {
+		logger.Error(ctx, "Unable to unpin deleted message %v: %v", messageId, err)
+		return 0, fmt.Errorf("unable to unpin deleted message: %w", err)
+	}
+
+	var chatId pgtype.UUID
+	err = tx.QueryRowContext(ctx, deleteMessageQuery, messageId).Scan(&chatId)
 	if errors.Is(err, sql.ErrNoRows) {
-		logger.Error(ctx, "Unable to find chat %v with user %v: %s", chatId, userId, err.Error())
-		return messenger_service.ErrNotFound
+		return 0, messenger_service.ErrNotFound
 	} else if err != nil {
+		logger.Error(ctx, "Unable to delete message %v from database: %s", messageId, err.Error())
+		return 0, fmt.Errorf("unable to delete message from database: %w", err)
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventMessageDeleted,
+		ChatID:    chatId.Bytes,
+		MessageID: messageId,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save delete event of message %v: %v", messageId, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit deletion of message %v: %v", messageId, err)
+		return 0, fmt.Errorf("unable to commit message deletion: %w", err)
+	}
+	return seq, nil
+}
+
+// AddReaction stores reaction of the user and returns sequence number of the reaction event
+func (m *MessageRepository) AddReaction(ctx context.Context, reaction models.Reaction) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, addReactionQuery,
+		pgtype.UUID{Bytes: reaction.MessageID, Valid: true}, pgtype.UUID{Bytes: reaction.UserID, Valid: true},
+		reaction.Emoji, pgtype.Timestamptz{Time: reaction.CreatedAt, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to save reaction %v of user %v to message %v: %v", reaction.Emoji, reaction.UserID, reaction.MessageID, err)
+		return 0, fmt.Errorf("unable to save reaction to database: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return 0, messenger_service.ErrAlreadyReacted
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventReactionAdded,
+		ChatID:    reaction.ChatID,
+		MessageID: reaction.MessageID,
+		UserID:    reaction.UserID,
+		CreatedAt: reaction.CreatedAt,
+		Reaction:  reaction.Emoji,
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save reaction event of message %v: %v", reaction.MessageID, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit reaction to message %v: %v", reaction.MessageID, err)
+		return 0, fmt.Errorf("unable to commit reaction: %w", err)
+	}
+	return seq, nil
+}
+
+// RemoveReaction deletes reaction of the user and returns sequence number of the removal event
+func (m *MessageRepository) RemoveReaction(ctx context.Context, reaction models.Reaction) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, removeReactionQuery,
+		pgtype.UUID{Bytes: reaction.MessageID, Valid: true}, pgtype.UUID{Bytes: reaction.UserID, Valid: true}, reaction.Emoji)
+	if err != nil {
+		logger.Error(ctx, "Unable to delete reaction %v of user %v to message %v: %v", reaction.Emoji, reaction.UserID, reaction.MessageID, err)
+		return 0, fmt.Errorf("unable to delete reaction from database: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return 0, messenger_service.ErrNotFound
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventReactionRemoved,
+		ChatID:    reaction.ChatID,
+		MessageID: reaction.MessageID,
+		UserID:    reaction.UserID,
+		CreatedAt: reaction.CreatedAt,
+		Reaction:  reaction.Emoji,
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save reaction removal event of message %v: %v", reaction.MessageID, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit reaction removal from message %v: %v", reaction.MessageID, err)
+		return 0, fmt.Errorf("unable to commit reaction removal: %w", err)
+	}
+	return seq, nil
+}
+
+// GetReactions returns reaction counts of the messages, Reacted is set for reactions of the user
+func (m *MessageRepository) GetReactions(ctx context.Context, messageIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID][]models.ReactionCount, error) {
+	rows, err := m.connPool.QueryContext(ctx, getReactionsQuery, messageIds, pgtype.UUID{Bytes: userId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to get reactions of %d messages: %v", len(messageIds), err)
+		return nil, fmt.Errorf("unable to get reactions from database: %w", err)
+	}
+	defer rows.Close()
+
+	reactions := make(map[uuid.UUID][]models.ReactionCount)
+	for rows.Next() {
+		var reactionPostgres pgmodels.ReactionCountPostgres
+		if err = rows.Scan(&reactionPostgres.MessageID, &reactionPostgres.Emoji,
+			&reactionPostgres.Count, &reactionPostgres.Reacted); err != nil {
+			logger.Error(ctx, "Unable to scan reaction: %v", err)
+			return nil, fmt.Errorf("unable to scan reaction: %w", err)
+		}
+		messageId := uuid.UUID(reactionPostgres.MessageID.Bytes)
+		reactions[messageId] = append(reactions[messageId], reactionPostgres.ToReactionCount())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read reactions: %w", err)
+	}
+	return reactions, nil
+}
+
+// UpdateLastReadTs updates last read timestamp of the user and returns sequence number of the read event.
+// The event is stored with the read message and the read timestamp as its creation time.
+func (m *MessageRepository) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, markReadQuery, chatId, userId, pgtype.Timestamptz{Time: timestamp, Valid: true})
+	if err != nil {
 		logger.Error(ctx, "Unable to update last read %v for chat %v with user %v: %s", timestamp, chatId, userId, err.Error())
-		return fmt.Errorf("unable to update last read message in database: %w", err)
+		return 0, fmt.Errorf("unable to update last read message in database: %w", err)
 	}
-	return nil
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		logger.Error(ctx, "Unable to find chat %v with user %v", chatId, userId)
+		return 0, messenger_service.ErrNotFound
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventMessageRead,
+		ChatID:    chatId,
+		MessageID: messageId,
+		UserID:    userId,
+		CreatedAt: timestamp,
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save read event for chat %v with user %v: %v", chatId, userId, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit last read for chat %v with user %v: %v", chatId, userId, err)
+		return 0, fmt.Errorf("unable to commit last read: %w", err)
+	}
+	return seq, nil
+}
+
+// UpdateLastDeliveredTs records that messages created up to timestamp reached the user.
+// Returns false if a message that is not older was recorded already.
+func (m *MessageRepository) UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error) {
+	res, err := m.connPool.ExecContext(ctx, markDeliveredQuery, pgtype.UUID{Bytes: chatId, Valid: true},
+		pgtype.UUID{Bytes: userId, Valid: true}, pgtype.Timestamptz{Time: timestamp, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to update last delivered %v for chat %v with user %v: %v", timestamp, chatId, userId, err)
+		return false, fmt.Errorf("unable to update last delivered message in database: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to update last delivered message in database: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// GetMessageReceipts returns delivery and read times of the message for every participant except the sender
+func (m *MessageRepository) GetMessageReceipts(ctx context.Context, message models.Message) ([]models.MessageReceipt, error) {
+	rows, err := m.connPool.QueryContext(ctx, getMessageReceiptsQuery, pgtype.UUID{Bytes: message.ChatID, Valid: true},
+		pgtype.Timestamptz{Time: message.CreatedAt, Valid: true}, pgtype.UUID{Bytes: message.SenderID, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to get receipts of message %v: %v", message.ID, err)
+		return nil, fmt.Errorf("unable to get message receipts from database: %w", err)
+	}
+	defer rows.Close()
+
+	var receipts []models.MessageReceipt
+	for rows.Next() {
+		var (
+			userId              pgtype.UUID
+			deliveredAt, readAt pgtype.Timestamptz
+		)
+		if err = rows.Scan(&userId, &deliveredAt, &readAt); err != nil {
+			logger.Error(ctx, "Unable to scan receipt of message %v: %v", message.ID, err)
+			return nil, fmt.Errorf("unable to scan message receipt: %w", err)
+		}
+
+		receipt := models.MessageReceipt{UserID: userId.Bytes}
+		if deliveredAt.Valid {
+			receipt.DeliveredAt = &deliveredAt.Time
+		}
+		if readAt.Valid {
+			receipt.ReadAt = &readAt.Time
+		}
+		receipts = append(receipts, receipt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read message receipts: %w", err)
+	}
+	return receipts, nil
+}
+
+// GetChatEventsSince returns up to limit chat events with sequence number greater than seq in order
+func (m *MessageRepository) GetChatEventsSince(ctx context.Context, chatId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error) {
+	rows, err := m.connPool.QueryContext(ctx, getChatEventsSinceQuery, pgtype.UUID{Bytes: chatId, Valid: true}, seq, limit)
+	if err != nil {
+		logger.Error(ctx, "Unable to get events of chat %v since %d: %v", chatId, seq, err)
+		return nil, fmt.Errorf("unable to get chat events from database: %w", err)
+	}
+
+	var events []models.ChatEvent
+	for rows.Next() {
+		var eventPostgres pgmodels.ChatEventPostgres
+		if err = rows.Scan(&eventPostgres.Seq, &eventPostgres.Type, &eventPostgres.ChatID,
+			&eventPostgres.MessageID, &eventPostgres.UserID, &eventPostgres.CreatedAt, &eventPostgres.Reaction); err != nil {
+			rows.Close()
+			logger.Error(ctx, "Unable to scan event of chat %v: %v", chatId, err)
+			return nil, fmt.Errorf("unable to scan chat event: %w", err)
+		}
+		events = append(events, eventPostgres.ToChatEvent())
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read chat events: %w", err)
+	}
+
+	var messageIds []uuid.UUID
+	for _, event := range events {
+		if event.Type == models.ChatEventMessageSent || event.Type == models.ChatEventMessageEdited {
+			messageIds = append(messageIds, event.MessageID)
+		}
+	}
+	messages, err := m.GetMessagesByIds(ctx, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		if events[i].Type != models.ChatEventMessageSent && events[i].Type != models.ChatEventMessageEdited {
+			continue
+		}
+		// a message deleted later is missing, the deletion event follows
+		if message, ok := messages[events[i].MessageID]; ok {
+			events[i].Message = &message
+		}
+	}
+
+	return events, nil
 }
 
 func (m *MessageRepository) GetLastReadTs(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (*time.Time, error) {
@@ -202,7 +842,9 @@ func (m *MessageRepository) GetLastChatMessage(ctx context.Context, chatId uuid.
 	var messagePostgres pgmodels.MessagePostgres
 	err := m.connPool.QueryRowContext(ctx, getLastChatMessage, pgtype.UUID{Bytes: chatId, Valid: true}).Scan(
 		&messagePostgres.ID, &messagePostgres.ChatID, &messagePostgres.SenderID,
-		&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt)
+		&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt, &messagePostgres.Seq, &messagePostgres.EditedAt, &messagePostgres.ReplyToID,
+		&messagePostgres.ForwardedFromSenderID, &messagePostgres.ForwardedFromChatID,
+		&messagePostgres.SystemAction, &messagePostgres.SystemUserID, &messagePostgres.Ciphertext)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	} else if err != nil {
@@ -218,7 +860,7 @@ func (m *MessageRepository) GetLastChatMessage(ctx context.Context, chatId uuid.
 
 	for files.Next() {
 		var pgfile pgmodels.PostgresFile
-		err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name)
+		err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name, &pgfile.DurationMs, &pgfile.Waveform)
 		if err != nil {
 			logger.Error(ctx, "Unable to scan file URL for message %v: %v", messagePostgres.ID, err)
 			return nil, err
@@ -228,14 +870,22 @@ func (m *MessageRepository) GetLastChatMessage(ctx context.Context, chatId uuid.
 	}
 
 	message := messagePostgres.ToMessage()
+	if err = m.fillKeyEnvelopes(ctx, &message); err != nil {
+		return nil, err
+	}
+	if err = m.fillReplyPreview(ctx, &message); err != nil {
+		return nil, err
+	}
 	return &message, nil
 }
 
 func (m *MessageRepository) GetMessageById(ctx context.Context, messageId uuid.UUID) (models.Message, error) {
 	var messagePostgres pgmodels.MessagePostgres
-	err := m.connPool.QueryRowContext(ctx, "SELECT id, chat_id, sender_id, text, created_at, updated_at FROM message WHERE id = $1", messageId).Scan(
+	err := m.connPool.QueryRowContext(ctx, getMessageByIdQuery, messageId).Scan(
 		&messagePostgres.ID, &messagePostgres.ChatID, &messagePostgres.SenderID,
-		&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt)
+		&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt, &messagePostgres.Seq, &messagePostgres.EditedAt, &messagePostgres.ReplyToID,
+		&messagePostgres.ForwardedFromSenderID, &messagePostgres.ForwardedFromChatID,
+		&messagePostgres.SystemAction, &messagePostgres.SystemUserID, &messagePostgres.Ciphertext)
 	if errors.Is(err, sql.ErrNoRows) {
 		return models.Message{}, messenger_service.ErrNotFound
 	} else if err != nil {
@@ -251,7 +901,7 @@ func (m *MessageRepository) GetMessageById(ctx context.Context, messageId uuid.U
 
 	for files.Next() {
 		var pgfile pgmodels.PostgresFile
-		err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name)
+		err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name, &pgfile.DurationMs, &pgfile.Waveform)
 		if err != nil {
 			logger.Error(ctx, "Unable to scan file URL for message %v: %v", messagePostgres.ID, err)
 			return models.Message{}, err
@@ -261,9 +911,226 @@ func (m *MessageRepository) GetMessageById(ctx context.Context, messageId uuid.U
 	}
 
 	message := messagePostgres.ToMessage()
+	if err = m.fillKeyEnvelopes(ctx, &message); err != nil {
+		return models.Message{}, err
+	}
+	if err = m.fillReplyPreview(ctx, &message); err != nil {
+		return models.Message{}, err
+	}
 	return message, nil
 }
 
+// GetMessagesByIds loads the messages with files, key envelopes and reply previews in a few queries.
+// Messages that do not exist are missing from the result.
+func (m *MessageRepository) GetMessagesByIds(ctx context.Context, messageIds []uuid.UUID) (map[uuid.UUID]models.Message, error) {
+	if len(messageIds) == 0 {
+		return map[uuid.UUID]models.Message{}, nil
+	}
+
+	rows, err := m.connPool.QueryContext(ctx, getMessagesByIdsQuery, messageIds)
+	if err != nil {
+		logger.Error(ctx, "Unable to get %d messages from database: %v", len(messageIds), err)
+		return nil, fmt.Errorf("unable to get messages from database: %w", err)
+	}
+
+	var found []pgmodels.MessagePostgres
+	for rows.Next() {
+		var messagePostgres pgmodels.MessagePostgres
+		if err = rows.Scan(&messagePostgres.ID, &messagePostgres.ChatID, &messagePostgres.SenderID,
+			&messagePostgres.Text, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt, &messagePostgres.Seq, &messagePostgres.EditedAt, &messagePostgres.ReplyToID,
+			&messagePostgres.ForwardedFromSenderID, &messagePostgres.ForwardedFromChatID,
+			&messagePostgres.SystemAction, &messagePostgres.SystemUserID, &messagePostgres.Ciphertext); err != nil {
+			rows.Close()
+			logger.Error(ctx, "Unable to scan message: %v", err)
+			return nil, fmt.Errorf("unable to scan message: %w", err)
+		}
+		found = append(found, messagePostgres)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read messages: %w", err)
+	}
+	if len(found) == 0 {
+		return map[uuid.UUID]models.Message{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(found))
+	for _, messagePostgres := range found {
+		ids = append(ids, messagePostgres.ID.Bytes)
+	}
+	files, err := m.getFilesOfMessages(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(map[uuid.UUID]models.Message, len(found))
+	var encryptedIds, replyToIds []uuid.UUID
+	for _, messagePostgres := range found {
+		messagePostgres.Attachments = files[messagePostgres.ID.Bytes]
+		message := messagePostgres.ToMessage()
+		if message.Encrypted != nil {
+			encryptedIds = append(encryptedIds, message.ID)
+		}
+		if message.ReplyToID != uuid.Nil {
+			replyToIds = append(replyToIds, message.ReplyToID)
+		}
+		messages[message.ID] = message
+	}
+
+	if err = m.fillKeyEnvelopesOfMessages(ctx, messages, encryptedIds); err != nil {
+		return nil, err
+	}
+	if err = m.fillReplyPreviews(ctx, messages, replyToIds); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// getFilesOfMessages returns files of the messages in the order they were attached
+func (m *MessageRepository) getFilesOfMessages(ctx context.Context, messageIds []uuid.UUID) (map[uuid.UUID][]pgmodels.PostgresFile, error) {
+	rows, err := m.connPool.QueryContext(ctx, getFilesOfMessagesQuery, messageIds)
+	if err != nil {
+		logger.Error(ctx, "Unable to get files of %d messages: %v", len(messageIds), err)
+		return nil, fmt.Errorf("unable to get message files from database: %w", err)
+	}
+	defer rows.Close()
+
+	files := make(map[uuid.UUID][]pgmodels.PostgresFile)
+	for rows.Next() {
+		var (
+			messageId pgtype.UUID
+			pgfile    pgmodels.PostgresFile
+		)
+		if err = rows.Scan(&messageId, &pgfile.URL, &pgfile.DisplayType, &pgfile.Name, &pgfile.DurationMs, &pgfile.Waveform); err != nil {
+			logger.Error(ctx, "Unable to scan message file: %v", err)
+			return nil, fmt.Errorf("unable to scan message file: %w", err)
+		}
+		files[messageId.Bytes] = append(files[messageId.Bytes], pgfile)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read message files: %w", err)
+	}
+	return files, nil
+}
+
+// fillKeyEnvelopesOfMessages loads key envelopes of the encrypted messages
+func (m *MessageRepository) fillKeyEnvelopesOfMessages(ctx context.Context, messages map[uuid.UUID]models.Message, encryptedIds []uuid.UUID) error {
+	if len(encryptedIds) == 0 {
+		return nil
+	}
+
+	rows, err := m.connPool.QueryContext(ctx, getKeyEnvelopesOfMessagesQuery, encryptedIds)
+	if err != nil {
+		logger.Error(ctx, "Unable to get key envelopes of %d messages: %v", len(encryptedIds), err)
+		return fmt.Errorf("unable to get key envelopes from database: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageId, recipientId pgtype.UUID
+			envelope               models.KeyEnvelope
+		)
+		if err = rows.Scan(&messageId, &recipientId, &envelope.DeviceID, &envelope.Key); err != nil {
+			logger.Error(ctx, "Unable to scan key envelope: %v", err)
+			return fmt.Errorf("unable to scan key envelope: %w", err)
+		}
+		envelope.RecipientID = recipientId.Bytes
+		// Encrypted is a pointer, the message in the map shares it
+		message := messages[messageId.Bytes]
+		message.Encrypted.Envelopes = append(message.Encrypted.Envelopes, envelope)
+	}
+	return rows.Err()
+}
+
+// fillReplyPreviews sets previews of the quoted messages, deleted messages get a placeholder preview
+func (m *MessageRepository) fillReplyPreviews(ctx context.Context, messages map[uuid.UUID]models.Message, replyToIds []uuid.UUID) error {
+	if len(replyToIds) == 0 {
+		return nil
+	}
+
+	rows, err := m.connPool.QueryContext(ctx, getReplyPreviewsQuery, replyToIds, replyPreviewTextLen)
+	if err != nil {
+		logger.Error(ctx, "Unable to get previews of %d quoted messages: %v", len(replyToIds), err)
+		return fmt.Errorf("unable to get reply previews from database: %w", err)
+	}
+	defer rows.Close()
+
+	previews := make(map[uuid.UUID]*models.MessagePreview)
+	for rows.Next() {
+		var previewPostgres pgmodels.MessagePreviewPostgres
+		if err = rows.Scan(&previewPostgres.ID, &previewPostgres.SenderID, &previewPostgres.Text, &previewPostgres.AttachmentType); err != nil {
+			logger.Error(ctx, "Unable to scan reply preview: %v", err)
+			return fmt.Errorf("unable to scan reply preview: %w", err)
+		}
+		previews[previewPostgres.ID.Bytes] = previewPostgres.ToMessagePreview()
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("unable to read reply previews: %w", err)
+	}
+
+	for id, message := range messages {
+		if message.ReplyToID == uuid.Nil {
+			continue
+		}
+		message.ReplyTo = previews[message.ReplyToID]
+		if message.ReplyTo == nil {
+			message.ReplyTo = &models.MessagePreview{ID: message.ReplyToID, Deleted: true}
+		}
+		messages[id] = message
+	}
+	return nil
+}
+
+// fillKeyEnvelopes loads key envelopes of the encrypted message, plain messages are left as is
+func (m *MessageRepository) fillKeyEnvelopes(ctx context.Context, message *models.Message) error {
+	if message.Encrypted == nil {
+		return nil
+	}
+
+	rows, err := m.connPool.QueryContext(ctx, getKeyEnvelopesQuery, pgtype.UUID{Bytes: message.ID, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to get key envelopes of message %v: %v", message.ID, err)
+		return fmt.Errorf("unable to get key envelopes from database: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			recipientId pgtype.UUID
+			envelope    models.KeyEnvelope
+		)
+		if err = rows.Scan(&recipientId, &envelope.DeviceID, &envelope.Key); err != nil {
+			logger.Error(ctx, "Unable to scan key envelope of message %v: %v", message.ID, err)
+			return fmt.Errorf("unable to scan key envelope: %w", err)
+		}
+		envelope.RecipientID = recipientId.Bytes
+		message.Encrypted.Envelopes = append(message.Encrypted.Envelopes, envelope)
+	}
+	return rows.Err()
+}
+
+// fillReplyPreview sets preview of the quoted message, deleted message gets a placeholder preview
+func (m *MessageRepository) fillReplyPreview(ctx context.Context, message *models.Message) error {
+	if message.ReplyToID == uuid.Nil {
+		return nil
+	}
+
+	var previewPostgres pgmodels.MessagePreviewPostgres
+	err := m.connPool.QueryRowContext(ctx, getReplyPreviewQuery, pgtype.UUID{Bytes: message.ReplyToID, Valid: true}, replyPreviewTextLen).Scan(
+		&previewPostgres.ID, &previewPostgres.SenderID, &previewPostgres.Text, &previewPostgres.AttachmentType)
+	if errors.Is(err, sql.ErrNoRows) {
+		message.ReplyTo = &models.MessagePreview{ID: message.ReplyToID, Deleted: true}
+		return nil
+	} else if err != nil {
+		logger.Error(ctx, "Unable to get preview of message %v replied by %v: %v", message.ReplyToID, message.ID, err)
+		return fmt.Errorf("unable to get reply preview from database: %w", err)
+	}
+
+	message.ReplyTo = previewPostgres.ToMessagePreview()
+	return nil
+}
+
 func (m *MessageRepository) GetNumUnreadMessages(ctx context.Context, chatId, userId uuid.UUID) (int, error) {
 	var numUnread int
 	err := m.connPool.QueryRowContext(ctx, getNumUnreadMessagesQuery, pgtype.UUID{Bytes: userId, Valid: true}, pgtype.UUID{Bytes: chatId, Valid: true}).Scan(&numUnread)
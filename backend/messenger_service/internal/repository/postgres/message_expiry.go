@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// getExpiredMessagesQuery skips messages locked by other reapers, so replicas do not delete the same batch
+const getExpiredMessagesQuery = `
+        select id, chat_id
+        from message
+        where expires_at <= $1
+        order by expires_at
+        limit $2
+        for update skip locked
+`
+
+// DeleteExpiredMessages deletes up to limit messages expired by now together with their files and pins.
+// Returns deletion events with their sequence numbers, empty when nothing has expired.
+func (m *MessageRepository) DeleteExpiredMessages(ctx context.Context, now time.Time, limit int) ([]models.ChatEvent, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return nil, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, getExpiredMessagesQuery, now, limit)
+	if err != nil {
+		logger.Error(ctx, "Unable to get expired messages: %v", err)
+		return nil, fmt.Errorf("unable to get expired messages: %w", err)
+	}
+
+	var events []models.ChatEvent
+	for rows.Next() {
+		var messageId, chatId pgtype.UUID
+		if err = rows.Scan(&messageId, &chatId); err != nil {
+			rows.Close()
+			logger.Error(ctx, "Unable to scan expired message: %v", err)
+			return nil, fmt.Errorf("unable to scan expired message: %w", err)
+		}
+		events = append(events, models.ChatEvent{
+			Type:      models.ChatEventMessageDeleted,
+			ChatID:    chatId.Bytes,
+			MessageID: messageId.Bytes,
+			CreatedAt: now,
+		})
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to get expired messages: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	for i, event := range events {
+		if _, err = tx.ExecContext(ctx, unpinDeletedMessageQuery, event.MessageID); err != nil {
+			logger.Error(ctx, "Unable to unpin expired message %v: %v", event.MessageID, err)
+			return nil, fmt.Errorf("unable to unpin expired message: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, deleteFilesQuery, event.MessageID); err != nil {
+			logger.Error(ctx, "Unable to delete files of expired message %v: %v", event.MessageID, err)
+			return nil, fmt.Errorf("unable to delete expired message files: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, deleteMessageQuery, event.MessageID); err != nil {
+			logger.Error(ctx, "Unable to delete expired message %v: %v", event.MessageID, err)
+			return nil, fmt.Errorf("unable to delete expired message: %w", err)
+		}
+
+		events[i].Seq, err = m.saveChatEvent(ctx, tx, event)
+		if err != nil {
+			logger.Error(ctx, "Unable to save delete event of expired message %v: %v", event.MessageID, err)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit deletion of expired messages: %v", err)
+		return nil, fmt.Errorf("unable to commit expired messages deletion: %w", err)
+	}
+	return events, nil
+}
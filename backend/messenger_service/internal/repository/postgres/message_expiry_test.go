@@ -0,0 +1,76 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+func TestDeleteExpiredMessages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	chatID := uuid.New()
+	messageIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`select id, chat_id\s+from message`).
+		WithArgs(now, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "chat_id"}).
+			AddRow(messageIDs[0], chatID).
+			AddRow(messageIDs[1], chatID))
+	for i, messageID := range messageIDs {
+		mock.ExpectExec(`delete from chat_pinned_message`).
+			WithArgs(messageID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`delete from message_file`).
+			WithArgs(messageID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`delete from message\s+where id`).
+			WithArgs(messageID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`update chat`).
+			WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(7 + i)))
+		mock.ExpectExec(`insert into message_event`).
+			WithArgs(sqlmock.AnyArg(), int64(7+i), string(models.ChatEventMessageDeleted),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	events, err := repo.DeleteExpiredMessages(ctx, now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []models.ChatEvent{
+		{Seq: 7, Type: models.ChatEventMessageDeleted, ChatID: chatID, MessageID: messageIDs[0], CreatedAt: now},
+		{Seq: 8, Type: models.ChatEventMessageDeleted, ChatID: chatID, MessageID: messageIDs[1], CreatedAt: now},
+	}, events)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteExpiredMessages_NothingExpired(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`select id, chat_id\s+from message`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "chat_id"}))
+	mock.ExpectRollback()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	events, err := repo.DeleteExpiredMessages(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,67 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+func TestGetMessageReceipts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	message := models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New(), CreatedAt: now.Add(-time.Hour)}
+	reader, receiver, absent := uuid.New(), uuid.New(), uuid.New()
+	deliveredAt, readAt := now.Add(-30*time.Minute), now.Add(-10*time.Minute)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`select user_id,`).
+		WithArgs(message.ChatID, message.CreatedAt, message.SenderID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "delivered_at", "read_at"}).
+			AddRow(reader, deliveredAt, readAt).
+			AddRow(receiver, deliveredAt, nil).
+			AddRow(absent, nil, nil))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	receipts, err := repo.GetMessageReceipts(ctx, message)
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+	require.Equal(t, reader, receipts[0].UserID)
+	require.True(t, readAt.Equal(*receipts[0].ReadAt))
+	require.True(t, deliveredAt.Equal(*receipts[1].DeliveredAt))
+	require.Nil(t, receipts[1].ReadAt)
+	require.Nil(t, receipts[2].DeliveredAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateLastDeliveredTs(t *testing.T) {
+	ctx := context.Background()
+	chatID, userID := uuid.New(), uuid.New()
+	ts := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// an older delivery does not move the timestamp back and is not an error
+	mock.ExpectExec(`update chat_user\s+set last_delivered`).
+		WithArgs(chatID, userID, ts).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	updated, err := repo.UpdateLastDeliveredTs(ctx, ts, chatID, userID)
+	require.NoError(t, err)
+	require.False(t, updated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
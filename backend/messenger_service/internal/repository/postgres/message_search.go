@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// searchMessagesQuery looks only through chats of the user, the newest messages first.
+// The query is stemmed both as russian and as english text, like the indexed messages.
+// The server can not read end-to-end encrypted messages, so they are never found.
+const searchMessagesQuery = `
+        select m.id, ts_headline('russian', m.text, q, $7)
+        from message m
+            join chat_user cu on cu.chat_id = m.chat_id and cu.user_id = $1
+            cross join (select websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2) as q) search_query
+        where m.text_tsv @@ q
+          and m.ciphertext is null
+          and ($3::uuid is null or m.chat_id = $3)
+          and ($4::timestamptz is null or m.created_at >= $4)
+          and ($5::timestamptz is null or m.created_at < $5)
+        order by m.created_at desc
+        limit $6
+`
+
+// searchHeadlineOptions keep up to two fragments of the message around the matches
+var searchHeadlineOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=20, MinWords=5, MaxFragments=2",
+	models.SearchMatchStart, models.SearchMatchEnd)
+
+// SearchMessages returns messages of the user chats matching the query with highlighted snippets
+func (m *MessageRepository) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	var from, to pgtype.Timestamptz
+	if query.From != nil {
+		from = pgtype.Timestamptz{Time: *query.From, Valid: true}
+	}
+	if query.To != nil {
+		to = pgtype.Timestamptz{Time: *query.To, Valid: true}
+	}
+
+	rows, err := m.connPool.QueryContext(ctx, searchMessagesQuery,
+		pgtype.UUID{Bytes: userId, Valid: true}, query.Text,
+		pgtype.UUID{Bytes: query.ChatID, Valid: query.ChatID != uuid.Nil},
+		from, to, query.Limit, searchHeadlineOptions)
+	if err != nil {
+		logger.Error(ctx, "Unable to search messages of user %v: %v", userId, err)
+		return nil, fmt.Errorf("unable to search messages: %w", err)
+	}
+
+	type found struct {
+		id      pgtype.UUID
+		snippet pgtype.Text
+	}
+	var matches []found
+	for rows.Next() {
+		var match found
+		if err = rows.Scan(&match.id, &match.snippet); err != nil {
+			rows.Close()
+			logger.Error(ctx, "Unable to scan found message: %v", err)
+			return nil, fmt.Errorf("unable to scan found message: %w", err)
+		}
+		matches = append(matches, match)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read found messages: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.id.Bytes)
+	}
+	messages, err := m.GetMessagesByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.MessageSearchResult, 0, len(matches))
+	for _, match := range matches {
+		message, ok := messages[match.id.Bytes]
+		if !ok {
+			// deleted after the search
+			continue
+		}
+		results = append(results, models.MessageSearchResult{Message: message, Snippet: match.snippet.String})
+	}
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+	"quickflow/shared/testutil"
+)
+
+func TestSearchMessages(t *testing.T) {
+	ctx := context.Background()
+	userID, chatID := uuid.New(), uuid.New()
+	foundID, deletedID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	snippet := models.SearchMatchStart + "report" + models.SearchMatchEnd + " is ready"
+	mock.ExpectQuery(`select m.id, ts_headline`).
+		WithArgs(sqlmock.AnyArg(), "report", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 20, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "snippet"}).
+			AddRow(foundID, snippet).
+			AddRow(deletedID, "deleted "+models.SearchMatchStart+"report"+models.SearchMatchEnd))
+	// the deleted message is missing from the batch
+	mock.ExpectQuery(`SELECT id, chat_id, sender_id, text`).
+		WithArgs([]uuid.UUID{foundID, deletedID}).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(foundID, chatID, userID, "the report is ready", now, now, int64(3), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.message_id, mf.file_url`).WithArgs([]uuid.UUID{foundID}).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "file_url", "file_type", "file_name", "duration_ms", "waveform"}))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	results, err := repo.SearchMessages(ctx, userID, models.MessageSearchQuery{Text: "report", Limit: 20})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, foundID, results[0].Message.ID)
+	require.Equal(t, snippet, results[0].Snippet)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
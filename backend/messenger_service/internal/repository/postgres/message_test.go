@@ -1 +1,321 @@
-package postgres
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+	"quickflow/shared/testutil"
+)
+
+var messageColumns = []string{"id", "chat_id", "sender_id", "text", "created_at", "updated_at", "seq",
+	"edited_at", "reply_to_id", "forwarded_from_sender_id", "forwarded_from_chat_id", "system_action", "system_user_id", "ciphertext"}
+
+func TestGetMessagesForChatOlderAndNewer(t *testing.T) {
+	ctx := context.Background()
+	chatID, senderID := uuid.New(), uuid.New()
+	firstID, secondID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// older messages come newest first from the database
+	mock.ExpectQuery(`WHERE chat_id = \$1 AND created_at < \$2`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(secondID, chatID, senderID, "second", now.Add(-time.Minute), now.Add(-time.Minute), int64(2), nil, nil, nil, nil, nil, nil, nil).
+			AddRow(firstID, chatID, senderID, "first", now.Add(-2*time.Minute), now.Add(-2*time.Minute), int64(1), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(secondID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(firstID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+
+	// newer messages come oldest first
+	mock.ExpectQuery(`WHERE chat_id = \$1 AND created_at > \$2`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(firstID, chatID, senderID, "first", now.Add(-2*time.Minute), now.Add(-2*time.Minute), int64(1), nil, nil, nil, nil, nil, nil, nil).
+			AddRow(secondID, chatID, senderID, "second", now.Add(-time.Minute), now.Add(-time.Minute), int64(2), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(firstID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(secondID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	older, err := repo.GetMessagesForChatOlder(ctx, chatID, 2, now)
+	require.NoError(t, err)
+	require.Len(t, older, 2)
+	require.Equal(t, firstID, older[0].ID)
+	require.Equal(t, secondID, older[1].ID)
+
+	newer, err := repo.GetMessagesForChatNewer(ctx, chatID, 2, now.Add(-3*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, newer, 2)
+	require.Equal(t, firstID, newer[0].ID)
+	require.Equal(t, secondID, newer[1].ID)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMessagesForChatAfterSeq(t *testing.T) {
+	ctx := context.Background()
+	chatID, senderID := uuid.New(), uuid.New()
+	firstID, secondID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// messages sent at the same time are told apart by seq
+	mock.ExpectQuery(`WHERE chat_id = \$1 AND seq > \$2\s+ORDER BY seq`).
+		WithArgs(sqlmock.AnyArg(), int64(4), 2).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(firstID, chatID, senderID, "first", now, now, int64(5), nil, nil, nil, nil, nil, nil, nil).
+			AddRow(secondID, chatID, senderID, "second", now, now, int64(6), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(firstID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(secondID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	messages, err := repo.GetMessagesForChatAfterSeq(ctx, chatID, 2, 4)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, int64(5), messages[0].Seq)
+	require.Equal(t, int64(6), messages[1].Seq)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEncryptedMessageKeyEnvelopes(t *testing.T) {
+	ctx := context.Background()
+	chatID, senderID, recipientID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+	message := models.Message{
+		ID:        uuid.New(),
+		ChatID:    chatID,
+		SenderID:  senderID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Encrypted: &models.EncryptedContent{
+			Ciphertext: []byte("ciphertext"),
+			Envelopes: []models.KeyEnvelope{
+				{RecipientID: recipientID, DeviceID: "phone", Key: []byte("key")},
+			},
+		},
+	}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`update chat\s+set last_seq`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(4)))
+	mock.ExpectExec(`INSERT INTO message \(`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), []byte("ciphertext")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`insert into message_key_envelope`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "phone", []byte("key")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(`SELECT id, chat_id, sender_id, text`).
+		WithArgs(message.ID).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(message.ID, chatID, senderID, "", now, now, int64(4), nil, nil, nil, nil, nil, nil, []byte("ciphertext")))
+	mock.ExpectQuery(`SELECT mf.file_url`).WithArgs(message.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name"}))
+	mock.ExpectQuery(`from message_key_envelope`).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "device_id", "envelope"}).
+			AddRow(recipientID, "phone", []byte("key")))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	require.NoError(t, repo.SaveMessage(ctx, message))
+
+	saved, err := repo.GetMessageById(ctx, message.ID)
+	require.NoError(t, err)
+	require.Equal(t, message.Encrypted, saved.Encrypted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMessageById_VoiceAttachment(t *testing.T) {
+	ctx := context.Background()
+	messageID, chatID, senderID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id, chat_id, sender_id, text`).
+		WithArgs(messageID).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(messageID, chatID, senderID, "", now, now, int64(1), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.file_url, mf.file_type, f.filename, f.duration_ms, f.waveform`).WithArgs(messageID).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "file_name", "duration_ms", "waveform"}).
+			AddRow("voice.ogg", "audio", "voice.ogg", int32(2500), []byte{0, 255}).
+			AddRow("song.mp3", "audio", "song.mp3", nil, nil))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	message, err := repo.GetMessageById(ctx, messageID)
+	require.NoError(t, err)
+	require.Len(t, message.Attachments, 2)
+	require.True(t, message.Attachments[0].IsVoice)
+	require.Equal(t, &models.VoiceMetadata{Duration: 2500 * time.Millisecond, Waveform: []byte{0, 255}}, message.Attachments[0].Voice)
+	require.Nil(t, message.Attachments[1].Voice)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMessages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	chat := models.Chat{ID: uuid.New(), Type: models.ChatTypePrivate, CreatedAt: now, UpdatedAt: now}
+	senderID, receiverID := uuid.New(), uuid.New()
+	messages := []models.Message{
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: senderID, Text: "first", CreatedAt: now, UpdatedAt: now},
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: senderID, Text: "second", CreatedAt: now, UpdatedAt: now},
+	}
+
+	tests := []struct {
+		name       string
+		failSecond bool
+	}{
+		{name: "saved with the chat"},
+		{name: "nothing saved when a message fails", failSecond: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO chat \(`).
+				WithArgs(chat.ID, nil, nil, chat.Type, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO chat_user`).WithArgs(chat.ID, senderID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO chat_user`).WithArgs(chat.ID, receiverID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			for i := range messages {
+				mock.ExpectQuery(`update chat\s+set last_seq`).
+					WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(i + 1)))
+				insert := mock.ExpectExec(`INSERT INTO message \(`)
+				if i == 1 && tt.failSecond {
+					insert.WillReturnError(errors.New("connection lost"))
+					break
+				}
+				insert.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			if tt.failSecond {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresMessageRepository(db)
+			err = repo.SaveMessages(ctx, messages, &chat, []uuid.UUID{senderID, receiverID})
+			if tt.failSecond {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetMessagesByIds(t *testing.T) {
+	ctx := context.Background()
+	chatID, senderID, recipientID := uuid.New(), uuid.New(), uuid.New()
+	plainID, encryptedID, deletedReplyID, missingID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`WHERE id = any\(\$1\)`).
+		WithArgs([]uuid.UUID{plainID, encryptedID, missingID}).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(plainID, chatID, senderID, "hi", now, now, int64(1), nil, deletedReplyID, nil, nil, nil, nil, nil).
+			AddRow(encryptedID, chatID, senderID, "", now, now, int64(2), nil, nil, nil, nil, nil, nil, []byte("ciphertext")))
+	mock.ExpectQuery(`SELECT mf.message_id, mf.file_url`).
+		WithArgs([]uuid.UUID{plainID, encryptedID}).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "file_url", "file_type", "file_name", "duration_ms", "waveform"}).
+			AddRow(plainID, "a.png", "media", "a.png", nil, nil).
+			AddRow(plainID, "b.png", "media", "b.png", nil, nil))
+	mock.ExpectQuery(`from message_key_envelope`).
+		WithArgs([]uuid.UUID{encryptedID}).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "recipient_id", "device_id", "envelope"}).
+			AddRow(encryptedID, recipientID, "phone", []byte("key")))
+	mock.ExpectQuery(`where m.id = any\(\$1\)`).
+		WithArgs([]uuid.UUID{deletedReplyID}, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "sender_id", "text", "file_type"}))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	messages, err := repo.GetMessagesByIds(ctx, []uuid.UUID{plainID, encryptedID, missingID})
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Len(t, messages[plainID].Attachments, 2)
+	require.Equal(t, "a.png", messages[plainID].Attachments[0].URL)
+	require.Equal(t, &models.MessagePreview{ID: deletedReplyID, Deleted: true}, messages[plainID].ReplyTo)
+	require.Equal(t, []models.KeyEnvelope{{RecipientID: recipientID, DeviceID: "phone", Key: []byte("key")}},
+		messages[encryptedID].Encrypted.Envelopes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetChatEventsSince(t *testing.T) {
+	ctx := context.Background()
+	chatID, senderID, readerID := uuid.New(), uuid.New(), uuid.New()
+	sentID, deletedID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`from message_event e`).
+		WithArgs(sqlmock.AnyArg(), int64(4), 10).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "type", "chat_id", "message_id", "user_id", "created_at", "reaction"}).
+			AddRow(int64(5), "message", chatID, sentID, senderID, now, nil).
+			AddRow(int64(6), "message", chatID, deletedID, senderID, now, nil).
+			AddRow(int64(7), "message_read", chatID, nil, readerID, now, nil).
+			AddRow(int64(8), "message_deleted", chatID, deletedID, senderID, now, nil))
+	// messages of all events are loaded at once, the deleted one is missing
+	mock.ExpectQuery(`WHERE id = any\(\$1\)`).
+		WithArgs([]uuid.UUID{sentID, deletedID}).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(sentID, chatID, senderID, "hi", now, now, int64(5), nil, nil, nil, nil, nil, nil, nil))
+	mock.ExpectQuery(`SELECT mf.message_id, mf.file_url`).WithArgs([]uuid.UUID{sentID}).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "file_url", "file_type", "file_name", "duration_ms", "waveform"}))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	events, err := repo.GetChatEventsSince(ctx, chatID, 4, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.NotNil(t, events[0].Message)
+	require.Equal(t, "hi", events[0].Message.Text)
+	require.Nil(t, events[1].Message)
+	require.Equal(t, readerID, events[2].UserID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	messenger_service "quickflow/messenger_service/internal/errors"
+	pgmodels "quickflow/messenger_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	pinMessageQuery = `
+        insert into chat_pinned_message (chat_id, message_id, pinned_by, pinned_at)
+        values ($1, $2, $3, $4)
+        on conflict do nothing
+`
+	unpinMessageQuery = `
+        delete from chat_pinned_message
+        where chat_id = $1 and message_id = $2
+`
+	unpinDeletedMessageQuery = `
+        delete from chat_pinned_message
+        where message_id = $1
+`
+	getPinnedMessagesQuery = `
+        select p.chat_id, p.pinned_by, p.pinned_at, m.id, m.sender_id, left(m.text, $2),
+               (select mf.file_type from message_file mf where mf.message_id = m.id order by mf.id limit 1)
+        from chat_pinned_message p
+            join message m on m.id = p.message_id
+        where p.chat_id = any($1)
+        order by p.pinned_at desc
+`
+)
+
+// PinMessage pins the message in the chat and returns sequence number of the pin event
+func (m *MessageRepository) PinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	res, err := tx.ExecContext(ctx, pinMessageQuery,
+		pgtype.UUID{Bytes: chatId, Valid: true}, pgtype.UUID{Bytes: messageId, Valid: true},
+		pgtype.UUID{Bytes: userId, Valid: true}, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to pin message %v in chat %v: %v", messageId, chatId, err)
+		return 0, fmt.Errorf("unable to pin message: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return 0, messenger_service.ErrAlreadyPinned
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventMessagePinned,
+		ChatID:    chatId,
+		MessageID: messageId,
+		UserID:    userId,
+		CreatedAt: now,
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save pin event of message %v: %v", messageId, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit pin of message %v: %v", messageId, err)
+		return 0, fmt.Errorf("unable to commit message pin: %w", err)
+	}
+	return seq, nil
+}
+
+// UnpinMessage unpins the message in the chat and returns sequence number of the unpin event
+func (m *MessageRepository) UnpinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error) {
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, unpinMessageQuery,
+		pgtype.UUID{Bytes: chatId, Valid: true}, pgtype.UUID{Bytes: messageId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to unpin message %v in chat %v: %v", messageId, chatId, err)
+		return 0, fmt.Errorf("unable to unpin message: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return 0, messenger_service.ErrNotFound
+	}
+
+	seq, err := m.saveChatEvent(ctx, tx, models.ChatEvent{
+		Type:      models.ChatEventMessageUnpinned,
+		ChatID:    chatId,
+		MessageID: messageId,
+		UserID:    userId,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Unable to save unpin event of message %v: %v", messageId, err)
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit unpin of message %v: %v", messageId, err)
+		return 0, fmt.Errorf("unable to commit message unpin: %w", err)
+	}
+	return seq, nil
+}
+
+// GetPinnedMessages returns pinned messages of the chats, the latest pinned first
+func (m *MessageRepository) GetPinnedMessages(ctx context.Context, chatIds []uuid.UUID) (map[uuid.UUID][]models.PinnedMessage, error) {
+	rows, err := m.connPool.QueryContext(ctx, getPinnedMessagesQuery, chatIds, replyPreviewTextLen)
+	if err != nil {
+		logger.Error(ctx, "Unable to get pinned messages of %d chats: %v", len(chatIds), err)
+		return nil, fmt.Errorf("unable to get pinned messages from database: %w", err)
+	}
+	defer rows.Close()
+
+	pinned := make(map[uuid.UUID][]models.PinnedMessage)
+	for rows.Next() {
+		var pinPostgres pgmodels.PinnedMessagePostgres
+		if err = rows.Scan(&pinPostgres.ChatID, &pinPostgres.PinnedBy, &pinPostgres.PinnedAt,
+			&pinPostgres.Message.ID, &pinPostgres.Message.SenderID, &pinPostgres.Message.Text, &pinPostgres.Message.AttachmentType); err != nil {
+			logger.Error(ctx, "Unable to scan pinned message: %v", err)
+			return nil, fmt.Errorf("unable to scan pinned message: %w", err)
+		}
+		chatId := uuid.UUID(pinPostgres.ChatID.Bytes)
+		pinned[chatId] = append(pinned[chatId], pinPostgres.ToPinnedMessage())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read pinned messages: %w", err)
+	}
+	return pinned, nil
+}
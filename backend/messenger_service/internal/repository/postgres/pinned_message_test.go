@@ -0,0 +1,137 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+	"quickflow/shared/testutil"
+)
+
+func TestPinMessage(t *testing.T) {
+	ctx := context.Background()
+	chatID, messageID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`insert into chat_pinned_message`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`update chat`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(42)))
+	mock.ExpectExec(`insert into message_event`).
+		WithArgs(sqlmock.AnyArg(), int64(42), string(models.ChatEventMessagePinned),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	seq, err := repo.PinMessage(ctx, chatID, messageID, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), seq)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinMessage_AlreadyPinned(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`insert into chat_pinned_message`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	_, err = repo.PinMessage(ctx, uuid.New(), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, messenger_errors.ErrAlreadyPinned)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnpinMessage_NotPinned(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`delete from chat_pinned_message`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	_, err = repo.UnpinMessage(ctx, uuid.New(), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPinnedMessages(t *testing.T) {
+	ctx := context.Background()
+	chatID, messageID, senderID, pinnedBy := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	pinnedAt := time.Now()
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`from chat_pinned_message`).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id", "pinned_by", "pinned_at", "id", "sender_id", "text", "file_type"}).
+			AddRow(chatID, pinnedBy, pinnedAt, messageID, senderID, "meet at 6", "media"))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	pinned, err := repo.GetPinnedMessages(ctx, []uuid.UUID{chatID})
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID][]models.PinnedMessage{
+		chatID: {{
+			Message: models.MessagePreview{
+				ID:             messageID,
+				SenderID:       senderID,
+				Text:           "meet at 6",
+				AttachmentType: models.DisplayTypeMedia,
+			},
+			PinnedBy: pinnedBy,
+			PinnedAt: pinnedAt,
+		}},
+	}, pinned)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteMessage_Unpins(t *testing.T) {
+	ctx := context.Background()
+	chatID, messageID := uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`delete from chat_pinned_message`).
+		WithArgs(messageID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`delete from message`).
+		WithArgs(messageID).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(chatID))
+	mock.ExpectQuery(`update chat`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(7)))
+	mock.ExpectExec(`insert into message_event`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+	seq, err := repo.DeleteMessage(ctx, messageID)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), seq)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	messenger_service "quickflow/messenger_service/internal/errors"
+	pgmodels "quickflow/messenger_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	saveScheduledMessageQuery = `
+        insert into scheduled_message (id, chat_id, sender_id, text, reply_to_id, send_at, created_at, updated_at)
+        values ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+	saveScheduledFilesQuery = `
+        insert into scheduled_message_file (scheduled_message_id, file_url, file_type)
+        values ($1, $2, $3)
+`
+	getScheduledFilesQuery = `
+        select sf.file_url, sf.file_type, f.filename, f.duration_ms, f.waveform
+        from scheduled_message_file sf
+            left join files f on sf.file_url = f.file_url
+        where sf.scheduled_message_id = $1
+        order by sf.id
+`
+	deleteScheduledFilesQuery = `
+        delete from scheduled_message_file
+        where scheduled_message_id = $1
+`
+	getScheduledMessageQuery = `
+        select id, chat_id, sender_id, text, reply_to_id, send_at, created_at, updated_at
+        from scheduled_message
+        where id = $1
+`
+	getScheduledMessagesQuery = `
+        select id, chat_id, sender_id, text, reply_to_id, send_at, created_at, updated_at
+        from scheduled_message
+        where chat_id = $1 and sender_id = $2
+        order by send_at
+`
+	// messages taken by the dispatcher are not changed by their senders until the lease ends
+	updateScheduledMessageQuery = `
+        update scheduled_message
+        set text = $2, send_at = $3, updated_at = $4
+        where id = $1 and (locked_until is null or locked_until < $4)
+`
+	cancelScheduledMessageQuery = `
+        delete from scheduled_message
+        where id = $1 and (locked_until is null or locked_until < $2)
+`
+	deleteScheduledMessageQuery = `
+        delete from scheduled_message
+        where id = $1
+`
+	// skips messages taken by other dispatchers, a message whose lease ended is taken again
+	claimDueScheduledMessagesQuery = `
+        update scheduled_message
+        set locked_until = $2
+        where id in (
+            select id
+            from scheduled_message
+            where send_at <= $1 and (locked_until is null or locked_until < $1)
+            order by send_at
+            limit $3
+            for update skip locked
+        )
+        returning id, chat_id, sender_id, text, reply_to_id, send_at, created_at, updated_at
+`
+)
+
+// SaveScheduledMessage stores the message together with its attachments until it is sent
+func (m *MessageRepository) SaveScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error {
+	messagePostgres := pgmodels.FromMessage(scheduled.Message)
+
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, saveScheduledMessageQuery,
+		messagePostgres.ID, messagePostgres.ChatID, messagePostgres.SenderID, messagePostgres.Text,
+		messagePostgres.ReplyToID, pgtype.Timestamptz{Time: scheduled.SendAt, Valid: true},
+		messagePostgres.CreatedAt, messagePostgres.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to save scheduled message %v: %v", messagePostgres.ID, err)
+		return fmt.Errorf("unable to save scheduled message: %w", err)
+	}
+	if err = saveScheduledFiles(ctx, tx, messagePostgres); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit scheduled message %v: %v", messagePostgres.ID, err)
+		return fmt.Errorf("unable to commit scheduled message: %w", err)
+	}
+	return nil
+}
+
+// GetScheduledMessage returns ErrNotFound if the message was sent or cancelled
+func (m *MessageRepository) GetScheduledMessage(ctx context.Context, id uuid.UUID) (models.ScheduledMessage, error) {
+	scheduled, err := scanScheduledMessage(m.connPool.QueryRowContext(ctx, getScheduledMessageQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ScheduledMessage{}, messenger_service.ErrNotFound
+	} else if err != nil {
+		logger.Error(ctx, "Unable to get scheduled message %v: %v", id, err)
+		return models.ScheduledMessage{}, fmt.Errorf("unable to get scheduled message: %w", err)
+	}
+
+	if err = m.fillScheduledFiles(ctx, &scheduled); err != nil {
+		return models.ScheduledMessage{}, err
+	}
+	return scheduled, nil
+}
+
+// GetScheduledMessages returns messages the sender scheduled in the chat, the earliest first
+func (m *MessageRepository) GetScheduledMessages(ctx context.Context, chatId, senderId uuid.UUID) ([]models.ScheduledMessage, error) {
+	rows, err := m.connPool.QueryContext(ctx, getScheduledMessagesQuery, chatId, senderId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get scheduled messages of chat %v: %v", chatId, err)
+		return nil, fmt.Errorf("unable to get scheduled messages: %w", err)
+	}
+
+	scheduledMessages, err := scanScheduledMessages(rows)
+	if err != nil {
+		logger.Error(ctx, "Unable to scan scheduled messages of chat %v: %v", chatId, err)
+		return nil, fmt.Errorf("unable to scan scheduled messages: %w", err)
+	}
+
+	for i := range scheduledMessages {
+		if err = m.fillScheduledFiles(ctx, &scheduledMessages[i]); err != nil {
+			return nil, err
+		}
+	}
+	return scheduledMessages, nil
+}
+
+// UpdateScheduledMessage replaces text, attachments and send time of the message.
+// Returns ErrNotFound if the message was sent, cancelled or is being sent right now.
+func (m *MessageRepository) UpdateScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error {
+	messagePostgres := pgmodels.FromMessage(scheduled.Message)
+
+	tx, err := m.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, updateScheduledMessageQuery,
+		messagePostgres.ID, messagePostgres.Text,
+		pgtype.Timestamptz{Time: scheduled.SendAt, Valid: true}, messagePostgres.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to update scheduled message %v: %v", messagePostgres.ID, err)
+		return fmt.Errorf("unable to update scheduled message: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return messenger_service.ErrNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteScheduledFilesQuery, messagePostgres.ID); err != nil {
+		logger.Error(ctx, "Unable to delete files of scheduled message %v: %v", messagePostgres.ID, err)
+		return fmt.Errorf("unable to delete scheduled message files: %w", err)
+	}
+	if err = saveScheduledFiles(ctx, tx, messagePostgres); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit scheduled message %v update: %v", messagePostgres.ID, err)
+		return fmt.Errorf("unable to commit scheduled message update: %w", err)
+	}
+	return nil
+}
+
+// CancelScheduledMessage deletes the message before it is sent.
+// Returns ErrNotFound if the message was sent, cancelled or is being sent right now.
+func (m *MessageRepository) CancelScheduledMessage(ctx context.Context, id uuid.UUID, now time.Time) error {
+	res, err := m.connPool.ExecContext(ctx, cancelScheduledMessageQuery, id, now)
+	if err != nil {
+		logger.Error(ctx, "Unable to cancel scheduled message %v: %v", id, err)
+		return fmt.Errorf("unable to cancel scheduled message: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return messenger_service.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteScheduledMessage removes the message once the dispatcher has sent it
+func (m *MessageRepository) DeleteScheduledMessage(ctx context.Context, id uuid.UUID) error {
+	if _, err := m.connPool.ExecContext(ctx, deleteScheduledMessageQuery, id); err != nil {
+		logger.Error(ctx, "Unable to delete scheduled message %v: %v", id, err)
+		return fmt.Errorf("unable to delete scheduled message: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueScheduledMessages takes up to limit messages due by now for sending until lockedUntil.
+// Messages not deleted by then are taken again, so a failed send is retried.
+func (m *MessageRepository) ClaimDueScheduledMessages(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ScheduledMessage, error) {
+	rows, err := m.connPool.QueryContext(ctx, claimDueScheduledMessagesQuery, now, lockedUntil, limit)
+	if err != nil {
+		logger.Error(ctx, "Unable to claim due scheduled messages: %v", err)
+		return nil, fmt.Errorf("unable to claim due scheduled messages: %w", err)
+	}
+
+	scheduledMessages, err := scanScheduledMessages(rows)
+	if err != nil {
+		logger.Error(ctx, "Unable to scan due scheduled messages: %v", err)
+		return nil, fmt.Errorf("unable to scan due scheduled messages: %w", err)
+	}
+
+	for i := range scheduledMessages {
+		if err = m.fillScheduledFiles(ctx, &scheduledMessages[i]); err != nil {
+			return nil, err
+		}
+	}
+	return scheduledMessages, nil
+}
+
+func (m *MessageRepository) fillScheduledFiles(ctx context.Context, scheduled *models.ScheduledMessage) error {
+	files, err := m.connPool.QueryContext(ctx, getScheduledFilesQuery, scheduled.Message.ID)
+	if err != nil {
+		logger.Error(ctx, "Unable to get files of scheduled message %v: %v", scheduled.Message.ID, err)
+		return fmt.Errorf("unable to get scheduled message files: %w", err)
+	}
+	defer files.Close()
+
+	for files.Next() {
+		var pgfile pgmodels.PostgresFile
+		if err = files.Scan(&pgfile.URL, &pgfile.DisplayType, &pgfile.Name, &pgfile.DurationMs, &pgfile.Waveform); err != nil {
+			logger.Error(ctx, "Unable to scan file of scheduled message %v: %v", scheduled.Message.ID, err)
+			return fmt.Errorf("unable to scan scheduled message file: %w", err)
+		}
+		scheduled.Message.Attachments = append(scheduled.Message.Attachments, pgfile.ToFile())
+	}
+	return files.Err()
+}
+
+func saveScheduledFiles(ctx context.Context, tx *sql.Tx, messagePostgres pgmodels.MessagePostgres) error {
+	for _, file := range messagePostgres.Attachments {
+		if _, err := tx.ExecContext(ctx, saveScheduledFilesQuery, messagePostgres.ID, file.URL, file.DisplayType); err != nil {
+			logger.Error(ctx, "Unable to save file %v of scheduled message %v: %v", file.URL, messagePostgres.ID, err)
+			return fmt.Errorf("unable to save scheduled message file: %w", err)
+		}
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanScheduledMessage(row rowScanner) (models.ScheduledMessage, error) {
+	var messagePostgres pgmodels.MessagePostgres
+	var sendAt pgtype.Timestamptz
+	err := row.Scan(&messagePostgres.ID, &messagePostgres.ChatID, &messagePostgres.SenderID, &messagePostgres.Text,
+		&messagePostgres.ReplyToID, &sendAt, &messagePostgres.CreatedAt, &messagePostgres.UpdatedAt)
+	if err != nil {
+		return models.ScheduledMessage{}, err
+	}
+	return models.ScheduledMessage{Message: messagePostgres.ToMessage(), SendAt: sendAt.Time}, nil
+}
+
+func scanScheduledMessages(rows *sql.Rows) ([]models.ScheduledMessage, error) {
+	defer rows.Close()
+
+	var scheduledMessages []models.ScheduledMessage
+	for rows.Next() {
+		scheduled, err := scanScheduledMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		scheduledMessages = append(scheduledMessages, scheduled)
+	}
+	return scheduledMessages, rows.Err()
+}
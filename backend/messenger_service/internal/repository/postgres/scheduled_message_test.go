@@ -0,0 +1,165 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+var scheduledMessageColumns = []string{"id", "chat_id", "sender_id", "text", "reply_to_id", "send_at", "created_at", "updated_at"}
+
+func TestSaveScheduledMessage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{
+			ID:          uuid.New(),
+			ChatID:      uuid.New(),
+			SenderID:    uuid.New(),
+			Text:        "С днём рождения!",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Attachments: []*models.File{{URL: "cake.png", DisplayType: models.DisplayTypeMedia}},
+		},
+		SendAt: now.Add(12 * time.Hour),
+	}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`insert into scheduled_message \(`).
+		WithArgs(scheduled.Message.ID, scheduled.Message.ChatID, scheduled.Message.SenderID, scheduled.Message.Text,
+			nil, scheduled.SendAt, now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`insert into scheduled_message_file`).
+		WithArgs(scheduled.Message.ID, "cake.png", string(models.DisplayTypeMedia)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	require.NoError(t, repo.SaveScheduledMessage(ctx, scheduled))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimDueScheduledMessages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	lockedUntil := now.Add(time.Minute)
+	id, chatID, senderID := uuid.New(), uuid.New(), uuid.New()
+	sendAt := now.Add(-time.Second)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`update scheduled_message\s+set locked_until`).
+		WithArgs(now, lockedUntil, 50).
+		WillReturnRows(sqlmock.NewRows(scheduledMessageColumns).
+			AddRow(id, chatID, senderID, "hello", nil, sendAt, now, now))
+	mock.ExpectQuery(`select sf.file_url, sf.file_type, f.filename`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"file_url", "file_type", "filename", "duration_ms", "waveform"}).
+			AddRow("cake.png", "media", "cake.png", nil, nil))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	claimed, err := repo.ClaimDueScheduledMessages(ctx, now, lockedUntil, 50)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, id, claimed[0].Message.ID)
+	require.Equal(t, chatID, claimed[0].Message.ChatID)
+	require.Equal(t, senderID, claimed[0].Message.SenderID)
+	require.Equal(t, "hello", claimed[0].Message.Text)
+	require.True(t, sendAt.Equal(claimed[0].SendAt))
+	require.Equal(t, []*models.File{{URL: "cake.png", DisplayType: models.DisplayTypeMedia, Name: "cake.png"}}, claimed[0].Message.Attachments)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateScheduledMessage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ID: uuid.New(), Text: "edited", UpdatedAt: now},
+		SendAt:  now.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name        string
+		mock        func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name: "updates text and files",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`update scheduled_message\s+set text`).
+					WithArgs(scheduled.Message.ID, "edited", scheduled.SendAt, now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`delete from scheduled_message_file`).
+					WithArgs(scheduled.Message.ID).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "already being sent",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`update scheduled_message\s+set text`).
+					WithArgs(scheduled.Message.ID, "edited", scheduled.SendAt, now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: messenger_errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mock(mock)
+			repo := postgres.NewPostgresMessageRepository(db)
+
+			err = repo.UpdateScheduledMessage(ctx, scheduled)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	id := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`delete from scheduled_message\s+where id = \$1 and`).
+		WithArgs(id, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`delete from scheduled_message\s+where id = \$1 and`).
+		WithArgs(id, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	require.NoError(t, repo.CancelScheduledMessage(ctx, id, now))
+	require.ErrorIs(t, repo.CancelScheduledMessage(ctx, id, now), messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	postgres_models "quickflow/messenger_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// maxRecentStickers is the number of recently sent stickers kept for every user
+const maxRecentStickers = 20
+
+const (
+	// new packs go to the end of the user's library
+	installStickerPackQuery = `
+		INSERT INTO user_sticker_pack (user_id, pack_id, position, added_at)
+		SELECT $1, $2, coalesce(max(position), 0) + 1, $3
+		FROM user_sticker_pack
+		WHERE user_id = $1
+		ON CONFLICT (user_id, pack_id) DO NOTHING`
+
+	uninstallStickerPackQuery = `
+		DELETE FROM user_sticker_pack
+		WHERE user_id = $1 AND pack_id = $2`
+
+	getUserStickerPacksQuery = `
+		SELECT sp.id, sp.name, sp.creator_id, sp.created_at, sp.updated_at
+		FROM user_sticker_pack usp
+			JOIN sticker_pack sp ON sp.id = usp.pack_id
+		WHERE usp.user_id = $1
+		ORDER BY usp.position`
+
+	setStickerPackPositionQuery = `
+		UPDATE user_sticker_pack
+		SET position = $3
+		WHERE user_id = $1 AND pack_id = $2`
+
+	touchRecentStickerQuery = `
+		INSERT INTO recent_sticker (user_id, sticker_url, used_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, sticker_url) DO UPDATE SET used_at = excluded.used_at`
+
+	trimRecentStickersQuery = `
+		DELETE FROM recent_sticker
+		WHERE user_id = $1 AND sticker_url NOT IN (
+			SELECT sticker_url
+			FROM recent_sticker
+			WHERE user_id = $1
+			ORDER BY used_at DESC
+			LIMIT $2
+		)`
+
+	getRecentStickersQuery = `
+		SELECT sticker_url
+		FROM recent_sticker
+		WHERE user_id = $1
+		ORDER BY used_at DESC`
+
+	// a sticker that is already a favourite counts as added, only unknown stickers add no rows
+	addFavouriteStickerQuery = `
+		INSERT INTO favourite_sticker (user_id, sticker_url, created_at)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM sticker WHERE sticker_url = $2)
+		ON CONFLICT (user_id, sticker_url) DO UPDATE SET created_at = favourite_sticker.created_at`
+
+	removeFavouriteStickerQuery = `
+		DELETE FROM favourite_sticker
+		WHERE user_id = $1 AND sticker_url = $2`
+
+	getFavouriteStickersQuery = `
+		SELECT sticker_url
+		FROM favourite_sticker
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+)
+
+// InstallStickerPack adds the pack to the end of the user's library, installing it twice changes nothing
+func (s *StickerRepository) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID, now time.Time) error {
+	if _, err := s.ConnPool.ExecContext(ctx, installStickerPackQuery, userId, packId, now); err != nil {
+		logger.Error(ctx, "Unable to install sticker pack %v for user %v: %v", packId, userId, err)
+		return fmt.Errorf("could not install sticker pack: %w", err)
+	}
+	return nil
+}
+
+// UninstallStickerPack returns ErrNotFound if the pack is not in the user's library
+func (s *StickerRepository) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	res, err := s.ConnPool.ExecContext(ctx, uninstallStickerPackQuery, userId, packId)
+	if err != nil {
+		logger.Error(ctx, "Unable to uninstall sticker pack %v for user %v: %v", packId, userId, err)
+		return fmt.Errorf("could not uninstall sticker pack: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// GetUserStickerPacks returns packs of the user's library in their order
+func (s *StickerRepository) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]models.StickerPack, error) {
+	rows, err := s.ConnPool.QueryContext(ctx, getUserStickerPacksQuery, userId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get sticker packs of user %v: %v", userId, err)
+		return nil, fmt.Errorf("could not get user sticker packs: %w", err)
+	}
+
+	var packs []postgres_models.StickerPackPostgres
+	for rows.Next() {
+		var pack postgres_models.StickerPackPostgres
+		if err = rows.Scan(&pack.ID, &pack.Name, &pack.CreatorID, &pack.CreatedAt, &pack.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("could not scan sticker pack: %w", err)
+		}
+		packs = append(packs, pack)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get user sticker packs: %w", err)
+	}
+
+	stickerPacks := make([]models.StickerPack, len(packs))
+	for i := range packs {
+		if packs[i].Stickers, err = s.getStickers(ctx, packs[i].ID.Bytes); err != nil {
+			return nil, err
+		}
+		stickerPacks[i] = packs[i].ToStickerPack()
+	}
+	return stickerPacks, nil
+}
+
+// SetUserStickerPacksOrder moves the given packs of the user's library to the given positions
+func (s *StickerRepository) SetUserStickerPacksOrder(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	tx, err := s.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for i, packId := range packIds {
+		res, err := tx.ExecContext(ctx, setStickerPackPositionQuery, userId, packId, i+1)
+		if err != nil {
+			logger.Error(ctx, "Unable to move sticker pack %v of user %v: %v", packId, userId, err)
+			return fmt.Errorf("could not move sticker pack: %w", err)
+		}
+		if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+			return messenger_errors.ErrNotFound
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit transaction: %v", err)
+		return fmt.Errorf("unable to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetRecentStickers returns stickers the user sent lately, the latest first
+func (s *StickerRepository) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	rows, err := s.ConnPool.QueryContext(ctx, getRecentStickersQuery, userId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get recent stickers of user %v: %v", userId, err)
+		return nil, fmt.Errorf("could not get recent stickers: %w", err)
+	}
+	return scanStickerURLs(rows)
+}
+
+// AddFavouriteSticker returns ErrNotFound if there is no such sticker in any pack
+func (s *StickerRepository) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string, now time.Time) error {
+	res, err := s.ConnPool.ExecContext(ctx, addFavouriteStickerQuery, userId, stickerURL, now)
+	if err != nil {
+		logger.Error(ctx, "Unable to add favourite sticker for user %v: %v", userId, err)
+		return fmt.Errorf("could not add favourite sticker: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// RemoveFavouriteSticker returns ErrNotFound if the sticker is not a favourite of the user
+func (s *StickerRepository) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	res, err := s.ConnPool.ExecContext(ctx, removeFavouriteStickerQuery, userId, stickerURL)
+	if err != nil {
+		logger.Error(ctx, "Unable to remove favourite sticker for user %v: %v", userId, err)
+		return fmt.Errorf("could not remove favourite sticker: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return messenger_errors.ErrNotFound
+	}
+	return nil
+}
+
+// GetFavouriteStickers returns favourite stickers of the user, the last added first
+func (s *StickerRepository) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	rows, err := s.ConnPool.QueryContext(ctx, getFavouriteStickersQuery, userId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get favourite stickers of user %v: %v", userId, err)
+		return nil, fmt.Errorf("could not get favourite stickers: %w", err)
+	}
+	return scanStickerURLs(rows)
+}
+
+func (s *StickerRepository) getStickers(ctx context.Context, packId uuid.UUID) ([]postgres_models.PostgresFile, error) {
+	rows, err := s.ConnPool.QueryContext(ctx, getStickersQuery, packId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get stickers: %w", err)
+	}
+	defer rows.Close()
+
+	var stickers []postgres_models.PostgresFile
+	for rows.Next() {
+		var sticker postgres_models.PostgresFile
+		if err = rows.Scan(&sticker.URL); err != nil {
+			return nil, fmt.Errorf("could not scan sticker: %w", err)
+		}
+		stickers = append(stickers, sticker)
+	}
+	return stickers, rows.Err()
+}
+
+// touchRecentStickers moves stickers of the sent message to the top of the sender's recent stickers
+func touchRecentStickers(ctx context.Context, tx *sql.Tx, userId uuid.UUID, files []*models.File, usedAt time.Time) error {
+	touched := false
+	for _, file := range files {
+		if file == nil || file.DisplayType != models.DisplayTypeSticker {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, touchRecentStickerQuery, userId, file.URL, usedAt); err != nil {
+			logger.Error(ctx, "Unable to update recent stickers of user %v: %v", userId, err)
+			return fmt.Errorf("unable to update recent stickers: %w", err)
+		}
+		touched = true
+	}
+	if !touched {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, trimRecentStickersQuery, userId, maxRecentStickers); err != nil {
+		logger.Error(ctx, "Unable to trim recent stickers of user %v: %v", userId, err)
+		return fmt.Errorf("unable to trim recent stickers: %w", err)
+	}
+	return nil
+}
+
+func scanStickerURLs(rows *sql.Rows) ([]*models.File, error) {
+	defer rows.Close()
+
+	var stickers []*models.File
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("could not scan sticker: %w", err)
+		}
+		stickers = append(stickers, &models.File{URL: url, DisplayType: models.DisplayTypeSticker})
+	}
+	return stickers, rows.Err()
+}
@@ -0,0 +1,151 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/repository/postgres"
+	"quickflow/shared/models"
+)
+
+func TestGetUserStickerPacks(t *testing.T) {
+	ctx := context.Background()
+	userID, firstID, secondID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM user_sticker_pack usp`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator_id", "created_at", "updated_at"}).
+			AddRow(secondID, "second", userID, now, now).
+			AddRow(firstID, "first", userID, now, now))
+	mock.ExpectQuery(`SELECT sticker_url\s+FROM sticker WHERE sticker_pack_id`).
+		WithArgs(secondID).
+		WillReturnRows(sqlmock.NewRows([]string{"sticker_url"}).AddRow("cat.webp"))
+	mock.ExpectQuery(`SELECT sticker_url\s+FROM sticker WHERE sticker_pack_id`).
+		WithArgs(firstID).
+		WillReturnRows(sqlmock.NewRows([]string{"sticker_url"}))
+
+	repo := postgres.NewPostgresStickerRepository(db)
+
+	packs, err := repo.GetUserStickerPacks(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, packs, 2)
+	require.Equal(t, secondID, packs[0].Id)
+	require.Len(t, packs[0].Stickers, 1)
+	require.Equal(t, "cat.webp", packs[0].Stickers[0].URL)
+	require.Equal(t, firstID, packs[1].Id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetUserStickerPacksOrder(t *testing.T) {
+	ctx := context.Background()
+	userID, firstID, secondID := uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE user_sticker_pack\s+SET position`).
+		WithArgs(userID, secondID, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE user_sticker_pack\s+SET position`).
+		WithArgs(userID, firstID, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := postgres.NewPostgresStickerRepository(db)
+
+	err = repo.SetUserStickerPacksOrder(ctx, userID, []uuid.UUID{secondID, firstID})
+	require.ErrorIs(t, err, messenger_errors.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFavouriteStickers(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO favourite_sticker`).
+		WithArgs(userID, "cat.webp", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO favourite_sticker`).
+		WithArgs(userID, "unknown.webp", now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FROM favourite_sticker`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"sticker_url"}).AddRow("cat.webp"))
+	mock.ExpectExec(`DELETE FROM favourite_sticker`).
+		WithArgs(userID, "cat.webp").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := postgres.NewPostgresStickerRepository(db)
+
+	require.NoError(t, repo.AddFavouriteSticker(ctx, userID, "cat.webp", now))
+	require.ErrorIs(t, repo.AddFavouriteSticker(ctx, userID, "unknown.webp", now), messenger_errors.ErrNotFound)
+
+	favourites, err := repo.GetFavouriteStickers(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, []*models.File{{URL: "cat.webp", DisplayType: models.DisplayTypeSticker}}, favourites)
+
+	require.NoError(t, repo.RemoveFavouriteSticker(ctx, userID, "cat.webp"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMessage_TouchesRecentStickers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	message := models.Message{
+		ID:        uuid.New(),
+		ChatID:    uuid.New(),
+		SenderID:  uuid.New(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Attachments: []*models.File{
+			{URL: "cat.webp", DisplayType: models.DisplayTypeSticker},
+			{URL: "photo.png", DisplayType: models.DisplayTypeMedia},
+		},
+	}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`update chat\s+set last_seq`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq"}).AddRow(int64(7)))
+	mock.ExpectExec(`INSERT INTO message \(`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO message_file`).
+		WithArgs(message.ID, "cat.webp", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO message_file`).
+		WithArgs(message.ID, "photo.png", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO recent_sticker`).
+		WithArgs(message.SenderID, "cat.webp", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM recent_sticker`).
+		WithArgs(message.SenderID, 20).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := postgres.NewPostgresMessageRepository(db)
+
+	require.NoError(t, repo.SaveMessage(ctx, message))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,14 +7,17 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	addr "quickflow/config/micro-addr"
 	postgresConfig "quickflow/config/postgres"
+	redisConfig "quickflow/config/redis"
 	grpc2 "quickflow/messenger_service/internal/delivery/grpc"
 	"quickflow/messenger_service/internal/delivery/grpc/interceptor"
 	"quickflow/messenger_service/internal/repository/postgres"
@@ -23,12 +26,28 @@ import (
 	"quickflow/metrics"
 	"quickflow/shared/client/file_service"
 	"quickflow/shared/client/user_service"
+	"quickflow/shared/eventbus"
 	"quickflow/shared/interceptors"
 	"quickflow/shared/logger"
 	"quickflow/shared/proto/messenger_service"
 	getEnv "quickflow/utils/get-env"
 )
 
+const (
+	messageReaperInterval  = 5 * time.Second
+	messageReaperBatchSize = 100
+
+	scheduledMessageInterval  = 5 * time.Second
+	scheduledMessageLease     = time.Minute
+	scheduledMessageBatchSize = 100
+
+	chatExportInterval  = 10 * time.Second
+	chatExportLease     = 10 * time.Minute
+	chatExportTTL       = 7 * 24 * time.Hour
+	chatExportBatchSize = 5
+	chatExportPageSize  = 500
+)
+
 func main() {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", addr.DefaultMessengerServicePort))
 	if err != nil {
@@ -73,7 +92,30 @@ func main() {
 	messageRepo := postgres.NewPostgresMessageRepository(db)
 
 	messageUseCase := usecase.NewMessageService(messageRepo, fileService, chatRepo, messageValidator)
+	scheduledMessageUseCase := usecase.NewScheduledMessageService(messageRepo, chatRepo, messageValidator)
 	chatUseCase := usecase.NewChatUseCase(chatRepo, fileService, profileService, messageRepo, chatValidator)
+	chatExportUseCase := usecase.NewChatExportService(chatRepo, chatRepo)
+
+	// expired messages are announced through the same redis channels the gateway listens to
+	redisCfg := redisConfig.NewRedisConfig()
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     redisCfg.GetURL(),
+		Password: redisCfg.GetPass(),
+	})
+	defer redisClient.Close()
+	eventBus := eventbus.NewRedisEventBus(redisClient, eventbus.DefaultChannelPrefix)
+	messageReaper := usecase.NewMessageReaper(messageRepo, chatRepo, eventBus, messageReaperInterval, messageReaperBatchSize)
+	go messageReaper.Run(context.Background())
+
+	// sent scheduled messages are enriched by the gateway before being delivered to clients
+	messengerEventBus := eventbus.NewRedisEventBus(redisClient, eventbus.MessengerChannelPrefix)
+	scheduledMessageDispatcher := usecase.NewScheduledMessageDispatcher(messageRepo, chatRepo, messageUseCase, messengerEventBus,
+		scheduledMessageInterval, scheduledMessageLease, scheduledMessageBatchSize)
+	go scheduledMessageDispatcher.Run(context.Background())
+
+	chatExporter := usecase.NewChatExporter(chatRepo, chatRepo, messageRepo, profileService, fileService, eventBus,
+		chatExportInterval, chatExportLease, chatExportTTL, chatExportBatchSize, chatExportPageSize)
+	go chatExporter.Run(context.Background())
 
 	stickerValidator := validation.NewStickerValidator()
 	stickerRepo := postgres.NewPostgresStickerRepository(db)
@@ -102,8 +144,8 @@ func main() {
 	}()
 
 	log.Printf("Server is listening on %s", listener.Addr().String())
-	proto.RegisterChatServiceServer(server, grpc2.NewChatServiceServer(chatUseCase))
-	proto.RegisterMessageServiceServer(server, grpc2.NewMessageServiceServer(messageUseCase))
+	proto.RegisterChatServiceServer(server, grpc2.NewChatServiceServer(chatUseCase, chatExportUseCase))
+	proto.RegisterMessageServiceServer(server, grpc2.NewMessageServiceServer(messageUseCase, scheduledMessageUseCase))
 	proto.RegisterStickerServiceServer(server, grpc2.NewStickerServiceServer(stickerUseCase))
 	if err = server.Serve(listener); err != nil {
 		log.Fatalf("failed to serve: %v", err)
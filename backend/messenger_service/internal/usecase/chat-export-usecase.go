@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/models"
+)
+
+type ChatExportRepository interface {
+	SaveChatExport(ctx context.Context, export models.ChatExport) error
+	GetChatExport(ctx context.Context, id uuid.UUID) (models.ChatExport, error)
+	ClaimPendingChatExports(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ChatExport, error)
+	FinishChatExport(ctx context.Context, id uuid.UUID, status models.ChatExportStatus, fileURL string, now time.Time) error
+	GetExpiredChatExports(ctx context.Context, before time.Time, limit int) ([]models.ChatExport, error)
+}
+
+// ChatExportService accepts export requests of users, ChatExporter builds the archives
+type ChatExportService struct {
+	exportRepo ChatExportRepository
+	chatRepo   ChatRepository
+}
+
+func NewChatExportService(exportRepo ChatExportRepository, chatRepo ChatRepository) *ChatExportService {
+	return &ChatExportService{
+		exportRepo: exportRepo,
+		chatRepo:   chatRepo,
+	}
+}
+
+// RequestChatExport queues the export of the chat history, only participants can request it
+func (s *ChatExportService) RequestChatExport(ctx context.Context, chatId, userId uuid.UUID) (models.ChatExport, error) {
+	isParticipant, err := s.chatRepo.IsParticipant(ctx, chatId, userId)
+	if err != nil {
+		return models.ChatExport{}, fmt.Errorf("s.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return models.ChatExport{}, messenger_errors.ErrNotParticipant
+	}
+
+	now := time.Now()
+	export := models.ChatExport{
+		ID:        uuid.New(),
+		ChatID:    chatId,
+		UserID:    userId,
+		Status:    models.ChatExportPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err = s.exportRepo.SaveChatExport(ctx, export); err != nil {
+		return models.ChatExport{}, fmt.Errorf("s.exportRepo.SaveChatExport: %w", err)
+	}
+	return export, nil
+}
+
+// GetChatExport returns the export requested by the user, exports of others are not found
+func (s *ChatExportService) GetChatExport(ctx context.Context, exportId, userId uuid.UUID) (models.ChatExport, error) {
+	export, err := s.exportRepo.GetChatExport(ctx, exportId)
+	if err != nil {
+		return models.ChatExport{}, fmt.Errorf("s.exportRepo.GetChatExport: %w", err)
+	}
+	if export.UserID != userId {
+		return models.ChatExport{}, messenger_errors.ErrNotFound
+	}
+	return export, nil
+}
@@ -0,0 +1,265 @@
+package usecase_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/usecase"
+	"quickflow/messenger_service/internal/usecase/mocks"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/models"
+)
+
+func TestRequestChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	exportRepo := mocks.NewMockChatExportRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	chatID, userID, strangerID := uuid.New(), uuid.New(), uuid.New()
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, userID).Return(true, nil)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, strangerID).Return(false, nil)
+	var saved models.ChatExport
+	exportRepo.EXPECT().SaveChatExport(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, export models.ChatExport) error {
+		saved = export
+		return nil
+	})
+
+	// Создаем сервис
+	service := usecase.NewChatExportService(exportRepo, chatRepo)
+
+	// Вызов метода
+	export, err := service.RequestChatExport(context.Background(), chatID, userID)
+	_, strangerErr := service.RequestChatExport(context.Background(), chatID, strangerID)
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, saved, export)
+	assert.NotEqual(t, uuid.Nil, export.ID)
+	assert.Equal(t, chatID, export.ChatID)
+	assert.Equal(t, userID, export.UserID)
+	assert.Equal(t, models.ChatExportPending, export.Status)
+	assert.ErrorIs(t, strangerErr, messenger_errors.ErrNotParticipant)
+}
+
+func TestGetChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	exportRepo := mocks.NewMockChatExportRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	export := models.ChatExport{ID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New(), Status: models.ChatExportReady, FileURL: "export.zip"}
+
+	// Ожидания для моков
+	exportRepo.EXPECT().GetChatExport(gomock.Any(), export.ID).Return(export, nil).Times(2)
+
+	// Создаем сервис
+	service := usecase.NewChatExportService(exportRepo, chatRepo)
+
+	// Вызов метода
+	own, err := service.GetChatExport(context.Background(), export.ID, export.UserID)
+	_, otherErr := service.GetChatExport(context.Background(), export.ID, uuid.New())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, export, own)
+	assert.ErrorIs(t, otherErr, messenger_errors.ErrNotFound)
+}
+
+func TestChatExporter_ExportPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	exportRepo := mocks.NewMockChatExportRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	profileRepo := mocks.NewMockProfileService(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	// Подготовка тестовых данных
+	now := time.Now()
+	chat := models.Chat{ID: uuid.New(), Name: "Друзья", Type: models.ChatTypeGroup}
+	aliceID, bobID := uuid.New(), uuid.New()
+	export := models.ChatExport{ID: uuid.New(), ChatID: chat.ID, UserID: aliceID, Status: models.ChatExportPending, CreatedAt: now}
+	firstPage := []models.Message{
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: aliceID, Text: "Привет", CreatedAt: now.Add(-3 * time.Minute), Seq: 1},
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: bobID, Text: "<b>Смотри</b>", CreatedAt: now.Add(-2 * time.Minute), Seq: 2,
+			Attachments: []*models.File{{URL: "https://files/cat.png", Name: "cat.png", DisplayType: models.DisplayTypeMedia}}},
+	}
+	// the next page starts with a message sent at the same time as the last one of the first page
+	secondPage := []models.Message{
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: aliceID, Text: "Милота", CreatedAt: now.Add(-2 * time.Minute), Seq: 3},
+		{ID: uuid.New(), ChatID: chat.ID, SenderID: bobID, Text: "После запроса", CreatedAt: now.Add(time.Minute), Seq: 4},
+	}
+
+	// Ожидания для моков
+	exportRepo.EXPECT().ClaimPendingChatExports(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return([]models.ChatExport{export}, nil)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), chat.ID, aliceID).Return(true, nil)
+	chatRepo.EXPECT().GetChat(gomock.Any(), chat.ID).Return(chat, nil)
+	// the history is read once for messages.json and once for index.html
+	messageRepo.EXPECT().GetMessagesForChatAfterSeq(gomock.Any(), chat.ID, 2, int64(0)).Return(firstPage, nil).Times(2)
+	messageRepo.EXPECT().GetMessagesForChatAfterSeq(gomock.Any(), chat.ID, 2, int64(2)).Return(secondPage, nil).Times(2)
+	profileRepo.EXPECT().GetPublicUsersInfo(gomock.Any(), []uuid.UUID{aliceID, bobID}).Return([]models.PublicUserInfo{
+		{Id: aliceID, Firstname: "Алиса", Lastname: "Иванова"},
+		{Id: bobID, Username: "bob"},
+	}, nil)
+	var archive []byte
+	fileRepo.EXPECT().UploadFile(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, file *models.File) (string, error) {
+		var err error
+		archive, err = io.ReadAll(file.Reader)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(archive)), file.Size)
+		assert.Equal(t, "application/zip", file.MimeType)
+		assert.Equal(t, models.AccessPrivate, file.AccessMode)
+		return "export.zip", nil
+	})
+	exportRepo.EXPECT().FinishChatExport(gomock.Any(), export.ID, models.ChatExportReady, "export.zip", gomock.Any()).Return(nil)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventbus.Event) error {
+		assert.Equal(t, usecase.ChatExportEventFinished, event.Type)
+		assert.Equal(t, []uuid.UUID{aliceID}, event.Receivers)
+		return nil
+	})
+
+	// Создаем сервис
+	exporter := usecase.NewChatExporter(exportRepo, chatRepo, messageRepo, profileRepo, fileRepo, publisher, time.Minute, time.Minute, time.Hour, 10, 2)
+
+	// Вызов метода
+	taken, err := exporter.ExportPending(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+
+	files := readArchive(t, archive)
+	var exported struct {
+		Chat struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"chat"`
+		Messages []struct {
+			Text        string `json:"text"`
+			SenderName  string `json:"sender_name"`
+			Attachments []struct {
+				URL string `json:"url"`
+			} `json:"attachments"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal(files["messages.json"], &exported))
+	assert.Equal(t, "Друзья", exported.Chat.Name)
+	assert.Equal(t, "group", exported.Chat.Type)
+	require.Len(t, exported.Messages, 3)
+	assert.Equal(t, "Алиса Иванова", exported.Messages[0].SenderName)
+	assert.Equal(t, "bob", exported.Messages[1].SenderName)
+	assert.Equal(t, "https://files/cat.png", exported.Messages[1].Attachments[0].URL)
+	assert.Equal(t, "Милота", exported.Messages[2].Text)
+
+	index := string(files["index.html"])
+	assert.Contains(t, index, "<title>Друзья</title>")
+	assert.Contains(t, index, "&lt;b&gt;Смотри&lt;/b&gt;")
+	assert.Contains(t, index, `<img src="https://files/cat.png"`)
+	assert.Contains(t, index, "Милота")
+	assert.NotContains(t, index, "После запроса")
+}
+
+func TestChatExporter_ExportPending_LeftChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	exportRepo := mocks.NewMockChatExportRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	// Подготовка тестовых данных
+	export := models.ChatExport{ID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New(), Status: models.ChatExportPending}
+
+	// Ожидания для моков
+	exportRepo.EXPECT().ClaimPendingChatExports(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return([]models.ChatExport{export}, nil)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), export.ChatID, export.UserID).Return(false, nil)
+	exportRepo.EXPECT().FinishChatExport(gomock.Any(), export.ID, models.ChatExportFailed, "", gomock.Any()).Return(nil)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventbus.Event) error {
+		assert.JSONEq(t, `{"export_id":"`+export.ID.String()+`","chat_id":"`+export.ChatID.String()+`","status":"failed"}`, string(event.Payload))
+		return nil
+	})
+
+	// Создаем сервис
+	exporter := usecase.NewChatExporter(exportRepo, chatRepo, nil, nil, nil, publisher, time.Minute, time.Minute, time.Hour, 10, 2)
+
+	// Вызов метода
+	taken, err := exporter.ExportPending(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+}
+
+func TestChatExporter_ExpireReady(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	exportRepo := mocks.NewMockChatExportRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+
+	// Подготовка тестовых данных
+	deleted := models.ChatExport{ID: uuid.New(), Status: models.ChatExportReady, FileURL: "first.zip"}
+	failed := models.ChatExport{ID: uuid.New(), Status: models.ChatExportReady, FileURL: "second.zip"}
+
+	// Ожидания для моков
+	exportRepo.EXPECT().GetExpiredChatExports(gomock.Any(), gomock.Any(), 10).DoAndReturn(
+		func(_ context.Context, before time.Time, _ int) ([]models.ChatExport, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), before, time.Second)
+			return []models.ChatExport{deleted, failed}, nil
+		})
+	fileRepo.EXPECT().DeletePrivateFile(gomock.Any(), "first.zip").Return(nil)
+	exportRepo.EXPECT().FinishChatExport(gomock.Any(), deleted.ID, models.ChatExportExpired, "", gomock.Any()).Return(nil)
+	// the export stays ready until its archive is deleted
+	fileRepo.EXPECT().DeletePrivateFile(gomock.Any(), "second.zip").Return(errors.New("file service is down"))
+
+	// Создаем сервис
+	exporter := usecase.NewChatExporter(exportRepo, nil, nil, nil, fileRepo, nil, time.Minute, time.Minute, time.Hour, 10, 2)
+
+	// Вызов метода
+	expired, err := exporter.ExpireReady(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+}
+
+func readArchive(t *testing.T, archive []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+	}
+	return files
+}
@@ -0,0 +1,466 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// ChatExportEventFinished is the ws event telling the user that the requested archive is ready or failed
+const ChatExportEventFinished = "chat_export_finished"
+
+type chatExportFinishedPayload struct {
+	ExportId uuid.UUID `json:"export_id"`
+	ChatId   uuid.UUID `json:"chat_id"`
+	Status   string    `json:"status"`
+}
+
+// ChatExporter periodically builds ZIP archives of the chat history for pending exports and deletes expired ones.
+// The archive holds messages.json and index.html, attachments are referenced by their URLs.
+type ChatExporter struct {
+	exportRepo  ChatExportRepository
+	chatRepo    ChatRepository
+	messageRepo MessageRepository
+	profileRepo ProfileService
+	fileRepo    FileService
+	publisher   EventPublisher
+	interval    time.Duration
+	// lease is the time an exporter has to build a taken archive before others may take it
+	lease time.Duration
+	// ttl is the time a built archive is kept for download
+	ttl       time.Duration
+	batchSize int
+	pageSize  int
+}
+
+func NewChatExporter(exportRepo ChatExportRepository, chatRepo ChatRepository, messageRepo MessageRepository, profileRepo ProfileService, fileRepo FileService, publisher EventPublisher, interval, lease, ttl time.Duration, batchSize, pageSize int) *ChatExporter {
+	return &ChatExporter{
+		exportRepo:  exportRepo,
+		chatRepo:    chatRepo,
+		messageRepo: messageRepo,
+		profileRepo: profileRepo,
+		fileRepo:    fileRepo,
+		publisher:   publisher,
+		interval:    interval,
+		lease:       lease,
+		ttl:         ttl,
+		batchSize:   batchSize,
+		pageSize:    pageSize,
+	}
+}
+
+// Run builds pending archives and deletes expired ones every interval until ctx is done
+func (e *ChatExporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a full batch means more exports may be pending
+			for {
+				taken, err := e.ExportPending(ctx)
+				if err != nil {
+					logger.Error(ctx, "Failed to export chats: %v", err)
+					break
+				}
+				if taken < e.batchSize {
+					break
+				}
+			}
+			for {
+				expired, err := e.ExpireReady(ctx)
+				if err != nil {
+					logger.Error(ctx, "Failed to expire chat exports: %v", err)
+					break
+				}
+				if expired < e.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ExportPending builds one batch of pending archives and returns the number of taken exports.
+// Exports that could not be built are marked as failed, users may request them again.
+func (e *ChatExporter) ExportPending(ctx context.Context) (int, error) {
+	now := time.Now()
+	exports, err := e.exportRepo.ClaimPendingChatExports(ctx, now, now.Add(e.lease), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, export := range exports {
+		status := models.ChatExportReady
+		fileURL, err := e.export(ctx, export)
+		if err != nil {
+			logger.Error(ctx, "Failed to export chat %s for user %s: %v", export.ChatID, export.UserID, err)
+			status = models.ChatExportFailed
+		}
+
+		// an export left pending is taken again once its lease ends
+		if err = e.exportRepo.FinishChatExport(ctx, export.ID, status, fileURL, time.Now()); err != nil {
+			logger.Error(ctx, "Failed to finish chat export %s: %v", export.ID, err)
+			continue
+		}
+		e.notify(ctx, export, status)
+	}
+
+	if len(exports) != 0 {
+		logger.Info(ctx, "Exported %d chats", len(exports))
+	}
+	return len(exports), nil
+}
+
+// ExpireReady deletes one batch of archives built more than ttl ago and returns the number of expired exports.
+// The file is deleted first, so an export left ready after a failure is expired again on the next run.
+func (e *ChatExporter) ExpireReady(ctx context.Context) (int, error) {
+	exports, err := e.exportRepo.GetExpiredChatExports(ctx, time.Now().Add(-e.ttl), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, export := range exports {
+		if err = e.fileRepo.DeletePrivateFile(ctx, export.FileURL); err != nil {
+			logger.Error(ctx, "Failed to delete archive of chat export %s: %v", export.ID, err)
+			continue
+		}
+		if err = e.exportRepo.FinishChatExport(ctx, export.ID, models.ChatExportExpired, "", time.Now()); err != nil {
+			logger.Error(ctx, "Failed to expire chat export %s: %v", export.ID, err)
+			continue
+		}
+		expired++
+	}
+
+	if expired != 0 {
+		logger.Info(ctx, "Expired %d chat exports", expired)
+	}
+	return expired, nil
+}
+
+// export builds the archive in a temporary file and uploads it as a private file, returns the archive name
+func (e *ChatExporter) export(ctx context.Context, export models.ChatExport) (string, error) {
+	// the user may have left the chat since the request
+	isParticipant, err := e.chatRepo.IsParticipant(ctx, export.ChatID, export.UserID)
+	if err != nil {
+		return "", fmt.Errorf("e.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return "", messenger_errors.ErrNotParticipant
+	}
+
+	chat, err := e.chatRepo.GetChat(ctx, export.ChatID)
+	if err != nil {
+		return "", fmt.Errorf("e.chatRepo.GetChat: %w", err)
+	}
+
+	archive, err := os.CreateTemp("", "chat-export-*.zip")
+	if err != nil {
+		return "", fmt.Errorf("os.CreateTemp: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	// messages sent after the request are not exported
+	if err = e.WriteArchive(ctx, archive, chat, export.CreatedAt); err != nil {
+		return "", err
+	}
+
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", fmt.Errorf("archive.Seek: %w", err)
+	}
+	if _, err = archive.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("archive.Seek: %w", err)
+	}
+
+	fileName, err := e.fileRepo.UploadFile(ctx, &models.File{
+		Reader:      archive,
+		Name:        fmt.Sprintf("chat-%s.zip", chat.ID),
+		Size:        size,
+		Ext:         ".zip",
+		MimeType:    "application/zip",
+		AccessMode:  models.AccessPrivate,
+		DisplayType: models.DisplayTypeFile,
+	})
+	if err != nil {
+		return "", fmt.Errorf("e.fileRepo.UploadFile: %w", err)
+	}
+	return fileName, nil
+}
+
+// WriteArchive writes the ZIP archive of the chat messages sent until the given time
+func (e *ChatExporter) WriteArchive(ctx context.Context, w io.Writer, chat models.Chat, until time.Time) error {
+	archive := zip.NewWriter(w)
+	info := exportedChat{
+		ID:         chat.ID,
+		Name:       chat.Name,
+		Type:       "private",
+		ExportedAt: until,
+	}
+	if chat.Type == models.ChatTypeGroup {
+		info.Type = "group"
+	}
+	senders := make(map[uuid.UUID]string)
+
+	messagesJSON, err := archive.Create("messages.json")
+	if err != nil {
+		return fmt.Errorf("archive.Create: %w", err)
+	}
+	if err = e.writeMessagesJSON(ctx, messagesJSON, info, until, senders); err != nil {
+		return err
+	}
+
+	// history is read twice so that both files are streamed, until keeps them the same
+	indexHTML, err := archive.Create("index.html")
+	if err != nil {
+		return fmt.Errorf("archive.Create: %w", err)
+	}
+	if err = e.writeMessagesHTML(ctx, indexHTML, info, until, senders); err != nil {
+		return err
+	}
+
+	if err = archive.Close(); err != nil {
+		return fmt.Errorf("archive.Close: %w", err)
+	}
+	return nil
+}
+
+func (e *ChatExporter) writeMessagesJSON(ctx context.Context, w io.Writer, info exportedChat, until time.Time, senders map[uuid.UUID]string) error {
+	chatJSON, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	if _, err = fmt.Fprintf(w, `{"chat":%s,"messages":[`, chatJSON); err != nil {
+		return err
+	}
+
+	first := true
+	err = e.forEachMessage(ctx, info.ID, until, senders, func(message exportedMessage) error {
+		messageJSON, err := json.Marshal(message)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		if !first {
+			if _, err = io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(messageJSON)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+func (e *ChatExporter) writeMessagesHTML(ctx context.Context, w io.Writer, info exportedChat, until time.Time, senders map[uuid.UUID]string) error {
+	if err := chatArchiveTemplate.ExecuteTemplate(w, "header", info); err != nil {
+		return fmt.Errorf("template header: %w", err)
+	}
+
+	err := e.forEachMessage(ctx, info.ID, until, senders, func(message exportedMessage) error {
+		if err := chatArchiveTemplate.ExecuteTemplate(w, "message", message); err != nil {
+			return fmt.Errorf("template message: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = chatArchiveTemplate.ExecuteTemplate(w, "footer", info); err != nil {
+		return fmt.Errorf("template footer: %w", err)
+	}
+	return nil
+}
+
+// forEachMessage pages through the chat history from the first message to the last one sent until the given time
+func (e *ChatExporter) forEachMessage(ctx context.Context, chatId uuid.UUID, until time.Time, senders map[uuid.UUID]string, fn func(message exportedMessage) error) error {
+	var after int64
+	for {
+		messages, err := e.messageRepo.GetMessagesForChatAfterSeq(ctx, chatId, e.pageSize, after)
+		if err != nil {
+			return fmt.Errorf("e.messageRepo.GetMessagesForChatAfterSeq: %w", err)
+		}
+		if err = e.loadSenders(ctx, messages, senders); err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if message.CreatedAt.After(until) {
+				return nil
+			}
+			if err = fn(toExportedMessage(message, senders)); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < e.pageSize {
+			return nil
+		}
+		after = messages[len(messages)-1].Seq
+	}
+}
+
+// loadSenders adds names of the message senders missing in the cache, deleted users get an empty name
+func (e *ChatExporter) loadSenders(ctx context.Context, messages []models.Message, senders map[uuid.UUID]string) error {
+	var missing []uuid.UUID
+	for _, message := range messages {
+		if _, found := senders[message.SenderID]; !found && message.SenderID != uuid.Nil {
+			senders[message.SenderID] = ""
+			missing = append(missing, message.SenderID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	users, err := e.profileRepo.GetPublicUsersInfo(ctx, missing)
+	if err != nil {
+		return fmt.Errorf("e.profileRepo.GetPublicUsersInfo: %w", err)
+	}
+	for _, user := range users {
+		name := strings.TrimSpace(user.Firstname + " " + user.Lastname)
+		if len(name) == 0 {
+			name = user.Username
+		}
+		senders[user.Id] = name
+	}
+	return nil
+}
+
+func (e *ChatExporter) notify(ctx context.Context, export models.ChatExport, status models.ChatExportStatus) {
+	event, err := eventbus.NewEvent(ChatExportEventFinished, chatExportFinishedPayload{
+		ExportId: export.ID,
+		ChatId:   export.ChatID,
+		Status:   string(status),
+	}, export.UserID)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal chat export %s: %v", export.ID, err)
+		return
+	}
+	if err = e.publisher.Publish(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to notify about chat export %s: %v", export.ID, err)
+	}
+}
+
+type exportedChat struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name,omitempty"`
+	Type       string    `json:"type"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+type exportedFile struct {
+	URL  string `json:"url"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+type exportedMessage struct {
+	ID           uuid.UUID      `json:"id"`
+	SenderID     uuid.UUID      `json:"sender_id"`
+	SenderName   string         `json:"sender_name,omitempty"`
+	Text         string         `json:"text,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	EditedAt     *time.Time     `json:"edited_at,omitempty"`
+	ReplyToID    *uuid.UUID     `json:"reply_to_id,omitempty"`
+	SystemAction string         `json:"system_action,omitempty"`
+	Attachments  []exportedFile `json:"attachments,omitempty"`
+}
+
+func toExportedMessage(message models.Message, senders map[uuid.UUID]string) exportedMessage {
+	exported := exportedMessage{
+		ID:         message.ID,
+		SenderID:   message.SenderID,
+		SenderName: senders[message.SenderID],
+		Text:       message.Text,
+		CreatedAt:  message.CreatedAt,
+		EditedAt:   message.EditedAt,
+	}
+	if message.ReplyToID != uuid.Nil {
+		exported.ReplyToID = &message.ReplyToID
+	}
+	if message.System != nil {
+		exported.SystemAction = string(message.System.Action)
+	}
+	for _, file := range message.Attachments {
+		exported.Attachments = append(exported.Attachments, exportedFile{
+			URL:  file.URL,
+			Name: file.Name,
+			Type: string(file.DisplayType),
+		})
+	}
+	return exported
+}
+
+// chatArchiveTemplate renders index.html piece by piece, so the history is never kept in memory
+var chatArchiveTemplate = template.Must(template.New("archive").Parse(`
+{{- define "header" -}}
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{if .Name}}{{.Name}}{{else}}Chat{{end}}</title>
+<style>
+body { font-family: sans-serif; max-width: 800px; margin: 0 auto; padding: 16px; background: #f5f5f5; }
+.message { background: #fff; border-radius: 8px; padding: 8px 12px; margin: 8px 0; }
+.system { text-align: center; color: #888; font-size: 0.9em; }
+.sender { font-weight: bold; }
+.time { color: #888; font-size: 0.8em; margin-left: 8px; }
+.text { white-space: pre-wrap; margin-top: 4px; }
+.reply { display: block; color: #888; font-size: 0.8em; }
+img, video { max-width: 100%; margin-top: 4px; }
+</style>
+</head>
+<body>
+<h1>{{if .Name}}{{.Name}}{{else}}Chat{{end}}</h1>
+<p class="time">Exported {{.ExportedAt.UTC.Format "02.01.2006 15:04"}} UTC</p>
+{{end -}}
+
+{{- define "message" -}}
+{{if .SystemAction -}}
+<div class="system" id="{{.ID}}">{{.SenderName}} {{.SystemAction}} {{if .Text}}{{.Text}}{{end}}</div>
+{{else -}}
+<div class="message" id="{{.ID}}">
+<span class="sender">{{if .SenderName}}{{.SenderName}}{{else}}Deleted user{{end}}</span>
+<span class="time">{{.CreatedAt.UTC.Format "02.01.2006 15:04"}}{{if .EditedAt}} (edited){{end}}</span>
+{{with .ReplyToID}}<a class="reply" href="#{{.}}">In reply to a message</a>{{end}}
+{{if .Text}}<div class="text">{{.Text}}</div>{{end}}
+{{range .Attachments -}}
+{{if eq .Type "media"}}<a href="{{.URL}}"><img src="{{.URL}}" alt="{{.Name}}"></a>
+{{else if eq .Type "audio"}}<audio controls src="{{.URL}}"></audio>
+{{else}}<a href="{{.URL}}">{{if .Name}}{{.Name}}{{else}}{{.URL}}{{end}}</a>
+{{end}}
+{{- end -}}
+</div>
+{{end -}}
+{{end -}}
+
+{{- define "footer" -}}
+</body>
+</html>
+{{end -}}
+`))
@@ -2,8 +2,12 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,7 +19,8 @@ import (
 
 type ChatRepository interface {
 	CreateChat(ctx context.Context, chat models.Chat) error
-	GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error)
+	UpdateChat(ctx context.Context, chat models.Chat) error
+	GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error)
 	GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error)
 	GetChat(ctx context.Context, chatId uuid.UUID) (models.Chat, error)
 	GetPrivateChat(ctx context.Context, senderId, receiverId uuid.UUID) (models.Chat, error)
@@ -24,16 +29,40 @@ type ChatRepository interface {
 	IsParticipant(ctx context.Context, chatId, userId uuid.UUID) (bool, error)
 	JoinChat(ctx context.Context, chatId, userId uuid.UUID) error
 	LeaveChat(ctx context.Context, chatId, userId uuid.UUID) error
+	GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error)
+	GetChatRole(ctx context.Context, chatId, userId uuid.UUID) (models.ChatRole, error)
+	CreateGroupChat(ctx context.Context, chat models.Chat, members []models.ChatMember, message models.Message) error
+	AddChatMembers(ctx context.Context, chatId uuid.UUID, memberIds []uuid.UUID, messages []models.Message) error
+	RemoveChatMember(ctx context.Context, chatId, memberId uuid.UUID, message models.Message) error
+	SetChatRoles(ctx context.Context, chatId uuid.UUID, members []models.ChatMember, message models.Message) error
+	CreateChatInvite(ctx context.Context, invite models.ChatInvite) error
+	GetChatInvite(ctx context.Context, token string) (models.ChatInvite, error)
+	GetChatInvites(ctx context.Context, chatId uuid.UUID) ([]models.ChatInvite, error)
+	JoinChatByInvite(ctx context.Context, token string, chatId, userId uuid.UUID) error
+	DeleteChatInvite(ctx context.Context, token string) error
 	GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error)
+	SetChatMuted(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error
+	SetChatArchived(ctx context.Context, chatId, userId uuid.UUID, archived bool) error
+	GetPinnedChats(ctx context.Context, userId uuid.UUID) ([]uuid.UUID, error)
+	SetPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error
+	SetChatMessageTTL(ctx context.Context, chatId uuid.UUID, ttl time.Duration) error
+	SetChatEncrypted(ctx context.Context, chatId uuid.UUID, encrypted bool) error
 }
 
+// maxPinnedChats limits the number of chats the user can keep on top of the chat list
+const maxPinnedChats = 5
+
 type ChatValidator interface {
 	ValidateChatCreationInfo(chatInfo models.ChatCreationInfo) error
+	ValidateChatUpdateInfo(info models.ChatUpdateInfo) error
+	ValidateChatInviteCreationInfo(info models.ChatInviteCreationInfo) error
+	ValidateMessageTTL(ttl time.Duration) error
 }
 
 type FileService interface {
 	UploadFile(ctx context.Context, file *models.File) (string, error)
 	UploadManyFiles(ctx context.Context, files []*models.File) ([]string, error)
+	DeletePrivateFile(ctx context.Context, filename string) error
 }
 
 type ProfileService interface {
@@ -58,11 +87,12 @@ func NewChatUseCase(charRepo ChatRepository, fileRepo FileService, profileRepo P
 	}
 }
 
-// CreateChat создает новый чат
-func (c *ChatService) CreateChat(ctx context.Context, chatInfo models.ChatCreationInfo) (models.Chat, error) {
+// CreateChat создает новый чат.
+// Group chats are joined by the creator and the listed members, the returned system message announces the chat.
+func (c *ChatService) CreateChat(ctx context.Context, userId uuid.UUID, chatInfo models.ChatCreationInfo) (models.Chat, *models.Message, error) {
 	// validation
 	if err := c.validator.ValidateChatCreationInfo(chatInfo); err != nil {
-		return models.Chat{}, messenger_errors.ErrInvalidChatCreationInfo
+		return models.Chat{}, nil, messenger_errors.ErrInvalidChatCreationInfo
 	}
 
 	var chat models.Chat
@@ -76,9 +106,17 @@ func (c *ChatService) CreateChat(ctx context.Context, chatInfo models.ChatCreati
 			UpdatedAt: time.Now(),
 		}
 	case models.ChatTypeGroup:
-		imageURL, err := c.fileRepo.UploadFile(ctx, chatInfo.Avatar)
-		if err != nil {
-			return models.Chat{}, fmt.Errorf("c.fileRepo.UploadFile: %w", err)
+		if userId == uuid.Nil {
+			return models.Chat{}, nil, messenger_errors.ErrInvalidChatCreationInfo
+		}
+
+		var imageURL string
+		if chatInfo.Avatar != nil {
+			var err error
+			imageURL, err = c.fileRepo.UploadFile(ctx, chatInfo.Avatar)
+			if err != nil {
+				return models.Chat{}, nil, fmt.Errorf("c.fileRepo.UploadFile: %w", err)
+			}
 		}
 		chat = models.Chat{
 			Type:      chatInfo.Type,
@@ -90,15 +128,439 @@ func (c *ChatService) CreateChat(ctx context.Context, chatInfo models.ChatCreati
 		}
 	}
 
-	if err := c.chatRepo.CreateChat(ctx, chat); err != nil {
-		return models.Chat{}, fmt.Errorf("c.chatRepo.CreateChat: %w", err)
+	if chat.Type != models.ChatTypeGroup {
+		if err := c.chatRepo.CreateChat(ctx, chat); err != nil {
+			return models.Chat{}, nil, fmt.Errorf("c.chatRepo.CreateChat: %w", err)
+		}
+		return chat, nil, nil
+	}
+
+	members := []models.ChatMember{{UserID: userId, Role: models.ChatRoleOwner}}
+	joined := map[uuid.UUID]struct{}{userId: {}}
+	for _, memberId := range chatInfo.MemberIDs {
+		if _, ok := joined[memberId]; ok || memberId == uuid.Nil {
+			continue
+		}
+		members = append(members, models.ChatMember{UserID: memberId, Role: models.ChatRoleMember})
+		joined[memberId] = struct{}{}
+	}
+
+	message := newSystemMessage(chat.ID, userId, chat.Name, models.SystemInfo{Action: models.SystemActionChatCreated})
+	if err := c.chatRepo.CreateGroupChat(ctx, chat, members, message); err != nil {
+		return models.Chat{}, nil, fmt.Errorf("c.chatRepo.CreateGroupChat: %w", err)
+	}
+
+	message, err := c.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return models.Chat{}, nil, fmt.Errorf("c.messageRepo.GetMessageById: %w", err)
+	}
+	return chat, &message, nil
+}
+
+// AddChatMembers adds users to the group chat on behalf of its admin.
+// Returns a system message for every added member.
+func (c *ChatService) AddChatMembers(ctx context.Context, chatId, userId uuid.UUID, memberIds []uuid.UUID) ([]models.Message, error) {
+	// repeated ids are added once, before anything is written
+	unique := make([]uuid.UUID, 0, len(memberIds))
+	for _, memberId := range memberIds {
+		if memberId == uuid.Nil {
+			return nil, messenger_errors.ErrInvalidChatMembers
+		}
+		if !slices.Contains(unique, memberId) {
+			unique = append(unique, memberId)
+		}
+	}
+	memberIds = unique
+	if len(memberIds) == 0 {
+		return nil, messenger_errors.ErrInvalidChatMembers
+	}
+	if _, err := c.getGroupChatAsAdmin(ctx, chatId, userId); err != nil {
+		return nil, err
+	}
+
+	for _, memberId := range memberIds {
+		isParticipant, err := c.chatRepo.IsParticipant(ctx, chatId, memberId)
+		if err != nil {
+			return nil, fmt.Errorf("c.chatRepo.IsParticipant: %w", err)
+		}
+		if isParticipant {
+			return nil, messenger_errors.ErrAlreadyInChat
+		}
+	}
+
+	messages := make([]models.Message, 0, len(memberIds))
+	for _, memberId := range memberIds {
+		messages = append(messages, newSystemMessage(chatId, userId, "",
+			models.SystemInfo{Action: models.SystemActionMemberAdded, UserID: memberId}))
+	}
+	if err := c.chatRepo.AddChatMembers(ctx, chatId, memberIds, messages); err != nil {
+		return nil, fmt.Errorf("c.chatRepo.AddChatMembers: %w", err)
+	}
+
+	return c.getSavedMessages(ctx, messages)
+}
+
+// RemoveChatMember removes the member from the group chat, user removing himself leaves the chat.
+// Admins can remove only members ranked below them, the owner has to pass the ownership before leaving.
+func (c *ChatService) RemoveChatMember(ctx context.Context, chatId, userId, memberId uuid.UUID) (models.Message, error) {
+	_, role, err := c.getGroupChatRole(ctx, chatId, userId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	action := models.SystemActionMemberLeft
+	if memberId == userId {
+		if role == models.ChatRoleOwner {
+			return models.Message{}, messenger_errors.ErrOwnerCannotLeave
+		}
+	} else {
+		if !role.CanModerate() {
+			return models.Message{}, messenger_errors.ErrNotChatAdmin
+		}
+
+		memberRole, err := c.chatRepo.GetChatRole(ctx, chatId, memberId)
+		if err != nil {
+			return models.Message{}, fmt.Errorf("c.chatRepo.GetChatRole: %w", err)
+		}
+		if chatRoleRank(memberRole) >= chatRoleRank(role) {
+			return models.Message{}, messenger_errors.ErrNotChatAdmin
+		}
+		action = models.SystemActionMemberRemoved
+	}
+
+	message := newSystemMessage(chatId, userId, "", models.SystemInfo{Action: action, UserID: memberId})
+	if err = c.chatRepo.RemoveChatMember(ctx, chatId, memberId, message); err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.RemoveChatMember: %w", err)
+	}
+
+	message, err = c.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.messageRepo.GetMessageById: %w", err)
+	}
+	return message, nil
+}
+
+// UpdateChat renames the group chat and/or changes its avatar.
+// Returns the updated chat and a system message for every change.
+func (c *ChatService) UpdateChat(ctx context.Context, chatId, userId uuid.UUID, info models.ChatUpdateInfo) (models.Chat, []models.Message, error) {
+	if err := c.validator.ValidateChatUpdateInfo(info); err != nil {
+		return models.Chat{}, nil, messenger_errors.ErrInvalidChatUpdateInfo
+	}
+
+	chat, err := c.getGroupChatAsAdmin(ctx, chatId, userId)
+	if err != nil {
+		return models.Chat{}, nil, err
+	}
+
+	var changes []models.SystemInfo
+	if len(info.Name) != 0 && info.Name != chat.Name {
+		chat.Name = info.Name
+		changes = append(changes, models.SystemInfo{Action: models.SystemActionChatRenamed})
+	}
+	if info.Avatar != nil {
+		chat.AvatarURL, err = c.fileRepo.UploadFile(ctx, info.Avatar)
+		if err != nil {
+			return models.Chat{}, nil, fmt.Errorf("c.fileRepo.UploadFile: %w", err)
+		}
+		changes = append(changes, models.SystemInfo{Action: models.SystemActionAvatarChanged})
+	}
+	if len(changes) == 0 {
+		return chat, nil, nil
+	}
+
+	chat.UpdatedAt = time.Now()
+	if err = c.chatRepo.UpdateChat(ctx, chat); err != nil {
+		return models.Chat{}, nil, fmt.Errorf("c.chatRepo.UpdateChat: %w", err)
+	}
+
+	messages := make([]models.Message, 0, len(changes))
+	for _, change := range changes {
+		text := chat.Name
+		if change.Action == models.SystemActionAvatarChanged {
+			text = chat.AvatarURL
+		}
+		message, err := c.saveSystemMessage(ctx, chatId, userId, text, change)
+		if err != nil {
+			return models.Chat{}, nil, err
+		}
+		messages = append(messages, message)
+	}
+	return chat, messages, nil
+}
+
+// ChangeChatRole sets the role of the group chat member, only the owner can do it.
+// Making another member the owner passes the ownership, the old owner becomes admin.
+func (c *ChatService) ChangeChatRole(ctx context.Context, chatId, userId, memberId uuid.UUID, role models.ChatRole) (models.Message, error) {
+	if role != models.ChatRoleMember && role != models.ChatRoleAdmin && role != models.ChatRoleOwner {
+		return models.Message{}, messenger_errors.ErrInvalidChatRole
+	}
+	if memberId == userId {
+		return models.Message{}, messenger_errors.ErrInvalidChatRole
+	}
+
+	_, userRole, err := c.getGroupChatRole(ctx, chatId, userId)
+	if err != nil {
+		return models.Message{}, err
+	}
+	if userRole != models.ChatRoleOwner {
+		return models.Message{}, messenger_errors.ErrNotChatAdmin
+	}
+
+	memberRole, err := c.chatRepo.GetChatRole(ctx, chatId, memberId)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.GetChatRole: %w", err)
+	}
+	if memberRole == role {
+		return models.Message{}, messenger_errors.ErrInvalidChatRole
+	}
+
+	members := []models.ChatMember{{UserID: memberId, Role: role}}
+	if role == models.ChatRoleOwner {
+		members = append(members, models.ChatMember{UserID: userId, Role: models.ChatRoleAdmin})
 	}
 
+	message := newSystemMessage(chatId, userId, string(role),
+		models.SystemInfo{Action: models.SystemActionRoleChanged, UserID: memberId})
+	if err = c.chatRepo.SetChatRoles(ctx, chatId, members, message); err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.SetChatRoles: %w", err)
+	}
+
+	message, err = c.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.messageRepo.GetMessageById: %w", err)
+	}
+	return message, nil
+}
+
+func (c *ChatService) GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error) {
+	members, err := c.chatRepo.GetChatMembers(ctx, chatId)
+	if err != nil {
+		return nil, fmt.Errorf("c.chatRepo.GetChatMembers: %w", err)
+	}
+	return members, nil
+}
+
+// CreateChatInvite generates a link to join the group chat on behalf of its admin
+func (c *ChatService) CreateChatInvite(ctx context.Context, chatId, userId uuid.UUID, info models.ChatInviteCreationInfo) (models.ChatInvite, error) {
+	if err := c.validator.ValidateChatInviteCreationInfo(info); err != nil {
+		return models.ChatInvite{}, messenger_errors.ErrInvalidChatInvite
+	}
+	if _, err := c.getGroupChatAsAdmin(ctx, chatId, userId); err != nil {
+		return models.ChatInvite{}, err
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return models.ChatInvite{}, fmt.Errorf("generateInviteToken: %w", err)
+	}
+	invite := models.ChatInvite{
+		Token:      token,
+		ChatID:     chatId,
+		CreatorID:  userId,
+		CreatedAt:  time.Now(),
+		ExpiresAt:  info.ExpiresAt,
+		UsageLimit: info.UsageLimit,
+	}
+	if err = c.chatRepo.CreateChatInvite(ctx, invite); err != nil {
+		return models.ChatInvite{}, fmt.Errorf("c.chatRepo.CreateChatInvite: %w", err)
+	}
+	return invite, nil
+}
+
+// GetChatInvites returns active invites of the group chat, only admins can list them
+func (c *ChatService) GetChatInvites(ctx context.Context, chatId, userId uuid.UUID) ([]models.ChatInvite, error) {
+	if _, err := c.getGroupChatAsAdmin(ctx, chatId, userId); err != nil {
+		return nil, err
+	}
+
+	invites, err := c.chatRepo.GetChatInvites(ctx, chatId)
+	if err != nil {
+		return nil, fmt.Errorf("c.chatRepo.GetChatInvites: %w", err)
+	}
+	return invites, nil
+}
+
+// RevokeChatInvite deletes the invite, so nobody can join through it anymore
+func (c *ChatService) RevokeChatInvite(ctx context.Context, token string, userId uuid.UUID) error {
+	invite, err := c.chatRepo.GetChatInvite(ctx, token)
+	if err != nil {
+		return fmt.Errorf("c.chatRepo.GetChatInvite: %w", err)
+	}
+	if _, err = c.getGroupChatAsAdmin(ctx, invite.ChatID, userId); err != nil {
+		return err
+	}
+
+	if err = c.chatRepo.DeleteChatInvite(ctx, token); err != nil {
+		return fmt.Errorf("c.chatRepo.DeleteChatInvite: %w", err)
+	}
+	return nil
+}
+
+// GetChatInvitePreview returns the chat behind the active invite to show it before joining
+func (c *ChatService) GetChatInvitePreview(ctx context.Context, token string) (models.ChatPreview, error) {
+	invite, err := c.getActiveChatInvite(ctx, token)
+	if err != nil {
+		return models.ChatPreview{}, err
+	}
+
+	chat, err := c.chatRepo.GetChat(ctx, invite.ChatID)
+	if err != nil {
+		return models.ChatPreview{}, fmt.Errorf("c.chatRepo.GetChat: %w", err)
+	}
+	participants, err := c.chatRepo.GetChatParticipants(ctx, invite.ChatID)
+	if err != nil {
+		return models.ChatPreview{}, fmt.Errorf("c.chatRepo.GetChatParticipants: %w", err)
+	}
+	return models.ChatPreview{Chat: chat, NumMembers: len(participants)}, nil
+}
+
+// JoinChatByInvite adds the user to the chat of the invite.
+// Returns the chat and a system message about the new member.
+func (c *ChatService) JoinChatByInvite(ctx context.Context, token string, userId uuid.UUID) (models.Chat, models.Message, error) {
+	invite, err := c.getActiveChatInvite(ctx, token)
+	if err != nil {
+		return models.Chat{}, models.Message{}, err
+	}
+
+	isParticipant, err := c.chatRepo.IsParticipant(ctx, invite.ChatID, userId)
+	if err != nil {
+		return models.Chat{}, models.Message{}, fmt.Errorf("c.chatRepo.IsParticipant: %w", err)
+	}
+	if isParticipant {
+		return models.Chat{}, models.Message{}, messenger_errors.ErrAlreadyInChat
+	}
+
+	// the membership and the usage are saved together, it fails if the last use was taken
+	// or the user joined concurrently
+	if err = c.chatRepo.JoinChatByInvite(ctx, token, invite.ChatID, userId); err != nil {
+		return models.Chat{}, models.Message{}, fmt.Errorf("c.chatRepo.JoinChatByInvite: %w", err)
+	}
+
+	message, err := c.saveSystemMessage(ctx, invite.ChatID, userId, "",
+		models.SystemInfo{Action: models.SystemActionMemberJoined, UserID: userId})
+	if err != nil {
+		return models.Chat{}, models.Message{}, err
+	}
+
+	chat, err := c.chatRepo.GetChat(ctx, invite.ChatID)
+	if err != nil {
+		return models.Chat{}, models.Message{}, fmt.Errorf("c.chatRepo.GetChat: %w", err)
+	}
+	return chat, message, nil
+}
+
+func (c *ChatService) getActiveChatInvite(ctx context.Context, token string) (models.ChatInvite, error) {
+	invite, err := c.chatRepo.GetChatInvite(ctx, token)
+	if err != nil {
+		return models.ChatInvite{}, fmt.Errorf("c.chatRepo.GetChatInvite: %w", err)
+	}
+	if !invite.IsActive(time.Now()) {
+		return models.ChatInvite{}, messenger_errors.ErrChatInviteExpired
+	}
+	return invite, nil
+}
+
+func generateInviteToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// getGroupChatRole returns the group chat and role of the user in it
+func (c *ChatService) getGroupChatRole(ctx context.Context, chatId, userId uuid.UUID) (models.Chat, models.ChatRole, error) {
+	chat, err := c.chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return models.Chat{}, "", fmt.Errorf("c.chatRepo.GetChat: %w", err)
+	}
+	if chat.Type != models.ChatTypeGroup {
+		return models.Chat{}, "", messenger_errors.ErrInvalidChatType
+	}
+
+	role, err := c.chatRepo.GetChatRole(ctx, chatId, userId)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		return models.Chat{}, "", messenger_errors.ErrNotParticipant
+	} else if err != nil {
+		return models.Chat{}, "", fmt.Errorf("c.chatRepo.GetChatRole: %w", err)
+	}
+	return chat, role, nil
+}
+
+func (c *ChatService) getGroupChatAsAdmin(ctx context.Context, chatId, userId uuid.UUID) (models.Chat, error) {
+	chat, role, err := c.getGroupChatRole(ctx, chatId, userId)
+	if err != nil {
+		return models.Chat{}, err
+	}
+	if !role.CanModerate() {
+		return models.Chat{}, messenger_errors.ErrNotChatAdmin
+	}
 	return chat, nil
 }
 
-func (c *ChatService) GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error) {
-	chats, err := c.chatRepo.GetUserChats(ctx, userId)
+// chatRoleRank orders roles, moderators can remove only members ranked below them
+func chatRoleRank(role models.ChatRole) int {
+	switch role {
+	case models.ChatRoleOwner:
+		return 2
+	case models.ChatRoleAdmin:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func newSystemMessage(chatId, senderId uuid.UUID, text string, system models.SystemInfo) models.Message {
+	now := time.Now()
+	return models.Message{
+		ID:        uuid.New(),
+		ChatID:    chatId,
+		SenderID:  senderId,
+		Text:      text,
+		CreatedAt: now,
+		UpdatedAt: now,
+		System:    &system,
+	}
+}
+
+func (c *ChatService) saveSystemMessage(ctx context.Context, chatId, senderId uuid.UUID, text string, system models.SystemInfo) (models.Message, error) {
+	message := newSystemMessage(chatId, senderId, text, system)
+	if err := c.messageRepo.SaveMessage(ctx, message); err != nil {
+		return models.Message{}, fmt.Errorf("c.messageRepo.SaveMessage: %w", err)
+	}
+
+	saved, err := c.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.messageRepo.GetMessageById: %w", err)
+	}
+	return saved, nil
+}
+
+// getSavedMessages reloads the messages saved by the repository in their original order
+func (c *ChatService) getSavedMessages(ctx context.Context, messages []models.Message) ([]models.Message, error) {
+	ids := make([]uuid.UUID, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	saved, err := c.messageRepo.GetMessagesByIds(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("c.messageRepo.GetMessagesByIds: %w", err)
+	}
+
+	result := make([]models.Message, 0, len(ids))
+	for _, id := range ids {
+		message, ok := saved[id]
+		if !ok {
+			return nil, messenger_errors.ErrNotFound
+		}
+		result = append(result, message)
+	}
+	return result, nil
+}
+
+// GetUserChats returns archived or not archived chats of the user, pinned chats come first
+func (c *ChatService) GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error) {
+	chats, err := c.chatRepo.GetUserChats(ctx, userId, archived)
 	if err != nil {
 		return nil, fmt.Errorf("c.chatRepo.GetUserChats: %w", err)
 	}
@@ -109,7 +571,18 @@ func (c *ChatService) GetUserChats(ctx context.Context, userId uuid.UUID) ([]mod
 		i := i
 
 		if chats[i].Type != models.ChatTypePrivate {
-			chatsCopy[i] = chats[i]
+			g.Go(func() error {
+				lastMessage, err := c.messageRepo.GetLastChatMessage(ctx, chats[i].ID)
+				if err != nil {
+					return fmt.Errorf("c.messageRepo.GetLastChatMessage: %w", err)
+				}
+
+				chatsCopy[i] = chats[i]
+				if lastMessage != nil {
+					chatsCopy[i].LastMessage = *lastMessage
+				}
+				return nil
+			})
 			continue
 		}
 
@@ -171,6 +644,21 @@ func (c *ChatService) GetUserChats(ctx context.Context, userId uuid.UUID) ([]mod
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
+	if len(chatsCopy) == 0 {
+		return chatsCopy, nil
+	}
+
+	chatIds := make([]uuid.UUID, len(chatsCopy))
+	for i := range chatsCopy {
+		chatIds[i] = chatsCopy[i].ID
+	}
+	pinned, err := c.messageRepo.GetPinnedMessages(ctx, chatIds)
+	if err != nil {
+		return nil, fmt.Errorf("c.messageRepo.GetPinnedMessages: %w", err)
+	}
+	for i := range chatsCopy {
+		chatsCopy[i].PinnedMessages = pinned[chatsCopy[i].ID]
+	}
 	return chatsCopy, nil
 }
 
@@ -179,17 +667,45 @@ func (c *ChatService) GetChat(ctx context.Context, chatId uuid.UUID) (models.Cha
 	if err != nil {
 		return models.Chat{}, fmt.Errorf("c.chatRepo.GetChat: %w", err)
 	}
-	return chat, nil
-}
 
-func (c *ChatService) DeleteChat(ctx context.Context, chatId uuid.UUID) error {
-	exists, err := c.chatRepo.Exists(ctx, chatId)
+	pinned, err := c.messageRepo.GetPinnedMessages(ctx, []uuid.UUID{chatId})
 	if err != nil {
-		return fmt.Errorf("c.chatRepo.Exists: %w", err)
+		return models.Chat{}, fmt.Errorf("c.messageRepo.GetPinnedMessages: %w", err)
 	}
-	if !exists {
+	chat.PinnedMessages = pinned[chatId]
+	return chat, nil
+}
+
+// DeleteChat deletes the chat on behalf of the user. Any participant can delete a private chat,
+// a group chat can be deleted only by its owner.
+func (c *ChatService) DeleteChat(ctx context.Context, chatId, userId uuid.UUID) error {
+	chat, err := c.chatRepo.GetChat(ctx, chatId)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
 		return messenger_errors.ErrNotFound
+	} else if err != nil {
+		return fmt.Errorf("c.chatRepo.GetChat: %w", err)
 	}
+
+	if chat.Type == models.ChatTypeGroup {
+		role, err := c.chatRepo.GetChatRole(ctx, chatId, userId)
+		if errors.Is(err, messenger_errors.ErrNotFound) {
+			return messenger_errors.ErrNotParticipant
+		} else if err != nil {
+			return fmt.Errorf("c.chatRepo.GetChatRole: %w", err)
+		}
+		if role != models.ChatRoleOwner {
+			return messenger_errors.ErrNotChatAdmin
+		}
+	} else {
+		isParticipant, err := c.chatRepo.IsParticipant(ctx, chatId, userId)
+		if err != nil {
+			return fmt.Errorf("c.chatRepo.IsParticipant: %w", err)
+		}
+		if !isParticipant {
+			return messenger_errors.ErrNotParticipant
+		}
+	}
+
 	err = c.chatRepo.DeleteChat(ctx, chatId)
 	if err != nil {
 		return fmt.Errorf("c.chatRepo.DeleteChat: %w", err)
@@ -269,3 +785,150 @@ func (c *ChatService) GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (
 	}
 	return numUnreadChats, nil
 }
+
+// MuteChat turns off notifications from the chat for the user until the time, nil turns them back on
+func (c *ChatService) MuteChat(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error {
+	err := c.chatRepo.SetChatMuted(ctx, chatId, userId, mutedUntil)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		return messenger_errors.ErrNotParticipant
+	} else if err != nil {
+		return fmt.Errorf("c.chatRepo.SetChatMuted: %w", err)
+	}
+	return nil
+}
+
+// ArchiveChat hides the chat from the main chat list of the user or brings it back
+func (c *ChatService) ArchiveChat(ctx context.Context, chatId, userId uuid.UUID, archived bool) error {
+	err := c.chatRepo.SetChatArchived(ctx, chatId, userId, archived)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		return messenger_errors.ErrNotParticipant
+	} else if err != nil {
+		return fmt.Errorf("c.chatRepo.SetChatArchived: %w", err)
+	}
+	return nil
+}
+
+// PinChat puts the chat after the other pinned chats of the user or unpins it
+func (c *ChatService) PinChat(ctx context.Context, chatId, userId uuid.UUID, pinned bool) error {
+	pinnedChats, err := c.chatRepo.GetPinnedChats(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("c.chatRepo.GetPinnedChats: %w", err)
+	}
+
+	idx := slices.Index(pinnedChats, chatId)
+	if pinned == (idx >= 0) {
+		return nil
+	}
+	if pinned {
+		if len(pinnedChats) >= maxPinnedChats {
+			return messenger_errors.ErrTooManyPinnedChats
+		}
+		pinnedChats = append(pinnedChats, chatId)
+	} else {
+		pinnedChats = slices.Delete(pinnedChats, idx, idx+1)
+	}
+
+	err = c.chatRepo.SetPinnedChats(ctx, userId, pinnedChats)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		return messenger_errors.ErrNotParticipant
+	} else if err != nil {
+		return fmt.Errorf("c.chatRepo.SetPinnedChats: %w", err)
+	}
+	return nil
+}
+
+// ReorderPinnedChats sets the order of pinned chats, every pinned chat of the user must be listed
+func (c *ChatService) ReorderPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error {
+	pinnedChats, err := c.chatRepo.GetPinnedChats(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("c.chatRepo.GetPinnedChats: %w", err)
+	}
+
+	if len(chatIds) != len(pinnedChats) {
+		return messenger_errors.ErrInvalidPinnedChats
+	}
+	seen := make(map[uuid.UUID]struct{}, len(chatIds))
+	for _, chatId := range chatIds {
+		if _, ok := seen[chatId]; ok || !slices.Contains(pinnedChats, chatId) {
+			return messenger_errors.ErrInvalidPinnedChats
+		}
+		seen[chatId] = struct{}{}
+	}
+
+	if err = c.chatRepo.SetPinnedChats(ctx, userId, chatIds); err != nil {
+		return fmt.Errorf("c.chatRepo.SetPinnedChats: %w", err)
+	}
+	return nil
+}
+
+// SetChatMessageTTL makes messages sent to the chat from now on disappear after ttl, zero turns it off.
+// Any participant of a private chat and only admins of a group chat can change it.
+// Returns the system message about the change.
+func (c *ChatService) SetChatMessageTTL(ctx context.Context, chatId, userId uuid.UUID, ttl time.Duration) (models.Message, error) {
+	if err := c.validator.ValidateMessageTTL(ttl); err != nil {
+		return models.Message{}, messenger_errors.ErrInvalidMessageTTL
+	}
+
+	chat, err := c.chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.GetChat: %w", err)
+	}
+	if chat.Type == models.ChatTypeGroup {
+		role, err := c.chatRepo.GetChatRole(ctx, chatId, userId)
+		if errors.Is(err, messenger_errors.ErrNotFound) {
+			return models.Message{}, messenger_errors.ErrNotParticipant
+		} else if err != nil {
+			return models.Message{}, fmt.Errorf("c.chatRepo.GetChatRole: %w", err)
+		}
+		if !role.CanModerate() {
+			return models.Message{}, messenger_errors.ErrNotChatAdmin
+		}
+	} else {
+		isParticipant, err := c.chatRepo.IsParticipant(ctx, chatId, userId)
+		if err != nil {
+			return models.Message{}, fmt.Errorf("c.chatRepo.IsParticipant: %w", err)
+		}
+		if !isParticipant {
+			return models.Message{}, messenger_errors.ErrNotParticipant
+		}
+	}
+
+	ttl = ttl.Truncate(time.Second)
+	if err = c.chatRepo.SetChatMessageTTL(ctx, chatId, ttl); err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.SetChatMessageTTL: %w", err)
+	}
+
+	return c.saveSystemMessage(ctx, chatId, userId, strconv.Itoa(int(ttl/time.Second)),
+		models.SystemInfo{Action: models.SystemActionMessageTTLChanged})
+}
+
+// SetChatEncryption turns end-to-end encryption of the private chat on or off, any participant can change it.
+// Messages sent before the change are left as is. Returns the system message about the change.
+func (c *ChatService) SetChatEncryption(ctx context.Context, chatId, userId uuid.UUID, enabled bool) (models.Message, error) {
+	chat, err := c.chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.GetChat: %w", err)
+	}
+	if chat.Type != models.ChatTypePrivate {
+		return models.Message{}, messenger_errors.ErrInvalidChatType
+	}
+
+	isParticipant, err := c.chatRepo.IsParticipant(ctx, chatId, userId)
+	if err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return models.Message{}, messenger_errors.ErrNotParticipant
+	}
+
+	if err = c.chatRepo.SetChatEncrypted(ctx, chatId, enabled); err != nil {
+		return models.Message{}, fmt.Errorf("c.chatRepo.SetChatEncrypted: %w", err)
+	}
+
+	text := "off"
+	if enabled {
+		text = "on"
+	}
+	return c.saveSystemMessage(ctx, chatId, userId, text,
+		models.SystemInfo{Action: models.SystemActionEncryptionChanged})
+}
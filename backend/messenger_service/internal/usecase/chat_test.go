@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"quickflow/messenger_service/internal/usecase/mocks"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -69,9 +71,10 @@ func TestCreateChat_Private(t *testing.T) {
 		}).
 		Return(nil)
 
-	result, err := service.CreateChat(ctx, chatInfo)
+	result, systemMessage, err := service.CreateChat(ctx, uuid.Nil, chatInfo)
 
 	assert.NoError(t, err)
+	assert.Nil(t, systemMessage)
 	assert.Equal(t, models.ChatTypePrivate, result.Type)
 	assert.NotEqual(t, uuid.Nil, result.ID)
 }
@@ -82,18 +85,22 @@ func TestCreateChat_Group(t *testing.T) {
 
 	mockChatRepo := mocks.NewMockChatRepository(ctrl)
 	mockFileRepo := mocks.NewMockFileService(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
 	mockValidator := mocks.NewMockChatValidator(ctrl)
 	service := NewChatUseCase(
 		mockChatRepo,
-		mockFileRepo, nil, nil,
+		mockFileRepo, nil, mockMessageRepo,
 		mockValidator,
 	)
 
 	ctx := context.Background()
+	creatorID := uuid.New()
+	memberID := uuid.New()
 	chatInfo := models.ChatCreationInfo{
-		Type:   models.ChatTypeGroup,
-		Name:   "Test Group",
-		Avatar: &models.File{},
+		Type:      models.ChatTypeGroup,
+		Name:      "Test Group",
+		Avatar:    &models.File{},
+		MemberIDs: []uuid.UUID{memberID, creatorID, memberID},
 	}
 
 	mockValidator.EXPECT().
@@ -104,18 +111,34 @@ func TestCreateChat_Group(t *testing.T) {
 		UploadFile(ctx, chatInfo.Avatar).
 		Return("avatar_url", nil)
 
+	// создатель и участники добавляются по одному разу вместе с системным сообщением
+	var saved models.Message
 	mockChatRepo.EXPECT().
-		CreateChat(ctx, gomock.Any()).
-		Do(func(_ context.Context, chat models.Chat) {
+		CreateGroupChat(ctx, gomock.Any(), []models.ChatMember{
+			{UserID: creatorID, Role: models.ChatRoleOwner},
+			{UserID: memberID, Role: models.ChatRoleMember},
+		}, gomock.Any()).
+		Do(func(_ context.Context, chat models.Chat, _ []models.ChatMember, message models.Message) {
 			assert.Equal(t, models.ChatTypeGroup, chat.Type)
 			assert.Equal(t, "Test Group", chat.Name)
 			assert.Equal(t, "avatar_url", chat.AvatarURL)
+			saved = message
 		}).
 		Return(nil)
+	mockMessageRepo.EXPECT().
+		GetMessageById(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID) (models.Message, error) {
+			return saved, nil
+		})
 
-	result, err := service.CreateChat(ctx, chatInfo)
+	result, systemMessage, err := service.CreateChat(ctx, creatorID, chatInfo)
 
 	assert.NoError(t, err)
+	assert.NotNil(t, systemMessage)
+	assert.Equal(t, creatorID, systemMessage.SenderID)
+	assert.Equal(t, result.ID, systemMessage.ChatID)
+	assert.Equal(t, "Test Group", systemMessage.Text)
+	assert.Equal(t, &models.SystemInfo{Action: models.SystemActionChatCreated}, systemMessage.System)
 	assert.Equal(t, models.ChatTypeGroup, result.Type)
 	assert.Equal(t, "Test Group", result.Name)
 	assert.Equal(t, "avatar_url", result.AvatarURL)
@@ -138,7 +161,7 @@ func TestCreateChat_ValidationError(t *testing.T) {
 		ValidateChatCreationInfo(chatInfo).
 		Return(errors.New("validation error"))
 
-	_, err := service.CreateChat(ctx, chatInfo)
+	_, _, err := service.CreateChat(ctx, uuid.New(), chatInfo)
 
 	assert.Error(t, err)
 	assert.Equal(t, messenger_errors.ErrInvalidChatCreationInfo, err)
@@ -170,7 +193,7 @@ func TestCreateChat_UploadError(t *testing.T) {
 		UploadFile(ctx, chatInfo.Avatar).
 		Return("", errors.New("upload error"))
 
-	_, err := service.CreateChat(ctx, chatInfo)
+	_, _, err := service.CreateChat(ctx, uuid.New(), chatInfo)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "upload error")
@@ -204,7 +227,7 @@ func TestGetUserChats(t *testing.T) {
 
 	// Mock expectations
 	mockChatRepo.EXPECT().
-		GetUserChats(ctx, userID).
+		GetUserChats(ctx, userID, false).
 		Return(chats, nil)
 
 	mockChatRepo.EXPECT().
@@ -231,12 +254,18 @@ func TestGetUserChats(t *testing.T) {
 		GetLastChatMessage(gomock.Any(), chatID).
 		Return(&models.Message{Text: "last message"}, nil)
 
+	pinned := []models.PinnedMessage{{Message: models.MessagePreview{ID: uuid.New(), Text: "address"}, PinnedBy: otherUserID}}
+	mockMessageRepo.EXPECT().
+		GetPinnedMessages(gomock.Any(), []uuid.UUID{chatID}).
+		Return(map[uuid.UUID][]models.PinnedMessage{chatID: pinned}, nil)
+
 	// Execute
-	result, err := service.GetUserChats(ctx, userID)
+	result, err := service.GetUserChats(ctx, userID, false)
 
 	// Verify
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
+	assert.Equal(t, pinned, result[0].PinnedMessages)
 }
 
 func TestGetChat(t *testing.T) {
@@ -244,59 +273,87 @@ func TestGetChat(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
 	service := NewChatUseCase(
 		mockChatRepo,
-		nil, nil, nil,
+		nil, nil, mockMessageRepo,
 		nil,
 	)
 
 	ctx := context.Background()
 	chatID := uuid.New()
 	expectedChat := models.Chat{ID: chatID}
+	pinned := []models.PinnedMessage{{Message: models.MessagePreview{ID: uuid.New()}, PinnedBy: uuid.New()}}
 
 	mockChatRepo.EXPECT().
 		GetChat(ctx, chatID).
 		Return(expectedChat, nil)
+	mockMessageRepo.EXPECT().
+		GetPinnedMessages(ctx, []uuid.UUID{chatID}).
+		Return(map[uuid.UUID][]models.PinnedMessage{chatID: pinned}, nil)
 
 	result, err := service.GetChat(ctx, chatID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, chatID, result.ID)
+	assert.Equal(t, pinned, result.PinnedMessages)
 }
 
 func TestDeleteChat(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockChatRepo := mocks.NewMockChatRepository(ctrl)
-	service := NewChatUseCase(
-		mockChatRepo,
-		nil, nil, nil,
-		nil,
-	)
-
 	ctx := context.Background()
-	chatID := uuid.New()
-
-	// Success case
-	mockChatRepo.EXPECT().
-		Exists(ctx, chatID).
-		Return(true, nil)
-
-	mockChatRepo.EXPECT().
-		DeleteChat(ctx, chatID).
-		Return(nil)
-
-	err := service.DeleteChat(ctx, chatID)
-	assert.NoError(t, err)
-
-	// Chat doesn't exist
-	mockChatRepo.EXPECT().
-		Exists(ctx, chatID).
-		Return(false, nil)
+	chatID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		chat        models.Chat
+		chatErr     error
+		role        models.ChatRole
+		roleErr     error
+		participant bool
+		wantDelete  bool
+		wantErr     error
+	}{
+		{name: "private chat participant", chat: models.Chat{ID: chatID, Type: models.ChatTypePrivate}, participant: true, wantDelete: true},
+		{name: "private chat stranger", chat: models.Chat{ID: chatID, Type: models.ChatTypePrivate}, wantErr: messenger_errors.ErrNotParticipant},
+		{name: "group chat owner", chat: models.Chat{ID: chatID, Type: models.ChatTypeGroup}, role: models.ChatRoleOwner, wantDelete: true},
+		{name: "group chat admin", chat: models.Chat{ID: chatID, Type: models.ChatTypeGroup}, role: models.ChatRoleAdmin, wantErr: messenger_errors.ErrNotChatAdmin},
+		{name: "group chat member", chat: models.Chat{ID: chatID, Type: models.ChatTypeGroup}, role: models.ChatRoleMember, wantErr: messenger_errors.ErrNotChatAdmin},
+		{name: "group chat stranger", chat: models.Chat{ID: chatID, Type: models.ChatTypeGroup}, roleErr: messenger_errors.ErrNotFound, wantErr: messenger_errors.ErrNotParticipant},
+		{name: "chat doesn't exist", chatErr: messenger_errors.ErrNotFound, wantErr: messenger_errors.ErrNotFound},
+	}
 
-	err = service.DeleteChat(ctx, chatID)
-	assert.Equal(t, messenger_errors.ErrNotFound, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			service := NewChatUseCase(
+				mockChatRepo,
+				nil, nil, nil,
+				nil,
+			)
+
+			mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(tt.chat, tt.chatErr)
+			switch {
+			case tt.chatErr != nil:
+			case tt.chat.Type == models.ChatTypeGroup:
+				mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(tt.role, tt.roleErr)
+			default:
+				mockChatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(tt.participant, nil)
+			}
+			if tt.wantDelete {
+				mockChatRepo.EXPECT().DeleteChat(ctx, chatID).Return(nil)
+			}
+
+			err := service.DeleteChat(ctx, chatID, userID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestJoinChat(t *testing.T) {
@@ -495,23 +552,23 @@ func TestGetUserChats_ErrorHandling(t *testing.T) {
 
 	// Test GetUserChats error
 	mockChatRepo.EXPECT().
-		GetUserChats(ctx, userID).
+		GetUserChats(ctx, userID, false).
 		Return(nil, errors.New("database error"))
 
-	_, err := service.GetUserChats(ctx, userID)
+	_, err := service.GetUserChats(ctx, userID, false)
 	assert.Error(t, err)
 
 	// Test GetChatParticipants error
 	chats := []models.Chat{{ID: uuid.New(), Type: models.ChatTypePrivate}}
 	mockChatRepo.EXPECT().
-		GetUserChats(ctx, userID).
+		GetUserChats(ctx, userID, false).
 		Return(chats, nil)
 
 	mockChatRepo.EXPECT().
 		GetChatParticipants(gomock.Any(), chats[0].ID).
 		Return(nil, errors.New("participants error")).AnyTimes()
 
-	_, err = service.GetUserChats(ctx, userID)
+	_, err = service.GetUserChats(ctx, userID, false)
 	assert.Error(t, err)
 }
 
@@ -542,7 +599,7 @@ func TestGetUserChats_ConcurrentProcessing(t *testing.T) {
 
 	// Mock expectations
 	mockChatRepo.EXPECT().
-		GetUserChats(ctx, userID).
+		GetUserChats(ctx, userID, false).
 		Return(chats, nil)
 
 	// Each chat will have its own participants
@@ -563,9 +620,958 @@ func TestGetUserChats_ConcurrentProcessing(t *testing.T) {
 			GetLastChatMessage(gomock.Any(), chat.ID).
 			Return(&models.Message{}, nil)
 	}
+	mockMessageRepo.EXPECT().
+		GetPinnedMessages(gomock.Any(), gomock.Len(len(chats))).
+		Return(map[uuid.UUID][]models.PinnedMessage{}, nil)
 
 	// Execute and verify concurrent processing
-	result, err := service.GetUserChats(ctx, userID)
+	result, err := service.GetUserChats(ctx, userID, false)
 	assert.NoError(t, err)
 	assert.Len(t, result, 10)
 }
+
+func TestAddChatMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+	mockChatRepo.EXPECT().IsParticipant(ctx, chatID, memberID).Return(false, nil)
+	var messageID uuid.UUID
+	mockChatRepo.EXPECT().
+		AddChatMembers(ctx, chatID, []uuid.UUID{memberID}, gomock.Any()).
+		Do(func(_ context.Context, _ uuid.UUID, _ []uuid.UUID, messages []models.Message) {
+			assert.Len(t, messages, 1)
+			assert.Equal(t, userID, messages[0].SenderID)
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberAdded, UserID: memberID}, messages[0].System)
+			messageID = messages[0].ID
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().
+		GetMessagesByIds(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []uuid.UUID) (map[uuid.UUID]models.Message, error) {
+			return map[uuid.UUID]models.Message{messageID: {ID: messageID, Seq: 7}}, nil
+		})
+
+	// Вызов метода, повторный id добавляется один раз
+	messages, err := service.AddChatMembers(ctx, chatID, userID, []uuid.UUID{memberID, memberID})
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, int64(7), messages[0].Seq)
+}
+
+func TestAddChatMembers_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name      string
+		memberIds []uuid.UUID
+		mockSetup func(chatRepo *mocks.MockChatRepository)
+		wantErr   error
+	}{
+		{
+			name: "private chat",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypePrivate}, nil)
+			},
+			wantErr: messenger_errors.ErrInvalidChatType,
+		},
+		{
+			name:      "nil member",
+			memberIds: []uuid.UUID{memberID, uuid.Nil},
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {},
+			wantErr:   messenger_errors.ErrInvalidChatMembers,
+		},
+		{
+			name: "not participant",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRole(""), messenger_errors.ErrNotFound)
+			},
+			wantErr: messenger_errors.ErrNotParticipant,
+		},
+		{
+			name: "not admin",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleMember, nil)
+			},
+			wantErr: messenger_errors.ErrNotChatAdmin,
+		},
+		{
+			name: "already in chat",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleOwner, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, memberID).Return(true, nil)
+			},
+			wantErr: messenger_errors.ErrAlreadyInChat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			tt.mockSetup(mockChatRepo)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			memberIds := tt.memberIds
+			if memberIds == nil {
+				memberIds = []uuid.UUID{memberID}
+			}
+			_, err := service.AddChatMembers(ctx, chatID, userID, memberIds)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRemoveChatMember_Leave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleMember, nil)
+	mockChatRepo.EXPECT().
+		RemoveChatMember(ctx, chatID, userID, gomock.Any()).
+		Do(func(_ context.Context, _, _ uuid.UUID, message models.Message) {
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberLeft, UserID: userID}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{}, nil)
+
+	// Вызов метода
+	_, err := service.RemoveChatMember(ctx, chatID, userID, userID)
+
+	// Проверки
+	assert.NoError(t, err)
+}
+
+func TestRemoveChatMember_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, memberID).Return(models.ChatRole(""), messenger_errors.ErrNotFound)
+
+	_, err := service.RemoveChatMember(ctx, chatID, userID, memberID)
+	assert.ErrorIs(t, err, messenger_errors.ErrNotFound)
+}
+
+func TestRemoveChatMember_Permissions(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name       string
+		userRole   models.ChatRole
+		memberRole models.ChatRole
+		memberID   uuid.UUID
+		wantErr    error
+	}{
+		{name: "member removes member", userRole: models.ChatRoleMember, memberID: memberID, wantErr: messenger_errors.ErrNotChatAdmin},
+		{name: "admin removes admin", userRole: models.ChatRoleAdmin, memberRole: models.ChatRoleAdmin, memberID: memberID, wantErr: messenger_errors.ErrNotChatAdmin},
+		{name: "admin removes owner", userRole: models.ChatRoleAdmin, memberRole: models.ChatRoleOwner, memberID: memberID, wantErr: messenger_errors.ErrNotChatAdmin},
+		{name: "owner leaves", userRole: models.ChatRoleOwner, memberID: userID, wantErr: messenger_errors.ErrOwnerCannotLeave},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+			mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(tt.userRole, nil)
+			if len(tt.memberRole) != 0 {
+				mockChatRepo.EXPECT().GetChatRole(ctx, chatID, tt.memberID).Return(tt.memberRole, nil)
+			}
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			_, err := service.RemoveChatMember(ctx, chatID, userID, tt.memberID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRemoveChatMember_AdminRemovesMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, memberID).Return(models.ChatRoleMember, nil)
+	mockChatRepo.EXPECT().
+		RemoveChatMember(ctx, chatID, memberID, gomock.Any()).
+		Do(func(_ context.Context, _, _ uuid.UUID, message models.Message) {
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberRemoved, UserID: memberID}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{}, nil)
+
+	// Вызов метода
+	_, err := service.RemoveChatMember(ctx, chatID, userID, memberID)
+
+	// Проверки
+	assert.NoError(t, err)
+}
+
+func TestUpdateChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockFileRepo := mocks.NewMockFileService(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	mockValidator := mocks.NewMockChatValidator(ctrl)
+	service := NewChatUseCase(mockChatRepo, mockFileRepo, nil, mockMessageRepo, mockValidator)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	info := models.ChatUpdateInfo{Name: "New name", Avatar: &models.File{}}
+
+	// Ожидания для моков
+	mockValidator.EXPECT().ValidateChatUpdateInfo(info).Return(nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup, Name: "Old name"}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+	mockFileRepo.EXPECT().UploadFile(ctx, info.Avatar).Return("avatar_url", nil)
+	mockChatRepo.EXPECT().
+		UpdateChat(ctx, gomock.Any()).
+		Do(func(_ context.Context, chat models.Chat) {
+			assert.Equal(t, "New name", chat.Name)
+			assert.Equal(t, "avatar_url", chat.AvatarURL)
+		}).
+		Return(nil)
+
+	var saved []models.Message
+	mockMessageRepo.EXPECT().
+		SaveMessage(ctx, gomock.Any()).
+		Do(func(_ context.Context, message models.Message) {
+			saved = append(saved, message)
+		}).
+		Return(nil).Times(2)
+	mockMessageRepo.EXPECT().
+		GetMessageById(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID) (models.Message, error) {
+			return saved[len(saved)-1], nil
+		}).Times(2)
+
+	// Вызов метода
+	chat, messages, err := service.UpdateChat(ctx, chatID, userID, info)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, "New name", chat.Name)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, models.SystemActionChatRenamed, messages[0].System.Action)
+	assert.Equal(t, "New name", messages[0].Text)
+	assert.Equal(t, models.SystemActionAvatarChanged, messages[1].System.Action)
+	assert.Equal(t, "avatar_url", messages[1].Text)
+}
+
+func TestUpdateChat_ValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockValidator := mocks.NewMockChatValidator(ctrl)
+	service := NewChatUseCase(nil, nil, nil, nil, mockValidator)
+
+	mockValidator.EXPECT().ValidateChatUpdateInfo(gomock.Any()).Return(errors.New("nothing to update"))
+
+	_, _, err := service.UpdateChat(context.Background(), uuid.New(), uuid.New(), models.ChatUpdateInfo{})
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidChatUpdateInfo)
+}
+
+func TestChangeChatRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, ownerID).Return(models.ChatRoleOwner, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, memberID).Return(models.ChatRoleMember, nil)
+	mockChatRepo.EXPECT().
+		SetChatRoles(ctx, chatID, []models.ChatMember{{UserID: memberID, Role: models.ChatRoleAdmin}}, gomock.Any()).
+		Do(func(_ context.Context, _ uuid.UUID, _ []models.ChatMember, message models.Message) {
+			assert.Equal(t, ownerID, message.SenderID)
+			assert.Equal(t, string(models.ChatRoleAdmin), message.Text)
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionRoleChanged, UserID: memberID}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{Seq: 3}, nil)
+
+	// Вызов метода
+	message, err := service.ChangeChatRole(ctx, chatID, ownerID, memberID, models.ChatRoleAdmin)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), message.Seq)
+}
+
+func TestChangeChatRole_TransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, ownerID).Return(models.ChatRoleOwner, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, adminID).Return(models.ChatRoleAdmin, nil)
+	// новый владелец и бывший владелец-админ сохраняются одной транзакцией
+	mockChatRepo.EXPECT().SetChatRoles(ctx, chatID, []models.ChatMember{
+		{UserID: adminID, Role: models.ChatRoleOwner},
+		{UserID: ownerID, Role: models.ChatRoleAdmin},
+	}, gomock.Any()).Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{}, nil)
+
+	// Вызов метода
+	_, err := service.ChangeChatRole(ctx, chatID, ownerID, adminID, models.ChatRoleOwner)
+
+	// Проверки
+	assert.NoError(t, err)
+}
+
+func TestChangeChatRole_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name      string
+		memberID  uuid.UUID
+		role      models.ChatRole
+		mockSetup func(chatRepo *mocks.MockChatRepository)
+		wantErr   error
+	}{
+		{
+			name:      "invalid role",
+			memberID:  memberID,
+			role:      "moderator",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {},
+			wantErr:   messenger_errors.ErrInvalidChatRole,
+		},
+		{
+			name:      "own role",
+			memberID:  userID,
+			role:      models.ChatRoleMember,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {},
+			wantErr:   messenger_errors.ErrInvalidChatRole,
+		},
+		{
+			name:     "not owner",
+			memberID: memberID,
+			role:     models.ChatRoleAdmin,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+			},
+			wantErr: messenger_errors.ErrNotChatAdmin,
+		},
+		{
+			name:     "same role",
+			memberID: memberID,
+			role:     models.ChatRoleAdmin,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleOwner, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, memberID).Return(models.ChatRoleAdmin, nil)
+			},
+			wantErr: messenger_errors.ErrInvalidChatRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			tt.mockSetup(mockChatRepo)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			_, err := service.ChangeChatRole(ctx, chatID, userID, tt.memberID, tt.role)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCreateChatInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockValidator := mocks.NewMockChatValidator(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, nil, mockValidator)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+	info := models.ChatInviteCreationInfo{ExpiresAt: &expiresAt, UsageLimit: 5}
+
+	// Ожидания для моков
+	mockValidator.EXPECT().ValidateChatInviteCreationInfo(info).Return(nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleAdmin, nil)
+	mockChatRepo.EXPECT().CreateChatInvite(ctx, gomock.Any()).Return(nil)
+
+	// Вызов метода
+	invite, err := service.CreateChatInvite(ctx, chatID, userID, info)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.NotEmpty(t, invite.Token)
+	assert.Equal(t, chatID, invite.ChatID)
+	assert.Equal(t, userID, invite.CreatorID)
+	assert.Equal(t, &expiresAt, invite.ExpiresAt)
+	assert.Equal(t, 5, invite.UsageLimit)
+}
+
+func TestCreateChatInvite_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator)
+		wantErr   error
+	}{
+		{
+			name: "invalid info",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator) {
+				validator.EXPECT().ValidateChatInviteCreationInfo(gomock.Any()).Return(errors.New("negative invite usage limit"))
+			},
+			wantErr: messenger_errors.ErrInvalidChatInvite,
+		},
+		{
+			name: "not admin",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator) {
+				validator.EXPECT().ValidateChatInviteCreationInfo(gomock.Any()).Return(nil)
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleMember, nil)
+			},
+			wantErr: messenger_errors.ErrNotChatAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			mockValidator := mocks.NewMockChatValidator(ctrl)
+			tt.mockSetup(mockChatRepo, mockValidator)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, mockValidator)
+
+			_, err := service.CreateChatInvite(ctx, chatID, userID, models.ChatInviteCreationInfo{})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRevokeChatInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mockChatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{Token: "token", ChatID: chatID}, nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+	mockChatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleOwner, nil)
+	mockChatRepo.EXPECT().DeleteChatInvite(ctx, "token").Return(nil)
+
+	err := service.RevokeChatInvite(ctx, "token", userID)
+	assert.NoError(t, err)
+}
+
+func TestGetChatInvitePreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	chat := models.Chat{ID: chatID, Type: models.ChatTypeGroup, Name: "Study Group"}
+
+	mockChatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{Token: "token", ChatID: chatID}, nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(chat, nil)
+	mockChatRepo.EXPECT().GetChatParticipants(ctx, chatID).Return([]uuid.UUID{uuid.New(), uuid.New()}, nil)
+
+	preview, err := service.GetChatInvitePreview(ctx, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, models.ChatPreview{Chat: chat, NumMembers: 2}, preview)
+}
+
+func TestJoinChatByInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	invite := models.ChatInvite{Token: "token", ChatID: chatID, UsageLimit: 2, UsageCount: 1}
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChatInvite(ctx, "token").Return(invite, nil)
+	mockChatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(false, nil)
+	mockChatRepo.EXPECT().JoinChatByInvite(ctx, "token", chatID, userID).Return(nil)
+	mockMessageRepo.EXPECT().
+		SaveMessage(ctx, gomock.Any()).
+		Do(func(_ context.Context, message models.Message) {
+			assert.Equal(t, userID, message.SenderID)
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberJoined, UserID: userID}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{Seq: 4}, nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+
+	// Вызов метода
+	chat, message, err := service.JoinChatByInvite(ctx, "token", userID)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, chatID, chat.ID)
+	assert.Equal(t, int64(4), message.Seq)
+}
+
+func TestJoinChatByInvite_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	expired := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		mockSetup func(chatRepo *mocks.MockChatRepository)
+		wantErr   error
+	}{
+		{
+			name: "unknown token",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{}, messenger_errors.ErrNotFound)
+			},
+			wantErr: messenger_errors.ErrNotFound,
+		},
+		{
+			name: "expired",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{ChatID: chatID, ExpiresAt: &expired}, nil)
+			},
+			wantErr: messenger_errors.ErrChatInviteExpired,
+		},
+		{
+			name: "exhausted",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{ChatID: chatID, UsageLimit: 3, UsageCount: 3}, nil)
+			},
+			wantErr: messenger_errors.ErrChatInviteExpired,
+		},
+		{
+			name: "already in chat",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{ChatID: chatID}, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(true, nil)
+			},
+			wantErr: messenger_errors.ErrAlreadyInChat,
+		},
+		{
+			name: "last use taken concurrently",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{ChatID: chatID, UsageLimit: 1}, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(false, nil)
+				chatRepo.EXPECT().JoinChatByInvite(ctx, "token", chatID, userID).Return(messenger_errors.ErrChatInviteExpired)
+			},
+			wantErr: messenger_errors.ErrChatInviteExpired,
+		},
+		{
+			name: "joined concurrently",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChatInvite(ctx, "token").Return(models.ChatInvite{ChatID: chatID}, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(false, nil)
+				chatRepo.EXPECT().JoinChatByInvite(ctx, "token", chatID, userID).Return(messenger_errors.ErrAlreadyInChat)
+			},
+			wantErr: messenger_errors.ErrAlreadyInChat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			tt.mockSetup(mockChatRepo)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			_, _, err := service.JoinChatByInvite(ctx, "token", userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPinChat(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	pinned := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name      string
+		pinned    bool
+		mockSetup func(chatRepo *mocks.MockChatRepository)
+		wantErr   error
+	}{
+		{
+			name:   "pins after others",
+			pinned: true,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetPinnedChats(ctx, userID).Return(slices.Clone(pinned), nil)
+				chatRepo.EXPECT().SetPinnedChats(ctx, userID, []uuid.UUID{pinned[0], pinned[1], chatID}).Return(nil)
+			},
+		},
+		{
+			name:   "already pinned",
+			pinned: true,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetPinnedChats(ctx, userID).Return([]uuid.UUID{chatID}, nil)
+			},
+		},
+		{
+			name:   "unpins keeping order",
+			pinned: false,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetPinnedChats(ctx, userID).Return([]uuid.UUID{pinned[0], chatID, pinned[1]}, nil)
+				chatRepo.EXPECT().SetPinnedChats(ctx, userID, pinned).Return(nil)
+			},
+		},
+		{
+			name:   "too many pinned",
+			pinned: true,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetPinnedChats(ctx, userID).Return(
+					[]uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}, nil)
+			},
+			wantErr: messenger_errors.ErrTooManyPinnedChats,
+		},
+		{
+			name:   "not a participant",
+			pinned: true,
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetPinnedChats(ctx, userID).Return(nil, nil)
+				chatRepo.EXPECT().SetPinnedChats(ctx, userID, []uuid.UUID{chatID}).Return(messenger_errors.ErrNotFound)
+			},
+			wantErr: messenger_errors.ErrNotParticipant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			tt.mockSetup(mockChatRepo)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			err := service.PinChat(ctx, chatID, userID, tt.pinned)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReorderPinnedChats(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		chatIds []uuid.UUID
+		wantErr error
+	}{
+		{name: "reordered", chatIds: []uuid.UUID{second, first}},
+		{name: "missing chat", chatIds: []uuid.UUID{second}, wantErr: messenger_errors.ErrInvalidPinnedChats},
+		{name: "duplicate chat", chatIds: []uuid.UUID{second, second}, wantErr: messenger_errors.ErrInvalidPinnedChats},
+		{name: "not pinned chat", chatIds: []uuid.UUID{second, uuid.New()}, wantErr: messenger_errors.ErrInvalidPinnedChats},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			mockChatRepo.EXPECT().GetPinnedChats(ctx, userID).Return([]uuid.UUID{first, second}, nil)
+			if tt.wantErr == nil {
+				mockChatRepo.EXPECT().SetPinnedChats(ctx, userID, tt.chatIds).Return(nil)
+			}
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			err := service.ReorderPinnedChats(ctx, userID, tt.chatIds)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSetChatMessageTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	mockValidator := mocks.NewMockChatValidator(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, mockValidator)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	ttl := time.Hour + 500*time.Millisecond
+
+	// Ожидания для моков
+	mockValidator.EXPECT().ValidateMessageTTL(ttl).Return(nil)
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypePrivate}, nil)
+	mockChatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(true, nil)
+	mockChatRepo.EXPECT().SetChatMessageTTL(ctx, chatID, time.Hour).Return(nil)
+	mockMessageRepo.EXPECT().
+		SaveMessage(ctx, gomock.Any()).
+		Do(func(_ context.Context, message models.Message) {
+			assert.Equal(t, "3600", message.Text)
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMessageTTLChanged}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{Seq: 5}, nil)
+
+	// Вызов метода
+	message, err := service.SetChatMessageTTL(ctx, chatID, userID, ttl)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), message.Seq)
+}
+
+func TestSetChatMessageTTL_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator)
+		wantErr   error
+	}{
+		{
+			name: "invalid ttl",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator) {
+				validator.EXPECT().ValidateMessageTTL(time.Minute).Return(errors.New("message ttl too short"))
+			},
+			wantErr: messenger_errors.ErrInvalidMessageTTL,
+		},
+		{
+			name: "group member",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator) {
+				validator.EXPECT().ValidateMessageTTL(time.Minute).Return(nil)
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+				chatRepo.EXPECT().GetChatRole(ctx, chatID, userID).Return(models.ChatRoleMember, nil)
+			},
+			wantErr: messenger_errors.ErrNotChatAdmin,
+		},
+		{
+			name: "not a participant",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockChatValidator) {
+				validator.EXPECT().ValidateMessageTTL(time.Minute).Return(nil)
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypePrivate}, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(false, nil)
+			},
+			wantErr: messenger_errors.ErrNotParticipant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			mockValidator := mocks.NewMockChatValidator(ctrl)
+			tt.mockSetup(mockChatRepo, mockValidator)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, mockValidator)
+
+			_, err := service.SetChatMessageTTL(ctx, chatID, userID, time.Minute)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSetChatEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	mockChatRepo := mocks.NewMockChatRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	service := NewChatUseCase(mockChatRepo, nil, nil, mockMessageRepo, nil)
+
+	// Подготовка тестовых данных
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	// Ожидания для моков
+	mockChatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypePrivate}, nil)
+	mockChatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(true, nil)
+	mockChatRepo.EXPECT().SetChatEncrypted(ctx, chatID, true).Return(nil)
+	mockMessageRepo.EXPECT().
+		SaveMessage(ctx, gomock.Any()).
+		Do(func(_ context.Context, message models.Message) {
+			assert.Equal(t, "on", message.Text)
+			assert.Equal(t, &models.SystemInfo{Action: models.SystemActionEncryptionChanged}, message.System)
+		}).
+		Return(nil)
+	mockMessageRepo.EXPECT().GetMessageById(ctx, gomock.Any()).Return(models.Message{Seq: 3}, nil)
+
+	// Вызов метода
+	message, err := service.SetChatEncryption(ctx, chatID, userID, true)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), message.Seq)
+}
+
+func TestSetChatEncryption_Errors(t *testing.T) {
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(chatRepo *mocks.MockChatRepository)
+		wantErr   error
+	}{
+		{
+			name: "group chat",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypeGroup}, nil)
+			},
+			wantErr: messenger_errors.ErrInvalidChatType,
+		},
+		{
+			name: "not a participant",
+			mockSetup: func(chatRepo *mocks.MockChatRepository) {
+				chatRepo.EXPECT().GetChat(ctx, chatID).Return(models.Chat{ID: chatID, Type: models.ChatTypePrivate}, nil)
+				chatRepo.EXPECT().IsParticipant(ctx, chatID, userID).Return(false, nil)
+			},
+			wantErr: messenger_errors.ErrNotParticipant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChatRepo := mocks.NewMockChatRepository(ctrl)
+			tt.mockSetup(mockChatRepo)
+			service := NewChatUseCase(mockChatRepo, nil, nil, nil, nil)
+
+			_, err := service.SetChatEncryption(ctx, chatID, userID, true)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+)
+
+// MessageEventDeleted is the ws event the gateway sends to participants on message deletion
+const MessageEventDeleted = "message_delete"
+
+// EventPublisher delivers ws events to the users connected to the gateway
+type EventPublisher interface {
+	Publish(ctx context.Context, event eventbus.Event) error
+}
+
+// deletedMessagePayload has the same fields as the one of the gateway, so clients handle both alike
+type deletedMessagePayload struct {
+	ChatId    uuid.UUID `json:"chat_id"`
+	MessageId uuid.UUID `json:"message_id"`
+	Seq       int64     `json:"seq"`
+}
+
+// MessageReaper periodically deletes messages of disappearing chats once they expire
+type MessageReaper struct {
+	messageRepo MessageRepository
+	chatRepo    ChatRepository
+	publisher   EventPublisher
+	interval    time.Duration
+	batchSize   int
+}
+
+func NewMessageReaper(messageRepo MessageRepository, chatRepo ChatRepository, publisher EventPublisher, interval time.Duration, batchSize int) *MessageReaper {
+	return &MessageReaper{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		publisher:   publisher,
+		interval:    interval,
+		batchSize:   batchSize,
+	}
+}
+
+// Run deletes expired messages every interval until ctx is done
+func (r *MessageReaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a full batch means more messages may have expired
+			for {
+				deleted, err := r.ReapExpired(ctx)
+				if err != nil {
+					logger.Error(ctx, "Failed to delete expired messages: %v", err)
+					break
+				}
+				if deleted < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ReapExpired deletes one batch of expired messages, notifies participants of their chats
+// and returns the number of deleted messages.
+// Messages are already deleted when notification fails, clients get the deletion on resume.
+func (r *MessageReaper) ReapExpired(ctx context.Context) (int, error) {
+	events, err := r.messageRepo.DeleteExpiredMessages(ctx, time.Now(), r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	participants := make(map[uuid.UUID][]uuid.UUID)
+	for _, event := range events {
+		receivers, found := participants[event.ChatID]
+		if !found {
+			receivers, err = r.chatRepo.GetChatParticipants(ctx, event.ChatID)
+			if err != nil {
+				logger.Error(ctx, "Failed to get chat %s participants: %v", event.ChatID, err)
+				continue
+			}
+			participants[event.ChatID] = receivers
+		}
+
+		wsEvent, err := eventbus.NewEvent(MessageEventDeleted, deletedMessagePayload{
+			ChatId:    event.ChatID,
+			MessageId: event.MessageID,
+			Seq:       event.Seq,
+		}, receivers...)
+		if err != nil {
+			logger.Error(ctx, "Failed to marshal deletion of message %s: %v", event.MessageID, err)
+			continue
+		}
+		if err = r.publisher.Publish(ctx, wsEvent); err != nil {
+			logger.Error(ctx, "Failed to notify about deletion of message %s: %v", event.MessageID, err)
+		}
+	}
+
+	if len(events) != 0 {
+		logger.Info(ctx, "Deleted %d expired messages", len(events))
+	}
+	return len(events), nil
+}
@@ -0,0 +1,105 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/messenger_service/internal/usecase"
+	"quickflow/messenger_service/internal/usecase/mocks"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/models"
+)
+
+func TestMessageReaper_ReapExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	// Подготовка тестовых данных
+	chatID := uuid.New()
+	participants := []uuid.UUID{uuid.New(), uuid.New()}
+	events := []models.ChatEvent{
+		{Seq: 3, Type: models.ChatEventMessageDeleted, ChatID: chatID, MessageID: uuid.New()},
+		{Seq: 4, Type: models.ChatEventMessageDeleted, ChatID: chatID, MessageID: uuid.New()},
+	}
+
+	// Ожидания для моков
+	messageRepo.EXPECT().DeleteExpiredMessages(gomock.Any(), gomock.Any(), 2).Return(events, nil)
+	chatRepo.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return(participants, nil).Times(1)
+	var published []eventbus.Event
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventbus.Event) error {
+		published = append(published, event)
+		return nil
+	}).Times(2)
+
+	// Вызов метода
+	reaper := usecase.NewMessageReaper(messageRepo, chatRepo, publisher, 0, 2)
+	deleted, err := reaper.ReapExpired(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	require.Len(t, published, 2)
+	for i, event := range published {
+		assert.Equal(t, usecase.MessageEventDeleted, event.Type)
+		assert.Equal(t, participants, event.Receivers)
+		assert.JSONEq(t, fmt.Sprintf(`{"chat_id":%q,"message_id":%q,"seq":%d}`,
+			chatID, events[i].MessageID, events[i].Seq), string(event.Payload))
+	}
+}
+
+func TestMessageReaper_ReapExpired_PublishError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	// Подготовка тестовых данных
+	chatID := uuid.New()
+	events := []models.ChatEvent{{Seq: 1, Type: models.ChatEventMessageDeleted, ChatID: chatID, MessageID: uuid.New()}}
+
+	// Ожидания для моков
+	messageRepo.EXPECT().DeleteExpiredMessages(gomock.Any(), gomock.Any(), 10).Return(events, nil)
+	chatRepo.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return([]uuid.UUID{uuid.New()}, nil)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("redis is down"))
+
+	// Вызов метода
+	reaper := usecase.NewMessageReaper(messageRepo, chatRepo, publisher, 0, 10)
+	deleted, err := reaper.ReapExpired(context.Background())
+
+	// Проверки: messages are deleted even if clients were not notified
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
+
+func TestMessageReaper_ReapExpired_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+
+	// Ожидания для моков
+	messageRepo.EXPECT().DeleteExpiredMessages(gomock.Any(), gomock.Any(), 10).Return(nil, errors.New("db error"))
+
+	// Вызов метода
+	reaper := usecase.NewMessageReaper(messageRepo, nil, nil, 0, 10)
+	_, err := reaper.ReapExpired(context.Background())
+
+	// Проверки
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,20 +15,50 @@ import (
 
 type MessageRepository interface {
 	GetMessageById(ctx context.Context, messageId uuid.UUID) (models.Message, error)
+	GetMessagesByIds(ctx context.Context, messageIds []uuid.UUID) (map[uuid.UUID]models.Message, error)
 	GetMessagesForChatOlder(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error)
+	GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error)
+	GetMessagesForChatAfterSeq(ctx context.Context, chatId uuid.UUID, numMessages int, seq int64) ([]models.Message, error)
 	GetLastChatMessage(ctx context.Context, chatId uuid.UUID) (*models.Message, error)
 
 	SaveMessage(ctx context.Context, message models.Message) error
+	SaveMessages(ctx context.Context, messages []models.Message, newChat *models.Chat, participants []uuid.UUID) error
+	UpdateMessage(ctx context.Context, message models.Message) (int64, error)
 
-	DeleteMessage(ctx context.Context, messageId uuid.UUID) error
+	DeleteMessage(ctx context.Context, messageId uuid.UUID) (int64, error)
+	DeleteExpiredMessages(ctx context.Context, now time.Time, limit int) ([]models.ChatEvent, error)
 
 	GetLastReadTs(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (*time.Time, error)
-	UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId uuid.UUID, userId uuid.UUID) error
+	UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error)
 	GetNumUnreadMessages(ctx context.Context, chatId uuid.UUID, userId uuid.UUID) (int, error)
+	UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error)
+	GetMessageReceipts(ctx context.Context, message models.Message) ([]models.MessageReceipt, error)
+
+	GetChatEventsSince(ctx context.Context, chatId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error)
+
+	AddReaction(ctx context.Context, reaction models.Reaction) (int64, error)
+	RemoveReaction(ctx context.Context, reaction models.Reaction) (int64, error)
+	GetReactions(ctx context.Context, messageIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID][]models.ReactionCount, error)
+
+	PinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error)
+	UnpinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error)
+	GetPinnedMessages(ctx context.Context, chatIds []uuid.UUID) (map[uuid.UUID][]models.PinnedMessage, error)
+
+	GetPolls(ctx context.Context, messageIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]*models.Poll, error)
+	SavePollVotes(ctx context.Context, pollId, userId uuid.UUID, options []int, now time.Time) error
+
+	SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error)
 }
 
+// maxForwardedMessages limits the number of messages forwarded at once
+const maxForwardedMessages = 100
+
 type MessageValidator interface {
 	ValidateMessage(message models.Message) error
+	ValidateReaction(emoji string) error
+	ValidateSearchQuery(query models.MessageSearchQuery) error
+	ValidateSendAt(sendAt, now time.Time) error
+	ValidatePoll(poll models.Poll, now time.Time) error
 }
 
 type MessageService struct {
@@ -65,10 +96,165 @@ func (m *MessageService) GetMessagesForChatOlder(ctx context.Context, chatId uui
 	if err != nil {
 		return nil, err
 	}
+	if err = m.fillReactions(ctx, messages, userId); err != nil {
+		return nil, err
+	}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return nil, err
+	}
 
 	return messages, nil
 }
 
+// GetMessagesForChatNewer returns messages created after the timestamp, the oldest first
+func (m *MessageService) GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, userId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error) {
+	// validation
+	if numMessages <= 0 {
+		return nil, messenger_errors.ErrInvalidNumMessages
+	}
+
+	if err := m.checkParticipant(ctx, chatId, userId); err != nil {
+		return nil, err
+	}
+
+	messages, err := m.messageRepo.GetMessagesForChatNewer(ctx, chatId, numMessages, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if err = m.fillReactions(ctx, messages, userId); err != nil {
+		return nil, err
+	}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// GetMessagesAround returns the anchor message with up to numMessages messages before and after it,
+// so the client can open the chat history at a found, pinned or quoted message
+func (m *MessageService) GetMessagesAround(ctx context.Context, chatId, anchorId, userId uuid.UUID, numMessages int) (models.MessagesPage, error) {
+	// validation
+	if numMessages <= 0 {
+		return models.MessagesPage{}, messenger_errors.ErrInvalidNumMessages
+	}
+
+	if err := m.checkParticipant(ctx, chatId, userId); err != nil {
+		return models.MessagesPage{}, err
+	}
+
+	anchor, err := m.messageRepo.GetMessageById(ctx, anchorId)
+	if err != nil {
+		return models.MessagesPage{}, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	if anchor.ChatID != chatId {
+		return models.MessagesPage{}, messenger_errors.ErrNotFound
+	}
+
+	// one extra message in each direction tells whether there is more to load
+	older, err := m.messageRepo.GetMessagesForChatOlder(ctx, chatId, numMessages+1, anchor.CreatedAt)
+	if err != nil {
+		return models.MessagesPage{}, fmt.Errorf("m.messageRepo.GetMessagesForChatOlder: %w", err)
+	}
+	newer, err := m.messageRepo.GetMessagesForChatNewer(ctx, chatId, numMessages+1, anchor.CreatedAt)
+	if err != nil {
+		return models.MessagesPage{}, fmt.Errorf("m.messageRepo.GetMessagesForChatNewer: %w", err)
+	}
+
+	hasOlder, hasNewer := len(older) > numMessages, len(newer) > numMessages
+	if hasOlder {
+		older = older[1:]
+	}
+	if hasNewer {
+		newer = newer[:numMessages]
+	}
+
+	messages := make([]models.Message, 0, len(older)+len(newer)+1)
+	messages = append(messages, older...)
+	messages = append(messages, anchor)
+	messages = append(messages, newer...)
+	if err = m.fillReactions(ctx, messages, userId); err != nil {
+		return models.MessagesPage{}, err
+	}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return models.MessagesPage{}, err
+	}
+
+	page := models.MessagesPage{Messages: messages}
+	if hasOlder {
+		page.OlderCursor = &messages[0].CreatedAt
+	}
+	if hasNewer {
+		page.NewerCursor = &messages[len(messages)-1].CreatedAt
+	}
+	return page, nil
+}
+
+// fillReactions sets reactions of the messages as seen by the user
+func (m *MessageService) fillReactions(ctx context.Context, messages []models.Message, userId uuid.UUID) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	messageIds := make([]uuid.UUID, len(messages))
+	for i := range messages {
+		messageIds[i] = messages[i].ID
+	}
+	reactions, err := m.messageRepo.GetReactions(ctx, messageIds, userId)
+	if err != nil {
+		return fmt.Errorf("m.messageRepo.GetReactions: %w", err)
+	}
+	for i := range messages {
+		messages[i].Reactions = reactions[messages[i].ID]
+	}
+	return nil
+}
+
+// fillPolls sets polls of the messages with the results the user may see
+func (m *MessageService) fillPolls(ctx context.Context, messages []models.Message, userId uuid.UUID) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	messageIds := make([]uuid.UUID, len(messages))
+	for i := range messages {
+		messageIds[i] = messages[i].ID
+	}
+	polls, err := m.messageRepo.GetPolls(ctx, messageIds, userId)
+	if err != nil {
+		return fmt.Errorf("m.messageRepo.GetPolls: %w", err)
+	}
+	now := time.Now()
+	for i := range messages {
+		messages[i].Poll = polls[messages[i].ID]
+		if messages[i].Poll != nil {
+			messages[i].Poll.HideResults(now)
+		}
+	}
+	return nil
+}
+
+// SearchMessages finds messages by text in the chat or in all chats of the user, the newest first.
+// The next page is requested with To set to creation time of the last found message.
+func (m *MessageService) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	// validation
+	if err := m.validator.ValidateSearchQuery(query); err != nil {
+		return nil, messenger_errors.ErrInvalidSearchQuery
+	}
+
+	if query.ChatID != uuid.Nil {
+		if err := m.checkParticipant(ctx, query.ChatID, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := m.messageRepo.SearchMessages(ctx, userId, query)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.SearchMessages: %w", err)
+	}
+	return results, nil
+}
+
 func (m *MessageService) GetLastChatMessage(ctx context.Context, chatId uuid.UUID) (*models.Message, error) {
 	// validate
 	if chatId == uuid.Nil {
@@ -88,86 +274,594 @@ func (m *MessageService) SaveMessage(ctx context.Context, message models.Message
 	if err != nil {
 		return nil, fmt.Errorf("validation.ValidateMessage: %w", err)
 	}
+	if message.Poll != nil {
+		if err = m.validator.ValidatePoll(*message.Poll, time.Now()); err != nil {
+			return nil, messenger_errors.ErrInvalidPoll
+		}
+		message.Poll.ID = uuid.New()
+		message.Poll.CreatedAt = message.CreatedAt
+	}
 
-	// check if chat exists and create if it doesn't
+	// check if chat exists and create if it doesn't, only participants can write to an existing chat
 	if message.ChatID == uuid.Nil {
-		if message.ReceiverID == uuid.Nil {
-			return nil, fmt.Errorf("both chatId and receiverId are empty")
+		message.ChatID, err = getOrCreatePrivateChat(ctx, m.chatRepo, message.SenderID, message.ReceiverID)
+		if err != nil {
+			return nil, err
 		}
+	} else if err = m.checkParticipant(ctx, message.ChatID, message.SenderID); err != nil {
+		return nil, err
+	}
+	if err = m.checkEncryption(ctx, message); err != nil {
+		return nil, err
+	}
 
-		chat, err := m.chatRepo.GetPrivateChat(ctx, message.SenderID, message.ReceiverID)
+	// replied message must be in the same chat
+	if message.ReplyToID != uuid.Nil {
+		replyTo, err := m.messageRepo.GetMessageById(ctx, message.ReplyToID)
 		if errors.Is(err, messenger_errors.ErrNotFound) {
-			newChat := models.Chat{
+			return nil, messenger_errors.ErrInvalidReplyTo
+		} else if err != nil {
+			return nil, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+		}
+		if replyTo.ChatID != message.ChatID {
+			return nil, messenger_errors.ErrInvalidReplyTo
+		}
+	}
+
+	// Save message to repository
+	err = m.messageRepo.SaveMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	newMessage, err := m.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	if message.Poll != nil {
+		messages := []models.Message{newMessage}
+		if err = m.fillPolls(ctx, messages, message.SenderID); err != nil {
+			return nil, err
+		}
+		newMessage = messages[0]
+	}
+
+	return &newMessage, nil
+}
+
+// checkEncryption makes encrypted chats accept only encrypted messages with key envelopes for their participants
+func (m *MessageService) checkEncryption(ctx context.Context, message models.Message) error {
+	chat, err := m.chatRepo.GetChat(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("m.chatRepo.GetChat: %w", err)
+	}
+	if !chat.Encrypted {
+		if message.Encrypted != nil {
+			return messenger_errors.ErrInvalidEncrypted
+		}
+		return nil
+	}
+	if message.Encrypted == nil {
+		return messenger_errors.ErrChatEncrypted
+	}
+
+	participants, err := m.chatRepo.GetChatParticipants(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("m.chatRepo.GetChatParticipants: %w", err)
+	}
+	for _, envelope := range message.Encrypted.Envelopes {
+		if !slices.Contains(participants, envelope.RecipientID) {
+			return messenger_errors.ErrInvalidEncrypted
+		}
+	}
+	return nil
+}
+
+// checkChatNotEncrypted rejects messages the server composes itself, as forwarded or scheduled ones, in encrypted chats
+func checkChatNotEncrypted(ctx context.Context, chatRepo ChatRepository, chatId uuid.UUID) error {
+	chat, err := chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return fmt.Errorf("chatRepo.GetChat: %w", err)
+	}
+	if chat.Encrypted {
+		return messenger_errors.ErrChatEncrypted
+	}
+	return nil
+}
+
+// getOrCreatePrivateChat returns id of the private chat of the users, creating the chat if there is none
+func getOrCreatePrivateChat(ctx context.Context, chatRepo ChatRepository, senderId, receiverId uuid.UUID) (uuid.UUID, error) {
+	if receiverId == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("both chatId and receiverId are empty")
+	}
+
+	chat, err := chatRepo.GetPrivateChat(ctx, senderId, receiverId)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		newChat := models.Chat{
+			Type:      models.ChatTypePrivate,
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+
+		err = chatRepo.CreateChat(ctx, newChat)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("chatRepo.CreateChat: %w", err)
+		}
+		err = chatRepo.JoinChat(ctx, newChat.ID, senderId)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("chatRepo.JoinChat: %w", err)
+		}
+		err = chatRepo.JoinChat(ctx, newChat.ID, receiverId)
+		if err != nil {
+			chatRepo.LeaveChat(ctx, newChat.ID, senderId)
+			return uuid.Nil, fmt.Errorf("chatRepo.JoinChat: %w", err)
+		}
+		return newChat.ID, nil
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("chatRepo.GetChat: %w", err)
+	}
+	return chat.ID, nil
+}
+
+// ForwardMessages copies messages to the chat, or to the private chat with the receiver if chatId is empty.
+// Copies share attachments with the originals and keep the original author and chat.
+// Encrypted messages and polls can not be forwarded and encrypted chats do not accept forwarded messages.
+// All originals are checked first, then the copies are saved at once, together with the new private chat if needed.
+func (m *MessageService) ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]models.Message, error) {
+	// validate
+	if len(messageIds) == 0 || len(messageIds) > maxForwardedMessages {
+		return nil, messenger_errors.ErrInvalidForward
+	}
+
+	var (
+		newChat *models.Chat
+		err     error
+	)
+	if chatId == uuid.Nil {
+		if receiverId == uuid.Nil {
+			return nil, fmt.Errorf("both chatId and receiverId are empty")
+		}
+		// there is no private chat with yourself
+		if receiverId == userId {
+			return nil, messenger_errors.ErrInvalidForward
+		}
+		chat, err := m.chatRepo.GetPrivateChat(ctx, userId, receiverId)
+		if errors.Is(err, messenger_errors.ErrNotFound) {
+			newChat = &models.Chat{
 				Type:      models.ChatTypePrivate,
 				ID:        uuid.New(),
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
 			}
-
-			err = m.chatRepo.CreateChat(ctx, newChat)
-			if err != nil {
-				return nil, fmt.Errorf("m.chatRepo.CreateChat: %w", err)
-			}
-			err = m.chatRepo.JoinChat(ctx, newChat.ID, message.SenderID)
-			if err != nil {
-				return nil, fmt.Errorf("m.chatRepo.JoinChat: %w", err)
-			}
-			err = m.chatRepo.JoinChat(ctx, newChat.ID, message.ReceiverID)
-			if err != nil {
-				m.chatRepo.LeaveChat(ctx, newChat.ID, message.SenderID)
-				return nil, fmt.Errorf("m.chatRepo.JoinChat: %w", err)
-			}
-			message.ChatID = newChat.ID
+			chatId = newChat.ID
 		} else if err != nil {
-			return nil, fmt.Errorf("m.chatRepo.GetChat: %w", err)
+			return nil, fmt.Errorf("m.chatRepo.GetPrivateChat: %w", err)
+		} else if chat.Encrypted {
+			return nil, messenger_errors.ErrChatEncrypted
 		} else {
-			message.ChatID = chat.ID
+			chatId = chat.ID
+		}
+	} else {
+		if err = m.checkParticipant(ctx, chatId, userId); err != nil {
+			return nil, err
+		}
+		if err = checkChatNotEncrypted(ctx, m.chatRepo, chatId); err != nil {
+			return nil, err
 		}
 	}
 
-	// Save message to repository
-	err = m.messageRepo.SaveMessage(ctx, message)
+	// user must see the originals
+	found, err := m.messageRepo.GetMessagesByIds(ctx, messageIds)
 	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetMessagesByIds: %w", err)
+	}
+	sources := make([]models.Message, 0, len(messageIds))
+	checkedChats := make(map[uuid.UUID]struct{})
+	for _, messageId := range messageIds {
+		source, ok := found[messageId]
+		if !ok {
+			return nil, messenger_errors.ErrNotFound
+		}
+		if source.Encrypted != nil {
+			return nil, messenger_errors.ErrChatEncrypted
+		}
+		if _, ok = checkedChats[source.ChatID]; !ok {
+			if err = m.checkParticipant(ctx, source.ChatID, userId); err != nil {
+				return nil, err
+			}
+			checkedChats[source.ChatID] = struct{}{}
+		}
+		sources = append(sources, source)
+	}
+	if err = m.fillPolls(ctx, sources, userId); err != nil {
 		return nil, err
 	}
+	for _, source := range sources {
+		if source.Poll != nil {
+			return nil, messenger_errors.ErrInvalidForward
+		}
+	}
 
-	newMessage, err := m.messageRepo.GetMessageById(ctx, message.ID)
+	copies := make([]models.Message, 0, len(sources))
+	copyIds := make([]uuid.UUID, 0, len(sources))
+	for _, source := range sources {
+		forwardedFrom := source.ForwardedFrom
+		if forwardedFrom == nil {
+			forwardedFrom = &models.ForwardedFrom{SenderID: source.SenderID, ChatID: source.ChatID}
+		}
+
+		message := models.Message{
+			ID:            uuid.New(),
+			Text:          source.Text,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+			Attachments:   source.Attachments,
+			SenderID:      userId,
+			ChatID:        chatId,
+			ForwardedFrom: forwardedFrom,
+		}
+		copies = append(copies, message)
+		copyIds = append(copyIds, message.ID)
+	}
+
+	if err = m.messageRepo.SaveMessages(ctx, copies, newChat, []uuid.UUID{userId, receiverId}); err != nil {
+		return nil, fmt.Errorf("m.messageRepo.SaveMessages: %w", err)
+	}
+	saved, err := m.messageRepo.GetMessagesByIds(ctx, copyIds)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetMessagesByIds: %w", err)
+	}
+
+	forwarded := make([]models.Message, 0, len(copyIds))
+	for _, id := range copyIds {
+		if message, ok := saved[id]; ok {
+			forwarded = append(forwarded, message)
+		}
+	}
+	return forwarded, nil
+}
+
+func (m *MessageService) checkParticipant(ctx context.Context, chatId, userId uuid.UUID) error {
+	isParticipant, err := m.chatRepo.IsParticipant(ctx, chatId, userId)
+	if err != nil {
+		return fmt.Errorf("m.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return messenger_errors.ErrNotParticipant
+	}
+	return nil
+}
+
+// checkModerator allows the action on messages of others only to admins of group chats
+func (m *MessageService) checkModerator(ctx context.Context, chatId, userId uuid.UUID) error {
+	chat, err := m.chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return fmt.Errorf("m.chatRepo.GetChat: %w", err)
+	}
+	if chat.Type != models.ChatTypeGroup {
+		return messenger_errors.ErrNotSender
+	}
+
+	role, err := m.chatRepo.GetChatRole(ctx, chatId, userId)
+	if errors.Is(err, messenger_errors.ErrNotFound) {
+		return messenger_errors.ErrNotParticipant
+	} else if err != nil {
+		return fmt.Errorf("m.chatRepo.GetChatRole: %w", err)
+	}
+	if !role.CanModerate() {
+		return messenger_errors.ErrNotSender
+	}
+	return nil
+}
+
+// UpdateMessage replaces text and attachments of the message sent by the user, encrypted messages can not be edited.
+// Returns the updated message and sequence number of the edit in the chat.
+func (m *MessageService) UpdateMessage(ctx context.Context, message models.Message, userId uuid.UUID) (*models.Message, int64, error) {
+	// validate
+	if message.ID == uuid.Nil {
+		return nil, 0, fmt.Errorf("messageId is empty")
+	}
+	err := m.validator.ValidateMessage(message)
+	if err != nil {
+		return nil, 0, fmt.Errorf("validation.ValidateMessage: %w", err)
+	}
+
+	// only the sender can edit the message
+	oldMessage, err := m.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	if oldMessage.SenderID != userId {
+		return nil, 0, messenger_errors.ErrNotSender
+	}
+	if oldMessage.System != nil {
+		return nil, 0, messenger_errors.ErrSystemMessage
+	}
+	if oldMessage.Encrypted != nil || message.Encrypted != nil {
+		return nil, 0, messenger_errors.ErrChatEncrypted
+	}
+
+	message.SenderID = oldMessage.SenderID
+	message.ChatID = oldMessage.ChatID
+	message.UpdatedAt = time.Now()
+
+	seq, err := m.messageRepo.UpdateMessage(ctx, message)
+	if err != nil {
+		return nil, 0, fmt.Errorf("m.messageRepo.UpdateMessage: %w", err)
+	}
+
+	updatedMessage, err := m.messageRepo.GetMessageById(ctx, message.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	messages := []models.Message{updatedMessage}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return nil, 0, err
+	}
+	return &messages[0], seq, nil
+}
+
+// VotePoll replaces vote of the user in the poll of the message, an empty vote retracts it.
+// Returns the message with the poll as seen by the user.
+func (m *MessageService) VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error) {
+	// validate
+	if messageId == uuid.Nil {
+		return nil, fmt.Errorf("messageId is empty")
+	}
+
+	message, err := m.messageRepo.GetMessageById(ctx, messageId)
 	if err != nil {
 		return nil, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
 	}
+	if err = m.checkParticipant(ctx, message.ChatID, userId); err != nil {
+		return nil, err
+	}
 
-	return &newMessage, nil
+	messages := []models.Message{message}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return nil, err
+	}
+	poll := messages[0].Poll
+	if poll == nil {
+		return nil, messenger_errors.ErrNotFound
+	}
+	now := time.Now()
+	if poll.IsClosed(now) {
+		return nil, messenger_errors.ErrPollClosed
+	}
+	if !poll.IsValidVote(options) {
+		return nil, messenger_errors.ErrInvalidPollVote
+	}
+
+	if err = m.messageRepo.SavePollVotes(ctx, poll.ID, userId, options, now); err != nil {
+		return nil, fmt.Errorf("m.messageRepo.SavePollVotes: %w", err)
+	}
+	if err = m.fillPolls(ctx, messages, userId); err != nil {
+		return nil, err
+	}
+	return &messages[0], nil
 }
 
-func (m *MessageService) DeleteMessage(ctx context.Context, messageId uuid.UUID) error {
+// AddReaction adds reaction of the user to the message.
+// Returns chat of the message and sequence number of the reaction in the chat.
+func (m *MessageService) AddReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error) {
+	reaction, err := m.newReaction(ctx, messageId, userId, emoji)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	seq, err := m.messageRepo.AddReaction(ctx, reaction)
+	if err != nil {
+		return uuid.Nil, 0, fmt.Errorf("m.messageRepo.AddReaction: %w", err)
+	}
+	return reaction.ChatID, seq, nil
+}
+
+// RemoveReaction removes reaction of the user from the message.
+// Returns chat of the message and sequence number of the removal in the chat.
+func (m *MessageService) RemoveReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (uuid.UUID, int64, error) {
+	reaction, err := m.newReaction(ctx, messageId, userId, emoji)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	seq, err := m.messageRepo.RemoveReaction(ctx, reaction)
+	if err != nil {
+		return uuid.Nil, 0, fmt.Errorf("m.messageRepo.RemoveReaction: %w", err)
+	}
+	return reaction.ChatID, seq, nil
+}
+
+// newReaction validates the emoji and checks that the user can see the message
+func (m *MessageService) newReaction(ctx context.Context, messageId, userId uuid.UUID, emoji string) (models.Reaction, error) {
+	if messageId == uuid.Nil {
+		return models.Reaction{}, fmt.Errorf("messageId is empty")
+	}
+	if err := m.validator.ValidateReaction(emoji); err != nil {
+		return models.Reaction{}, messenger_errors.ErrInvalidReaction
+	}
+
+	message, err := m.messageRepo.GetMessageById(ctx, messageId)
+	if err != nil {
+		return models.Reaction{}, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	if err = m.checkParticipant(ctx, message.ChatID, userId); err != nil {
+		return models.Reaction{}, err
+	}
+
+	return models.Reaction{
+		MessageID: messageId,
+		ChatID:    message.ChatID,
+		UserID:    userId,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// DeleteMessage deletes the message sent by the user, admins of group chats can delete messages of others.
+// Returns sequence number of the deletion in the chat.
+func (m *MessageService) DeleteMessage(ctx context.Context, messageId, userId uuid.UUID) (int64, error) {
 	// validate
 	if messageId == uuid.Nil {
-		return fmt.Errorf("messageId is empty")
+		return 0, fmt.Errorf("messageId is empty")
 	}
 
-	err := m.messageRepo.DeleteMessage(ctx, messageId)
+	message, err := m.messageRepo.GetMessageById(ctx, messageId)
 	if err != nil {
-		return fmt.Errorf("m.messageRepo.DeleteMessage: %w", err)
+		return 0, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	// system messages keep the history of the chat, the actor can not remove them either
+	if message.System != nil {
+		return 0, messenger_errors.ErrSystemMessage
+	}
+	if message.SenderID != userId {
+		if err = m.checkModerator(ctx, message.ChatID, userId); err != nil {
+			return 0, err
+		}
 	}
 
-	return nil
+	seq, err := m.messageRepo.DeleteMessage(ctx, messageId)
+	if err != nil {
+		return 0, fmt.Errorf("m.messageRepo.DeleteMessage: %w", err)
+	}
+
+	return seq, nil
+}
+
+// PinMessage pins the message in its chat, in group chats only admins can pin messages.
+// Returns chat of the message and sequence number of the pin in the chat.
+func (m *MessageService) PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	chatId, err := m.checkCanPin(ctx, messageId, userId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	seq, err := m.messageRepo.PinMessage(ctx, chatId, messageId, userId)
+	if err != nil {
+		return uuid.Nil, 0, fmt.Errorf("m.messageRepo.PinMessage: %w", err)
+	}
+	return chatId, seq, nil
+}
+
+// UnpinMessage unpins the message in its chat, in group chats only admins can unpin messages.
+// Returns chat of the message and sequence number of the unpin in the chat.
+func (m *MessageService) UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	chatId, err := m.checkCanPin(ctx, messageId, userId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	seq, err := m.messageRepo.UnpinMessage(ctx, chatId, messageId, userId)
+	if err != nil {
+		return uuid.Nil, 0, fmt.Errorf("m.messageRepo.UnpinMessage: %w", err)
+	}
+	return chatId, seq, nil
+}
+
+// checkCanPin checks that the user can change pins of the message chat and returns the chat id
+func (m *MessageService) checkCanPin(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, error) {
+	if messageId == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("messageId is empty")
+	}
+
+	message, err := m.messageRepo.GetMessageById(ctx, messageId)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+	if err = m.checkParticipant(ctx, message.ChatID, userId); err != nil {
+		return uuid.Nil, err
+	}
+
+	chat, err := m.chatRepo.GetChat(ctx, message.ChatID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("m.chatRepo.GetChat: %w", err)
+	}
+	if chat.Type != models.ChatTypeGroup {
+		return message.ChatID, nil
+	}
+
+	role, err := m.chatRepo.GetChatRole(ctx, message.ChatID, userId)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("m.chatRepo.GetChatRole: %w", err)
+	}
+	if !role.CanModerate() {
+		return uuid.Nil, messenger_errors.ErrNotChatAdmin
+	}
+	return message.ChatID, nil
 }
 
-func (m *MessageService) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) error {
+// UpdateLastReadTs marks chat as read and returns sequence number of the read event in the chat
+func (m *MessageService) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error) {
 	// check if user is participant
 	isParticipant, err := m.chatRepo.IsParticipant(ctx, chatId, userId)
 	if err != nil {
-		return fmt.Errorf("m.chatRepo.IsParticipant: %w", err)
+		return 0, fmt.Errorf("m.chatRepo.IsParticipant: %w", err)
 	}
 	if !isParticipant {
-		return messenger_errors.ErrNotParticipant
+		return 0, messenger_errors.ErrNotParticipant
 	}
 
-	err = m.messageRepo.UpdateLastReadTs(ctx, timestamp, chatId, userId)
+	seq, err := m.messageRepo.UpdateLastReadTs(ctx, timestamp, chatId, userId, messageId)
 	if err != nil {
-		return fmt.Errorf("m.messageRepo.UpdateLastMessageRead: %w", err)
+		return 0, fmt.Errorf("m.messageRepo.UpdateLastMessageRead: %w", err)
 	}
-	return nil
+	return seq, nil
+}
+
+// GetChatEventsSince returns chat events the user missed after the given sequence number
+func (m *MessageService) GetChatEventsSince(ctx context.Context, chatId, userId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error) {
+	// validation
+	if limit <= 0 {
+		return nil, messenger_errors.ErrInvalidNumMessages
+	}
+
+	// check if user is participant
+	isParticipant, err := m.chatRepo.IsParticipant(ctx, chatId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("m.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return nil, messenger_errors.ErrNotParticipant
+	}
+
+	events, err := m.messageRepo.GetChatEventsSince(ctx, chatId, seq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetChatEventsSince: %w", err)
+	}
+	return events, nil
+}
+
+// UpdateLastDeliveredTs records that messages of the chat created up to timestamp reached the user,
+// users who are not participants are ignored. Returns false if nothing new was delivered.
+func (m *MessageService) UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error) {
+	updated, err := m.messageRepo.UpdateLastDeliveredTs(ctx, timestamp, chatId, userId)
+	if err != nil {
+		return false, fmt.Errorf("m.messageRepo.UpdateLastDeliveredTs: %w", err)
+	}
+	return updated, nil
+}
+
+// GetMessageReceipts returns who of the other participants got and read the message and when
+func (m *MessageService) GetMessageReceipts(ctx context.Context, messageId, userId uuid.UUID) ([]models.MessageReceipt, error) {
+	message, err := m.messageRepo.GetMessageById(ctx, messageId)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetMessageById: %w", err)
+	}
+
+	isParticipant, err := m.chatRepo.IsParticipant(ctx, message.ChatID, userId)
+	if err != nil {
+		return nil, fmt.Errorf("m.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		return nil, messenger_errors.ErrNotParticipant
+	}
+
+	receipts, err := m.messageRepo.GetMessageReceipts(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("m.messageRepo.GetMessageReceipts: %w", err)
+	}
+	return receipts, nil
 }
 
 func (m *MessageService) GetLastReadTs(ctx context.Context, chatId, userId uuid.UUID) (*time.Time, error) {
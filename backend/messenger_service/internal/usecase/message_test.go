@@ -39,6 +39,8 @@ func TestSaveMessage_Success(t *testing.T) {
 
 	// Ожидания для моков
 	validator.EXPECT().ValidateMessage(message).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, message.SenderID).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), message.ChatID).Return(models.Chat{ID: message.ChatID}, nil)
 	messageRepo.EXPECT().SaveMessage(context.Background(), message).Return(nil)
 	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
 
@@ -53,6 +55,65 @@ func TestSaveMessage_Success(t *testing.T) {
 	assert.Equal(t, message, *savedMessage)
 }
 
+func TestSaveMessage_ReplyToOtherChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	message := models.Message{
+		ID:        uuid.New(),
+		Text:      "Hello, World!",
+		SenderID:  uuid.New(),
+		ChatID:    uuid.New(),
+		ReplyToID: uuid.New(),
+	}
+	replyTo := models.Message{ID: message.ReplyToID, ChatID: uuid.New()}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, message.SenderID).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), message.ChatID).Return(models.Chat{ID: message.ChatID}, nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ReplyToID).Return(replyTo, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, err := messageService.SaveMessage(context.Background(), message)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidReplyTo)
+}
+
+func TestSaveMessage_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных: бывший участник чата
+	message := models.Message{ID: uuid.New(), Text: "still here?", SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, message.SenderID).Return(false, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(nil, nil, chatRepo, validator)
+	_, err := messageService.SaveMessage(context.Background(), message)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
 func TestSaveMessage_ValidationError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -88,6 +149,96 @@ func TestSaveMessage_ValidationError(t *testing.T) {
 	assert.Nil(t, savedMessage)
 }
 
+func TestSaveMessage_Encryption(t *testing.T) {
+	chatId, senderId, recipientId := uuid.New(), uuid.New(), uuid.New()
+	encrypted := &models.EncryptedContent{
+		Ciphertext: []byte("ciphertext"),
+		Envelopes:  []models.KeyEnvelope{{RecipientID: recipientId, DeviceID: "phone", Key: []byte("key")}},
+	}
+	strangerEnvelope := &models.EncryptedContent{
+		Ciphertext: []byte("ciphertext"),
+		Envelopes:  []models.KeyEnvelope{{RecipientID: uuid.New(), DeviceID: "phone", Key: []byte("key")}},
+	}
+
+	tests := []struct {
+		name          string
+		chatEncrypted bool
+		content       *models.EncryptedContent
+		wantErr       error
+	}{
+		{name: "encrypted message to encrypted chat", chatEncrypted: true, content: encrypted},
+		{name: "plain message to encrypted chat", chatEncrypted: true, wantErr: messenger_errors.ErrChatEncrypted},
+		{name: "encrypted message to plain chat", content: encrypted, wantErr: messenger_errors.ErrInvalidEncrypted},
+		{name: "envelope for stranger", chatEncrypted: true, content: strangerEnvelope, wantErr: messenger_errors.ErrInvalidEncrypted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Моки
+			messageRepo := mocks.NewMockMessageRepository(ctrl)
+			chatRepo := mocks.NewMockChatRepository(ctrl)
+			validator := mocks.NewMockMessageValidator(ctrl)
+
+			// Подготовка тестовых данных
+			message := models.Message{ID: uuid.New(), SenderID: senderId, ChatID: chatId, Encrypted: tt.content}
+
+			// Ожидания для моков
+			validator.EXPECT().ValidateMessage(message).Return(nil)
+			chatRepo.EXPECT().IsParticipant(context.Background(), chatId, senderId).Return(true, nil)
+			chatRepo.EXPECT().GetChat(context.Background(), chatId).Return(models.Chat{ID: chatId, Encrypted: tt.chatEncrypted}, nil)
+			if tt.chatEncrypted && tt.content != nil {
+				chatRepo.EXPECT().GetChatParticipants(context.Background(), chatId).Return([]uuid.UUID{senderId, recipientId}, nil)
+			}
+			if tt.wantErr == nil {
+				messageRepo.EXPECT().SaveMessage(context.Background(), message).Return(nil)
+				messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+			}
+
+			// Вызов метода
+			messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, validator)
+			saved, err := messageService.SaveMessage(context.Background(), message)
+
+			// Проверки
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, encrypted, saved.Encrypted)
+		})
+	}
+}
+
+func TestUpdateMessage_Encrypted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), Text: "edited"}
+	oldMessage := models.Message{ID: message.ID, SenderID: userId, ChatID: uuid.New(),
+		Encrypted: &models.EncryptedContent{Ciphertext: []byte("ciphertext")}}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(oldMessage, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, validator)
+	_, _, err := messageService.UpdateMessage(context.Background(), message, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrChatEncrypted)
+}
+
 func TestGetMessagesForChatOlder_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -116,6 +267,17 @@ func TestGetMessagesForChatOlder_Success(t *testing.T) {
 	// Ожидания для моков
 	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
 	messageRepo.EXPECT().GetMessagesForChatOlder(context.Background(), chatId, 5, gomock.Any()).Return(messages, nil)
+	reactions := []models.ReactionCount{{Emoji: "👍", Count: 2, Reacted: true}}
+	messageRepo.EXPECT().GetReactions(context.Background(), []uuid.UUID{messages[0].ID}, userId).
+		Return(map[uuid.UUID][]models.ReactionCount{messages[0].ID: reactions}, nil)
+	poll := &models.Poll{
+		Question:          "Lunch?",
+		Options:           []models.PollOption{{Text: "Yes", VoteCount: 3}, {Text: "No", VoteCount: 1}},
+		ResultsVisibility: models.PollResultsAfterVote,
+		VoterCount:        4,
+	}
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{messages[0].ID}, userId).
+		Return(map[uuid.UUID]*models.Poll{messages[0].ID: poll}, nil)
 
 	// Создаем сервис
 	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
@@ -127,6 +289,13 @@ func TestGetMessagesForChatOlder_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, resultMessages, 1)
 	assert.Equal(t, messages[0].Text, resultMessages[0].Text)
+	assert.Equal(t, reactions, resultMessages[0].Reactions)
+	// the user has not voted yet
+	if assert.NotNil(t, resultMessages[0].Poll) {
+		assert.True(t, resultMessages[0].Poll.ResultsHidden)
+		assert.Zero(t, resultMessages[0].Poll.Options[0].VoteCount)
+		assert.Equal(t, 4, resultMessages[0].Poll.VoterCount)
+	}
 }
 
 func TestGetMessagesForChatOlder_NotParticipant(t *testing.T) {
@@ -170,18 +339,112 @@ func TestDeleteMessage_Success(t *testing.T) {
 
 	// Подготовка тестовых данных
 	messageId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, SenderID: userId, ChatID: uuid.New()}, nil)
+	messageRepo.EXPECT().DeleteMessage(context.Background(), messageId).Return(int64(7), nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	seq, err := messageService.DeleteMessage(context.Background(), messageId, userId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), seq)
+}
+
+func TestDeleteMessage_SystemMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+
+	// Подготовка тестовых данных
+	messageId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, SenderID: userId, ChatID: uuid.New(),
+			System: &models.SystemInfo{Action: models.SystemActionMemberRemoved, UserID: uuid.New()}}, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, nil, nil)
+	_, err := messageService.DeleteMessage(context.Background(), messageId, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrSystemMessage)
+}
+
+func TestDeleteMessage_AdminDeletesOthersMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	messageId := uuid.New()
+	chatId := uuid.New()
+	adminId := uuid.New()
 
 	// Ожидания для моков
-	messageRepo.EXPECT().DeleteMessage(context.Background(), messageId).Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, SenderID: uuid.New(), ChatID: chatId}, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), chatId).
+		Return(models.Chat{ID: chatId, Type: models.ChatTypeGroup}, nil)
+	chatRepo.EXPECT().GetChatRole(context.Background(), chatId, adminId).Return(models.ChatRoleAdmin, nil)
+	messageRepo.EXPECT().DeleteMessage(context.Background(), messageId).Return(int64(8), nil)
 
 	// Создаем сервис
 	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
 
 	// Вызов метода
-	err := messageService.DeleteMessage(context.Background(), messageId)
+	seq, err := messageService.DeleteMessage(context.Background(), messageId, adminId)
 
 	// Проверки
 	assert.NoError(t, err)
+	assert.Equal(t, int64(8), seq)
+}
+
+func TestDeleteMessage_MemberDeletesOthersMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	messageId := uuid.New()
+	chatId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, SenderID: uuid.New(), ChatID: chatId}, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), chatId).
+		Return(models.Chat{ID: chatId, Type: models.ChatTypeGroup}, nil)
+	chatRepo.EXPECT().GetChatRole(context.Background(), chatId, userId).Return(models.ChatRoleMember, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, err := messageService.DeleteMessage(context.Background(), messageId, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotSender)
 }
 
 func TestDeleteMessage_InvalidId(t *testing.T) {
@@ -201,9 +464,894 @@ func TestDeleteMessage_InvalidId(t *testing.T) {
 	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
 
 	// Вызов метода
-	err := messageService.DeleteMessage(context.Background(), invalidMessageId)
+	_, err := messageService.DeleteMessage(context.Background(), invalidMessageId, uuid.New())
 
 	// Проверки
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "messageId is empty")
 }
+
+func TestUpdateMessage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	chatId := uuid.New()
+	message := models.Message{ID: uuid.New(), Text: "edited"}
+	oldMessage := models.Message{ID: message.ID, Text: "original", SenderID: userId, ChatID: chatId}
+	editedAt := time.Now()
+	updatedMessage := models.Message{ID: message.ID, Text: "edited", SenderID: userId, ChatID: chatId, EditedAt: &editedAt}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	gomock.InOrder(
+		messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(oldMessage, nil),
+		messageRepo.EXPECT().UpdateMessage(context.Background(), gomock.Any()).Return(int64(9), nil),
+		messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(updatedMessage, nil),
+	)
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{message.ID}, userId).Return(nil, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, seq, err := messageService.UpdateMessage(context.Background(), message, userId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), seq)
+	assert.Equal(t, &updatedMessage, result)
+}
+
+func TestUpdateMessage_NotSender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	message := models.Message{ID: uuid.New(), Text: "edited"}
+	oldMessage := models.Message{ID: message.ID, Text: "original", SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(oldMessage, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, _, err := messageService.UpdateMessage(context.Background(), message, uuid.New())
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotSender)
+}
+
+func TestUpdateMessage_SystemMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), Text: "renamed"}
+	oldMessage := models.Message{ID: message.ID, Text: "group", SenderID: userId, ChatID: uuid.New(),
+		System: &models.SystemInfo{Action: models.SystemActionChatRenamed}}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(oldMessage, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, nil, validator)
+	_, _, err := messageService.UpdateMessage(context.Background(), message, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrSystemMessage)
+}
+
+func TestForwardMessages_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	targetChatId := uuid.New()
+	source := models.Message{
+		ID:          uuid.New(),
+		Text:        "original",
+		SenderID:    uuid.New(),
+		ChatID:      uuid.New(),
+		Attachments: []*models.File{{URL: "https://example.com/image.png", DisplayType: models.DisplayTypeMedia}},
+	}
+
+	// Ожидания для моков
+	var saved models.Message
+	chatRepo.EXPECT().IsParticipant(context.Background(), targetChatId, userId).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), targetChatId).Return(models.Chat{ID: targetChatId}, nil)
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), []uuid.UUID{source.ID}).
+		Return(map[uuid.UUID]models.Message{source.ID: source}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), source.ChatID, userId).Return(true, nil)
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{source.ID}, userId).Return(nil, nil)
+	messageRepo.EXPECT().SaveMessages(context.Background(), gomock.Len(1), nil, gomock.Any()).DoAndReturn(
+		func(_ context.Context, messages []models.Message, _ *models.Chat, _ []uuid.UUID) error {
+			saved = messages[0]
+			return nil
+		})
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), gomock.Not([]uuid.UUID{source.ID})).DoAndReturn(
+		func(_ context.Context, _ []uuid.UUID) (map[uuid.UUID]models.Message, error) {
+			return map[uuid.UUID]models.Message{saved.ID: saved}, nil
+		})
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{source.ID}, targetChatId, uuid.Nil, userId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.NotEqual(t, source.ID, result[0].ID)
+	assert.Equal(t, userId, result[0].SenderID)
+	assert.Equal(t, targetChatId, result[0].ChatID)
+	assert.Equal(t, source.Text, result[0].Text)
+	assert.Equal(t, source.Attachments, result[0].Attachments)
+	assert.Equal(t, &models.ForwardedFrom{SenderID: source.SenderID, ChatID: source.ChatID}, result[0].ForwardedFrom)
+}
+
+func TestForwardMessages_NotParticipantOfSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	targetChatId := uuid.New()
+	source := models.Message{ID: uuid.New(), Text: "secret", SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), targetChatId, userId).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), targetChatId).Return(models.Chat{ID: targetChatId}, nil)
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), []uuid.UUID{source.ID}).
+		Return(map[uuid.UUID]models.Message{source.ID: source}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), source.ChatID, userId).Return(false, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{source.ID}, targetChatId, uuid.Nil, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestForwardMessages_NewPrivateChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId, receiverId := uuid.New(), uuid.New()
+	source := models.Message{ID: uuid.New(), Text: "original", SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	var saved models.Message
+	chatRepo.EXPECT().GetPrivateChat(context.Background(), userId, receiverId).Return(models.Chat{}, messenger_errors.ErrNotFound)
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), []uuid.UUID{source.ID}).
+		Return(map[uuid.UUID]models.Message{source.ID: source}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), source.ChatID, userId).Return(true, nil)
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{source.ID}, userId).Return(nil, nil)
+	messageRepo.EXPECT().SaveMessages(context.Background(), gomock.Len(1), gomock.Not(gomock.Nil()), []uuid.UUID{userId, receiverId}).DoAndReturn(
+		func(_ context.Context, messages []models.Message, chat *models.Chat, _ []uuid.UUID) error {
+			assert.Equal(t, models.ChatTypePrivate, chat.Type)
+			assert.Equal(t, chat.ID, messages[0].ChatID)
+			saved = messages[0]
+			return nil
+		})
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), gomock.Not([]uuid.UUID{source.ID})).DoAndReturn(
+		func(_ context.Context, _ []uuid.UUID) (map[uuid.UUID]models.Message, error) {
+			return map[uuid.UUID]models.Message{saved.ID: saved}, nil
+		})
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	result, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{source.ID}, uuid.Nil, receiverId, userId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, saved.ChatID, result[0].ChatID)
+}
+
+func TestForwardMessages_InvalidSourceCreatesNoChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId, receiverId := uuid.New(), uuid.New()
+	missingId := uuid.New()
+
+	// Ожидания для моков, SaveMessages is not called
+	chatRepo.EXPECT().GetPrivateChat(context.Background(), userId, receiverId).Return(models.Chat{}, messenger_errors.ErrNotFound)
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), []uuid.UUID{missingId}).Return(map[uuid.UUID]models.Message{}, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	_, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{missingId}, uuid.Nil, receiverId, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotFound)
+}
+
+func TestForwardMessages_ToYourself(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки, репозитории не вызываются
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	_, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{uuid.New()}, uuid.Nil, userId, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidForward)
+}
+
+func TestAddReaction_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateReaction("👍").Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, userId).Return(true, nil)
+	messageRepo.EXPECT().AddReaction(context.Background(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, reaction models.Reaction) (int64, error) {
+			assert.Equal(t, message.ID, reaction.MessageID)
+			assert.Equal(t, message.ChatID, reaction.ChatID)
+			assert.Equal(t, userId, reaction.UserID)
+			assert.Equal(t, "👍", reaction.Emoji)
+			return 7, nil
+		})
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	chatId, seq, err := messageService.AddReaction(context.Background(), message.ID, userId, "👍")
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, message.ChatID, chatId)
+	assert.Equal(t, int64(7), seq)
+}
+
+func TestAddReaction_InvalidEmoji(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateReaction("like").Return(errors.New("reaction must be an emoji"))
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, _, err := messageService.AddReaction(context.Background(), uuid.New(), uuid.New(), "like")
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidReaction)
+}
+
+func TestRemoveReaction_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateReaction("👍").Return(nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, userId).Return(false, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	_, _, err := messageService.RemoveReaction(context.Background(), message.ID, userId, "👍")
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestPinMessage_PrivateChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	messageId := uuid.New()
+	chatId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, SenderID: uuid.New(), ChatID: chatId}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), chatId).
+		Return(models.Chat{ID: chatId, Type: models.ChatTypePrivate}, nil)
+	messageRepo.EXPECT().PinMessage(context.Background(), chatId, messageId, userId).Return(int64(12), nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+
+	// Вызов метода
+	gotChatId, seq, err := messageService.PinMessage(context.Background(), messageId, userId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, chatId, gotChatId)
+	assert.Equal(t, int64(12), seq)
+}
+
+func TestPinMessage_GroupChatRoles(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    models.ChatRole
+		wantErr error
+	}{
+		{name: "admin", role: models.ChatRoleAdmin},
+		{name: "owner", role: models.ChatRoleOwner},
+		{name: "member", role: models.ChatRoleMember, wantErr: messenger_errors.ErrNotChatAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Моки
+			messageRepo := mocks.NewMockMessageRepository(ctrl)
+			chatRepo := mocks.NewMockChatRepository(ctrl)
+
+			// Подготовка тестовых данных
+			messageId := uuid.New()
+			chatId := uuid.New()
+			userId := uuid.New()
+
+			// Ожидания для моков
+			messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+				Return(models.Message{ID: messageId, SenderID: uuid.New(), ChatID: chatId}, nil)
+			chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+			chatRepo.EXPECT().GetChat(context.Background(), chatId).
+				Return(models.Chat{ID: chatId, Type: models.ChatTypeGroup}, nil)
+			chatRepo.EXPECT().GetChatRole(context.Background(), chatId, userId).Return(tt.role, nil)
+			if tt.wantErr == nil {
+				messageRepo.EXPECT().PinMessage(context.Background(), chatId, messageId, userId).Return(int64(1), nil)
+			}
+
+			// Вызов метода
+			messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+			_, _, err := messageService.PinMessage(context.Background(), messageId, userId)
+
+			// Проверки
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUnpinMessage_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	messageId := uuid.New()
+	chatId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), messageId).
+		Return(models.Message{ID: messageId, ChatID: chatId}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(false, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	_, _, err := messageService.UnpinMessage(context.Background(), messageId, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestGetChatEventsSince_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	chatId := uuid.New()
+	userId := uuid.New()
+	events := []models.ChatEvent{
+		{Seq: 4, Type: models.ChatEventMessageSent, ChatID: chatId, Message: &models.Message{ID: uuid.New(), ChatID: chatId, Seq: 4}},
+		{Seq: 5, Type: models.ChatEventMessageDeleted, ChatID: chatId, MessageID: uuid.New()},
+	}
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+	messageRepo.EXPECT().GetChatEventsSince(context.Background(), chatId, int64(3), 10).Return(events, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, err := messageService.GetChatEventsSince(context.Background(), chatId, userId, 3, 10)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, events, result)
+}
+
+func TestGetChatEventsSince_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	chatId := uuid.New()
+	userId := uuid.New()
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(false, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, err := messageService.GetChatEventsSince(context.Background(), chatId, userId, 0, 10)
+
+	// Проверки
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestSearchMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	chatId := uuid.New()
+	query := models.MessageSearchQuery{Text: "report", ChatID: chatId, Limit: 20}
+	expected := []models.MessageSearchResult{{Message: models.Message{ID: uuid.New(), ChatID: chatId}, Snippet: "report"}}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateSearchQuery(query).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+	messageRepo.EXPECT().SearchMessages(context.Background(), userId, query).Return(expected, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, validator)
+	results, err := messageService.SearchMessages(context.Background(), userId, query)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, expected, results)
+}
+
+func TestSearchMessages_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	invalid := models.MessageSearchQuery{Text: " ", Limit: 20}
+	foreign := models.MessageSearchQuery{Text: "report", ChatID: uuid.New(), Limit: 20}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateSearchQuery(invalid).Return(errors.New("invalid search query length"))
+	validator.EXPECT().ValidateSearchQuery(foreign).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), foreign.ChatID, userId).Return(false, nil)
+
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, validator)
+
+	// Вызов метода и проверки
+	_, err := messageService.SearchMessages(context.Background(), userId, invalid)
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidSearchQuery)
+
+	_, err = messageService.SearchMessages(context.Background(), userId, foreign)
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestGetMessagesAround(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	chatId := uuid.New()
+	now := time.Now()
+	newMessage := func(offset time.Duration) models.Message {
+		return models.Message{ID: uuid.New(), ChatID: chatId, SenderID: userId, CreatedAt: now.Add(offset)}
+	}
+	anchor := newMessage(0)
+	older := []models.Message{newMessage(-3 * time.Minute), newMessage(-2 * time.Minute), newMessage(-time.Minute)}
+	newer := []models.Message{newMessage(time.Minute)}
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), anchor.ID).Return(anchor, nil)
+	messageRepo.EXPECT().GetMessagesForChatOlder(context.Background(), chatId, 3, anchor.CreatedAt).Return(older, nil)
+	messageRepo.EXPECT().GetMessagesForChatNewer(context.Background(), chatId, 3, anchor.CreatedAt).Return(newer, nil)
+	messageRepo.EXPECT().GetReactions(context.Background(), gomock.Len(4), userId).
+		Return(map[uuid.UUID][]models.ReactionCount{}, nil)
+	messageRepo.EXPECT().GetPolls(context.Background(), gomock.Len(4), userId).
+		Return(map[uuid.UUID]*models.Poll{}, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	page, err := messageService.GetMessagesAround(context.Background(), chatId, anchor.ID, userId, 2)
+
+	// Проверки
+	assert.NoError(t, err)
+	if assert.Len(t, page.Messages, 4) {
+		assert.Equal(t, older[1].ID, page.Messages[0].ID)
+		assert.Equal(t, anchor.ID, page.Messages[2].ID)
+		assert.Equal(t, newer[0].ID, page.Messages[3].ID)
+	}
+	if assert.NotNil(t, page.OlderCursor) {
+		assert.Equal(t, older[1].CreatedAt, *page.OlderCursor)
+	}
+	assert.Nil(t, page.NewerCursor)
+}
+
+func TestGetMessagesAround_AnchorInOtherChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	chatId := uuid.New()
+	anchor := models.Message{ID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), chatId, userId).Return(true, nil)
+	messageRepo.EXPECT().GetMessageById(context.Background(), anchor.ID).Return(anchor, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	_, err := messageService.GetMessagesAround(context.Background(), chatId, anchor.ID, userId, 20)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotFound)
+}
+
+func TestGetMessageReceipts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	senderId := uuid.New()
+	readerId := uuid.New()
+	readAt := time.Now()
+	message := models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: senderId, CreatedAt: readAt.Add(-time.Minute)}
+	receipts := []models.MessageReceipt{{UserID: readerId, DeliveredAt: &readAt, ReadAt: &readAt}}
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, senderId).Return(true, nil)
+	messageRepo.EXPECT().GetMessageReceipts(context.Background(), message).Return(receipts, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, err := messageService.GetMessageReceipts(context.Background(), message.ID, senderId)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, receipts, result)
+}
+
+func TestGetMessageReceipts_NotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	fileRepo := mocks.NewMockFileService(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New()}
+
+	// Ожидания для моков
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, userId).Return(false, nil)
+
+	// Создаем сервис
+	messageService := usecase.NewMessageService(messageRepo, fileRepo, chatRepo, validator)
+
+	// Вызов метода
+	result, err := messageService.GetMessageReceipts(context.Background(), message.ID, userId)
+
+	// Проверки
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, messenger_errors.ErrNotParticipant)
+}
+
+func TestSaveMessage_Poll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	message := models.Message{
+		ID:        uuid.New(),
+		SenderID:  uuid.New(),
+		ChatID:    uuid.New(),
+		CreatedAt: time.Now(),
+		Poll: &models.Poll{
+			Question:          "Lunch?",
+			Options:           []models.PollOption{{Text: "Yes"}, {Text: "No"}},
+			ResultsVisibility: models.PollResultsAlways,
+		},
+	}
+	savedPoll := &models.Poll{Question: "Lunch?", Options: []models.PollOption{{Text: "Yes"}, {Text: "No"}}}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(gomock.Any()).Return(nil)
+	validator.EXPECT().ValidatePoll(gomock.Any(), gomock.Any()).Return(nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, message.SenderID).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), message.ChatID).Return(models.Chat{ID: message.ChatID}, nil)
+	messageRepo.EXPECT().SaveMessage(context.Background(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, saved models.Message) error {
+			assert.NotEqual(t, uuid.Nil, saved.Poll.ID)
+			assert.Equal(t, message.CreatedAt, saved.Poll.CreatedAt)
+			return nil
+		})
+	messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{message.ID}, message.SenderID).
+		Return(map[uuid.UUID]*models.Poll{message.ID: savedPoll}, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, validator)
+	result, err := messageService.SaveMessage(context.Background(), message)
+
+	// Проверки
+	assert.NoError(t, err)
+	assert.Equal(t, savedPoll, result.Poll)
+}
+
+func TestSaveMessage_InvalidPoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	message := models.Message{ID: uuid.New(), SenderID: uuid.New(), ChatID: uuid.New(), Poll: &models.Poll{}}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(message).Return(nil)
+	validator.EXPECT().ValidatePoll(gomock.Any(), gomock.Any()).Return(errors.New("no question"))
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(nil, nil, nil, validator)
+	_, err := messageService.SaveMessage(context.Background(), message)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidPoll)
+}
+
+func TestForwardMessages_Poll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	messageRepo := mocks.NewMockMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	targetChatId := uuid.New()
+	source := models.Message{ID: uuid.New(), SenderID: uuid.New(), ChatID: uuid.New()}
+
+	// Ожидания для моков
+	chatRepo.EXPECT().IsParticipant(context.Background(), targetChatId, userId).Return(true, nil)
+	chatRepo.EXPECT().GetChat(context.Background(), targetChatId).Return(models.Chat{ID: targetChatId}, nil)
+	messageRepo.EXPECT().GetMessagesByIds(context.Background(), []uuid.UUID{source.ID}).
+		Return(map[uuid.UUID]models.Message{source.ID: source}, nil)
+	chatRepo.EXPECT().IsParticipant(context.Background(), source.ChatID, userId).Return(true, nil)
+	messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{source.ID}, userId).
+		Return(map[uuid.UUID]*models.Poll{source.ID: {Question: "Lunch?"}}, nil)
+
+	// Вызов метода
+	messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+	_, err := messageService.ForwardMessages(context.Background(), []uuid.UUID{source.ID}, targetChatId, uuid.Nil, userId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidForward)
+}
+
+func TestVotePoll(t *testing.T) {
+	userId := uuid.New()
+	message := models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New()}
+	past := time.Now().Add(-time.Minute)
+	newPoll := func() *models.Poll {
+		return &models.Poll{
+			ID:                uuid.New(),
+			Question:          "Lunch?",
+			Options:           []models.PollOption{{Text: "Yes"}, {Text: "No"}},
+			ResultsVisibility: models.PollResultsAlways,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		poll          func() *models.Poll
+		options       []int
+		expectedError error
+	}{
+		{name: "vote", poll: newPoll, options: []int{1}},
+		{name: "retract", poll: newPoll},
+		{name: "no poll", poll: func() *models.Poll { return nil }, options: []int{0}, expectedError: messenger_errors.ErrNotFound},
+		{name: "two options in single choice poll", poll: newPoll, options: []int{0, 1}, expectedError: messenger_errors.ErrInvalidPollVote},
+		{
+			name: "closed",
+			poll: func() *models.Poll {
+				poll := newPoll()
+				poll.ClosesAt = &past
+				return poll
+			},
+			options:       []int{0},
+			expectedError: messenger_errors.ErrPollClosed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Моки
+			messageRepo := mocks.NewMockMessageRepository(ctrl)
+			chatRepo := mocks.NewMockChatRepository(ctrl)
+
+			// Ожидания для моков
+			poll := test.poll()
+			messageRepo.EXPECT().GetMessageById(context.Background(), message.ID).Return(message, nil)
+			chatRepo.EXPECT().IsParticipant(context.Background(), message.ChatID, userId).Return(true, nil)
+			messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{message.ID}, userId).
+				Return(map[uuid.UUID]*models.Poll{message.ID: poll}, nil)
+			if test.expectedError == nil {
+				messageRepo.EXPECT().SavePollVotes(context.Background(), poll.ID, userId, test.options, gomock.Any()).Return(nil)
+				voted := newPoll()
+				voted.Voted = test.options
+				messageRepo.EXPECT().GetPolls(context.Background(), []uuid.UUID{message.ID}, userId).
+					Return(map[uuid.UUID]*models.Poll{message.ID: voted}, nil)
+			}
+
+			// Вызов метода
+			messageService := usecase.NewMessageService(messageRepo, nil, chatRepo, nil)
+			result, err := messageService.VotePoll(context.Background(), message.ID, test.options, userId)
+
+			// Проверки
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.options, result.Poll.Voted)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//messenger_service/internal/usecase/chat-export-usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "quickflow/shared/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockChatExportRepository is a mock of ChatExportRepository interface.
+type MockChatExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatExportRepositoryMockRecorder
+}
+
+// MockChatExportRepositoryMockRecorder is the mock recorder for MockChatExportRepository.
+type MockChatExportRepositoryMockRecorder struct {
+	mock *MockChatExportRepository
+}
+
+// NewMockChatExportRepository creates a new mock instance.
+func NewMockChatExportRepository(ctrl *gomock.Controller) *MockChatExportRepository {
+	mock := &MockChatExportRepository{ctrl: ctrl}
+	mock.recorder = &MockChatExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatExportRepository) EXPECT() *MockChatExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingChatExports mocks base method.
+func (m *MockChatExportRepository) ClaimPendingChatExports(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ChatExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingChatExports", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]models.ChatExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingChatExports indicates an expected call of ClaimPendingChatExports.
+func (mr *MockChatExportRepositoryMockRecorder) ClaimPendingChatExports(ctx, now, lockedUntil, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingChatExports", reflect.TypeOf((*MockChatExportRepository)(nil).ClaimPendingChatExports), ctx, now, lockedUntil, limit)
+}
+
+// FinishChatExport mocks base method.
+func (m *MockChatExportRepository) FinishChatExport(ctx context.Context, id uuid.UUID, status models.ChatExportStatus, fileURL string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishChatExport", ctx, id, status, fileURL, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishChatExport indicates an expected call of FinishChatExport.
+func (mr *MockChatExportRepositoryMockRecorder) FinishChatExport(ctx, id, status, fileURL, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishChatExport", reflect.TypeOf((*MockChatExportRepository)(nil).FinishChatExport), ctx, id, status, fileURL, now)
+}
+
+// GetChatExport mocks base method.
+func (m *MockChatExportRepository) GetChatExport(ctx context.Context, id uuid.UUID) (models.ChatExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatExport", ctx, id)
+	ret0, _ := ret[0].(models.ChatExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatExport indicates an expected call of GetChatExport.
+func (mr *MockChatExportRepositoryMockRecorder) GetChatExport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatExport", reflect.TypeOf((*MockChatExportRepository)(nil).GetChatExport), ctx, id)
+}
+
+// GetExpiredChatExports mocks base method.
+func (m *MockChatExportRepository) GetExpiredChatExports(ctx context.Context, before time.Time, limit int) ([]models.ChatExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredChatExports", ctx, before, limit)
+	ret0, _ := ret[0].([]models.ChatExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredChatExports indicates an expected call of GetExpiredChatExports.
+func (mr *MockChatExportRepositoryMockRecorder) GetExpiredChatExports(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredChatExports", reflect.TypeOf((*MockChatExportRepository)(nil).GetExpiredChatExports), ctx, before, limit)
+}
+
+// SaveChatExport mocks base method.
+func (m *MockChatExportRepository) SaveChatExport(ctx context.Context, export models.ChatExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveChatExport", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveChatExport indicates an expected call of SaveChatExport.
+func (mr *MockChatExportRepositoryMockRecorder) SaveChatExport(ctx, export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChatExport", reflect.TypeOf((*MockChatExportRepository)(nil).SaveChatExport), ctx, export)
+}
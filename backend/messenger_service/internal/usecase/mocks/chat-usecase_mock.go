@@ -8,6 +8,7 @@ import (
 	context "context"
 	models "quickflow/shared/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,20 @@ func (m *MockChatRepository) EXPECT() *MockChatRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddChatMembers mocks base method.
+func (m *MockChatRepository) AddChatMembers(ctx context.Context, chatId uuid.UUID, memberIds []uuid.UUID, messages []models.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMembers", ctx, chatId, memberIds, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChatMembers indicates an expected call of AddChatMembers.
+func (mr *MockChatRepositoryMockRecorder) AddChatMembers(ctx, chatId, memberIds, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMembers", reflect.TypeOf((*MockChatRepository)(nil).AddChatMembers), ctx, chatId, memberIds, messages)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatRepository) CreateChat(ctx context.Context, chat models.Chat) error {
 	m.ctrl.T.Helper()
@@ -50,6 +65,34 @@ func (mr *MockChatRepositoryMockRecorder) CreateChat(ctx, chat interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatRepository)(nil).CreateChat), ctx, chat)
 }
 
+// CreateChatInvite mocks base method.
+func (m *MockChatRepository) CreateChatInvite(ctx context.Context, invite models.ChatInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChatInvite", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChatInvite indicates an expected call of CreateChatInvite.
+func (mr *MockChatRepositoryMockRecorder) CreateChatInvite(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatInvite", reflect.TypeOf((*MockChatRepository)(nil).CreateChatInvite), ctx, invite)
+}
+
+// CreateGroupChat mocks base method.
+func (m *MockChatRepository) CreateGroupChat(ctx context.Context, chat models.Chat, members []models.ChatMember, message models.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupChat", ctx, chat, members, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroupChat indicates an expected call of CreateGroupChat.
+func (mr *MockChatRepositoryMockRecorder) CreateGroupChat(ctx, chat, members, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupChat", reflect.TypeOf((*MockChatRepository)(nil).CreateGroupChat), ctx, chat, members, message)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatRepository) DeleteChat(ctx context.Context, chatId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -64,6 +107,20 @@ func (mr *MockChatRepositoryMockRecorder) DeleteChat(ctx, chatId interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatRepository)(nil).DeleteChat), ctx, chatId)
 }
 
+// DeleteChatInvite mocks base method.
+func (m *MockChatRepository) DeleteChatInvite(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatInvite", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatInvite indicates an expected call of DeleteChatInvite.
+func (mr *MockChatRepositoryMockRecorder) DeleteChatInvite(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatInvite", reflect.TypeOf((*MockChatRepository)(nil).DeleteChatInvite), ctx, token)
+}
+
 // Exists mocks base method.
 func (m *MockChatRepository) Exists(ctx context.Context, chatId uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +151,51 @@ func (mr *MockChatRepositoryMockRecorder) GetChat(ctx, chatId interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatRepository)(nil).GetChat), ctx, chatId)
 }
 
+// GetChatInvite mocks base method.
+func (m *MockChatRepository) GetChatInvite(ctx context.Context, token string) (models.ChatInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvite", ctx, token)
+	ret0, _ := ret[0].(models.ChatInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvite indicates an expected call of GetChatInvite.
+func (mr *MockChatRepositoryMockRecorder) GetChatInvite(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvite", reflect.TypeOf((*MockChatRepository)(nil).GetChatInvite), ctx, token)
+}
+
+// GetChatInvites mocks base method.
+func (m *MockChatRepository) GetChatInvites(ctx context.Context, chatId uuid.UUID) ([]models.ChatInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvites", ctx, chatId)
+	ret0, _ := ret[0].([]models.ChatInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatRepositoryMockRecorder) GetChatInvites(ctx, chatId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatRepository)(nil).GetChatInvites), ctx, chatId)
+}
+
+// GetChatMembers mocks base method.
+func (m *MockChatRepository) GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatMembers", ctx, chatId)
+	ret0, _ := ret[0].([]models.ChatMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMembers indicates an expected call of GetChatMembers.
+func (mr *MockChatRepositoryMockRecorder) GetChatMembers(ctx, chatId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMembers", reflect.TypeOf((*MockChatRepository)(nil).GetChatMembers), ctx, chatId)
+}
+
 // GetChatParticipants mocks base method.
 func (m *MockChatRepository) GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +211,21 @@ func (mr *MockChatRepositoryMockRecorder) GetChatParticipants(ctx, chatId interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatParticipants", reflect.TypeOf((*MockChatRepository)(nil).GetChatParticipants), ctx, chatId)
 }
 
+// GetChatRole mocks base method.
+func (m *MockChatRepository) GetChatRole(ctx context.Context, chatId, userId uuid.UUID) (models.ChatRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRole", ctx, chatId, userId)
+	ret0, _ := ret[0].(models.ChatRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRole indicates an expected call of GetChatRole.
+func (mr *MockChatRepositoryMockRecorder) GetChatRole(ctx, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRole", reflect.TypeOf((*MockChatRepository)(nil).GetChatRole), ctx, chatId, userId)
+}
+
 // GetNumUnreadChats mocks base method.
 func (m *MockChatRepository) GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +241,21 @@ func (mr *MockChatRepositoryMockRecorder) GetNumUnreadChats(ctx, userId interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadChats", reflect.TypeOf((*MockChatRepository)(nil).GetNumUnreadChats), ctx, userId)
 }
 
+// GetPinnedChats mocks base method.
+func (m *MockChatRepository) GetPinnedChats(ctx context.Context, userId uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedChats", ctx, userId)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinnedChats indicates an expected call of GetPinnedChats.
+func (mr *MockChatRepositoryMockRecorder) GetPinnedChats(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedChats", reflect.TypeOf((*MockChatRepository)(nil).GetPinnedChats), ctx, userId)
+}
+
 // GetPrivateChat mocks base method.
 func (m *MockChatRepository) GetPrivateChat(ctx context.Context, senderId, receiverId uuid.UUID) (models.Chat, error) {
 	m.ctrl.T.Helper()
@@ -140,18 +272,18 @@ func (mr *MockChatRepositoryMockRecorder) GetPrivateChat(ctx, senderId, receiver
 }
 
 // GetUserChats mocks base method.
-func (m *MockChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID) ([]models.Chat, error) {
+func (m *MockChatRepository) GetUserChats(ctx context.Context, userId uuid.UUID, archived bool) ([]models.Chat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserChats", ctx, userId)
+	ret := m.ctrl.Call(m, "GetUserChats", ctx, userId, archived)
 	ret0, _ := ret[0].([]models.Chat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserChats indicates an expected call of GetUserChats.
-func (mr *MockChatRepositoryMockRecorder) GetUserChats(ctx, userId interface{}) *gomock.Call {
+func (mr *MockChatRepositoryMockRecorder) GetUserChats(ctx, userId, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChatRepository)(nil).GetUserChats), ctx, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserChats", reflect.TypeOf((*MockChatRepository)(nil).GetUserChats), ctx, userId, archived)
 }
 
 // IsParticipant mocks base method.
@@ -183,6 +315,20 @@ func (mr *MockChatRepositoryMockRecorder) JoinChat(ctx, chatId, userId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChat", reflect.TypeOf((*MockChatRepository)(nil).JoinChat), ctx, chatId, userId)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatRepository) JoinChatByInvite(ctx context.Context, token string, chatId, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChatByInvite", ctx, token, chatId, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatRepositoryMockRecorder) JoinChatByInvite(ctx, token, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatRepository)(nil).JoinChatByInvite), ctx, token, chatId, userId)
+}
+
 // LeaveChat mocks base method.
 func (m *MockChatRepository) LeaveChat(ctx context.Context, chatId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -197,6 +343,118 @@ func (mr *MockChatRepositoryMockRecorder) LeaveChat(ctx, chatId, userId interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChat", reflect.TypeOf((*MockChatRepository)(nil).LeaveChat), ctx, chatId, userId)
 }
 
+// RemoveChatMember mocks base method.
+func (m *MockChatRepository) RemoveChatMember(ctx context.Context, chatId, memberId uuid.UUID, message models.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChatMember", ctx, chatId, memberId, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatRepositoryMockRecorder) RemoveChatMember(ctx, chatId, memberId, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatRepository)(nil).RemoveChatMember), ctx, chatId, memberId, message)
+}
+
+// SetChatArchived mocks base method.
+func (m *MockChatRepository) SetChatArchived(ctx context.Context, chatId, userId uuid.UUID, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatArchived", ctx, chatId, userId, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatArchived indicates an expected call of SetChatArchived.
+func (mr *MockChatRepositoryMockRecorder) SetChatArchived(ctx, chatId, userId, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatArchived", reflect.TypeOf((*MockChatRepository)(nil).SetChatArchived), ctx, chatId, userId, archived)
+}
+
+// SetChatEncrypted mocks base method.
+func (m *MockChatRepository) SetChatEncrypted(ctx context.Context, chatId uuid.UUID, encrypted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatEncrypted", ctx, chatId, encrypted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatEncrypted indicates an expected call of SetChatEncrypted.
+func (mr *MockChatRepositoryMockRecorder) SetChatEncrypted(ctx, chatId, encrypted interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatEncrypted", reflect.TypeOf((*MockChatRepository)(nil).SetChatEncrypted), ctx, chatId, encrypted)
+}
+
+// SetChatMessageTTL mocks base method.
+func (m *MockChatRepository) SetChatMessageTTL(ctx context.Context, chatId uuid.UUID, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatMessageTTL", ctx, chatId, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatMessageTTL indicates an expected call of SetChatMessageTTL.
+func (mr *MockChatRepositoryMockRecorder) SetChatMessageTTL(ctx, chatId, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMessageTTL", reflect.TypeOf((*MockChatRepository)(nil).SetChatMessageTTL), ctx, chatId, ttl)
+}
+
+// SetChatMuted mocks base method.
+func (m *MockChatRepository) SetChatMuted(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatMuted", ctx, chatId, userId, mutedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatMuted indicates an expected call of SetChatMuted.
+func (mr *MockChatRepositoryMockRecorder) SetChatMuted(ctx, chatId, userId, mutedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMuted", reflect.TypeOf((*MockChatRepository)(nil).SetChatMuted), ctx, chatId, userId, mutedUntil)
+}
+
+// SetChatRoles mocks base method.
+func (m *MockChatRepository) SetChatRoles(ctx context.Context, chatId uuid.UUID, members []models.ChatMember, message models.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatRoles", ctx, chatId, members, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatRoles indicates an expected call of SetChatRoles.
+func (mr *MockChatRepositoryMockRecorder) SetChatRoles(ctx, chatId, members, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatRoles", reflect.TypeOf((*MockChatRepository)(nil).SetChatRoles), ctx, chatId, members, message)
+}
+
+// SetPinnedChats mocks base method.
+func (m *MockChatRepository) SetPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPinnedChats", ctx, userId, chatIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPinnedChats indicates an expected call of SetPinnedChats.
+func (mr *MockChatRepositoryMockRecorder) SetPinnedChats(ctx, userId, chatIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPinnedChats", reflect.TypeOf((*MockChatRepository)(nil).SetPinnedChats), ctx, userId, chatIds)
+}
+
+// UpdateChat mocks base method.
+func (m *MockChatRepository) UpdateChat(ctx context.Context, chat models.Chat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChat", ctx, chat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChat indicates an expected call of UpdateChat.
+func (mr *MockChatRepositoryMockRecorder) UpdateChat(ctx, chat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatRepository)(nil).UpdateChat), ctx, chat)
+}
+
 // MockChatValidator is a mock of ChatValidator interface.
 type MockChatValidator struct {
 	ctrl     *gomock.Controller
@@ -234,6 +492,48 @@ func (mr *MockChatValidatorMockRecorder) ValidateChatCreationInfo(chatInfo inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateChatCreationInfo", reflect.TypeOf((*MockChatValidator)(nil).ValidateChatCreationInfo), chatInfo)
 }
 
+// ValidateChatInviteCreationInfo mocks base method.
+func (m *MockChatValidator) ValidateChatInviteCreationInfo(info models.ChatInviteCreationInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateChatInviteCreationInfo", info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateChatInviteCreationInfo indicates an expected call of ValidateChatInviteCreationInfo.
+func (mr *MockChatValidatorMockRecorder) ValidateChatInviteCreationInfo(info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateChatInviteCreationInfo", reflect.TypeOf((*MockChatValidator)(nil).ValidateChatInviteCreationInfo), info)
+}
+
+// ValidateChatUpdateInfo mocks base method.
+func (m *MockChatValidator) ValidateChatUpdateInfo(info models.ChatUpdateInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateChatUpdateInfo", info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateChatUpdateInfo indicates an expected call of ValidateChatUpdateInfo.
+func (mr *MockChatValidatorMockRecorder) ValidateChatUpdateInfo(info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateChatUpdateInfo", reflect.TypeOf((*MockChatValidator)(nil).ValidateChatUpdateInfo), info)
+}
+
+// ValidateMessageTTL mocks base method.
+func (m *MockChatValidator) ValidateMessageTTL(ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMessageTTL", ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMessageTTL indicates an expected call of ValidateMessageTTL.
+func (mr *MockChatValidatorMockRecorder) ValidateMessageTTL(ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMessageTTL", reflect.TypeOf((*MockChatValidator)(nil).ValidateMessageTTL), ttl)
+}
+
 // MockFileService is a mock of FileService interface.
 type MockFileService struct {
 	ctrl     *gomock.Controller
@@ -257,6 +557,20 @@ func (m *MockFileService) EXPECT() *MockFileServiceMockRecorder {
 	return m.recorder
 }
 
+// DeletePrivateFile mocks base method.
+func (m *MockFileService) DeletePrivateFile(ctx context.Context, filename string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateFile", ctx, filename)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateFile indicates an expected call of DeletePrivateFile.
+func (mr *MockFileServiceMockRecorder) DeletePrivateFile(ctx, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateFile", reflect.TypeOf((*MockFileService)(nil).DeletePrivateFile), ctx, filename)
+}
+
 // UploadFile mocks base method.
 func (m *MockFileService) UploadFile(ctx context.Context, file *models.File) (string, error) {
 	m.ctrl.T.Helper()
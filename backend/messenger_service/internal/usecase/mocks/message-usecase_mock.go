@@ -37,12 +37,43 @@ func (m *MockMessageRepository) EXPECT() *MockMessageRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockMessageRepository) AddReaction(ctx context.Context, reaction models.Reaction) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, reaction)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageRepositoryMockRecorder) AddReaction(ctx, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageRepository)(nil).AddReaction), ctx, reaction)
+}
+
+// DeleteExpiredMessages mocks base method.
+func (m *MockMessageRepository) DeleteExpiredMessages(ctx context.Context, now time.Time, limit int) ([]models.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredMessages", ctx, now, limit)
+	ret0, _ := ret[0].([]models.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredMessages indicates an expected call of DeleteExpiredMessages.
+func (mr *MockMessageRepositoryMockRecorder) DeleteExpiredMessages(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredMessages", reflect.TypeOf((*MockMessageRepository)(nil).DeleteExpiredMessages), ctx, now, limit)
+}
+
 // DeleteMessage mocks base method.
-func (m *MockMessageRepository) DeleteMessage(ctx context.Context, messageId uuid.UUID) error {
+func (m *MockMessageRepository) DeleteMessage(ctx context.Context, messageId uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteMessage indicates an expected call of DeleteMessage.
@@ -51,6 +82,21 @@ func (mr *MockMessageRepositoryMockRecorder) DeleteMessage(ctx, messageId interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageRepository)(nil).DeleteMessage), ctx, messageId)
 }
 
+// GetChatEventsSince mocks base method.
+func (m *MockMessageRepository) GetChatEventsSince(ctx context.Context, chatId uuid.UUID, seq int64, limit int) ([]models.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatEventsSince", ctx, chatId, seq, limit)
+	ret0, _ := ret[0].([]models.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEventsSince indicates an expected call of GetChatEventsSince.
+func (mr *MockMessageRepositoryMockRecorder) GetChatEventsSince(ctx, chatId, seq, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEventsSince", reflect.TypeOf((*MockMessageRepository)(nil).GetChatEventsSince), ctx, chatId, seq, limit)
+}
+
 // GetLastChatMessage mocks base method.
 func (m *MockMessageRepository) GetLastChatMessage(ctx context.Context, chatId uuid.UUID) (*models.Message, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +142,66 @@ func (mr *MockMessageRepositoryMockRecorder) GetMessageById(ctx, messageId inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageById), ctx, messageId)
 }
 
+// GetMessageReceipts mocks base method.
+func (m *MockMessageRepository) GetMessageReceipts(ctx context.Context, message models.Message) ([]models.MessageReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReceipts", ctx, message)
+	ret0, _ := ret[0].([]models.MessageReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReceipts indicates an expected call of GetMessageReceipts.
+func (mr *MockMessageRepositoryMockRecorder) GetMessageReceipts(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReceipts", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageReceipts), ctx, message)
+}
+
+// GetMessagesByIds mocks base method.
+func (m *MockMessageRepository) GetMessagesByIds(ctx context.Context, messageIds []uuid.UUID) (map[uuid.UUID]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByIds", ctx, messageIds)
+	ret0, _ := ret[0].(map[uuid.UUID]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByIds indicates an expected call of GetMessagesByIds.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesByIds(ctx, messageIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByIds", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesByIds), ctx, messageIds)
+}
+
+// GetMessagesForChatAfterSeq mocks base method.
+func (m *MockMessageRepository) GetMessagesForChatAfterSeq(ctx context.Context, chatId uuid.UUID, numMessages int, seq int64) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesForChatAfterSeq", ctx, chatId, numMessages, seq)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesForChatAfterSeq indicates an expected call of GetMessagesForChatAfterSeq.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesForChatAfterSeq(ctx, chatId, numMessages, seq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChatAfterSeq", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesForChatAfterSeq), ctx, chatId, numMessages, seq)
+}
+
+// GetMessagesForChatNewer mocks base method.
+func (m *MockMessageRepository) GetMessagesForChatNewer(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesForChatNewer", ctx, chatId, numMessages, timestamp)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesForChatNewer indicates an expected call of GetMessagesForChatNewer.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesForChatNewer(ctx, chatId, numMessages, timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesForChatNewer", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesForChatNewer), ctx, chatId, numMessages, timestamp)
+}
+
 // GetMessagesForChatOlder mocks base method.
 func (m *MockMessageRepository) GetMessagesForChatOlder(ctx context.Context, chatId uuid.UUID, numMessages int, timestamp time.Time) ([]models.Message, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +232,81 @@ func (mr *MockMessageRepositoryMockRecorder) GetNumUnreadMessages(ctx, chatId, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadMessages", reflect.TypeOf((*MockMessageRepository)(nil).GetNumUnreadMessages), ctx, chatId, userId)
 }
 
+// GetPinnedMessages mocks base method.
+func (m *MockMessageRepository) GetPinnedMessages(ctx context.Context, chatIds []uuid.UUID) (map[uuid.UUID][]models.PinnedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedMessages", ctx, chatIds)
+	ret0, _ := ret[0].(map[uuid.UUID][]models.PinnedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinnedMessages indicates an expected call of GetPinnedMessages.
+func (mr *MockMessageRepositoryMockRecorder) GetPinnedMessages(ctx, chatIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedMessages", reflect.TypeOf((*MockMessageRepository)(nil).GetPinnedMessages), ctx, chatIds)
+}
+
+// GetPolls mocks base method.
+func (m *MockMessageRepository) GetPolls(ctx context.Context, messageIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]*models.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolls", ctx, messageIds, userId)
+	ret0, _ := ret[0].(map[uuid.UUID]*models.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolls indicates an expected call of GetPolls.
+func (mr *MockMessageRepositoryMockRecorder) GetPolls(ctx, messageIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolls", reflect.TypeOf((*MockMessageRepository)(nil).GetPolls), ctx, messageIds, userId)
+}
+
+// GetReactions mocks base method.
+func (m *MockMessageRepository) GetReactions(ctx context.Context, messageIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID][]models.ReactionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", ctx, messageIds, userId)
+	ret0, _ := ret[0].(map[uuid.UUID][]models.ReactionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockMessageRepositoryMockRecorder) GetReactions(ctx, messageIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockMessageRepository)(nil).GetReactions), ctx, messageIds, userId)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageRepository) PinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", ctx, chatId, messageId, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageRepositoryMockRecorder) PinMessage(ctx, chatId, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageRepository)(nil).PinMessage), ctx, chatId, messageId, userId)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageRepository) RemoveReaction(ctx context.Context, reaction models.Reaction) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, reaction)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageRepositoryMockRecorder) RemoveReaction(ctx, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageRepository)(nil).RemoveReaction), ctx, reaction)
+}
+
 // SaveMessage mocks base method.
 func (m *MockMessageRepository) SaveMessage(ctx context.Context, message models.Message) error {
 	m.ctrl.T.Helper()
@@ -140,18 +321,107 @@ func (mr *MockMessageRepositoryMockRecorder) SaveMessage(ctx, message interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockMessageRepository)(nil).SaveMessage), ctx, message)
 }
 
-// UpdateLastReadTs mocks base method.
-func (m *MockMessageRepository) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) error {
+// SaveMessages mocks base method.
+func (m *MockMessageRepository) SaveMessages(ctx context.Context, messages []models.Message, newChat *models.Chat, participants []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, timestamp, chatId, userId)
+	ret := m.ctrl.Call(m, "SaveMessages", ctx, messages, newChat, participants)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// SaveMessages indicates an expected call of SaveMessages.
+func (mr *MockMessageRepositoryMockRecorder) SaveMessages(ctx, messages, newChat, participants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessages", reflect.TypeOf((*MockMessageRepository)(nil).SaveMessages), ctx, messages, newChat, participants)
+}
+
+// SavePollVotes mocks base method.
+func (m *MockMessageRepository) SavePollVotes(ctx context.Context, pollId, userId uuid.UUID, options []int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePollVotes", ctx, pollId, userId, options, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePollVotes indicates an expected call of SavePollVotes.
+func (mr *MockMessageRepositoryMockRecorder) SavePollVotes(ctx, pollId, userId, options, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePollVotes", reflect.TypeOf((*MockMessageRepository)(nil).SavePollVotes), ctx, pollId, userId, options, now)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageRepository) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userId, query)
+	ret0, _ := ret[0].([]models.MessageSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageRepositoryMockRecorder) SearchMessages(ctx, userId, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageRepository)(nil).SearchMessages), ctx, userId, query)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockMessageRepository) UnpinMessage(ctx context.Context, chatId, messageId, userId uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", ctx, chatId, messageId, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageRepositoryMockRecorder) UnpinMessage(ctx, chatId, messageId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageRepository)(nil).UnpinMessage), ctx, chatId, messageId, userId)
+}
+
+// UpdateLastDeliveredTs mocks base method.
+func (m *MockMessageRepository) UpdateLastDeliveredTs(ctx context.Context, timestamp time.Time, chatId, userId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastDeliveredTs", ctx, timestamp, chatId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastDeliveredTs indicates an expected call of UpdateLastDeliveredTs.
+func (mr *MockMessageRepositoryMockRecorder) UpdateLastDeliveredTs(ctx, timestamp, chatId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastDeliveredTs", reflect.TypeOf((*MockMessageRepository)(nil).UpdateLastDeliveredTs), ctx, timestamp, chatId, userId)
+}
+
+// UpdateLastReadTs mocks base method.
+func (m *MockMessageRepository) UpdateLastReadTs(ctx context.Context, timestamp time.Time, chatId, userId, messageId uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastReadTs", ctx, timestamp, chatId, userId, messageId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateLastReadTs indicates an expected call of UpdateLastReadTs.
-func (mr *MockMessageRepositoryMockRecorder) UpdateLastReadTs(ctx, timestamp, chatId, userId interface{}) *gomock.Call {
+func (mr *MockMessageRepositoryMockRecorder) UpdateLastReadTs(ctx, timestamp, chatId, userId, messageId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageRepository)(nil).UpdateLastReadTs), ctx, timestamp, chatId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageRepository)(nil).UpdateLastReadTs), ctx, timestamp, chatId, userId, messageId)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockMessageRepository) UpdateMessage(ctx context.Context, message models.Message) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ctx, message)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageRepositoryMockRecorder) UpdateMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageRepository)(nil).UpdateMessage), ctx, message)
 }
 
 // MockMessageValidator is a mock of MessageValidator interface.
@@ -190,3 +460,59 @@ func (mr *MockMessageValidatorMockRecorder) ValidateMessage(message interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMessage", reflect.TypeOf((*MockMessageValidator)(nil).ValidateMessage), message)
 }
+
+// ValidatePoll mocks base method.
+func (m *MockMessageValidator) ValidatePoll(poll models.Poll, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePoll", poll, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePoll indicates an expected call of ValidatePoll.
+func (mr *MockMessageValidatorMockRecorder) ValidatePoll(poll, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePoll", reflect.TypeOf((*MockMessageValidator)(nil).ValidatePoll), poll, now)
+}
+
+// ValidateReaction mocks base method.
+func (m *MockMessageValidator) ValidateReaction(emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateReaction", emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateReaction indicates an expected call of ValidateReaction.
+func (mr *MockMessageValidatorMockRecorder) ValidateReaction(emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateReaction", reflect.TypeOf((*MockMessageValidator)(nil).ValidateReaction), emoji)
+}
+
+// ValidateSearchQuery mocks base method.
+func (m *MockMessageValidator) ValidateSearchQuery(query models.MessageSearchQuery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSearchQuery", query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSearchQuery indicates an expected call of ValidateSearchQuery.
+func (mr *MockMessageValidatorMockRecorder) ValidateSearchQuery(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSearchQuery", reflect.TypeOf((*MockMessageValidator)(nil).ValidateSearchQuery), query)
+}
+
+// ValidateSendAt mocks base method.
+func (m *MockMessageValidator) ValidateSendAt(sendAt, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSendAt", sendAt, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSendAt indicates an expected call of ValidateSendAt.
+func (mr *MockMessageValidatorMockRecorder) ValidateSendAt(sendAt, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSendAt", reflect.TypeOf((*MockMessageValidator)(nil).ValidateSendAt), sendAt, now)
+}
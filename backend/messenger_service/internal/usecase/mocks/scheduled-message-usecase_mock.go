@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//messenger_service/internal/usecase/scheduled-message-usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "quickflow/shared/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockScheduledMessageRepository is a mock of ScheduledMessageRepository interface.
+type MockScheduledMessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledMessageRepositoryMockRecorder
+}
+
+// MockScheduledMessageRepositoryMockRecorder is the mock recorder for MockScheduledMessageRepository.
+type MockScheduledMessageRepositoryMockRecorder struct {
+	mock *MockScheduledMessageRepository
+}
+
+// NewMockScheduledMessageRepository creates a new mock instance.
+func NewMockScheduledMessageRepository(ctrl *gomock.Controller) *MockScheduledMessageRepository {
+	mock := &MockScheduledMessageRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledMessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledMessageRepository) EXPECT() *MockScheduledMessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledMessage mocks base method.
+func (m *MockScheduledMessageRepository) CancelScheduledMessage(ctx context.Context, id uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledMessage", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledMessage indicates an expected call of CancelScheduledMessage.
+func (mr *MockScheduledMessageRepositoryMockRecorder) CancelScheduledMessage(ctx, id, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledMessage", reflect.TypeOf((*MockScheduledMessageRepository)(nil).CancelScheduledMessage), ctx, id, now)
+}
+
+// ClaimDueScheduledMessages mocks base method.
+func (m *MockScheduledMessageRepository) ClaimDueScheduledMessages(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueScheduledMessages", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueScheduledMessages indicates an expected call of ClaimDueScheduledMessages.
+func (mr *MockScheduledMessageRepositoryMockRecorder) ClaimDueScheduledMessages(ctx, now, lockedUntil, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueScheduledMessages", reflect.TypeOf((*MockScheduledMessageRepository)(nil).ClaimDueScheduledMessages), ctx, now, lockedUntil, limit)
+}
+
+// DeleteScheduledMessage mocks base method.
+func (m *MockScheduledMessageRepository) DeleteScheduledMessage(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledMessage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledMessage indicates an expected call of DeleteScheduledMessage.
+func (mr *MockScheduledMessageRepositoryMockRecorder) DeleteScheduledMessage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledMessage", reflect.TypeOf((*MockScheduledMessageRepository)(nil).DeleteScheduledMessage), ctx, id)
+}
+
+// GetScheduledMessage mocks base method.
+func (m *MockScheduledMessageRepository) GetScheduledMessage(ctx context.Context, id uuid.UUID) (models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMessage", ctx, id)
+	ret0, _ := ret[0].(models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessage indicates an expected call of GetScheduledMessage.
+func (mr *MockScheduledMessageRepositoryMockRecorder) GetScheduledMessage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessage", reflect.TypeOf((*MockScheduledMessageRepository)(nil).GetScheduledMessage), ctx, id)
+}
+
+// GetScheduledMessages mocks base method.
+func (m *MockScheduledMessageRepository) GetScheduledMessages(ctx context.Context, chatId, senderId uuid.UUID) ([]models.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMessages", ctx, chatId, senderId)
+	ret0, _ := ret[0].([]models.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessages indicates an expected call of GetScheduledMessages.
+func (mr *MockScheduledMessageRepositoryMockRecorder) GetScheduledMessages(ctx, chatId, senderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessages", reflect.TypeOf((*MockScheduledMessageRepository)(nil).GetScheduledMessages), ctx, chatId, senderId)
+}
+
+// SaveScheduledMessage mocks base method.
+func (m *MockScheduledMessageRepository) SaveScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveScheduledMessage", ctx, scheduled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveScheduledMessage indicates an expected call of SaveScheduledMessage.
+func (mr *MockScheduledMessageRepositoryMockRecorder) SaveScheduledMessage(ctx, scheduled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveScheduledMessage", reflect.TypeOf((*MockScheduledMessageRepository)(nil).SaveScheduledMessage), ctx, scheduled)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockScheduledMessageRepository) UpdateScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", ctx, scheduled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockScheduledMessageRepositoryMockRecorder) UpdateScheduledMessage(ctx, scheduled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockScheduledMessageRepository)(nil).UpdateScheduledMessage), ctx, scheduled)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	models "quickflow/shared/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,20 @@ func (m *MockStickerRepository) EXPECT() *MockStickerRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddFavouriteSticker mocks base method.
+func (m *MockStickerRepository) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavouriteSticker", ctx, userId, stickerURL, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavouriteSticker indicates an expected call of AddFavouriteSticker.
+func (mr *MockStickerRepositoryMockRecorder) AddFavouriteSticker(ctx, userId, stickerURL, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavouriteSticker", reflect.TypeOf((*MockStickerRepository)(nil).AddFavouriteSticker), ctx, userId, stickerURL, now)
+}
+
 // AddStickerPack mocks base method.
 func (m *MockStickerRepository) AddStickerPack(ctx context.Context, stickerPack models.StickerPack) error {
 	m.ctrl.T.Helper()
@@ -79,6 +94,36 @@ func (mr *MockStickerRepositoryMockRecorder) DeleteStickerPack(ctx, userId, pack
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStickerPack", reflect.TypeOf((*MockStickerRepository)(nil).DeleteStickerPack), ctx, userId, packId)
 }
 
+// GetFavouriteStickers mocks base method.
+func (m *MockStickerRepository) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavouriteStickers", ctx, userId)
+	ret0, _ := ret[0].([]*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavouriteStickers indicates an expected call of GetFavouriteStickers.
+func (mr *MockStickerRepositoryMockRecorder) GetFavouriteStickers(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavouriteStickers", reflect.TypeOf((*MockStickerRepository)(nil).GetFavouriteStickers), ctx, userId)
+}
+
+// GetRecentStickers mocks base method.
+func (m *MockStickerRepository) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentStickers", ctx, userId)
+	ret0, _ := ret[0].([]*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentStickers indicates an expected call of GetRecentStickers.
+func (mr *MockStickerRepositoryMockRecorder) GetRecentStickers(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentStickers", reflect.TypeOf((*MockStickerRepository)(nil).GetRecentStickers), ctx, userId)
+}
+
 // GetStickerPack mocks base method.
 func (m *MockStickerRepository) GetStickerPack(ctx context.Context, packId uuid.UUID) (models.StickerPack, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +169,77 @@ func (mr *MockStickerRepositoryMockRecorder) GetStickerPacks(ctx, userId, count,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickerPacks", reflect.TypeOf((*MockStickerRepository)(nil).GetStickerPacks), ctx, userId, count, offset)
 }
 
+// GetUserStickerPacks mocks base method.
+func (m *MockStickerRepository) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]models.StickerPack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStickerPacks", ctx, userId)
+	ret0, _ := ret[0].([]models.StickerPack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStickerPacks indicates an expected call of GetUserStickerPacks.
+func (mr *MockStickerRepositoryMockRecorder) GetUserStickerPacks(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStickerPacks", reflect.TypeOf((*MockStickerRepository)(nil).GetUserStickerPacks), ctx, userId)
+}
+
+// InstallStickerPack mocks base method.
+func (m *MockStickerRepository) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallStickerPack", ctx, userId, packId, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallStickerPack indicates an expected call of InstallStickerPack.
+func (mr *MockStickerRepositoryMockRecorder) InstallStickerPack(ctx, userId, packId, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallStickerPack", reflect.TypeOf((*MockStickerRepository)(nil).InstallStickerPack), ctx, userId, packId, now)
+}
+
+// RemoveFavouriteSticker mocks base method.
+func (m *MockStickerRepository) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavouriteSticker", ctx, userId, stickerURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavouriteSticker indicates an expected call of RemoveFavouriteSticker.
+func (mr *MockStickerRepositoryMockRecorder) RemoveFavouriteSticker(ctx, userId, stickerURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavouriteSticker", reflect.TypeOf((*MockStickerRepository)(nil).RemoveFavouriteSticker), ctx, userId, stickerURL)
+}
+
+// SetUserStickerPacksOrder mocks base method.
+func (m *MockStickerRepository) SetUserStickerPacksOrder(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserStickerPacksOrder", ctx, userId, packIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserStickerPacksOrder indicates an expected call of SetUserStickerPacksOrder.
+func (mr *MockStickerRepositoryMockRecorder) SetUserStickerPacksOrder(ctx, userId, packIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserStickerPacksOrder", reflect.TypeOf((*MockStickerRepository)(nil).SetUserStickerPacksOrder), ctx, userId, packIds)
+}
+
+// UninstallStickerPack mocks base method.
+func (m *MockStickerRepository) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallStickerPack", ctx, userId, packId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallStickerPack indicates an expected call of UninstallStickerPack.
+func (mr *MockStickerRepositoryMockRecorder) UninstallStickerPack(ctx, userId, packId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallStickerPack", reflect.TypeOf((*MockStickerRepository)(nil).UninstallStickerPack), ctx, userId, packId)
+}
+
 // MockStickerPackValidator is a mock of StickerPackValidator interface.
 type MockStickerPackValidator struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+// MessageSender sends messages the same way as they are sent by users
+type MessageSender interface {
+	GetMessageById(ctx context.Context, messageId uuid.UUID) (models.Message, error)
+	SaveMessage(ctx context.Context, message models.Message) (*models.Message, error)
+}
+
+// ScheduledMessageDispatcher periodically sends scheduled messages once they are due
+type ScheduledMessageDispatcher struct {
+	scheduledRepo ScheduledMessageRepository
+	chatRepo      ChatRepository
+	sender        MessageSender
+	publisher     EventPublisher
+	interval      time.Duration
+	// lease is the time a dispatcher has to send a taken message before others may take it
+	lease     time.Duration
+	batchSize int
+}
+
+func NewScheduledMessageDispatcher(scheduledRepo ScheduledMessageRepository, chatRepo ChatRepository, sender MessageSender, publisher EventPublisher, interval, lease time.Duration, batchSize int) *ScheduledMessageDispatcher {
+	return &ScheduledMessageDispatcher{
+		scheduledRepo: scheduledRepo,
+		chatRepo:      chatRepo,
+		sender:        sender,
+		publisher:     publisher,
+		interval:      interval,
+		lease:         lease,
+		batchSize:     batchSize,
+	}
+}
+
+// Run sends due messages every interval until ctx is done
+func (d *ScheduledMessageDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a full batch means more messages may be due
+			for {
+				taken, err := d.DispatchDue(ctx)
+				if err != nil {
+					logger.Error(ctx, "Failed to dispatch scheduled messages: %v", err)
+					break
+				}
+				if taken < d.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// DispatchDue sends one batch of due messages and returns the number of taken messages.
+// Messages that failed to be sent are taken again once their lease ends.
+func (d *ScheduledMessageDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := d.scheduledRepo.ClaimDueScheduledMessages(ctx, now, now.Add(d.lease), d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, scheduled := range due {
+		if err = d.dispatch(ctx, scheduled); err != nil {
+			logger.Error(ctx, "Failed to send scheduled message %s, it will be retried: %v", scheduled.Message.ID, err)
+		}
+	}
+
+	if len(due) != 0 {
+		logger.Info(ctx, "Dispatched %d scheduled messages", len(due))
+	}
+	return len(due), nil
+}
+
+func (d *ScheduledMessageDispatcher) dispatch(ctx context.Context, scheduled models.ScheduledMessage) error {
+	message := scheduled.Message
+
+	// the sent message keeps the id, so a message sent before a failed deletion is not sent twice
+	_, err := d.sender.GetMessageById(ctx, message.ID)
+	if err == nil {
+		return d.scheduledRepo.DeleteScheduledMessage(ctx, message.ID)
+	} else if !errors.Is(err, messenger_errors.ErrNotFound) {
+		return fmt.Errorf("d.sender.GetMessageById: %w", err)
+	}
+
+	isParticipant, err := d.chatRepo.IsParticipant(ctx, message.ChatID, message.SenderID)
+	if err != nil {
+		return fmt.Errorf("d.chatRepo.IsParticipant: %w", err)
+	}
+	if !isParticipant {
+		logger.Info(ctx, "Sender of scheduled message %s left chat %s, the message is dropped", message.ID, message.ChatID)
+		return d.scheduledRepo.DeleteScheduledMessage(ctx, message.ID)
+	}
+
+	now := time.Now()
+	message.CreatedAt = now
+	message.UpdatedAt = now
+	sent, err := d.sender.SaveMessage(ctx, message)
+	if errors.Is(err, messenger_errors.ErrInvalidReplyTo) {
+		// the replied message was deleted while the message was waiting
+		message.ReplyToID = uuid.Nil
+		sent, err = d.sender.SaveMessage(ctx, message)
+	}
+	if errors.Is(err, messenger_errors.ErrChatEncrypted) {
+		logger.Info(ctx, "Chat %s of scheduled message %s was encrypted, the message is dropped", message.ChatID, message.ID)
+		return d.scheduledRepo.DeleteScheduledMessage(ctx, message.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("d.sender.SaveMessage: %w", err)
+	}
+
+	if err = d.scheduledRepo.DeleteScheduledMessage(ctx, message.ID); err != nil {
+		return err
+	}
+	d.notify(ctx, *sent)
+	return nil
+}
+
+// notify asks gateways to deliver the message as a live one.
+// The message is already sent when notification fails, clients get it on resume.
+func (d *ScheduledMessageDispatcher) notify(ctx context.Context, message models.Message) {
+	receivers, err := d.chatRepo.GetChatParticipants(ctx, message.ChatID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat %s participants: %v", message.ChatID, err)
+		return
+	}
+
+	event, err := eventbus.NewEvent(eventbus.ScheduledMessageSent, eventbus.ScheduledMessageSentPayload{
+		ChatID:    message.ChatID,
+		MessageID: message.ID,
+	}, receivers...)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal scheduled message %s: %v", message.ID, err)
+		return
+	}
+	if err = d.publisher.Publish(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to notify about scheduled message %s: %v", message.ID, err)
+	}
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/shared/models"
+)
+
+type ScheduledMessageRepository interface {
+	SaveScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error
+	GetScheduledMessage(ctx context.Context, id uuid.UUID) (models.ScheduledMessage, error)
+	GetScheduledMessages(ctx context.Context, chatId, senderId uuid.UUID) ([]models.ScheduledMessage, error)
+	UpdateScheduledMessage(ctx context.Context, scheduled models.ScheduledMessage) error
+	CancelScheduledMessage(ctx context.Context, id uuid.UUID, now time.Time) error
+	DeleteScheduledMessage(ctx context.Context, id uuid.UUID) error
+	ClaimDueScheduledMessages(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ScheduledMessage, error)
+}
+
+// ScheduledMessageService keeps messages of users until their send time, ScheduledMessageDispatcher sends them
+type ScheduledMessageService struct {
+	scheduledRepo ScheduledMessageRepository
+	chatRepo      ChatRepository
+	validator     MessageValidator
+}
+
+func NewScheduledMessageService(scheduledRepo ScheduledMessageRepository, chatRepo ChatRepository, validator MessageValidator) *ScheduledMessageService {
+	return &ScheduledMessageService{
+		scheduledRepo: scheduledRepo,
+		chatRepo:      chatRepo,
+		validator:     validator,
+	}
+}
+
+// ScheduleMessage saves the message to be sent at SendAt, to the private chat with the receiver if chat is empty.
+// Messages can not be scheduled in encrypted chats, polls can not be scheduled.
+func (s *ScheduledMessageService) ScheduleMessage(ctx context.Context, scheduled models.ScheduledMessage) (models.ScheduledMessage, error) {
+	now := time.Now()
+	if scheduled.Message.Encrypted != nil {
+		return models.ScheduledMessage{}, messenger_errors.ErrChatEncrypted
+	}
+	if scheduled.Message.Poll != nil {
+		return models.ScheduledMessage{}, messenger_errors.ErrInvalidPoll
+	}
+	if err := s.validator.ValidateMessage(scheduled.Message); err != nil {
+		return models.ScheduledMessage{}, fmt.Errorf("validation.ValidateMessage: %w", err)
+	}
+	if err := s.validator.ValidateSendAt(scheduled.SendAt, now); err != nil {
+		return models.ScheduledMessage{}, messenger_errors.ErrInvalidSendAt
+	}
+
+	message := &scheduled.Message
+	if message.ChatID == uuid.Nil {
+		chatId, err := getOrCreatePrivateChat(ctx, s.chatRepo, message.SenderID, message.ReceiverID)
+		if err != nil {
+			return models.ScheduledMessage{}, err
+		}
+		message.ChatID = chatId
+	} else {
+		isParticipant, err := s.chatRepo.IsParticipant(ctx, message.ChatID, message.SenderID)
+		if err != nil {
+			return models.ScheduledMessage{}, fmt.Errorf("s.chatRepo.IsParticipant: %w", err)
+		}
+		if !isParticipant {
+			return models.ScheduledMessage{}, messenger_errors.ErrNotParticipant
+		}
+		if err = checkChatNotEncrypted(ctx, s.chatRepo, message.ChatID); err != nil {
+			return models.ScheduledMessage{}, err
+		}
+	}
+
+	message.ID = uuid.New()
+	message.CreatedAt = now
+	message.UpdatedAt = now
+	if err := s.scheduledRepo.SaveScheduledMessage(ctx, scheduled); err != nil {
+		return models.ScheduledMessage{}, fmt.Errorf("s.scheduledRepo.SaveScheduledMessage: %w", err)
+	}
+	return scheduled, nil
+}
+
+// GetScheduledMessages returns messages the user scheduled in the chat, the earliest first
+func (s *ScheduledMessageService) GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error) {
+	scheduledMessages, err := s.scheduledRepo.GetScheduledMessages(ctx, chatId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("s.scheduledRepo.GetScheduledMessages: %w", err)
+	}
+	return scheduledMessages, nil
+}
+
+// UpdateScheduledMessage changes text, attachments and send time of the message, only the sender can do it
+func (s *ScheduledMessageService) UpdateScheduledMessage(ctx context.Context, update models.ScheduledMessage, userId uuid.UUID) (models.ScheduledMessage, error) {
+	scheduled, err := s.getOwnScheduledMessage(ctx, update.Message.ID, userId)
+	if err != nil {
+		return models.ScheduledMessage{}, err
+	}
+
+	now := time.Now()
+	scheduled.Message.Text = update.Message.Text
+	scheduled.Message.Attachments = update.Message.Attachments
+	scheduled.Message.UpdatedAt = now
+	scheduled.SendAt = update.SendAt
+	if err = s.validator.ValidateMessage(scheduled.Message); err != nil {
+		return models.ScheduledMessage{}, fmt.Errorf("validation.ValidateMessage: %w", err)
+	}
+	if err = s.validator.ValidateSendAt(scheduled.SendAt, now); err != nil {
+		return models.ScheduledMessage{}, messenger_errors.ErrInvalidSendAt
+	}
+
+	if err = s.scheduledRepo.UpdateScheduledMessage(ctx, scheduled); err != nil {
+		return models.ScheduledMessage{}, fmt.Errorf("s.scheduledRepo.UpdateScheduledMessage: %w", err)
+	}
+	return scheduled, nil
+}
+
+// CancelScheduledMessage deletes the message before it is sent, only the sender can do it
+func (s *ScheduledMessageService) CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error {
+	if _, err := s.getOwnScheduledMessage(ctx, id, userId); err != nil {
+		return err
+	}
+
+	if err := s.scheduledRepo.CancelScheduledMessage(ctx, id, time.Now()); err != nil {
+		return fmt.Errorf("s.scheduledRepo.CancelScheduledMessage: %w", err)
+	}
+	return nil
+}
+
+func (s *ScheduledMessageService) getOwnScheduledMessage(ctx context.Context, id, userId uuid.UUID) (models.ScheduledMessage, error) {
+	scheduled, err := s.scheduledRepo.GetScheduledMessage(ctx, id)
+	if err != nil {
+		return models.ScheduledMessage{}, fmt.Errorf("s.scheduledRepo.GetScheduledMessage: %w", err)
+	}
+	if scheduled.Message.SenderID != userId {
+		return models.ScheduledMessage{}, messenger_errors.ErrNotSender
+	}
+	return scheduled, nil
+}
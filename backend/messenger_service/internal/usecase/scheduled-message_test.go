@@ -0,0 +1,327 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messenger_errors "quickflow/messenger_service/internal/errors"
+	"quickflow/messenger_service/internal/usecase"
+	"quickflow/messenger_service/internal/usecase/mocks"
+	"quickflow/shared/eventbus"
+	"quickflow/shared/models"
+)
+
+func TestScheduleMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	chatID, senderID := uuid.New(), uuid.New()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ChatID: chatID, SenderID: senderID, Text: "С днём рождения!"},
+		SendAt:  time.Now().Add(12 * time.Hour),
+	}
+
+	// Ожидания для моков
+	validator.EXPECT().ValidateMessage(scheduled.Message).Return(nil)
+	validator.EXPECT().ValidateSendAt(scheduled.SendAt, gomock.Any()).Return(nil)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, senderID).Return(true, nil)
+	chatRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(models.Chat{ID: chatID}, nil)
+	scheduledRepo.EXPECT().SaveScheduledMessage(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, saved models.ScheduledMessage) {
+			assert.NotEqual(t, uuid.Nil, saved.Message.ID)
+			assert.Equal(t, "С днём рождения!", saved.Message.Text)
+		}).
+		Return(nil)
+
+	// Вызов метода
+	service := usecase.NewScheduledMessageService(scheduledRepo, chatRepo, validator)
+	saved, err := service.ScheduleMessage(context.Background(), scheduled)
+
+	// Проверки
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, saved.Message.ID)
+	assert.Equal(t, scheduled.SendAt, saved.SendAt)
+}
+
+func TestScheduleMessage_Errors(t *testing.T) {
+	chatID, senderID := uuid.New(), uuid.New()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ChatID: chatID, SenderID: senderID, Text: "hello"},
+		SendAt:  time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(chatRepo *mocks.MockChatRepository, validator *mocks.MockMessageValidator)
+		wantErr   error
+	}{
+		{
+			name: "send time in the past",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockMessageValidator) {
+				validator.EXPECT().ValidateMessage(gomock.Any()).Return(nil)
+				validator.EXPECT().ValidateSendAt(gomock.Any(), gomock.Any()).Return(errors.New("send time must be in the future"))
+			},
+			wantErr: messenger_errors.ErrInvalidSendAt,
+		},
+		{
+			name: "not a participant",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockMessageValidator) {
+				validator.EXPECT().ValidateMessage(gomock.Any()).Return(nil)
+				validator.EXPECT().ValidateSendAt(gomock.Any(), gomock.Any()).Return(nil)
+				chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, senderID).Return(false, nil)
+			},
+			wantErr: messenger_errors.ErrNotParticipant,
+		},
+		{
+			name: "encrypted chat",
+			mockSetup: func(chatRepo *mocks.MockChatRepository, validator *mocks.MockMessageValidator) {
+				validator.EXPECT().ValidateMessage(gomock.Any()).Return(nil)
+				validator.EXPECT().ValidateSendAt(gomock.Any(), gomock.Any()).Return(nil)
+				chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, senderID).Return(true, nil)
+				chatRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(models.Chat{ID: chatID, Encrypted: true}, nil)
+			},
+			wantErr: messenger_errors.ErrChatEncrypted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chatRepo := mocks.NewMockChatRepository(ctrl)
+			validator := mocks.NewMockMessageValidator(ctrl)
+			tt.mockSetup(chatRepo, validator)
+
+			service := usecase.NewScheduledMessageService(nil, chatRepo, validator)
+			_, err := service.ScheduleMessage(context.Background(), scheduled)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUpdateScheduledMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	validator := mocks.NewMockMessageValidator(ctrl)
+
+	// Подготовка тестовых данных
+	id, chatID, senderID := uuid.New(), uuid.New(), uuid.New()
+	existing := models.ScheduledMessage{
+		Message: models.Message{ID: id, ChatID: chatID, SenderID: senderID, Text: "old"},
+		SendAt:  time.Now().Add(time.Hour),
+	}
+	update := models.ScheduledMessage{
+		Message: models.Message{ID: id, Text: "new"},
+		SendAt:  time.Now().Add(2 * time.Hour),
+	}
+
+	// Ожидания для моков
+	scheduledRepo.EXPECT().GetScheduledMessage(gomock.Any(), id).Return(existing, nil).Times(2)
+	validator.EXPECT().ValidateMessage(gomock.Any()).Return(nil)
+	validator.EXPECT().ValidateSendAt(update.SendAt, gomock.Any()).Return(nil)
+	scheduledRepo.EXPECT().UpdateScheduledMessage(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, updated models.ScheduledMessage) {
+			assert.Equal(t, chatID, updated.Message.ChatID)
+			assert.Equal(t, "new", updated.Message.Text)
+			assert.Equal(t, update.SendAt, updated.SendAt)
+		}).
+		Return(nil)
+
+	// Вызов метода
+	service := usecase.NewScheduledMessageService(scheduledRepo, nil, validator)
+	updated, err := service.UpdateScheduledMessage(context.Background(), update, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, "new", updated.Message.Text)
+
+	// Проверки: only the sender can edit the message
+	_, err = service.UpdateScheduledMessage(context.Background(), update, uuid.New())
+	assert.ErrorIs(t, err, messenger_errors.ErrNotSender)
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+
+	// Подготовка тестовых данных
+	id, senderID := uuid.New(), uuid.New()
+
+	// Ожидания для моков
+	scheduledRepo.EXPECT().GetScheduledMessage(gomock.Any(), id).
+		Return(models.ScheduledMessage{Message: models.Message{ID: id, SenderID: senderID}}, nil)
+	scheduledRepo.EXPECT().CancelScheduledMessage(gomock.Any(), id, gomock.Any()).Return(messenger_errors.ErrNotFound)
+
+	// Вызов метода
+	service := usecase.NewScheduledMessageService(scheduledRepo, nil, nil)
+	err := service.CancelScheduledMessage(context.Background(), id, senderID)
+
+	// Проверки: the message is already being sent
+	assert.ErrorIs(t, err, messenger_errors.ErrNotFound)
+}
+
+func TestScheduledMessageDispatcher_DispatchDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	sender := mocks.NewMockMessageSender(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	// Подготовка тестовых данных
+	chatID, senderID := uuid.New(), uuid.New()
+	participants := []uuid.UUID{senderID, uuid.New()}
+	due := models.ScheduledMessage{
+		Message: models.Message{ID: uuid.New(), ChatID: chatID, SenderID: senderID, Text: "hello", ReplyToID: uuid.New()},
+		SendAt:  time.Now().Add(-time.Second),
+	}
+
+	// Ожидания для моков
+	scheduledRepo.EXPECT().ClaimDueScheduledMessages(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]models.ScheduledMessage{due}, nil)
+	sender.EXPECT().GetMessageById(gomock.Any(), due.Message.ID).Return(models.Message{}, messenger_errors.ErrNotFound)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), chatID, senderID).Return(true, nil)
+	gomock.InOrder(
+		sender.EXPECT().SaveMessage(gomock.Any(), gomock.Any()).Return(nil, messenger_errors.ErrInvalidReplyTo),
+		sender.EXPECT().SaveMessage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, message models.Message) (*models.Message, error) {
+				assert.Equal(t, due.Message.ID, message.ID)
+				assert.Equal(t, uuid.Nil, message.ReplyToID)
+				assert.True(t, message.CreatedAt.After(due.SendAt))
+				return &message, nil
+			}),
+	)
+	scheduledRepo.EXPECT().DeleteScheduledMessage(gomock.Any(), due.Message.ID).Return(nil)
+	chatRepo.EXPECT().GetChatParticipants(gomock.Any(), chatID).Return(participants, nil)
+	var published eventbus.Event
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventbus.Event) error {
+		published = event
+		return nil
+	})
+
+	// Вызов метода
+	dispatcher := usecase.NewScheduledMessageDispatcher(scheduledRepo, chatRepo, sender, publisher, 0, time.Minute, 10)
+	taken, err := dispatcher.DispatchDue(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+	assert.Equal(t, eventbus.ScheduledMessageSent, published.Type)
+	assert.Equal(t, participants, published.Receivers)
+	var payload eventbus.ScheduledMessageSentPayload
+	require.NoError(t, json.Unmarshal(published.Payload, &payload))
+	assert.Equal(t, eventbus.ScheduledMessageSentPayload{ChatID: chatID, MessageID: due.Message.ID}, payload)
+}
+
+func TestScheduledMessageDispatcher_DispatchDue_AlreadySent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	sender := mocks.NewMockMessageSender(ctrl)
+
+	// Подготовка тестовых данных
+	due := models.ScheduledMessage{Message: models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New()}}
+
+	// Ожидания для моков: the message was sent, but not deleted before
+	scheduledRepo.EXPECT().ClaimDueScheduledMessages(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]models.ScheduledMessage{due}, nil)
+	sender.EXPECT().GetMessageById(gomock.Any(), due.Message.ID).Return(due.Message, nil)
+	scheduledRepo.EXPECT().DeleteScheduledMessage(gomock.Any(), due.Message.ID).Return(nil)
+
+	// Вызов метода
+	dispatcher := usecase.NewScheduledMessageDispatcher(scheduledRepo, nil, sender, nil, 0, time.Minute, 10)
+	taken, err := dispatcher.DispatchDue(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+}
+
+func TestScheduledMessageDispatcher_DispatchDue_SenderLeft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	sender := mocks.NewMockMessageSender(ctrl)
+
+	// Подготовка тестовых данных
+	due := models.ScheduledMessage{Message: models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New()}}
+
+	// Ожидания для моков
+	scheduledRepo.EXPECT().ClaimDueScheduledMessages(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]models.ScheduledMessage{due}, nil)
+	sender.EXPECT().GetMessageById(gomock.Any(), due.Message.ID).Return(models.Message{}, messenger_errors.ErrNotFound)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), due.Message.ChatID, due.Message.SenderID).Return(false, nil)
+	scheduledRepo.EXPECT().DeleteScheduledMessage(gomock.Any(), due.Message.ID).Return(nil)
+
+	// Вызов метода
+	dispatcher := usecase.NewScheduledMessageDispatcher(scheduledRepo, chatRepo, sender, nil, 0, time.Minute, 10)
+	_, err := dispatcher.DispatchDue(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+}
+
+func TestScheduledMessageDispatcher_DispatchDue_ChatEncrypted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	scheduledRepo := mocks.NewMockScheduledMessageRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	sender := mocks.NewMockMessageSender(ctrl)
+
+	// Подготовка тестовых данных
+	due := models.ScheduledMessage{Message: models.Message{ID: uuid.New(), ChatID: uuid.New(), SenderID: uuid.New()}}
+
+	// Ожидания для моков
+	scheduledRepo.EXPECT().ClaimDueScheduledMessages(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]models.ScheduledMessage{due}, nil)
+	sender.EXPECT().GetMessageById(gomock.Any(), due.Message.ID).Return(models.Message{}, messenger_errors.ErrNotFound)
+	chatRepo.EXPECT().IsParticipant(gomock.Any(), due.Message.ChatID, due.Message.SenderID).Return(true, nil)
+	sender.EXPECT().SaveMessage(gomock.Any(), gomock.Any()).Return(nil, messenger_errors.ErrChatEncrypted)
+	scheduledRepo.EXPECT().DeleteScheduledMessage(gomock.Any(), due.Message.ID).Return(nil)
+
+	// Вызов метода
+	dispatcher := usecase.NewScheduledMessageDispatcher(scheduledRepo, chatRepo, sender, nil, 0, time.Minute, 10)
+	_, err := dispatcher.DispatchDue(context.Background())
+
+	// Проверки
+	require.NoError(t, err)
+}
+
+func TestScheduleMessage_Poll(t *testing.T) {
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ChatID: uuid.New(), SenderID: uuid.New(), Poll: &models.Poll{Question: "Lunch?"}},
+		SendAt:  time.Now().Add(time.Hour),
+	}
+
+	service := usecase.NewScheduledMessageService(nil, nil, nil)
+	_, err := service.ScheduleMessage(context.Background(), scheduled)
+	assert.ErrorIs(t, err, messenger_errors.ErrInvalidPoll)
+}
@@ -2,6 +2,9 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -16,8 +19,20 @@ type StickerRepository interface {
 	DeleteStickerPack(ctx context.Context, userId, packId uuid.UUID) error
 	GetStickerPackByName(ctx context.Context, name string) (models.StickerPack, error)
 	BelongsTo(ctx context.Context, userId uuid.UUID, packId uuid.UUID) (bool, error)
+
+	InstallStickerPack(ctx context.Context, userId, packId uuid.UUID, now time.Time) error
+	UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error
+	GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]models.StickerPack, error)
+	SetUserStickerPacksOrder(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error
+	GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
+	AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string, now time.Time) error
+	RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error
+	GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error)
 }
 
+// maxFavouriteStickers limits the number of stickers the user can keep in favourites
+const maxFavouriteStickers = 50
+
 type StickerPackValidator interface {
 	ValidateStickerPack(stickerPack *models.StickerPack) error
 }
@@ -91,3 +106,106 @@ func (s *StickerService) BelongsTo(ctx context.Context, userId uuid.UUID, packId
 	}
 	return belongs, nil
 }
+
+// InstallStickerPack adds the pack to the end of the user's sticker library
+func (s *StickerService) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	if _, err := s.stickerRepo.GetStickerPack(ctx, packId); err != nil {
+		return fmt.Errorf("s.stickerRepo.GetStickerPack: %w", err)
+	}
+
+	if err := s.stickerRepo.InstallStickerPack(ctx, userId, packId, time.Now()); err != nil {
+		return fmt.Errorf("s.stickerRepo.InstallStickerPack: %w", err)
+	}
+	return nil
+}
+
+func (s *StickerService) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	if err := s.stickerRepo.UninstallStickerPack(ctx, userId, packId); err != nil {
+		return fmt.Errorf("s.stickerRepo.UninstallStickerPack: %w", err)
+	}
+	return nil
+}
+
+// GetUserStickerPacks returns packs of the user's sticker library in their order
+func (s *StickerService) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]models.StickerPack, error) {
+	packs, err := s.stickerRepo.GetUserStickerPacks(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("s.stickerRepo.GetUserStickerPacks: %w", err)
+	}
+	return packs, nil
+}
+
+// ReorderUserStickerPacks sets the new order of the user's sticker library, it must list every installed pack once
+func (s *StickerService) ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	packs, err := s.stickerRepo.GetUserStickerPacks(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("s.stickerRepo.GetUserStickerPacks: %w", err)
+	}
+
+	if len(packIds) != len(packs) {
+		return messenger_errors.ErrInvalidStickerPackOrder
+	}
+	installed := make([]uuid.UUID, len(packs))
+	for i, pack := range packs {
+		installed[i] = pack.Id
+	}
+	seen := make(map[uuid.UUID]struct{}, len(packIds))
+	for _, packId := range packIds {
+		if _, ok := seen[packId]; ok || !slices.Contains(installed, packId) {
+			return messenger_errors.ErrInvalidStickerPackOrder
+		}
+		seen[packId] = struct{}{}
+	}
+
+	if err = s.stickerRepo.SetUserStickerPacksOrder(ctx, userId, packIds); err != nil {
+		return fmt.Errorf("s.stickerRepo.SetUserStickerPacksOrder: %w", err)
+	}
+	return nil
+}
+
+// GetRecentStickers returns stickers the user sent lately, the latest first
+func (s *StickerService) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	stickers, err := s.stickerRepo.GetRecentStickers(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("s.stickerRepo.GetRecentStickers: %w", err)
+	}
+	return stickers, nil
+}
+
+// AddFavouriteSticker adds the sticker of any pack to the user's favourites
+func (s *StickerService) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	favourites, err := s.stickerRepo.GetFavouriteStickers(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("s.stickerRepo.GetFavouriteStickers: %w", err)
+	}
+	isFavourite := slices.ContainsFunc(favourites, func(sticker *models.File) bool {
+		return sticker.URL == stickerURL
+	})
+	if isFavourite {
+		return nil
+	}
+	if len(favourites) >= maxFavouriteStickers {
+		return messenger_errors.ErrTooManyFavouriteStickers
+	}
+
+	if err = s.stickerRepo.AddFavouriteSticker(ctx, userId, stickerURL, time.Now()); err != nil {
+		return fmt.Errorf("s.stickerRepo.AddFavouriteSticker: %w", err)
+	}
+	return nil
+}
+
+func (s *StickerService) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	if err := s.stickerRepo.RemoveFavouriteSticker(ctx, userId, stickerURL); err != nil {
+		return fmt.Errorf("s.stickerRepo.RemoveFavouriteSticker: %w", err)
+	}
+	return nil
+}
+
+// GetFavouriteStickers returns favourite stickers of the user, the last added first
+func (s *StickerService) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	stickers, err := s.stickerRepo.GetFavouriteStickers(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("s.stickerRepo.GetFavouriteStickers: %w", err)
+	}
+	return stickers, nil
+}
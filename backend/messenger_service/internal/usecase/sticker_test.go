@@ -273,3 +273,102 @@ func TestBelongsTo_NotFound(t *testing.T) {
 	assert.Equal(t, err, messenger_errors.ErrNotFound)
 	assert.False(t, belongs)
 }
+
+func TestInstallStickerPack_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	stickerRepo := mocks.NewMockStickerRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	packId := uuid.New()
+
+	// Ожидания для моков
+	stickerRepo.EXPECT().GetStickerPack(gomock.Any(), packId).Return(models.StickerPack{}, messenger_errors.ErrNotFound)
+
+	// Создаем сервис
+	stickerService := usecase.NewStickerService(stickerRepo, nil, nil)
+
+	// Вызов метода
+	err := stickerService.InstallStickerPack(context.Background(), userId, packId)
+
+	// Проверки
+	assert.ErrorIs(t, err, messenger_errors.ErrNotFound)
+}
+
+func TestReorderUserStickerPacks(t *testing.T) {
+	userId := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	installed := []models.StickerPack{{Id: first}, {Id: second}}
+
+	tests := []struct {
+		name        string
+		packIds     []uuid.UUID
+		expectedErr error
+	}{
+		{name: "new order", packIds: []uuid.UUID{second, first}},
+		{name: "missing pack", packIds: []uuid.UUID{second}, expectedErr: messenger_errors.ErrInvalidStickerPackOrder},
+		{name: "duplicate pack", packIds: []uuid.UUID{second, second}, expectedErr: messenger_errors.ErrInvalidStickerPackOrder},
+		{name: "not installed pack", packIds: []uuid.UUID{second, uuid.New()}, expectedErr: messenger_errors.ErrInvalidStickerPackOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Моки
+			stickerRepo := mocks.NewMockStickerRepository(ctrl)
+
+			// Ожидания для моков
+			stickerRepo.EXPECT().GetUserStickerPacks(gomock.Any(), userId).Return(installed, nil)
+			if tt.expectedErr == nil {
+				stickerRepo.EXPECT().SetUserStickerPacksOrder(gomock.Any(), userId, tt.packIds).Return(nil)
+			}
+
+			// Создаем сервис
+			stickerService := usecase.NewStickerService(stickerRepo, nil, nil)
+
+			// Вызов метода
+			err := stickerService.ReorderUserStickerPacks(context.Background(), userId, tt.packIds)
+
+			// Проверки
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAddFavouriteSticker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	stickerRepo := mocks.NewMockStickerRepository(ctrl)
+
+	// Подготовка тестовых данных
+	userId := uuid.New()
+	full := make([]*models.File, 50)
+	for i := range full {
+		full[i] = &models.File{URL: uuid.NewString(), DisplayType: models.DisplayTypeSticker}
+	}
+
+	// Ожидания для моков
+	stickerRepo.EXPECT().GetFavouriteStickers(gomock.Any(), userId).Return(full[:1], nil)
+	stickerRepo.EXPECT().AddFavouriteSticker(gomock.Any(), userId, "cat.webp", gomock.Any()).Return(nil)
+	stickerRepo.EXPECT().GetFavouriteStickers(gomock.Any(), userId).Return(full, nil).Times(2)
+
+	// Создаем сервис
+	stickerService := usecase.NewStickerService(stickerRepo, nil, nil)
+
+	// Вызов метода
+	added := stickerService.AddFavouriteSticker(context.Background(), userId, "cat.webp")
+	tooMany := stickerService.AddFavouriteSticker(context.Background(), userId, "dog.webp")
+	alreadyFavourite := stickerService.AddFavouriteSticker(context.Background(), userId, full[0].URL)
+
+	// Проверки
+	assert.NoError(t, added)
+	assert.ErrorIs(t, tooMany, messenger_errors.ErrTooManyFavouriteStickers)
+	assert.NoError(t, alreadyFavourite)
+}
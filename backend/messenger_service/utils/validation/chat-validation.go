@@ -2,6 +2,7 @@ package validation
 
 import (
 	"errors"
+	"time"
 
 	"quickflow/shared/models"
 )
@@ -22,17 +23,63 @@ func (c *ChatValidator) ValidateChatCreationInfo(chatInfo models.ChatCreationInf
 			return errors.New("unexpected avatar for private chat")
 		}
 	case models.ChatTypeGroup:
-		if len(chatInfo.Name) == 0 {
-			return errors.New("empty name for group chat")
-		}
-		if len(chatInfo.Name) > 30 {
-			return errors.New("name too long for group chat")
-		}
-		if len(chatInfo.Name) < 3 {
-			return errors.New("name too short for group chat")
-		}
+		return validateGroupChatName(chatInfo.Name)
 	default:
 		return errors.New("invalid chat type")
 	}
 	return nil
 }
+
+// ValidateChatUpdateInfo requires at least one field to change, empty name keeps the old one
+func (c *ChatValidator) ValidateChatUpdateInfo(info models.ChatUpdateInfo) error {
+	if len(info.Name) == 0 && info.Avatar == nil {
+		return errors.New("nothing to update")
+	}
+	if len(info.Name) != 0 {
+		return validateGroupChatName(info.Name)
+	}
+	return nil
+}
+
+// ValidateChatInviteCreationInfo accepts invites without expiry and with zero usage limit, which never run out
+func (c *ChatValidator) ValidateChatInviteCreationInfo(info models.ChatInviteCreationInfo) error {
+	if info.ExpiresAt != nil && !info.ExpiresAt.After(time.Now()) {
+		return errors.New("invite expiry is in the past")
+	}
+	if info.UsageLimit < 0 {
+		return errors.New("negative invite usage limit")
+	}
+	return nil
+}
+
+const (
+	minMessageTTL = 30 * time.Second
+	maxMessageTTL = 365 * 24 * time.Hour
+)
+
+// ValidateMessageTTL accepts zero, which turns disappearing messages off
+func (c *ChatValidator) ValidateMessageTTL(ttl time.Duration) error {
+	if ttl == 0 {
+		return nil
+	}
+	if ttl < minMessageTTL {
+		return errors.New("message ttl too short")
+	}
+	if ttl > maxMessageTTL {
+		return errors.New("message ttl too long")
+	}
+	return nil
+}
+
+func validateGroupChatName(name string) error {
+	if len(name) == 0 {
+		return errors.New("empty name for group chat")
+	}
+	if len(name) > 30 {
+		return errors.New("name too long for group chat")
+	}
+	if len(name) < 3 {
+		return errors.New("name too short for group chat")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"quickflow/gateway/utils/validation"
 	"testing"
+	"time"
 
 	"quickflow/shared/models"
 
@@ -89,3 +90,103 @@ func TestValidateChatCreationInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestChatValidator_ValidateChatUpdateInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    models.ChatUpdateInfo
+		expected error
+	}{
+		{
+			name:  "rename",
+			input: models.ChatUpdateInfo{Name: "NewName"},
+		},
+		{
+			name:  "avatar only",
+			input: models.ChatUpdateInfo{Avatar: &models.File{Name: "Avatar"}},
+		},
+		{
+			name:     "nothing to update",
+			input:    models.ChatUpdateInfo{},
+			expected: errors.New("nothing to update"),
+		},
+		{
+			name:     "short name",
+			input:    models.ChatUpdateInfo{Name: "Go"},
+			expected: errors.New("name too short for group chat"),
+		},
+	}
+
+	validator := NewChatValidator()
+	for _, tt := range tests {
+		err := validator.ValidateChatUpdateInfo(tt.input)
+		if tt.expected != nil {
+			require.EqualError(t, err, tt.expected.Error(), tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
+
+func TestChatValidator_ValidateChatInviteCreationInfo(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name     string
+		input    models.ChatInviteCreationInfo
+		expected error
+	}{
+		{
+			name:  "unlimited",
+			input: models.ChatInviteCreationInfo{},
+		},
+		{
+			name:  "expiry and usage limit",
+			input: models.ChatInviteCreationInfo{ExpiresAt: &future, UsageLimit: 10},
+		},
+		{
+			name:     "expired",
+			input:    models.ChatInviteCreationInfo{ExpiresAt: &past},
+			expected: errors.New("invite expiry is in the past"),
+		},
+		{
+			name:     "negative usage limit",
+			input:    models.ChatInviteCreationInfo{UsageLimit: -1},
+			expected: errors.New("negative invite usage limit"),
+		},
+	}
+
+	validator := NewChatValidator()
+	for _, tt := range tests {
+		err := validator.ValidateChatInviteCreationInfo(tt.input)
+		if tt.expected != nil {
+			require.EqualError(t, err, tt.expected.Error(), tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
+
+func TestChatValidator_ValidateMessageTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Duration
+		expected error
+	}{
+		{name: "turned off", input: 0},
+		{name: "one day", input: 24 * time.Hour},
+		{name: "too short", input: time.Second, expected: errors.New("message ttl too short")},
+		{name: "negative", input: -time.Hour, expected: errors.New("message ttl too short")},
+		{name: "too long", input: 2 * 365 * 24 * time.Hour, expected: errors.New("message ttl too long")},
+	}
+
+	validator := NewChatValidator()
+	for _, tt := range tests {
+		err := validator.ValidateMessageTTL(tt.input)
+		if tt.expected != nil {
+			require.EqualError(t, err, tt.expected.Error(), tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
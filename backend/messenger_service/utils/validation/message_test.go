@@ -2,7 +2,9 @@ package validation_test
 
 import (
 	"quickflow/messenger_service/utils/validation"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"quickflow/shared/models"
@@ -50,6 +52,62 @@ func TestMessageValidator_ValidateMessage(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "encrypted message",
+			message: models.Message{
+				ChatID:   uuid.New(),
+				SenderID: uuid.New(),
+				Encrypted: &models.EncryptedContent{
+					Ciphertext: []byte("ciphertext"),
+					Envelopes:  []models.KeyEnvelope{{RecipientID: uuid.New(), DeviceID: "phone", Key: []byte("key")}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "encrypted message with plain text",
+			message: models.Message{
+				Text:     "leaked",
+				ChatID:   uuid.New(),
+				SenderID: uuid.New(),
+				Encrypted: &models.EncryptedContent{
+					Ciphertext: []byte("ciphertext"),
+					Envelopes:  []models.KeyEnvelope{{RecipientID: uuid.New(), DeviceID: "phone", Key: []byte("key")}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "poll without text",
+			message: models.Message{
+				ChatID:   uuid.New(),
+				SenderID: uuid.New(),
+				Poll:     &models.Poll{Question: "Lunch?"},
+			},
+			expectError: false,
+		},
+		{
+			name: "encrypted message with poll",
+			message: models.Message{
+				ChatID:   uuid.New(),
+				SenderID: uuid.New(),
+				Poll:     &models.Poll{Question: "Lunch?"},
+				Encrypted: &models.EncryptedContent{
+					Ciphertext: []byte("ciphertext"),
+					Envelopes:  []models.KeyEnvelope{{RecipientID: uuid.New(), DeviceID: "phone", Key: []byte("key")}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "encrypted message without envelopes",
+			message: models.Message{
+				ChatID:    uuid.New(),
+				SenderID:  uuid.New(),
+				Encrypted: &models.EncryptedContent{Ciphertext: []byte("ciphertext")},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -61,3 +119,115 @@ func TestMessageValidator_ValidateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageValidator_ValidateReaction(t *testing.T) {
+	validator := validation.NewMessageValidator()
+
+	tests := []struct {
+		name        string
+		emoji       string
+		expectError bool
+	}{
+		{name: "single emoji", emoji: "👍", expectError: false},
+		{name: "emoji with skin tone", emoji: "👍🏽", expectError: false},
+		{name: "zwj sequence", emoji: "👩‍💻", expectError: false},
+		{name: "empty", emoji: "", expectError: true},
+		{name: "text", emoji: "like", expectError: true},
+		{name: "too long", emoji: "😀😀😀😀😀😀😀😀😀😀😀", expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.ValidateReaction(test.emoji)
+			if (err != nil) != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, err)
+			}
+		})
+	}
+}
+
+func TestMessageValidator_ValidateSearchQuery(t *testing.T) {
+	validator := validation.NewMessageValidator()
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		query       models.MessageSearchQuery
+		expectError bool
+	}{
+		{name: "valid", query: models.MessageSearchQuery{Text: "отчёт", Limit: 20}, expectError: false},
+		{name: "valid range", query: models.MessageSearchQuery{Text: "report", From: &hourAgo, To: &now, Limit: 20}, expectError: false},
+		{name: "blank text", query: models.MessageSearchQuery{Text: "   ", Limit: 20}, expectError: true},
+		{name: "too long text", query: models.MessageSearchQuery{Text: strings.Repeat("a", 257), Limit: 20}, expectError: true},
+		{name: "zero limit", query: models.MessageSearchQuery{Text: "report"}, expectError: true},
+		{name: "too big limit", query: models.MessageSearchQuery{Text: "report", Limit: 101}, expectError: true},
+		{name: "reversed range", query: models.MessageSearchQuery{Text: "report", From: &now, To: &hourAgo, Limit: 20}, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.ValidateSearchQuery(test.query)
+			if (err != nil) != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, err)
+			}
+		})
+	}
+}
+
+func TestMessageValidator_ValidateSendAt(t *testing.T) {
+	validator := validation.NewMessageValidator()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		sendAt      time.Time
+		expectError bool
+	}{
+		{name: "tomorrow", sendAt: now.Add(24 * time.Hour), expectError: false},
+		{name: "now", sendAt: now, expectError: true},
+		{name: "past", sendAt: now.Add(-time.Minute), expectError: true},
+		{name: "too far", sendAt: now.Add(366 * 24 * time.Hour), expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.ValidateSendAt(test.sendAt, now)
+			if (err != nil) != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, err)
+			}
+		})
+	}
+}
+
+func TestMessageValidator_ValidatePoll(t *testing.T) {
+	validator := validation.NewMessageValidator()
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	options := []models.PollOption{{Text: "Yes"}, {Text: "No"}}
+
+	tests := []struct {
+		name        string
+		poll        models.Poll
+		expectError bool
+	}{
+		{name: "valid", poll: models.Poll{Question: "Lunch?", Options: options, ResultsVisibility: models.PollResultsAlways}},
+		{name: "closes in future", poll: models.Poll{Question: "Lunch?", Options: options, ResultsVisibility: models.PollResultsAfterClose, ClosesAt: &future}},
+		{name: "closes in past", poll: models.Poll{Question: "Lunch?", Options: options, ResultsVisibility: models.PollResultsAlways, ClosesAt: &past}, expectError: true},
+		{name: "blank question", poll: models.Poll{Question: "  ", Options: options, ResultsVisibility: models.PollResultsAlways}, expectError: true},
+		{name: "one option", poll: models.Poll{Question: "Lunch?", Options: options[:1], ResultsVisibility: models.PollResultsAlways}, expectError: true},
+		{name: "too many options", poll: models.Poll{Question: "Lunch?", Options: make([]models.PollOption, 11), ResultsVisibility: models.PollResultsAlways}, expectError: true},
+		{name: "empty option", poll: models.Poll{Question: "Lunch?", Options: []models.PollOption{{Text: "Yes"}, {}}, ResultsVisibility: models.PollResultsAlways}, expectError: true},
+		{name: "long option", poll: models.Poll{Question: "Lunch?", Options: []models.PollOption{{Text: "Yes"}, {Text: strings.Repeat("a", 101)}}, ResultsVisibility: models.PollResultsAlways}, expectError: true},
+		{name: "unknown visibility", poll: models.Poll{Question: "Lunch?", Options: options, ResultsVisibility: "never"}, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.ValidatePoll(test.poll, now)
+			if (err != nil) != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, err)
+			}
+		})
+	}
+}
@@ -2,12 +2,41 @@ package validation
 
 import (
 	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
 	"quickflow/shared/models"
 )
 
+// maxReactionRunes allows emoji with skin tone modifiers and zero width joiner sequences
+const maxReactionRunes = 10
+
+const (
+	maxSearchQueryRunes = 256
+	maxSearchResults    = 100
+)
+
+// maxScheduleAhead limits how far in the future a message can be scheduled
+const maxScheduleAhead = 365 * 24 * time.Hour
+
+const (
+	minPollOptions       = 2
+	maxPollOptions       = 10
+	maxPollQuestionRunes = 300
+	maxPollOptionRunes   = 100
+)
+
+const (
+	maxCiphertextBytes = 64 * 1024
+	maxEnvelopeBytes   = 1024
+	// maxKeyEnvelopes covers every device of both participants of a private chat
+	maxKeyEnvelopes = 20
+)
+
 type MessageValidator struct{}
 
 func NewMessageValidator() *MessageValidator {
@@ -15,7 +44,11 @@ func NewMessageValidator() *MessageValidator {
 }
 
 func (m *MessageValidator) ValidateMessage(message models.Message) error {
-	if len(message.Text) == 0 && len(message.Attachments) == 0 {
+	if message.Encrypted != nil {
+		if err := validateEncryptedContent(message); err != nil {
+			return err
+		}
+	} else if len(message.Text) == 0 && len(message.Attachments) == 0 && message.Poll == nil {
 		return errors.New("message cannot be empty")
 	}
 	// TODO make clean, move to config
@@ -27,3 +60,86 @@ func (m *MessageValidator) ValidateMessage(message models.Message) error {
 	}
 	return nil
 }
+
+// validateEncryptedContent checks only sizes, the server can not read the content
+func validateEncryptedContent(message models.Message) error {
+	if len(message.Text) != 0 || len(message.Attachments) != 0 || message.Poll != nil {
+		return errors.New("encrypted message cannot have plain text, attachments or a poll")
+	}
+	if len(message.Encrypted.Ciphertext) == 0 || len(message.Encrypted.Ciphertext) > maxCiphertextBytes {
+		return errors.New("invalid ciphertext length")
+	}
+	if len(message.Encrypted.Envelopes) == 0 || len(message.Encrypted.Envelopes) > maxKeyEnvelopes {
+		return errors.New("invalid number of key envelopes")
+	}
+	for _, envelope := range message.Encrypted.Envelopes {
+		if envelope.RecipientID == uuid.Nil || len(envelope.DeviceID) == 0 {
+			return errors.New("key envelope must have recipient and device")
+		}
+		if len(envelope.Key) == 0 || len(envelope.Key) > maxEnvelopeBytes {
+			return errors.New("invalid key envelope length")
+		}
+	}
+	return nil
+}
+
+func (m *MessageValidator) ValidateReaction(emoji string) error {
+	if len(emoji) == 0 || !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxReactionRunes {
+		return errors.New("invalid reaction length")
+	}
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return errors.New("reaction must be an emoji")
+		}
+	}
+	return nil
+}
+
+func (m *MessageValidator) ValidateSearchQuery(query models.MessageSearchQuery) error {
+	text := strings.TrimSpace(query.Text)
+	if len(text) == 0 || utf8.RuneCountInString(text) > maxSearchQueryRunes {
+		return errors.New("invalid search query length")
+	}
+	if query.Limit <= 0 || query.Limit > maxSearchResults {
+		return errors.New("invalid number of search results")
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return errors.New("search date range is empty")
+	}
+	return nil
+}
+
+func (m *MessageValidator) ValidateSendAt(sendAt, now time.Time) error {
+	if !sendAt.After(now) {
+		return errors.New("send time must be in the future")
+	}
+	if sendAt.Sub(now) > maxScheduleAhead {
+		return errors.New("send time is too far in the future")
+	}
+	return nil
+}
+
+func (m *MessageValidator) ValidatePoll(poll models.Poll, now time.Time) error {
+	question := strings.TrimSpace(poll.Question)
+	if len(question) == 0 || utf8.RuneCountInString(question) > maxPollQuestionRunes {
+		return errors.New("invalid poll question length")
+	}
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return errors.New("invalid number of poll options")
+	}
+	for _, option := range poll.Options {
+		text := strings.TrimSpace(option.Text)
+		if len(text) == 0 || utf8.RuneCountInString(text) > maxPollOptionRunes {
+			return errors.New("invalid poll option length")
+		}
+	}
+	switch poll.ResultsVisibility {
+	case models.PollResultsAlways, models.PollResultsAfterVote, models.PollResultsAfterClose:
+	default:
+		return errors.New("invalid poll results visibility")
+	}
+	if poll.ClosesAt != nil && !poll.ClosesAt.After(now) {
+		return errors.New("poll close time must be in the future")
+	}
+	return nil
+}
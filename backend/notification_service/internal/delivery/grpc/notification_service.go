@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	notification_errors "quickflow/notification_service/internal/errors"
+	dto "quickflow/shared/client/notification_service"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+)
+
+type NotificationUseCase interface {
+	SaveNotification(ctx context.Context, notification models.Notification) (*models.Notification, bool, error)
+	GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error)
+	MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error
+	MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error
+	GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error)
+}
+
+type NotificationServiceServer struct {
+	pb.UnimplementedNotificationServiceServer
+	notificationUseCase NotificationUseCase
+}
+
+func NewNotificationServiceServer(notificationUseCase NotificationUseCase) *NotificationServiceServer {
+	return &NotificationServiceServer{
+		notificationUseCase: notificationUseCase,
+	}
+}
+
+func (s *NotificationServiceServer) SaveNotification(ctx context.Context, req *pb.SaveNotificationRequest) (*pb.SaveNotificationResponse, error) {
+	logger.Info(ctx, "Received SaveNotification request")
+
+	notification, err := dto.ProtoNotificationToModel(req.Notification)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert proto to model: %v", err)
+		return nil, fmt.Errorf("%w: %v", notification_errors.ErrInvalidNotification, err)
+	}
+
+	saved, grouped, err := s.notificationUseCase.SaveNotification(ctx, *notification)
+	if err != nil {
+		logger.Error(ctx, "Failed to save notification: %v", err)
+		return nil, err
+	}
+
+	pbNotification, err := dto.ModelNotificationToProto(saved)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert notification to proto: %v", err)
+		return nil, err
+	}
+	return &pb.SaveNotificationResponse{Notification: pbNotification, Grouped: grouped}, nil
+}
+
+func (s *NotificationServiceServer) GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
+	logger.Info(ctx, "Received GetNotifications request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid user id: %v", err)
+		return nil, fmt.Errorf("%w: user id", notification_errors.ErrInvalidId)
+	}
+
+	var lastId uuid.UUID
+	if len(req.LastId) != 0 {
+		lastId, err = uuid.Parse(req.LastId)
+		if err != nil {
+			logger.Error(ctx, "Invalid last notification id: %v", err)
+			return nil, fmt.Errorf("%w: last id", notification_errors.ErrInvalidId)
+		}
+	}
+
+	notifications, err := s.notificationUseCase.GetNotifications(ctx, userId, int(req.Count), req.Ts.AsTime(), lastId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get notifications: %v", err)
+		return nil, err
+	}
+
+	pbNotifications := make([]*pb.Notification, len(notifications))
+	for i := range notifications {
+		pbNotifications[i], err = dto.ModelNotificationToProto(&notifications[i])
+		if err != nil {
+			logger.Error(ctx, "Failed to convert notification to proto: %v", err)
+			return nil, err
+		}
+	}
+	return &pb.GetNotificationsResponse{Notifications: pbNotifications}, nil
+}
+
+func (s *NotificationServiceServer) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*emptypb.Empty, error) {
+	logger.Info(ctx, "Received MarkNotificationsRead request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid user id: %v", err)
+		return nil, fmt.Errorf("%w: user id", notification_errors.ErrInvalidId)
+	}
+
+	notificationIds := make([]uuid.UUID, len(req.NotificationIds))
+	for i, id := range req.NotificationIds {
+		if notificationIds[i], err = uuid.Parse(id); err != nil {
+			logger.Error(ctx, "Invalid notification id: %v", err)
+			return nil, fmt.Errorf("%w: notification id", notification_errors.ErrInvalidId)
+		}
+	}
+
+	if err = s.notificationUseCase.MarkNotificationsRead(ctx, userId, notificationIds); err != nil {
+		logger.Error(ctx, "Failed to mark notifications read: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *NotificationServiceServer) MarkAllNotificationsRead(ctx context.Context, req *pb.MarkAllNotificationsReadRequest) (*emptypb.Empty, error) {
+	logger.Info(ctx, "Received MarkAllNotificationsRead request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid user id: %v", err)
+		return nil, fmt.Errorf("%w: user id", notification_errors.ErrInvalidId)
+	}
+
+	if err = s.notificationUseCase.MarkAllNotificationsRead(ctx, userId); err != nil {
+		logger.Error(ctx, "Failed to mark all notifications read: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *NotificationServiceServer) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	logger.Info(ctx, "Received GetUnreadCount request")
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid user id: %v", err)
+		return nil, fmt.Errorf("%w: user id", notification_errors.ErrInvalidId)
+	}
+
+	count, err := s.notificationUseCase.GetUnreadCount(ctx, userId)
+	if err != nil {
+		logger.Error(ctx, "Failed to get unread count: %v", err)
+		return nil, err
+	}
+	return &pb.GetUnreadCountResponse{Count: count}, nil
+}
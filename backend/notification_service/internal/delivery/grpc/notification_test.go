@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"quickflow/notification_service/internal/delivery/grpc/mocks"
+	notification_errors "quickflow/notification_service/internal/errors"
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+)
+
+func TestSaveNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	userId, actorId, targetId := uuid.New(), uuid.New(), uuid.New()
+	request := &models.Notification{
+		UserId:    userId,
+		ActorId:   actorId,
+		Type:      models.NotificationFriendAccepted,
+		TargetId:  targetId,
+		CreatedAt: time.Unix(0, 0).UTC(),
+		UpdatedAt: time.Unix(0, 0).UTC(),
+	}
+
+	tests := []struct {
+		name        string
+		req         *pb.SaveNotificationRequest
+		mockSetup   func(*mocks.MockNotificationUseCase)
+		wantGrouped bool
+		wantErr     error
+	}{
+		{
+			name: "saved",
+			req: &pb.SaveNotificationRequest{Notification: &pb.Notification{
+				UserId:    userId.String(),
+				ActorId:   actorId.String(),
+				Type:      pb.NotificationType_NOTIFICATION_FRIEND_ACCEPTED,
+				TargetId:  targetId.String(),
+				CreatedAt: timestamppb.New(time.Unix(0, 0)),
+			}},
+			mockSetup: func(m *mocks.MockNotificationUseCase) {
+				saved := *request
+				saved.Id = uuid.New()
+				saved.CreatedAt = now
+				m.EXPECT().SaveNotification(gomock.Any(), *request).Return(&saved, false, nil)
+			},
+		},
+		{
+			name: "grouped",
+			req: &pb.SaveNotificationRequest{Notification: &pb.Notification{
+				UserId:    userId.String(),
+				ActorId:   actorId.String(),
+				Type:      pb.NotificationType_NOTIFICATION_FRIEND_ACCEPTED,
+				TargetId:  targetId.String(),
+				CreatedAt: timestamppb.New(time.Unix(0, 0)),
+			}},
+			mockSetup: func(m *mocks.MockNotificationUseCase) {
+				saved := *request
+				saved.Id = uuid.New()
+				saved.ActorIds = []uuid.UUID{actorId, uuid.New()}
+				saved.ActorCount = 2
+				saved.CreatedAt = now
+				m.EXPECT().SaveNotification(gomock.Any(), *request).Return(&saved, true, nil)
+			},
+			wantGrouped: true,
+		},
+		{
+			name: "invalid actor",
+			req: &pb.SaveNotificationRequest{Notification: &pb.Notification{
+				UserId:   userId.String(),
+				ActorId:  "invalid",
+				Type:     pb.NotificationType_NOTIFICATION_FRIEND_ACCEPTED,
+				TargetId: targetId.String(),
+			}},
+			mockSetup: func(m *mocks.MockNotificationUseCase) {},
+			wantErr:   notification_errors.ErrInvalidNotification,
+		},
+		{
+			name: "use case error",
+			req: &pb.SaveNotificationRequest{Notification: &pb.Notification{
+				UserId:    userId.String(),
+				ActorId:   actorId.String(),
+				Type:      pb.NotificationType_NOTIFICATION_FRIEND_ACCEPTED,
+				TargetId:  targetId.String(),
+				CreatedAt: timestamppb.New(time.Unix(0, 0)),
+			}},
+			mockSetup: func(m *mocks.MockNotificationUseCase) {
+				m.EXPECT().SaveNotification(gomock.Any(), *request).Return(nil, false, notification_errors.ErrSelfNotification)
+			},
+			wantErr: notification_errors.ErrSelfNotification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockNotificationUseCase(ctrl)
+			tt.mockSetup(mockUseCase)
+			server := NewNotificationServiceServer(mockUseCase)
+
+			resp, err := server.SaveNotification(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.Notification.Id)
+			assert.Equal(t, now, resp.Notification.CreatedAt.AsTime())
+			assert.Equal(t, tt.wantGrouped, resp.Grouped)
+		})
+	}
+}
+
+func TestGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServiceServer(mockUseCase)
+
+	userId, lastId := uuid.New(), uuid.New()
+	ts := time.Now().UTC()
+	notification := models.Notification{
+		Id:        uuid.New(),
+		UserId:    userId,
+		ActorId:   uuid.New(),
+		Type:      models.NotificationMention,
+		TargetId:  uuid.New(),
+		CreatedAt: ts.Add(-time.Minute),
+	}
+
+	mockUseCase.EXPECT().GetNotifications(gomock.Any(), userId, 10, ts, lastId).Return([]models.Notification{notification}, nil)
+	resp, err := server.GetNotifications(context.Background(), &pb.GetNotificationsRequest{
+		UserId: userId.String(),
+		Ts:     timestamppb.New(ts),
+		Count:  10,
+		LastId: lastId.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Notifications, 1)
+	assert.Equal(t, notification.Id.String(), resp.Notifications[0].Id)
+	assert.Equal(t, pb.NotificationType_NOTIFICATION_MENTION, resp.Notifications[0].Type)
+
+	// первая страница без id последнего уведомления
+	mockUseCase.EXPECT().GetNotifications(gomock.Any(), userId, 10, ts, uuid.Nil).Return(nil, nil)
+	_, err = server.GetNotifications(context.Background(), &pb.GetNotificationsRequest{
+		UserId: userId.String(),
+		Ts:     timestamppb.New(ts),
+		Count:  10,
+	})
+	require.NoError(t, err)
+
+	_, err = server.GetNotifications(context.Background(), &pb.GetNotificationsRequest{UserId: "invalid"})
+	assert.ErrorIs(t, err, notification_errors.ErrInvalidId)
+
+	_, err = server.GetNotifications(context.Background(), &pb.GetNotificationsRequest{UserId: userId.String(), LastId: "invalid"})
+	assert.ErrorIs(t, err, notification_errors.ErrInvalidId)
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServiceServer(mockUseCase)
+
+	userId, notificationId := uuid.New(), uuid.New()
+
+	mockUseCase.EXPECT().MarkNotificationsRead(gomock.Any(), userId, []uuid.UUID{notificationId}).Return(nil)
+	_, err := server.MarkNotificationsRead(context.Background(), &pb.MarkNotificationsReadRequest{
+		UserId:          userId.String(),
+		NotificationIds: []string{notificationId.String()},
+	})
+	assert.NoError(t, err)
+
+	_, err = server.MarkNotificationsRead(context.Background(), &pb.MarkNotificationsReadRequest{
+		UserId:          userId.String(),
+		NotificationIds: []string{"invalid"},
+	})
+	assert.ErrorIs(t, err, notification_errors.ErrInvalidId)
+
+	mockUseCase.EXPECT().MarkAllNotificationsRead(gomock.Any(), userId).Return(errors.New("db error"))
+	_, err = server.MarkAllNotificationsRead(context.Background(), &pb.MarkAllNotificationsReadRequest{UserId: userId.String()})
+	assert.Error(t, err)
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServiceServer(mockUseCase)
+
+	userId := uuid.New()
+	mockUseCase.EXPECT().GetUnreadCount(gomock.Any(), userId).Return(int64(2), nil)
+
+	resp, err := server.GetUnreadCount(context.Background(), &pb.GetUnreadCountRequest{UserId: userId.String()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Count)
+}
@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_errors "quickflow/notification_service/internal/errors"
+)
+
+// helper: создает gRPC error с деталями
+func statusWithDetails(code codes.Code, msg, reason string) error {
+	st := status.New(code, msg)
+
+	detail := &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "notification_service",
+	}
+
+	stWithDetails, err := st.WithDetails(detail)
+	if err != nil {
+		// если что-то пошло не так, fallback
+		return st.Err()
+	}
+
+	return stWithDetails.Err()
+}
+
+func ErrorInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic: %v", r)
+		}
+	}()
+
+	resp, err = handler(ctx, req)
+
+	if err == nil {
+		return resp, nil
+	}
+
+	// Если ошибка уже содержит ErrorInfo — просто пробрасываем её
+	if st, ok := status.FromError(err); ok {
+		for _, detail := range st.Details() {
+			if _, ok := detail.(*errdetails.ErrorInfo); ok {
+				// Пробрасываем error как есть
+				return nil, err
+			}
+		}
+	}
+
+	switch {
+	case errors.Is(err, notification_errors.ErrNotFound):
+		return nil, statusWithDetails(codes.NotFound, err.Error(), "NOT_FOUND")
+
+	case errors.Is(err, notification_errors.ErrInvalidId):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_ID")
+
+	case errors.Is(err, notification_errors.ErrInvalidNotification):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_NOTIFICATION")
+
+	case errors.Is(err, notification_errors.ErrReceiver):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_RECEIVER")
+
+	case errors.Is(err, notification_errors.ErrActor):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_ACTOR")
+
+	case errors.Is(err, notification_errors.ErrSelfNotification):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "SELF_NOTIFICATION")
+
+	case errors.Is(err, notification_errors.ErrTarget):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_TARGET")
+
+	case errors.Is(err, notification_errors.ErrNotificationType):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_NOTIFICATION_TYPE")
+
+	case errors.Is(err, notification_errors.ErrInvalidNumNotification):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_NUM_NOTIFICATIONS")
+
+	case errors.Is(err, notification_errors.ErrInvalidTimestamp):
+		return nil, statusWithDetails(codes.InvalidArgument, err.Error(), "INVALID_TIMESTAMP")
+
+	default:
+		return nil, statusWithDetails(codes.Internal, err.Error(), "INTERNAL")
+	}
+}
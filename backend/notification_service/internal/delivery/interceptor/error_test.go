@@ -0,0 +1,129 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_errors "quickflow/notification_service/internal/errors"
+)
+
+type mockHandler struct {
+	mock.Mock
+}
+
+func (m *mockHandler) Invoke(ctx context.Context, req interface{}) (interface{}, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0), args.Error(1)
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputError     error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name:           "ErrNotFound",
+			inputError:     notification_errors.ErrNotFound,
+			expectedCode:   codes.NotFound,
+			expectedReason: "NOT_FOUND",
+		},
+		{
+			name:           "ErrInvalidId",
+			inputError:     fmt.Errorf("%w: user id", notification_errors.ErrInvalidId),
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_ID",
+		},
+		{
+			name:           "ErrInvalidNotification",
+			inputError:     notification_errors.ErrInvalidNotification,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_NOTIFICATION",
+		},
+		{
+			name:           "ErrReceiver",
+			inputError:     notification_errors.ErrReceiver,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_RECEIVER",
+		},
+		{
+			name:           "ErrActor",
+			inputError:     notification_errors.ErrActor,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_ACTOR",
+		},
+		{
+			name:           "ErrSelfNotification",
+			inputError:     notification_errors.ErrSelfNotification,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "SELF_NOTIFICATION",
+		},
+		{
+			name:           "ErrTarget",
+			inputError:     notification_errors.ErrTarget,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_TARGET",
+		},
+		{
+			name:           "ErrNotificationType",
+			inputError:     notification_errors.ErrNotificationType,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_NOTIFICATION_TYPE",
+		},
+		{
+			name:           "ErrInvalidNumNotification",
+			inputError:     notification_errors.ErrInvalidNumNotification,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_NUM_NOTIFICATIONS",
+		},
+		{
+			name:           "ErrInvalidTimestamp",
+			inputError:     notification_errors.ErrInvalidTimestamp,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_TIMESTAMP",
+		},
+		{
+			name:           "Default case",
+			inputError:     errors.New("some other error"),
+			expectedCode:   codes.Internal,
+			expectedReason: "INTERNAL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHandler := new(mockHandler)
+			mockHandler.On("Invoke", mock.Anything, mock.Anything).Return(nil, tt.inputError)
+
+			_, err := ErrorInterceptor(context.Background(), nil, nil, mockHandler.Invoke)
+
+			if assert.Error(t, err) {
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, st.Code())
+				assert.Equal(t, tt.inputError.Error(), st.Message())
+				if assert.Len(t, st.Details(), 1) {
+					assert.Equal(t, tt.expectedReason, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorInterceptor_Success(t *testing.T) {
+	mockHandler := new(mockHandler)
+	mockHandler.On("Invoke", mock.Anything, mock.Anything).Return("ok", nil)
+
+	resp, err := ErrorInterceptor(context.Background(), nil, nil, mockHandler.Invoke)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
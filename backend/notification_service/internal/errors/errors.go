@@ -0,0 +1,20 @@
+package errors
+
+import (
+	"errors"
+
+	"quickflow/notification_service/utils/validation"
+)
+
+var (
+	ErrReceiver               = validation.ErrReceiver
+	ErrActor                  = validation.ErrActor
+	ErrSelfNotification       = validation.ErrSelfNotification
+	ErrTarget                 = validation.ErrTarget
+	ErrNotificationType       = validation.ErrNotificationType
+	ErrInvalidNumNotification = validation.ErrInvalidNumNotification
+	ErrInvalidTimestamp       = validation.ErrInvalidTimestamp
+	ErrNotFound               = errors.New("not found")
+	ErrInvalidId              = errors.New("invalid id")
+	ErrInvalidNotification    = errors.New("invalid notification")
+)
@@ -0,0 +1,64 @@
+package postgres_models
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"quickflow/shared/models"
+)
+
+type PgNotification struct {
+	Id         pgtype.UUID
+	UserId     pgtype.UUID
+	ActorId    pgtype.UUID
+	ActorIds   []pgtype.UUID
+	ActorCount pgtype.Int4
+	Type       pgtype.Text
+	TargetId   pgtype.UUID
+	Content    pgtype.Text
+	IsRead     pgtype.Bool
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+func FromModel(notification *models.Notification) *PgNotification {
+	actorIds := make([]pgtype.UUID, len(notification.ActorIds))
+	for i, actorId := range notification.ActorIds {
+		actorIds[i] = pgtype.UUID{Bytes: actorId, Valid: true}
+	}
+
+	return &PgNotification{
+		Id:         pgtype.UUID{Bytes: notification.Id, Valid: true},
+		UserId:     pgtype.UUID{Bytes: notification.UserId, Valid: true},
+		ActorId:    pgtype.UUID{Bytes: notification.ActorId, Valid: true},
+		ActorIds:   actorIds,
+		ActorCount: pgtype.Int4{Int32: int32(notification.ActorCount), Valid: true},
+		Type:       pgtype.Text{String: string(notification.Type), Valid: true},
+		TargetId:   pgtype.UUID{Bytes: notification.TargetId, Valid: true},
+		Content:    pgtype.Text{String: notification.Content, Valid: true},
+		IsRead:     pgtype.Bool{Bool: notification.IsRead, Valid: true},
+		CreatedAt:  pgtype.Timestamptz{Time: notification.CreatedAt, Valid: true},
+		UpdatedAt:  pgtype.Timestamptz{Time: notification.UpdatedAt, Valid: true},
+	}
+}
+
+func (pn *PgNotification) ToModel() models.Notification {
+	var actorIds []uuid.UUID
+	for _, actorId := range pn.ActorIds {
+		actorIds = append(actorIds, actorId.Bytes)
+	}
+
+	return models.Notification{
+		Id:         pn.Id.Bytes,
+		UserId:     pn.UserId.Bytes,
+		ActorId:    pn.ActorId.Bytes,
+		ActorIds:   actorIds,
+		ActorCount: int(pn.ActorCount.Int32),
+		Type:       models.NotificationType(pn.Type.String),
+		TargetId:   pn.TargetId.Bytes,
+		Content:    pn.Content.String,
+		IsRead:     pn.IsRead.Bool,
+		CreatedAt:  pn.CreatedAt.Time,
+		UpdatedAt:  pn.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,32 @@
+package postgres_models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quickflow/shared/models"
+)
+
+func TestNotification_RoundTrip(t *testing.T) {
+	actorId := uuid.New()
+	notification := models.Notification{
+		Id:         uuid.New(),
+		UserId:     uuid.New(),
+		ActorId:    actorId,
+		ActorIds:   []uuid.UUID{actorId, uuid.New()},
+		ActorCount: 5,
+		Type:       models.NotificationCommunityRoleChanged,
+		TargetId:   uuid.New(),
+		Content:    "admin",
+		IsRead:     true,
+		CreatedAt:  time.Now().Add(-time.Hour),
+		UpdatedAt:  time.Now(),
+	}
+
+	pgNotification := FromModel(&notification)
+	assert.True(t, pgNotification.Content.Valid)
+	assert.Equal(t, notification, pgNotification.ToModel())
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	postgres_models "quickflow/notification_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	saveNotificationQuery = `
+	with saved as (
+	    insert into notification (id, user_id, actor_id, actor_ids, actor_count, type, target_id, content, is_read, created_at, updated_at)
+	    values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	    returning id, actor_id
+	)
+	insert into notification_actor (notification_id, actor_id)
+	select id, actor_id from saved
+`
+
+	// the actor goes first in the sample, the count grows only if the actor is new to notification_actor,
+	// which keeps all of them while actor_ids is capped
+	groupNotificationQuery = `
+	with grouped as (
+	    select id
+	    from notification
+	    where user_id = $1 and type = $2 and target_id = $3 and not is_read and created_at >= $7
+	    order by created_at desc
+	    limit 1
+	    for update
+	), added as (
+	    insert into notification_actor (notification_id, actor_id)
+	    select id, $4::uuid from grouped
+	    on conflict do nothing
+	    returning actor_id
+	)
+	update notification
+	set actor_id = $4,
+	    actor_ids = ($4::uuid || array_remove(actor_ids, $4::uuid))[1:$5],
+	    actor_count = actor_count + (select count(*) from added),
+	    updated_at = $6
+	where id = (select id from grouped)
+	returning id, user_id, actor_id, actor_ids, actor_count, type, target_id, content, is_read, created_at, updated_at
+`
+
+	// notifications updated at the same time are ordered by id, so none of them is skipped between pages
+	getNotificationsOlderQuery = `
+	select id, user_id, actor_id, actor_ids, actor_count, type, target_id, content, is_read, created_at, updated_at
+	from notification
+	where user_id = $1 and (updated_at, id) < ($2, $4)
+	order by updated_at desc, id desc
+	limit $3
+`
+
+	markNotificationsReadQuery = `
+	update notification
+	set is_read = true
+	where user_id = $1 and id = any($2) and not is_read
+`
+
+	markAllNotificationsReadQuery = `
+	update notification
+	set is_read = true
+	where user_id = $1 and not is_read
+`
+
+	getUnreadCountQuery = `
+	select count(*)
+	from notification
+	where user_id = $1 and not is_read
+`
+)
+
+type NotificationRepository struct {
+	ConnPool *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{ConnPool: db}
+}
+
+// Close закрывает пул соединений
+func (n *NotificationRepository) Close() {
+	n.ConnPool.Close()
+}
+
+// scanner is a row of either QueryContext or QueryRowContext
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanNotification reads a notification selected in the order of getNotificationsOlderQuery
+func scanNotification(row scanner, typeMap *pgtype.Map) (models.Notification, error) {
+	var pn postgres_models.PgNotification
+	err := row.Scan(&pn.Id, &pn.UserId, &pn.ActorId, typeMap.SQLScanner(&pn.ActorIds), &pn.ActorCount,
+		&pn.Type, &pn.TargetId, &pn.Content, &pn.IsRead, &pn.CreatedAt, &pn.UpdatedAt)
+	if err != nil {
+		return models.Notification{}, err
+	}
+	return pn.ToModel(), nil
+}
+
+func (n *NotificationRepository) SaveNotification(ctx context.Context, notification *models.Notification) error {
+	pgNotification := postgres_models.FromModel(notification)
+	_, err := n.ConnPool.ExecContext(ctx, saveNotificationQuery,
+		pgNotification.Id, pgNotification.UserId, pgNotification.ActorId, pgNotification.ActorIds, pgNotification.ActorCount,
+		pgNotification.Type, pgNotification.TargetId, pgNotification.Content, pgNotification.IsRead,
+		pgNotification.CreatedAt, pgNotification.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "failed to save notification: %v", err)
+		return fmt.Errorf("save notification: %w", err)
+	}
+	return nil
+}
+
+// GroupNotification adds the actor to the latest unread notification of the same type and target created after since.
+// The notification is replaced with the updated one, false is returned if there is nothing to group with.
+func (n *NotificationRepository) GroupNotification(ctx context.Context, notification *models.Notification, since time.Time) (bool, error) {
+	row := n.ConnPool.QueryRowContext(ctx, groupNotificationQuery,
+		pgtype.UUID{Bytes: notification.UserId, Valid: true}, string(notification.Type),
+		pgtype.UUID{Bytes: notification.TargetId, Valid: true}, pgtype.UUID{Bytes: notification.ActorId, Valid: true},
+		models.MaxNotificationActors, pgtype.Timestamptz{Time: notification.UpdatedAt, Valid: true},
+		pgtype.Timestamptz{Time: since, Valid: true})
+
+	grouped, err := scanNotification(row, pgtype.NewMap())
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		logger.Error(ctx, "failed to group notification: %v", err)
+		return false, fmt.Errorf("group notification: %w", err)
+	}
+
+	*notification = grouped
+	return true, nil
+}
+
+// GetNotifications returns notifications older than (ts, lastId), uuid.Nil as lastId leaves out all updated at ts
+func (n *NotificationRepository) GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error) {
+	rows, err := n.ConnPool.QueryContext(ctx, getNotificationsOlderQuery,
+		pgtype.UUID{Bytes: userId, Valid: true}, pgtype.Timestamptz{Time: ts, Valid: true}, count,
+		pgtype.UUID{Bytes: lastId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "failed to get notifications: %v", err)
+		return nil, fmt.Errorf("get notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []models.Notification
+	typeMap := pgtype.NewMap()
+	for rows.Next() {
+		notification, err := scanNotification(rows, typeMap)
+		if err != nil {
+			logger.Error(ctx, "failed to scan notification: %v", err)
+			return nil, fmt.Errorf("get notifications: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationsRead marks only notifications of the user, ids of others are ignored
+func (n *NotificationRepository) MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error {
+	_, err := n.ConnPool.ExecContext(ctx, markNotificationsReadQuery, pgtype.UUID{Bytes: userId, Valid: true}, notificationIds)
+	if err != nil {
+		logger.Error(ctx, "failed to mark notifications read: %v", err)
+		return fmt.Errorf("mark notifications read: %w", err)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error {
+	_, err := n.ConnPool.ExecContext(ctx, markAllNotificationsReadQuery, pgtype.UUID{Bytes: userId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "failed to mark all notifications read: %v", err)
+		return fmt.Errorf("mark all notifications read: %w", err)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error) {
+	var count int64
+	err := n.ConnPool.QueryRowContext(ctx, getUnreadCountQuery, pgtype.UUID{Bytes: userId, Valid: true}).Scan(&count)
+	if err != nil {
+		logger.Error(ctx, "failed to get unread notifications count: %v", err)
+		return 0, fmt.Errorf("get unread count: %w", err)
+	}
+	return count, nil
+}
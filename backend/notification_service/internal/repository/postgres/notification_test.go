@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/shared/models"
+	"quickflow/shared/testutil"
+)
+
+var notificationColumns = []string{"id", "user_id", "actor_id", "actor_ids", "actor_count", "type", "target_id",
+	"content", "is_read", "created_at", "updated_at"}
+
+func TestSaveNotification(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	notification := &models.Notification{
+		Id:        uuid.New(),
+		UserId:    uuid.New(),
+		ActorId:   uuid.New(),
+		Type:      models.NotificationPostCommented,
+		TargetId:  uuid.New(),
+		Content:   "nice",
+		CreatedAt: time.Now(),
+	}
+
+	// the actor is saved with the notification, so the first repeat of it is not counted
+	mock.ExpectExec(`insert into notification \(id.*insert into notification_actor`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repo.SaveNotification(context.Background(), notification))
+
+	mock.ExpectExec("insert into notification").WillReturnError(errors.New("database error"))
+	assert.Error(t, repo.SaveNotification(context.Background(), notification))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupNotification(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	userId, actorId, otherId, targetId, id := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	createdAt, now := time.Now().Add(-time.Hour), time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	notification := &models.Notification{
+		UserId:     userId,
+		ActorId:    actorId,
+		ActorIds:   []uuid.UUID{actorId},
+		ActorCount: 1,
+		Type:       models.NotificationPostLiked,
+		TargetId:   targetId,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	// actors are counted by notification_actor, actor_ids is only a sample of them
+	mock.ExpectQuery(`insert into notification_actor.*on conflict do nothing.*update notification`).
+		WithArgs(sqlmock.AnyArg(), "post_liked", sqlmock.AnyArg(), sqlmock.AnyArg(), models.MaxNotificationActors,
+			sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(notificationColumns).
+			AddRow(id.String(), userId.String(), actorId.String(), "{"+actorId.String()+","+otherId.String()+"}", int32(13),
+				"post_liked", targetId.String(), "", false, createdAt, now))
+
+	grouped, err := repo.GroupNotification(context.Background(), notification, since)
+	require.NoError(t, err)
+	assert.True(t, grouped)
+	assert.Equal(t, &models.Notification{
+		Id:         id,
+		UserId:     userId,
+		ActorId:    actorId,
+		ActorIds:   []uuid.UUID{actorId, otherId},
+		ActorCount: 13,
+		Type:       models.NotificationPostLiked,
+		TargetId:   targetId,
+		CreatedAt:  createdAt,
+		UpdatedAt:  now,
+	}, notification)
+
+	// нет непрочитанного уведомления для группировки
+	mock.ExpectQuery("update notification").WillReturnRows(sqlmock.NewRows(notificationColumns))
+	grouped, err = repo.GroupNotification(context.Background(), notification, since)
+	require.NoError(t, err)
+	assert.False(t, grouped)
+
+	mock.ExpectQuery("update notification").WillReturnError(errors.New("database error"))
+	_, err = repo.GroupNotification(context.Background(), notification, since)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNotifications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	userId, actorId, targetId, id, lastId := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	createdAt, updatedAt := time.Now().Add(-time.Hour), time.Now().Add(-time.Minute)
+
+	mock.ExpectQuery(`from notification\s+where user_id = \$1 and \(updated_at, id\) < \(\$2, \$4\)\s+order by updated_at desc, id desc`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 10, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(notificationColumns).
+			AddRow(id.String(), userId.String(), actorId.String(), "{"+actorId.String()+"}", int32(1),
+				"friend_request", targetId.String(), "", false, createdAt, updatedAt))
+
+	notifications, err := repo.GetNotifications(context.Background(), userId, 10, time.Now(), lastId)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Notification{{
+		Id:         id,
+		UserId:     userId,
+		ActorId:    actorId,
+		ActorIds:   []uuid.UUID{actorId},
+		ActorCount: 1,
+		Type:       models.NotificationFriendRequest,
+		TargetId:   targetId,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}}, notifications)
+
+	mock.ExpectQuery("from notification").WillReturnError(errors.New("database error"))
+	_, err = repo.GetNotifications(context.Background(), userId, 10, time.Now(), lastId)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	userId, ids := uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectExec("update notification").
+		WithArgs(sqlmock.AnyArg(), ids).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	assert.NoError(t, repo.MarkNotificationsRead(context.Background(), userId, ids))
+
+	mock.ExpectExec("update notification").WillReturnError(errors.New("database error"))
+	assert.Error(t, repo.MarkAllNotificationsRead(context.Background(), userId))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	mock.ExpectQuery("select count").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(4)))
+	count, err := repo.GetUnreadCount(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
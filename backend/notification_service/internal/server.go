@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+
+	addr "quickflow/config/micro-addr"
+	postgresConfig "quickflow/config/postgres"
+	"quickflow/metrics"
+	grpc3 "quickflow/notification_service/internal/delivery/grpc"
+	"quickflow/notification_service/internal/delivery/interceptor"
+	postgres2 "quickflow/notification_service/internal/repository/postgres"
+	"quickflow/notification_service/internal/usecase"
+	"quickflow/shared/interceptors"
+	"quickflow/shared/logger"
+	proto "quickflow/shared/proto/notification_service"
+)
+
+func main() {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", addr.DefaultNotificationServicePort))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	db, err := sql.Open("pgx", postgresConfig.NewPostgresConfig().GetURL())
+	if err != nil {
+		log.Fatalf("failed to connect to postgres: %v", err)
+	}
+
+	notificationRepository := postgres2.NewNotificationRepository(db)
+	defer notificationRepository.Close()
+	notificationUseCase := usecase.NewNotificationUseCase(notificationRepository)
+
+	notificationMetrics := metrics.NewMetrics("QuickFlow")
+
+	go func() {
+		http.Handle("/metrics", promhttp.Handler())
+		metricsPort := addr.DefaultNotificationServicePort + 1000
+		logger.Info(context.Background(), "Metrics server is running on :%d/metrics", metricsPort)
+		if err = http.ListenAndServe(fmt.Sprintf(":%d", metricsPort), nil); err != nil {
+			log.Fatalf("failed to start metrics HTTP server: %v", err)
+		}
+	}()
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.RequestIDServerInterceptor(),
+			interceptor.ErrorInterceptor,
+			interceptors.MetricsInterceptor(addr.DefaultNotificationServiceName, notificationMetrics),
+		),
+		grpc.MaxRecvMsgSize(addr.MaxMessageSize),
+		grpc.MaxSendMsgSize(addr.MaxMessageSize))
+	proto.RegisterNotificationServiceServer(server, grpc3.NewNotificationServiceServer(notificationUseCase))
+	log.Printf("Server is listening on %s", listener.Addr().String())
+
+	if err = server.Serve(listener); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
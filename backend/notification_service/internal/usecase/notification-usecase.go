@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"quickflow/notification_service/utils/validation"
+	"quickflow/shared/models"
+)
+
+const (
+	// maxContentRunes is enough to preview a comment in the notification list
+	maxContentRunes = 200
+	// groupWindow limits how long an unread notification collects new actors
+	groupWindow = 24 * time.Hour
+)
+
+type NotificationRepository interface {
+	SaveNotification(ctx context.Context, notification *models.Notification) error
+	GroupNotification(ctx context.Context, notification *models.Notification, since time.Time) (bool, error)
+	GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error)
+	MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error
+	MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error
+	GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error)
+}
+
+type NotificationUseCase struct {
+	notificationRepo NotificationRepository
+}
+
+func NewNotificationUseCase(notificationRepo NotificationRepository) *NotificationUseCase {
+	return &NotificationUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// SaveNotification stores a new unread notification, long content is cut to a preview.
+// Notifications of grouped types are added to the unread one about the same target if it is recent enough,
+// grouped is set in that case and the updated notification is returned.
+func (n *NotificationUseCase) SaveNotification(ctx context.Context, notification models.Notification) (*models.Notification, bool, error) {
+	if err := validation.ValidateNotification(&notification); err != nil {
+		return nil, false, err
+	}
+
+	// updated_at is the page cursor and clients send it back with milliseconds
+	now := time.Now().Truncate(time.Millisecond)
+	notification.ActorIds = []uuid.UUID{notification.ActorId}
+	notification.ActorCount = 1
+	notification.IsRead = false
+	notification.CreatedAt = now
+	notification.UpdatedAt = now
+	if utf8.RuneCountInString(notification.Content) > maxContentRunes {
+		notification.Content = string([]rune(notification.Content)[:maxContentRunes])
+	}
+
+	if notification.Type.IsGrouped() {
+		grouped, err := n.notificationRepo.GroupNotification(ctx, &notification, now.Add(-groupWindow))
+		if err != nil {
+			return nil, false, fmt.Errorf("group notification: %w", err)
+		}
+		if grouped {
+			return &notification, true, nil
+		}
+	}
+
+	notification.Id = uuid.New()
+	if err := n.notificationRepo.SaveNotification(ctx, &notification); err != nil {
+		return nil, false, fmt.Errorf("save notification: %w", err)
+	}
+	return &notification, false, nil
+}
+
+func (n *NotificationUseCase) GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error) {
+	if err := validation.ValidateFetchParams(count, ts); err != nil {
+		return nil, err
+	}
+
+	notifications, err := n.notificationRepo.GetNotifications(ctx, userId, count, ts, lastId)
+	if err != nil {
+		return nil, fmt.Errorf("get notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+func (n *NotificationUseCase) MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error {
+	if len(notificationIds) == 0 {
+		return nil
+	}
+
+	if err := n.notificationRepo.MarkNotificationsRead(ctx, userId, notificationIds); err != nil {
+		return fmt.Errorf("mark notifications read: %w", err)
+	}
+	return nil
+}
+
+func (n *NotificationUseCase) MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error {
+	if err := n.notificationRepo.MarkAllNotificationsRead(ctx, userId); err != nil {
+		return fmt.Errorf("mark all notifications read: %w", err)
+	}
+	return nil
+}
+
+func (n *NotificationUseCase) GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error) {
+	count, err := n.notificationRepo.GetUnreadCount(ctx, userId)
+	if err != nil {
+		return 0, fmt.Errorf("get unread count: %w", err)
+	}
+	return count, nil
+}
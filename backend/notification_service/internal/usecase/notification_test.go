@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notification_errors "quickflow/notification_service/internal/errors"
+	"quickflow/notification_service/internal/usecase"
+	"quickflow/notification_service/internal/usecase/mocks"
+	"quickflow/shared/models"
+)
+
+func TestSaveNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	notification := models.Notification{
+		UserId:   uuid.New(),
+		ActorId:  uuid.New(),
+		Type:     models.NotificationPostCommented,
+		TargetId: uuid.New(),
+		Content:  strings.Repeat("я", 300),
+		IsRead:   true,
+	}
+
+	mockRepo.EXPECT().SaveNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+	saved, grouped, err := uc.SaveNotification(context.Background(), notification)
+	require.NoError(t, err)
+	assert.False(t, grouped)
+	assert.NotEqual(t, uuid.Nil, saved.Id)
+	assert.False(t, saved.IsRead)
+	assert.False(t, saved.CreatedAt.IsZero())
+	assert.Equal(t, []uuid.UUID{notification.ActorId}, saved.ActorIds)
+	assert.Equal(t, 1, saved.ActorCount)
+	assert.Equal(t, strings.Repeat("я", 200), saved.Content)
+}
+
+func TestSaveNotification_Grouped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	notification := models.Notification{
+		UserId:   uuid.New(),
+		ActorId:  uuid.New(),
+		Type:     models.NotificationPostLiked,
+		TargetId: uuid.New(),
+	}
+	groupId := uuid.New()
+
+	mockRepo.EXPECT().GroupNotification(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, n *models.Notification, since time.Time) (bool, error) {
+			assert.WithinDuration(t, n.UpdatedAt.Add(-24*time.Hour), since, time.Second)
+			n.Id = groupId
+			n.ActorIds = append(n.ActorIds, uuid.New())
+			n.ActorCount = 2
+			return true, nil
+		})
+
+	saved, grouped, err := uc.SaveNotification(context.Background(), notification)
+	require.NoError(t, err)
+	assert.True(t, grouped)
+	assert.Equal(t, groupId, saved.Id)
+	assert.Equal(t, notification.ActorId, saved.ActorIds[0])
+	assert.Equal(t, 2, saved.ActorCount)
+
+	// нечего группировать, создается новое уведомление
+	mockRepo.EXPECT().GroupNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+	mockRepo.EXPECT().SaveNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+	saved, grouped, err = uc.SaveNotification(context.Background(), notification)
+	require.NoError(t, err)
+	assert.False(t, grouped)
+	assert.NotEqual(t, groupId, saved.Id)
+
+	mockRepo.EXPECT().GroupNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("db error"))
+	_, _, err = uc.SaveNotification(context.Background(), notification)
+	assert.Error(t, err)
+}
+
+func TestSaveNotification_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	userId := uuid.New()
+	_, _, err := uc.SaveNotification(context.Background(), models.Notification{
+		UserId:   userId,
+		ActorId:  userId,
+		Type:     models.NotificationPostLiked,
+		TargetId: uuid.New(),
+	})
+	assert.ErrorIs(t, err, notification_errors.ErrSelfNotification)
+
+	mockRepo.EXPECT().SaveNotification(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+	_, _, err = uc.SaveNotification(context.Background(), models.Notification{
+		UserId:   userId,
+		ActorId:  uuid.New(),
+		Type:     models.NotificationFriendRequest,
+		TargetId: uuid.New(),
+	})
+	assert.Error(t, err)
+}
+
+func TestGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	userId, lastId, ts := uuid.New(), uuid.New(), time.Now()
+	expected := []models.Notification{{Id: uuid.New(), UserId: userId, Type: models.NotificationMention}}
+
+	mockRepo.EXPECT().GetNotifications(gomock.Any(), userId, 20, ts, lastId).Return(expected, nil)
+	notifications, err := uc.GetNotifications(context.Background(), userId, 20, ts, lastId)
+	require.NoError(t, err)
+	assert.Equal(t, expected, notifications)
+
+	_, err = uc.GetNotifications(context.Background(), userId, 0, ts, lastId)
+	assert.ErrorIs(t, err, notification_errors.ErrInvalidNumNotification)
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	userId, ids := uuid.New(), []uuid.UUID{uuid.New()}
+
+	// пустой список не идет в базу
+	assert.NoError(t, uc.MarkNotificationsRead(context.Background(), userId, nil))
+
+	mockRepo.EXPECT().MarkNotificationsRead(gomock.Any(), userId, ids).Return(nil)
+	assert.NoError(t, uc.MarkNotificationsRead(context.Background(), userId, ids))
+
+	mockRepo.EXPECT().MarkAllNotificationsRead(gomock.Any(), userId).Return(errors.New("db error"))
+	assert.Error(t, uc.MarkAllNotificationsRead(context.Background(), userId))
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepository(ctrl)
+	uc := usecase.NewNotificationUseCase(mockRepo)
+
+	userId := uuid.New()
+	mockRepo.EXPECT().GetUnreadCount(gomock.Any(), userId).Return(int64(7), nil)
+
+	count, err := uc.GetUnreadCount(context.Background(), userId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), count)
+}
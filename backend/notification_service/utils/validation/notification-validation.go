@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"quickflow/shared/models"
+)
+
+const maxNumNotifications = 100
+
+var (
+	ErrReceiver               = errors.New("invalid receiver")
+	ErrActor                  = errors.New("invalid actor")
+	ErrSelfNotification       = errors.New("user can not be notified about own action")
+	ErrTarget                 = errors.New("invalid target")
+	ErrNotificationType       = errors.New("invalid notification type")
+	ErrInvalidNumNotification = errors.New("invalid number of notifications")
+	ErrInvalidTimestamp       = errors.New("invalid timestamp")
+)
+
+func ValidateNotification(notification *models.Notification) error {
+	if notification == nil {
+		return errors.New("invalid notification")
+	}
+	if notification.UserId == uuid.Nil {
+		return ErrReceiver
+	}
+	if notification.ActorId == uuid.Nil {
+		return ErrActor
+	}
+	if notification.ActorId == notification.UserId {
+		return ErrSelfNotification
+	}
+	if notification.TargetId == uuid.Nil {
+		return ErrTarget
+	}
+	if !slices.Contains(models.NotificationTypes, notification.Type) {
+		return ErrNotificationType
+	}
+	return nil
+}
+
+func ValidateFetchParams(count int, ts time.Time) error {
+	if count <= 0 || count > maxNumNotifications {
+		return ErrInvalidNumNotification
+	}
+	if ts.IsZero() {
+		return ErrInvalidTimestamp
+	}
+	return nil
+}
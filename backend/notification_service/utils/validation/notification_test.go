@@ -0,0 +1,95 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quickflow/notification_service/utils/validation"
+	"quickflow/shared/models"
+)
+
+func TestValidateNotification(t *testing.T) {
+	userId, actorId := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name         string
+		notification *models.Notification
+		expected     error
+	}{
+		{
+			name: "Valid notification",
+			notification: &models.Notification{
+				UserId:   userId,
+				ActorId:  actorId,
+				Type:     models.NotificationPostLiked,
+				TargetId: uuid.New(),
+			},
+			expected: nil,
+		},
+		{
+			name: "No receiver",
+			notification: &models.Notification{
+				ActorId:  actorId,
+				Type:     models.NotificationPostLiked,
+				TargetId: uuid.New(),
+			},
+			expected: validation.ErrReceiver,
+		},
+		{
+			name: "No actor",
+			notification: &models.Notification{
+				UserId:   userId,
+				Type:     models.NotificationPostLiked,
+				TargetId: uuid.New(),
+			},
+			expected: validation.ErrActor,
+		},
+		{
+			name: "Own action",
+			notification: &models.Notification{
+				UserId:   userId,
+				ActorId:  userId,
+				Type:     models.NotificationCommentLiked,
+				TargetId: uuid.New(),
+			},
+			expected: validation.ErrSelfNotification,
+		},
+		{
+			name: "No target",
+			notification: &models.Notification{
+				UserId:  userId,
+				ActorId: actorId,
+				Type:    models.NotificationMention,
+			},
+			expected: validation.ErrTarget,
+		},
+		{
+			name: "Unknown type",
+			notification: &models.Notification{
+				UserId:   userId,
+				ActorId:  actorId,
+				Type:     "post_shared",
+				TargetId: uuid.New(),
+			},
+			expected: validation.ErrNotificationType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, validation.ValidateNotification(tt.notification), tt.expected)
+		})
+	}
+
+	assert.Error(t, validation.ValidateNotification(nil))
+}
+
+func TestValidateFetchParams(t *testing.T) {
+	assert.NoError(t, validation.ValidateFetchParams(20, time.Now()))
+	assert.ErrorIs(t, validation.ValidateFetchParams(0, time.Now()), validation.ErrInvalidNumNotification)
+	assert.ErrorIs(t, validation.ValidateFetchParams(101, time.Now()), validation.ErrInvalidNumNotification)
+	assert.ErrorIs(t, validation.ValidateFetchParams(20, time.Time{}), validation.ErrInvalidTimestamp)
+}
@@ -169,6 +169,21 @@ func (mr *MockPostUseCaseMockRecorder) UpdatePost(ctx, update, userId interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostUseCase)(nil).UpdatePost), ctx, update, userId)
 }
 
+// VotePoll mocks base method.
+func (m *MockPostUseCase) VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", ctx, postId, userId, options)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockPostUseCaseMockRecorder) VotePoll(ctx, postId, userId, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockPostUseCase)(nil).VotePoll), ctx, postId, userId, options)
+}
+
 // MockUserUseCase is a mock of UserUseCase interface.
 type MockUserUseCase struct {
 	ctrl     *gomock.Controller
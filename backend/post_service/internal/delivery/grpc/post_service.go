@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	poll_mapper "quickflow/shared/client/poll"
 	dto "quickflow/shared/client/post_service"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
@@ -23,6 +24,7 @@ type PostUseCase interface {
 	LikePost(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error
 	UnlikePost(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error
 	GetPost(ctx context.Context, postId uuid.UUID, userId uuid.UUID) (*models.Post, error)
+	VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error)
 }
 
 type UserUseCase interface {
@@ -219,3 +221,24 @@ func (p *PostServiceServer) GetPost(ctx context.Context, req *pb.GetPostRequest)
 	}
 	return &pb.GetPostResponse{Post: dto.ModelPostToProto(post)}, nil
 }
+
+func (p *PostServiceServer) VotePoll(ctx context.Context, req *pb.VotePollRequest) (*pb.VotePollResponse, error) {
+	logger.Info(ctx, "VotePoll called")
+	postId, err := uuid.Parse(req.PostId)
+	if err != nil {
+		logger.Error(ctx, "Invalid post ID:: %v", err)
+		return nil, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Error(ctx, "Invalid user ID:: %v", err)
+		return nil, err
+	}
+
+	post, err := p.postUseCase.VotePoll(ctx, postId, userId, poll_mapper.ProtoOptionsToModel(req.Options))
+	if err != nil {
+		logger.Error(ctx, "Failed to vote in poll:: %v", err)
+		return nil, err
+	}
+	return &pb.VotePollResponse{Post: dto.ModelPostToProto(post)}, nil
+}
@@ -522,3 +522,77 @@ func TestPostServiceServer_GetPost(t *testing.T) {
 		})
 	}
 }
+
+func TestPostServiceServer_VotePoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostUC := mocks.NewMockPostUseCase(ctrl)
+	mockUserUC := mocks.NewMockUserUseCase(ctrl)
+	server := NewPostServiceServer(mockPostUC, mockUserUC)
+
+	postId := uuid.New()
+	userId := uuid.New()
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		req         *pb.VotePollRequest
+		expectedErr bool
+	}{
+		{
+			name: "successful vote",
+			setupMock: func() {
+				mockPostUC.EXPECT().
+					VotePoll(gomock.Any(), postId, userId, []int{1}).
+					Return(&models.Post{
+						Id:   postId,
+						Poll: &models.Poll{ID: uuid.New(), Question: "Lunch?", Voted: []int{1}},
+					}, nil)
+			},
+			req: &pb.VotePollRequest{
+				PostId:  postId.String(),
+				UserId:  userId.String(),
+				Options: []int32{1},
+			},
+		},
+		{
+			name: "use case error",
+			setupMock: func() {
+				mockPostUC.EXPECT().
+					VotePoll(gomock.Any(), postId, userId, []int{}).
+					Return(nil, errors.New("poll is closed"))
+			},
+			req: &pb.VotePollRequest{
+				PostId: postId.String(),
+				UserId: userId.String(),
+			},
+			expectedErr: true,
+		},
+		{
+			name:      "invalid user id",
+			setupMock: func() {},
+			req: &pb.VotePollRequest{
+				PostId: postId.String(),
+				UserId: "invalid",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := server.VotePoll(context.Background(), tt.req)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, postId.String(), resp.Post.Id)
+				assert.Equal(t, []int32{1}, resp.Post.Poll.Voted)
+			}
+		})
+	}
+}
@@ -65,6 +65,15 @@ func ErrorInterceptor(
 		errors.Is(err, post_errors.ErrInvalidNumComments):
 		return nil, withErrorInfo(codes.InvalidArgument, "INVALID_ARGUMENT", err.Error())
 
+	case errors.Is(err, post_errors.ErrInvalidPoll):
+		return nil, withErrorInfo(codes.InvalidArgument, "INVALID_POLL", err.Error())
+
+	case errors.Is(err, post_errors.ErrInvalidPollVote):
+		return nil, withErrorInfo(codes.InvalidArgument, "INVALID_POLL_VOTE", err.Error())
+
+	case errors.Is(err, post_errors.ErrPollClosed):
+		return nil, withErrorInfo(codes.InvalidArgument, "POLL_CLOSED", err.Error())
+
 	case errors.Is(err, post_errors.ErrDoesNotBelongToUser):
 		return nil, withErrorInfo(codes.PermissionDenied, "FORBIDDEN", err.Error())
 
@@ -14,4 +14,7 @@ var (
 	ErrInvalidUUID         = errors.New("invalid uuid")
 	ErrAlreadyExists       = errors.New("already exists")
 	ErrInvalidNumComments  = errors.New("invalid number of comments")
+	ErrInvalidPoll         = errors.New("invalid poll")
+	ErrInvalidPollVote     = errors.New("invalid poll vote")
+	ErrPollClosed          = errors.New("poll is closed")
 )
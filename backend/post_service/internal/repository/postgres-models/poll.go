@@ -0,0 +1,52 @@
+package postgres_models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"quickflow/shared/models"
+)
+
+type PollPostgres struct {
+	ID                pgtype.UUID
+	PostID            pgtype.UUID
+	Question          pgtype.Text
+	MultipleChoice    pgtype.Bool
+	Anonymous         pgtype.Bool
+	ResultsVisibility pgtype.Text
+	ClosesAt          pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+	VoterCount        pgtype.Int8
+}
+
+func (p *PollPostgres) ToPoll() *models.Poll {
+	poll := &models.Poll{
+		ID:                p.ID.Bytes,
+		Question:          p.Question.String,
+		MultipleChoice:    p.MultipleChoice.Bool,
+		Anonymous:         p.Anonymous.Bool,
+		ResultsVisibility: models.PollResultsVisibility(p.ResultsVisibility.String),
+		CreatedAt:         p.CreatedAt.Time,
+		VoterCount:        int(p.VoterCount.Int64),
+	}
+	if p.ClosesAt.Valid {
+		closesAt := p.ClosesAt.Time
+		poll.ClosesAt = &closesAt
+	}
+	return poll
+}
+
+// PollOptionPostgres is the option with its tally, Voted is set if the requesting user chose it
+type PollOptionPostgres struct {
+	PollID    pgtype.UUID
+	Position  pgtype.Int4
+	Text      pgtype.Text
+	VoteCount pgtype.Int8
+	Voted     pgtype.Bool
+}
+
+func (o *PollOptionPostgres) ToPollOption() models.PollOption {
+	return models.PollOption{
+		Text:      o.Text.String,
+		VoteCount: int(o.VoteCount.Int64),
+	}
+}
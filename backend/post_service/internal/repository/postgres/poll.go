@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pgmodels "quickflow/post_service/internal/repository/postgres-models"
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+)
+
+const (
+	savePollQuery = `
+        insert into poll (id, post_id, question, multiple_choice, anonymous, results_visibility, closes_at, created_at)
+        values ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+	savePollOptionQuery = `
+        insert into poll_option (poll_id, position, text)
+        values ($1, $2, $3)
+`
+	getPollsQuery = `
+        select p.id, p.post_id, p.question, p.multiple_choice, p.anonymous, p.results_visibility, p.closes_at, p.created_at,
+               (select count(distinct v.user_id) from poll_vote v where v.poll_id = p.id)
+        from poll p
+        where p.post_id = any($1)
+`
+	getPollOptionsQuery = `
+        select o.poll_id, o.position, o.text, count(v.user_id), coalesce(bool_or(v.user_id = $2), false)
+        from poll_option o
+            left join poll_vote v on v.poll_id = o.poll_id and v.position = o.position
+        where o.poll_id = any($1)
+        group by o.poll_id, o.position, o.text
+        order by o.poll_id, o.position
+`
+	// voters of anonymous polls are never read
+	getPollVotersQuery = `
+        select v.poll_id, v.position, v.user_id
+        from poll_vote v
+            join poll p on p.id = v.poll_id
+        where v.poll_id = any($1) and not p.anonymous
+        order by v.voted_at
+`
+	deletePollVotesQuery = `
+        delete from poll_vote
+        where poll_id = $1 and user_id = $2
+`
+	savePollVoteQuery = `
+        insert into poll_vote (poll_id, user_id, position, voted_at)
+        values ($1, $2, $3, $4)
+`
+)
+
+// addPoll stores the poll of the post with its options
+func (p *PostgresPostRepository) addPoll(ctx context.Context, postId uuid.UUID, poll *models.Poll) error {
+	tx, err := p.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var closesAt pgtype.Timestamptz
+	if poll.ClosesAt != nil {
+		closesAt = pgtype.Timestamptz{Time: *poll.ClosesAt, Valid: true}
+	}
+
+	_, err = tx.ExecContext(ctx, savePollQuery,
+		pgtype.UUID{Bytes: poll.ID, Valid: true}, pgtype.UUID{Bytes: postId, Valid: true},
+		poll.Question, poll.MultipleChoice, poll.Anonymous, string(poll.ResultsVisibility),
+		closesAt, pgtype.Timestamptz{Time: poll.CreatedAt, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to save poll of post %v: %v", postId, err)
+		return fmt.Errorf("unable to save poll to database: %w", err)
+	}
+
+	for position, option := range poll.Options {
+		_, err = tx.ExecContext(ctx, savePollOptionQuery, pgtype.UUID{Bytes: poll.ID, Valid: true}, position, option.Text)
+		if err != nil {
+			logger.Error(ctx, "Unable to save option %d of poll %v: %v", position, poll.ID, err)
+			return fmt.Errorf("unable to save poll option to database: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit poll of post %v: %v", postId, err)
+		return fmt.Errorf("unable to commit poll: %w", err)
+	}
+	return nil
+}
+
+// GetPolls returns polls of the posts with their results, Voted is set to the options chosen by the user
+func (p *PostgresPostRepository) GetPolls(ctx context.Context, postIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]*models.Poll, error) {
+	rows, err := p.connPool.QueryContext(ctx, getPollsQuery, postIds)
+	if err != nil {
+		logger.Error(ctx, "Unable to get polls of %d posts: %v", len(postIds), err)
+		return nil, fmt.Errorf("unable to get polls from database: %w", err)
+	}
+	defer rows.Close()
+
+	polls := make(map[uuid.UUID]*models.Poll)
+	pollsById := make(map[uuid.UUID]*models.Poll)
+	var pollIds []uuid.UUID
+	for rows.Next() {
+		var pollPostgres pgmodels.PollPostgres
+		if err = rows.Scan(&pollPostgres.ID, &pollPostgres.PostID, &pollPostgres.Question,
+			&pollPostgres.MultipleChoice, &pollPostgres.Anonymous, &pollPostgres.ResultsVisibility,
+			&pollPostgres.ClosesAt, &pollPostgres.CreatedAt, &pollPostgres.VoterCount); err != nil {
+			logger.Error(ctx, "Unable to scan poll: %v", err)
+			return nil, fmt.Errorf("unable to scan poll: %w", err)
+		}
+		poll := pollPostgres.ToPoll()
+		polls[pollPostgres.PostID.Bytes] = poll
+		pollsById[poll.ID] = poll
+		pollIds = append(pollIds, poll.ID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read polls: %w", err)
+	}
+	if len(pollIds) == 0 {
+		return polls, nil
+	}
+
+	if err = p.fillPollOptions(ctx, pollsById, pollIds, userId); err != nil {
+		return nil, err
+	}
+	if err = p.fillPollVoters(ctx, pollsById, pollIds); err != nil {
+		return nil, err
+	}
+	return polls, nil
+}
+
+// fillPollOptions sets options of the polls in their order with the number of votes for each
+func (p *PostgresPostRepository) fillPollOptions(ctx context.Context, polls map[uuid.UUID]*models.Poll, pollIds []uuid.UUID, userId uuid.UUID) error {
+	rows, err := p.connPool.QueryContext(ctx, getPollOptionsQuery, pollIds, pgtype.UUID{Bytes: userId, Valid: true})
+	if err != nil {
+		logger.Error(ctx, "Unable to get options of %d polls: %v", len(pollIds), err)
+		return fmt.Errorf("unable to get poll options from database: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var optionPostgres pgmodels.PollOptionPostgres
+		if err = rows.Scan(&optionPostgres.PollID, &optionPostgres.Position, &optionPostgres.Text,
+			&optionPostgres.VoteCount, &optionPostgres.Voted); err != nil {
+			logger.Error(ctx, "Unable to scan poll option: %v", err)
+			return fmt.Errorf("unable to scan poll option: %w", err)
+		}
+		poll := polls[optionPostgres.PollID.Bytes]
+		poll.Options = append(poll.Options, optionPostgres.ToPollOption())
+		if optionPostgres.Voted.Bool {
+			poll.Voted = append(poll.Voted, int(optionPostgres.Position.Int32))
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("unable to read poll options: %w", err)
+	}
+	return nil
+}
+
+// fillPollVoters lists who voted for every option of public polls, the first voters first
+func (p *PostgresPostRepository) fillPollVoters(ctx context.Context, polls map[uuid.UUID]*models.Poll, pollIds []uuid.UUID) error {
+	rows, err := p.connPool.QueryContext(ctx, getPollVotersQuery, pollIds)
+	if err != nil {
+		logger.Error(ctx, "Unable to get voters of %d polls: %v", len(pollIds), err)
+		return fmt.Errorf("unable to get poll voters from database: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pollId, voterId pgtype.UUID
+		var position pgtype.Int4
+		if err = rows.Scan(&pollId, &position, &voterId); err != nil {
+			logger.Error(ctx, "Unable to scan poll voter: %v", err)
+			return fmt.Errorf("unable to scan poll voter: %w", err)
+		}
+		option := &polls[pollId.Bytes].Options[position.Int32]
+		option.Voters = append(option.Voters, voterId.Bytes)
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("unable to read poll voters: %w", err)
+	}
+	return nil
+}
+
+// SavePollVotes replaces votes of the user in the poll, no options retract the vote
+func (p *PostgresPostRepository) SavePollVotes(ctx context.Context, pollId, userId uuid.UUID, options []int, now time.Time) error {
+	tx, err := p.connPool.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Unable to begin transaction: %v", err)
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	pgPollId, pgUserId := pgtype.UUID{Bytes: pollId, Valid: true}, pgtype.UUID{Bytes: userId, Valid: true}
+	if _, err = tx.ExecContext(ctx, deletePollVotesQuery, pgPollId, pgUserId); err != nil {
+		logger.Error(ctx, "Unable to delete votes of user %v in poll %v: %v", userId, pollId, err)
+		return fmt.Errorf("unable to delete poll votes: %w", err)
+	}
+	for _, option := range options {
+		_, err = tx.ExecContext(ctx, savePollVoteQuery, pgPollId, pgUserId, option, pgtype.Timestamptz{Time: now, Valid: true})
+		if err != nil {
+			logger.Error(ctx, "Unable to save vote of user %v in poll %v: %v", userId, pollId, err)
+			return fmt.Errorf("unable to save poll vote: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Unable to commit votes in poll %v: %v", pollId, err)
+		return fmt.Errorf("unable to commit poll votes: %w", err)
+	}
+	return nil
+}
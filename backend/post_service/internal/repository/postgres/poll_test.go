@@ -0,0 +1,125 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/post_service/internal/repository/postgres"
+	"quickflow/shared/models"
+	"quickflow/shared/testutil"
+)
+
+func TestGetPolls(t *testing.T) {
+	ctx := context.Background()
+	postID, pollID, userID, voterID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	createdAt := time.Now().Truncate(time.Second)
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`from poll p`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "question", "multiple_choice", "anonymous",
+			"results_visibility", "closes_at", "created_at", "voters"}).
+			AddRow(pollID.String(), postID.String(), "Where?", true, false, "always", nil, createdAt, int64(2)))
+	mock.ExpectQuery(`from poll_option o`).
+		WillReturnRows(sqlmock.NewRows([]string{"poll_id", "position", "text", "votes", "voted"}).
+			AddRow(pollID.String(), int32(0), "Office", int64(2), true).
+			AddRow(pollID.String(), int32(1), "Cafe", int64(0), false))
+	mock.ExpectQuery(`from poll_vote v`).
+		WillReturnRows(sqlmock.NewRows([]string{"poll_id", "position", "user_id"}).
+			AddRow(pollID.String(), int32(0), voterID.String()).
+			AddRow(pollID.String(), int32(0), userID.String()))
+
+	repo := postgres.NewPostgresPostRepository(db)
+	polls, err := repo.GetPolls(ctx, []uuid.UUID{postID}, userID)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]*models.Poll{
+		postID: {
+			ID:       pollID,
+			Question: "Where?",
+			Options: []models.PollOption{
+				{Text: "Office", VoteCount: 2, Voters: []uuid.UUID{voterID, userID}},
+				{Text: "Cafe"},
+			},
+			MultipleChoice:    true,
+			ResultsVisibility: models.PollResultsAlways,
+			CreatedAt:         createdAt,
+			VoterCount:        2,
+			Voted:             []int{0},
+		},
+	}, polls)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPolls_NoPolls(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(testutil.PassThroughConverter{}))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`from poll p`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "question", "multiple_choice", "anonymous",
+			"results_visibility", "closes_at", "created_at", "voters"}))
+
+	repo := postgres.NewPostgresPostRepository(db)
+	polls, err := repo.GetPolls(context.Background(), []uuid.UUID{uuid.New()}, uuid.New())
+	require.NoError(t, err)
+	require.Empty(t, polls)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSavePollVotes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		options []int
+		execErr error
+	}{
+		{name: "replaces votes", options: []int{0, 2}},
+		{name: "retracts vote"},
+		{name: "insert fails", options: []int{1}, execErr: errors.New("db error")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`delete from poll_vote`).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			for _, option := range test.options {
+				exec := mock.ExpectExec(`insert into poll_vote`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), option, sqlmock.AnyArg())
+				if test.execErr != nil {
+					exec.WillReturnError(test.execErr)
+				} else {
+					exec.WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+			}
+			if test.execErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			repo := postgres.NewPostgresPostRepository(db)
+			err = repo.SavePollVotes(ctx, uuid.New(), uuid.New(), test.options, now)
+			if test.execErr != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
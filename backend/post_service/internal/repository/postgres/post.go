@@ -135,6 +135,12 @@ func (p *PostgresPostRepository) AddPost(ctx context.Context, post models.Post)
 		}
 	}
 
+	if post.Poll != nil {
+		if err = p.addPoll(ctx, post.Id, post.Poll); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -51,6 +51,20 @@ func (mr *MockPostValidatorMockRecorder) ValidateFeedParams(numPosts, timestamp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateFeedParams", reflect.TypeOf((*MockPostValidator)(nil).ValidateFeedParams), numPosts, timestamp)
 }
 
+// ValidatePoll mocks base method.
+func (m *MockPostValidator) ValidatePoll(poll models.Poll, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePoll", poll, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePoll indicates an expected call of ValidatePoll.
+func (mr *MockPostValidatorMockRecorder) ValidatePoll(poll, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePoll", reflect.TypeOf((*MockPostValidator)(nil).ValidatePoll), poll, now)
+}
+
 // MockPostRepository is a mock of PostRepository interface.
 type MockPostRepository struct {
 	ctrl     *gomock.Controller
@@ -132,6 +146,21 @@ func (mr *MockPostRepositoryMockRecorder) DeletePost(ctx, postId interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostRepository)(nil).DeletePost), ctx, postId)
 }
 
+// GetPolls mocks base method.
+func (m *MockPostRepository) GetPolls(ctx context.Context, postIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]*models.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolls", ctx, postIds, userId)
+	ret0, _ := ret[0].(map[uuid.UUID]*models.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolls indicates an expected call of GetPolls.
+func (mr *MockPostRepositoryMockRecorder) GetPolls(ctx, postIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolls", reflect.TypeOf((*MockPostRepository)(nil).GetPolls), ctx, postIds, userId)
+}
+
 // GetPost mocks base method.
 func (m *MockPostRepository) GetPost(ctx context.Context, postId uuid.UUID) (models.Post, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +250,20 @@ func (mr *MockPostRepositoryMockRecorder) LikePost(ctx, postId, userId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikePost", reflect.TypeOf((*MockPostRepository)(nil).LikePost), ctx, postId, userId)
 }
 
+// SavePollVotes mocks base method.
+func (m *MockPostRepository) SavePollVotes(ctx context.Context, pollId, userId uuid.UUID, options []int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePollVotes", ctx, pollId, userId, options, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePollVotes indicates an expected call of SavePollVotes.
+func (mr *MockPostRepositoryMockRecorder) SavePollVotes(ctx, pollId, userId, options, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePollVotes", reflect.TypeOf((*MockPostRepository)(nil).SavePollVotes), ctx, pollId, userId, options, now)
+}
+
 // UnlikePost mocks base method.
 func (m *MockPostRepository) UnlikePost(ctx context.Context, postId, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
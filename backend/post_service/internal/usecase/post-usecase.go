@@ -18,6 +18,7 @@ import (
 
 type PostValidator interface {
 	ValidateFeedParams(numPosts int, timestamp time.Time) error
+	ValidatePoll(poll models.Poll, now time.Time) error
 }
 
 type PostRepository interface {
@@ -33,6 +34,8 @@ type PostRepository interface {
 	CheckIfPostLiked(ctx context.Context, postId uuid.UUID, userId uuid.UUID) (bool, error)
 	UnlikePost(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error
 	LikePost(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error
+	GetPolls(ctx context.Context, postIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]*models.Poll, error)
+	SavePollVotes(ctx context.Context, pollId, userId uuid.UUID, options []int, now time.Time) error
 }
 
 type FileService interface {
@@ -61,6 +64,14 @@ func (p *PostUseCase) AddPost(ctx context.Context, post models.Post) (*models.Po
 	post.Id = uuid.New()
 
 	var err error
+	if post.Poll != nil {
+		if err = p.validator.ValidatePoll(*post.Poll, time.Now()); err != nil {
+			return nil, post_errors.ErrInvalidPoll
+		}
+		post.Poll.ID = uuid.New()
+		post.Poll.CreatedAt = time.Now()
+	}
+
 	// Update post images with urls
 	err = p.postRepo.AddPost(ctx, post)
 	if err != nil {
@@ -71,6 +82,9 @@ func (p *PostUseCase) AddPost(ctx context.Context, post models.Post) (*models.Po
 	if err != nil {
 		return nil, fmt.Errorf("p.postRepo.GetPost: %w", err)
 	}
+	if err = p.fillPost(ctx, &newPost, post.CreatorId); err != nil {
+		return nil, err
+	}
 
 	return &newPost, nil
 }
@@ -126,6 +140,9 @@ func (p *PostUseCase) FetchFeed(ctx context.Context, userId uuid.UUID, numPosts
 	if err != nil {
 		return nil, fmt.Errorf("p.repo.GetPostsForUId: %w", err)
 	}
+	if err = p.fillPolls(ctx, posts, userId); err != nil {
+		return nil, err
+	}
 
 	return posts, nil
 }
@@ -147,6 +164,9 @@ func (p *PostUseCase) FetchRecommendations(ctx context.Context, userId uuid.UUID
 	if err != nil {
 		return []models.Post{}, fmt.Errorf("p.repo.GetRecommendationsForUId: %w", err)
 	}
+	if err = p.fillPolls(ctx, posts, userId); err != nil {
+		return []models.Post{}, err
+	}
 
 	return posts, nil
 }
@@ -167,6 +187,9 @@ func (p *PostUseCase) FetchUserPosts(ctx context.Context, userId, requesterId uu
 	if err != nil {
 		return []models.Post{}, fmt.Errorf("p.repo.GetPostsForUId: %w", err)
 	}
+	if err = p.fillPolls(ctx, posts, requesterId); err != nil {
+		return []models.Post{}, err
+	}
 
 	return posts, nil
 }
@@ -223,6 +246,9 @@ func (p *PostUseCase) UpdatePost(ctx context.Context, postUpdate models.PostUpda
 	if err != nil {
 		return nil, fmt.Errorf("p.postRepo.GetPost: %w", err)
 	}
+	if err = p.fillPost(ctx, &post, userId); err != nil {
+		return nil, err
+	}
 
 	return &post, nil
 }
@@ -288,5 +314,73 @@ func (p *PostUseCase) GetPost(ctx context.Context, postId, userId uuid.UUID) (*m
 	if err != nil {
 		return nil, fmt.Errorf("p.postRepo.CheckIfPostLiked: %w", err)
 	}
+	if err = p.fillPost(ctx, &post, userId); err != nil {
+		return nil, err
+	}
 	return &post, err
 }
+
+// VotePoll replaces vote of the user in the poll of the post, an empty vote retracts it.
+// Returns the post with the poll as seen by the user.
+func (p *PostUseCase) VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error) {
+	if postId == uuid.Nil || userId == uuid.Nil {
+		return nil, errors.New("userId or postId is empty")
+	}
+
+	post, err := p.GetPost(ctx, postId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Poll == nil {
+		return nil, post_errors.ErrNotFound
+	}
+	now := time.Now()
+	if post.Poll.IsClosed(now) {
+		return nil, post_errors.ErrPollClosed
+	}
+	if !post.Poll.IsValidVote(options) {
+		return nil, post_errors.ErrInvalidPollVote
+	}
+
+	if err = p.postRepo.SavePollVotes(ctx, post.Poll.ID, userId, options, now); err != nil {
+		return nil, fmt.Errorf("p.postRepo.SavePollVotes: %w", err)
+	}
+	if err = p.fillPost(ctx, post, userId); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// fillPost sets poll of the single post as seen by the user
+func (p *PostUseCase) fillPost(ctx context.Context, post *models.Post, userId uuid.UUID) error {
+	posts := []models.Post{*post}
+	if err := p.fillPolls(ctx, posts, userId); err != nil {
+		return err
+	}
+	post.Poll = posts[0].Poll
+	return nil
+}
+
+// fillPolls sets polls of the posts with the results the user may see
+func (p *PostUseCase) fillPolls(ctx context.Context, posts []models.Post, userId uuid.UUID) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIds := make([]uuid.UUID, len(posts))
+	for i := range posts {
+		postIds[i] = posts[i].Id
+	}
+	polls, err := p.postRepo.GetPolls(ctx, postIds, userId)
+	if err != nil {
+		return fmt.Errorf("p.postRepo.GetPolls: %w", err)
+	}
+	now := time.Now()
+	for i := range posts {
+		posts[i].Poll = polls[posts[i].Id]
+		if posts[i].Poll != nil {
+			posts[i].Poll.HideResults(now)
+		}
+	}
+	return nil
+}
@@ -38,6 +38,7 @@ func TestAddPost(t *testing.T) {
 	// Ожидаемый вызов репозитория
 	postRepo.EXPECT().AddPost(gomock.Any(), gomock.Any()).Return(nil)
 	postRepo.EXPECT().GetPost(gomock.Any(), gomock.Any()).Return(post, nil)
+	postRepo.EXPECT().GetPolls(gomock.Any(), []uuid.UUID{post.Id}, post.CreatorId).Return(nil, nil)
 
 	// Создание объекта usecase
 	service := usecase.NewPostUseCase(postRepo, fileRepo, validator)
@@ -193,6 +194,7 @@ func TestUpdatePost(t *testing.T) {
 
 	postRepo.EXPECT().GetPostFiles(gomock.Any(), postId).Return([]string{"file1", "file2"}, nil).AnyTimes()
 	fileRepo.EXPECT().DeleteFile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	postRepo.EXPECT().GetPolls(gomock.Any(), []uuid.UUID{postId}, userId).Return(nil, nil)
 
 	// Создание объекта usecase
 	service := usecase.NewPostUseCase(postRepo, fileRepo, validator)
@@ -203,3 +205,104 @@ func TestUpdatePost(t *testing.T) {
 	// Проверки
 	assert.NoError(t, err)
 }
+
+func TestAddPost_InvalidPoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Моки
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	validator := mocks.NewMockPostValidator(ctrl)
+
+	post := models.Post{CreatorId: uuid.New(), CreatorType: models.PostUser, Poll: &models.Poll{Question: "Lunch?"}}
+
+	// Ожидаемый вызов валидатора
+	validator.EXPECT().ValidatePoll(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	// Создание объекта usecase
+	service := usecase.NewPostUseCase(postRepo, nil, validator)
+
+	// Вызов функции
+	_, err := service.AddPost(context.Background(), post)
+
+	// Проверки
+	assert.ErrorIs(t, err, errors.ErrInvalidPoll)
+}
+
+func TestVotePoll(t *testing.T) {
+	postId := uuid.New()
+	userId := uuid.New()
+	past := time.Now().Add(-time.Minute)
+	newPoll := func() *models.Poll {
+		return &models.Poll{
+			ID:                uuid.New(),
+			Question:          "Lunch?",
+			Options:           []models.PollOption{{Text: "Yes"}, {Text: "No"}, {Text: "Later"}},
+			MultipleChoice:    true,
+			ResultsVisibility: models.PollResultsAfterVote,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		poll        func() *models.Poll
+		options     []int
+		expectedErr error
+	}{
+		{name: "vote", poll: newPoll, options: []int{0, 2}},
+		{name: "no poll", poll: func() *models.Poll { return nil }, options: []int{0}, expectedErr: errors.ErrNotFound},
+		{name: "unknown option", poll: newPoll, options: []int{3}, expectedErr: errors.ErrInvalidPollVote},
+		{
+			name: "closed",
+			poll: func() *models.Poll {
+				poll := newPoll()
+				poll.ClosesAt = &past
+				return poll
+			},
+			options:     []int{1},
+			expectedErr: errors.ErrPollClosed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Моки
+			postRepo := mocks.NewMockPostRepository(ctrl)
+
+			// Ожидаемый вызов репозитория
+			poll := test.poll()
+			postRepo.EXPECT().GetPost(gomock.Any(), postId).Return(models.Post{Id: postId}, nil)
+			postRepo.EXPECT().CheckIfPostLiked(gomock.Any(), postId, userId).Return(false, nil)
+			postRepo.EXPECT().GetPolls(gomock.Any(), []uuid.UUID{postId}, userId).
+				Return(map[uuid.UUID]*models.Poll{postId: poll}, nil)
+			if test.expectedErr == nil {
+				postRepo.EXPECT().SavePollVotes(gomock.Any(), poll.ID, userId, test.options, gomock.Any()).Return(nil)
+				voted := newPoll()
+				voted.Options[0].VoteCount = 1
+				voted.Voted = test.options
+				postRepo.EXPECT().GetPolls(gomock.Any(), []uuid.UUID{postId}, userId).
+					Return(map[uuid.UUID]*models.Poll{postId: voted}, nil)
+			}
+
+			// Создание объекта usecase
+			service := usecase.NewPostUseCase(postRepo, nil, nil)
+
+			// Вызов функции
+			result, err := service.VotePoll(context.Background(), postId, userId, test.options)
+
+			// Проверки
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.options, result.Poll.Voted)
+			// results are shown once the user has voted
+			assert.False(t, result.Poll.ResultsHidden)
+			assert.Equal(t, 1, result.Poll.Options[0].VoteCount)
+		})
+	}
+}
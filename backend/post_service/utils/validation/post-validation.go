@@ -2,7 +2,11 @@ package validation
 
 import (
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"quickflow/shared/models"
 )
 
 var (
@@ -10,6 +14,13 @@ var (
 	ErrInvalidTimestamp = errors.New("invalid timestamp")
 )
 
+const (
+	minPollOptions       = 2
+	maxPollOptions       = 10
+	maxPollQuestionRunes = 300
+	maxPollOptionRunes   = 100
+)
+
 type PostValidator struct{}
 
 func NewPostValidator() *PostValidator {
@@ -25,3 +36,28 @@ func (p *PostValidator) ValidateFeedParams(numPosts int, timestamp time.Time) er
 	}
 	return nil
 }
+
+func (p *PostValidator) ValidatePoll(poll models.Poll, now time.Time) error {
+	question := strings.TrimSpace(poll.Question)
+	if len(question) == 0 || utf8.RuneCountInString(question) > maxPollQuestionRunes {
+		return errors.New("invalid poll question length")
+	}
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return errors.New("invalid number of poll options")
+	}
+	for _, option := range poll.Options {
+		text := strings.TrimSpace(option.Text)
+		if len(text) == 0 || utf8.RuneCountInString(text) > maxPollOptionRunes {
+			return errors.New("invalid poll option length")
+		}
+	}
+	switch poll.ResultsVisibility {
+	case models.PollResultsAlways, models.PollResultsAfterVote, models.PollResultsAfterClose:
+	default:
+		return errors.New("invalid poll results visibility")
+	}
+	if poll.ClosesAt != nil && !poll.ClosesAt.After(now) {
+		return errors.New("poll close time must be in the future")
+	}
+	return nil
+}
@@ -40,6 +40,7 @@ func (f *FileClient) UploadFile(ctx context.Context, file *models.File) (string,
 				FileSize:    file.Size,
 				AccessMode:  pb.AccessMode(file.AccessMode),
 				DisplayType: string(file.DisplayType),
+				IsVoice:     file.IsVoice,
 			},
 		},
 	})
@@ -118,6 +119,7 @@ func (f *FileClient) UploadManyFiles(ctx context.Context, files []*models.File)
 					FileSize:    file.Size,
 					AccessMode:  pb.AccessMode(file.AccessMode),
 					DisplayType: string(file.DisplayType),
+					IsVoice:     file.IsVoice,
 				},
 			},
 		})
@@ -188,3 +190,64 @@ func (f *FileClient) DeleteFile(ctx context.Context, filename string) error {
 
 	return nil
 }
+
+// DownloadPrivateFile streams the private file, Reader of the returned file receives the content
+// while it is read and fails once ctx is done
+func (f *FileClient) DownloadPrivateFile(ctx context.Context, filename string) (*models.File, error) {
+	stream, err := f.client.DownloadPrivateFile(ctx, &pb.DownloadPrivateFileRequest{FileName: filename})
+	if err != nil {
+		return nil, fmt.Errorf("DownloadPrivateFile: %w", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		logger.Error(ctx, "Failed to download private file %s: %v", filename, err)
+		return nil, fmt.Errorf("receive file info: %w", err)
+	}
+	info := resp.GetInfo()
+	if info == nil {
+		return nil, fmt.Errorf("file info must be sent before chunks")
+	}
+
+	return &models.File{
+		Reader:     &downloadReader{stream: stream},
+		Name:       info.FileName,
+		Size:       info.FileSize,
+		MimeType:   info.FileType,
+		AccessMode: models.AccessPrivate,
+	}, nil
+}
+
+// DeletePrivateFile удаляет приватный файл
+func (f *FileClient) DeletePrivateFile(ctx context.Context, filename string) error {
+	if len(filename) == 0 {
+		return fmt.Errorf("filename is empty")
+	}
+
+	_, err := f.client.DeletePrivateFile(ctx, &pb.DeletePrivateFileRequest{FileName: filename})
+	if err != nil {
+		logger.Error(ctx, "Failed to delete private file from file_service: %s", filename)
+		return fmt.Errorf("fileClient.DeletePrivateFile: %w", err)
+	}
+	return nil
+}
+
+// downloadReader reads chunks of the download stream
+type downloadReader struct {
+	stream pb.FileService_DownloadPrivateFileClient
+	chunk  []byte
+}
+
+func (r *downloadReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = resp.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
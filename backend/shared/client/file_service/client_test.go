@@ -211,6 +211,82 @@ func TestFileClient_UploadManyFiles(t *testing.T) {
 	}
 }
 
+func TestFileClient_VoiceFlagIsSent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	voice := func() *models.File {
+		return &models.File{
+			Name:        "voice.ogg",
+			MimeType:    "audio/ogg",
+			DisplayType: models.DisplayTypeAudio,
+			IsVoice:     true,
+			Reader:      strings.NewReader("voice"),
+		}
+	}
+	var infos []*pb.File
+	recordInfo := func(req *pb.UploadFileRequest) error {
+		if info := req.GetInfo(); info != nil {
+			infos = append(infos, info)
+		}
+		return nil
+	}
+
+	stream := mocks.NewMockFileService_UploadFileClient(ctrl)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(recordInfo).AnyTimes()
+	stream.EXPECT().CloseAndRecv().Return(&pb.UploadFileResponse{FileUrl: "voice"}, nil)
+
+	manyStream := mocks.NewMockFileService_UploadManyFilesClient(ctrl)
+	manyStream.EXPECT().Send(gomock.Any()).DoAndReturn(recordInfo).AnyTimes()
+	manyStream.EXPECT().CloseSend().Return(nil)
+	manyStream.EXPECT().Recv().Return(&pb.UploadFileResponse{FileUrl: "voice"}, nil)
+	manyStream.EXPECT().Recv().Return(nil, io.EOF)
+
+	mockClient := mocks.NewMockFileServiceClient(ctrl)
+	mockClient.EXPECT().UploadFile(gomock.Any()).Return(stream, nil)
+	mockClient.EXPECT().UploadManyFiles(gomock.Any()).Return(manyStream, nil)
+	client := &FileClient{client: mockClient}
+
+	_, err := client.UploadFile(context.Background(), voice())
+	assert.NoError(t, err)
+	_, err = client.UploadManyFiles(context.Background(), []*models.File{voice()})
+	assert.NoError(t, err)
+
+	if assert.Len(t, infos, 2) {
+		assert.True(t, infos[0].IsVoice)
+		assert.True(t, infos[1].IsVoice)
+	}
+}
+
+func TestFileClient_DownloadPrivateFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFileServiceClient(ctrl)
+	stream := mocks.NewMockFileService_DownloadPrivateFileClient(ctrl)
+	client := &FileClient{client: mockClient}
+
+	mockClient.EXPECT().DownloadPrivateFile(gomock.Any(), &pb.DownloadPrivateFileRequest{FileName: "export.zip"}).
+		Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&pb.DownloadPrivateFileResponse{Data: &pb.DownloadPrivateFileResponse_Info{
+			Info: &pb.File{FileName: "export.zip", FileType: "application/zip", FileSize: 11},
+		}}, nil),
+		stream.EXPECT().Recv().Return(&pb.DownloadPrivateFileResponse{Data: &pb.DownloadPrivateFileResponse_Chunk{Chunk: []byte("hello ")}}, nil),
+		stream.EXPECT().Recv().Return(&pb.DownloadPrivateFileResponse{Data: &pb.DownloadPrivateFileResponse_Chunk{Chunk: []byte("world")}}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	file, err := client.DownloadPrivateFile(context.Background(), "export.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "application/zip", file.MimeType)
+	assert.Equal(t, int64(11), file.Size)
+
+	content, err := io.ReadAll(file.Reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+}
+
 func TestFileClient_DeleteFile(t *testing.T) {
 	tests := []struct {
 		name        string
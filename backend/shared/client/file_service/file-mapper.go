@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"path"
+	"time"
 
 	shared_models "quickflow/shared/models"
 	"quickflow/shared/proto/file_service"
@@ -23,6 +24,28 @@ func ProtoFileToModel(file *file_service.File) *shared_models.File {
 		Reader:      bytes.NewReader(file.File),
 		DisplayType: shared_models.DisplayType(file.DisplayType),
 		Ext:         path.Ext(file.FileName),
+		IsVoice:     file.IsVoice,
+		Voice:       ProtoVoiceToModel(file.Voice),
+	}
+}
+
+func ProtoVoiceToModel(voice *file_service.VoiceMetadata) *shared_models.VoiceMetadata {
+	if voice == nil {
+		return nil
+	}
+	return &shared_models.VoiceMetadata{
+		Duration: time.Duration(voice.DurationMs) * time.Millisecond,
+		Waveform: voice.Waveform,
+	}
+}
+
+func ModelVoiceToProto(voice *shared_models.VoiceMetadata) *file_service.VoiceMetadata {
+	if voice == nil {
+		return nil
+	}
+	return &file_service.VoiceMetadata{
+		DurationMs: voice.Duration.Milliseconds(),
+		Waveform:   voice.Waveform,
 	}
 }
 
@@ -39,6 +62,8 @@ func ModelFileToProto(file *shared_models.File) *file_service.File {
 			Url:         file.URL,
 			DisplayType: string(file.DisplayType),
 			FileName:    file.Name,
+			IsVoice:     file.IsVoice,
+			Voice:       ModelVoiceToProto(file.Voice),
 		}
 	}
 	content, err := io.ReadAll(file.Reader)
@@ -53,6 +78,8 @@ func ModelFileToProto(file *shared_models.File) *file_service.File {
 		Url:         file.URL,
 		File:        content,
 		DisplayType: string(file.DisplayType),
+		IsVoice:     file.IsVoice,
+		Voice:       ModelVoiceToProto(file.Voice),
 	}
 }
 
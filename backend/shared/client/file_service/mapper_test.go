@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -150,6 +151,23 @@ func TestModelFileToProto(t *testing.T) {
 	}
 }
 
+func TestVoiceAttachmentToProtoAndBack(t *testing.T) {
+	file := &models.File{
+		URL:         "http://example.com/voice.ogg",
+		DisplayType: models.DisplayTypeAudio,
+		IsVoice:     true,
+		Voice:       &models.VoiceMetadata{Duration: 1500 * time.Millisecond, Waveform: []byte{0, 128, 255}},
+	}
+
+	proto := ModelFileToProto(file)
+	assert.True(t, proto.IsVoice)
+	assert.Equal(t, int64(1500), proto.Voice.DurationMs)
+
+	result := ProtoFileToModel(proto)
+	assert.True(t, result.IsVoice)
+	assert.Equal(t, file.Voice, result.Voice)
+}
+
 func TestProtoFilesToModels(t *testing.T) {
 	tests := []struct {
 		name     string
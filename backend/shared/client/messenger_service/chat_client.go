@@ -2,6 +2,7 @@ package messenger_service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,11 +25,12 @@ func NewChatServiceClient(conn *grpc.ClientConn) *ChatServiceClient {
 	}
 }
 
-func (c *ChatServiceClient) GetUserChats(ctx context.Context, userId uuid.UUID, limit int, updatedAt time.Time) ([]models.Chat, error) {
+func (c *ChatServiceClient) GetUserChats(ctx context.Context, userId uuid.UUID, limit int, updatedAt time.Time, archived bool) ([]models.Chat, error) {
 	req := &pb.GetUserChatsRequest{
 		UserId:    userId.String(),
 		ChatsNum:  int32(limit),
 		UpdatedAt: timestamppb.New(updatedAt),
+		Archived:  archived,
 	}
 
 	logger.Info(ctx, "Getting user chats for userId: %s", userId.String())
@@ -40,23 +42,26 @@ func (c *ChatServiceClient) GetUserChats(ctx context.Context, userId uuid.UUID,
 	return MapProtoToChats(resp.Chats), nil
 }
 
-func (c *ChatServiceClient) CreateChat(ctx context.Context, userId uuid.UUID, info models.ChatCreationInfo) (*models.Chat, error) {
+// CreateChat creates chat, for group chats also returns the system message about the creation
+func (c *ChatServiceClient) CreateChat(ctx context.Context, userId uuid.UUID, info models.ChatCreationInfo) (*models.Chat, *models.Message, error) {
 	req := &pb.CreateChatRequest{
-		UserId: userId.String(),
-		ChatInfo: &pb.ChatCreationInfo{
-			Name:   info.Name,
-			Type:   pb.ChatType(info.Type),
-			Avatar: file_service.ModelFileToProto(info.Avatar),
-		},
+		UserId:   userId.String(),
+		ChatInfo: MapCreationInfoToProto(info),
 	}
 
 	logger.Info(ctx, "Creating chat for userId: %s", userId.String())
 	resp, err := c.client.CreateChat(ctx, req)
 	if err != nil {
 		logger.Error(ctx, "Failed to create chat: %v", err)
-		return nil, err
+		return nil, nil, err
 	}
-	return MapProtoToChat(resp.Chat), nil
+
+	systemMessage, err := MapProtoToMessage(resp.SystemMessage)
+	if err != nil {
+		logger.Error(ctx, "Failed to map system message: %v", err)
+		return nil, nil, err
+	}
+	return MapProtoToChat(resp.Chat), systemMessage, nil
 }
 
 func (c *ChatServiceClient) GetChatParticipants(ctx context.Context, chatId uuid.UUID) ([]uuid.UUID, error) {
@@ -91,9 +96,9 @@ func (c *ChatServiceClient) GetPrivateChat(ctx context.Context, user1, user2 uui
 	return MapProtoToChat(resp.Chat), nil
 }
 
-func (c *ChatServiceClient) DeleteChat(ctx context.Context, chatId uuid.UUID) error {
-	logger.Info(ctx, "Deleting chat for userId: %s", chatId.String())
-	_, err := c.client.DeleteChat(ctx, &pb.DeleteChatRequest{ChatId: chatId.String()})
+func (c *ChatServiceClient) DeleteChat(ctx context.Context, chatId, userId uuid.UUID) error {
+	logger.Info(ctx, "Deleting chat %s on behalf of user %s", chatId.String(), userId.String())
+	_, err := c.client.DeleteChat(ctx, &pb.DeleteChatRequest{ChatId: chatId.String(), UserId: userId.String()})
 	return err
 }
 
@@ -125,6 +130,77 @@ func (c *ChatServiceClient) LeaveChat(ctx context.Context, chatId, userId uuid.U
 	return err
 }
 
+// AddChatMembers adds users to the group chat and returns system messages about every added member
+func (c *ChatServiceClient) AddChatMembers(ctx context.Context, chatId, userId uuid.UUID, memberIds []uuid.UUID) ([]*models.Message, error) {
+	logger.Info(ctx, "Adding %d members to chat %s", len(memberIds), chatId.String())
+	ids := make([]string, len(memberIds))
+	for i, memberId := range memberIds {
+		ids[i] = memberId.String()
+	}
+
+	resp, err := c.client.AddChatMembers(ctx, &pb.AddChatMembersRequest{
+		ChatId:    chatId.String(),
+		UserId:    userId.String(),
+		MemberIds: ids,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to add chat members: %v", err)
+		return nil, err
+	}
+
+	messages := make([]*models.Message, 0, len(resp.SystemMessages))
+	for _, message := range resp.SystemMessages {
+		msg, err := MapProtoToMessage(message)
+		if err != nil {
+			logger.Error(ctx, "Failed to map system message: %v", err)
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// RemoveChatMember removes member from the group chat, the user leaves the chat if memberId is his own id
+func (c *ChatServiceClient) RemoveChatMember(ctx context.Context, chatId, userId, memberId uuid.UUID) (*models.Message, error) {
+	logger.Info(ctx, "Removing member %s from chat %s", memberId.String(), chatId.String())
+	resp, err := c.client.RemoveChatMember(ctx, &pb.RemoveChatMemberRequest{
+		ChatId:   chatId.String(),
+		UserId:   userId.String(),
+		MemberId: memberId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to remove chat member: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessage(resp.SystemMessage)
+}
+
+// UpdateChat changes name and avatar of the group chat and returns system messages about the changes
+func (c *ChatServiceClient) UpdateChat(ctx context.Context, chatId, userId uuid.UUID, info models.ChatUpdateInfo) (*models.Chat, []*models.Message, error) {
+	logger.Info(ctx, "Updating chat %s", chatId.String())
+	resp, err := c.client.UpdateChat(ctx, &pb.UpdateChatRequest{
+		ChatId: chatId.String(),
+		UserId: userId.String(),
+		Name:   info.Name,
+		Avatar: file_service.ModelFileToProto(info.Avatar),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to update chat: %v", err)
+		return nil, nil, err
+	}
+
+	messages := make([]*models.Message, 0, len(resp.SystemMessages))
+	for _, message := range resp.SystemMessages {
+		msg, err := MapProtoToMessage(message)
+		if err != nil {
+			logger.Error(ctx, "Failed to map system message: %v", err)
+			return nil, nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return MapProtoToChat(resp.Chat), messages, nil
+}
+
 func (c *ChatServiceClient) GetNumUnreadChats(ctx context.Context, userId uuid.UUID) (int, error) {
 	logger.Info(ctx, "Getting number of unread chats for userId: %s", userId.String())
 	resp, err := c.client.GetNumUnreadChats(ctx, &pb.GetNumUnreadChatsRequest{UserId: userId.String()})
@@ -134,3 +210,243 @@ func (c *ChatServiceClient) GetNumUnreadChats(ctx context.Context, userId uuid.U
 	}
 	return int(resp.NumChats), nil
 }
+
+// GetChatMembers returns participants of the chat with their roles
+func (c *ChatServiceClient) GetChatMembers(ctx context.Context, chatId uuid.UUID) ([]models.ChatMember, error) {
+	logger.Info(ctx, "Getting chat members for chatId: %s", chatId.String())
+	resp, err := c.client.GetChatMembers(ctx, &pb.GetChatMembersRequest{ChatId: chatId.String()})
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat members: %v", err)
+		return nil, err
+	}
+	return MapProtoToChatMembers(resp.Members)
+}
+
+// ChangeChatRole sets role of the group chat member and returns the system message about the change
+func (c *ChatServiceClient) ChangeChatRole(ctx context.Context, chatId, userId, memberId uuid.UUID, role models.ChatRole) (*models.Message, error) {
+	logger.Info(ctx, "Changing role of member %s in chat %s to %s", memberId.String(), chatId.String(), role)
+	resp, err := c.client.ChangeChatRole(ctx, &pb.ChangeChatRoleRequest{
+		ChatId:   chatId.String(),
+		UserId:   userId.String(),
+		MemberId: memberId.String(),
+		Role:     string(role),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to change chat role: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessage(resp.SystemMessage)
+}
+
+func (c *ChatServiceClient) CreateChatInvite(ctx context.Context, chatId, userId uuid.UUID, info models.ChatInviteCreationInfo) (*models.ChatInvite, error) {
+	logger.Info(ctx, "Creating invite to chat %s by user %s", chatId.String(), userId.String())
+	req := &pb.CreateChatInviteRequest{
+		ChatId:     chatId.String(),
+		UserId:     userId.String(),
+		UsageLimit: int32(info.UsageLimit),
+	}
+	if info.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*info.ExpiresAt)
+	}
+
+	resp, err := c.client.CreateChatInvite(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to create chat invite: %v", err)
+		return nil, err
+	}
+
+	invite, err := MapProtoToChatInvite(resp.Invite)
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (c *ChatServiceClient) GetChatInvites(ctx context.Context, chatId, userId uuid.UUID) ([]models.ChatInvite, error) {
+	logger.Info(ctx, "Getting invites of chat %s", chatId.String())
+	resp, err := c.client.GetChatInvites(ctx, &pb.GetChatInvitesRequest{
+		ChatId: chatId.String(),
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat invites: %v", err)
+		return nil, err
+	}
+	return MapProtoToChatInvites(resp.Invites)
+}
+
+func (c *ChatServiceClient) RevokeChatInvite(ctx context.Context, token string, userId uuid.UUID) error {
+	logger.Info(ctx, "Revoking chat invite by user %s", userId.String())
+	_, err := c.client.RevokeChatInvite(ctx, &pb.RevokeChatInviteRequest{
+		Token:  token,
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to revoke chat invite: %v", err)
+	}
+	return err
+}
+
+func (c *ChatServiceClient) GetChatInvitePreview(ctx context.Context, token string) (*models.ChatPreview, error) {
+	logger.Info(ctx, "Getting chat invite preview")
+	resp, err := c.client.GetChatInvitePreview(ctx, &pb.GetChatInvitePreviewRequest{Token: token})
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat invite preview: %v", err)
+		return nil, err
+	}
+
+	chat := MapProtoToChat(resp.Chat)
+	if chat == nil {
+		return nil, fmt.Errorf("invalid chat in invite preview")
+	}
+	return &models.ChatPreview{Chat: *chat, NumMembers: int(resp.NumMembers)}, nil
+}
+
+// JoinChatByInvite returns the joined chat and the system message about the new member
+func (c *ChatServiceClient) JoinChatByInvite(ctx context.Context, token string, userId uuid.UUID) (*models.Chat, *models.Message, error) {
+	logger.Info(ctx, "Joining chat by invite for userId: %s", userId.String())
+	resp, err := c.client.JoinChatByInvite(ctx, &pb.JoinChatByInviteRequest{
+		Token:  token,
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to join chat by invite: %v", err)
+		return nil, nil, err
+	}
+
+	message, err := MapProtoToMessage(resp.SystemMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return MapProtoToChat(resp.Chat), message, nil
+}
+
+// MuteChat turns off notifications from the chat until mutedUntil, nil turns them back on
+func (c *ChatServiceClient) MuteChat(ctx context.Context, chatId, userId uuid.UUID, mutedUntil *time.Time) error {
+	req := &pb.MuteChatRequest{
+		ChatId: chatId.String(),
+		UserId: userId.String(),
+	}
+	if mutedUntil != nil {
+		req.MutedUntil = timestamppb.New(*mutedUntil)
+	}
+
+	logger.Info(ctx, "Changing mute of chat %s for user %s", chatId.String(), userId.String())
+	_, err := c.client.MuteChat(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to change chat mute: %v", err)
+	}
+	return err
+}
+
+func (c *ChatServiceClient) ArchiveChat(ctx context.Context, chatId, userId uuid.UUID, archived bool) error {
+	logger.Info(ctx, "Changing archive of chat %s for user %s", chatId.String(), userId.String())
+	_, err := c.client.ArchiveChat(ctx, &pb.ArchiveChatRequest{
+		ChatId:   chatId.String(),
+		UserId:   userId.String(),
+		Archived: archived,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to change chat archive: %v", err)
+	}
+	return err
+}
+
+func (c *ChatServiceClient) PinChat(ctx context.Context, chatId, userId uuid.UUID, pinned bool) error {
+	logger.Info(ctx, "Changing pin of chat %s for user %s", chatId.String(), userId.String())
+	_, err := c.client.PinChat(ctx, &pb.PinChatRequest{
+		ChatId: chatId.String(),
+		UserId: userId.String(),
+		Pinned: pinned,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to change chat pin: %v", err)
+	}
+	return err
+}
+
+func (c *ChatServiceClient) ReorderPinnedChats(ctx context.Context, userId uuid.UUID, chatIds []uuid.UUID) error {
+	ids := make([]string, len(chatIds))
+	for i, chatId := range chatIds {
+		ids[i] = chatId.String()
+	}
+
+	logger.Info(ctx, "Reordering pinned chats for user %s", userId.String())
+	_, err := c.client.ReorderPinnedChats(ctx, &pb.ReorderPinnedChatsRequest{
+		UserId:  userId.String(),
+		ChatIds: ids,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to reorder pinned chats: %v", err)
+	}
+	return err
+}
+
+// SetChatMessageTTL makes messages sent to the chat disappear after ttl, zero turns it off.
+// Returns the system message about the change.
+func (c *ChatServiceClient) SetChatMessageTTL(ctx context.Context, chatId, userId uuid.UUID, ttl time.Duration) (*models.Message, error) {
+	logger.Info(ctx, "Setting message ttl of chat %s to %v", chatId.String(), ttl)
+	resp, err := c.client.SetChatMessageTTL(ctx, &pb.SetChatMessageTTLRequest{
+		ChatId:     chatId.String(),
+		UserId:     userId.String(),
+		TtlSeconds: int32(ttl / time.Second),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to set chat message ttl: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessage(resp.SystemMessage)
+}
+
+// SetChatEncryption turns end-to-end encryption of the private chat on or off.
+// Returns the system message about the change.
+func (c *ChatServiceClient) SetChatEncryption(ctx context.Context, chatId, userId uuid.UUID, enabled bool) (*models.Message, error) {
+	logger.Info(ctx, "Setting encryption of chat %s to %v", chatId.String(), enabled)
+	resp, err := c.client.SetChatEncryption(ctx, &pb.SetChatEncryptionRequest{
+		ChatId:  chatId.String(),
+		UserId:  userId.String(),
+		Enabled: enabled,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to set chat encryption: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessage(resp.SystemMessage)
+}
+
+// RequestChatExport queues the export of the chat history, the archive is built in background
+func (c *ChatServiceClient) RequestChatExport(ctx context.Context, chatId, userId uuid.UUID) (*models.ChatExport, error) {
+	logger.Info(ctx, "Requesting export of chat %s by user %s", chatId.String(), userId.String())
+	resp, err := c.client.RequestChatExport(ctx, &pb.RequestChatExportRequest{
+		ChatId: chatId.String(),
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to request chat export: %v", err)
+		return nil, err
+	}
+
+	export, err := MapProtoToChatExport(resp.Export)
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+func (c *ChatServiceClient) GetChatExport(ctx context.Context, exportId, userId uuid.UUID) (*models.ChatExport, error) {
+	logger.Info(ctx, "Getting chat export %s", exportId.String())
+	resp, err := c.client.GetChatExport(ctx, &pb.GetChatExportRequest{
+		ExportId: exportId.String(),
+		UserId:   userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat export: %v", err)
+		return nil, err
+	}
+
+	export, err := MapProtoToChatExport(resp.Export)
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
@@ -42,7 +42,7 @@ func TestChatServiceClient_GetUserChats(t *testing.T) {
 			UpdatedAt: timestamppb.New(now),
 		}).Return(&pb.GetUserChatsResponse{Chats: expectedChats}, nil)
 
-		chats, err := client.GetUserChats(ctx, userID, limit, now)
+		chats, err := client.GetUserChats(ctx, userID, limit, now, false)
 		require.NoError(t, err)
 		assert.Len(t, chats, 2)
 		assert.Equal(t, expectedChats[0].Id, chats[0].ID.String())
@@ -53,7 +53,7 @@ func TestChatServiceClient_GetUserChats(t *testing.T) {
 		expectedErr := errors.New("server error")
 		mockClient.EXPECT().GetUserChats(ctx, gomock.Any()).Return(nil, expectedErr)
 
-		chats, err := client.GetUserChats(ctx, userID, limit, now)
+		chats, err := client.GetUserChats(ctx, userID, limit, now, false)
 		assert.Error(t, err)
 		assert.Nil(t, chats)
 		assert.Equal(t, expectedErr, err)
@@ -89,21 +89,30 @@ func TestChatServiceClient_CreateChat(t *testing.T) {
 				Type:   pb.ChatType(chatInfo.Type),
 				Avatar: file_service.ModelFileToProto(chatInfo.Avatar),
 			},
-		}).Return(&pb.CreateChatResponse{Chat: expectedChat}, nil)
-
-		chat, err := client.CreateChat(ctx, userID, chatInfo)
+		}).Return(&pb.CreateChatResponse{Chat: expectedChat, SystemMessage: &pb.Message{
+			Id:       uuid.New().String(),
+			ChatId:   expectedChat.Id,
+			SenderId: userID.String(),
+			Text:     chatInfo.Name,
+			System:   &pb.SystemInfo{Action: string(models.SystemActionChatCreated)},
+		}}, nil)
+
+		chat, systemMessage, err := client.CreateChat(ctx, userID, chatInfo)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChat.Id, chat.ID.String())
 		assert.Equal(t, expectedChat.Name, chat.Name)
+		require.NotNil(t, systemMessage)
+		assert.Equal(t, &models.SystemInfo{Action: models.SystemActionChatCreated}, systemMessage.System)
 	})
 
 	t.Run("error from server", func(t *testing.T) {
 		expectedErr := errors.New("server error")
 		mockClient.EXPECT().CreateChat(ctx, gomock.Any()).Return(nil, expectedErr)
 
-		chat, err := client.CreateChat(ctx, userID, chatInfo)
+		chat, systemMessage, err := client.CreateChat(ctx, userID, chatInfo)
 		assert.Error(t, err)
 		assert.Nil(t, chat)
+		assert.Nil(t, systemMessage)
 		assert.Equal(t, expectedErr, err)
 	})
 
@@ -128,9 +137,10 @@ func TestChatServiceClient_CreateChat(t *testing.T) {
 			},
 		}).Return(&pb.CreateChatResponse{Chat: expectedChat}, nil)
 
-		chat, err := client.CreateChat(ctx, userID, info)
+		chat, systemMessage, err := client.CreateChat(ctx, userID, info)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChat.Id, chat.ID.String())
+		assert.Nil(t, systemMessage)
 	})
 }
 
@@ -227,14 +237,15 @@ func TestChatServiceClient_DeleteChat(t *testing.T) {
 	client := &ChatServiceClient{client: mockClient}
 
 	ctx := context.Background()
-	chatID := uuid.New()
+	chatID, userID := uuid.New(), uuid.New()
 
 	t.Run("success", func(t *testing.T) {
 		mockClient.EXPECT().DeleteChat(ctx, &pb.DeleteChatRequest{
 			ChatId: chatID.String(),
+			UserId: userID.String(),
 		}).Return(&pb.DeleteChatResponse{}, nil)
 
-		err := client.DeleteChat(ctx, chatID)
+		err := client.DeleteChat(ctx, chatID, userID)
 		assert.NoError(t, err)
 	})
 
@@ -242,7 +253,7 @@ func TestChatServiceClient_DeleteChat(t *testing.T) {
 		expectedErr := errors.New("server error")
 		mockClient.EXPECT().DeleteChat(ctx, gomock.Any()).Return(nil, expectedErr)
 
-		err := client.DeleteChat(ctx, chatID)
+		err := client.DeleteChat(ctx, chatID, userID)
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
@@ -379,3 +390,405 @@ func TestChatServiceClient_GetNumUnreadChats(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 }
+
+func TestChatServiceClient_AddChatMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockClient.EXPECT().AddChatMembers(ctx, &pb.AddChatMembersRequest{
+			ChatId:    chatID.String(),
+			UserId:    userID.String(),
+			MemberIds: []string{memberID.String()},
+		}).Return(&pb.AddChatMembersResponse{SystemMessages: []*pb.Message{{
+			Id:       uuid.New().String(),
+			ChatId:   chatID.String(),
+			SenderId: userID.String(),
+			System:   &pb.SystemInfo{Action: string(models.SystemActionMemberAdded), UserId: memberID.String()},
+		}}}, nil)
+
+		messages, err := client.AddChatMembers(ctx, chatID, userID, []uuid.UUID{memberID})
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberAdded, UserID: memberID}, messages[0].System)
+	})
+
+	t.Run("error from server", func(t *testing.T) {
+		expectedErr := errors.New("server error")
+		mockClient.EXPECT().AddChatMembers(ctx, gomock.Any()).Return(nil, expectedErr)
+
+		messages, err := client.AddChatMembers(ctx, chatID, userID, []uuid.UUID{memberID})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, messages)
+	})
+}
+
+func TestChatServiceClient_RemoveChatMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockClient.EXPECT().RemoveChatMember(ctx, &pb.RemoveChatMemberRequest{
+			ChatId:   chatID.String(),
+			UserId:   userID.String(),
+			MemberId: userID.String(),
+		}).Return(&pb.RemoveChatMemberResponse{SystemMessage: &pb.Message{
+			Id:       uuid.New().String(),
+			ChatId:   chatID.String(),
+			SenderId: userID.String(),
+			System:   &pb.SystemInfo{Action: string(models.SystemActionMemberLeft), UserId: userID.String()},
+		}}, nil)
+
+		message, err := client.RemoveChatMember(ctx, chatID, userID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, models.SystemActionMemberLeft, message.System.Action)
+	})
+
+	t.Run("error from server", func(t *testing.T) {
+		expectedErr := errors.New("server error")
+		mockClient.EXPECT().RemoveChatMember(ctx, gomock.Any()).Return(nil, expectedErr)
+
+		message, err := client.RemoveChatMember(ctx, chatID, userID, userID)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, message)
+	})
+}
+
+func TestChatServiceClient_UpdateChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockClient.EXPECT().UpdateChat(ctx, &pb.UpdateChatRequest{
+			ChatId: chatID.String(),
+			UserId: userID.String(),
+			Name:   "Renamed",
+		}).Return(&pb.UpdateChatResponse{
+			Chat: &pb.Chat{Id: chatID.String(), Name: "Renamed", Type: pb.ChatType_CHAT_TYPE_GROUP},
+			SystemMessages: []*pb.Message{{
+				Id:       uuid.New().String(),
+				ChatId:   chatID.String(),
+				SenderId: userID.String(),
+				Text:     "Renamed",
+				System:   &pb.SystemInfo{Action: string(models.SystemActionChatRenamed)},
+			}},
+		}, nil)
+
+		chat, messages, err := client.UpdateChat(ctx, chatID, userID, models.ChatUpdateInfo{Name: "Renamed"})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", chat.Name)
+		require.Len(t, messages, 1)
+		assert.Equal(t, &models.SystemInfo{Action: models.SystemActionChatRenamed}, messages[0].System)
+	})
+
+	t.Run("error from server", func(t *testing.T) {
+		expectedErr := errors.New("server error")
+		mockClient.EXPECT().UpdateChat(ctx, gomock.Any()).Return(nil, expectedErr)
+
+		chat, messages, err := client.UpdateChat(ctx, chatID, userID, models.ChatUpdateInfo{Name: "Renamed"})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, chat)
+		assert.Nil(t, messages)
+	})
+}
+
+func TestChatServiceClient_ChangeChatRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+
+	mockClient.EXPECT().ChangeChatRole(ctx, &pb.ChangeChatRoleRequest{
+		ChatId:   chatID.String(),
+		UserId:   userID.String(),
+		MemberId: memberID.String(),
+		Role:     "admin",
+	}).Return(&pb.ChangeChatRoleResponse{SystemMessage: &pb.Message{
+		Id:       uuid.New().String(),
+		ChatId:   chatID.String(),
+		SenderId: userID.String(),
+		Text:     "admin",
+		System:   &pb.SystemInfo{Action: string(models.SystemActionRoleChanged), UserId: memberID.String()},
+	}}, nil)
+
+	message, err := client.ChangeChatRole(ctx, chatID, userID, memberID, models.ChatRoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", message.Text)
+	assert.Equal(t, &models.SystemInfo{Action: models.SystemActionRoleChanged, UserID: memberID}, message.System)
+}
+
+func TestChatServiceClient_GetChatMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	ownerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockClient.EXPECT().GetChatMembers(ctx, &pb.GetChatMembersRequest{ChatId: chatID.String()}).
+			Return(&pb.GetChatMembersResponse{Members: []*pb.ChatMember{{UserId: ownerID.String(), Role: "owner"}}}, nil)
+
+		members, err := client.GetChatMembers(ctx, chatID)
+		require.NoError(t, err)
+		assert.Equal(t, []models.ChatMember{{UserID: ownerID, Role: models.ChatRoleOwner}}, members)
+	})
+
+	t.Run("invalid member id", func(t *testing.T) {
+		mockClient.EXPECT().GetChatMembers(ctx, gomock.Any()).
+			Return(&pb.GetChatMembersResponse{Members: []*pb.ChatMember{{UserId: "invalid", Role: "owner"}}}, nil)
+
+		_, err := client.GetChatMembers(ctx, chatID)
+		assert.Error(t, err)
+	})
+}
+
+func TestChatServiceClient_CreateChatInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	mockClient.EXPECT().CreateChatInvite(ctx, &pb.CreateChatInviteRequest{
+		ChatId:     chatID.String(),
+		UserId:     userID.String(),
+		ExpiresAt:  timestamppb.New(expiresAt),
+		UsageLimit: 5,
+	}).Return(&pb.CreateChatInviteResponse{Invite: &pb.ChatInvite{
+		Token:      "token",
+		ChatId:     chatID.String(),
+		CreatorId:  userID.String(),
+		CreatedAt:  timestamppb.Now(),
+		ExpiresAt:  timestamppb.New(expiresAt),
+		UsageLimit: 5,
+	}}, nil)
+
+	invite, err := client.CreateChatInvite(ctx, chatID, userID, models.ChatInviteCreationInfo{ExpiresAt: &expiresAt, UsageLimit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "token", invite.Token)
+	assert.Equal(t, chatID, invite.ChatID)
+	assert.Equal(t, expiresAt, *invite.ExpiresAt)
+	assert.Equal(t, 5, invite.UsageLimit)
+}
+
+func TestChatServiceClient_JoinChatByInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mockClient.EXPECT().JoinChatByInvite(ctx, &pb.JoinChatByInviteRequest{
+		Token:  "token",
+		UserId: userID.String(),
+	}).Return(&pb.JoinChatByInviteResponse{
+		Chat: &pb.Chat{Id: chatID.String(), Type: pb.ChatType_CHAT_TYPE_GROUP, CreatedAt: timestamppb.Now(), UpdatedAt: timestamppb.Now()},
+		SystemMessage: &pb.Message{
+			Id:       uuid.New().String(),
+			ChatId:   chatID.String(),
+			SenderId: userID.String(),
+			System:   &pb.SystemInfo{Action: string(models.SystemActionMemberJoined), UserId: userID.String()},
+		},
+	}, nil)
+
+	chat, message, err := client.JoinChatByInvite(ctx, "token", userID)
+	require.NoError(t, err)
+	assert.Equal(t, chatID, chat.ID)
+	assert.Equal(t, &models.SystemInfo{Action: models.SystemActionMemberJoined, UserID: userID}, message.System)
+}
+
+func TestChatServiceClient_MuteChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	mutedUntil := time.Now().Add(time.Hour).UTC()
+
+	mockClient.EXPECT().MuteChat(ctx, &pb.MuteChatRequest{
+		ChatId:     chatID.String(),
+		UserId:     userID.String(),
+		MutedUntil: timestamppb.New(mutedUntil),
+	}).Return(&pb.MuteChatResponse{Success: true}, nil)
+	mockClient.EXPECT().MuteChat(ctx, &pb.MuteChatRequest{
+		ChatId: chatID.String(),
+		UserId: userID.String(),
+	}).Return(&pb.MuteChatResponse{Success: true}, nil)
+
+	require.NoError(t, client.MuteChat(ctx, chatID, userID, &mutedUntil))
+	require.NoError(t, client.MuteChat(ctx, chatID, userID, nil))
+}
+
+func TestChatServiceClient_ReorderPinnedChats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	expectedErr := errors.New("server error")
+
+	mockClient.EXPECT().ReorderPinnedChats(ctx, &pb.ReorderPinnedChatsRequest{
+		UserId:  userID.String(),
+		ChatIds: []string{chatIDs[0].String(), chatIDs[1].String()},
+	}).Return(nil, expectedErr)
+
+	err := client.ReorderPinnedChats(ctx, userID, chatIDs)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestChatServiceClient_SetChatMessageTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mockClient.EXPECT().SetChatMessageTTL(ctx, &pb.SetChatMessageTTLRequest{
+		ChatId:     chatID.String(),
+		UserId:     userID.String(),
+		TtlSeconds: 86400,
+	}).Return(&pb.SetChatMessageTTLResponse{SystemMessage: &pb.Message{
+		Id:       uuid.New().String(),
+		ChatId:   chatID.String(),
+		SenderId: userID.String(),
+		Text:     "86400",
+		System:   &pb.SystemInfo{Action: string(models.SystemActionMessageTTLChanged)},
+	}}, nil)
+
+	message, err := client.SetChatMessageTTL(ctx, chatID, userID, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "86400", message.Text)
+	assert.Equal(t, models.SystemActionMessageTTLChanged, message.System.Action)
+}
+
+func TestChatServiceClient_SetChatEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mockClient.EXPECT().SetChatEncryption(ctx, &pb.SetChatEncryptionRequest{
+		ChatId:  chatID.String(),
+		UserId:  userID.String(),
+		Enabled: true,
+	}).Return(&pb.SetChatEncryptionResponse{SystemMessage: &pb.Message{
+		Id:       uuid.New().String(),
+		ChatId:   chatID.String(),
+		SenderId: userID.String(),
+		Text:     "on",
+		System:   &pb.SystemInfo{Action: string(models.SystemActionEncryptionChanged)},
+	}}, nil)
+
+	message, err := client.SetChatEncryption(ctx, chatID, userID, true)
+	require.NoError(t, err)
+	assert.Equal(t, "on", message.Text)
+	assert.Equal(t, models.SystemActionEncryptionChanged, message.System.Action)
+}
+
+func TestChatServiceClient_RequestChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	userID := uuid.New()
+	exportID := uuid.New()
+
+	mockClient.EXPECT().RequestChatExport(ctx, &pb.RequestChatExportRequest{
+		ChatId: chatID.String(),
+		UserId: userID.String(),
+	}).Return(&pb.RequestChatExportResponse{Export: &pb.ChatExport{
+		Id:     exportID.String(),
+		ChatId: chatID.String(),
+		Status: string(models.ChatExportPending),
+	}}, nil)
+
+	export, err := client.RequestChatExport(ctx, chatID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, exportID, export.ID)
+	assert.Equal(t, chatID, export.ChatID)
+	assert.Equal(t, models.ChatExportPending, export.Status)
+}
+
+func TestChatServiceClient_GetChatExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	client := &ChatServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	exportID := uuid.New()
+	userID := uuid.New()
+	expectedErr := errors.New("not found")
+
+	mockClient.EXPECT().GetChatExport(ctx, &pb.GetChatExportRequest{
+		ExportId: exportID.String(),
+		UserId:   userID.String(),
+	}).Return(nil, expectedErr)
+
+	export, err := client.GetChatExport(ctx, exportID, userID)
+	assert.Nil(t, export)
+	assert.Equal(t, expectedErr, err)
+}
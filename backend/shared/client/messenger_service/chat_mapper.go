@@ -1,6 +1,8 @@
 package messenger_service
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -30,6 +32,15 @@ func MapChatToProto(chat models.Chat) *pb.Chat {
 	if chat.LastMessage.ID != uuid.Nil {
 		res.LastMessage = MapMessageToProto(chat.LastMessage)
 	}
+	res.PinnedMessages = MapPinnedMessagesToProto(chat.PinnedMessages)
+
+	if chat.MutedUntil != nil {
+		res.MutedUntil = timestamppb.New(*chat.MutedUntil)
+	}
+	res.Archived = chat.Archived
+	res.PinOrder = int32(chat.PinOrder)
+	res.MessageTtlSeconds = int32(chat.MessageTTL / time.Second)
+	res.Encrypted = chat.Encrypted
 
 	return res
 }
@@ -76,6 +87,16 @@ func MapProtoToChat(chat *pb.Chat) *models.Chat {
 			res.LastMessage = *msg
 		}
 	}
+	res.PinnedMessages = MapProtoToPinnedMessages(chat.PinnedMessages)
+
+	if chat.MutedUntil != nil {
+		tm := chat.MutedUntil.AsTime()
+		res.MutedUntil = &tm
+	}
+	res.Archived = chat.Archived
+	res.PinOrder = int(chat.PinOrder)
+	res.MessageTTL = time.Duration(chat.MessageTtlSeconds) * time.Second
+	res.Encrypted = chat.Encrypted
 
 	return res
 }
@@ -97,9 +118,147 @@ func MapProtoCreationInfoToModel(chatInfo *pb.ChatCreationInfo) models.ChatCreat
 	if chatInfo == nil {
 		return models.ChatCreationInfo{}
 	}
+	memberIds := make([]uuid.UUID, 0, len(chatInfo.MemberIds))
+	for _, s := range chatInfo.MemberIds {
+		memberId, err := uuid.Parse(s)
+		if err != nil {
+			continue
+		}
+		memberIds = append(memberIds, memberId)
+	}
 	return models.ChatCreationInfo{
-		Name:   chatInfo.Name,
-		Type:   models.ChatType(chatInfo.Type),
-		Avatar: file_service.ProtoFileToModel(chatInfo.Avatar),
+		Name:      chatInfo.Name,
+		Type:      models.ChatType(chatInfo.Type),
+		Avatar:    file_service.ProtoFileToModel(chatInfo.Avatar),
+		MemberIDs: memberIds,
+	}
+}
+
+func MapCreationInfoToProto(chatInfo models.ChatCreationInfo) *pb.ChatCreationInfo {
+	var memberIds []string
+	for _, memberId := range chatInfo.MemberIDs {
+		memberIds = append(memberIds, memberId.String())
+	}
+	return &pb.ChatCreationInfo{
+		Name:      chatInfo.Name,
+		Type:      pb.ChatType(chatInfo.Type),
+		Avatar:    file_service.ModelFileToProto(chatInfo.Avatar),
+		MemberIds: memberIds,
+	}
+}
+
+func MapChatMembersToProto(members []models.ChatMember) []*pb.ChatMember {
+	res := make([]*pb.ChatMember, len(members))
+	for i, member := range members {
+		res[i] = &pb.ChatMember{
+			UserId: member.UserID.String(),
+			Role:   string(member.Role),
+		}
+	}
+	return res
+}
+
+func MapProtoToChatMembers(members []*pb.ChatMember) ([]models.ChatMember, error) {
+	res := make([]models.ChatMember, len(members))
+	for i, member := range members {
+		userId, err := uuid.Parse(member.UserId)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = models.ChatMember{
+			UserID: userId,
+			Role:   models.ChatRole(member.Role),
+		}
+	}
+	return res, nil
+}
+
+func MapChatInviteToProto(invite models.ChatInvite) *pb.ChatInvite {
+	res := &pb.ChatInvite{
+		Token:      invite.Token,
+		ChatId:     invite.ChatID.String(),
+		CreatorId:  invite.CreatorID.String(),
+		CreatedAt:  timestamppb.New(invite.CreatedAt),
+		UsageLimit: int32(invite.UsageLimit),
+		UsageCount: int32(invite.UsageCount),
 	}
+	if invite.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+	return res
+}
+
+func MapChatInvitesToProto(invites []models.ChatInvite) []*pb.ChatInvite {
+	res := make([]*pb.ChatInvite, len(invites))
+	for i, invite := range invites {
+		res[i] = MapChatInviteToProto(invite)
+	}
+	return res
+}
+
+func MapProtoToChatInvite(invite *pb.ChatInvite) (models.ChatInvite, error) {
+	chatId, err := uuid.Parse(invite.ChatId)
+	if err != nil {
+		return models.ChatInvite{}, err
+	}
+	creatorId, err := uuid.Parse(invite.CreatorId)
+	if err != nil {
+		return models.ChatInvite{}, err
+	}
+
+	res := models.ChatInvite{
+		Token:      invite.Token,
+		ChatID:     chatId,
+		CreatorID:  creatorId,
+		CreatedAt:  invite.CreatedAt.AsTime(),
+		UsageLimit: int(invite.UsageLimit),
+		UsageCount: int(invite.UsageCount),
+	}
+	if invite.ExpiresAt != nil {
+		expiresAt := invite.ExpiresAt.AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	return res, nil
+}
+
+func MapProtoToChatInvites(invites []*pb.ChatInvite) ([]models.ChatInvite, error) {
+	res := make([]models.ChatInvite, len(invites))
+	for i, invite := range invites {
+		var err error
+		if res[i], err = MapProtoToChatInvite(invite); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func MapChatExportToProto(export models.ChatExport) *pb.ChatExport {
+	return &pb.ChatExport{
+		Id:        export.ID.String(),
+		ChatId:    export.ChatID.String(),
+		Status:    string(export.Status),
+		FileUrl:   export.FileURL,
+		CreatedAt: timestamppb.New(export.CreatedAt),
+		UpdatedAt: timestamppb.New(export.UpdatedAt),
+	}
+}
+
+func MapProtoToChatExport(export *pb.ChatExport) (models.ChatExport, error) {
+	id, err := uuid.Parse(export.Id)
+	if err != nil {
+		return models.ChatExport{}, err
+	}
+	chatId, err := uuid.Parse(export.ChatId)
+	if err != nil {
+		return models.ChatExport{}, err
+	}
+
+	return models.ChatExport{
+		ID:        id,
+		ChatID:    chatId,
+		Status:    models.ChatExportStatus(export.Status),
+		FileURL:   export.FileUrl,
+		CreatedAt: export.CreatedAt.AsTime(),
+		UpdatedAt: export.UpdatedAt.AsTime(),
+	}, nil
 }
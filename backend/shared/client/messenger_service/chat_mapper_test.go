@@ -414,3 +414,67 @@ func TestMapProtoCreationInfoToModel(t *testing.T) {
 		})
 	}
 }
+
+func TestMapChatInvite_RoundTrip(t *testing.T) {
+	createdAt := time.Now().UTC()
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	tests := []struct {
+		name   string
+		invite models.ChatInvite
+	}{
+		{
+			name: "with expiry and usage limit",
+			invite: models.ChatInvite{
+				Token:      "token",
+				ChatID:     uuid.New(),
+				CreatorID:  uuid.New(),
+				CreatedAt:  createdAt,
+				ExpiresAt:  &expiresAt,
+				UsageLimit: 10,
+				UsageCount: 4,
+			},
+		},
+		{
+			name: "unlimited",
+			invite: models.ChatInvite{
+				Token:     "token",
+				ChatID:    uuid.New(),
+				CreatorID: uuid.New(),
+				CreatedAt: createdAt,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protoInvite := MapChatInviteToProto(tt.invite)
+			assert.Equal(t, tt.invite.ExpiresAt == nil, protoInvite.ExpiresAt == nil)
+
+			got, err := MapProtoToChatInvite(protoInvite)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.invite, got)
+		})
+	}
+}
+
+func TestMapProtoToChatInvites_InvalidChatId(t *testing.T) {
+	_, err := MapProtoToChatInvites([]*pb.ChatInvite{{Token: "token", ChatId: "invalid"}})
+	assert.Error(t, err)
+}
+
+func TestMapChatExport_RoundTrip(t *testing.T) {
+	now := time.Now().UTC()
+	export := models.ChatExport{
+		ID:        uuid.New(),
+		ChatID:    uuid.New(),
+		Status:    models.ChatExportReady,
+		FileURL:   "https://files/export.zip",
+		CreatedAt: now.Add(-time.Minute),
+		UpdatedAt: now,
+	}
+
+	got, err := MapProtoToChatExport(MapChatExportToProto(export))
+	assert.NoError(t, err)
+	assert.Equal(t, export, got)
+}
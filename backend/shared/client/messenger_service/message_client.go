@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	poll_mapper "quickflow/shared/client/poll"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
@@ -46,8 +47,61 @@ func (c *MessageServiceClient) GetMessagesForChat(ctx context.Context, chatID uu
 		logger.Error(ctx, "Failed to get messages for chat: %v", err)
 		return nil, err
 	}
-	messages := make([]*models.Message, 0, len(resp.Messages))
-	for _, m := range resp.Messages {
+	return mapProtoToMessages(ctx, resp.Messages)
+}
+
+// GetMessagesForChatNewer returns messages created after the timestamp, the oldest first
+func (c *MessageServiceClient) GetMessagesForChatNewer(ctx context.Context, chatID uuid.UUID, num int, after time.Time, userId uuid.UUID) ([]*models.Message, error) {
+	logger.Info(ctx, "Getting newer messages for chatId: %s", chatID.String())
+	resp, err := c.client.GetMessagesForChat(ctx, &pb.GetMessagesForChatRequest{
+		ChatId:      chatID.String(),
+		MessagesNum: int32(num),
+		After:       timestamppb.New(after),
+		UserAuthId:  userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get newer messages for chat: %v", err)
+		return nil, err
+	}
+	return mapProtoToMessages(ctx, resp.Messages)
+}
+
+// GetMessagesAround returns the anchor message with num messages on each side of it
+func (c *MessageServiceClient) GetMessagesAround(ctx context.Context, chatID, anchorId uuid.UUID, num int, userId uuid.UUID) (*models.MessagesPage, error) {
+	logger.Info(ctx, "Getting messages for chatId: %s around %s", chatID.String(), anchorId.String())
+	resp, err := c.client.GetMessagesForChat(ctx, &pb.GetMessagesForChatRequest{
+		ChatId:      chatID.String(),
+		MessagesNum: int32(num),
+		AnchorId:    anchorId.String(),
+		UserAuthId:  userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get messages around anchor: %v", err)
+		return nil, err
+	}
+
+	messages, err := mapProtoToMessages(ctx, resp.Messages)
+	if err != nil {
+		return nil, err
+	}
+	page := &models.MessagesPage{Messages: make([]models.Message, len(messages))}
+	for i := range messages {
+		page.Messages[i] = *messages[i]
+	}
+	if resp.OlderCursor != nil {
+		olderCursor := resp.OlderCursor.AsTime()
+		page.OlderCursor = &olderCursor
+	}
+	if resp.NewerCursor != nil {
+		newerCursor := resp.NewerCursor.AsTime()
+		page.NewerCursor = &newerCursor
+	}
+	return page, nil
+}
+
+func mapProtoToMessages(ctx context.Context, protoMessages []*pb.Message) ([]*models.Message, error) {
+	messages := make([]*models.Message, 0, len(protoMessages))
+	for _, m := range protoMessages {
 		msg, err := MapProtoToMessage(m)
 		if err != nil {
 			logger.Error(ctx, "Failed to convert message to proto: %v", err)
@@ -68,21 +122,318 @@ func (c *MessageServiceClient) GetMessageById(ctx context.Context, msgID uuid.UU
 	return MapProtoToMessage(resp.Message)
 }
 
-func (c *MessageServiceClient) DeleteMessage(ctx context.Context, msgID uuid.UUID) error {
+// UpdateMessage edits message on behalf of the user and returns updated message
+// and sequence number of the edit in the chat
+func (c *MessageServiceClient) UpdateMessage(ctx context.Context, msg *models.Message, userId uuid.UUID) (*models.Message, int64, error) {
+	logger.Info(ctx, "Updating message: %s", msg.ID.String())
+	resp, err := c.client.UpdateMessage(ctx, &pb.UpdateMessageRequest{Message: MapMessageToProto(*msg), UserAuthId: userId.String()})
+	if err != nil {
+		logger.Error(ctx, "Failed to update message: %v", err)
+		return nil, 0, err
+	}
+
+	message, err := MapProtoToMessage(resp.Message)
+	if err != nil {
+		return nil, 0, err
+	}
+	return message, resp.Seq, nil
+}
+
+// ForwardMessages copies messages on behalf of the user to the chat,
+// or to the private chat with the receiver if chatId is empty
+func (c *MessageServiceClient) ForwardMessages(ctx context.Context, messageIds []uuid.UUID, chatId, receiverId, userId uuid.UUID) ([]*models.Message, error) {
+	logger.Info(ctx, "Forwarding %d messages", len(messageIds))
+	ids := make([]string, 0, len(messageIds))
+	for _, id := range messageIds {
+		ids = append(ids, id.String())
+	}
+
+	req := &pb.ForwardMessagesRequest{MessageIds: ids, UserAuthId: userId.String()}
+	if chatId != uuid.Nil {
+		req.ChatId = chatId.String()
+	}
+	if receiverId != uuid.Nil {
+		req.ReceiverId = receiverId.String()
+	}
+
+	resp, err := c.client.ForwardMessages(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to forward messages: %v", err)
+		return nil, err
+	}
+
+	messages := make([]*models.Message, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		msg, err := MapProtoToMessage(m)
+		if err != nil {
+			logger.Error(ctx, "Failed to convert message from proto: %v", err)
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// AddReaction adds reaction of the user to the message and returns chat of the message
+// and sequence number of the reaction in the chat
+func (c *MessageServiceClient) AddReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error) {
+	logger.Info(ctx, "Adding reaction to message: %s", messageId.String())
+	resp, err := c.client.AddReaction(ctx, &pb.AddReactionRequest{
+		MessageId:  messageId.String(),
+		Emoji:      emoji,
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to add reaction: %v", err)
+		return uuid.Nil, 0, err
+	}
+
+	chatId, err := uuid.Parse(resp.ChatId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	return chatId, resp.Seq, nil
+}
+
+// RemoveReaction removes reaction of the user from the message and returns chat of the message
+// and sequence number of the removal in the chat
+func (c *MessageServiceClient) RemoveReaction(ctx context.Context, messageId uuid.UUID, emoji string, userId uuid.UUID) (uuid.UUID, int64, error) {
+	logger.Info(ctx, "Removing reaction from message: %s", messageId.String())
+	resp, err := c.client.RemoveReaction(ctx, &pb.RemoveReactionRequest{
+		MessageId:  messageId.String(),
+		Emoji:      emoji,
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to remove reaction: %v", err)
+		return uuid.Nil, 0, err
+	}
+
+	chatId, err := uuid.Parse(resp.ChatId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	return chatId, resp.Seq, nil
+}
+
+// PinMessage pins the message in its chat and returns chat of the message
+// and sequence number of the pin in the chat
+func (c *MessageServiceClient) PinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	logger.Info(ctx, "Pinning message: %s", messageId.String())
+	resp, err := c.client.PinMessage(ctx, &pb.PinMessageRequest{
+		MessageId:  messageId.String(),
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to pin message: %v", err)
+		return uuid.Nil, 0, err
+	}
+
+	chatId, err := uuid.Parse(resp.ChatId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	return chatId, resp.Seq, nil
+}
+
+// UnpinMessage unpins the message in its chat and returns chat of the message
+// and sequence number of the unpin in the chat
+func (c *MessageServiceClient) UnpinMessage(ctx context.Context, messageId, userId uuid.UUID) (uuid.UUID, int64, error) {
+	logger.Info(ctx, "Unpinning message: %s", messageId.String())
+	resp, err := c.client.UnpinMessage(ctx, &pb.UnpinMessageRequest{
+		MessageId:  messageId.String(),
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to unpin message: %v", err)
+		return uuid.Nil, 0, err
+	}
+
+	chatId, err := uuid.Parse(resp.ChatId)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	return chatId, resp.Seq, nil
+}
+
+// VotePoll replaces the vote of the user in the message poll and returns the message with updated results
+func (c *MessageServiceClient) VotePoll(ctx context.Context, messageId uuid.UUID, options []int, userId uuid.UUID) (*models.Message, error) {
+	logger.Info(ctx, "Voting in poll of message: %s", messageId.String())
+	resp, err := c.client.VotePoll(ctx, &pb.VotePollRequest{
+		MessageId:  messageId.String(),
+		Options:    poll_mapper.OptionsToProto(options),
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to vote in poll: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessage(resp.Message)
+}
+
+// SearchMessages finds messages of the user chats by text, the newest first
+func (c *MessageServiceClient) SearchMessages(ctx context.Context, userId uuid.UUID, query models.MessageSearchQuery) ([]models.MessageSearchResult, error) {
+	logger.Info(ctx, "Searching messages of user: %s", userId.String())
+	req := &pb.SearchMessagesRequest{
+		UserAuthId: userId.String(),
+		Query:      query.Text,
+		Limit:      int32(query.Limit),
+	}
+	if query.ChatID != uuid.Nil {
+		req.ChatId = query.ChatID.String()
+	}
+	if query.From != nil {
+		req.From = timestamppb.New(*query.From)
+	}
+	if query.To != nil {
+		req.To = timestamppb.New(*query.To)
+	}
+
+	resp, err := c.client.SearchMessages(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to search messages: %v", err)
+		return nil, err
+	}
+
+	return MapProtoToMessageSearchResults(resp.Results)
+}
+
+// ScheduleMessage saves the message to be sent at its send time
+func (c *MessageServiceClient) ScheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error) {
+	logger.Info(ctx, "Scheduling message to chat %s at %s", scheduled.Message.ChatID.String(), scheduled.SendAt.String())
+	resp, err := c.client.ScheduleMessage(ctx, &pb.ScheduleMessageRequest{
+		ScheduledMessage: MapScheduledMessageToProto(*scheduled),
+		UserAuthId:       userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to schedule message: %v", err)
+		return nil, err
+	}
+	return MapProtoToScheduledMessage(resp.ScheduledMessage)
+}
+
+// GetScheduledMessages returns messages the user scheduled in the chat
+func (c *MessageServiceClient) GetScheduledMessages(ctx context.Context, chatId, userId uuid.UUID) ([]models.ScheduledMessage, error) {
+	logger.Info(ctx, "Getting scheduled messages for chatId: %s", chatId.String())
+	resp, err := c.client.GetScheduledMessages(ctx, &pb.GetScheduledMessagesRequest{
+		ChatId:     chatId.String(),
+		UserAuthId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get scheduled messages: %v", err)
+		return nil, err
+	}
+	return MapProtoToScheduledMessages(resp.ScheduledMessages)
+}
+
+func (c *MessageServiceClient) UpdateScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, userId uuid.UUID) (*models.ScheduledMessage, error) {
+	logger.Info(ctx, "Updating scheduled message: %s", scheduled.Message.ID.String())
+	resp, err := c.client.UpdateScheduledMessage(ctx, &pb.UpdateScheduledMessageRequest{
+		ScheduledMessage: MapScheduledMessageToProto(*scheduled),
+		UserAuthId:       userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to update scheduled message: %v", err)
+		return nil, err
+	}
+	return MapProtoToScheduledMessage(resp.ScheduledMessage)
+}
+
+func (c *MessageServiceClient) CancelScheduledMessage(ctx context.Context, id, userId uuid.UUID) error {
+	logger.Info(ctx, "Cancelling scheduled message: %s", id.String())
+	_, err := c.client.CancelScheduledMessage(ctx, &pb.CancelScheduledMessageRequest{
+		ScheduledMessageId: id.String(),
+		UserAuthId:         userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to cancel scheduled message: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteMessage deletes message on behalf of the user and returns sequence number of the deletion in the chat
+func (c *MessageServiceClient) DeleteMessage(ctx context.Context, msgID, userAuthId uuid.UUID) (int64, error) {
 	logger.Info(ctx, "Deleting message: %s", msgID.String())
-	_, err := c.client.DeleteMessage(ctx, &pb.DeleteMessageRequest{MessageId: msgID.String()})
-	return err
+	resp, err := c.client.DeleteMessage(ctx, &pb.DeleteMessageRequest{
+		MessageId:  msgID.String(),
+		UserAuthId: userAuthId.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Seq, nil
 }
 
-func (c *MessageServiceClient) UpdateLastReadTs(ctx context.Context, chatID, userID uuid.UUID, ts time.Time, userAuthId uuid.UUID) error {
+// UpdateLastReadTs marks chat as read and returns sequence number of the read event in the chat
+func (c *MessageServiceClient) UpdateLastReadTs(ctx context.Context, chatID, userID, messageID uuid.UUID, ts time.Time, userAuthId uuid.UUID) (int64, error) {
 	logger.Info(ctx, "Updating last read timestamp for chatId: %s", chatID.String())
-	_, err := c.client.UpdateLastReadTs(ctx, &pb.UpdateLastReadTsRequest{
+	resp, err := c.client.UpdateLastReadTs(ctx, &pb.UpdateLastReadTsRequest{
 		ChatId:            chatID.String(),
 		UserId:            userID.String(),
 		LastReadTimestamp: timestamppb.New(ts),
 		UserAuthId:        userAuthId.String(),
+		MessageId:         messageID.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Seq, nil
+}
+
+// UpdateLastDeliveredTs records that messages of the chat up to the timestamp reached the user.
+// Returns false if the user already got a message that is not older.
+func (c *MessageServiceClient) UpdateLastDeliveredTs(ctx context.Context, chatID uuid.UUID, ts time.Time, userAuthId uuid.UUID) (bool, error) {
+	resp, err := c.client.UpdateLastDeliveredTs(ctx, &pb.UpdateLastDeliveredTsRequest{
+		ChatId:                 chatID.String(),
+		LastDeliveredTimestamp: timestamppb.New(ts),
+		UserAuthId:             userAuthId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to update last delivered timestamp: %v", err)
+		return false, err
+	}
+	return resp.Updated, nil
+}
+
+// GetMessageReceipts returns when other participants got and read the message
+func (c *MessageServiceClient) GetMessageReceipts(ctx context.Context, messageID, userAuthId uuid.UUID) ([]models.MessageReceipt, error) {
+	logger.Info(ctx, "Getting receipts of message: %s", messageID.String())
+	resp, err := c.client.GetMessageReceipts(ctx, &pb.GetMessageReceiptsRequest{
+		MessageId:  messageID.String(),
+		UserAuthId: userAuthId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get message receipts: %v", err)
+		return nil, err
+	}
+	return MapProtoToMessageReceipts(resp.Receipts)
+}
+
+// GetChatEventsSince returns up to limit chat events with sequence number greater than seq
+func (c *MessageServiceClient) GetChatEventsSince(ctx context.Context, chatID uuid.UUID, seq int64, limit int, userAuthId uuid.UUID) ([]models.ChatEvent, error) {
+	logger.Info(ctx, "Getting events for chatId: %s since %d", chatID.String(), seq)
+	resp, err := c.client.GetChatEventsSince(ctx, &pb.GetChatEventsSinceRequest{
+		ChatId:     chatID.String(),
+		Seq:        seq,
+		Limit:      int32(limit),
+		UserAuthId: userAuthId.String(),
 	})
-	return err
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat events: %v", err)
+		return nil, err
+	}
+
+	events := make([]models.ChatEvent, 0, len(resp.Events))
+	for _, e := range resp.Events {
+		event, err := MapProtoToChatEvent(e)
+		if err != nil {
+			logger.Error(ctx, "Failed to convert chat event from proto: %v", err)
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
 }
 
 func (c *MessageServiceClient) GetLastReadTs(ctx context.Context, chatID, userID uuid.UUID) (time.Time, error) {
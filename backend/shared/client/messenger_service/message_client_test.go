@@ -16,6 +16,7 @@ import (
 
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
+	poll_pb "quickflow/shared/proto/poll"
 )
 
 func TestMessageServiceClient_SendMessage(t *testing.T) {
@@ -289,6 +290,7 @@ func TestMessageServiceClient_DeleteMessage(t *testing.T) {
 
 	ctx := context.Background()
 	msgID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name        string
@@ -300,8 +302,9 @@ func TestMessageServiceClient_DeleteMessage(t *testing.T) {
 			name: "success",
 			setup: func() {
 				mockClient.EXPECT().DeleteMessage(ctx, &pb.DeleteMessageRequest{
-					MessageId: msgID.String(),
-				}).Return(&pb.DeleteMessageResponse{}, nil)
+					MessageId:  msgID.String(),
+					UserAuthId: userID.String(),
+				}).Return(&pb.DeleteMessageResponse{Success: true, Seq: 3}, nil)
 			},
 			inputID: msgID,
 		},
@@ -319,17 +322,217 @@ func TestMessageServiceClient_DeleteMessage(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := client.DeleteMessage(ctx, tt.inputID)
+			seq, err := client.DeleteMessage(ctx, tt.inputID, userID)
 
 			if tt.expectError {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, int64(3), seq)
 			}
 		})
 	}
 }
 
+func TestMessageServiceClient_PinMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	msgID := uuid.New()
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mockClient.EXPECT().PinMessage(ctx, &pb.PinMessageRequest{
+		MessageId:  msgID.String(),
+		UserAuthId: userID.String(),
+	}).Return(&pb.PinMessageResponse{ChatId: chatID.String(), Seq: 8}, nil)
+
+	gotChatID, seq, err := client.PinMessage(ctx, msgID, userID)
+	require.NoError(t, err)
+	require.Equal(t, chatID, gotChatID)
+	require.Equal(t, int64(8), seq)
+
+	mockClient.EXPECT().UnpinMessage(ctx, gomock.Any()).Return(nil, errors.New("unpin error"))
+
+	_, _, err = client.UnpinMessage(ctx, msgID, userID)
+	require.Error(t, err)
+}
+
+func TestMessageServiceClient_VotePoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	msgID := uuid.New()
+	chatID := uuid.New()
+	userID := uuid.New()
+	pollID := uuid.New()
+
+	mockClient.EXPECT().VotePoll(ctx, &pb.VotePollRequest{
+		MessageId:  msgID.String(),
+		Options:    []int32{0, 2},
+		UserAuthId: userID.String(),
+	}).Return(&pb.VotePollResponse{Message: &pb.Message{
+		Id:       msgID.String(),
+		ChatId:   chatID.String(),
+		SenderId: userID.String(),
+		Poll: &poll_pb.Poll{
+			Id:             pollID.String(),
+			Options:        []*poll_pb.PollOption{{Text: "a", VoteCount: 1}, {Text: "b"}, {Text: "c", VoteCount: 1}},
+			MultipleChoice: true,
+			VoterCount:     1,
+			Voted:          []int32{0, 2},
+		},
+	}}, nil)
+
+	message, err := client.VotePoll(ctx, msgID, []int{0, 2}, userID)
+	require.NoError(t, err)
+	require.Equal(t, chatID, message.ChatID)
+	require.Equal(t, pollID, message.Poll.ID)
+	require.Equal(t, []int{0, 2}, message.Poll.Voted)
+
+	mockClient.EXPECT().VotePoll(ctx, gomock.Any()).Return(nil, errors.New("poll is closed"))
+
+	_, err = client.VotePoll(ctx, msgID, []int{1}, userID)
+	require.Error(t, err)
+}
+
+func TestMessageServiceClient_GetMessagesAround(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	chatID := uuid.New()
+	anchorID := uuid.New()
+	userID := uuid.New()
+	now := time.Now()
+
+	mockClient.EXPECT().GetMessagesForChat(ctx, &pb.GetMessagesForChatRequest{
+		ChatId:      chatID.String(),
+		MessagesNum: 20,
+		AnchorId:    anchorID.String(),
+		UserAuthId:  userID.String(),
+	}).Return(&pb.GetMessagesForChatResponse{
+		Messages: []*pb.Message{{
+			Id:        anchorID.String(),
+			ChatId:    chatID.String(),
+			SenderId:  userID.String(),
+			CreatedAt: timestamppb.New(now),
+			UpdatedAt: timestamppb.New(now),
+		}},
+		NewerCursor: timestamppb.New(now),
+	}, nil)
+
+	page, err := client.GetMessagesAround(ctx, chatID, anchorID, 20, userID)
+	require.NoError(t, err)
+	require.Len(t, page.Messages, 1)
+	require.Equal(t, anchorID, page.Messages[0].ID)
+	require.Nil(t, page.OlderCursor)
+	require.NotNil(t, page.NewerCursor)
+	require.True(t, now.Equal(*page.NewerCursor))
+
+	mockClient.EXPECT().GetMessagesForChat(ctx, gomock.Any()).Return(nil, errors.New("newer error"))
+
+	_, err = client.GetMessagesForChatNewer(ctx, chatID, 20, now, userID)
+	require.Error(t, err)
+}
+
+func TestMessageServiceClient_SearchMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	msgID := uuid.New()
+	to := time.Now()
+
+	mockClient.EXPECT().SearchMessages(ctx, &pb.SearchMessagesRequest{
+		UserAuthId: userID.String(),
+		Query:      "report",
+		ChatId:     chatID.String(),
+		To:         timestamppb.New(to),
+		Limit:      20,
+	}).Return(&pb.SearchMessagesResponse{Results: []*pb.MessageSearchResult{{
+		Message: &pb.Message{
+			Id:        msgID.String(),
+			ChatId:    chatID.String(),
+			SenderId:  userID.String(),
+			Text:      "the report is ready",
+			CreatedAt: timestamppb.New(to.Add(-time.Minute)),
+			UpdatedAt: timestamppb.New(to.Add(-time.Minute)),
+		},
+		Snippet: "the report",
+	}}}, nil)
+
+	results, err := client.SearchMessages(ctx, userID, models.MessageSearchQuery{Text: "report", ChatID: chatID, To: &to, Limit: 20})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, msgID, results[0].Message.ID)
+	require.Equal(t, "the report", results[0].Snippet)
+}
+
+func TestMessageServiceClient_ScheduledMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	now := time.Now().UTC()
+	scheduled := models.ScheduledMessage{
+		Message: models.Message{ID: uuid.New(), ChatID: chatID, SenderID: userID, Text: "later", CreatedAt: now, UpdatedAt: now},
+		SendAt:  now.Add(time.Hour),
+	}
+
+	mockClient.EXPECT().ScheduleMessage(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *pb.ScheduleMessageRequest, _ ...grpc.CallOption) (*pb.ScheduleMessageResponse, error) {
+			require.Equal(t, userID.String(), req.UserAuthId)
+			require.True(t, scheduled.SendAt.Equal(req.ScheduledMessage.SendAt.AsTime()))
+			return &pb.ScheduleMessageResponse{ScheduledMessage: req.ScheduledMessage}, nil
+		})
+
+	got, err := client.ScheduleMessage(ctx, &scheduled, userID)
+	require.NoError(t, err)
+	require.Equal(t, scheduled.Message.ID, got.Message.ID)
+	require.True(t, scheduled.SendAt.Equal(got.SendAt))
+
+	mockClient.EXPECT().GetScheduledMessages(ctx, &pb.GetScheduledMessagesRequest{
+		ChatId:     chatID.String(),
+		UserAuthId: userID.String(),
+	}).Return(&pb.GetScheduledMessagesResponse{
+		ScheduledMessages: []*pb.ScheduledMessage{MapScheduledMessageToProto(scheduled)},
+	}, nil)
+
+	list, err := client.GetScheduledMessages(ctx, chatID, userID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "later", list[0].Message.Text)
+
+	mockClient.EXPECT().CancelScheduledMessage(ctx, &pb.CancelScheduledMessageRequest{
+		ScheduledMessageId: scheduled.Message.ID.String(),
+		UserAuthId:         userID.String(),
+	}).Return(nil, errors.New("cancel error"))
+
+	require.Error(t, client.CancelScheduledMessage(ctx, scheduled.Message.ID, userID))
+}
+
 func TestMessageServiceClient_UpdateLastReadTs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -340,6 +543,7 @@ func TestMessageServiceClient_UpdateLastReadTs(t *testing.T) {
 	ctx := context.Background()
 	chatID := uuid.New()
 	userID := uuid.New()
+	messageID := uuid.New()
 	authID := uuid.New()
 	now := time.Now()
 
@@ -348,6 +552,7 @@ func TestMessageServiceClient_UpdateLastReadTs(t *testing.T) {
 		setup       func()
 		chatID      uuid.UUID
 		userID      uuid.UUID
+		messageID   uuid.UUID
 		ts          time.Time
 		authID      uuid.UUID
 		expectError bool
@@ -360,12 +565,14 @@ func TestMessageServiceClient_UpdateLastReadTs(t *testing.T) {
 					UserId:            userID.String(),
 					LastReadTimestamp: timestamppb.New(now),
 					UserAuthId:        authID.String(),
-				}).Return(&pb.UpdateLastReadTsResponse{}, nil)
+					MessageId:         messageID.String(),
+				}).Return(&pb.UpdateLastReadTsResponse{Success: true, Seq: 4}, nil)
 			},
-			chatID: chatID,
-			userID: userID,
-			ts:     now,
-			authID: authID,
+			chatID:    chatID,
+			userID:    userID,
+			messageID: messageID,
+			ts:        now,
+			authID:    authID,
 		},
 		{
 			name: "error",
@@ -384,12 +591,13 @@ func TestMessageServiceClient_UpdateLastReadTs(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := client.UpdateLastReadTs(ctx, tt.chatID, tt.userID, tt.ts, tt.authID)
+			seq, err := client.UpdateLastReadTs(ctx, tt.chatID, tt.userID, tt.messageID, tt.ts, tt.authID)
 
 			if tt.expectError {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, int64(4), seq)
 			}
 		})
 	}
@@ -526,3 +734,35 @@ type MockClientConnInterface struct {
 func NewMockClientConnInterface(ctrl *gomock.Controller) *MockClientConnInterface {
 	return &MockClientConnInterface{}
 }
+
+func TestMessageServiceClient_GetMessageReceipts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockMessageServiceClient(ctrl)
+	client := &MessageServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	msgID := uuid.New()
+	userID := uuid.New()
+	readerID := uuid.New()
+	readAt := time.Now().UTC()
+
+	mockClient.EXPECT().GetMessageReceipts(ctx, &pb.GetMessageReceiptsRequest{
+		MessageId:  msgID.String(),
+		UserAuthId: userID.String(),
+	}).Return(&pb.GetMessageReceiptsResponse{Receipts: []*pb.MessageReceipt{{
+		UserId:      readerID.String(),
+		DeliveredAt: timestamppb.New(readAt),
+		ReadAt:      timestamppb.New(readAt),
+	}}}, nil)
+
+	receipts, err := client.GetMessageReceipts(ctx, msgID, userID)
+	require.NoError(t, err)
+	require.Equal(t, []models.MessageReceipt{{UserID: readerID, DeliveredAt: &readAt, ReadAt: &readAt}}, receipts)
+
+	mockClient.EXPECT().UpdateLastDeliveredTs(ctx, gomock.Any()).Return(nil, errors.New("delivered error"))
+
+	_, err = client.UpdateLastDeliveredTs(ctx, uuid.New(), readAt, userID)
+	require.Error(t, err)
+}
@@ -1,27 +1,178 @@
 package messenger_service
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"quickflow/shared/client/file_service"
+	poll_mapper "quickflow/shared/client/poll"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
 )
 
 func MapMessageToProto(message models.Message) *pb.Message {
+	var editedAt *timestamppb.Timestamp
+	if message.EditedAt != nil {
+		editedAt = timestamppb.New(*message.EditedAt)
+	}
+
+	var replyToId string
+	if message.ReplyToID != uuid.Nil {
+		replyToId = message.ReplyToID.String()
+	}
+
 	return &pb.Message{
-		Id:          message.ID.String(),
-		SenderId:    message.SenderID.String(),
-		ChatId:      message.ChatID.String(),
-		Text:        message.Text,
-		CreatedAt:   timestamppb.New(message.CreatedAt),
-		UpdatedAt:   timestamppb.New(message.UpdatedAt),
-		Attachments: file_service.ModelFilesToProto(message.Attachments),
-		ReceiverId:  message.ReceiverID.String(),
+		Id:            message.ID.String(),
+		SenderId:      message.SenderID.String(),
+		ChatId:        message.ChatID.String(),
+		Text:          message.Text,
+		CreatedAt:     timestamppb.New(message.CreatedAt),
+		UpdatedAt:     timestamppb.New(message.UpdatedAt),
+		Attachments:   file_service.ModelFilesToProto(message.Attachments),
+		ReceiverId:    message.ReceiverID.String(),
+		Seq:           message.Seq,
+		EditedAt:      editedAt,
+		ReplyToId:     replyToId,
+		ReplyTo:       MapMessagePreviewToProto(message.ReplyTo),
+		ForwardedFrom: MapForwardedFromToProto(message.ForwardedFrom),
+		Reactions:     MapReactionCountsToProto(message.Reactions),
+		System:        MapSystemInfoToProto(message.System),
+		Encrypted:     MapEncryptedContentToProto(message.Encrypted),
+		Poll:          poll_mapper.ModelPollToProto(message.Poll),
+	}
+}
+
+func MapEncryptedContentToProto(encrypted *models.EncryptedContent) *pb.EncryptedContent {
+	if encrypted == nil {
+		return nil
+	}
+	res := &pb.EncryptedContent{
+		Ciphertext: encrypted.Ciphertext,
+		Envelopes:  make([]*pb.KeyEnvelope, len(encrypted.Envelopes)),
+	}
+	for i, envelope := range encrypted.Envelopes {
+		res.Envelopes[i] = &pb.KeyEnvelope{
+			RecipientId: envelope.RecipientID.String(),
+			DeviceId:    envelope.DeviceID,
+			Key:         envelope.Key,
+		}
+	}
+	return res
+}
+
+func MapProtoToEncryptedContent(encrypted *pb.EncryptedContent) (*models.EncryptedContent, error) {
+	if encrypted == nil {
+		return nil, nil
+	}
+	res := &models.EncryptedContent{Ciphertext: encrypted.Ciphertext}
+	for _, envelope := range encrypted.Envelopes {
+		recipientId, err := uuid.Parse(envelope.RecipientId)
+		if err != nil {
+			return nil, err
+		}
+		res.Envelopes = append(res.Envelopes, models.KeyEnvelope{
+			RecipientID: recipientId,
+			DeviceID:    envelope.DeviceId,
+			Key:         envelope.Key,
+		})
+	}
+	return res, nil
+}
+
+// MapSystemInfoToProto sends empty user id for changes that are not about a member
+func MapSystemInfoToProto(system *models.SystemInfo) *pb.SystemInfo {
+	if system == nil {
+		return nil
+	}
+	res := &pb.SystemInfo{Action: string(system.Action)}
+	if system.UserID != uuid.Nil {
+		res.UserId = system.UserID.String()
+	}
+	return res
+}
+
+func MapReactionCountsToProto(reactions []models.ReactionCount) []*pb.ReactionCount {
+	if len(reactions) == 0 {
+		return nil
+	}
+	res := make([]*pb.ReactionCount, len(reactions))
+	for i, reaction := range reactions {
+		res[i] = &pb.ReactionCount{
+			Emoji:   reaction.Emoji,
+			Count:   int32(reaction.Count),
+			Reacted: reaction.Reacted,
+		}
+	}
+	return res
+}
+
+func MapProtoToReactionCounts(reactions []*pb.ReactionCount) []models.ReactionCount {
+	if len(reactions) == 0 {
+		return nil
+	}
+	res := make([]models.ReactionCount, len(reactions))
+	for i, reaction := range reactions {
+		res[i] = models.ReactionCount{
+			Emoji:   reaction.Emoji,
+			Count:   int(reaction.Count),
+			Reacted: reaction.Reacted,
+		}
+	}
+	return res
+}
+
+func MapForwardedFromToProto(forwardedFrom *models.ForwardedFrom) *pb.ForwardedFrom {
+	if forwardedFrom == nil {
+		return nil
+	}
+	return &pb.ForwardedFrom{
+		SenderId: forwardedFrom.SenderID.String(),
+		ChatId:   forwardedFrom.ChatID.String(),
+	}
+}
+
+func MapMessagePreviewToProto(preview *models.MessagePreview) *pb.MessagePreview {
+	if preview == nil {
+		return nil
+	}
+	return &pb.MessagePreview{
+		Id:             preview.ID.String(),
+		SenderId:       preview.SenderID.String(),
+		Text:           preview.Text,
+		AttachmentType: string(preview.AttachmentType),
+		Deleted:        preview.Deleted,
 	}
 }
 
+func MapPinnedMessagesToProto(pinned []models.PinnedMessage) []*pb.PinnedMessage {
+	if len(pinned) == 0 {
+		return nil
+	}
+	res := make([]*pb.PinnedMessage, len(pinned))
+	for i := range pinned {
+		res[i] = &pb.PinnedMessage{
+			Message:  MapMessagePreviewToProto(&pinned[i].Message),
+			PinnedBy: pinned[i].PinnedBy.String(),
+			PinnedAt: timestamppb.New(pinned[i].PinnedAt),
+		}
+	}
+	return res
+}
+
+func MapMessageSearchResultsToProto(results []models.MessageSearchResult) []*pb.MessageSearchResult {
+	res := make([]*pb.MessageSearchResult, len(results))
+	for i := range results {
+		res[i] = &pb.MessageSearchResult{
+			Message: MapMessageToProto(results[i].Message),
+			Snippet: results[i].Snippet,
+		}
+	}
+	return res
+}
+
 func MapMessagesToProto(messages []models.Message) []*pb.Message {
 	res := make([]*pb.Message, len(messages))
 	for i, message := range messages {
@@ -55,14 +206,277 @@ func MapProtoToMessage(message *pb.Message) (*models.Message, error) {
 		receiverId = uuid.Nil
 	}
 
+	var editedAt *time.Time
+	if message.EditedAt != nil {
+		t := message.EditedAt.AsTime()
+		editedAt = &t
+	}
+
+	replyToId, err := uuid.Parse(message.ReplyToId)
+	if err != nil {
+		replyToId = uuid.Nil
+	}
+	replyTo, err := MapProtoToMessagePreview(message.ReplyTo)
+	if err != nil {
+		return nil, err
+	}
+	forwardedFrom, err := MapProtoToForwardedFrom(message.ForwardedFrom)
+	if err != nil {
+		return nil, err
+	}
+	system, err := MapProtoToSystemInfo(message.System)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := MapProtoToEncryptedContent(message.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+	poll, err := poll_mapper.ProtoPollToModel(message.Poll)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.Message{
-		ID:          id,
-		SenderID:    senderId,
-		ChatID:      chatId,
-		ReceiverID:  receiverId,
-		Text:        message.Text,
-		CreatedAt:   message.CreatedAt.AsTime(),
-		UpdatedAt:   message.UpdatedAt.AsTime(),
-		Attachments: file_service.ProtoFilesToModels(message.Attachments),
+		ID:            id,
+		SenderID:      senderId,
+		ChatID:        chatId,
+		ReceiverID:    receiverId,
+		Text:          message.Text,
+		CreatedAt:     message.CreatedAt.AsTime(),
+		UpdatedAt:     message.UpdatedAt.AsTime(),
+		Attachments:   file_service.ProtoFilesToModels(message.Attachments),
+		Seq:           message.Seq,
+		EditedAt:      editedAt,
+		ReplyToID:     replyToId,
+		ReplyTo:       replyTo,
+		ForwardedFrom: forwardedFrom,
+		Reactions:     MapProtoToReactionCounts(message.Reactions),
+		System:        system,
+		Encrypted:     encrypted,
+		Poll:          poll,
 	}, nil
 }
+
+func MapProtoToSystemInfo(system *pb.SystemInfo) (*models.SystemInfo, error) {
+	if system == nil {
+		return nil, nil
+	}
+	res := &models.SystemInfo{Action: models.SystemAction(system.Action)}
+	if len(system.UserId) != 0 {
+		userId, err := uuid.Parse(system.UserId)
+		if err != nil {
+			return nil, err
+		}
+		res.UserID = userId
+	}
+	return res, nil
+}
+
+func MapProtoToForwardedFrom(forwardedFrom *pb.ForwardedFrom) (*models.ForwardedFrom, error) {
+	if forwardedFrom == nil {
+		return nil, nil
+	}
+	senderId, err := uuid.Parse(forwardedFrom.SenderId)
+	if err != nil {
+		return nil, err
+	}
+	chatId, err := uuid.Parse(forwardedFrom.ChatId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ForwardedFrom{
+		SenderID: senderId,
+		ChatID:   chatId,
+	}, nil
+}
+
+func MapProtoToMessagePreview(preview *pb.MessagePreview) (*models.MessagePreview, error) {
+	if preview == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(preview.Id)
+	if err != nil {
+		return nil, err
+	}
+	senderId, err := uuid.Parse(preview.SenderId)
+	if err != nil {
+		senderId = uuid.Nil
+	}
+
+	return &models.MessagePreview{
+		ID:             id,
+		SenderID:       senderId,
+		Text:           preview.Text,
+		AttachmentType: models.DisplayType(preview.AttachmentType),
+		Deleted:        preview.Deleted,
+	}, nil
+}
+
+// MapProtoToPinnedMessages skips pins with invalid message preview
+func MapProtoToPinnedMessages(pinned []*pb.PinnedMessage) []models.PinnedMessage {
+	if len(pinned) == 0 {
+		return nil
+	}
+	res := make([]models.PinnedMessage, 0, len(pinned))
+	for _, pin := range pinned {
+		preview, err := MapProtoToMessagePreview(pin.Message)
+		if err != nil || preview == nil {
+			continue
+		}
+		pinnedBy, err := uuid.Parse(pin.PinnedBy)
+		if err != nil {
+			pinnedBy = uuid.Nil
+		}
+		res = append(res, models.PinnedMessage{
+			Message:  *preview,
+			PinnedBy: pinnedBy,
+			PinnedAt: pin.PinnedAt.AsTime(),
+		})
+	}
+	return res
+}
+
+func MapProtoToMessageSearchResults(results []*pb.MessageSearchResult) ([]models.MessageSearchResult, error) {
+	res := make([]models.MessageSearchResult, 0, len(results))
+	for _, result := range results {
+		message, err := MapProtoToMessage(result.Message)
+		if err != nil {
+			return nil, err
+		}
+		if message == nil {
+			continue
+		}
+		res = append(res, models.MessageSearchResult{Message: *message, Snippet: result.Snippet})
+	}
+	return res, nil
+}
+
+func MapChatEventToProto(event models.ChatEvent) *pb.ChatEvent {
+	res := &pb.ChatEvent{
+		Seq:       event.Seq,
+		Type:      string(event.Type),
+		ChatId:    event.ChatID.String(),
+		MessageId: event.MessageID.String(),
+		UserId:    event.UserID.String(),
+		CreatedAt: timestamppb.New(event.CreatedAt),
+		Reaction:  event.Reaction,
+	}
+	if event.Message != nil {
+		res.Message = MapMessageToProto(*event.Message)
+	}
+	return res
+}
+
+func MapChatEventsToProto(events []models.ChatEvent) []*pb.ChatEvent {
+	res := make([]*pb.ChatEvent, len(events))
+	for i, event := range events {
+		res[i] = MapChatEventToProto(event)
+	}
+	return res
+}
+
+func MapProtoToChatEvent(event *pb.ChatEvent) (*models.ChatEvent, error) {
+	chatId, err := uuid.Parse(event.ChatId)
+	if err != nil {
+		return nil, err
+	}
+	messageId, err := uuid.Parse(event.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := uuid.Parse(event.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := MapProtoToMessage(event.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ChatEvent{
+		Seq:       event.Seq,
+		Type:      models.ChatEventType(event.Type),
+		ChatID:    chatId,
+		MessageID: messageId,
+		UserID:    userId,
+		CreatedAt: event.CreatedAt.AsTime(),
+		Message:   message,
+		Reaction:  event.Reaction,
+	}, nil
+}
+
+func MapScheduledMessageToProto(scheduled models.ScheduledMessage) *pb.ScheduledMessage {
+	return &pb.ScheduledMessage{
+		Message: MapMessageToProto(scheduled.Message),
+		SendAt:  timestamppb.New(scheduled.SendAt),
+	}
+}
+
+func MapScheduledMessagesToProto(scheduledMessages []models.ScheduledMessage) []*pb.ScheduledMessage {
+	res := make([]*pb.ScheduledMessage, len(scheduledMessages))
+	for i := range scheduledMessages {
+		res[i] = MapScheduledMessageToProto(scheduledMessages[i])
+	}
+	return res
+}
+
+func MapProtoToScheduledMessage(scheduled *pb.ScheduledMessage) (*models.ScheduledMessage, error) {
+	if scheduled == nil || scheduled.Message == nil {
+		return nil, errors.New("scheduled message is empty")
+	}
+	message, err := MapProtoToMessage(scheduled.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ScheduledMessage{Message: *message, SendAt: scheduled.SendAt.AsTime()}, nil
+}
+
+func MapProtoToScheduledMessages(scheduledMessages []*pb.ScheduledMessage) ([]models.ScheduledMessage, error) {
+	res := make([]models.ScheduledMessage, 0, len(scheduledMessages))
+	for _, scheduled := range scheduledMessages {
+		message, err := MapProtoToScheduledMessage(scheduled)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *message)
+	}
+	return res, nil
+}
+
+func MapMessageReceiptsToProto(receipts []models.MessageReceipt) []*pb.MessageReceipt {
+	res := make([]*pb.MessageReceipt, len(receipts))
+	for i, receipt := range receipts {
+		res[i] = &pb.MessageReceipt{UserId: receipt.UserID.String()}
+		if receipt.DeliveredAt != nil {
+			res[i].DeliveredAt = timestamppb.New(*receipt.DeliveredAt)
+		}
+		if receipt.ReadAt != nil {
+			res[i].ReadAt = timestamppb.New(*receipt.ReadAt)
+		}
+	}
+	return res
+}
+
+func MapProtoToMessageReceipts(receipts []*pb.MessageReceipt) ([]models.MessageReceipt, error) {
+	res := make([]models.MessageReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		userId, err := uuid.Parse(receipt.UserId)
+		if err != nil {
+			return nil, err
+		}
+		mapped := models.MessageReceipt{UserID: userId}
+		if receipt.DeliveredAt != nil {
+			t := receipt.DeliveredAt.AsTime()
+			mapped.DeliveredAt = &t
+		}
+		if receipt.ReadAt != nil {
+			t := receipt.ReadAt.AsTime()
+			mapped.ReadAt = &t
+		}
+		res = append(res, mapped)
+	}
+	return res, nil
+}
@@ -212,3 +212,135 @@ func TestMapProtoToMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMapMessageReplyToProtoAndBack(t *testing.T) {
+	replyToID := uuid.New()
+	message := models.Message{
+		ID:        uuid.New(),
+		SenderID:  uuid.New(),
+		ChatID:    uuid.New(),
+		ReplyToID: replyToID,
+		ReplyTo: &models.MessagePreview{
+			ID:             replyToID,
+			SenderID:       uuid.New(),
+			Text:           "original",
+			AttachmentType: models.DisplayTypeMedia,
+		},
+	}
+
+	protoMessage := MapMessageToProto(message)
+	assert.Equal(t, replyToID.String(), protoMessage.ReplyToId)
+
+	result, err := MapProtoToMessage(protoMessage)
+	require.NoError(t, err)
+	assert.Equal(t, message.ReplyToID, result.ReplyToID)
+	assert.Equal(t, message.ReplyTo, result.ReplyTo)
+
+	// not a reply
+	protoMessage = MapMessageToProto(models.Message{ID: uuid.New(), SenderID: uuid.New(), ChatID: uuid.New()})
+	assert.Empty(t, protoMessage.ReplyToId)
+	assert.Nil(t, protoMessage.ReplyTo)
+
+	result, err = MapProtoToMessage(protoMessage)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, result.ReplyToID)
+	assert.Nil(t, result.ReplyTo)
+}
+
+func TestMapEncryptedMessageToProtoAndBack(t *testing.T) {
+	message := models.Message{
+		ID:       uuid.New(),
+		SenderID: uuid.New(),
+		ChatID:   uuid.New(),
+		Encrypted: &models.EncryptedContent{
+			Ciphertext: []byte("ciphertext"),
+			Envelopes: []models.KeyEnvelope{
+				{RecipientID: uuid.New(), DeviceID: "phone", Key: []byte("key")},
+				{RecipientID: uuid.New(), DeviceID: "laptop", Key: []byte("other key")},
+			},
+		},
+	}
+
+	protoMessage := MapMessageToProto(message)
+	assert.Empty(t, protoMessage.Text)
+	assert.Len(t, protoMessage.Encrypted.Envelopes, 2)
+
+	result, err := MapProtoToMessage(protoMessage)
+	require.NoError(t, err)
+	assert.Equal(t, message.Encrypted, result.Encrypted)
+
+	protoMessage.Encrypted.Envelopes[0].RecipientId = "invalid"
+	_, err = MapProtoToMessage(protoMessage)
+	assert.Error(t, err)
+}
+
+func TestMapPinnedMessagesToProtoAndBack(t *testing.T) {
+	pinned := []models.PinnedMessage{{
+		Message: models.MessagePreview{
+			ID:       uuid.New(),
+			SenderID: uuid.New(),
+			Text:     "meet at 6",
+		},
+		PinnedBy: uuid.New(),
+		PinnedAt: time.Now().UTC(),
+	}}
+
+	protoPinned := MapPinnedMessagesToProto(pinned)
+	require.Len(t, protoPinned, 1)
+	assert.Equal(t, pinned[0].PinnedBy.String(), protoPinned[0].PinnedBy)
+
+	assert.Equal(t, pinned, MapProtoToPinnedMessages(protoPinned))
+
+	// pin with broken preview is skipped
+	protoPinned = append(protoPinned, &pb.PinnedMessage{Message: &pb.MessagePreview{Id: "invalid"}})
+	assert.Len(t, MapProtoToPinnedMessages(protoPinned), 1)
+
+	assert.Nil(t, MapPinnedMessagesToProto(nil))
+}
+
+func TestMapScheduledMessagesToProtoAndBack(t *testing.T) {
+	now := time.Now().UTC()
+	scheduled := []models.ScheduledMessage{{
+		Message: models.Message{
+			ID:        uuid.New(),
+			ChatID:    uuid.New(),
+			SenderID:  uuid.New(),
+			Text:      "С днём рождения!",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		SendAt: now.Add(12 * time.Hour),
+	}}
+
+	protoScheduled := MapScheduledMessagesToProto(scheduled)
+	require.Len(t, protoScheduled, 1)
+	assert.Equal(t, scheduled[0].Message.Text, protoScheduled[0].Message.Text)
+
+	back, err := MapProtoToScheduledMessages(protoScheduled)
+	require.NoError(t, err)
+	require.Len(t, back, 1)
+	assert.Equal(t, scheduled[0].Message.ID, back[0].Message.ID)
+	assert.Equal(t, scheduled[0].SendAt, back[0].SendAt)
+
+	_, err = MapProtoToScheduledMessage(&pb.ScheduledMessage{})
+	assert.Error(t, err)
+}
+
+func TestMapMessageReceiptsToProtoAndBack(t *testing.T) {
+	now := time.Now().UTC()
+	receipts := []models.MessageReceipt{
+		{UserID: uuid.New(), DeliveredAt: &now, ReadAt: &now},
+		{UserID: uuid.New()},
+	}
+
+	protoReceipts := MapMessageReceiptsToProto(receipts)
+	require.Len(t, protoReceipts, 2)
+	assert.Nil(t, protoReceipts[1].ReadAt)
+
+	back, err := MapProtoToMessageReceipts(protoReceipts)
+	require.NoError(t, err)
+	assert.Equal(t, receipts, back)
+
+	_, err = MapProtoToMessageReceipts([]*pb.MessageReceipt{{UserId: "not-a-uuid"}})
+	assert.Error(t, err)
+}
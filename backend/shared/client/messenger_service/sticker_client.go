@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 
+	"quickflow/shared/client/file_service"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
@@ -113,3 +114,119 @@ func (c *StickerServiceClient) GetStickerPackByName(ctx context.Context, packNam
 
 	return stickerPack, nil
 }
+
+func (c *StickerServiceClient) InstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	_, err := c.client.InstallStickerPack(ctx, &pb.InstallStickerPackRequest{
+		UserId: userId.String(),
+		PackId: packId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to install sticker pack: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *StickerServiceClient) UninstallStickerPack(ctx context.Context, userId, packId uuid.UUID) error {
+	_, err := c.client.UninstallStickerPack(ctx, &pb.UninstallStickerPackRequest{
+		UserId: userId.String(),
+		PackId: packId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to uninstall sticker pack: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *StickerServiceClient) GetUserStickerPacks(ctx context.Context, userId uuid.UUID) ([]*models.StickerPack, error) {
+	resp, err := c.client.GetUserStickerPacks(ctx, &pb.GetUserStickerPacksRequest{
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get user sticker packs: %v", err)
+		return nil, err
+	}
+
+	var stickerPacks []*models.StickerPack
+	for _, pack := range resp.StickerPacks {
+		stickerPack, err := MapProtoToStickerPack(pack)
+		if err != nil {
+			logger.Error(ctx, "Failed to convert Proto to StickerPack: %v", err)
+			return nil, err
+		}
+		stickerPacks = append(stickerPacks, stickerPack)
+	}
+
+	return stickerPacks, nil
+}
+
+func (c *StickerServiceClient) ReorderUserStickerPacks(ctx context.Context, userId uuid.UUID, packIds []uuid.UUID) error {
+	ids := make([]string, len(packIds))
+	for i, id := range packIds {
+		ids[i] = id.String()
+	}
+
+	_, err := c.client.ReorderUserStickerPacks(ctx, &pb.ReorderUserStickerPacksRequest{
+		UserId:  userId.String(),
+		PackIds: ids,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to reorder user sticker packs: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *StickerServiceClient) GetRecentStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	resp, err := c.client.GetRecentStickers(ctx, &pb.GetRecentStickersRequest{
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get recent stickers: %v", err)
+		return nil, err
+	}
+
+	return file_service.ProtoFilesToModels(resp.Stickers), nil
+}
+
+func (c *StickerServiceClient) AddFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	_, err := c.client.AddFavouriteSticker(ctx, &pb.AddFavouriteStickerRequest{
+		UserId:     userId.String(),
+		StickerUrl: stickerURL,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to add favourite sticker: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *StickerServiceClient) RemoveFavouriteSticker(ctx context.Context, userId uuid.UUID, stickerURL string) error {
+	_, err := c.client.RemoveFavouriteSticker(ctx, &pb.RemoveFavouriteStickerRequest{
+		UserId:     userId.String(),
+		StickerUrl: stickerURL,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to remove favourite sticker: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *StickerServiceClient) GetFavouriteStickers(ctx context.Context, userId uuid.UUID) ([]*models.File, error) {
+	resp, err := c.client.GetFavouriteStickers(ctx, &pb.GetFavouriteStickersRequest{
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get favourite stickers: %v", err)
+		return nil, err
+	}
+
+	return file_service.ProtoFilesToModels(resp.Stickers), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"quickflow/shared/client/file_service"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/messenger_service"
 	"quickflow/shared/proto/messenger_service/mocks"
@@ -440,3 +441,52 @@ func TestGetStickerPackByName(t *testing.T) {
 		})
 	}
 }
+
+func TestReorderUserStickerPacks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockStickerServiceClient(ctrl)
+	client := &StickerServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	userId := uuid.New()
+	packIds := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mockClient.EXPECT().
+		ReorderUserStickerPacks(ctx, &pb.ReorderUserStickerPacksRequest{
+			UserId:  userId.String(),
+			PackIds: []string{packIds[0].String(), packIds[1].String()},
+		}).
+		Return(&pb.ReorderUserStickerPacksResponse{Success: true}, nil)
+	mockClient.EXPECT().
+		ReorderUserStickerPacks(ctx, gomock.Any()).
+		Return(nil, errors.New("grpc error"))
+
+	assert.NoError(t, client.ReorderUserStickerPacks(ctx, userId, packIds))
+	assert.Error(t, client.ReorderUserStickerPacks(ctx, userId, packIds))
+}
+
+func TestGetRecentStickers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockStickerServiceClient(ctrl)
+	client := &StickerServiceClient{client: mockClient}
+
+	ctx := context.Background()
+	userId := uuid.New()
+
+	mockClient.EXPECT().
+		GetRecentStickers(ctx, &pb.GetRecentStickersRequest{UserId: userId.String()}).
+		Return(&pb.GetRecentStickersResponse{
+			Stickers: file_service.ModelFilesToProto([]*models.File{{URL: "cat.webp", DisplayType: models.DisplayTypeSticker}}),
+		}, nil)
+
+	stickers, err := client.GetRecentStickers(ctx, userId)
+
+	assert.NoError(t, err)
+	assert.Len(t, stickers, 1)
+	assert.Equal(t, "cat.webp", stickers[0].URL)
+	assert.Equal(t, models.DisplayType(models.DisplayTypeSticker), stickers[0].DisplayType)
+}
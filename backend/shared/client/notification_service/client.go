@@ -0,0 +1,105 @@
+package notification_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+)
+
+type Client struct {
+	client pb.NotificationServiceClient
+}
+
+func NewNotificationClient(conn *grpc.ClientConn) *Client {
+	return &Client{
+		client: pb.NewNotificationServiceClient(conn),
+	}
+}
+
+// SaveNotification returns the stored notification, grouped is set when it is an existing one updated with the actor
+func (c *Client) SaveNotification(ctx context.Context, notification models.Notification) (*models.Notification, bool, error) {
+	pbNotification, err := ModelNotificationToProto(&notification)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert notification to proto: %v", err)
+		return nil, false, err
+	}
+
+	resp, err := c.client.SaveNotification(ctx, &pb.SaveNotificationRequest{Notification: pbNotification})
+	if err != nil {
+		logger.Error(ctx, "Failed to save notification: %v", err)
+		return nil, false, err
+	}
+
+	saved, err := ProtoNotificationToModel(resp.Notification)
+	if err != nil {
+		logger.Error(ctx, "Failed to convert proto notification to model: %v", err)
+		return nil, false, err
+	}
+	return saved, resp.Grouped, nil
+}
+
+func (c *Client) GetNotifications(ctx context.Context, userId uuid.UUID, count int, ts time.Time, lastId uuid.UUID) ([]models.Notification, error) {
+	resp, err := c.client.GetNotifications(ctx, &pb.GetNotificationsRequest{
+		UserId: userId.String(),
+		Ts:     timestamppb.New(ts),
+		Count:  int32(count),
+		LastId: lastId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get notifications: %v", err)
+		return nil, err
+	}
+
+	notifications := make([]models.Notification, 0, len(resp.Notifications))
+	for _, n := range resp.Notifications {
+		notification, err := ProtoNotificationToModel(n)
+		if err != nil {
+			logger.Error(ctx, "Failed to convert proto notification to model: %v", err)
+			return nil, err
+		}
+		notifications = append(notifications, *notification)
+	}
+	return notifications, nil
+}
+
+func (c *Client) MarkNotificationsRead(ctx context.Context, userId uuid.UUID, notificationIds []uuid.UUID) error {
+	ids := make([]string, len(notificationIds))
+	for i, id := range notificationIds {
+		ids[i] = id.String()
+	}
+
+	_, err := c.client.MarkNotificationsRead(ctx, &pb.MarkNotificationsReadRequest{
+		UserId:          userId.String(),
+		NotificationIds: ids,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to mark notifications read: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) MarkAllNotificationsRead(ctx context.Context, userId uuid.UUID) error {
+	_, err := c.client.MarkAllNotificationsRead(ctx, &pb.MarkAllNotificationsReadRequest{UserId: userId.String()})
+	if err != nil {
+		logger.Error(ctx, "Failed to mark all notifications read: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) GetUnreadCount(ctx context.Context, userId uuid.UUID) (int64, error) {
+	resp, err := c.client.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{UserId: userId.String()})
+	if err != nil {
+		logger.Error(ctx, "Failed to get unread notifications count: %v", err)
+		return 0, err
+	}
+	return resp.Count, nil
+}
@@ -0,0 +1,134 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+	"quickflow/shared/proto/notification_service/mocks"
+)
+
+func TestClient_SaveNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	client := &Client{client: mockClient}
+
+	notification := models.Notification{
+		UserId:   uuid.New(),
+		ActorId:  uuid.New(),
+		Type:     models.NotificationPostLiked,
+		TargetId: uuid.New(),
+	}
+	saved := notification
+	saved.Id = uuid.New()
+	saved.ActorIds = []uuid.UUID{notification.ActorId, uuid.New()}
+	saved.ActorCount = 13
+	saved.CreatedAt = time.Now().UTC().Add(-time.Hour)
+	saved.UpdatedAt = time.Now().UTC()
+	pbSaved, err := ModelNotificationToProto(&saved)
+	require.NoError(t, err)
+
+	mockClient.EXPECT().SaveNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *pb.SaveNotificationRequest, _ ...interface{}) (*pb.SaveNotificationResponse, error) {
+			assert.Equal(t, pb.NotificationType_NOTIFICATION_POST_LIKED, req.Notification.Type)
+			return &pb.SaveNotificationResponse{Notification: pbSaved, Grouped: true}, nil
+		})
+
+	result, grouped, err := client.SaveNotification(context.Background(), notification)
+	require.NoError(t, err)
+	assert.True(t, grouped)
+	assert.Equal(t, saved, *result)
+}
+
+func TestClient_SaveNotification_InvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &Client{client: mocks.NewMockNotificationServiceClient(ctrl)}
+
+	_, _, err := client.SaveNotification(context.Background(), models.Notification{Type: "unknown"})
+	assert.ErrorIs(t, err, ErrInvalidNotificationType)
+}
+
+func TestClient_GetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	client := &Client{client: mockClient}
+
+	userId, lastId := uuid.New(), uuid.New()
+	ts := time.Now()
+	pbNotification := &pb.Notification{
+		Id:        uuid.NewString(),
+		UserId:    userId.String(),
+		ActorId:   uuid.NewString(),
+		Type:      pb.NotificationType_NOTIFICATION_MENTION,
+		TargetId:  uuid.NewString(),
+		CreatedAt: timestamppb.New(ts.Add(-time.Minute)),
+	}
+
+	mockClient.EXPECT().GetNotifications(gomock.Any(), &pb.GetNotificationsRequest{
+		UserId: userId.String(),
+		Ts:     timestamppb.New(ts),
+		Count:  10,
+		LastId: lastId.String(),
+	}).Return(&pb.GetNotificationsResponse{Notifications: []*pb.Notification{pbNotification}}, nil)
+
+	result, err := client.GetNotifications(context.Background(), userId, 10, ts, lastId)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, models.NotificationMention, result[0].Type)
+
+	mockClient.EXPECT().GetNotifications(gomock.Any(), gomock.Any()).Return(nil, errors.New("grpc error"))
+	_, err = client.GetNotifications(context.Background(), userId, 10, ts, lastId)
+	assert.Error(t, err)
+}
+
+func TestClient_MarkRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	client := &Client{client: mockClient}
+
+	userId, notificationId := uuid.New(), uuid.New()
+
+	mockClient.EXPECT().MarkNotificationsRead(gomock.Any(), &pb.MarkNotificationsReadRequest{
+		UserId:          userId.String(),
+		NotificationIds: []string{notificationId.String()},
+	}).Return(&emptypb.Empty{}, nil)
+	assert.NoError(t, client.MarkNotificationsRead(context.Background(), userId, []uuid.UUID{notificationId}))
+
+	mockClient.EXPECT().MarkAllNotificationsRead(gomock.Any(), &pb.MarkAllNotificationsReadRequest{UserId: userId.String()}).
+		Return(nil, errors.New("grpc error"))
+	assert.Error(t, client.MarkAllNotificationsRead(context.Background(), userId))
+}
+
+func TestClient_GetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	client := &Client{client: mockClient}
+
+	userId := uuid.New()
+	mockClient.EXPECT().GetUnreadCount(gomock.Any(), &pb.GetUnreadCountRequest{UserId: userId.String()}).
+		Return(&pb.GetUnreadCountResponse{Count: 3}, nil)
+
+	count, err := client.GetUnreadCount(context.Background(), userId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
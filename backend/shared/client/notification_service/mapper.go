@@ -0,0 +1,122 @@
+package notification_service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+)
+
+var ErrInvalidNotificationType = errors.New("invalid notification type")
+
+var notificationTypeToProto = map[models.NotificationType]pb.NotificationType{
+	models.NotificationPostLiked:            pb.NotificationType_NOTIFICATION_POST_LIKED,
+	models.NotificationCommentLiked:         pb.NotificationType_NOTIFICATION_COMMENT_LIKED,
+	models.NotificationPostCommented:        pb.NotificationType_NOTIFICATION_POST_COMMENTED,
+	models.NotificationFriendRequest:        pb.NotificationType_NOTIFICATION_FRIEND_REQUEST,
+	models.NotificationFriendAccepted:       pb.NotificationType_NOTIFICATION_FRIEND_ACCEPTED,
+	models.NotificationCommunityRoleChanged: pb.NotificationType_NOTIFICATION_COMMUNITY_ROLE_CHANGED,
+	models.NotificationMention:              pb.NotificationType_NOTIFICATION_MENTION,
+}
+
+func NotificationTypeToProto(t models.NotificationType) (pb.NotificationType, error) {
+	protoType, ok := notificationTypeToProto[t]
+	if !ok {
+		return pb.NotificationType_NOTIFICATION_UNSPECIFIED, ErrInvalidNotificationType
+	}
+	return protoType, nil
+}
+
+func NotificationTypeFromProto(t pb.NotificationType) (models.NotificationType, error) {
+	for modelType, protoType := range notificationTypeToProto {
+		if protoType == t {
+			return modelType, nil
+		}
+	}
+	return "", ErrInvalidNotificationType
+}
+
+func ModelNotificationToProto(n *models.Notification) (*pb.Notification, error) {
+	notificationType, err := NotificationTypeToProto(n.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	actorIds := make([]string, len(n.ActorIds))
+	for i, actorId := range n.ActorIds {
+		actorIds[i] = actorId.String()
+	}
+
+	return &pb.Notification{
+		Id:         n.Id.String(),
+		UserId:     n.UserId.String(),
+		ActorId:    n.ActorId.String(),
+		ActorIds:   actorIds,
+		ActorCount: int32(n.ActorCount),
+		Type:       notificationType,
+		TargetId:   n.TargetId.String(),
+		Content:    n.Content,
+		IsRead:     n.IsRead,
+		CreatedAt:  timestamppb.New(n.CreatedAt),
+		UpdatedAt:  timestamppb.New(n.UpdatedAt),
+	}, nil
+}
+
+// ProtoNotificationToModel accepts an empty id for notifications that are not saved yet
+func ProtoNotificationToModel(n *pb.Notification) (*models.Notification, error) {
+	if n == nil {
+		return nil, errors.New("notification is nil")
+	}
+
+	var (
+		id  uuid.UUID
+		err error
+	)
+	if len(n.Id) != 0 {
+		if id, err = uuid.Parse(n.Id); err != nil {
+			return nil, fmt.Errorf("invalid notification id: %w", err)
+		}
+	}
+	userId, err := uuid.Parse(n.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	actorId, err := uuid.Parse(n.ActorId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor id: %w", err)
+	}
+	var actorIds []uuid.UUID
+	for _, id := range n.ActorIds {
+		actorId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid actor id: %w", err)
+		}
+		actorIds = append(actorIds, actorId)
+	}
+	targetId, err := uuid.Parse(n.TargetId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target id: %w", err)
+	}
+	notificationType, err := NotificationTypeFromProto(n.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Notification{
+		Id:         id,
+		UserId:     userId,
+		ActorId:    actorId,
+		ActorIds:   actorIds,
+		ActorCount: int(n.ActorCount),
+		Type:       notificationType,
+		TargetId:   targetId,
+		Content:    n.Content,
+		IsRead:     n.IsRead,
+		CreatedAt:  n.CreatedAt.AsTime(),
+		UpdatedAt:  n.UpdatedAt.AsTime(),
+	}, nil
+}
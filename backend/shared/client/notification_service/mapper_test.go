@@ -0,0 +1,103 @@
+package notification_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"quickflow/shared/client/notification_service"
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/notification_service"
+)
+
+func TestNotificationType_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, notificationType := range models.NotificationTypes {
+		protoType, err := notification_service.NotificationTypeToProto(notificationType)
+		require.NoError(t, err)
+		assert.NotEqual(t, pb.NotificationType_NOTIFICATION_UNSPECIFIED, protoType)
+
+		modelType, err := notification_service.NotificationTypeFromProto(protoType)
+		require.NoError(t, err)
+		assert.Equal(t, notificationType, modelType)
+	}
+
+	_, err := notification_service.NotificationTypeToProto("unknown")
+	assert.ErrorIs(t, err, notification_service.ErrInvalidNotificationType)
+	_, err = notification_service.NotificationTypeFromProto(pb.NotificationType_NOTIFICATION_UNSPECIFIED)
+	assert.ErrorIs(t, err, notification_service.ErrInvalidNotificationType)
+}
+
+func TestNotification_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	notification := models.Notification{
+		Id:        uuid.New(),
+		UserId:    uuid.New(),
+		ActorId:   uuid.New(),
+		Type:      models.NotificationPostCommented,
+		TargetId:  uuid.New(),
+		Content:   "nice post",
+		IsRead:    true,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	protoNotification, err := notification_service.ModelNotificationToProto(&notification)
+	require.NoError(t, err)
+
+	result, err := notification_service.ProtoNotificationToModel(protoNotification)
+	require.NoError(t, err)
+	assert.Equal(t, notification, *result)
+}
+
+func TestProtoNotificationToModel(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *pb.Notification {
+		return &pb.Notification{
+			UserId:    uuid.NewString(),
+			ActorId:   uuid.NewString(),
+			Type:      pb.NotificationType_NOTIFICATION_FRIEND_REQUEST,
+			TargetId:  uuid.NewString(),
+			CreatedAt: timestamppb.Now(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(n *pb.Notification)
+		wantErr bool
+	}{
+		{name: "not saved yet", modify: func(n *pb.Notification) {}},
+		{name: "invalid id", modify: func(n *pb.Notification) { n.Id = "invalid" }, wantErr: true},
+		{name: "invalid user id", modify: func(n *pb.Notification) { n.UserId = "invalid" }, wantErr: true},
+		{name: "invalid actor id", modify: func(n *pb.Notification) { n.ActorId = "" }, wantErr: true},
+		{name: "invalid sample actor id", modify: func(n *pb.Notification) { n.ActorIds = []string{"invalid"} }, wantErr: true},
+		{name: "invalid target id", modify: func(n *pb.Notification) { n.TargetId = "invalid" }, wantErr: true},
+		{name: "unspecified type", modify: func(n *pb.Notification) { n.Type = pb.NotificationType_NOTIFICATION_UNSPECIFIED }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := valid()
+			tt.modify(n)
+
+			result, err := notification_service.ProtoNotificationToModel(n)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uuid.Nil, result.Id)
+		})
+	}
+
+	_, err := notification_service.ProtoNotificationToModel(nil)
+	assert.Error(t, err)
+}
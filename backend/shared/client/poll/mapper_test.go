@@ -0,0 +1,73 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/shared/models"
+	pb "quickflow/shared/proto/poll"
+)
+
+func TestPollMapping(t *testing.T) {
+	closesAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	voter := uuid.New()
+
+	tests := []struct {
+		name string
+		poll *models.Poll
+	}{
+		{name: "nil poll"},
+		{
+			name: "public poll with results",
+			poll: &models.Poll{
+				ID:       uuid.New(),
+				Question: "Where do we meet?",
+				Options: []models.PollOption{
+					{Text: "Office", VoteCount: 1, Voters: []uuid.UUID{voter}},
+					{Text: "Cafe"},
+				},
+				MultipleChoice:    true,
+				ResultsVisibility: models.PollResultsAfterVote,
+				ClosesAt:          &closesAt,
+				CreatedAt:         closesAt.Add(-time.Hour),
+				VoterCount:        1,
+				Voted:             []int{0},
+			},
+		},
+		{
+			name: "anonymous poll with hidden results",
+			poll: &models.Poll{
+				ID:                uuid.New(),
+				Question:          "Pizza?",
+				Options:           []models.PollOption{{Text: "Yes"}, {Text: "No"}},
+				Anonymous:         true,
+				ResultsVisibility: models.PollResultsAfterClose,
+				CreatedAt:         closesAt,
+				VoterCount:        10,
+				ResultsHidden:     true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ProtoPollToModel(ModelPollToProto(test.poll))
+
+			require.NoError(t, err)
+			assert.Equal(t, test.poll, result)
+		})
+	}
+}
+
+func TestProtoPollToModel_InvalidVoter(t *testing.T) {
+	_, err := ProtoPollToModel(&pb.Poll{
+		Id:      uuid.NewString(),
+		Options: []*pb.PollOption{{Text: "Yes", VoteCount: 1, Voters: []string{"invalid"}}},
+	})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package poll
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	shared_models "quickflow/shared/models"
+	"quickflow/shared/proto/poll"
+)
+
+func ModelPollToProto(p *shared_models.Poll) *poll.Poll {
+	if p == nil {
+		return nil
+	}
+
+	var closesAt *timestamppb.Timestamp
+	if p.ClosesAt != nil {
+		closesAt = timestamppb.New(*p.ClosesAt)
+	}
+
+	options := make([]*poll.PollOption, len(p.Options))
+	for i, option := range p.Options {
+		voters := make([]string, len(option.Voters))
+		for j, voter := range option.Voters {
+			voters[j] = voter.String()
+		}
+		options[i] = &poll.PollOption{
+			Text:      option.Text,
+			VoteCount: int64(option.VoteCount),
+			Voters:    voters,
+		}
+	}
+
+	return &poll.Poll{
+		Id:                p.ID.String(),
+		Question:          p.Question,
+		Options:           options,
+		MultipleChoice:    p.MultipleChoice,
+		Anonymous:         p.Anonymous,
+		ResultsVisibility: string(p.ResultsVisibility),
+		ClosesAt:          closesAt,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		VoterCount:        int64(p.VoterCount),
+		Voted:             OptionsToProto(p.Voted),
+		ResultsHidden:     p.ResultsHidden,
+	}
+}
+
+func ProtoPollToModel(p *poll.Poll) (*shared_models.Poll, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(p.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var closesAt *time.Time
+	if p.ClosesAt != nil {
+		t := p.ClosesAt.AsTime()
+		closesAt = &t
+	}
+
+	options := make([]shared_models.PollOption, len(p.Options))
+	for i, option := range p.Options {
+		var voters []uuid.UUID
+		for _, voter := range option.Voters {
+			voterId, err := uuid.Parse(voter)
+			if err != nil {
+				return nil, err
+			}
+			voters = append(voters, voterId)
+		}
+		options[i] = shared_models.PollOption{
+			Text:      option.Text,
+			VoteCount: int(option.VoteCount),
+			Voters:    voters,
+		}
+	}
+
+	var voted []int
+	for _, option := range p.Voted {
+		voted = append(voted, int(option))
+	}
+
+	return &shared_models.Poll{
+		ID:                id,
+		Question:          p.Question,
+		Options:           options,
+		MultipleChoice:    p.MultipleChoice,
+		Anonymous:         p.Anonymous,
+		ResultsVisibility: shared_models.PollResultsVisibility(p.ResultsVisibility),
+		ClosesAt:          closesAt,
+		CreatedAt:         p.CreatedAt.AsTime(),
+		VoterCount:        int(p.VoterCount),
+		Voted:             voted,
+		ResultsHidden:     p.ResultsHidden,
+	}, nil
+}
+
+// OptionsToProto converts indexes of chosen options for a vote request
+func OptionsToProto(options []int) []int32 {
+	res := make([]int32, len(options))
+	for i, option := range options {
+		res[i] = int32(option)
+	}
+	return res
+}
+
+func ProtoOptionsToModel(options []int32) []int {
+	res := make([]int, len(options))
+	for i, option := range options {
+		res[i] = int(option)
+	}
+	return res
+}
@@ -8,6 +8,7 @@ import (
 
 	time_config "quickflow/config/time"
 	file_service2 "quickflow/shared/client/file_service"
+	poll_mapper "quickflow/shared/client/poll"
 	shared_models "quickflow/shared/models"
 	pb "quickflow/shared/proto/post_service"
 )
@@ -21,6 +22,10 @@ func ProtoPostToModel(p *pb.Post) (*shared_models.Post, error) {
 	if err != nil {
 		return nil, err
 	}
+	poll, err := poll_mapper.ProtoPollToModel(p.Poll)
+	if err != nil {
+		return nil, err
+	}
 	return &shared_models.Post{
 		Id:           id,
 		CreatorId:    creatorId,
@@ -34,6 +39,7 @@ func ProtoPostToModel(p *pb.Post) (*shared_models.Post, error) {
 		CommentCount: int(p.CommentCount),
 		IsRepost:     p.IsRepost,
 		IsLiked:      p.IsLiked,
+		Poll:         poll,
 	}, nil
 }
 
@@ -63,6 +69,7 @@ func ModelPostToProto(p *shared_models.Post) *pb.Post {
 		CommentCount: int64(p.CommentCount),
 		IsRepost:     p.IsRepost,
 		IsLiked:      p.IsLiked,
+		Poll:         poll_mapper.ModelPollToProto(p.Poll),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 
+	poll_mapper "quickflow/shared/client/poll"
 	"quickflow/shared/logger"
 	"quickflow/shared/models"
 	pb "quickflow/shared/proto/post_service"
@@ -131,3 +132,18 @@ func (c *PostServiceClient) GetPost(ctx context.Context, postId uuid.UUID, userI
 	}
 	return ProtoPostToModel(resp.Post)
 }
+
+// VotePoll replaces the vote of the user in the post poll and returns the post with updated results
+func (c *PostServiceClient) VotePoll(ctx context.Context, postId, userId uuid.UUID, options []int) (*models.Post, error) {
+	logger.Info(ctx, "Sending request to vote in poll of post: %v", postId)
+	resp, err := c.client.VotePoll(ctx, &pb.VotePollRequest{
+		PostId:  postId.String(),
+		UserId:  userId.String(),
+		Options: poll_mapper.OptionsToProto(options),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to vote in poll: %v", err)
+		return nil, err
+	}
+	return ProtoPostToModel(resp.Post)
+}
@@ -13,6 +13,7 @@ import (
     "google.golang.org/protobuf/types/known/timestamppb"
 
     "quickflow/shared/models"
+    poll_pb "quickflow/shared/proto/poll"
     pb "quickflow/shared/proto/post_service"
 )
 
@@ -67,6 +68,11 @@ func (m *MockPostServiceClient) GetPost(ctx context.Context, in *pb.GetPostReque
     return args.Get(0).(*pb.GetPostResponse), args.Error(1)
 }
 
+func (m *MockPostServiceClient) VotePoll(ctx context.Context, in *pb.VotePollRequest, opts ...grpc.CallOption) (*pb.VotePollResponse, error) {
+    args := m.Called(ctx, in, opts)
+    return args.Get(0).(*pb.VotePollResponse), args.Error(1)
+}
+
 func TestPostServiceClient_AddPost(t *testing.T) {
     now := time.Now()
     postID := uuid.New()
@@ -900,3 +906,68 @@ func TestPostServiceClient_GetPost(t *testing.T) {
         })
     }
 }
+
+func TestPostServiceClient_VotePoll(t *testing.T) {
+    postID := uuid.New()
+    userID := uuid.New()
+    pollID := uuid.New()
+    now := time.Now()
+
+    tests := []struct {
+        name        string
+        mockResp    *pb.VotePollResponse
+        mockErr     error
+        expectedErr bool
+    }{
+        {
+            name: "successful vote",
+            mockResp: &pb.VotePollResponse{
+                Post: &pb.Post{
+                    Id:          postID.String(),
+                    CreatorId:   userID.String(),
+                    CreatorType: string(models.PostUser),
+                    CreatedAt:   timestamppb.New(now),
+                    UpdatedAt:   timestamppb.New(now),
+                    Poll: &poll_pb.Poll{
+                        Id:        pollID.String(),
+                        Question:  "Tea or coffee?",
+                        Options:   []*poll_pb.PollOption{{Text: "Tea"}, {Text: "Coffee", VoteCount: 1}},
+                        CreatedAt: timestamppb.New(now),
+                        Voted:     []int32{1},
+                    },
+                },
+            },
+        },
+        {
+            name:        "grpc error",
+            mockErr:     errors.New("poll is closed"),
+            expectedErr: true,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            mockClient := new(MockPostServiceClient)
+            client := &PostServiceClient{client: mockClient}
+
+            mockClient.On("VotePoll", mock.Anything, &pb.VotePollRequest{
+                PostId:  postID.String(),
+                UserId:  userID.String(),
+                Options: []int32{1},
+            }, mock.Anything).Return(tt.mockResp, tt.mockErr)
+
+            result, err := client.VotePoll(context.Background(), postID, userID, []int{1})
+
+            if tt.expectedErr {
+                assert.Error(t, err)
+            } else {
+                assert.NoError(t, err)
+                assert.Equal(t, pollID, result.Poll.ID)
+                assert.Equal(t, []int{1}, result.Poll.Voted)
+                assert.Equal(t, 1, result.Poll.Options[1].VoteCount)
+            }
+
+            mockClient.AssertExpectations(t)
+        })
+    }
+}
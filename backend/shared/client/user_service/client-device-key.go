@@ -0,0 +1,79 @@
+package userclient
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"quickflow/shared/logger"
+	shared_models "quickflow/shared/models"
+	pb "quickflow/shared/proto/user_service"
+)
+
+type DeviceKeyClient struct {
+	client pb.DeviceKeyServiceClient
+}
+
+func NewDeviceKeyClient(conn *grpc.ClientConn) *DeviceKeyClient {
+	return &DeviceKeyClient{
+		client: pb.NewDeviceKeyServiceClient(conn),
+	}
+}
+
+func (c *DeviceKeyClient) UploadDeviceKeys(ctx context.Context, keys shared_models.DeviceKeys) error {
+	_, err := c.client.UploadDeviceKeys(ctx, &pb.UploadDeviceKeysRequest{
+		Keys: MapDeviceKeysToDTO(keys),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to upload device keys: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *DeviceKeyClient) RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey shared_models.SignedPrekey) error {
+	_, err := c.client.RotateSignedPrekey(ctx, &pb.RotateSignedPrekeyRequest{
+		UserId:       userId.String(),
+		DeviceId:     deviceId,
+		SignedPrekey: MapSignedPrekeyToDTO(prekey),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to rotate signed prekey: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *DeviceKeyClient) RemoveDevice(ctx context.Context, userId uuid.UUID, deviceId string) error {
+	_, err := c.client.RemoveDevice(ctx, &pb.RemoveDeviceRequest{
+		UserId:   userId.String(),
+		DeviceId: deviceId,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to remove device: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *DeviceKeyClient) GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]shared_models.DeviceKeys, error) {
+	resp, err := c.client.GetUserDeviceKeys(ctx, &pb.GetUserDeviceKeysRequest{
+		UserId: userId.String(),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to get user device keys: %v", err)
+		return nil, err
+	}
+
+	devices := make([]shared_models.DeviceKeys, 0, len(resp.Devices))
+	for _, device := range resp.Devices {
+		keys, err := MapDeviceKeysDTOToModel(device)
+		if err != nil {
+			logger.Error(ctx, "Failed to convert to DeviceKeys: %v", err)
+			return nil, err
+		}
+		devices = append(devices, keys)
+	}
+	return devices, nil
+}
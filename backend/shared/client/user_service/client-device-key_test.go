@@ -0,0 +1,97 @@
+package userclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	shared_models "quickflow/shared/models"
+	pb "quickflow/shared/proto/user_service"
+	"quickflow/shared/proto/user_service/mocks"
+)
+
+func TestDeviceKeyClient_GetUserDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockDeviceKeyServiceClient(ctrl)
+	client := &DeviceKeyClient{client: mockClient}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	keys := shared_models.DeviceKeys{
+		UserID:       uuid.New(),
+		DeviceID:     "phone",
+		IdentityKey:  []byte("identity"),
+		SignedPrekey: shared_models.SignedPrekey{ID: 7, PublicKey: []byte("prekey"), Signature: []byte("signature")},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	mockClient.EXPECT().
+		GetUserDeviceKeys(ctx, &pb.GetUserDeviceKeysRequest{UserId: keys.UserID.String()}).
+		Return(&pb.GetUserDeviceKeysResponse{Devices: []*pb.DeviceKeys{MapDeviceKeysToDTO(keys)}}, nil)
+	mockClient.EXPECT().
+		GetUserDeviceKeys(ctx, gomock.Any()).
+		Return(&pb.GetUserDeviceKeysResponse{Devices: []*pb.DeviceKeys{{UserId: "invalid"}}}, nil)
+
+	devices, err := client.GetUserDeviceKeys(ctx, keys.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, []shared_models.DeviceKeys{keys}, devices)
+
+	_, err = client.GetUserDeviceKeys(ctx, keys.UserID)
+	assert.Error(t, err)
+}
+
+func TestDeviceKeyClient_RotateSignedPrekey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockDeviceKeyServiceClient(ctrl)
+	client := &DeviceKeyClient{client: mockClient}
+
+	ctx := context.Background()
+	userId := uuid.New()
+	prekey := shared_models.SignedPrekey{ID: 2, PublicKey: []byte("prekey"), Signature: []byte("signature")}
+
+	mockClient.EXPECT().
+		RotateSignedPrekey(ctx, &pb.RotateSignedPrekeyRequest{
+			UserId:       userId.String(),
+			DeviceId:     "phone",
+			SignedPrekey: &pb.SignedPrekey{Id: 2, PublicKey: prekey.PublicKey, Signature: prekey.Signature},
+		}).
+		Return(&pb.RotateSignedPrekeyResponse{Success: true}, nil)
+	mockClient.EXPECT().
+		RotateSignedPrekey(ctx, gomock.Any()).
+		Return(nil, errors.New("grpc error"))
+
+	assert.NoError(t, client.RotateSignedPrekey(ctx, userId, "phone", prekey))
+	assert.Error(t, client.RotateSignedPrekey(ctx, userId, "phone", prekey))
+}
+
+func TestDeviceKeyClient_RemoveDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockDeviceKeyServiceClient(ctrl)
+	client := &DeviceKeyClient{client: mockClient}
+
+	ctx := context.Background()
+	userId := uuid.New()
+
+	mockClient.EXPECT().
+		RemoveDevice(ctx, &pb.RemoveDeviceRequest{UserId: userId.String(), DeviceId: "phone"}).
+		Return(&pb.RemoveDeviceResponse{Success: true}, nil)
+	mockClient.EXPECT().
+		RemoveDevice(ctx, gomock.Any()).
+		Return(nil, errors.New("grpc error"))
+
+	assert.NoError(t, client.RemoveDevice(ctx, userId, "phone"))
+	assert.Error(t, client.RemoveDevice(ctx, userId, "phone"))
+}
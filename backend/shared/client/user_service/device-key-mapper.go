@@ -0,0 +1,57 @@
+package userclient
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	shared_models "quickflow/shared/models"
+	pb "quickflow/shared/proto/user_service"
+)
+
+func MapSignedPrekeyToDTO(prekey shared_models.SignedPrekey) *pb.SignedPrekey {
+	return &pb.SignedPrekey{
+		Id:        int32(prekey.ID),
+		PublicKey: prekey.PublicKey,
+		Signature: prekey.Signature,
+	}
+}
+
+func MapSignedPrekeyDTOToModel(prekey *pb.SignedPrekey) shared_models.SignedPrekey {
+	if prekey == nil {
+		return shared_models.SignedPrekey{}
+	}
+	return shared_models.SignedPrekey{
+		ID:        int(prekey.Id),
+		PublicKey: prekey.PublicKey,
+		Signature: prekey.Signature,
+	}
+}
+
+func MapDeviceKeysToDTO(keys shared_models.DeviceKeys) *pb.DeviceKeys {
+	return &pb.DeviceKeys{
+		UserId:       keys.UserID.String(),
+		DeviceId:     keys.DeviceID,
+		IdentityKey:  keys.IdentityKey,
+		SignedPrekey: MapSignedPrekeyToDTO(keys.SignedPrekey),
+		CreatedAt:    timestamppb.New(keys.CreatedAt),
+		UpdatedAt:    timestamppb.New(keys.UpdatedAt),
+	}
+}
+
+func MapDeviceKeysDTOToModel(keys *pb.DeviceKeys) (shared_models.DeviceKeys, error) {
+	if keys == nil {
+		return shared_models.DeviceKeys{}, nil
+	}
+	userId, err := uuid.Parse(keys.UserId)
+	if err != nil {
+		return shared_models.DeviceKeys{}, err
+	}
+	return shared_models.DeviceKeys{
+		UserID:       userId,
+		DeviceID:     keys.DeviceId,
+		IdentityKey:  keys.IdentityKey,
+		SignedPrekey: MapSignedPrekeyDTOToModel(keys.SignedPrekey),
+		CreatedAt:    keys.CreatedAt.AsTime(),
+		UpdatedAt:    keys.UpdatedAt.AsTime(),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// Event is a WebSocket event addressed to a set of users.
+// Payload is delivered to the receivers as is, wrapped with event type.
+type Event struct {
+	Type      string          `json:"type"`
+	Receivers []uuid.UUID     `json:"receivers"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Handler delivers published events to the users connected to the current instance
+type Handler func(ctx context.Context, event Event) error
+
+func NewEvent(eventType string, payload interface{}, receivers ...uuid.UUID) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:      eventType,
+		Receivers: receivers,
+		Payload:   data,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	receiver := uuid.New()
+	event, err := NewEvent("message", map[string]string{"text": "hi"}, receiver)
+	require.NoError(t, err)
+
+	assert.Equal(t, "message", event.Type)
+	assert.Equal(t, []uuid.UUID{receiver}, event.Receivers)
+	assert.JSONEq(t, `{"text":"hi"}`, string(event.Payload))
+}
+
+func TestInMemoryEventBus(t *testing.T) {
+	bus := NewInMemoryEventBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan Event, 1)
+	go func() {
+		_ = bus.Subscribe(ctx, func(_ context.Context, event Event) error {
+			received <- event
+			return nil
+		})
+	}()
+
+	// wait for the subscriber to register
+	require.Eventually(t, func() bool {
+		bus.mu.RLock()
+		defer bus.mu.RUnlock()
+		return len(bus.handlers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	event, err := NewEvent("post_liked", struct{}{}, uuid.New())
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, event))
+
+	select {
+	case got := <-received:
+		assert.Equal(t, event, got)
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+	}
+
+	// events without receivers are dropped
+	require.NoError(t, bus.Publish(ctx, Event{Type: "message"}))
+	assert.Empty(t, received)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		bus.mu.RLock()
+		defer bus.mu.RUnlock()
+		return len(bus.handlers) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRedisEventBus_Publish(t *testing.T) {
+	event, err := NewEvent("message_read", map[string]string{"chat_id": "1"}, uuid.New())
+	require.NoError(t, err)
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		mock    func(mock redismock.ClientMock)
+		wantErr bool
+	}{
+		{
+			name: "Successfully publish",
+			mock: func(mock redismock.ClientMock) {
+				mock.ExpectPublish(DefaultChannelPrefix+"message_read", data).SetVal(1)
+			},
+		},
+		{
+			name: "Redis error",
+			mock: func(mock redismock.ClientMock) {
+				mock.ExpectPublish(DefaultChannelPrefix+"message_read", data).SetErr(errors.New("redis is down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			tt.mock(mock)
+
+			bus := NewRedisEventBus(db, DefaultChannelPrefix)
+			err := bus.Publish(context.Background(), event)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// flakySubscriber fails the first subscriptions and then stays subscribed until ctx is done
+type flakySubscriber struct {
+	failures int
+	attempts chan struct{}
+}
+
+func (s *flakySubscriber) Subscribe(ctx context.Context, _ Handler) error {
+	s.attempts <- struct{}{}
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("connection refused")
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestKeepSubscribed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &flakySubscriber{failures: 2, attempts: make(chan struct{}, 10)}
+
+	done := make(chan struct{})
+	go func() {
+		KeepSubscribed(ctx, sub, func(context.Context, Event) error { return nil }, time.Millisecond, 10*time.Millisecond)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-sub.attempts:
+		case <-time.After(time.Second):
+			t.Fatalf("subscription attempt %d was not made", i+1)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("KeepSubscribed did not stop after ctx was done")
+	}
+	assert.Empty(t, sub.attempts)
+}
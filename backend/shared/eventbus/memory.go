@@ -0,0 +1,58 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"quickflow/shared/logger"
+)
+
+// InMemoryEventBus delivers events to subscribers of the same process.
+// Suitable for tests and single instance deployments.
+type InMemoryEventBus struct {
+	handlers map[int]Handler
+	nextId   int
+	mu       sync.RWMutex
+}
+
+func NewInMemoryEventBus() *InMemoryEventBus {
+	return &InMemoryEventBus{
+		handlers: make(map[int]Handler),
+	}
+}
+
+func (b *InMemoryEventBus) Publish(ctx context.Context, event Event) error {
+	if len(event.Receivers) == 0 {
+		return nil
+	}
+
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers))
+	for _, handler := range b.handlers {
+		handlers = append(handlers, handler)
+	}
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			logger.Error(ctx, "Failed to handle event %s: %v", event.Type, err)
+		}
+	}
+	return nil
+}
+
+// Subscribe registers handler and blocks until ctx is done
+func (b *InMemoryEventBus) Subscribe(ctx context.Context, handler Handler) error {
+	b.mu.Lock()
+	id := b.nextId
+	b.nextId++
+	b.handlers[id] = handler
+	b.mu.Unlock()
+
+	<-ctx.Done()
+
+	b.mu.Lock()
+	delete(b.handlers, id)
+	b.mu.Unlock()
+	return ctx.Err()
+}
@@ -0,0 +1,15 @@
+package eventbus
+
+import "github.com/google/uuid"
+
+// MessengerChannelPrefix is used by the messenger to tell gateways about messages it sends on its own.
+// Gateways enrich such events and deliver them to their connections, so they are not sent to clients as is.
+const MessengerChannelPrefix = "messenger:events:"
+
+// ScheduledMessageSent is published by the messenger once a scheduled message is sent to the chat
+const ScheduledMessageSent = "scheduled_message_sent"
+
+type ScheduledMessageSentPayload struct {
+	ChatID    uuid.UUID `json:"chat_id"`
+	MessageID uuid.UUID `json:"message_id"`
+}
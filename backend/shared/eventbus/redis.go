@@ -0,0 +1,79 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"quickflow/shared/logger"
+)
+
+const DefaultChannelPrefix = "ws:events:"
+
+// RedisEventBus publishes events to Redis channels, one channel per event type,
+// so that every gateway instance receives them and delivers to its own connections.
+type RedisEventBus struct {
+	rdb    *redis.Client
+	prefix string
+}
+
+func NewRedisEventBus(rdb *redis.Client, channelPrefix string) *RedisEventBus {
+	return &RedisEventBus{
+		rdb:    rdb,
+		prefix: channelPrefix,
+	}
+}
+
+func (b *RedisEventBus) channel(eventType string) string {
+	return b.prefix + eventType
+}
+
+func (b *RedisEventBus) Publish(ctx context.Context, event Event) error {
+	if len(event.Receivers) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err = b.rdb.Publish(ctx, b.channel(event.Type), data).Err(); err != nil {
+		return fmt.Errorf("failed to publish event %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+// Subscribe listens to all event channels and passes received events to handler.
+// Blocks until ctx is done or subscription is closed.
+func (b *RedisEventBus) Subscribe(ctx context.Context, handler Handler) error {
+	pubsub := b.rdb.PSubscribe(ctx, b.prefix+"*")
+	defer pubsub.Close()
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				logger.Error(ctx, "Failed to unmarshal event from channel %s: %v", msg.Channel, err)
+				continue
+			}
+			if err := handler(ctx, event); err != nil {
+				logger.Error(ctx, "Failed to handle event %s: %v", event.Type, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package eventbus
+
+import (
+	"context"
+	"time"
+
+	"quickflow/shared/logger"
+)
+
+const (
+	DefaultMinBackoff = time.Second
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// Subscriber is an event bus that can be listened to
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler Handler) error
+}
+
+// KeepSubscribed subscribes again whenever the subscription fails or stops, e.g. when Redis is not up yet
+// or the connection is lost. The delay doubles from minBackoff up to maxBackoff and is reset once
+// a subscription has lasted for maxBackoff. Blocks until ctx is done.
+func KeepSubscribed(ctx context.Context, sub Subscriber, handler Handler, minBackoff, maxBackoff time.Duration) {
+	backoff := minBackoff
+	for {
+		started := time.Now()
+		err := sub.Subscribe(ctx, handler)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) >= maxBackoff {
+			backoff = minBackoff
+		}
+		logger.Error(ctx, "Event bus subscription stopped, retrying in %v: %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
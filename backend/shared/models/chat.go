@@ -13,10 +13,36 @@ const (
 	ChatTypeGroup
 )
 
+type ChatRole string
+
+const (
+	ChatRoleMember ChatRole = "member"
+	ChatRoleAdmin  ChatRole = "admin"
+	ChatRoleOwner  ChatRole = "owner"
+)
+
+// CanModerate reports whether the role allows to manage members, the chat and messages of others
+func (r ChatRole) CanModerate() bool {
+	return r == ChatRoleAdmin || r == ChatRoleOwner
+}
+
+type ChatMember struct {
+	UserID uuid.UUID
+	Role   ChatRole
+}
+
 type ChatCreationInfo struct {
 	Name   string
 	Type   ChatType
 	Avatar *File
+	// MemberIDs are added to the group chat together with its creator
+	MemberIDs []uuid.UUID
+}
+
+// ChatUpdateInfo holds new name and avatar of the group chat, empty fields are left unchanged
+type ChatUpdateInfo struct {
+	Name   string
+	Avatar *File
 }
 
 type Chat struct {
@@ -29,4 +55,78 @@ type Chat struct {
 	LastMessage     Message
 	LastReadByOther *time.Time
 	LastReadByMe    *time.Time
+	// PinnedMessages are ordered from the latest pinned
+	PinnedMessages []PinnedMessage
+
+	// MutedUntil, Archived and PinOrder are chat list settings of the requesting user,
+	// PinOrder is the position of the chat among pinned ones starting from 1, 0 if it is not pinned
+	MutedUntil *time.Time
+	Archived   bool
+	PinOrder   int
+
+	// MessageTTL is the lifetime of messages sent to the chat, zero if they do not disappear
+	MessageTTL time.Duration
+	// Encrypted private chats accept only end-to-end encrypted messages
+	Encrypted bool
+}
+
+// MutedForever is stored as the mute end of chats muted without a time limit
+var MutedForever = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// IsMuted reports whether the user has turned off notifications from the chat
+func (c Chat) IsMuted(now time.Time) bool {
+	return c.MutedUntil != nil && now.Before(*c.MutedUntil)
+}
+
+// ChatInvite is a link to join the group chat.
+// Nil ExpiresAt never expires, zero UsageLimit allows unlimited joins.
+type ChatInvite struct {
+	Token      string
+	ChatID     uuid.UUID
+	CreatorID  uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	UsageLimit int
+	UsageCount int
+}
+
+// IsActive reports whether the invite can still be used to join the chat
+func (i ChatInvite) IsActive(now time.Time) bool {
+	if i.ExpiresAt != nil && !now.Before(*i.ExpiresAt) {
+		return false
+	}
+	return i.UsageLimit == 0 || i.UsageCount < i.UsageLimit
+}
+
+type ChatInviteCreationInfo struct {
+	ExpiresAt  *time.Time
+	UsageLimit int
+}
+
+// ChatPreview is shown to users opening an invite link before they join the chat
+type ChatPreview struct {
+	Chat       Chat
+	NumMembers int
+}
+
+type ChatExportStatus string
+
+const (
+	ChatExportPending ChatExportStatus = "pending"
+	ChatExportReady   ChatExportStatus = "ready"
+	ChatExportFailed  ChatExportStatus = "failed"
+	// ChatExportExpired archives are deleted some time after they are built
+	ChatExportExpired ChatExportStatus = "expired"
+)
+
+// ChatExport is an archive of the chat history requested by the user, FileURL is set once it is ready.
+// The archive is a private file, FileURL is its name in the file service and is not shown to users.
+type ChatExport struct {
+	ID        uuid.UUID
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+	Status    ChatExportStatus
+	FileURL   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
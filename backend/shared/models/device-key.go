@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SignedPrekey is a medium term public key of the device signed with its identity key.
+// Devices rotate it from time to time, ID grows with every rotation.
+type SignedPrekey struct {
+	ID        int
+	PublicKey []byte
+	Signature []byte
+}
+
+// DeviceKeys are public keys the device publishes so that others can encrypt messages for it
+type DeviceKeys struct {
+	UserID       uuid.UUID
+	DeviceID     string
+	IdentityKey  []byte
+	SignedPrekey SignedPrekey
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"io"
+	"time"
 )
 
 type DisplayType string
@@ -26,6 +27,17 @@ type File struct {
 	AccessMode  AccessMode
 	URL         string
 	DisplayType DisplayType
+
+	// IsVoice marks audio recorded as a voice message, file service fills Voice for it
+	IsVoice bool
+	Voice   *VoiceMetadata
+}
+
+// VoiceMetadata is computed by file service from the recording itself
+type VoiceMetadata struct {
+	Duration time.Duration
+	// Waveform is the downsampled amplitude of the recording, from 0 to 255
+	Waveform []byte
 }
 
 func (f File) String() string {
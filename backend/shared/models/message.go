@@ -16,4 +16,174 @@ type Message struct {
 	SenderID   uuid.UUID
 	ChatID     uuid.UUID
 	ReceiverID uuid.UUID
+
+	// Seq is a monotonically increasing number of the event within the chat
+	Seq int64
+	// EditedAt is nil if the message was never edited
+	EditedAt *time.Time
+
+	// ReplyToID is uuid.Nil if the message is not a reply
+	ReplyToID uuid.UUID
+	// ReplyTo is the preview of the quoted message, set on fetched messages only
+	ReplyTo *MessagePreview
+	// ForwardedFrom is nil if the message was not forwarded
+	ForwardedFrom *ForwardedFrom
+	// Reactions are counted for the user who fetches the messages
+	Reactions []ReactionCount
+	// System is set for messages the messenger writes on chat changes
+	System *SystemInfo
+	// Encrypted replaces Text in end-to-end encrypted chats, nil for plain messages
+	Encrypted *EncryptedContent
+	// Poll is nil for messages without a poll
+	Poll *Poll
+}
+
+// EncryptedContent is the message text encrypted on the sender device with a one-time message key.
+// The server stores it as is, the key reaches every device of the chat in its own envelope.
+type EncryptedContent struct {
+	Ciphertext []byte
+	Envelopes  []KeyEnvelope
+}
+
+// KeyEnvelope is the message key encrypted for one device of the recipient
+type KeyEnvelope struct {
+	RecipientID uuid.UUID
+	DeviceID    string
+	Key         []byte
+}
+
+// Reaction is an emoji reaction of the user to the message
+type Reaction struct {
+	MessageID uuid.UUID
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+	Emoji     string
+	CreatedAt time.Time
+}
+
+// ReactionCount aggregates reactions with the same emoji on the message
+type ReactionCount struct {
+	Emoji string
+	Count int
+	// Reacted is true if the requesting user left this reaction
+	Reacted bool
+}
+
+type SystemAction string
+
+const (
+	SystemActionChatCreated   SystemAction = "chat_created"
+	SystemActionMemberAdded   SystemAction = "member_added"
+	SystemActionMemberRemoved SystemAction = "member_removed"
+	SystemActionMemberLeft    SystemAction = "member_left"
+	SystemActionChatRenamed   SystemAction = "chat_renamed"
+	SystemActionAvatarChanged SystemAction = "avatar_changed"
+	SystemActionRoleChanged   SystemAction = "role_changed"
+	SystemActionMemberJoined  SystemAction = "member_joined"
+	// SystemActionMessageTTLChanged keeps the new ttl in seconds as the message text, 0 if it is turned off
+	SystemActionMessageTTLChanged SystemAction = "message_ttl_changed"
+	// SystemActionEncryptionChanged keeps "on" or "off" as the message text
+	SystemActionEncryptionChanged SystemAction = "encryption_changed"
+)
+
+// UserID is the member the change is about, text of the message holds the new chat name, avatar url or member role.
+type SystemInfo struct {
+	Action SystemAction
+	UserID uuid.UUID
+}
+
+// ForwardedFrom points to the author and the chat of the original message
+type ForwardedFrom struct {
+	SenderID uuid.UUID
+	ChatID   uuid.UUID
+}
+
+// MessagePreview is a compact view of the quoted message.
+// If the quoted message was deleted, only ID and Deleted are set.
+type MessagePreview struct {
+	ID             uuid.UUID
+	SenderID       uuid.UUID
+	Text           string
+	AttachmentType DisplayType
+	Deleted        bool
+}
+
+// PinnedMessage is a message pinned in the chat by one of its participants
+type PinnedMessage struct {
+	Message  MessagePreview
+	PinnedBy uuid.UUID
+	PinnedAt time.Time
+}
+
+// Search snippet wraps every matched word with SearchMatchStart and SearchMatchEnd
+const (
+	SearchMatchStart = "\x02"
+	SearchMatchEnd   = "\x03"
+)
+
+// MessageSearchQuery searches in all chats of the user if ChatID is empty,
+// nil From and To leave the date range open
+type MessageSearchQuery struct {
+	Text   string
+	ChatID uuid.UUID
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+}
+
+// MessageSearchResult is a found message with the matched part of its text
+type MessageSearchResult struct {
+	Message Message
+	Snippet string
+}
+
+// MessageReceipt tells when the participant got and read the message, times are nil until it happens
+type MessageReceipt struct {
+	UserID      uuid.UUID
+	DeliveredAt *time.Time
+	ReadAt      *time.Time
+}
+
+// MessagesPage is a part of chat history, the oldest message first. OlderCursor and NewerCursor
+// are creation times to continue loading from, nil when there are no more messages in that direction
+type MessagesPage struct {
+	Messages    []Message
+	OlderCursor *time.Time
+	NewerCursor *time.Time
+}
+
+// ScheduledMessage is a message written now and sent to the chat at SendAt.
+// The sent message gets the same ID, CreatedAt and UpdatedAt of Message belong to the schedule itself.
+type ScheduledMessage struct {
+	Message Message
+	SendAt  time.Time
+}
+
+type ChatEventType string
+
+const (
+	ChatEventMessageSent     ChatEventType = "message"
+	ChatEventMessageDeleted  ChatEventType = "message_delete"
+	ChatEventMessageRead     ChatEventType = "message_read"
+	ChatEventMessageEdited   ChatEventType = "message_edit"
+	ChatEventReactionAdded   ChatEventType = "reaction_add"
+	ChatEventReactionRemoved ChatEventType = "reaction_remove"
+	ChatEventMessagePinned   ChatEventType = "message_pin"
+	ChatEventMessageUnpinned ChatEventType = "message_unpin"
+)
+
+// ChatEvent is an entry of the chat history used to replay missed events after reconnect
+type ChatEvent struct {
+	Seq       int64
+	Type      ChatEventType
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+
+	// Message is set for ChatEventMessageSent and ChatEventMessageEdited events,
+	// edited message is returned in its current state
+	Message *Message
+	// Reaction is the emoji of reaction events
+	Reaction string
 }
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationPostLiked            NotificationType = "post_liked"
+	NotificationCommentLiked         NotificationType = "comment_liked"
+	NotificationPostCommented        NotificationType = "post_commented"
+	NotificationFriendRequest        NotificationType = "friend_request"
+	NotificationFriendAccepted       NotificationType = "friend_accepted"
+	NotificationCommunityRoleChanged NotificationType = "community_role_changed"
+	NotificationMention              NotificationType = "mention"
+)
+
+var NotificationTypes = []NotificationType{
+	NotificationPostLiked,
+	NotificationCommentLiked,
+	NotificationPostCommented,
+	NotificationFriendRequest,
+	NotificationFriendAccepted,
+	NotificationCommunityRoleChanged,
+	NotificationMention,
+}
+
+// MaxNotificationActors is the number of latest actors kept in a grouped notification
+const MaxNotificationActors = 3
+
+// IsGrouped reports whether notifications of the type about the same target are merged into one
+func (t NotificationType) IsGrouped() bool {
+	return t == NotificationPostLiked || t == NotificationCommentLiked
+}
+
+// Notification tells UserId that ActorId did something with TargetId.
+// Target is the post, comment or community of the event, for friend events it is the actor.
+// Content keeps a short text of the event such as the comment or the new role.
+// Grouped notifications keep the latest actors first in ActorIds and the number of all of them in ActorCount,
+// ActorId is the latest one and UpdatedAt is when they acted.
+type Notification struct {
+	Id         uuid.UUID
+	UserId     uuid.UUID
+	ActorId    uuid.UUID
+	ActorIds   []uuid.UUID
+	ActorCount int
+	Type       NotificationType
+	TargetId   uuid.UUID
+	Content    string
+	IsRead     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PollResultsVisibility string
+
+const (
+	// PollResultsAlways shows tallies to everyone from the start
+	PollResultsAlways PollResultsVisibility = "always"
+	// PollResultsAfterVote shows tallies to the user once they have voted
+	PollResultsAfterVote PollResultsVisibility = "after_vote"
+	// PollResultsAfterClose shows tallies to everyone only when the poll is closed
+	PollResultsAfterClose PollResultsVisibility = "after_close"
+)
+
+// Poll is attached to a post or a message, options are referred to by their index
+type Poll struct {
+	ID                uuid.UUID
+	Question          string
+	Options           []PollOption
+	MultipleChoice    bool
+	Anonymous         bool
+	ResultsVisibility PollResultsVisibility
+	// ClosesAt is nil for polls open until deleted
+	ClosesAt  *time.Time
+	CreatedAt time.Time
+
+	// VoterCount, Voted and ResultsHidden are set for the user who fetches the poll,
+	// Voted are indexes of options the user has chosen
+	VoterCount    int
+	Voted         []int
+	ResultsHidden bool
+}
+
+type PollOption struct {
+	Text      string
+	VoteCount int
+	// Voters are listed only in public polls
+	Voters []uuid.UUID
+}
+
+// IsClosed reports whether the poll no longer accepts votes
+func (p Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+// ResultsVisible reports whether the user who fetched the poll may see its tallies
+func (p Poll) ResultsVisible(now time.Time) bool {
+	switch p.ResultsVisibility {
+	case PollResultsAfterVote:
+		return len(p.Voted) > 0 || p.IsClosed(now)
+	case PollResultsAfterClose:
+		return p.IsClosed(now)
+	default:
+		return true
+	}
+}
+
+// HideResults drops the tallies and voters the user may not see yet, the number of voters stays visible
+func (p *Poll) HideResults(now time.Time) {
+	if p.ResultsVisible(now) {
+		return
+	}
+
+	p.ResultsHidden = true
+	for i := range p.Options {
+		p.Options[i].VoteCount = 0
+		p.Options[i].Voters = nil
+	}
+}
+
+// PublicView returns the poll as seen by a user who has not voted, it is pushed to everyone following the poll
+func (p Poll) PublicView(now time.Time) Poll {
+	public := p
+	public.Voted = nil
+	public.Options = make([]PollOption, len(p.Options))
+	copy(public.Options, p.Options)
+	public.HideResults(now)
+	return public
+}
+
+// IsValidVote reports whether the options can be chosen together, an empty vote retracts the previous one
+func (p Poll) IsValidVote(options []int) bool {
+	if len(options) > 1 && !p.MultipleChoice {
+		return false
+	}
+
+	chosen := make(map[int]struct{}, len(options))
+	for _, option := range options {
+		if option < 0 || option >= len(p.Options) {
+			return false
+		}
+		if _, ok := chosen[option]; ok {
+			return false
+		}
+		chosen[option] = struct{}{}
+	}
+	return true
+}
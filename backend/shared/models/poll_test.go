@@ -0,0 +1,100 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll_HideResults(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		visibility PollResultsVisibility
+		closesAt   *time.Time
+		voted      []int
+		hidden     bool
+	}{
+		{name: "always", visibility: PollResultsAlways},
+		{name: "after vote, not voted", visibility: PollResultsAfterVote, hidden: true},
+		{name: "after vote, voted", visibility: PollResultsAfterVote, voted: []int{1}},
+		{name: "after vote, closed", visibility: PollResultsAfterVote, closesAt: &past},
+		{name: "after close, voted", visibility: PollResultsAfterClose, closesAt: &future, voted: []int{0}, hidden: true},
+		{name: "after close, closed", visibility: PollResultsAfterClose, closesAt: &past},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			poll := Poll{
+				Options: []PollOption{
+					{Text: "yes", VoteCount: 2, Voters: []uuid.UUID{uuid.New(), uuid.New()}},
+					{Text: "no", VoteCount: 1},
+				},
+				ResultsVisibility: test.visibility,
+				ClosesAt:          test.closesAt,
+				VoterCount:        3,
+				Voted:             test.voted,
+			}
+
+			poll.HideResults(now)
+
+			assert.Equal(t, test.hidden, poll.ResultsHidden)
+			assert.Equal(t, 3, poll.VoterCount)
+			if test.hidden {
+				assert.Zero(t, poll.Options[0].VoteCount)
+				assert.Nil(t, poll.Options[0].Voters)
+			} else {
+				assert.Equal(t, 2, poll.Options[0].VoteCount)
+				assert.Len(t, poll.Options[0].Voters, 2)
+			}
+		})
+	}
+}
+
+func TestPoll_PublicView(t *testing.T) {
+	poll := Poll{
+		Options:           []PollOption{{Text: "yes", VoteCount: 1}, {Text: "no"}},
+		ResultsVisibility: PollResultsAfterVote,
+		Voted:             []int{0},
+	}
+
+	public := poll.PublicView(time.Now())
+
+	assert.True(t, public.ResultsHidden)
+	assert.Nil(t, public.Voted)
+	assert.Zero(t, public.Options[0].VoteCount)
+	// the voter still sees the tallies
+	assert.Equal(t, 1, poll.Options[0].VoteCount)
+	assert.False(t, poll.ResultsHidden)
+}
+
+func TestPoll_IsValidVote(t *testing.T) {
+	single := Poll{Options: []PollOption{{Text: "a"}, {Text: "b"}, {Text: "c"}}}
+	multiple := single
+	multiple.MultipleChoice = true
+
+	tests := []struct {
+		name    string
+		poll    Poll
+		options []int
+		valid   bool
+	}{
+		{name: "single option", poll: single, options: []int{2}, valid: true},
+		{name: "retract", poll: single, options: nil, valid: true},
+		{name: "many options in single choice poll", poll: single, options: []int{0, 1}, valid: false},
+		{name: "many options", poll: multiple, options: []int{0, 2}, valid: true},
+		{name: "repeated option", poll: multiple, options: []int{1, 1}, valid: false},
+		{name: "unknown option", poll: multiple, options: []int{3}, valid: false},
+		{name: "negative option", poll: single, options: []int{-1}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.valid, test.poll.IsValidVote(test.options))
+		})
+	}
+}
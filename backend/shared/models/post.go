@@ -26,6 +26,8 @@ type Post struct {
 	CommentCount int
 	IsRepost     bool
 	IsLiked      bool
+	// Poll is nil for posts without a poll
+	Poll *Poll
 }
 
 type PostUpdate struct {
@@ -21,6 +21,8 @@ type FileServiceClient interface {
 	UploadFile(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadFileClient, error)
 	UploadManyFiles(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadManyFilesClient, error)
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	DownloadPrivateFile(ctx context.Context, in *DownloadPrivateFileRequest, opts ...grpc.CallOption) (FileService_DownloadPrivateFileClient, error)
+	DeletePrivateFile(ctx context.Context, in *DeletePrivateFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
 }
 
 type fileServiceClient struct {
@@ -105,6 +107,47 @@ func (c *fileServiceClient) DeleteFile(ctx context.Context, in *DeleteFileReques
 	return out, nil
 }
 
+func (c *fileServiceClient) DownloadPrivateFile(ctx context.Context, in *DownloadPrivateFileRequest, opts ...grpc.CallOption) (FileService_DownloadPrivateFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FileService_ServiceDesc.Streams[2], "/file_service.FileService/DownloadPrivateFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileServiceDownloadPrivateFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FileService_DownloadPrivateFileClient interface {
+	Recv() (*DownloadPrivateFileResponse, error)
+	grpc.ClientStream
+}
+
+type fileServiceDownloadPrivateFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileServiceDownloadPrivateFileClient) Recv() (*DownloadPrivateFileResponse, error) {
+	m := new(DownloadPrivateFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fileServiceClient) DeletePrivateFile(ctx context.Context, in *DeletePrivateFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error) {
+	out := new(DeleteFileResponse)
+	err := c.cc.Invoke(ctx, "/file_service.FileService/DeletePrivateFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility
@@ -112,6 +155,8 @@ type FileServiceServer interface {
 	UploadFile(FileService_UploadFileServer) error
 	UploadManyFiles(FileService_UploadManyFilesServer) error
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	DownloadPrivateFile(*DownloadPrivateFileRequest, FileService_DownloadPrivateFileServer) error
+	DeletePrivateFile(context.Context, *DeletePrivateFileRequest) (*DeleteFileResponse, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -128,6 +173,12 @@ func (UnimplementedFileServiceServer) UploadManyFiles(FileService_UploadManyFile
 func (UnimplementedFileServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
 }
+func (UnimplementedFileServiceServer) DownloadPrivateFile(*DownloadPrivateFileRequest, FileService_DownloadPrivateFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadPrivateFile not implemented")
+}
+func (UnimplementedFileServiceServer) DeletePrivateFile(context.Context, *DeletePrivateFileRequest) (*DeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrivateFile not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 
 // UnsafeFileServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -211,6 +262,45 @@ func _FileService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_DownloadPrivateFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadPrivateFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileServiceServer).DownloadPrivateFile(m, &fileServiceDownloadPrivateFileServer{stream})
+}
+
+type FileService_DownloadPrivateFileServer interface {
+	Send(*DownloadPrivateFileResponse) error
+	grpc.ServerStream
+}
+
+type fileServiceDownloadPrivateFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileServiceDownloadPrivateFileServer) Send(m *DownloadPrivateFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FileService_DeletePrivateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrivateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).DeletePrivateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file_service.FileService/DeletePrivateFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).DeletePrivateFile(ctx, req.(*DeletePrivateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +312,10 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _FileService_DeleteFile_Handler,
 		},
+		{
+			MethodName: "DeletePrivateFile",
+			Handler:    _FileService_DeletePrivateFile_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -235,6 +329,11 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DownloadPrivateFile",
+			Handler:       _FileService_DownloadPrivateFile_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "file_service.proto",
 }
@@ -57,6 +57,46 @@ func (mr *MockFileServiceClientMockRecorder) DeleteFile(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFileServiceClient)(nil).DeleteFile), varargs...)
 }
 
+// DeletePrivateFile mocks base method.
+func (m *MockFileServiceClient) DeletePrivateFile(ctx context.Context, in *file_service.DeletePrivateFileRequest, opts ...grpc.CallOption) (*file_service.DeleteFileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePrivateFile", varargs...)
+	ret0, _ := ret[0].(*file_service.DeleteFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrivateFile indicates an expected call of DeletePrivateFile.
+func (mr *MockFileServiceClientMockRecorder) DeletePrivateFile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateFile", reflect.TypeOf((*MockFileServiceClient)(nil).DeletePrivateFile), varargs...)
+}
+
+// DownloadPrivateFile mocks base method.
+func (m *MockFileServiceClient) DownloadPrivateFile(ctx context.Context, in *file_service.DownloadPrivateFileRequest, opts ...grpc.CallOption) (file_service.FileService_DownloadPrivateFileClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DownloadPrivateFile", varargs...)
+	ret0, _ := ret[0].(file_service.FileService_DownloadPrivateFileClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPrivateFile indicates an expected call of DownloadPrivateFile.
+func (mr *MockFileServiceClientMockRecorder) DownloadPrivateFile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPrivateFile", reflect.TypeOf((*MockFileServiceClient)(nil).DownloadPrivateFile), varargs...)
+}
+
 // UploadFile mocks base method.
 func (m *MockFileServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (file_service.FileService_UploadFileClient, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +411,129 @@ func (mr *MockFileService_UploadManyFilesClientMockRecorder) Trailer() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockFileService_UploadManyFilesClient)(nil).Trailer))
 }
 
+// MockFileService_DownloadPrivateFileClient is a mock of FileService_DownloadPrivateFileClient interface.
+type MockFileService_DownloadPrivateFileClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileService_DownloadPrivateFileClientMockRecorder
+}
+
+// MockFileService_DownloadPrivateFileClientMockRecorder is the mock recorder for MockFileService_DownloadPrivateFileClient.
+type MockFileService_DownloadPrivateFileClientMockRecorder struct {
+	mock *MockFileService_DownloadPrivateFileClient
+}
+
+// NewMockFileService_DownloadPrivateFileClient creates a new mock instance.
+func NewMockFileService_DownloadPrivateFileClient(ctrl *gomock.Controller) *MockFileService_DownloadPrivateFileClient {
+	mock := &MockFileService_DownloadPrivateFileClient{ctrl: ctrl}
+	mock.recorder = &MockFileService_DownloadPrivateFileClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileService_DownloadPrivateFileClient) EXPECT() *MockFileService_DownloadPrivateFileClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockFileService_DownloadPrivateFileClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockFileService_DownloadPrivateFileClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockFileService_DownloadPrivateFileClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockFileService_DownloadPrivateFileClient) Recv() (*file_service.DownloadPrivateFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*file_service.DownloadPrivateFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockFileService_DownloadPrivateFileClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockFileService_DownloadPrivateFileClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockFileService_DownloadPrivateFileClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockFileService_DownloadPrivateFileClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockFileService_DownloadPrivateFileClient)(nil).Trailer))
+}
+
 // MockFileServiceServer is a mock of FileServiceServer interface.
 type MockFileServiceServer struct {
 	ctrl     *gomock.Controller
@@ -409,6 +572,35 @@ func (mr *MockFileServiceServerMockRecorder) DeleteFile(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFileServiceServer)(nil).DeleteFile), arg0, arg1)
 }
 
+// DeletePrivateFile mocks base method.
+func (m *MockFileServiceServer) DeletePrivateFile(arg0 context.Context, arg1 *file_service.DeletePrivateFileRequest) (*file_service.DeleteFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateFile", arg0, arg1)
+	ret0, _ := ret[0].(*file_service.DeleteFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrivateFile indicates an expected call of DeletePrivateFile.
+func (mr *MockFileServiceServerMockRecorder) DeletePrivateFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateFile", reflect.TypeOf((*MockFileServiceServer)(nil).DeletePrivateFile), arg0, arg1)
+}
+
+// DownloadPrivateFile mocks base method.
+func (m *MockFileServiceServer) DownloadPrivateFile(arg0 *file_service.DownloadPrivateFileRequest, arg1 file_service.FileService_DownloadPrivateFileServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPrivateFile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadPrivateFile indicates an expected call of DownloadPrivateFile.
+func (mr *MockFileServiceServerMockRecorder) DownloadPrivateFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPrivateFile", reflect.TypeOf((*MockFileServiceServer)(nil).DownloadPrivateFile), arg0, arg1)
+}
+
 // UploadFile mocks base method.
 func (m *MockFileServiceServer) UploadFile(arg0 file_service.FileService_UploadFileServer) error {
 	m.ctrl.T.Helper()
@@ -751,3 +943,122 @@ func (mr *MockFileService_UploadManyFilesServerMockRecorder) SetTrailer(arg0 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockFileService_UploadManyFilesServer)(nil).SetTrailer), arg0)
 }
+
+// MockFileService_DownloadPrivateFileServer is a mock of FileService_DownloadPrivateFileServer interface.
+type MockFileService_DownloadPrivateFileServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileService_DownloadPrivateFileServerMockRecorder
+}
+
+// MockFileService_DownloadPrivateFileServerMockRecorder is the mock recorder for MockFileService_DownloadPrivateFileServer.
+type MockFileService_DownloadPrivateFileServerMockRecorder struct {
+	mock *MockFileService_DownloadPrivateFileServer
+}
+
+// NewMockFileService_DownloadPrivateFileServer creates a new mock instance.
+func NewMockFileService_DownloadPrivateFileServer(ctrl *gomock.Controller) *MockFileService_DownloadPrivateFileServer {
+	mock := &MockFileService_DownloadPrivateFileServer{ctrl: ctrl}
+	mock.recorder = &MockFileService_DownloadPrivateFileServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileService_DownloadPrivateFileServer) EXPECT() *MockFileService_DownloadPrivateFileServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockFileService_DownloadPrivateFileServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockFileService_DownloadPrivateFileServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockFileService_DownloadPrivateFileServer) Send(arg0 *file_service.DownloadPrivateFileResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockFileService_DownloadPrivateFileServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockFileService_DownloadPrivateFileServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockFileService_DownloadPrivateFileServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockFileService_DownloadPrivateFileServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockFileService_DownloadPrivateFileServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockFileService_DownloadPrivateFileServer)(nil).SetTrailer), arg0)
+}
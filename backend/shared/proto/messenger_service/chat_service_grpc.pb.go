@@ -28,6 +28,24 @@ type ChatServiceClient interface {
 	GetUserChatsById(ctx context.Context, in *GetUserChatsRequest, opts ...grpc.CallOption) (*GetUserChatsResponse, error)
 	GetChatParticipants(ctx context.Context, in *GetChatParticipantsRequest, opts ...grpc.CallOption) (*GetChatParticipantsResponse, error)
 	GetNumUnreadChats(ctx context.Context, in *GetNumUnreadChatsRequest, opts ...grpc.CallOption) (*GetNumUnreadChatsResponse, error)
+	AddChatMembers(ctx context.Context, in *AddChatMembersRequest, opts ...grpc.CallOption) (*AddChatMembersResponse, error)
+	RemoveChatMember(ctx context.Context, in *RemoveChatMemberRequest, opts ...grpc.CallOption) (*RemoveChatMemberResponse, error)
+	UpdateChat(ctx context.Context, in *UpdateChatRequest, opts ...grpc.CallOption) (*UpdateChatResponse, error)
+	GetChatMembers(ctx context.Context, in *GetChatMembersRequest, opts ...grpc.CallOption) (*GetChatMembersResponse, error)
+	ChangeChatRole(ctx context.Context, in *ChangeChatRoleRequest, opts ...grpc.CallOption) (*ChangeChatRoleResponse, error)
+	CreateChatInvite(ctx context.Context, in *CreateChatInviteRequest, opts ...grpc.CallOption) (*CreateChatInviteResponse, error)
+	GetChatInvites(ctx context.Context, in *GetChatInvitesRequest, opts ...grpc.CallOption) (*GetChatInvitesResponse, error)
+	RevokeChatInvite(ctx context.Context, in *RevokeChatInviteRequest, opts ...grpc.CallOption) (*RevokeChatInviteResponse, error)
+	GetChatInvitePreview(ctx context.Context, in *GetChatInvitePreviewRequest, opts ...grpc.CallOption) (*GetChatInvitePreviewResponse, error)
+	JoinChatByInvite(ctx context.Context, in *JoinChatByInviteRequest, opts ...grpc.CallOption) (*JoinChatByInviteResponse, error)
+	MuteChat(ctx context.Context, in *MuteChatRequest, opts ...grpc.CallOption) (*MuteChatResponse, error)
+	ArchiveChat(ctx context.Context, in *ArchiveChatRequest, opts ...grpc.CallOption) (*ArchiveChatResponse, error)
+	PinChat(ctx context.Context, in *PinChatRequest, opts ...grpc.CallOption) (*PinChatResponse, error)
+	ReorderPinnedChats(ctx context.Context, in *ReorderPinnedChatsRequest, opts ...grpc.CallOption) (*ReorderPinnedChatsResponse, error)
+	SetChatMessageTTL(ctx context.Context, in *SetChatMessageTTLRequest, opts ...grpc.CallOption) (*SetChatMessageTTLResponse, error)
+	SetChatEncryption(ctx context.Context, in *SetChatEncryptionRequest, opts ...grpc.CallOption) (*SetChatEncryptionResponse, error)
+	RequestChatExport(ctx context.Context, in *RequestChatExportRequest, opts ...grpc.CallOption) (*RequestChatExportResponse, error)
+	GetChatExport(ctx context.Context, in *GetChatExportRequest, opts ...grpc.CallOption) (*GetChatExportResponse, error)
 }
 
 type chatServiceClient struct {
@@ -128,6 +146,168 @@ func (c *chatServiceClient) GetNumUnreadChats(ctx context.Context, in *GetNumUnr
 	return out, nil
 }
 
+func (c *chatServiceClient) AddChatMembers(ctx context.Context, in *AddChatMembersRequest, opts ...grpc.CallOption) (*AddChatMembersResponse, error) {
+	out := new(AddChatMembersResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/AddChatMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveChatMember(ctx context.Context, in *RemoveChatMemberRequest, opts ...grpc.CallOption) (*RemoveChatMemberResponse, error) {
+	out := new(RemoveChatMemberResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/RemoveChatMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UpdateChat(ctx context.Context, in *UpdateChatRequest, opts ...grpc.CallOption) (*UpdateChatResponse, error) {
+	out := new(UpdateChatResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/UpdateChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChatMembers(ctx context.Context, in *GetChatMembersRequest, opts ...grpc.CallOption) (*GetChatMembersResponse, error) {
+	out := new(GetChatMembersResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/GetChatMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ChangeChatRole(ctx context.Context, in *ChangeChatRoleRequest, opts ...grpc.CallOption) (*ChangeChatRoleResponse, error) {
+	out := new(ChangeChatRoleResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/ChangeChatRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateChatInvite(ctx context.Context, in *CreateChatInviteRequest, opts ...grpc.CallOption) (*CreateChatInviteResponse, error) {
+	out := new(CreateChatInviteResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/CreateChatInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChatInvites(ctx context.Context, in *GetChatInvitesRequest, opts ...grpc.CallOption) (*GetChatInvitesResponse, error) {
+	out := new(GetChatInvitesResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/GetChatInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RevokeChatInvite(ctx context.Context, in *RevokeChatInviteRequest, opts ...grpc.CallOption) (*RevokeChatInviteResponse, error) {
+	out := new(RevokeChatInviteResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/RevokeChatInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChatInvitePreview(ctx context.Context, in *GetChatInvitePreviewRequest, opts ...grpc.CallOption) (*GetChatInvitePreviewResponse, error) {
+	out := new(GetChatInvitePreviewResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/GetChatInvitePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) JoinChatByInvite(ctx context.Context, in *JoinChatByInviteRequest, opts ...grpc.CallOption) (*JoinChatByInviteResponse, error) {
+	out := new(JoinChatByInviteResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/JoinChatByInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MuteChat(ctx context.Context, in *MuteChatRequest, opts ...grpc.CallOption) (*MuteChatResponse, error) {
+	out := new(MuteChatResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/MuteChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ArchiveChat(ctx context.Context, in *ArchiveChatRequest, opts ...grpc.CallOption) (*ArchiveChatResponse, error) {
+	out := new(ArchiveChatResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/ArchiveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PinChat(ctx context.Context, in *PinChatRequest, opts ...grpc.CallOption) (*PinChatResponse, error) {
+	out := new(PinChatResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/PinChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ReorderPinnedChats(ctx context.Context, in *ReorderPinnedChatsRequest, opts ...grpc.CallOption) (*ReorderPinnedChatsResponse, error) {
+	out := new(ReorderPinnedChatsResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/ReorderPinnedChats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChatMessageTTL(ctx context.Context, in *SetChatMessageTTLRequest, opts ...grpc.CallOption) (*SetChatMessageTTLResponse, error) {
+	out := new(SetChatMessageTTLResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/SetChatMessageTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChatEncryption(ctx context.Context, in *SetChatEncryptionRequest, opts ...grpc.CallOption) (*SetChatEncryptionResponse, error) {
+	out := new(SetChatEncryptionResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/SetChatEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RequestChatExport(ctx context.Context, in *RequestChatExportRequest, opts ...grpc.CallOption) (*RequestChatExportResponse, error) {
+	out := new(RequestChatExportResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/RequestChatExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChatExport(ctx context.Context, in *GetChatExportRequest, opts ...grpc.CallOption) (*GetChatExportResponse, error) {
+	out := new(GetChatExportResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.ChatService/GetChatExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -142,6 +322,24 @@ type ChatServiceServer interface {
 	GetUserChatsById(context.Context, *GetUserChatsRequest) (*GetUserChatsResponse, error)
 	GetChatParticipants(context.Context, *GetChatParticipantsRequest) (*GetChatParticipantsResponse, error)
 	GetNumUnreadChats(context.Context, *GetNumUnreadChatsRequest) (*GetNumUnreadChatsResponse, error)
+	AddChatMembers(context.Context, *AddChatMembersRequest) (*AddChatMembersResponse, error)
+	RemoveChatMember(context.Context, *RemoveChatMemberRequest) (*RemoveChatMemberResponse, error)
+	UpdateChat(context.Context, *UpdateChatRequest) (*UpdateChatResponse, error)
+	GetChatMembers(context.Context, *GetChatMembersRequest) (*GetChatMembersResponse, error)
+	ChangeChatRole(context.Context, *ChangeChatRoleRequest) (*ChangeChatRoleResponse, error)
+	CreateChatInvite(context.Context, *CreateChatInviteRequest) (*CreateChatInviteResponse, error)
+	GetChatInvites(context.Context, *GetChatInvitesRequest) (*GetChatInvitesResponse, error)
+	RevokeChatInvite(context.Context, *RevokeChatInviteRequest) (*RevokeChatInviteResponse, error)
+	GetChatInvitePreview(context.Context, *GetChatInvitePreviewRequest) (*GetChatInvitePreviewResponse, error)
+	JoinChatByInvite(context.Context, *JoinChatByInviteRequest) (*JoinChatByInviteResponse, error)
+	MuteChat(context.Context, *MuteChatRequest) (*MuteChatResponse, error)
+	ArchiveChat(context.Context, *ArchiveChatRequest) (*ArchiveChatResponse, error)
+	PinChat(context.Context, *PinChatRequest) (*PinChatResponse, error)
+	ReorderPinnedChats(context.Context, *ReorderPinnedChatsRequest) (*ReorderPinnedChatsResponse, error)
+	SetChatMessageTTL(context.Context, *SetChatMessageTTLRequest) (*SetChatMessageTTLResponse, error)
+	SetChatEncryption(context.Context, *SetChatEncryptionRequest) (*SetChatEncryptionResponse, error)
+	RequestChatExport(context.Context, *RequestChatExportRequest) (*RequestChatExportResponse, error)
+	GetChatExport(context.Context, *GetChatExportRequest) (*GetChatExportResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -179,6 +377,60 @@ func (UnimplementedChatServiceServer) GetChatParticipants(context.Context, *GetC
 func (UnimplementedChatServiceServer) GetNumUnreadChats(context.Context, *GetNumUnreadChatsRequest) (*GetNumUnreadChatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNumUnreadChats not implemented")
 }
+func (UnimplementedChatServiceServer) AddChatMembers(context.Context, *AddChatMembersRequest) (*AddChatMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMembers not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveChatMember(context.Context, *RemoveChatMemberRequest) (*RemoveChatMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatMember not implemented")
+}
+func (UnimplementedChatServiceServer) UpdateChat(context.Context, *UpdateChatRequest) (*UpdateChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChat not implemented")
+}
+func (UnimplementedChatServiceServer) GetChatMembers(context.Context, *GetChatMembersRequest) (*GetChatMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatMembers not implemented")
+}
+func (UnimplementedChatServiceServer) ChangeChatRole(context.Context, *ChangeChatRoleRequest) (*ChangeChatRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeChatRole not implemented")
+}
+func (UnimplementedChatServiceServer) CreateChatInvite(context.Context, *CreateChatInviteRequest) (*CreateChatInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChatInvite not implemented")
+}
+func (UnimplementedChatServiceServer) GetChatInvites(context.Context, *GetChatInvitesRequest) (*GetChatInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatInvites not implemented")
+}
+func (UnimplementedChatServiceServer) RevokeChatInvite(context.Context, *RevokeChatInviteRequest) (*RevokeChatInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeChatInvite not implemented")
+}
+func (UnimplementedChatServiceServer) GetChatInvitePreview(context.Context, *GetChatInvitePreviewRequest) (*GetChatInvitePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatInvitePreview not implemented")
+}
+func (UnimplementedChatServiceServer) JoinChatByInvite(context.Context, *JoinChatByInviteRequest) (*JoinChatByInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChatByInvite not implemented")
+}
+func (UnimplementedChatServiceServer) MuteChat(context.Context, *MuteChatRequest) (*MuteChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteChat not implemented")
+}
+func (UnimplementedChatServiceServer) ArchiveChat(context.Context, *ArchiveChatRequest) (*ArchiveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveChat not implemented")
+}
+func (UnimplementedChatServiceServer) PinChat(context.Context, *PinChatRequest) (*PinChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinChat not implemented")
+}
+func (UnimplementedChatServiceServer) ReorderPinnedChats(context.Context, *ReorderPinnedChatsRequest) (*ReorderPinnedChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPinnedChats not implemented")
+}
+func (UnimplementedChatServiceServer) SetChatMessageTTL(context.Context, *SetChatMessageTTLRequest) (*SetChatMessageTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatMessageTTL not implemented")
+}
+func (UnimplementedChatServiceServer) SetChatEncryption(context.Context, *SetChatEncryptionRequest) (*SetChatEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatEncryption not implemented")
+}
+func (UnimplementedChatServiceServer) RequestChatExport(context.Context, *RequestChatExportRequest) (*RequestChatExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestChatExport not implemented")
+}
+func (UnimplementedChatServiceServer) GetChatExport(context.Context, *GetChatExportRequest) (*GetChatExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatExport not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -372,6 +624,330 @@ func _ChatService_GetNumUnreadChats_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_AddChatMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddChatMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/AddChatMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddChatMembers(ctx, req.(*AddChatMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveChatMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveChatMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/RemoveChatMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveChatMember(ctx, req.(*RemoveChatMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/UpdateChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateChat(ctx, req.(*UpdateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChatMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/GetChatMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatMembers(ctx, req.(*GetChatMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ChangeChatRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeChatRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ChangeChatRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/ChangeChatRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ChangeChatRole(ctx, req.(*ChangeChatRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateChatInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChatInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateChatInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/CreateChatInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateChatInvite(ctx, req.(*CreateChatInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChatInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/GetChatInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatInvites(ctx, req.(*GetChatInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RevokeChatInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeChatInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RevokeChatInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/RevokeChatInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RevokeChatInvite(ctx, req.(*RevokeChatInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChatInvitePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatInvitePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatInvitePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/GetChatInvitePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatInvitePreview(ctx, req.(*GetChatInvitePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_JoinChatByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChatByInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).JoinChatByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/JoinChatByInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).JoinChatByInvite(ctx, req.(*JoinChatByInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MuteChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MuteChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/MuteChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MuteChat(ctx, req.(*MuteChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ArchiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ArchiveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/ArchiveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ArchiveChat(ctx, req.(*ArchiveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PinChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PinChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/PinChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PinChat(ctx, req.(*PinChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ReorderPinnedChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPinnedChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ReorderPinnedChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/ReorderPinnedChats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ReorderPinnedChats(ctx, req.(*ReorderPinnedChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChatMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChatMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/SetChatMessageTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChatMessageTTL(ctx, req.(*SetChatMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChatEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatEncryptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChatEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/SetChatEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChatEncryption(ctx, req.(*SetChatEncryptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RequestChatExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestChatExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RequestChatExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/RequestChatExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RequestChatExport(ctx, req.(*RequestChatExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChatExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.ChatService/GetChatExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatExport(ctx, req.(*GetChatExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +995,78 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNumUnreadChats",
 			Handler:    _ChatService_GetNumUnreadChats_Handler,
 		},
+		{
+			MethodName: "AddChatMembers",
+			Handler:    _ChatService_AddChatMembers_Handler,
+		},
+		{
+			MethodName: "RemoveChatMember",
+			Handler:    _ChatService_RemoveChatMember_Handler,
+		},
+		{
+			MethodName: "UpdateChat",
+			Handler:    _ChatService_UpdateChat_Handler,
+		},
+		{
+			MethodName: "GetChatMembers",
+			Handler:    _ChatService_GetChatMembers_Handler,
+		},
+		{
+			MethodName: "ChangeChatRole",
+			Handler:    _ChatService_ChangeChatRole_Handler,
+		},
+		{
+			MethodName: "CreateChatInvite",
+			Handler:    _ChatService_CreateChatInvite_Handler,
+		},
+		{
+			MethodName: "GetChatInvites",
+			Handler:    _ChatService_GetChatInvites_Handler,
+		},
+		{
+			MethodName: "RevokeChatInvite",
+			Handler:    _ChatService_RevokeChatInvite_Handler,
+		},
+		{
+			MethodName: "GetChatInvitePreview",
+			Handler:    _ChatService_GetChatInvitePreview_Handler,
+		},
+		{
+			MethodName: "JoinChatByInvite",
+			Handler:    _ChatService_JoinChatByInvite_Handler,
+		},
+		{
+			MethodName: "MuteChat",
+			Handler:    _ChatService_MuteChat_Handler,
+		},
+		{
+			MethodName: "ArchiveChat",
+			Handler:    _ChatService_ArchiveChat_Handler,
+		},
+		{
+			MethodName: "PinChat",
+			Handler:    _ChatService_PinChat_Handler,
+		},
+		{
+			MethodName: "ReorderPinnedChats",
+			Handler:    _ChatService_ReorderPinnedChats_Handler,
+		},
+		{
+			MethodName: "SetChatMessageTTL",
+			Handler:    _ChatService_SetChatMessageTTL_Handler,
+		},
+		{
+			MethodName: "SetChatEncryption",
+			Handler:    _ChatService_SetChatEncryption_Handler,
+		},
+		{
+			MethodName: "RequestChatExport",
+			Handler:    _ChatService_RequestChatExport_Handler,
+		},
+		{
+			MethodName: "GetChatExport",
+			Handler:    _ChatService_GetChatExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat_service.proto",
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.36.6
 // 	protoc        v3.21.12
 // source: message_service.proto
 
@@ -11,8 +11,10 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	file_service "quickflow/shared/proto/file_service"
+	poll "quickflow/shared/proto/poll"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -23,27 +25,34 @@ const (
 )
 
 type Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	ReceiverId    string                 `protobuf:"bytes,4,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Attachments   []*file_service.File   `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Seq           int64                  `protobuf:"varint,9,opt,name=seq,proto3" json:"seq,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	ReplyToId     string                 `protobuf:"bytes,11,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+	ReplyTo       *MessagePreview        `protobuf:"bytes,12,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ForwardedFrom *ForwardedFrom         `protobuf:"bytes,13,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	Reactions     []*ReactionCount       `protobuf:"bytes,14,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	System        *SystemInfo            `protobuf:"bytes,15,opt,name=system,proto3" json:"system,omitempty"`
+	// encrypted replaces text in end-to-end encrypted chats
+	Encrypted     *EncryptedContent `protobuf:"bytes,16,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	Poll          *poll.Poll        `protobuf:"bytes,17,opt,name=poll,proto3" json:"poll,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChatId      string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	SenderId    string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	ReceiverId  string                 `protobuf:"bytes,4,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
-	Text        string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Attachments []*file_service.File   `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_message_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Message) String() string {
@@ -54,7 +63,7 @@ func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -125,103 +134,93 @@ func (x *Message) GetAttachments() []*file_service.File {
 	return nil
 }
 
-type GetMessagesForChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ChatId      string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	MessagesNum int32                  `protobuf:"varint,2,opt,name=messages_num,json=messagesNum,proto3" json:"messages_num,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UserAuthId  string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+func (x *Message) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
 }
 
-func (x *GetMessagesForChatRequest) Reset() {
-	*x = GetMessagesForChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Message) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
 	}
+	return nil
 }
 
-func (x *GetMessagesForChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Message) GetReplyToId() string {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return ""
 }
 
-func (*GetMessagesForChatRequest) ProtoMessage() {}
-
-func (x *GetMessagesForChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Message) GetReplyTo() *MessagePreview {
+	if x != nil {
+		return x.ReplyTo
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetMessagesForChatRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesForChatRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{1}
+func (x *Message) GetForwardedFrom() *ForwardedFrom {
+	if x != nil {
+		return x.ForwardedFrom
+	}
+	return nil
 }
 
-func (x *GetMessagesForChatRequest) GetChatId() string {
+func (x *Message) GetReactions() []*ReactionCount {
 	if x != nil {
-		return x.ChatId
+		return x.Reactions
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMessagesForChatRequest) GetMessagesNum() int32 {
+func (x *Message) GetSystem() *SystemInfo {
 	if x != nil {
-		return x.MessagesNum
+		return x.System
 	}
-	return 0
+	return nil
 }
 
-func (x *GetMessagesForChatRequest) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Message) GetEncrypted() *EncryptedContent {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Encrypted
 	}
 	return nil
 }
 
-func (x *GetMessagesForChatRequest) GetUserAuthId() string {
+func (x *Message) GetPoll() *poll.Poll {
 	if x != nil {
-		return x.UserAuthId
+		return x.Poll
 	}
-	return ""
+	return nil
 }
 
-type GetMessagesForChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type EncryptedContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext    []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Envelopes     []*KeyEnvelope         `protobuf:"bytes,2,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesForChatResponse) Reset() {
-	*x = GetMessagesForChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *EncryptedContent) Reset() {
+	*x = EncryptedContent{}
+	mi := &file_message_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesForChatResponse) String() string {
+func (x *EncryptedContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesForChatResponse) ProtoMessage() {}
+func (*EncryptedContent) ProtoMessage() {}
 
-func (x *GetMessagesForChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *EncryptedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[1]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -231,45 +230,50 @@ func (x *GetMessagesForChatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesForChatResponse.ProtoReflect.Descriptor instead.
-func (*GetMessagesForChatResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EncryptedContent.ProtoReflect.Descriptor instead.
+func (*EncryptedContent) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetMessagesForChatResponse) GetMessages() []*Message {
+func (x *EncryptedContent) GetCiphertext() []byte {
 	if x != nil {
-		return x.Messages
+		return x.Ciphertext
 	}
 	return nil
 }
 
-type SendMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EncryptedContent) GetEnvelopes() []*KeyEnvelope {
+	if x != nil {
+		return x.Envelopes
+	}
+	return nil
+}
 
-	Message    *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	UserAuthId string   `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+type KeyEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Key           []byte                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *KeyEnvelope) Reset() {
+	*x = KeyEnvelope{}
+	mi := &file_message_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *KeyEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*KeyEnvelope) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *KeyEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[2]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -279,51 +283,56 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use KeyEnvelope.ProtoReflect.Descriptor instead.
+func (*KeyEnvelope) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SendMessageRequest) GetMessage() *Message {
+func (x *KeyEnvelope) GetRecipientId() string {
 	if x != nil {
-		return x.Message
+		return x.RecipientId
 	}
-	return nil
+	return ""
 }
 
-func (x *SendMessageRequest) GetUserAuthId() string {
+func (x *KeyEnvelope) GetDeviceId() string {
 	if x != nil {
-		return x.UserAuthId
+		return x.DeviceId
 	}
 	return ""
 }
 
-type SendMessageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *KeyEnvelope) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_message_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *SystemInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*SystemInfo) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[3]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -333,44 +342,50 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SendMessageResponse) GetMessage() *Message {
+func (x *SystemInfo) GetAction() string {
 	if x != nil {
-		return x.Message
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-type DeleteMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SystemInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
 
-	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reacted       bool                   `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMessageRequest) Reset() {
-	*x = DeleteMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_message_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMessageRequest) String() string {
+func (x *ReactionCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMessageRequest) ProtoMessage() {}
+func (*ReactionCount) ProtoMessage() {}
 
-func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -380,44 +395,56 @@ func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteMessageRequest) GetMessageId() string {
+func (x *ReactionCount) GetEmoji() string {
 	if x != nil {
-		return x.MessageId
+		return x.Emoji
 	}
 	return ""
 }
 
-type DeleteMessageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *DeleteMessageResponse) Reset() {
-	*x = DeleteMessageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReactionCount) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
 	}
+	return false
 }
 
-func (x *DeleteMessageResponse) String() string {
+type ForwardedFrom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardedFrom) Reset() {
+	*x = ForwardedFrom{}
+	mi := &file_message_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardedFrom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMessageResponse) ProtoMessage() {}
+func (*ForwardedFrom) ProtoMessage() {}
 
-func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ForwardedFrom) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[5]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -427,47 +454,52 @@ func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ForwardedFrom.ProtoReflect.Descriptor instead.
+func (*ForwardedFrom) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteMessageResponse) GetSuccess() bool {
+func (x *ForwardedFrom) GetSenderId() string {
 	if x != nil {
-		return x.Success
+		return x.SenderId
 	}
-	return false
+	return ""
 }
 
-type UpdateLastReadTsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ForwardedFrom) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
 
-	ChatId            string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LastReadTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_read_timestamp,json=lastReadTimestamp,proto3" json:"last_read_timestamp,omitempty"`
-	UserAuthId        string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+type MessagePreview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId       string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Text           string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	AttachmentType string                 `protobuf:"bytes,4,opt,name=attachment_type,json=attachmentType,proto3" json:"attachment_type,omitempty"`
+	Deleted        bool                   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateLastReadTsRequest) Reset() {
-	*x = UpdateLastReadTsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *MessagePreview) Reset() {
+	*x = MessagePreview{}
+	mi := &file_message_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLastReadTsRequest) String() string {
+func (x *MessagePreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLastReadTsRequest) ProtoMessage() {}
+func (*MessagePreview) ProtoMessage() {}
 
-func (x *UpdateLastReadTsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *MessagePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[6]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -477,65 +509,71 @@ func (x *UpdateLastReadTsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLastReadTsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLastReadTsRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MessagePreview.ProtoReflect.Descriptor instead.
+func (*MessagePreview) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateLastReadTsRequest) GetChatId() string {
+func (x *MessagePreview) GetId() string {
 	if x != nil {
-		return x.ChatId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateLastReadTsRequest) GetUserId() string {
+func (x *MessagePreview) GetSenderId() string {
 	if x != nil {
-		return x.UserId
+		return x.SenderId
 	}
 	return ""
 }
 
-func (x *UpdateLastReadTsRequest) GetLastReadTimestamp() *timestamppb.Timestamp {
+func (x *MessagePreview) GetText() string {
 	if x != nil {
-		return x.LastReadTimestamp
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateLastReadTsRequest) GetUserAuthId() string {
+func (x *MessagePreview) GetAttachmentType() string {
 	if x != nil {
-		return x.UserAuthId
+		return x.AttachmentType
 	}
 	return ""
 }
 
-type UpdateLastReadTsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MessagePreview) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type PinnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MessagePreview        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PinnedBy      string                 `protobuf:"bytes,2,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	PinnedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLastReadTsResponse) Reset() {
-	*x = UpdateLastReadTsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
+	mi := &file_message_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLastReadTsResponse) String() string {
+func (x *PinnedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLastReadTsResponse) ProtoMessage() {}
+func (*PinnedMessage) ProtoMessage() {}
 
-func (x *UpdateLastReadTsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[7]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -545,45 +583,2226 @@ func (x *UpdateLastReadTsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLastReadTsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLastReadTsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PinnedMessage) GetMessage() *MessagePreview {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetPinnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return nil
+}
+
+type GetMessagesForChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessagesNum   int32                  `protobuf:"varint,2,opt,name=messages_num,json=messagesNum,proto3" json:"messages_num,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	AnchorId      string                 `protobuf:"bytes,5,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	After         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesForChatRequest) Reset() {
+	*x = GetMessagesForChatRequest{}
+	mi := &file_message_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesForChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesForChatRequest) ProtoMessage() {}
+
+func (x *GetMessagesForChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesForChatRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesForChatRequest) Descriptor() ([]byte, []int) {
 	return file_message_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateLastReadTsResponse) GetSuccess() bool {
+func (x *GetMessagesForChatRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *GetMessagesForChatRequest) GetMessagesNum() int32 {
+	if x != nil {
+		return x.MessagesNum
+	}
+	return 0
+}
+
+func (x *GetMessagesForChatRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetMessagesForChatRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+func (x *GetMessagesForChatRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *GetMessagesForChatRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type GetMessagesForChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	OlderCursor   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=older_cursor,json=olderCursor,proto3" json:"older_cursor,omitempty"`
+	NewerCursor   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=newer_cursor,json=newerCursor,proto3" json:"newer_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesForChatResponse) Reset() {
+	*x = GetMessagesForChatResponse{}
+	mi := &file_message_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesForChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesForChatResponse) ProtoMessage() {}
+
+func (x *GetMessagesForChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesForChatResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesForChatResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMessagesForChatResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessagesForChatResponse) GetOlderCursor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OlderCursor
+	}
+	return nil
+}
+
+func (x *GetMessagesForChatResponse) GetNewerCursor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NewerCursor
+	}
+	return nil
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendMessageRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type UpdateMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMessageRequest) Reset() {
+	*x = UpdateMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMessageRequest) ProtoMessage() {}
+
+func (x *UpdateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateMessageRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *UpdateMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type UpdateMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMessageResponse) Reset() {
+	*x = UpdateMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMessageResponse) ProtoMessage() {}
+
+func (x *UpdateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *UpdateMessageResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type ForwardMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageIds    []string               `protobuf:"bytes,1,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	ReceiverId    string                 `protobuf:"bytes,3,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardMessagesRequest) Reset() {
+	*x = ForwardMessagesRequest{}
+	mi := &file_message_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessagesRequest) ProtoMessage() {}
+
+func (x *ForwardMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForwardMessagesRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *ForwardMessagesRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *ForwardMessagesRequest) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *ForwardMessagesRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type ForwardMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardMessagesResponse) Reset() {
+	*x = ForwardMessagesResponse{}
+	mi := &file_message_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessagesResponse) ProtoMessage() {}
+
+func (x *ForwardMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ForwardMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,3,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_message_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_message_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddReactionResponse) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *AddReactionResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,3,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_message_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_message_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveReactionResponse) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *RemoveReactionResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PinMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PinMessageResponse) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *PinMessageResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnpinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *UnpinMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type UnpinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnpinMessageResponse) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *UnpinMessageResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// options replace the previous vote of the user, empty options retract it
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Options       []int32                `protobuf:"varint,2,rep,packed,name=options,proto3" json:"options,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,3,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_message_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VotePollRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetOptions() []int32 {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *VotePollRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_message_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VotePollResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageSearchResult) Reset() {
+	*x = MessageSearchResult{}
+	mi := &file_message_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSearchResult) ProtoMessage() {}
+
+func (x *MessageSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSearchResult.ProtoReflect.Descriptor instead.
+func (*MessageSearchResult) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MessageSearchResult) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessageSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAuthId    string                 `protobuf:"bytes,1,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	ChatId        string                 `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_message_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchMessagesRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MessageSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_message_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchMessagesResponse) GetResults() []*MessageSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DeleteMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteMessageResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type UpdateLastReadTsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ChatId            string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastReadTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_read_timestamp,json=lastReadTimestamp,proto3" json:"last_read_timestamp,omitempty"`
+	UserAuthId        string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	// read message, replayed read events carry it like the live ones
+	MessageId     string `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLastReadTsRequest) Reset() {
+	*x = UpdateLastReadTsRequest{}
+	mi := &file_message_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLastReadTsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastReadTsRequest) ProtoMessage() {}
+
+func (x *UpdateLastReadTsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastReadTsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLastReadTsRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateLastReadTsRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *UpdateLastReadTsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateLastReadTsRequest) GetLastReadTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReadTimestamp
+	}
+	return nil
+}
+
+func (x *UpdateLastReadTsRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+func (x *UpdateLastReadTsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type UpdateLastReadTsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLastReadTsResponse) Reset() {
+	*x = UpdateLastReadTsResponse{}
+	mi := &file_message_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLastReadTsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastReadTsResponse) ProtoMessage() {}
+
+func (x *UpdateLastReadTsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastReadTsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLastReadTsResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateLastReadTsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateLastReadTsResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// UpdateLastDeliveredTs records that messages up to the timestamp reached the user's device
+type UpdateLastDeliveredTsRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ChatId                 string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	LastDeliveredTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_delivered_timestamp,json=lastDeliveredTimestamp,proto3" json:"last_delivered_timestamp,omitempty"`
+	UserAuthId             string                 `protobuf:"bytes,3,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateLastDeliveredTsRequest) Reset() {
+	*x = UpdateLastDeliveredTsRequest{}
+	mi := &file_message_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLastDeliveredTsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastDeliveredTsRequest) ProtoMessage() {}
+
+func (x *UpdateLastDeliveredTsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastDeliveredTsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLastDeliveredTsRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateLastDeliveredTsRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *UpdateLastDeliveredTsRequest) GetLastDeliveredTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDeliveredTimestamp
+	}
+	return nil
+}
+
+func (x *UpdateLastDeliveredTsRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type UpdateLastDeliveredTsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// false if the user already got a message that is not older, e.g. on another gateway instance
+	Updated       bool `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLastDeliveredTsResponse) Reset() {
+	*x = UpdateLastDeliveredTsResponse{}
+	mi := &file_message_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLastDeliveredTsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastDeliveredTsResponse) ProtoMessage() {}
+
+func (x *UpdateLastDeliveredTsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastDeliveredTsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLastDeliveredTsResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateLastDeliveredTsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateLastDeliveredTsResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+// delivered_at and read_at are not set until the participant got or read the message
+type MessageReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageReceipt) Reset() {
+	*x = MessageReceipt{}
+	mi := &file_message_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReceipt) ProtoMessage() {}
+
+func (x *MessageReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReceipt.ProtoReflect.Descriptor instead.
+func (*MessageReceipt) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MessageReceipt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MessageReceipt) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *MessageReceipt) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+type GetMessageReceiptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReceiptsRequest) Reset() {
+	*x = GetMessageReceiptsRequest{}
+	mi := &file_message_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReceiptsRequest) ProtoMessage() {}
+
+func (x *GetMessageReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMessageReceiptsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *GetMessageReceiptsRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type GetMessageReceiptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipts      []*MessageReceipt      `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReceiptsResponse) Reset() {
+	*x = GetMessageReceiptsResponse{}
+	mi := &file_message_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReceiptsResponse) ProtoMessage() {}
+
+func (x *GetMessageReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMessageReceiptsResponse) GetReceipts() []*MessageReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type GetLastReadTsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLastReadTsRequest) Reset() {
+	*x = GetLastReadTsRequest{}
+	mi := &file_message_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastReadTsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastReadTsRequest) ProtoMessage() {}
+
+func (x *GetLastReadTsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastReadTsRequest.ProtoReflect.Descriptor instead.
+func (*GetLastReadTsRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetLastReadTsRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *GetLastReadTsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLastReadTsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastReadTs    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_read_ts,json=lastReadTs,proto3" json:"last_read_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLastReadTsResponse) Reset() {
+	*x = GetLastReadTsResponse{}
+	mi := &file_message_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastReadTsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastReadTsResponse) ProtoMessage() {}
+
+func (x *GetLastReadTsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastReadTsResponse.ProtoReflect.Descriptor instead.
+func (*GetLastReadTsResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetLastReadTsResponse) GetLastReadTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReadTs
+	}
+	return nil
+}
+
+type GetMessageByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageByIdRequest) Reset() {
+	*x = GetMessageByIdRequest{}
+	mi := &file_message_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageByIdRequest) ProtoMessage() {}
+
+func (x *GetMessageByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageByIdRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMessageByIdRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type GetMessageByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageByIdResponse) Reset() {
+	*x = GetMessageByIdResponse{}
+	mi := &file_message_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageByIdResponse) ProtoMessage() {}
+
+func (x *GetMessageByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageByIdResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetMessageByIdResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type GetNumUnreadMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNumUnreadMessagesRequest) Reset() {
+	*x = GetNumUnreadMessagesRequest{}
+	mi := &file_message_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNumUnreadMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNumUnreadMessagesRequest) ProtoMessage() {}
+
+func (x *GetNumUnreadMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNumUnreadMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetNumUnreadMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetNumUnreadMessagesRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *GetNumUnreadMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetNumUnreadMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumMessages   int32                  `protobuf:"varint,1,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNumUnreadMessagesResponse) Reset() {
+	*x = GetNumUnreadMessagesResponse{}
+	mi := &file_message_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNumUnreadMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNumUnreadMessagesResponse) ProtoMessage() {}
+
+func (x *GetNumUnreadMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNumUnreadMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetNumUnreadMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetNumUnreadMessagesResponse) GetNumMessages() int32 {
+	if x != nil {
+		return x.NumMessages
+	}
+	return 0
+}
+
+type ChatEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ChatId        string                 `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Message       *Message               `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Reaction      string                 `protobuf:"bytes,8,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_message_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChatEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ChatEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type GetChatEventsSinceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,4,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatEventsSinceRequest) Reset() {
+	*x = GetChatEventsSinceRequest{}
+	mi := &file_message_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatEventsSinceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatEventsSinceRequest) ProtoMessage() {}
+
+func (x *GetChatEventsSinceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatEventsSinceRequest.ProtoReflect.Descriptor instead.
+func (*GetChatEventsSinceRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetChatEventsSinceRequest) GetChatId() string {
 	if x != nil {
-		return x.Success
+		return x.ChatId
 	}
-	return false
+	return ""
 }
 
-type GetLastReadTsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *GetChatEventsSinceRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetChatEventsSinceRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetChatEventsSinceRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type GetChatEventsSinceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ChatEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	ChatId string `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *GetChatEventsSinceResponse) Reset() {
+	*x = GetChatEventsSinceResponse{}
+	mi := &file_message_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastReadTsRequest) Reset() {
-	*x = GetLastReadTsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[9]
+func (x *GetChatEventsSinceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatEventsSinceResponse) ProtoMessage() {}
+
+func (x *GetChatEventsSinceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[46]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetLastReadTsRequest) String() string {
+// Deprecated: Use GetChatEventsSinceResponse.ProtoReflect.Descriptor instead.
+func (*GetChatEventsSinceResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetChatEventsSinceResponse) GetEvents() []*ChatEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ScheduledMessage is sent as message at send_at, the sent message keeps its id
+type ScheduledMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_message_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastReadTsRequest) ProtoMessage() {}
+func (*ScheduledMessage) ProtoMessage() {}
 
-func (x *GetLastReadTsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[47]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -593,51 +2812,100 @@ func (x *GetLastReadTsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastReadTsRequest.ProtoReflect.Descriptor instead.
-func (*GetLastReadTsRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetLastReadTsRequest) GetChatId() string {
+func (x *ScheduledMessage) GetMessage() *Message {
 	if x != nil {
-		return x.ChatId
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *GetLastReadTsRequest) GetUserId() string {
+func (x *ScheduledMessage) GetSendAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.SendAt
 	}
-	return ""
+	return nil
 }
 
-type GetLastReadTsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ScheduleMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	UserAuthId       string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-	LastReadTs *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_read_ts,json=lastReadTs,proto3" json:"last_read_ts,omitempty"`
+func (x *ScheduleMessageRequest) Reset() {
+	*x = ScheduleMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastReadTsResponse) Reset() {
-	*x = GetLastReadTsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[10]
+func (x *ScheduleMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageRequest) ProtoMessage() {}
+
+func (x *ScheduleMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[48]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetLastReadTsResponse) String() string {
+// Deprecated: Use ScheduleMessageRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ScheduleMessageRequest) GetScheduledMessage() *ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return nil
+}
+
+func (x *ScheduleMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
+
+type ScheduleMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageResponse) Reset() {
+	*x = ScheduleMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastReadTsResponse) ProtoMessage() {}
+func (*ScheduleMessageResponse) ProtoMessage() {}
 
-func (x *GetLastReadTsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ScheduleMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[49]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -647,44 +2915,42 @@ func (x *GetLastReadTsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastReadTsResponse.ProtoReflect.Descriptor instead.
-func (*GetLastReadTsResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ScheduleMessageResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetLastReadTsResponse) GetLastReadTs() *timestamppb.Timestamp {
+func (x *ScheduleMessageResponse) GetScheduledMessage() *ScheduledMessage {
 	if x != nil {
-		return x.LastReadTs
+		return x.ScheduledMessage
 	}
 	return nil
 }
 
-type GetMessageByIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type GetScheduledMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserAuthId    string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessageByIdRequest) Reset() {
-	*x = GetMessageByIdRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *GetScheduledMessagesRequest) Reset() {
+	*x = GetScheduledMessagesRequest{}
+	mi := &file_message_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessageByIdRequest) String() string {
+func (x *GetScheduledMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageByIdRequest) ProtoMessage() {}
+func (*GetScheduledMessagesRequest) ProtoMessage() {}
 
-func (x *GetMessageByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *GetScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[50]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -694,44 +2960,93 @@ func (x *GetMessageByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetMessageByIdRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetMessageByIdRequest) GetMessageId() string {
+func (x *GetScheduledMessagesRequest) GetChatId() string {
 	if x != nil {
-		return x.MessageId
+		return x.ChatId
 	}
 	return ""
 }
 
-type GetMessageByIdResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetScheduledMessagesRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type GetScheduledMessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessages []*ScheduledMessage    `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetMessageByIdResponse) Reset() {
-	*x = GetMessageByIdResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[12]
+func (x *GetScheduledMessagesResponse) Reset() {
+	*x = GetScheduledMessagesResponse{}
+	mi := &file_message_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *GetScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[51]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetMessageByIdResponse) String() string {
+// Deprecated: Use GetScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessages
+	}
+	return nil
+}
+
+type UpdateScheduledMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	UserAuthId       string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateScheduledMessageRequest) Reset() {
+	*x = UpdateScheduledMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageByIdResponse) ProtoMessage() {}
+func (*UpdateScheduledMessageRequest) ProtoMessage() {}
 
-func (x *GetMessageByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *UpdateScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[52]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -741,45 +3056,93 @@ func (x *GetMessageByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetMessageByIdResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetMessageByIdResponse) GetMessage() *Message {
+func (x *UpdateScheduledMessageRequest) GetScheduledMessage() *ScheduledMessage {
 	if x != nil {
-		return x.Message
+		return x.ScheduledMessage
 	}
 	return nil
 }
 
-type GetNumUnreadMessagesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UpdateScheduledMessageRequest) GetUserAuthId() string {
+	if x != nil {
+		return x.UserAuthId
+	}
+	return ""
+}
 
-	ChatId string `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type UpdateScheduledMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetNumUnreadMessagesRequest) Reset() {
-	*x = GetNumUnreadMessagesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[13]
+func (x *UpdateScheduledMessageResponse) Reset() {
+	*x = UpdateScheduledMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduledMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduledMessageResponse) ProtoMessage() {}
+
+func (x *UpdateScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[53]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetNumUnreadMessagesRequest) String() string {
+// Deprecated: Use UpdateScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateScheduledMessageResponse) GetScheduledMessage() *ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessageId string                 `protobuf:"bytes,1,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
+	UserAuthId         string                 `protobuf:"bytes,2,opt,name=user_auth_id,json=userAuthId,proto3" json:"user_auth_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_message_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNumUnreadMessagesRequest) ProtoMessage() {}
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
 
-func (x *GetNumUnreadMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[54]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -789,51 +3152,48 @@ func (x *GetNumUnreadMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNumUnreadMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetNumUnreadMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetNumUnreadMessagesRequest) GetChatId() string {
+func (x *CancelScheduledMessageRequest) GetScheduledMessageId() string {
 	if x != nil {
-		return x.ChatId
+		return x.ScheduledMessageId
 	}
 	return ""
 }
 
-func (x *GetNumUnreadMessagesRequest) GetUserId() string {
+func (x *CancelScheduledMessageRequest) GetUserAuthId() string {
 	if x != nil {
-		return x.UserId
+		return x.UserAuthId
 	}
 	return ""
 }
 
-type GetNumUnreadMessagesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type CancelScheduledMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NumMessages int32 `protobuf:"varint,1,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNumUnreadMessagesResponse) Reset() {
-	*x = GetNumUnreadMessagesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *CancelScheduledMessageResponse) Reset() {
+	*x = CancelScheduledMessageResponse{}
+	mi := &file_message_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNumUnreadMessagesResponse) String() string {
+func (x *CancelScheduledMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNumUnreadMessagesResponse) ProtoMessage() {}
+func (*CancelScheduledMessageResponse) ProtoMessage() {}
 
-func (x *GetNumUnreadMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *CancelScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_proto_msgTypes[55]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -843,241 +3203,461 @@ func (x *GetNumUnreadMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNumUnreadMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetNumUnreadMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CancelScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetNumUnreadMessagesResponse) GetNumMessages() int32 {
+func (x *CancelScheduledMessageResponse) GetSuccess() bool {
 	if x != nil {
-		return x.NumMessages
+		return x.Success
 	}
-	return 0
+	return false
 }
 
 var File_message_service_proto protoreflect.FileDescriptor
 
-var file_message_service_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x02, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0xb4, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46,
-	0x6f, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x12,
-	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x31,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74,
-	0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x4a, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
-	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x34, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65,
-	0x61, 0x64, 0x54, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68,
-	0x61, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
-	0x61, 0x64, 0x54, 0x73, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68,
-	0x61, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x32, 0xf6, 0x05, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x12, 0x2a, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65,
-	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x54,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x28, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65,
-	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x65,
-	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x75, 0x6d, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x71, 0x75, 0x69,
-	0x63, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_message_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15message_service.proto\x12\x11messenger_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ffile_service/file_service.proto\x1a\x0fpoll/poll.proto\"\xfc\x05\n" +
+	"\aMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\tR\bsenderId\x12\x1f\n" +
+	"\vreceiver_id\x18\x04 \x01(\tR\n" +
+	"receiverId\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\vattachments\x18\b \x03(\v2\x12.file_service.FileR\vattachments\x12\x10\n" +
+	"\x03seq\x18\t \x01(\x03R\x03seq\x127\n" +
+	"\tedited_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x1e\n" +
+	"\vreply_to_id\x18\v \x01(\tR\treplyToId\x12<\n" +
+	"\breply_to\x18\f \x01(\v2!.messenger_service.MessagePreviewR\areplyTo\x12G\n" +
+	"\x0eforwarded_from\x18\r \x01(\v2 .messenger_service.ForwardedFromR\rforwardedFrom\x12>\n" +
+	"\treactions\x18\x0e \x03(\v2 .messenger_service.ReactionCountR\treactions\x125\n" +
+	"\x06system\x18\x0f \x01(\v2\x1d.messenger_service.SystemInfoR\x06system\x12A\n" +
+	"\tencrypted\x18\x10 \x01(\v2#.messenger_service.EncryptedContentR\tencrypted\x12\x1e\n" +
+	"\x04poll\x18\x11 \x01(\v2\n" +
+	".poll.PollR\x04poll\"p\n" +
+	"\x10EncryptedContent\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x01 \x01(\fR\n" +
+	"ciphertext\x12<\n" +
+	"\tenvelopes\x18\x02 \x03(\v2\x1e.messenger_service.KeyEnvelopeR\tenvelopes\"_\n" +
+	"\vKeyEnvelope\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\fR\x03key\"=\n" +
+	"\n" +
+	"SystemInfo\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"U\n" +
+	"\rReactionCount\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\areacted\x18\x03 \x01(\bR\areacted\"E\n" +
+	"\rForwardedFrom\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\"\x94\x01\n" +
+	"\x0eMessagePreview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12'\n" +
+	"\x0fattachment_type\x18\x04 \x01(\tR\x0eattachmentType\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted\"\xa2\x01\n" +
+	"\rPinnedMessage\x12;\n" +
+	"\amessage\x18\x01 \x01(\v2!.messenger_service.MessagePreviewR\amessage\x12\x1b\n" +
+	"\tpinned_by\x18\x02 \x01(\tR\bpinnedBy\x127\n" +
+	"\tpinned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bpinnedAt\"\x83\x02\n" +
+	"\x19GetMessagesForChatRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12!\n" +
+	"\fmessages_num\x18\x02 \x01(\x05R\vmessagesNum\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\fuser_auth_id\x18\x04 \x01(\tR\n" +
+	"userAuthId\x12\x1b\n" +
+	"\tanchor_id\x18\x05 \x01(\tR\banchorId\x120\n" +
+	"\x05after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\"\xd2\x01\n" +
+	"\x1aGetMessagesForChatResponse\x126\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1a.messenger_service.MessageR\bmessages\x12=\n" +
+	"\folder_cursor\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\volderCursor\x12=\n" +
+	"\fnewer_cursor\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vnewerCursor\"l\n" +
+	"\x12SendMessageRequest\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\x12 \n" +
+	"\fuser_auth_id\x18\x04 \x01(\tR\n" +
+	"userAuthId\"K\n" +
+	"\x13SendMessageResponse\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\"n\n" +
+	"\x14UpdateMessageRequest\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"_\n" +
+	"\x15UpdateMessageResponse\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"\x95\x01\n" +
+	"\x16ForwardMessagesRequest\x12\x1f\n" +
+	"\vmessage_ids\x18\x01 \x03(\tR\n" +
+	"messageIds\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x1f\n" +
+	"\vreceiver_id\x18\x03 \x01(\tR\n" +
+	"receiverId\x12 \n" +
+	"\fuser_auth_id\x18\x04 \x01(\tR\n" +
+	"userAuthId\"Q\n" +
+	"\x17ForwardMessagesResponse\x126\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1a.messenger_service.MessageR\bmessages\"k\n" +
+	"\x12AddReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12 \n" +
+	"\fuser_auth_id\x18\x03 \x01(\tR\n" +
+	"userAuthId\"@\n" +
+	"\x13AddReactionResponse\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"n\n" +
+	"\x15RemoveReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12 \n" +
+	"\fuser_auth_id\x18\x03 \x01(\tR\n" +
+	"userAuthId\"C\n" +
+	"\x16RemoveReactionResponse\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"T\n" +
+	"\x11PinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"?\n" +
+	"\x12PinMessageResponse\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"V\n" +
+	"\x13UnpinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"A\n" +
+	"\x14UnpinMessageResponse\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"l\n" +
+	"\x0fVotePollRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
+	"\aoptions\x18\x02 \x03(\x05R\aoptions\x12 \n" +
+	"\fuser_auth_id\x18\x03 \x01(\tR\n" +
+	"userAuthId\"H\n" +
+	"\x10VotePollResponse\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\"e\n" +
+	"\x13MessageSearchResult\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\xda\x01\n" +
+	"\x15SearchMessagesRequest\x12 \n" +
+	"\fuser_auth_id\x18\x01 \x01(\tR\n" +
+	"userAuthId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x17\n" +
+	"\achat_id\x18\x03 \x01(\tR\x06chatId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"Z\n" +
+	"\x16SearchMessagesResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.messenger_service.MessageSearchResultR\aresults\"W\n" +
+	"\x14DeleteMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"C\n" +
+	"\x15DeleteMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"\xd8\x01\n" +
+	"\x17UpdateLastReadTsRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12J\n" +
+	"\x13last_read_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastReadTimestamp\x12 \n" +
+	"\fuser_auth_id\x18\x04 \x01(\tR\n" +
+	"userAuthId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\"F\n" +
+	"\x18UpdateLastReadTsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"\xaf\x01\n" +
+	"\x1cUpdateLastDeliveredTsRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12T\n" +
+	"\x18last_delivered_timestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16lastDeliveredTimestamp\x12 \n" +
+	"\fuser_auth_id\x18\x03 \x01(\tR\n" +
+	"userAuthId\"S\n" +
+	"\x1dUpdateLastDeliveredTsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\bR\aupdated\"\x9d\x01\n" +
+	"\x0eMessageReceipt\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fdelivered_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x123\n" +
+	"\aread_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\\\n" +
+	"\x19GetMessageReceiptsRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"[\n" +
+	"\x1aGetMessageReceiptsResponse\x12=\n" +
+	"\breceipts\x18\x01 \x03(\v2!.messenger_service.MessageReceiptR\breceipts\"H\n" +
+	"\x14GetLastReadTsRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"U\n" +
+	"\x15GetLastReadTsResponse\x12<\n" +
+	"\flast_read_ts\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastReadTs\"6\n" +
+	"\x15GetMessageByIdRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"N\n" +
+	"\x16GetMessageByIdResponse\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\"O\n" +
+	"\x1bGetNumUnreadMessagesRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"A\n" +
+	"\x1cGetNumUnreadMessagesResponse\x12!\n" +
+	"\fnum_messages\x18\x01 \x01(\x05R\vnumMessages\"\x8f\x02\n" +
+	"\tChatEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x17\n" +
+	"\achat_id\x18\x03 \x01(\tR\x06chatId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\amessage\x18\a \x01(\v2\x1a.messenger_service.MessageR\amessage\x12\x1a\n" +
+	"\breaction\x18\b \x01(\tR\breaction\"~\n" +
+	"\x19GetChatEventsSinceRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12 \n" +
+	"\fuser_auth_id\x18\x04 \x01(\tR\n" +
+	"userAuthId\"R\n" +
+	"\x1aGetChatEventsSinceResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.messenger_service.ChatEventR\x06events\"}\n" +
+	"\x10ScheduledMessage\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.messenger_service.MessageR\amessage\x123\n" +
+	"\asend_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\"\x8c\x01\n" +
+	"\x16ScheduleMessageRequest\x12P\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2#.messenger_service.ScheduledMessageR\x10scheduledMessage\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"k\n" +
+	"\x17ScheduleMessageResponse\x12P\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2#.messenger_service.ScheduledMessageR\x10scheduledMessage\"X\n" +
+	"\x1bGetScheduledMessagesRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"r\n" +
+	"\x1cGetScheduledMessagesResponse\x12R\n" +
+	"\x12scheduled_messages\x18\x01 \x03(\v2#.messenger_service.ScheduledMessageR\x11scheduledMessages\"\x93\x01\n" +
+	"\x1dUpdateScheduledMessageRequest\x12P\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2#.messenger_service.ScheduledMessageR\x10scheduledMessage\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\"r\n" +
+	"\x1eUpdateScheduledMessageResponse\x12P\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2#.messenger_service.ScheduledMessageR\x10scheduledMessage\"s\n" +
+	"\x1dCancelScheduledMessageRequest\x120\n" +
+	"\x14scheduled_message_id\x18\x01 \x01(\tR\x12scheduledMessageId\x12 \n" +
+	"\fuser_auth_id\x18\x02 \x01(\tR\n" +
+	"userAuthId\":\n" +
+	"\x1eCancelScheduledMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc4\x12\n" +
+	"\x0eMessageService\x12q\n" +
+	"\x12GetMessagesForChat\x12,.messenger_service.GetMessagesForChatRequest\x1a-.messenger_service.GetMessagesForChatResponse\x12\\\n" +
+	"\vSendMessage\x12%.messenger_service.SendMessageRequest\x1a&.messenger_service.SendMessageResponse\x12b\n" +
+	"\rUpdateMessage\x12'.messenger_service.UpdateMessageRequest\x1a(.messenger_service.UpdateMessageResponse\x12h\n" +
+	"\x0fForwardMessages\x12).messenger_service.ForwardMessagesRequest\x1a*.messenger_service.ForwardMessagesResponse\x12\\\n" +
+	"\vAddReaction\x12%.messenger_service.AddReactionRequest\x1a&.messenger_service.AddReactionResponse\x12e\n" +
+	"\x0eRemoveReaction\x12(.messenger_service.RemoveReactionRequest\x1a).messenger_service.RemoveReactionResponse\x12Y\n" +
+	"\n" +
+	"PinMessage\x12$.messenger_service.PinMessageRequest\x1a%.messenger_service.PinMessageResponse\x12_\n" +
+	"\fUnpinMessage\x12&.messenger_service.UnpinMessageRequest\x1a'.messenger_service.UnpinMessageResponse\x12S\n" +
+	"\bVotePoll\x12\".messenger_service.VotePollRequest\x1a#.messenger_service.VotePollResponse\x12e\n" +
+	"\x0eSearchMessages\x12(.messenger_service.SearchMessagesRequest\x1a).messenger_service.SearchMessagesResponse\x12b\n" +
+	"\rDeleteMessage\x12'.messenger_service.DeleteMessageRequest\x1a(.messenger_service.DeleteMessageResponse\x12k\n" +
+	"\x10UpdateLastReadTs\x12*.messenger_service.UpdateLastReadTsRequest\x1a+.messenger_service.UpdateLastReadTsResponse\x12b\n" +
+	"\rGetLastReadTs\x12'.messenger_service.GetLastReadTsRequest\x1a(.messenger_service.GetLastReadTsResponse\x12z\n" +
+	"\x15UpdateLastDeliveredTs\x12/.messenger_service.UpdateLastDeliveredTsRequest\x1a0.messenger_service.UpdateLastDeliveredTsResponse\x12q\n" +
+	"\x12GetMessageReceipts\x12,.messenger_service.GetMessageReceiptsRequest\x1a-.messenger_service.GetMessageReceiptsResponse\x12e\n" +
+	"\x0eGetMessageById\x12(.messenger_service.GetMessageByIdRequest\x1a).messenger_service.GetMessageByIdResponse\x12w\n" +
+	"\x14GetNumUnreadMessages\x12..messenger_service.GetNumUnreadMessagesRequest\x1a/.messenger_service.GetNumUnreadMessagesResponse\x12q\n" +
+	"\x12GetChatEventsSince\x12,.messenger_service.GetChatEventsSinceRequest\x1a-.messenger_service.GetChatEventsSinceResponse\x12h\n" +
+	"\x0fScheduleMessage\x12).messenger_service.ScheduleMessageRequest\x1a*.messenger_service.ScheduleMessageResponse\x12w\n" +
+	"\x14GetScheduledMessages\x12..messenger_service.GetScheduledMessagesRequest\x1a/.messenger_service.GetScheduledMessagesResponse\x12}\n" +
+	"\x16UpdateScheduledMessage\x120.messenger_service.UpdateScheduledMessageRequest\x1a1.messenger_service.UpdateScheduledMessageResponse\x12}\n" +
+	"\x16CancelScheduledMessage\x120.messenger_service.CancelScheduledMessageRequest\x1a1.messenger_service.CancelScheduledMessageResponseB:Z8quickflow/messenger_service/internal/delivery/grpc/protob\x06proto3"
 
 var (
 	file_message_service_proto_rawDescOnce sync.Once
-	file_message_service_proto_rawDescData = file_message_service_proto_rawDesc
+	file_message_service_proto_rawDescData []byte
 )
 
 func file_message_service_proto_rawDescGZIP() []byte {
 	file_message_service_proto_rawDescOnce.Do(func() {
-		file_message_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_message_service_proto_rawDescData)
+		file_message_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_message_service_proto_rawDesc), len(file_message_service_proto_rawDesc)))
 	})
 	return file_message_service_proto_rawDescData
 }
 
-var file_message_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_message_service_proto_goTypes = []interface{}{
-	(*Message)(nil),                      // 0: messenger_service.Message
-	(*GetMessagesForChatRequest)(nil),    // 1: messenger_service.GetMessagesForChatRequest
-	(*GetMessagesForChatResponse)(nil),   // 2: messenger_service.GetMessagesForChatResponse
-	(*SendMessageRequest)(nil),           // 3: messenger_service.SendMessageRequest
-	(*SendMessageResponse)(nil),          // 4: messenger_service.SendMessageResponse
-	(*DeleteMessageRequest)(nil),         // 5: messenger_service.DeleteMessageRequest
-	(*DeleteMessageResponse)(nil),        // 6: messenger_service.DeleteMessageResponse
-	(*UpdateLastReadTsRequest)(nil),      // 7: messenger_service.UpdateLastReadTsRequest
-	(*UpdateLastReadTsResponse)(nil),     // 8: messenger_service.UpdateLastReadTsResponse
-	(*GetLastReadTsRequest)(nil),         // 9: messenger_service.GetLastReadTsRequest
-	(*GetLastReadTsResponse)(nil),        // 10: messenger_service.GetLastReadTsResponse
-	(*GetMessageByIdRequest)(nil),        // 11: messenger_service.GetMessageByIdRequest
-	(*GetMessageByIdResponse)(nil),       // 12: messenger_service.GetMessageByIdResponse
-	(*GetNumUnreadMessagesRequest)(nil),  // 13: messenger_service.GetNumUnreadMessagesRequest
-	(*GetNumUnreadMessagesResponse)(nil), // 14: messenger_service.GetNumUnreadMessagesResponse
-	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
-	(*file_service.File)(nil),            // 16: file_service.File
+var file_message_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_message_service_proto_goTypes = []any{
+	(*Message)(nil),                        // 0: messenger_service.Message
+	(*EncryptedContent)(nil),               // 1: messenger_service.EncryptedContent
+	(*KeyEnvelope)(nil),                    // 2: messenger_service.KeyEnvelope
+	(*SystemInfo)(nil),                     // 3: messenger_service.SystemInfo
+	(*ReactionCount)(nil),                  // 4: messenger_service.ReactionCount
+	(*ForwardedFrom)(nil),                  // 5: messenger_service.ForwardedFrom
+	(*MessagePreview)(nil),                 // 6: messenger_service.MessagePreview
+	(*PinnedMessage)(nil),                  // 7: messenger_service.PinnedMessage
+	(*GetMessagesForChatRequest)(nil),      // 8: messenger_service.GetMessagesForChatRequest
+	(*GetMessagesForChatResponse)(nil),     // 9: messenger_service.GetMessagesForChatResponse
+	(*SendMessageRequest)(nil),             // 10: messenger_service.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 11: messenger_service.SendMessageResponse
+	(*UpdateMessageRequest)(nil),           // 12: messenger_service.UpdateMessageRequest
+	(*UpdateMessageResponse)(nil),          // 13: messenger_service.UpdateMessageResponse
+	(*ForwardMessagesRequest)(nil),         // 14: messenger_service.ForwardMessagesRequest
+	(*ForwardMessagesResponse)(nil),        // 15: messenger_service.ForwardMessagesResponse
+	(*AddReactionRequest)(nil),             // 16: messenger_service.AddReactionRequest
+	(*AddReactionResponse)(nil),            // 17: messenger_service.AddReactionResponse
+	(*RemoveReactionRequest)(nil),          // 18: messenger_service.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),         // 19: messenger_service.RemoveReactionResponse
+	(*PinMessageRequest)(nil),              // 20: messenger_service.PinMessageRequest
+	(*PinMessageResponse)(nil),             // 21: messenger_service.PinMessageResponse
+	(*UnpinMessageRequest)(nil),            // 22: messenger_service.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),           // 23: messenger_service.UnpinMessageResponse
+	(*VotePollRequest)(nil),                // 24: messenger_service.VotePollRequest
+	(*VotePollResponse)(nil),               // 25: messenger_service.VotePollResponse
+	(*MessageSearchResult)(nil),            // 26: messenger_service.MessageSearchResult
+	(*SearchMessagesRequest)(nil),          // 27: messenger_service.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),         // 28: messenger_service.SearchMessagesResponse
+	(*DeleteMessageRequest)(nil),           // 29: messenger_service.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),          // 30: messenger_service.DeleteMessageResponse
+	(*UpdateLastReadTsRequest)(nil),        // 31: messenger_service.UpdateLastReadTsRequest
+	(*UpdateLastReadTsResponse)(nil),       // 32: messenger_service.UpdateLastReadTsResponse
+	(*UpdateLastDeliveredTsRequest)(nil),   // 33: messenger_service.UpdateLastDeliveredTsRequest
+	(*UpdateLastDeliveredTsResponse)(nil),  // 34: messenger_service.UpdateLastDeliveredTsResponse
+	(*MessageReceipt)(nil),                 // 35: messenger_service.MessageReceipt
+	(*GetMessageReceiptsRequest)(nil),      // 36: messenger_service.GetMessageReceiptsRequest
+	(*GetMessageReceiptsResponse)(nil),     // 37: messenger_service.GetMessageReceiptsResponse
+	(*GetLastReadTsRequest)(nil),           // 38: messenger_service.GetLastReadTsRequest
+	(*GetLastReadTsResponse)(nil),          // 39: messenger_service.GetLastReadTsResponse
+	(*GetMessageByIdRequest)(nil),          // 40: messenger_service.GetMessageByIdRequest
+	(*GetMessageByIdResponse)(nil),         // 41: messenger_service.GetMessageByIdResponse
+	(*GetNumUnreadMessagesRequest)(nil),    // 42: messenger_service.GetNumUnreadMessagesRequest
+	(*GetNumUnreadMessagesResponse)(nil),   // 43: messenger_service.GetNumUnreadMessagesResponse
+	(*ChatEvent)(nil),                      // 44: messenger_service.ChatEvent
+	(*GetChatEventsSinceRequest)(nil),      // 45: messenger_service.GetChatEventsSinceRequest
+	(*GetChatEventsSinceResponse)(nil),     // 46: messenger_service.GetChatEventsSinceResponse
+	(*ScheduledMessage)(nil),               // 47: messenger_service.ScheduledMessage
+	(*ScheduleMessageRequest)(nil),         // 48: messenger_service.ScheduleMessageRequest
+	(*ScheduleMessageResponse)(nil),        // 49: messenger_service.ScheduleMessageResponse
+	(*GetScheduledMessagesRequest)(nil),    // 50: messenger_service.GetScheduledMessagesRequest
+	(*GetScheduledMessagesResponse)(nil),   // 51: messenger_service.GetScheduledMessagesResponse
+	(*UpdateScheduledMessageRequest)(nil),  // 52: messenger_service.UpdateScheduledMessageRequest
+	(*UpdateScheduledMessageResponse)(nil), // 53: messenger_service.UpdateScheduledMessageResponse
+	(*CancelScheduledMessageRequest)(nil),  // 54: messenger_service.CancelScheduledMessageRequest
+	(*CancelScheduledMessageResponse)(nil), // 55: messenger_service.CancelScheduledMessageResponse
+	(*timestamppb.Timestamp)(nil),          // 56: google.protobuf.Timestamp
+	(*file_service.File)(nil),              // 57: file_service.File
+	(*poll.Poll)(nil),                      // 58: poll.Poll
 }
 var file_message_service_proto_depIdxs = []int32{
-	15, // 0: messenger_service.Message.created_at:type_name -> google.protobuf.Timestamp
-	15, // 1: messenger_service.Message.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 2: messenger_service.Message.attachments:type_name -> file_service.File
-	15, // 3: messenger_service.GetMessagesForChatRequest.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: messenger_service.GetMessagesForChatResponse.messages:type_name -> messenger_service.Message
-	0,  // 5: messenger_service.SendMessageRequest.message:type_name -> messenger_service.Message
-	0,  // 6: messenger_service.SendMessageResponse.message:type_name -> messenger_service.Message
-	15, // 7: messenger_service.UpdateLastReadTsRequest.last_read_timestamp:type_name -> google.protobuf.Timestamp
-	15, // 8: messenger_service.GetLastReadTsResponse.last_read_ts:type_name -> google.protobuf.Timestamp
-	0,  // 9: messenger_service.GetMessageByIdResponse.message:type_name -> messenger_service.Message
-	1,  // 10: messenger_service.MessageService.GetMessagesForChat:input_type -> messenger_service.GetMessagesForChatRequest
-	3,  // 11: messenger_service.MessageService.SendMessage:input_type -> messenger_service.SendMessageRequest
-	5,  // 12: messenger_service.MessageService.DeleteMessage:input_type -> messenger_service.DeleteMessageRequest
-	7,  // 13: messenger_service.MessageService.UpdateLastReadTs:input_type -> messenger_service.UpdateLastReadTsRequest
-	9,  // 14: messenger_service.MessageService.GetLastReadTs:input_type -> messenger_service.GetLastReadTsRequest
-	11, // 15: messenger_service.MessageService.GetMessageById:input_type -> messenger_service.GetMessageByIdRequest
-	13, // 16: messenger_service.MessageService.GetNumUnreadMessages:input_type -> messenger_service.GetNumUnreadMessagesRequest
-	2,  // 17: messenger_service.MessageService.GetMessagesForChat:output_type -> messenger_service.GetMessagesForChatResponse
-	4,  // 18: messenger_service.MessageService.SendMessage:output_type -> messenger_service.SendMessageResponse
-	6,  // 19: messenger_service.MessageService.DeleteMessage:output_type -> messenger_service.DeleteMessageResponse
-	8,  // 20: messenger_service.MessageService.UpdateLastReadTs:output_type -> messenger_service.UpdateLastReadTsResponse
-	10, // 21: messenger_service.MessageService.GetLastReadTs:output_type -> messenger_service.GetLastReadTsResponse
-	12, // 22: messenger_service.MessageService.GetMessageById:output_type -> messenger_service.GetMessageByIdResponse
-	14, // 23: messenger_service.MessageService.GetNumUnreadMessages:output_type -> messenger_service.GetNumUnreadMessagesResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	56, // 0: messenger_service.Message.created_at:type_name -> google.protobuf.Timestamp
+	56, // 1: messenger_service.Message.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 2: messenger_service.Message.attachments:type_name -> file_service.File
+	56, // 3: messenger_service.Message.edited_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: messenger_service.Message.reply_to:type_name -> messenger_service.MessagePreview
+	5,  // 5: messenger_service.Message.forwarded_from:type_name -> messenger_service.ForwardedFrom
+	4,  // 6: messenger_service.Message.reactions:type_name -> messenger_service.ReactionCount
+	3,  // 7: messenger_service.Message.system:type_name -> messenger_service.SystemInfo
+	1,  // 8: messenger_service.Message.encrypted:type_name -> messenger_service.EncryptedContent
+	58, // 9: messenger_service.Message.poll:type_name -> poll.Poll
+	2,  // 10: messenger_service.EncryptedContent.envelopes:type_name -> messenger_service.KeyEnvelope
+	6,  // 11: messenger_service.PinnedMessage.message:type_name -> messenger_service.MessagePreview
+	56, // 12: messenger_service.PinnedMessage.pinned_at:type_name -> google.protobuf.Timestamp
+	56, // 13: messenger_service.GetMessagesForChatRequest.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 14: messenger_service.GetMessagesForChatRequest.after:type_name -> google.protobuf.Timestamp
+	0,  // 15: messenger_service.GetMessagesForChatResponse.messages:type_name -> messenger_service.Message
+	56, // 16: messenger_service.GetMessagesForChatResponse.older_cursor:type_name -> google.protobuf.Timestamp
+	56, // 17: messenger_service.GetMessagesForChatResponse.newer_cursor:type_name -> google.protobuf.Timestamp
+	0,  // 18: messenger_service.SendMessageRequest.message:type_name -> messenger_service.Message
+	0,  // 19: messenger_service.SendMessageResponse.message:type_name -> messenger_service.Message
+	0,  // 20: messenger_service.UpdateMessageRequest.message:type_name -> messenger_service.Message
+	0,  // 21: messenger_service.UpdateMessageResponse.message:type_name -> messenger_service.Message
+	0,  // 22: messenger_service.ForwardMessagesResponse.messages:type_name -> messenger_service.Message
+	0,  // 23: messenger_service.VotePollResponse.message:type_name -> messenger_service.Message
+	0,  // 24: messenger_service.MessageSearchResult.message:type_name -> messenger_service.Message
+	56, // 25: messenger_service.SearchMessagesRequest.from:type_name -> google.protobuf.Timestamp
+	56, // 26: messenger_service.SearchMessagesRequest.to:type_name -> google.protobuf.Timestamp
+	26, // 27: messenger_service.SearchMessagesResponse.results:type_name -> messenger_service.MessageSearchResult
+	56, // 28: messenger_service.UpdateLastReadTsRequest.last_read_timestamp:type_name -> google.protobuf.Timestamp
+	56, // 29: messenger_service.UpdateLastDeliveredTsRequest.last_delivered_timestamp:type_name -> google.protobuf.Timestamp
+	56, // 30: messenger_service.MessageReceipt.delivered_at:type_name -> google.protobuf.Timestamp
+	56, // 31: messenger_service.MessageReceipt.read_at:type_name -> google.protobuf.Timestamp
+	35, // 32: messenger_service.GetMessageReceiptsResponse.receipts:type_name -> messenger_service.MessageReceipt
+	56, // 33: messenger_service.GetLastReadTsResponse.last_read_ts:type_name -> google.protobuf.Timestamp
+	0,  // 34: messenger_service.GetMessageByIdResponse.message:type_name -> messenger_service.Message
+	56, // 35: messenger_service.ChatEvent.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 36: messenger_service.ChatEvent.message:type_name -> messenger_service.Message
+	44, // 37: messenger_service.GetChatEventsSinceResponse.events:type_name -> messenger_service.ChatEvent
+	0,  // 38: messenger_service.ScheduledMessage.message:type_name -> messenger_service.Message
+	56, // 39: messenger_service.ScheduledMessage.send_at:type_name -> google.protobuf.Timestamp
+	47, // 40: messenger_service.ScheduleMessageRequest.scheduled_message:type_name -> messenger_service.ScheduledMessage
+	47, // 41: messenger_service.ScheduleMessageResponse.scheduled_message:type_name -> messenger_service.ScheduledMessage
+	47, // 42: messenger_service.GetScheduledMessagesResponse.scheduled_messages:type_name -> messenger_service.ScheduledMessage
+	47, // 43: messenger_service.UpdateScheduledMessageRequest.scheduled_message:type_name -> messenger_service.ScheduledMessage
+	47, // 44: messenger_service.UpdateScheduledMessageResponse.scheduled_message:type_name -> messenger_service.ScheduledMessage
+	8,  // 45: messenger_service.MessageService.GetMessagesForChat:input_type -> messenger_service.GetMessagesForChatRequest
+	10, // 46: messenger_service.MessageService.SendMessage:input_type -> messenger_service.SendMessageRequest
+	12, // 47: messenger_service.MessageService.UpdateMessage:input_type -> messenger_service.UpdateMessageRequest
+	14, // 48: messenger_service.MessageService.ForwardMessages:input_type -> messenger_service.ForwardMessagesRequest
+	16, // 49: messenger_service.MessageService.AddReaction:input_type -> messenger_service.AddReactionRequest
+	18, // 50: messenger_service.MessageService.RemoveReaction:input_type -> messenger_service.RemoveReactionRequest
+	20, // 51: messenger_service.MessageService.PinMessage:input_type -> messenger_service.PinMessageRequest
+	22, // 52: messenger_service.MessageService.UnpinMessage:input_type -> messenger_service.UnpinMessageRequest
+	24, // 53: messenger_service.MessageService.VotePoll:input_type -> messenger_service.VotePollRequest
+	27, // 54: messenger_service.MessageService.SearchMessages:input_type -> messenger_service.SearchMessagesRequest
+	29, // 55: messenger_service.MessageService.DeleteMessage:input_type -> messenger_service.DeleteMessageRequest
+	31, // 56: messenger_service.MessageService.UpdateLastReadTs:input_type -> messenger_service.UpdateLastReadTsRequest
+	38, // 57: messenger_service.MessageService.GetLastReadTs:input_type -> messenger_service.GetLastReadTsRequest
+	33, // 58: messenger_service.MessageService.UpdateLastDeliveredTs:input_type -> messenger_service.UpdateLastDeliveredTsRequest
+	36, // 59: messenger_service.MessageService.GetMessageReceipts:input_type -> messenger_service.GetMessageReceiptsRequest
+	40, // 60: messenger_service.MessageService.GetMessageById:input_type -> messenger_service.GetMessageByIdRequest
+	42, // 61: messenger_service.MessageService.GetNumUnreadMessages:input_type -> messenger_service.GetNumUnreadMessagesRequest
+	45, // 62: messenger_service.MessageService.GetChatEventsSince:input_type -> messenger_service.GetChatEventsSinceRequest
+	48, // 63: messenger_service.MessageService.ScheduleMessage:input_type -> messenger_service.ScheduleMessageRequest
+	50, // 64: messenger_service.MessageService.GetScheduledMessages:input_type -> messenger_service.GetScheduledMessagesRequest
+	52, // 65: messenger_service.MessageService.UpdateScheduledMessage:input_type -> messenger_service.UpdateScheduledMessageRequest
+	54, // 66: messenger_service.MessageService.CancelScheduledMessage:input_type -> messenger_service.CancelScheduledMessageRequest
+	9,  // 67: messenger_service.MessageService.GetMessagesForChat:output_type -> messenger_service.GetMessagesForChatResponse
+	11, // 68: messenger_service.MessageService.SendMessage:output_type -> messenger_service.SendMessageResponse
+	13, // 69: messenger_service.MessageService.UpdateMessage:output_type -> messenger_service.UpdateMessageResponse
+	15, // 70: messenger_service.MessageService.ForwardMessages:output_type -> messenger_service.ForwardMessagesResponse
+	17, // 71: messenger_service.MessageService.AddReaction:output_type -> messenger_service.AddReactionResponse
+	19, // 72: messenger_service.MessageService.RemoveReaction:output_type -> messenger_service.RemoveReactionResponse
+	21, // 73: messenger_service.MessageService.PinMessage:output_type -> messenger_service.PinMessageResponse
+	23, // 74: messenger_service.MessageService.UnpinMessage:output_type -> messenger_service.UnpinMessageResponse
+	25, // 75: messenger_service.MessageService.VotePoll:output_type -> messenger_service.VotePollResponse
+	28, // 76: messenger_service.MessageService.SearchMessages:output_type -> messenger_service.SearchMessagesResponse
+	30, // 77: messenger_service.MessageService.DeleteMessage:output_type -> messenger_service.DeleteMessageResponse
+	32, // 78: messenger_service.MessageService.UpdateLastReadTs:output_type -> messenger_service.UpdateLastReadTsResponse
+	39, // 79: messenger_service.MessageService.GetLastReadTs:output_type -> messenger_service.GetLastReadTsResponse
+	34, // 80: messenger_service.MessageService.UpdateLastDeliveredTs:output_type -> messenger_service.UpdateLastDeliveredTsResponse
+	37, // 81: messenger_service.MessageService.GetMessageReceipts:output_type -> messenger_service.GetMessageReceiptsResponse
+	41, // 82: messenger_service.MessageService.GetMessageById:output_type -> messenger_service.GetMessageByIdResponse
+	43, // 83: messenger_service.MessageService.GetNumUnreadMessages:output_type -> messenger_service.GetNumUnreadMessagesResponse
+	46, // 84: messenger_service.MessageService.GetChatEventsSince:output_type -> messenger_service.GetChatEventsSinceResponse
+	49, // 85: messenger_service.MessageService.ScheduleMessage:output_type -> messenger_service.ScheduleMessageResponse
+	51, // 86: messenger_service.MessageService.GetScheduledMessages:output_type -> messenger_service.GetScheduledMessagesResponse
+	53, // 87: messenger_service.MessageService.UpdateScheduledMessage:output_type -> messenger_service.UpdateScheduledMessageResponse
+	55, // 88: messenger_service.MessageService.CancelScheduledMessage:output_type -> messenger_service.CancelScheduledMessageResponse
+	67, // [67:89] is the sub-list for method output_type
+	45, // [45:67] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_message_service_proto_init() }
@@ -1085,195 +3665,13 @@ func file_message_service_proto_init() {
 	if File_message_service_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_message_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesForChatRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesForChatResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMessageRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMessageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLastReadTsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLastReadTsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastReadTsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastReadTsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessageByIdRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessageByIdResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNumUnreadMessagesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNumUnreadMessagesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_message_service_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_service_proto_rawDesc), len(file_message_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1282,7 +3680,6 @@ func file_message_service_proto_init() {
 		MessageInfos:      file_message_service_proto_msgTypes,
 	}.Build()
 	File_message_service_proto = out.File
-	file_message_service_proto_rawDesc = nil
 	file_message_service_proto_goTypes = nil
 	file_message_service_proto_depIdxs = nil
 }
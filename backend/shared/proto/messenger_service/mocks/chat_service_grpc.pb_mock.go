@@ -36,6 +36,66 @@ func (m *MockChatServiceClient) EXPECT() *MockChatServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AddChatMembers mocks base method.
+func (m *MockChatServiceClient) AddChatMembers(ctx context.Context, in *proto.AddChatMembersRequest, opts ...grpc.CallOption) (*proto.AddChatMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddChatMembers", varargs...)
+	ret0, _ := ret[0].(*proto.AddChatMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChatMembers indicates an expected call of AddChatMembers.
+func (mr *MockChatServiceClientMockRecorder) AddChatMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMembers", reflect.TypeOf((*MockChatServiceClient)(nil).AddChatMembers), varargs...)
+}
+
+// ArchiveChat mocks base method.
+func (m *MockChatServiceClient) ArchiveChat(ctx context.Context, in *proto.ArchiveChatRequest, opts ...grpc.CallOption) (*proto.ArchiveChatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArchiveChat", varargs...)
+	ret0, _ := ret[0].(*proto.ArchiveChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveChat indicates an expected call of ArchiveChat.
+func (mr *MockChatServiceClientMockRecorder) ArchiveChat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChat", reflect.TypeOf((*MockChatServiceClient)(nil).ArchiveChat), varargs...)
+}
+
+// ChangeChatRole mocks base method.
+func (m *MockChatServiceClient) ChangeChatRole(ctx context.Context, in *proto.ChangeChatRoleRequest, opts ...grpc.CallOption) (*proto.ChangeChatRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeChatRole", varargs...)
+	ret0, _ := ret[0].(*proto.ChangeChatRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeChatRole indicates an expected call of ChangeChatRole.
+func (mr *MockChatServiceClientMockRecorder) ChangeChatRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeChatRole", reflect.TypeOf((*MockChatServiceClient)(nil).ChangeChatRole), varargs...)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatServiceClient) CreateChat(ctx context.Context, in *proto.CreateChatRequest, opts ...grpc.CallOption) (*proto.CreateChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +116,26 @@ func (mr *MockChatServiceClientMockRecorder) CreateChat(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatServiceClient)(nil).CreateChat), varargs...)
 }
 
+// CreateChatInvite mocks base method.
+func (m *MockChatServiceClient) CreateChatInvite(ctx context.Context, in *proto.CreateChatInviteRequest, opts ...grpc.CallOption) (*proto.CreateChatInviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateChatInvite", varargs...)
+	ret0, _ := ret[0].(*proto.CreateChatInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChatInvite indicates an expected call of CreateChatInvite.
+func (mr *MockChatServiceClientMockRecorder) CreateChatInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatInvite", reflect.TypeOf((*MockChatServiceClient)(nil).CreateChatInvite), varargs...)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatServiceClient) DeleteChat(ctx context.Context, in *proto.DeleteChatRequest, opts ...grpc.CallOption) (*proto.DeleteChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +176,86 @@ func (mr *MockChatServiceClientMockRecorder) GetChat(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatServiceClient)(nil).GetChat), varargs...)
 }
 
+// GetChatExport mocks base method.
+func (m *MockChatServiceClient) GetChatExport(ctx context.Context, in *proto.GetChatExportRequest, opts ...grpc.CallOption) (*proto.GetChatExportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatExport", varargs...)
+	ret0, _ := ret[0].(*proto.GetChatExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatExport indicates an expected call of GetChatExport.
+func (mr *MockChatServiceClientMockRecorder) GetChatExport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatExport", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatExport), varargs...)
+}
+
+// GetChatInvitePreview mocks base method.
+func (m *MockChatServiceClient) GetChatInvitePreview(ctx context.Context, in *proto.GetChatInvitePreviewRequest, opts ...grpc.CallOption) (*proto.GetChatInvitePreviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatInvitePreview", varargs...)
+	ret0, _ := ret[0].(*proto.GetChatInvitePreviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvitePreview indicates an expected call of GetChatInvitePreview.
+func (mr *MockChatServiceClientMockRecorder) GetChatInvitePreview(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvitePreview", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatInvitePreview), varargs...)
+}
+
+// GetChatInvites mocks base method.
+func (m *MockChatServiceClient) GetChatInvites(ctx context.Context, in *proto.GetChatInvitesRequest, opts ...grpc.CallOption) (*proto.GetChatInvitesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatInvites", varargs...)
+	ret0, _ := ret[0].(*proto.GetChatInvitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatServiceClientMockRecorder) GetChatInvites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatInvites), varargs...)
+}
+
+// GetChatMembers mocks base method.
+func (m *MockChatServiceClient) GetChatMembers(ctx context.Context, in *proto.GetChatMembersRequest, opts ...grpc.CallOption) (*proto.GetChatMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatMembers", varargs...)
+	ret0, _ := ret[0].(*proto.GetChatMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMembers indicates an expected call of GetChatMembers.
+func (mr *MockChatServiceClientMockRecorder) GetChatMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMembers", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatMembers), varargs...)
+}
+
 // GetChatParticipants mocks base method.
 func (m *MockChatServiceClient) GetChatParticipants(ctx context.Context, in *proto.GetChatParticipantsRequest, opts ...grpc.CallOption) (*proto.GetChatParticipantsResponse, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +376,26 @@ func (mr *MockChatServiceClientMockRecorder) JoinChat(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChat", reflect.TypeOf((*MockChatServiceClient)(nil).JoinChat), varargs...)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatServiceClient) JoinChatByInvite(ctx context.Context, in *proto.JoinChatByInviteRequest, opts ...grpc.CallOption) (*proto.JoinChatByInviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinChatByInvite", varargs...)
+	ret0, _ := ret[0].(*proto.JoinChatByInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatServiceClientMockRecorder) JoinChatByInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatServiceClient)(nil).JoinChatByInvite), varargs...)
+}
+
 // LeaveChat mocks base method.
 func (m *MockChatServiceClient) LeaveChat(ctx context.Context, in *proto.LeaveChatRequest, opts ...grpc.CallOption) (*proto.LeaveChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +416,186 @@ func (mr *MockChatServiceClientMockRecorder) LeaveChat(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChat", reflect.TypeOf((*MockChatServiceClient)(nil).LeaveChat), varargs...)
 }
 
+// MuteChat mocks base method.
+func (m *MockChatServiceClient) MuteChat(ctx context.Context, in *proto.MuteChatRequest, opts ...grpc.CallOption) (*proto.MuteChatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MuteChat", varargs...)
+	ret0, _ := ret[0].(*proto.MuteChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MuteChat indicates an expected call of MuteChat.
+func (mr *MockChatServiceClientMockRecorder) MuteChat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteChat", reflect.TypeOf((*MockChatServiceClient)(nil).MuteChat), varargs...)
+}
+
+// PinChat mocks base method.
+func (m *MockChatServiceClient) PinChat(ctx context.Context, in *proto.PinChatRequest, opts ...grpc.CallOption) (*proto.PinChatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PinChat", varargs...)
+	ret0, _ := ret[0].(*proto.PinChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinChat indicates an expected call of PinChat.
+func (mr *MockChatServiceClientMockRecorder) PinChat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinChat", reflect.TypeOf((*MockChatServiceClient)(nil).PinChat), varargs...)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockChatServiceClient) RemoveChatMember(ctx context.Context, in *proto.RemoveChatMemberRequest, opts ...grpc.CallOption) (*proto.RemoveChatMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveChatMember", varargs...)
+	ret0, _ := ret[0].(*proto.RemoveChatMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatServiceClientMockRecorder) RemoveChatMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatServiceClient)(nil).RemoveChatMember), varargs...)
+}
+
+// ReorderPinnedChats mocks base method.
+func (m *MockChatServiceClient) ReorderPinnedChats(ctx context.Context, in *proto.ReorderPinnedChatsRequest, opts ...grpc.CallOption) (*proto.ReorderPinnedChatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReorderPinnedChats", varargs...)
+	ret0, _ := ret[0].(*proto.ReorderPinnedChatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderPinnedChats indicates an expected call of ReorderPinnedChats.
+func (mr *MockChatServiceClientMockRecorder) ReorderPinnedChats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderPinnedChats", reflect.TypeOf((*MockChatServiceClient)(nil).ReorderPinnedChats), varargs...)
+}
+
+// RequestChatExport mocks base method.
+func (m *MockChatServiceClient) RequestChatExport(ctx context.Context, in *proto.RequestChatExportRequest, opts ...grpc.CallOption) (*proto.RequestChatExportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestChatExport", varargs...)
+	ret0, _ := ret[0].(*proto.RequestChatExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestChatExport indicates an expected call of RequestChatExport.
+func (mr *MockChatServiceClientMockRecorder) RequestChatExport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestChatExport", reflect.TypeOf((*MockChatServiceClient)(nil).RequestChatExport), varargs...)
+}
+
+// RevokeChatInvite mocks base method.
+func (m *MockChatServiceClient) RevokeChatInvite(ctx context.Context, in *proto.RevokeChatInviteRequest, opts ...grpc.CallOption) (*proto.RevokeChatInviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeChatInvite", varargs...)
+	ret0, _ := ret[0].(*proto.RevokeChatInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeChatInvite indicates an expected call of RevokeChatInvite.
+func (mr *MockChatServiceClientMockRecorder) RevokeChatInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeChatInvite", reflect.TypeOf((*MockChatServiceClient)(nil).RevokeChatInvite), varargs...)
+}
+
+// SetChatEncryption mocks base method.
+func (m *MockChatServiceClient) SetChatEncryption(ctx context.Context, in *proto.SetChatEncryptionRequest, opts ...grpc.CallOption) (*proto.SetChatEncryptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetChatEncryption", varargs...)
+	ret0, _ := ret[0].(*proto.SetChatEncryptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatEncryption indicates an expected call of SetChatEncryption.
+func (mr *MockChatServiceClientMockRecorder) SetChatEncryption(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatEncryption", reflect.TypeOf((*MockChatServiceClient)(nil).SetChatEncryption), varargs...)
+}
+
+// SetChatMessageTTL mocks base method.
+func (m *MockChatServiceClient) SetChatMessageTTL(ctx context.Context, in *proto.SetChatMessageTTLRequest, opts ...grpc.CallOption) (*proto.SetChatMessageTTLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetChatMessageTTL", varargs...)
+	ret0, _ := ret[0].(*proto.SetChatMessageTTLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatMessageTTL indicates an expected call of SetChatMessageTTL.
+func (mr *MockChatServiceClientMockRecorder) SetChatMessageTTL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMessageTTL", reflect.TypeOf((*MockChatServiceClient)(nil).SetChatMessageTTL), varargs...)
+}
+
+// UpdateChat mocks base method.
+func (m *MockChatServiceClient) UpdateChat(ctx context.Context, in *proto.UpdateChatRequest, opts ...grpc.CallOption) (*proto.UpdateChatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChat", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChat indicates an expected call of UpdateChat.
+func (mr *MockChatServiceClientMockRecorder) UpdateChat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatServiceClient)(nil).UpdateChat), varargs...)
+}
+
 // MockChatServiceServer is a mock of ChatServiceServer interface.
 type MockChatServiceServer struct {
 	ctrl     *gomock.Controller
@@ -259,6 +619,51 @@ func (m *MockChatServiceServer) EXPECT() *MockChatServiceServerMockRecorder {
 	return m.recorder
 }
 
+// AddChatMembers mocks base method.
+func (m *MockChatServiceServer) AddChatMembers(arg0 context.Context, arg1 *proto.AddChatMembersRequest) (*proto.AddChatMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMembers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddChatMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChatMembers indicates an expected call of AddChatMembers.
+func (mr *MockChatServiceServerMockRecorder) AddChatMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMembers", reflect.TypeOf((*MockChatServiceServer)(nil).AddChatMembers), arg0, arg1)
+}
+
+// ArchiveChat mocks base method.
+func (m *MockChatServiceServer) ArchiveChat(arg0 context.Context, arg1 *proto.ArchiveChatRequest) (*proto.ArchiveChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveChat", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ArchiveChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveChat indicates an expected call of ArchiveChat.
+func (mr *MockChatServiceServerMockRecorder) ArchiveChat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChat", reflect.TypeOf((*MockChatServiceServer)(nil).ArchiveChat), arg0, arg1)
+}
+
+// ChangeChatRole mocks base method.
+func (m *MockChatServiceServer) ChangeChatRole(arg0 context.Context, arg1 *proto.ChangeChatRoleRequest) (*proto.ChangeChatRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeChatRole", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ChangeChatRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeChatRole indicates an expected call of ChangeChatRole.
+func (mr *MockChatServiceServerMockRecorder) ChangeChatRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeChatRole", reflect.TypeOf((*MockChatServiceServer)(nil).ChangeChatRole), arg0, arg1)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatServiceServer) CreateChat(arg0 context.Context, arg1 *proto.CreateChatRequest) (*proto.CreateChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -274,6 +679,21 @@ func (mr *MockChatServiceServerMockRecorder) CreateChat(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatServiceServer)(nil).CreateChat), arg0, arg1)
 }
 
+// CreateChatInvite mocks base method.
+func (m *MockChatServiceServer) CreateChatInvite(arg0 context.Context, arg1 *proto.CreateChatInviteRequest) (*proto.CreateChatInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChatInvite", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateChatInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChatInvite indicates an expected call of CreateChatInvite.
+func (mr *MockChatServiceServerMockRecorder) CreateChatInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatInvite", reflect.TypeOf((*MockChatServiceServer)(nil).CreateChatInvite), arg0, arg1)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatServiceServer) DeleteChat(arg0 context.Context, arg1 *proto.DeleteChatRequest) (*proto.DeleteChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +724,66 @@ func (mr *MockChatServiceServerMockRecorder) GetChat(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatServiceServer)(nil).GetChat), arg0, arg1)
 }
 
+// GetChatExport mocks base method.
+func (m *MockChatServiceServer) GetChatExport(arg0 context.Context, arg1 *proto.GetChatExportRequest) (*proto.GetChatExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatExport", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetChatExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatExport indicates an expected call of GetChatExport.
+func (mr *MockChatServiceServerMockRecorder) GetChatExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatExport", reflect.TypeOf((*MockChatServiceServer)(nil).GetChatExport), arg0, arg1)
+}
+
+// GetChatInvitePreview mocks base method.
+func (m *MockChatServiceServer) GetChatInvitePreview(arg0 context.Context, arg1 *proto.GetChatInvitePreviewRequest) (*proto.GetChatInvitePreviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvitePreview", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetChatInvitePreviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvitePreview indicates an expected call of GetChatInvitePreview.
+func (mr *MockChatServiceServerMockRecorder) GetChatInvitePreview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvitePreview", reflect.TypeOf((*MockChatServiceServer)(nil).GetChatInvitePreview), arg0, arg1)
+}
+
+// GetChatInvites mocks base method.
+func (m *MockChatServiceServer) GetChatInvites(arg0 context.Context, arg1 *proto.GetChatInvitesRequest) (*proto.GetChatInvitesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvites", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetChatInvitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatServiceServerMockRecorder) GetChatInvites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatServiceServer)(nil).GetChatInvites), arg0, arg1)
+}
+
+// GetChatMembers mocks base method.
+func (m *MockChatServiceServer) GetChatMembers(arg0 context.Context, arg1 *proto.GetChatMembersRequest) (*proto.GetChatMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatMembers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetChatMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMembers indicates an expected call of GetChatMembers.
+func (mr *MockChatServiceServerMockRecorder) GetChatMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMembers", reflect.TypeOf((*MockChatServiceServer)(nil).GetChatMembers), arg0, arg1)
+}
+
 // GetChatParticipants mocks base method.
 func (m *MockChatServiceServer) GetChatParticipants(arg0 context.Context, arg1 *proto.GetChatParticipantsRequest) (*proto.GetChatParticipantsResponse, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +874,21 @@ func (mr *MockChatServiceServerMockRecorder) JoinChat(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChat", reflect.TypeOf((*MockChatServiceServer)(nil).JoinChat), arg0, arg1)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatServiceServer) JoinChatByInvite(arg0 context.Context, arg1 *proto.JoinChatByInviteRequest) (*proto.JoinChatByInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChatByInvite", arg0, arg1)
+	ret0, _ := ret[0].(*proto.JoinChatByInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatServiceServerMockRecorder) JoinChatByInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatServiceServer)(nil).JoinChatByInvite), arg0, arg1)
+}
+
 // LeaveChat mocks base method.
 func (m *MockChatServiceServer) LeaveChat(arg0 context.Context, arg1 *proto.LeaveChatRequest) (*proto.LeaveChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +904,141 @@ func (mr *MockChatServiceServerMockRecorder) LeaveChat(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChat", reflect.TypeOf((*MockChatServiceServer)(nil).LeaveChat), arg0, arg1)
 }
 
+// MuteChat mocks base method.
+func (m *MockChatServiceServer) MuteChat(arg0 context.Context, arg1 *proto.MuteChatRequest) (*proto.MuteChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteChat", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MuteChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MuteChat indicates an expected call of MuteChat.
+func (mr *MockChatServiceServerMockRecorder) MuteChat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteChat", reflect.TypeOf((*MockChatServiceServer)(nil).MuteChat), arg0, arg1)
+}
+
+// PinChat mocks base method.
+func (m *MockChatServiceServer) PinChat(arg0 context.Context, arg1 *proto.PinChatRequest) (*proto.PinChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinChat", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PinChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinChat indicates an expected call of PinChat.
+func (mr *MockChatServiceServerMockRecorder) PinChat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinChat", reflect.TypeOf((*MockChatServiceServer)(nil).PinChat), arg0, arg1)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockChatServiceServer) RemoveChatMember(arg0 context.Context, arg1 *proto.RemoveChatMemberRequest) (*proto.RemoveChatMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChatMember", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RemoveChatMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatServiceServerMockRecorder) RemoveChatMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatServiceServer)(nil).RemoveChatMember), arg0, arg1)
+}
+
+// ReorderPinnedChats mocks base method.
+func (m *MockChatServiceServer) ReorderPinnedChats(arg0 context.Context, arg1 *proto.ReorderPinnedChatsRequest) (*proto.ReorderPinnedChatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderPinnedChats", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ReorderPinnedChatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderPinnedChats indicates an expected call of ReorderPinnedChats.
+func (mr *MockChatServiceServerMockRecorder) ReorderPinnedChats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderPinnedChats", reflect.TypeOf((*MockChatServiceServer)(nil).ReorderPinnedChats), arg0, arg1)
+}
+
+// RequestChatExport mocks base method.
+func (m *MockChatServiceServer) RequestChatExport(arg0 context.Context, arg1 *proto.RequestChatExportRequest) (*proto.RequestChatExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestChatExport", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RequestChatExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestChatExport indicates an expected call of RequestChatExport.
+func (mr *MockChatServiceServerMockRecorder) RequestChatExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestChatExport", reflect.TypeOf((*MockChatServiceServer)(nil).RequestChatExport), arg0, arg1)
+}
+
+// RevokeChatInvite mocks base method.
+func (m *MockChatServiceServer) RevokeChatInvite(arg0 context.Context, arg1 *proto.RevokeChatInviteRequest) (*proto.RevokeChatInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeChatInvite", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RevokeChatInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeChatInvite indicates an expected call of RevokeChatInvite.
+func (mr *MockChatServiceServerMockRecorder) RevokeChatInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeChatInvite", reflect.TypeOf((*MockChatServiceServer)(nil).RevokeChatInvite), arg0, arg1)
+}
+
+// SetChatEncryption mocks base method.
+func (m *MockChatServiceServer) SetChatEncryption(arg0 context.Context, arg1 *proto.SetChatEncryptionRequest) (*proto.SetChatEncryptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatEncryption", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetChatEncryptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatEncryption indicates an expected call of SetChatEncryption.
+func (mr *MockChatServiceServerMockRecorder) SetChatEncryption(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatEncryption", reflect.TypeOf((*MockChatServiceServer)(nil).SetChatEncryption), arg0, arg1)
+}
+
+// SetChatMessageTTL mocks base method.
+func (m *MockChatServiceServer) SetChatMessageTTL(arg0 context.Context, arg1 *proto.SetChatMessageTTLRequest) (*proto.SetChatMessageTTLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatMessageTTL", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetChatMessageTTLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatMessageTTL indicates an expected call of SetChatMessageTTL.
+func (mr *MockChatServiceServerMockRecorder) SetChatMessageTTL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMessageTTL", reflect.TypeOf((*MockChatServiceServer)(nil).SetChatMessageTTL), arg0, arg1)
+}
+
+// UpdateChat mocks base method.
+func (m *MockChatServiceServer) UpdateChat(arg0 context.Context, arg1 *proto.UpdateChatRequest) (*proto.UpdateChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChat", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChat indicates an expected call of UpdateChat.
+func (mr *MockChatServiceServerMockRecorder) UpdateChat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatServiceServer)(nil).UpdateChat), arg0, arg1)
+}
+
 // mustEmbedUnimplementedChatServiceServer mocks base method.
 func (m *MockChatServiceServer) mustEmbedUnimplementedChatServiceServer() {
 	m.ctrl.T.Helper()
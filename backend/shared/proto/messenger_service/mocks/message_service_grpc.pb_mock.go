@@ -36,6 +36,46 @@ func (m *MockMessageServiceClient) EXPECT() *MockMessageServiceClientMockRecorde
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockMessageServiceClient) AddReaction(ctx context.Context, in *proto.AddReactionRequest, opts ...grpc.CallOption) (*proto.AddReactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddReaction", varargs...)
+	ret0, _ := ret[0].(*proto.AddReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageServiceClientMockRecorder) AddReaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageServiceClient)(nil).AddReaction), varargs...)
+}
+
+// CancelScheduledMessage mocks base method.
+func (m *MockMessageServiceClient) CancelScheduledMessage(ctx context.Context, in *proto.CancelScheduledMessageRequest, opts ...grpc.CallOption) (*proto.CancelScheduledMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelScheduledMessage", varargs...)
+	ret0, _ := ret[0].(*proto.CancelScheduledMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledMessage indicates an expected call of CancelScheduledMessage.
+func (mr *MockMessageServiceClientMockRecorder) CancelScheduledMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).CancelScheduledMessage), varargs...)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockMessageServiceClient) DeleteMessage(ctx context.Context, in *proto.DeleteMessageRequest, opts ...grpc.CallOption) (*proto.DeleteMessageResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +96,46 @@ func (mr *MockMessageServiceClientMockRecorder) DeleteMessage(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).DeleteMessage), varargs...)
 }
 
+// ForwardMessages mocks base method.
+func (m *MockMessageServiceClient) ForwardMessages(ctx context.Context, in *proto.ForwardMessagesRequest, opts ...grpc.CallOption) (*proto.ForwardMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForwardMessages", varargs...)
+	ret0, _ := ret[0].(*proto.ForwardMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForwardMessages indicates an expected call of ForwardMessages.
+func (mr *MockMessageServiceClientMockRecorder) ForwardMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardMessages", reflect.TypeOf((*MockMessageServiceClient)(nil).ForwardMessages), varargs...)
+}
+
+// GetChatEventsSince mocks base method.
+func (m *MockMessageServiceClient) GetChatEventsSince(ctx context.Context, in *proto.GetChatEventsSinceRequest, opts ...grpc.CallOption) (*proto.GetChatEventsSinceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatEventsSince", varargs...)
+	ret0, _ := ret[0].(*proto.GetChatEventsSinceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEventsSince indicates an expected call of GetChatEventsSince.
+func (mr *MockMessageServiceClientMockRecorder) GetChatEventsSince(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEventsSince", reflect.TypeOf((*MockMessageServiceClient)(nil).GetChatEventsSince), varargs...)
+}
+
 // GetLastReadTs mocks base method.
 func (m *MockMessageServiceClient) GetLastReadTs(ctx context.Context, in *proto.GetLastReadTsRequest, opts ...grpc.CallOption) (*proto.GetLastReadTsResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +176,26 @@ func (mr *MockMessageServiceClientMockRecorder) GetMessageById(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockMessageServiceClient)(nil).GetMessageById), varargs...)
 }
 
+// GetMessageReceipts mocks base method.
+func (m *MockMessageServiceClient) GetMessageReceipts(ctx context.Context, in *proto.GetMessageReceiptsRequest, opts ...grpc.CallOption) (*proto.GetMessageReceiptsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMessageReceipts", varargs...)
+	ret0, _ := ret[0].(*proto.GetMessageReceiptsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReceipts indicates an expected call of GetMessageReceipts.
+func (mr *MockMessageServiceClientMockRecorder) GetMessageReceipts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReceipts", reflect.TypeOf((*MockMessageServiceClient)(nil).GetMessageReceipts), varargs...)
+}
+
 // GetMessagesForChat mocks base method.
 func (m *MockMessageServiceClient) GetMessagesForChat(ctx context.Context, in *proto.GetMessagesForChatRequest, opts ...grpc.CallOption) (*proto.GetMessagesForChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +236,106 @@ func (mr *MockMessageServiceClientMockRecorder) GetNumUnreadMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadMessages", reflect.TypeOf((*MockMessageServiceClient)(nil).GetNumUnreadMessages), varargs...)
 }
 
+// GetScheduledMessages mocks base method.
+func (m *MockMessageServiceClient) GetScheduledMessages(ctx context.Context, in *proto.GetScheduledMessagesRequest, opts ...grpc.CallOption) (*proto.GetScheduledMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetScheduledMessages", varargs...)
+	ret0, _ := ret[0].(*proto.GetScheduledMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessages indicates an expected call of GetScheduledMessages.
+func (mr *MockMessageServiceClientMockRecorder) GetScheduledMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessages", reflect.TypeOf((*MockMessageServiceClient)(nil).GetScheduledMessages), varargs...)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageServiceClient) PinMessage(ctx context.Context, in *proto.PinMessageRequest, opts ...grpc.CallOption) (*proto.PinMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PinMessage", varargs...)
+	ret0, _ := ret[0].(*proto.PinMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageServiceClientMockRecorder) PinMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).PinMessage), varargs...)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageServiceClient) RemoveReaction(ctx context.Context, in *proto.RemoveReactionRequest, opts ...grpc.CallOption) (*proto.RemoveReactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveReaction", varargs...)
+	ret0, _ := ret[0].(*proto.RemoveReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageServiceClientMockRecorder) RemoveReaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageServiceClient)(nil).RemoveReaction), varargs...)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockMessageServiceClient) ScheduleMessage(ctx context.Context, in *proto.ScheduleMessageRequest, opts ...grpc.CallOption) (*proto.ScheduleMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleMessage", varargs...)
+	ret0, _ := ret[0].(*proto.ScheduleMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockMessageServiceClientMockRecorder) ScheduleMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).ScheduleMessage), varargs...)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageServiceClient) SearchMessages(ctx context.Context, in *proto.SearchMessagesRequest, opts ...grpc.CallOption) (*proto.SearchMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchMessages", varargs...)
+	ret0, _ := ret[0].(*proto.SearchMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageServiceClientMockRecorder) SearchMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageServiceClient)(nil).SearchMessages), varargs...)
+}
+
 // SendMessage mocks base method.
 func (m *MockMessageServiceClient) SendMessage(ctx context.Context, in *proto.SendMessageRequest, opts ...grpc.CallOption) (*proto.SendMessageResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +356,46 @@ func (mr *MockMessageServiceClientMockRecorder) SendMessage(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).SendMessage), varargs...)
 }
 
+// UnpinMessage mocks base method.
+func (m *MockMessageServiceClient) UnpinMessage(ctx context.Context, in *proto.UnpinMessageRequest, opts ...grpc.CallOption) (*proto.UnpinMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpinMessage", varargs...)
+	ret0, _ := ret[0].(*proto.UnpinMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageServiceClientMockRecorder) UnpinMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).UnpinMessage), varargs...)
+}
+
+// UpdateLastDeliveredTs mocks base method.
+func (m *MockMessageServiceClient) UpdateLastDeliveredTs(ctx context.Context, in *proto.UpdateLastDeliveredTsRequest, opts ...grpc.CallOption) (*proto.UpdateLastDeliveredTsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLastDeliveredTs", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateLastDeliveredTsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastDeliveredTs indicates an expected call of UpdateLastDeliveredTs.
+func (mr *MockMessageServiceClientMockRecorder) UpdateLastDeliveredTs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastDeliveredTs", reflect.TypeOf((*MockMessageServiceClient)(nil).UpdateLastDeliveredTs), varargs...)
+}
+
 // UpdateLastReadTs mocks base method.
 func (m *MockMessageServiceClient) UpdateLastReadTs(ctx context.Context, in *proto.UpdateLastReadTsRequest, opts ...grpc.CallOption) (*proto.UpdateLastReadTsResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +416,66 @@ func (mr *MockMessageServiceClientMockRecorder) UpdateLastReadTs(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageServiceClient)(nil).UpdateLastReadTs), varargs...)
 }
 
+// UpdateMessage mocks base method.
+func (m *MockMessageServiceClient) UpdateMessage(ctx context.Context, in *proto.UpdateMessageRequest, opts ...grpc.CallOption) (*proto.UpdateMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMessage", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageServiceClientMockRecorder) UpdateMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).UpdateMessage), varargs...)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockMessageServiceClient) UpdateScheduledMessage(ctx context.Context, in *proto.UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*proto.UpdateScheduledMessageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateScheduledMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockMessageServiceClientMockRecorder) UpdateScheduledMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).UpdateScheduledMessage), varargs...)
+}
+
+// VotePoll mocks base method.
+func (m *MockMessageServiceClient) VotePoll(ctx context.Context, in *proto.VotePollRequest, opts ...grpc.CallOption) (*proto.VotePollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VotePoll", varargs...)
+	ret0, _ := ret[0].(*proto.VotePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockMessageServiceClientMockRecorder) VotePoll(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockMessageServiceClient)(nil).VotePoll), varargs...)
+}
+
 // MockMessageServiceServer is a mock of MessageServiceServer interface.
 type MockMessageServiceServer struct {
 	ctrl     *gomock.Controller
@@ -199,6 +499,36 @@ func (m *MockMessageServiceServer) EXPECT() *MockMessageServiceServerMockRecorde
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockMessageServiceServer) AddReaction(arg0 context.Context, arg1 *proto.AddReactionRequest) (*proto.AddReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageServiceServerMockRecorder) AddReaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageServiceServer)(nil).AddReaction), arg0, arg1)
+}
+
+// CancelScheduledMessage mocks base method.
+func (m *MockMessageServiceServer) CancelScheduledMessage(arg0 context.Context, arg1 *proto.CancelScheduledMessageRequest) (*proto.CancelScheduledMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CancelScheduledMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledMessage indicates an expected call of CancelScheduledMessage.
+func (mr *MockMessageServiceServerMockRecorder) CancelScheduledMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).CancelScheduledMessage), arg0, arg1)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockMessageServiceServer) DeleteMessage(arg0 context.Context, arg1 *proto.DeleteMessageRequest) (*proto.DeleteMessageResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +544,36 @@ func (mr *MockMessageServiceServerMockRecorder) DeleteMessage(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).DeleteMessage), arg0, arg1)
 }
 
+// ForwardMessages mocks base method.
+func (m *MockMessageServiceServer) ForwardMessages(arg0 context.Context, arg1 *proto.ForwardMessagesRequest) (*proto.ForwardMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardMessages", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ForwardMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForwardMessages indicates an expected call of ForwardMessages.
+func (mr *MockMessageServiceServerMockRecorder) ForwardMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardMessages", reflect.TypeOf((*MockMessageServiceServer)(nil).ForwardMessages), arg0, arg1)
+}
+
+// GetChatEventsSince mocks base method.
+func (m *MockMessageServiceServer) GetChatEventsSince(arg0 context.Context, arg1 *proto.GetChatEventsSinceRequest) (*proto.GetChatEventsSinceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatEventsSince", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetChatEventsSinceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatEventsSince indicates an expected call of GetChatEventsSince.
+func (mr *MockMessageServiceServerMockRecorder) GetChatEventsSince(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatEventsSince", reflect.TypeOf((*MockMessageServiceServer)(nil).GetChatEventsSince), arg0, arg1)
+}
+
 // GetLastReadTs mocks base method.
 func (m *MockMessageServiceServer) GetLastReadTs(arg0 context.Context, arg1 *proto.GetLastReadTsRequest) (*proto.GetLastReadTsResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +604,21 @@ func (mr *MockMessageServiceServerMockRecorder) GetMessageById(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockMessageServiceServer)(nil).GetMessageById), arg0, arg1)
 }
 
+// GetMessageReceipts mocks base method.
+func (m *MockMessageServiceServer) GetMessageReceipts(arg0 context.Context, arg1 *proto.GetMessageReceiptsRequest) (*proto.GetMessageReceiptsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReceipts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetMessageReceiptsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReceipts indicates an expected call of GetMessageReceipts.
+func (mr *MockMessageServiceServerMockRecorder) GetMessageReceipts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReceipts", reflect.TypeOf((*MockMessageServiceServer)(nil).GetMessageReceipts), arg0, arg1)
+}
+
 // GetMessagesForChat mocks base method.
 func (m *MockMessageServiceServer) GetMessagesForChat(arg0 context.Context, arg1 *proto.GetMessagesForChatRequest) (*proto.GetMessagesForChatResponse, error) {
 	m.ctrl.T.Helper()
@@ -274,6 +649,81 @@ func (mr *MockMessageServiceServerMockRecorder) GetNumUnreadMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNumUnreadMessages", reflect.TypeOf((*MockMessageServiceServer)(nil).GetNumUnreadMessages), arg0, arg1)
 }
 
+// GetScheduledMessages mocks base method.
+func (m *MockMessageServiceServer) GetScheduledMessages(arg0 context.Context, arg1 *proto.GetScheduledMessagesRequest) (*proto.GetScheduledMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMessages", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetScheduledMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMessages indicates an expected call of GetScheduledMessages.
+func (mr *MockMessageServiceServerMockRecorder) GetScheduledMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMessages", reflect.TypeOf((*MockMessageServiceServer)(nil).GetScheduledMessages), arg0, arg1)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageServiceServer) PinMessage(arg0 context.Context, arg1 *proto.PinMessageRequest) (*proto.PinMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PinMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageServiceServerMockRecorder) PinMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).PinMessage), arg0, arg1)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageServiceServer) RemoveReaction(arg0 context.Context, arg1 *proto.RemoveReactionRequest) (*proto.RemoveReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RemoveReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageServiceServerMockRecorder) RemoveReaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageServiceServer)(nil).RemoveReaction), arg0, arg1)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockMessageServiceServer) ScheduleMessage(arg0 context.Context, arg1 *proto.ScheduleMessageRequest) (*proto.ScheduleMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ScheduleMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockMessageServiceServerMockRecorder) ScheduleMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).ScheduleMessage), arg0, arg1)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageServiceServer) SearchMessages(arg0 context.Context, arg1 *proto.SearchMessagesRequest) (*proto.SearchMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SearchMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageServiceServerMockRecorder) SearchMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageServiceServer)(nil).SearchMessages), arg0, arg1)
+}
+
 // SendMessage mocks base method.
 func (m *MockMessageServiceServer) SendMessage(arg0 context.Context, arg1 *proto.SendMessageRequest) (*proto.SendMessageResponse, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +739,36 @@ func (mr *MockMessageServiceServerMockRecorder) SendMessage(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).SendMessage), arg0, arg1)
 }
 
+// UnpinMessage mocks base method.
+func (m *MockMessageServiceServer) UnpinMessage(arg0 context.Context, arg1 *proto.UnpinMessageRequest) (*proto.UnpinMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnpinMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageServiceServerMockRecorder) UnpinMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).UnpinMessage), arg0, arg1)
+}
+
+// UpdateLastDeliveredTs mocks base method.
+func (m *MockMessageServiceServer) UpdateLastDeliveredTs(arg0 context.Context, arg1 *proto.UpdateLastDeliveredTsRequest) (*proto.UpdateLastDeliveredTsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastDeliveredTs", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateLastDeliveredTsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastDeliveredTs indicates an expected call of UpdateLastDeliveredTs.
+func (mr *MockMessageServiceServerMockRecorder) UpdateLastDeliveredTs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastDeliveredTs", reflect.TypeOf((*MockMessageServiceServer)(nil).UpdateLastDeliveredTs), arg0, arg1)
+}
+
 // UpdateLastReadTs mocks base method.
 func (m *MockMessageServiceServer) UpdateLastReadTs(arg0 context.Context, arg1 *proto.UpdateLastReadTsRequest) (*proto.UpdateLastReadTsResponse, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +784,51 @@ func (mr *MockMessageServiceServerMockRecorder) UpdateLastReadTs(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadTs", reflect.TypeOf((*MockMessageServiceServer)(nil).UpdateLastReadTs), arg0, arg1)
 }
 
+// UpdateMessage mocks base method.
+func (m *MockMessageServiceServer) UpdateMessage(arg0 context.Context, arg1 *proto.UpdateMessageRequest) (*proto.UpdateMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageServiceServerMockRecorder) UpdateMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).UpdateMessage), arg0, arg1)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockMessageServiceServer) UpdateScheduledMessage(arg0 context.Context, arg1 *proto.UpdateScheduledMessageRequest) (*proto.UpdateScheduledMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateScheduledMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockMessageServiceServerMockRecorder) UpdateScheduledMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockMessageServiceServer)(nil).UpdateScheduledMessage), arg0, arg1)
+}
+
+// VotePoll mocks base method.
+func (m *MockMessageServiceServer) VotePoll(arg0 context.Context, arg1 *proto.VotePollRequest) (*proto.VotePollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VotePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockMessageServiceServerMockRecorder) VotePoll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockMessageServiceServer)(nil).VotePoll), arg0, arg1)
+}
+
 // mustEmbedUnimplementedMessageServiceServer mocks base method.
 func (m *MockMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {
 	m.ctrl.T.Helper()
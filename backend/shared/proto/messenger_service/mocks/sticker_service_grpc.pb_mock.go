@@ -36,6 +36,26 @@ func (m *MockStickerServiceClient) EXPECT() *MockStickerServiceClientMockRecorde
 	return m.recorder
 }
 
+// AddFavouriteSticker mocks base method.
+func (m *MockStickerServiceClient) AddFavouriteSticker(ctx context.Context, in *proto.AddFavouriteStickerRequest, opts ...grpc.CallOption) (*proto.AddFavouriteStickerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddFavouriteSticker", varargs...)
+	ret0, _ := ret[0].(*proto.AddFavouriteStickerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavouriteSticker indicates an expected call of AddFavouriteSticker.
+func (mr *MockStickerServiceClientMockRecorder) AddFavouriteSticker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavouriteSticker", reflect.TypeOf((*MockStickerServiceClient)(nil).AddFavouriteSticker), varargs...)
+}
+
 // AddStickerPack mocks base method.
 func (m *MockStickerServiceClient) AddStickerPack(ctx context.Context, in *proto.AddStickerPackRequest, opts ...grpc.CallOption) (*proto.AddStickerPackResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +96,46 @@ func (mr *MockStickerServiceClientMockRecorder) DeleteStickerPack(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStickerPack", reflect.TypeOf((*MockStickerServiceClient)(nil).DeleteStickerPack), varargs...)
 }
 
+// GetFavouriteStickers mocks base method.
+func (m *MockStickerServiceClient) GetFavouriteStickers(ctx context.Context, in *proto.GetFavouriteStickersRequest, opts ...grpc.CallOption) (*proto.GetFavouriteStickersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFavouriteStickers", varargs...)
+	ret0, _ := ret[0].(*proto.GetFavouriteStickersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavouriteStickers indicates an expected call of GetFavouriteStickers.
+func (mr *MockStickerServiceClientMockRecorder) GetFavouriteStickers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavouriteStickers", reflect.TypeOf((*MockStickerServiceClient)(nil).GetFavouriteStickers), varargs...)
+}
+
+// GetRecentStickers mocks base method.
+func (m *MockStickerServiceClient) GetRecentStickers(ctx context.Context, in *proto.GetRecentStickersRequest, opts ...grpc.CallOption) (*proto.GetRecentStickersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecentStickers", varargs...)
+	ret0, _ := ret[0].(*proto.GetRecentStickersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentStickers indicates an expected call of GetRecentStickers.
+func (mr *MockStickerServiceClientMockRecorder) GetRecentStickers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentStickers", reflect.TypeOf((*MockStickerServiceClient)(nil).GetRecentStickers), varargs...)
+}
+
 // GetStickerPack mocks base method.
 func (m *MockStickerServiceClient) GetStickerPack(ctx context.Context, in *proto.GetStickerPackRequest, opts ...grpc.CallOption) (*proto.GetStickerPackResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +196,106 @@ func (mr *MockStickerServiceClientMockRecorder) GetStickerPacks(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickerPacks", reflect.TypeOf((*MockStickerServiceClient)(nil).GetStickerPacks), varargs...)
 }
 
+// GetUserStickerPacks mocks base method.
+func (m *MockStickerServiceClient) GetUserStickerPacks(ctx context.Context, in *proto.GetUserStickerPacksRequest, opts ...grpc.CallOption) (*proto.GetUserStickerPacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserStickerPacks", varargs...)
+	ret0, _ := ret[0].(*proto.GetUserStickerPacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStickerPacks indicates an expected call of GetUserStickerPacks.
+func (mr *MockStickerServiceClientMockRecorder) GetUserStickerPacks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStickerPacks", reflect.TypeOf((*MockStickerServiceClient)(nil).GetUserStickerPacks), varargs...)
+}
+
+// InstallStickerPack mocks base method.
+func (m *MockStickerServiceClient) InstallStickerPack(ctx context.Context, in *proto.InstallStickerPackRequest, opts ...grpc.CallOption) (*proto.InstallStickerPackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstallStickerPack", varargs...)
+	ret0, _ := ret[0].(*proto.InstallStickerPackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallStickerPack indicates an expected call of InstallStickerPack.
+func (mr *MockStickerServiceClientMockRecorder) InstallStickerPack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallStickerPack", reflect.TypeOf((*MockStickerServiceClient)(nil).InstallStickerPack), varargs...)
+}
+
+// RemoveFavouriteSticker mocks base method.
+func (m *MockStickerServiceClient) RemoveFavouriteSticker(ctx context.Context, in *proto.RemoveFavouriteStickerRequest, opts ...grpc.CallOption) (*proto.RemoveFavouriteStickerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFavouriteSticker", varargs...)
+	ret0, _ := ret[0].(*proto.RemoveFavouriteStickerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFavouriteSticker indicates an expected call of RemoveFavouriteSticker.
+func (mr *MockStickerServiceClientMockRecorder) RemoveFavouriteSticker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavouriteSticker", reflect.TypeOf((*MockStickerServiceClient)(nil).RemoveFavouriteSticker), varargs...)
+}
+
+// ReorderUserStickerPacks mocks base method.
+func (m *MockStickerServiceClient) ReorderUserStickerPacks(ctx context.Context, in *proto.ReorderUserStickerPacksRequest, opts ...grpc.CallOption) (*proto.ReorderUserStickerPacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReorderUserStickerPacks", varargs...)
+	ret0, _ := ret[0].(*proto.ReorderUserStickerPacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderUserStickerPacks indicates an expected call of ReorderUserStickerPacks.
+func (mr *MockStickerServiceClientMockRecorder) ReorderUserStickerPacks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserStickerPacks", reflect.TypeOf((*MockStickerServiceClient)(nil).ReorderUserStickerPacks), varargs...)
+}
+
+// UninstallStickerPack mocks base method.
+func (m *MockStickerServiceClient) UninstallStickerPack(ctx context.Context, in *proto.UninstallStickerPackRequest, opts ...grpc.CallOption) (*proto.UninstallStickerPackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UninstallStickerPack", varargs...)
+	ret0, _ := ret[0].(*proto.UninstallStickerPackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UninstallStickerPack indicates an expected call of UninstallStickerPack.
+func (mr *MockStickerServiceClientMockRecorder) UninstallStickerPack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallStickerPack", reflect.TypeOf((*MockStickerServiceClient)(nil).UninstallStickerPack), varargs...)
+}
+
 // MockStickerServiceServer is a mock of StickerServiceServer interface.
 type MockStickerServiceServer struct {
 	ctrl     *gomock.Controller
@@ -159,6 +319,21 @@ func (m *MockStickerServiceServer) EXPECT() *MockStickerServiceServerMockRecorde
 	return m.recorder
 }
 
+// AddFavouriteSticker mocks base method.
+func (m *MockStickerServiceServer) AddFavouriteSticker(arg0 context.Context, arg1 *proto.AddFavouriteStickerRequest) (*proto.AddFavouriteStickerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavouriteSticker", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddFavouriteStickerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavouriteSticker indicates an expected call of AddFavouriteSticker.
+func (mr *MockStickerServiceServerMockRecorder) AddFavouriteSticker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavouriteSticker", reflect.TypeOf((*MockStickerServiceServer)(nil).AddFavouriteSticker), arg0, arg1)
+}
+
 // AddStickerPack mocks base method.
 func (m *MockStickerServiceServer) AddStickerPack(arg0 context.Context, arg1 *proto.AddStickerPackRequest) (*proto.AddStickerPackResponse, error) {
 	m.ctrl.T.Helper()
@@ -189,6 +364,36 @@ func (mr *MockStickerServiceServerMockRecorder) DeleteStickerPack(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStickerPack", reflect.TypeOf((*MockStickerServiceServer)(nil).DeleteStickerPack), arg0, arg1)
 }
 
+// GetFavouriteStickers mocks base method.
+func (m *MockStickerServiceServer) GetFavouriteStickers(arg0 context.Context, arg1 *proto.GetFavouriteStickersRequest) (*proto.GetFavouriteStickersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavouriteStickers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetFavouriteStickersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavouriteStickers indicates an expected call of GetFavouriteStickers.
+func (mr *MockStickerServiceServerMockRecorder) GetFavouriteStickers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavouriteStickers", reflect.TypeOf((*MockStickerServiceServer)(nil).GetFavouriteStickers), arg0, arg1)
+}
+
+// GetRecentStickers mocks base method.
+func (m *MockStickerServiceServer) GetRecentStickers(arg0 context.Context, arg1 *proto.GetRecentStickersRequest) (*proto.GetRecentStickersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentStickers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetRecentStickersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentStickers indicates an expected call of GetRecentStickers.
+func (mr *MockStickerServiceServerMockRecorder) GetRecentStickers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentStickers", reflect.TypeOf((*MockStickerServiceServer)(nil).GetRecentStickers), arg0, arg1)
+}
+
 // GetStickerPack mocks base method.
 func (m *MockStickerServiceServer) GetStickerPack(arg0 context.Context, arg1 *proto.GetStickerPackRequest) (*proto.GetStickerPackResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +439,81 @@ func (mr *MockStickerServiceServerMockRecorder) GetStickerPacks(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickerPacks", reflect.TypeOf((*MockStickerServiceServer)(nil).GetStickerPacks), arg0, arg1)
 }
 
+// GetUserStickerPacks mocks base method.
+func (m *MockStickerServiceServer) GetUserStickerPacks(arg0 context.Context, arg1 *proto.GetUserStickerPacksRequest) (*proto.GetUserStickerPacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStickerPacks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetUserStickerPacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStickerPacks indicates an expected call of GetUserStickerPacks.
+func (mr *MockStickerServiceServerMockRecorder) GetUserStickerPacks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStickerPacks", reflect.TypeOf((*MockStickerServiceServer)(nil).GetUserStickerPacks), arg0, arg1)
+}
+
+// InstallStickerPack mocks base method.
+func (m *MockStickerServiceServer) InstallStickerPack(arg0 context.Context, arg1 *proto.InstallStickerPackRequest) (*proto.InstallStickerPackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallStickerPack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.InstallStickerPackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallStickerPack indicates an expected call of InstallStickerPack.
+func (mr *MockStickerServiceServerMockRecorder) InstallStickerPack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallStickerPack", reflect.TypeOf((*MockStickerServiceServer)(nil).InstallStickerPack), arg0, arg1)
+}
+
+// RemoveFavouriteSticker mocks base method.
+func (m *MockStickerServiceServer) RemoveFavouriteSticker(arg0 context.Context, arg1 *proto.RemoveFavouriteStickerRequest) (*proto.RemoveFavouriteStickerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavouriteSticker", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RemoveFavouriteStickerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFavouriteSticker indicates an expected call of RemoveFavouriteSticker.
+func (mr *MockStickerServiceServerMockRecorder) RemoveFavouriteSticker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavouriteSticker", reflect.TypeOf((*MockStickerServiceServer)(nil).RemoveFavouriteSticker), arg0, arg1)
+}
+
+// ReorderUserStickerPacks mocks base method.
+func (m *MockStickerServiceServer) ReorderUserStickerPacks(arg0 context.Context, arg1 *proto.ReorderUserStickerPacksRequest) (*proto.ReorderUserStickerPacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderUserStickerPacks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ReorderUserStickerPacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderUserStickerPacks indicates an expected call of ReorderUserStickerPacks.
+func (mr *MockStickerServiceServerMockRecorder) ReorderUserStickerPacks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserStickerPacks", reflect.TypeOf((*MockStickerServiceServer)(nil).ReorderUserStickerPacks), arg0, arg1)
+}
+
+// UninstallStickerPack mocks base method.
+func (m *MockStickerServiceServer) UninstallStickerPack(arg0 context.Context, arg1 *proto.UninstallStickerPackRequest) (*proto.UninstallStickerPackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallStickerPack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UninstallStickerPackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UninstallStickerPack indicates an expected call of UninstallStickerPack.
+func (mr *MockStickerServiceServerMockRecorder) UninstallStickerPack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallStickerPack", reflect.TypeOf((*MockStickerServiceServer)(nil).UninstallStickerPack), arg0, arg1)
+}
+
 // mustEmbedUnimplementedStickerServiceServer mocks base method.
 func (m *MockStickerServiceServer) mustEmbedUnimplementedStickerServiceServer() {
 	m.ctrl.T.Helper()
@@ -603,6 +603,798 @@ func (x *GetStickerPackByNameResponse) GetStickerPack() *StickerPack {
 	return nil
 }
 
+type InstallStickerPackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PackId string `protobuf:"bytes,2,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+}
+
+func (x *InstallStickerPackRequest) Reset() {
+	*x = InstallStickerPackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallStickerPackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallStickerPackRequest) ProtoMessage() {}
+
+func (x *InstallStickerPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallStickerPackRequest.ProtoReflect.Descriptor instead.
+func (*InstallStickerPackRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstallStickerPackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InstallStickerPackRequest) GetPackId() string {
+	if x != nil {
+		return x.PackId
+	}
+	return ""
+}
+
+type InstallStickerPackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *InstallStickerPackResponse) Reset() {
+	*x = InstallStickerPackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallStickerPackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallStickerPackResponse) ProtoMessage() {}
+
+func (x *InstallStickerPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallStickerPackResponse.ProtoReflect.Descriptor instead.
+func (*InstallStickerPackResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InstallStickerPackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UninstallStickerPackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PackId string `protobuf:"bytes,2,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+}
+
+func (x *UninstallStickerPackRequest) Reset() {
+	*x = UninstallStickerPackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallStickerPackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallStickerPackRequest) ProtoMessage() {}
+
+func (x *UninstallStickerPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallStickerPackRequest.ProtoReflect.Descriptor instead.
+func (*UninstallStickerPackRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UninstallStickerPackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UninstallStickerPackRequest) GetPackId() string {
+	if x != nil {
+		return x.PackId
+	}
+	return ""
+}
+
+type UninstallStickerPackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UninstallStickerPackResponse) Reset() {
+	*x = UninstallStickerPackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallStickerPackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallStickerPackResponse) ProtoMessage() {}
+
+func (x *UninstallStickerPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallStickerPackResponse.ProtoReflect.Descriptor instead.
+func (*UninstallStickerPackResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UninstallStickerPackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetUserStickerPacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserStickerPacksRequest) Reset() {
+	*x = GetUserStickerPacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserStickerPacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStickerPacksRequest) ProtoMessage() {}
+
+func (x *GetUserStickerPacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStickerPacksRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStickerPacksRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserStickerPacksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserStickerPacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StickerPacks []*StickerPack `protobuf:"bytes,1,rep,name=sticker_packs,json=stickerPacks,proto3" json:"sticker_packs,omitempty"`
+}
+
+func (x *GetUserStickerPacksResponse) Reset() {
+	*x = GetUserStickerPacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserStickerPacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStickerPacksResponse) ProtoMessage() {}
+
+func (x *GetUserStickerPacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStickerPacksResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStickerPacksResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserStickerPacksResponse) GetStickerPacks() []*StickerPack {
+	if x != nil {
+		return x.StickerPacks
+	}
+	return nil
+}
+
+type ReorderUserStickerPacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PackIds []string `protobuf:"bytes,2,rep,name=pack_ids,json=packIds,proto3" json:"pack_ids,omitempty"`
+}
+
+func (x *ReorderUserStickerPacksRequest) Reset() {
+	*x = ReorderUserStickerPacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderUserStickerPacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderUserStickerPacksRequest) ProtoMessage() {}
+
+func (x *ReorderUserStickerPacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderUserStickerPacksRequest.ProtoReflect.Descriptor instead.
+func (*ReorderUserStickerPacksRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReorderUserStickerPacksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderUserStickerPacksRequest) GetPackIds() []string {
+	if x != nil {
+		return x.PackIds
+	}
+	return nil
+}
+
+type ReorderUserStickerPacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ReorderUserStickerPacksResponse) Reset() {
+	*x = ReorderUserStickerPacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderUserStickerPacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderUserStickerPacksResponse) ProtoMessage() {}
+
+func (x *ReorderUserStickerPacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderUserStickerPacksResponse.ProtoReflect.Descriptor instead.
+func (*ReorderUserStickerPacksResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReorderUserStickerPacksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetRecentStickersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetRecentStickersRequest) Reset() {
+	*x = GetRecentStickersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentStickersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentStickersRequest) ProtoMessage() {}
+
+func (x *GetRecentStickersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentStickersRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentStickersRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRecentStickersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetRecentStickersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stickers []*file_service.File `protobuf:"bytes,1,rep,name=stickers,proto3" json:"stickers,omitempty"`
+}
+
+func (x *GetRecentStickersResponse) Reset() {
+	*x = GetRecentStickersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentStickersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentStickersResponse) ProtoMessage() {}
+
+func (x *GetRecentStickersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentStickersResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentStickersResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRecentStickersResponse) GetStickers() []*file_service.File {
+	if x != nil {
+		return x.Stickers
+	}
+	return nil
+}
+
+type AddFavouriteStickerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StickerUrl string `protobuf:"bytes,2,opt,name=sticker_url,json=stickerUrl,proto3" json:"sticker_url,omitempty"`
+}
+
+func (x *AddFavouriteStickerRequest) Reset() {
+	*x = AddFavouriteStickerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavouriteStickerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteStickerRequest) ProtoMessage() {}
+
+func (x *AddFavouriteStickerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteStickerRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteStickerRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddFavouriteStickerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavouriteStickerRequest) GetStickerUrl() string {
+	if x != nil {
+		return x.StickerUrl
+	}
+	return ""
+}
+
+type AddFavouriteStickerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *AddFavouriteStickerResponse) Reset() {
+	*x = AddFavouriteStickerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavouriteStickerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteStickerResponse) ProtoMessage() {}
+
+func (x *AddFavouriteStickerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteStickerResponse.ProtoReflect.Descriptor instead.
+func (*AddFavouriteStickerResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddFavouriteStickerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFavouriteStickerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StickerUrl string `protobuf:"bytes,2,opt,name=sticker_url,json=stickerUrl,proto3" json:"sticker_url,omitempty"`
+}
+
+func (x *RemoveFavouriteStickerRequest) Reset() {
+	*x = RemoveFavouriteStickerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFavouriteStickerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteStickerRequest) ProtoMessage() {}
+
+func (x *RemoveFavouriteStickerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteStickerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteStickerRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveFavouriteStickerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavouriteStickerRequest) GetStickerUrl() string {
+	if x != nil {
+		return x.StickerUrl
+	}
+	return ""
+}
+
+type RemoveFavouriteStickerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RemoveFavouriteStickerResponse) Reset() {
+	*x = RemoveFavouriteStickerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFavouriteStickerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteStickerResponse) ProtoMessage() {}
+
+func (x *RemoveFavouriteStickerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteStickerResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteStickerResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveFavouriteStickerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetFavouriteStickersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetFavouriteStickersRequest) Reset() {
+	*x = GetFavouriteStickersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFavouriteStickersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavouriteStickersRequest) ProtoMessage() {}
+
+func (x *GetFavouriteStickersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavouriteStickersRequest.ProtoReflect.Descriptor instead.
+func (*GetFavouriteStickersRequest) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetFavouriteStickersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetFavouriteStickersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stickers []*file_service.File `protobuf:"bytes,1,rep,name=stickers,proto3" json:"stickers,omitempty"`
+}
+
+func (x *GetFavouriteStickersResponse) Reset() {
+	*x = GetFavouriteStickersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sticker_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFavouriteStickersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavouriteStickersResponse) ProtoMessage() {}
+
+func (x *GetFavouriteStickersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sticker_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavouriteStickersResponse.ProtoReflect.Descriptor instead.
+func (*GetFavouriteStickersResponse) Descriptor() ([]byte, []int) {
+	return file_sticker_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFavouriteStickersResponse) GetStickers() []*file_service.File {
+	if x != nil {
+		return x.Stickers
+	}
+	return nil
+}
+
 var File_sticker_service_proto protoreflect.FileDescriptor
 
 var file_sticker_service_proto_rawDesc = []byte{
@@ -676,43 +1468,169 @@ var file_sticker_service_proto_rawDesc = []byte{
 	0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61,
 	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
 	0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x0b, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
-	0x63, 0x6b, 0x32, 0xff, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x63, 0x6b, 0x22, 0x4d, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x22, 0x36, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4f, 0x0a, 0x1b, 0x55, 0x6e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x55, 0x6e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x54, 0x0a, 0x1e, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x73,
+	0x22, 0x3b, 0x0a, 0x1f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x33, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22,
+	0x56, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x22, 0x37, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x46, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x59, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x22, 0x3a, 0x0a, 0x1e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x4e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x32,
+	0xf1, 0x0a, 0x0a, 0x0e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
 	0x50, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
 	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x69, 0x63,
 	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x50, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50,
+	0x61, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x6e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x63, 0x6b, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x2c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65,
+	0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -727,46 +1645,81 @@ func file_sticker_service_proto_rawDescGZIP() []byte {
 	return file_sticker_service_proto_rawDescData
 }
 
-var file_sticker_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_sticker_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_sticker_service_proto_goTypes = []interface{}{
-	(*StickerPack)(nil),                  // 0: chat_service.StickerPack
-	(*AddStickerPackRequest)(nil),        // 1: chat_service.AddStickerPackRequest
-	(*AddStickerPackResponse)(nil),       // 2: chat_service.AddStickerPackResponse
-	(*GetStickerPackRequest)(nil),        // 3: chat_service.GetStickerPackRequest
-	(*GetStickerPackResponse)(nil),       // 4: chat_service.GetStickerPackResponse
-	(*DeleteStickerPackRequest)(nil),     // 5: chat_service.DeleteStickerPackRequest
-	(*DeleteStickerPackResponse)(nil),    // 6: chat_service.DeleteStickerPackResponse
-	(*GetStickerPacksRequest)(nil),       // 7: chat_service.GetStickerPacksRequest
-	(*GetStickerPacksResponse)(nil),      // 8: chat_service.GetStickerPacksResponse
-	(*GetStickerPackByNameRequest)(nil),  // 9: chat_service.GetStickerPackByNameRequest
-	(*GetStickerPackByNameResponse)(nil), // 10: chat_service.GetStickerPackByNameResponse
-	(*file_service.File)(nil),            // 11: file_service.File
-	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
+	(*StickerPack)(nil),                     // 0: chat_service.StickerPack
+	(*AddStickerPackRequest)(nil),           // 1: chat_service.AddStickerPackRequest
+	(*AddStickerPackResponse)(nil),          // 2: chat_service.AddStickerPackResponse
+	(*GetStickerPackRequest)(nil),           // 3: chat_service.GetStickerPackRequest
+	(*GetStickerPackResponse)(nil),          // 4: chat_service.GetStickerPackResponse
+	(*DeleteStickerPackRequest)(nil),        // 5: chat_service.DeleteStickerPackRequest
+	(*DeleteStickerPackResponse)(nil),       // 6: chat_service.DeleteStickerPackResponse
+	(*GetStickerPacksRequest)(nil),          // 7: chat_service.GetStickerPacksRequest
+	(*GetStickerPacksResponse)(nil),         // 8: chat_service.GetStickerPacksResponse
+	(*GetStickerPackByNameRequest)(nil),     // 9: chat_service.GetStickerPackByNameRequest
+	(*GetStickerPackByNameResponse)(nil),    // 10: chat_service.GetStickerPackByNameResponse
+	(*InstallStickerPackRequest)(nil),       // 11: chat_service.InstallStickerPackRequest
+	(*InstallStickerPackResponse)(nil),      // 12: chat_service.InstallStickerPackResponse
+	(*UninstallStickerPackRequest)(nil),     // 13: chat_service.UninstallStickerPackRequest
+	(*UninstallStickerPackResponse)(nil),    // 14: chat_service.UninstallStickerPackResponse
+	(*GetUserStickerPacksRequest)(nil),      // 15: chat_service.GetUserStickerPacksRequest
+	(*GetUserStickerPacksResponse)(nil),     // 16: chat_service.GetUserStickerPacksResponse
+	(*ReorderUserStickerPacksRequest)(nil),  // 17: chat_service.ReorderUserStickerPacksRequest
+	(*ReorderUserStickerPacksResponse)(nil), // 18: chat_service.ReorderUserStickerPacksResponse
+	(*GetRecentStickersRequest)(nil),        // 19: chat_service.GetRecentStickersRequest
+	(*GetRecentStickersResponse)(nil),       // 20: chat_service.GetRecentStickersResponse
+	(*AddFavouriteStickerRequest)(nil),      // 21: chat_service.AddFavouriteStickerRequest
+	(*AddFavouriteStickerResponse)(nil),     // 22: chat_service.AddFavouriteStickerResponse
+	(*RemoveFavouriteStickerRequest)(nil),   // 23: chat_service.RemoveFavouriteStickerRequest
+	(*RemoveFavouriteStickerResponse)(nil),  // 24: chat_service.RemoveFavouriteStickerResponse
+	(*GetFavouriteStickersRequest)(nil),     // 25: chat_service.GetFavouriteStickersRequest
+	(*GetFavouriteStickersResponse)(nil),    // 26: chat_service.GetFavouriteStickersResponse
+	(*file_service.File)(nil),               // 27: file_service.File
+	(*timestamppb.Timestamp)(nil),           // 28: google.protobuf.Timestamp
 }
 var file_sticker_service_proto_depIdxs = []int32{
-	11, // 0: chat_service.StickerPack.stickers:type_name -> file_service.File
-	12, // 1: chat_service.StickerPack.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: chat_service.StickerPack.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 0: chat_service.StickerPack.stickers:type_name -> file_service.File
+	28, // 1: chat_service.StickerPack.created_at:type_name -> google.protobuf.Timestamp
+	28, // 2: chat_service.StickerPack.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: chat_service.AddStickerPackRequest.sticker_pack:type_name -> chat_service.StickerPack
 	0,  // 4: chat_service.AddStickerPackResponse.sticker_pack:type_name -> chat_service.StickerPack
 	0,  // 5: chat_service.GetStickerPackResponse.sticker_pack:type_name -> chat_service.StickerPack
 	0,  // 6: chat_service.GetStickerPacksResponse.sticker_packs:type_name -> chat_service.StickerPack
 	0,  // 7: chat_service.GetStickerPackByNameResponse.sticker_pack:type_name -> chat_service.StickerPack
-	1,  // 8: chat_service.StickerService.AddStickerPack:input_type -> chat_service.AddStickerPackRequest
-	3,  // 9: chat_service.StickerService.GetStickerPack:input_type -> chat_service.GetStickerPackRequest
-	5,  // 10: chat_service.StickerService.DeleteStickerPack:input_type -> chat_service.DeleteStickerPackRequest
-	7,  // 11: chat_service.StickerService.GetStickerPacks:input_type -> chat_service.GetStickerPacksRequest
-	9,  // 12: chat_service.StickerService.GetStickerPackByName:input_type -> chat_service.GetStickerPackByNameRequest
-	2,  // 13: chat_service.StickerService.AddStickerPack:output_type -> chat_service.AddStickerPackResponse
-	4,  // 14: chat_service.StickerService.GetStickerPack:output_type -> chat_service.GetStickerPackResponse
-	6,  // 15: chat_service.StickerService.DeleteStickerPack:output_type -> chat_service.DeleteStickerPackResponse
-	8,  // 16: chat_service.StickerService.GetStickerPacks:output_type -> chat_service.GetStickerPacksResponse
-	10, // 17: chat_service.StickerService.GetStickerPackByName:output_type -> chat_service.GetStickerPackByNameResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 8: chat_service.GetUserStickerPacksResponse.sticker_packs:type_name -> chat_service.StickerPack
+	27, // 9: chat_service.GetRecentStickersResponse.stickers:type_name -> file_service.File
+	27, // 10: chat_service.GetFavouriteStickersResponse.stickers:type_name -> file_service.File
+	1,  // 11: chat_service.StickerService.AddStickerPack:input_type -> chat_service.AddStickerPackRequest
+	3,  // 12: chat_service.StickerService.GetStickerPack:input_type -> chat_service.GetStickerPackRequest
+	5,  // 13: chat_service.StickerService.DeleteStickerPack:input_type -> chat_service.DeleteStickerPackRequest
+	7,  // 14: chat_service.StickerService.GetStickerPacks:input_type -> chat_service.GetStickerPacksRequest
+	9,  // 15: chat_service.StickerService.GetStickerPackByName:input_type -> chat_service.GetStickerPackByNameRequest
+	11, // 16: chat_service.StickerService.InstallStickerPack:input_type -> chat_service.InstallStickerPackRequest
+	13, // 17: chat_service.StickerService.UninstallStickerPack:input_type -> chat_service.UninstallStickerPackRequest
+	15, // 18: chat_service.StickerService.GetUserStickerPacks:input_type -> chat_service.GetUserStickerPacksRequest
+	17, // 19: chat_service.StickerService.ReorderUserStickerPacks:input_type -> chat_service.ReorderUserStickerPacksRequest
+	19, // 20: chat_service.StickerService.GetRecentStickers:input_type -> chat_service.GetRecentStickersRequest
+	21, // 21: chat_service.StickerService.AddFavouriteSticker:input_type -> chat_service.AddFavouriteStickerRequest
+	23, // 22: chat_service.StickerService.RemoveFavouriteSticker:input_type -> chat_service.RemoveFavouriteStickerRequest
+	25, // 23: chat_service.StickerService.GetFavouriteStickers:input_type -> chat_service.GetFavouriteStickersRequest
+	2,  // 24: chat_service.StickerService.AddStickerPack:output_type -> chat_service.AddStickerPackResponse
+	4,  // 25: chat_service.StickerService.GetStickerPack:output_type -> chat_service.GetStickerPackResponse
+	6,  // 26: chat_service.StickerService.DeleteStickerPack:output_type -> chat_service.DeleteStickerPackResponse
+	8,  // 27: chat_service.StickerService.GetStickerPacks:output_type -> chat_service.GetStickerPacksResponse
+	10, // 28: chat_service.StickerService.GetStickerPackByName:output_type -> chat_service.GetStickerPackByNameResponse
+	12, // 29: chat_service.StickerService.InstallStickerPack:output_type -> chat_service.InstallStickerPackResponse
+	14, // 30: chat_service.StickerService.UninstallStickerPack:output_type -> chat_service.UninstallStickerPackResponse
+	16, // 31: chat_service.StickerService.GetUserStickerPacks:output_type -> chat_service.GetUserStickerPacksResponse
+	18, // 32: chat_service.StickerService.ReorderUserStickerPacks:output_type -> chat_service.ReorderUserStickerPacksResponse
+	20, // 33: chat_service.StickerService.GetRecentStickers:output_type -> chat_service.GetRecentStickersResponse
+	22, // 34: chat_service.StickerService.AddFavouriteSticker:output_type -> chat_service.AddFavouriteStickerResponse
+	24, // 35: chat_service.StickerService.RemoveFavouriteSticker:output_type -> chat_service.RemoveFavouriteStickerResponse
+	26, // 36: chat_service.StickerService.GetFavouriteStickers:output_type -> chat_service.GetFavouriteStickersResponse
+	24, // [24:37] is the sub-list for method output_type
+	11, // [11:24] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_sticker_service_proto_init() }
@@ -907,6 +1860,198 @@ func file_sticker_service_proto_init() {
 				return nil
 			}
 		}
+		file_sticker_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallStickerPackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallStickerPackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallStickerPackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallStickerPackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserStickerPacksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserStickerPacksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderUserStickerPacksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderUserStickerPacksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentStickersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentStickersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavouriteStickerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavouriteStickerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFavouriteStickerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFavouriteStickerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFavouriteStickersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sticker_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFavouriteStickersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -914,7 +2059,7 @@ func file_sticker_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sticker_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,14 @@ type StickerServiceClient interface {
 	DeleteStickerPack(ctx context.Context, in *DeleteStickerPackRequest, opts ...grpc.CallOption) (*DeleteStickerPackResponse, error)
 	GetStickerPacks(ctx context.Context, in *GetStickerPacksRequest, opts ...grpc.CallOption) (*GetStickerPacksResponse, error)
 	GetStickerPackByName(ctx context.Context, in *GetStickerPackByNameRequest, opts ...grpc.CallOption) (*GetStickerPackByNameResponse, error)
+	InstallStickerPack(ctx context.Context, in *InstallStickerPackRequest, opts ...grpc.CallOption) (*InstallStickerPackResponse, error)
+	UninstallStickerPack(ctx context.Context, in *UninstallStickerPackRequest, opts ...grpc.CallOption) (*UninstallStickerPackResponse, error)
+	GetUserStickerPacks(ctx context.Context, in *GetUserStickerPacksRequest, opts ...grpc.CallOption) (*GetUserStickerPacksResponse, error)
+	ReorderUserStickerPacks(ctx context.Context, in *ReorderUserStickerPacksRequest, opts ...grpc.CallOption) (*ReorderUserStickerPacksResponse, error)
+	GetRecentStickers(ctx context.Context, in *GetRecentStickersRequest, opts ...grpc.CallOption) (*GetRecentStickersResponse, error)
+	AddFavouriteSticker(ctx context.Context, in *AddFavouriteStickerRequest, opts ...grpc.CallOption) (*AddFavouriteStickerResponse, error)
+	RemoveFavouriteSticker(ctx context.Context, in *RemoveFavouriteStickerRequest, opts ...grpc.CallOption) (*RemoveFavouriteStickerResponse, error)
+	GetFavouriteStickers(ctx context.Context, in *GetFavouriteStickersRequest, opts ...grpc.CallOption) (*GetFavouriteStickersResponse, error)
 }
 
 type stickerServiceClient struct {
@@ -78,6 +86,78 @@ func (c *stickerServiceClient) GetStickerPackByName(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *stickerServiceClient) InstallStickerPack(ctx context.Context, in *InstallStickerPackRequest, opts ...grpc.CallOption) (*InstallStickerPackResponse, error) {
+	out := new(InstallStickerPackResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/InstallStickerPack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) UninstallStickerPack(ctx context.Context, in *UninstallStickerPackRequest, opts ...grpc.CallOption) (*UninstallStickerPackResponse, error) {
+	out := new(UninstallStickerPackResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/UninstallStickerPack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) GetUserStickerPacks(ctx context.Context, in *GetUserStickerPacksRequest, opts ...grpc.CallOption) (*GetUserStickerPacksResponse, error) {
+	out := new(GetUserStickerPacksResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/GetUserStickerPacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) ReorderUserStickerPacks(ctx context.Context, in *ReorderUserStickerPacksRequest, opts ...grpc.CallOption) (*ReorderUserStickerPacksResponse, error) {
+	out := new(ReorderUserStickerPacksResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/ReorderUserStickerPacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) GetRecentStickers(ctx context.Context, in *GetRecentStickersRequest, opts ...grpc.CallOption) (*GetRecentStickersResponse, error) {
+	out := new(GetRecentStickersResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/GetRecentStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) AddFavouriteSticker(ctx context.Context, in *AddFavouriteStickerRequest, opts ...grpc.CallOption) (*AddFavouriteStickerResponse, error) {
+	out := new(AddFavouriteStickerResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/AddFavouriteSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) RemoveFavouriteSticker(ctx context.Context, in *RemoveFavouriteStickerRequest, opts ...grpc.CallOption) (*RemoveFavouriteStickerResponse, error) {
+	out := new(RemoveFavouriteStickerResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/RemoveFavouriteSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stickerServiceClient) GetFavouriteStickers(ctx context.Context, in *GetFavouriteStickersRequest, opts ...grpc.CallOption) (*GetFavouriteStickersResponse, error) {
+	out := new(GetFavouriteStickersResponse)
+	err := c.cc.Invoke(ctx, "/chat_service.StickerService/GetFavouriteStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StickerServiceServer is the server API for StickerService service.
 // All implementations must embed UnimplementedStickerServiceServer
 // for forward compatibility
@@ -87,6 +167,14 @@ type StickerServiceServer interface {
 	DeleteStickerPack(context.Context, *DeleteStickerPackRequest) (*DeleteStickerPackResponse, error)
 	GetStickerPacks(context.Context, *GetStickerPacksRequest) (*GetStickerPacksResponse, error)
 	GetStickerPackByName(context.Context, *GetStickerPackByNameRequest) (*GetStickerPackByNameResponse, error)
+	InstallStickerPack(context.Context, *InstallStickerPackRequest) (*InstallStickerPackResponse, error)
+	UninstallStickerPack(context.Context, *UninstallStickerPackRequest) (*UninstallStickerPackResponse, error)
+	GetUserStickerPacks(context.Context, *GetUserStickerPacksRequest) (*GetUserStickerPacksResponse, error)
+	ReorderUserStickerPacks(context.Context, *ReorderUserStickerPacksRequest) (*ReorderUserStickerPacksResponse, error)
+	GetRecentStickers(context.Context, *GetRecentStickersRequest) (*GetRecentStickersResponse, error)
+	AddFavouriteSticker(context.Context, *AddFavouriteStickerRequest) (*AddFavouriteStickerResponse, error)
+	RemoveFavouriteSticker(context.Context, *RemoveFavouriteStickerRequest) (*RemoveFavouriteStickerResponse, error)
+	GetFavouriteStickers(context.Context, *GetFavouriteStickersRequest) (*GetFavouriteStickersResponse, error)
 	mustEmbedUnimplementedStickerServiceServer()
 }
 
@@ -109,6 +197,30 @@ func (UnimplementedStickerServiceServer) GetStickerPacks(context.Context, *GetSt
 func (UnimplementedStickerServiceServer) GetStickerPackByName(context.Context, *GetStickerPackByNameRequest) (*GetStickerPackByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStickerPackByName not implemented")
 }
+func (UnimplementedStickerServiceServer) InstallStickerPack(context.Context, *InstallStickerPackRequest) (*InstallStickerPackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallStickerPack not implemented")
+}
+func (UnimplementedStickerServiceServer) UninstallStickerPack(context.Context, *UninstallStickerPackRequest) (*UninstallStickerPackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallStickerPack not implemented")
+}
+func (UnimplementedStickerServiceServer) GetUserStickerPacks(context.Context, *GetUserStickerPacksRequest) (*GetUserStickerPacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStickerPacks not implemented")
+}
+func (UnimplementedStickerServiceServer) ReorderUserStickerPacks(context.Context, *ReorderUserStickerPacksRequest) (*ReorderUserStickerPacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderUserStickerPacks not implemented")
+}
+func (UnimplementedStickerServiceServer) GetRecentStickers(context.Context, *GetRecentStickersRequest) (*GetRecentStickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentStickers not implemented")
+}
+func (UnimplementedStickerServiceServer) AddFavouriteSticker(context.Context, *AddFavouriteStickerRequest) (*AddFavouriteStickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavouriteSticker not implemented")
+}
+func (UnimplementedStickerServiceServer) RemoveFavouriteSticker(context.Context, *RemoveFavouriteStickerRequest) (*RemoveFavouriteStickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavouriteSticker not implemented")
+}
+func (UnimplementedStickerServiceServer) GetFavouriteStickers(context.Context, *GetFavouriteStickersRequest) (*GetFavouriteStickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavouriteStickers not implemented")
+}
 func (UnimplementedStickerServiceServer) mustEmbedUnimplementedStickerServiceServer() {}
 
 // UnsafeStickerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +324,150 @@ func _StickerService_GetStickerPackByName_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StickerService_InstallStickerPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallStickerPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).InstallStickerPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/InstallStickerPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).InstallStickerPack(ctx, req.(*InstallStickerPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_UninstallStickerPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UninstallStickerPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).UninstallStickerPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/UninstallStickerPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).UninstallStickerPack(ctx, req.(*UninstallStickerPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_GetUserStickerPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStickerPacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).GetUserStickerPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/GetUserStickerPacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).GetUserStickerPacks(ctx, req.(*GetUserStickerPacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_ReorderUserStickerPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderUserStickerPacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).ReorderUserStickerPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/ReorderUserStickerPacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).ReorderUserStickerPacks(ctx, req.(*ReorderUserStickerPacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_GetRecentStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentStickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).GetRecentStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/GetRecentStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).GetRecentStickers(ctx, req.(*GetRecentStickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_AddFavouriteSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteStickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).AddFavouriteSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/AddFavouriteSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).AddFavouriteSticker(ctx, req.(*AddFavouriteStickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_RemoveFavouriteSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavouriteStickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).RemoveFavouriteSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/RemoveFavouriteSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).RemoveFavouriteSticker(ctx, req.(*RemoveFavouriteStickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StickerService_GetFavouriteStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavouriteStickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StickerServiceServer).GetFavouriteStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_service.StickerService/GetFavouriteStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StickerServiceServer).GetFavouriteStickers(ctx, req.(*GetFavouriteStickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StickerService_ServiceDesc is the grpc.ServiceDesc for StickerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +495,38 @@ var StickerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStickerPackByName",
 			Handler:    _StickerService_GetStickerPackByName_Handler,
 		},
+		{
+			MethodName: "InstallStickerPack",
+			Handler:    _StickerService_InstallStickerPack_Handler,
+		},
+		{
+			MethodName: "UninstallStickerPack",
+			Handler:    _StickerService_UninstallStickerPack_Handler,
+		},
+		{
+			MethodName: "GetUserStickerPacks",
+			Handler:    _StickerService_GetUserStickerPacks_Handler,
+		},
+		{
+			MethodName: "ReorderUserStickerPacks",
+			Handler:    _StickerService_ReorderUserStickerPacks_Handler,
+		},
+		{
+			MethodName: "GetRecentStickers",
+			Handler:    _StickerService_GetRecentStickers_Handler,
+		},
+		{
+			MethodName: "AddFavouriteSticker",
+			Handler:    _StickerService_AddFavouriteSticker_Handler,
+		},
+		{
+			MethodName: "RemoveFavouriteSticker",
+			Handler:    _StickerService_RemoveFavouriteSticker_Handler,
+		},
+		{
+			MethodName: "GetFavouriteStickers",
+			Handler:    _StickerService_GetFavouriteStickers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sticker_service.proto",
@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//shared/proto/notification_service/notification_service_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	notification_service "quickflow/shared/proto/notification_service"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MockNotificationServiceClient is a mock of NotificationServiceClient interface.
+type MockNotificationServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceClientMockRecorder
+}
+
+// MockNotificationServiceClientMockRecorder is the mock recorder for MockNotificationServiceClient.
+type MockNotificationServiceClientMockRecorder struct {
+	mock *MockNotificationServiceClient
+}
+
+// NewMockNotificationServiceClient creates a new mock instance.
+func NewMockNotificationServiceClient(ctrl *gomock.Controller) *MockNotificationServiceClient {
+	mock := &MockNotificationServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceClient) EXPECT() *MockNotificationServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationServiceClient) GetNotifications(ctx context.Context, in *notification_service.GetNotificationsRequest, opts ...grpc.CallOption) (*notification_service.GetNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNotifications", varargs...)
+	ret0, _ := ret[0].(*notification_service.GetNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationServiceClientMockRecorder) GetNotifications(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationServiceClient)(nil).GetNotifications), varargs...)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationServiceClient) GetUnreadCount(ctx context.Context, in *notification_service.GetUnreadCountRequest, opts ...grpc.CallOption) (*notification_service.GetUnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUnreadCount", varargs...)
+	ret0, _ := ret[0].(*notification_service.GetUnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationServiceClientMockRecorder) GetUnreadCount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationServiceClient)(nil).GetUnreadCount), varargs...)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockNotificationServiceClient) MarkAllNotificationsRead(ctx context.Context, in *notification_service.MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockNotificationServiceClientMockRecorder) MarkAllNotificationsRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockNotificationServiceClient)(nil).MarkAllNotificationsRead), varargs...)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockNotificationServiceClient) MarkNotificationsRead(ctx context.Context, in *notification_service.MarkNotificationsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockNotificationServiceClientMockRecorder) MarkNotificationsRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockNotificationServiceClient)(nil).MarkNotificationsRead), varargs...)
+}
+
+// SaveNotification mocks base method.
+func (m *MockNotificationServiceClient) SaveNotification(ctx context.Context, in *notification_service.SaveNotificationRequest, opts ...grpc.CallOption) (*notification_service.SaveNotificationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveNotification", varargs...)
+	ret0, _ := ret[0].(*notification_service.SaveNotificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveNotification indicates an expected call of SaveNotification.
+func (mr *MockNotificationServiceClientMockRecorder) SaveNotification(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotification", reflect.TypeOf((*MockNotificationServiceClient)(nil).SaveNotification), varargs...)
+}
+
+// MockNotificationServiceServer is a mock of NotificationServiceServer interface.
+type MockNotificationServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceServerMockRecorder
+}
+
+// MockNotificationServiceServerMockRecorder is the mock recorder for MockNotificationServiceServer.
+type MockNotificationServiceServerMockRecorder struct {
+	mock *MockNotificationServiceServer
+}
+
+// NewMockNotificationServiceServer creates a new mock instance.
+func NewMockNotificationServiceServer(ctrl *gomock.Controller) *MockNotificationServiceServer {
+	mock := &MockNotificationServiceServer{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceServer) EXPECT() *MockNotificationServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationServiceServer) GetNotifications(arg0 context.Context, arg1 *notification_service.GetNotificationsRequest) (*notification_service.GetNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", arg0, arg1)
+	ret0, _ := ret[0].(*notification_service.GetNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationServiceServerMockRecorder) GetNotifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationServiceServer)(nil).GetNotifications), arg0, arg1)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationServiceServer) GetUnreadCount(arg0 context.Context, arg1 *notification_service.GetUnreadCountRequest) (*notification_service.GetUnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", arg0, arg1)
+	ret0, _ := ret[0].(*notification_service.GetUnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationServiceServerMockRecorder) GetUnreadCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationServiceServer)(nil).GetUnreadCount), arg0, arg1)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockNotificationServiceServer) MarkAllNotificationsRead(arg0 context.Context, arg1 *notification_service.MarkAllNotificationsReadRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockNotificationServiceServerMockRecorder) MarkAllNotificationsRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockNotificationServiceServer)(nil).MarkAllNotificationsRead), arg0, arg1)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockNotificationServiceServer) MarkNotificationsRead(arg0 context.Context, arg1 *notification_service.MarkNotificationsReadRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockNotificationServiceServerMockRecorder) MarkNotificationsRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockNotificationServiceServer)(nil).MarkNotificationsRead), arg0, arg1)
+}
+
+// SaveNotification mocks base method.
+func (m *MockNotificationServiceServer) SaveNotification(arg0 context.Context, arg1 *notification_service.SaveNotificationRequest) (*notification_service.SaveNotificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNotification", arg0, arg1)
+	ret0, _ := ret[0].(*notification_service.SaveNotificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveNotification indicates an expected call of SaveNotification.
+func (mr *MockNotificationServiceServerMockRecorder) SaveNotification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotification", reflect.TypeOf((*MockNotificationServiceServer)(nil).SaveNotification), arg0, arg1)
+}
+
+// mustEmbedUnimplementedNotificationServiceServer mocks base method.
+func (m *MockNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationServiceServer indicates an expected call of mustEmbedUnimplementedNotificationServiceServer.
+func (mr *MockNotificationServiceServerMockRecorder) mustEmbedUnimplementedNotificationServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationServiceServer", reflect.TypeOf((*MockNotificationServiceServer)(nil).mustEmbedUnimplementedNotificationServiceServer))
+}
+
+// MockUnsafeNotificationServiceServer is a mock of UnsafeNotificationServiceServer interface.
+type MockUnsafeNotificationServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeNotificationServiceServerMockRecorder
+}
+
+// MockUnsafeNotificationServiceServerMockRecorder is the mock recorder for MockUnsafeNotificationServiceServer.
+type MockUnsafeNotificationServiceServerMockRecorder struct {
+	mock *MockUnsafeNotificationServiceServer
+}
+
+// NewMockUnsafeNotificationServiceServer creates a new mock instance.
+func NewMockUnsafeNotificationServiceServer(ctrl *gomock.Controller) *MockUnsafeNotificationServiceServer {
+	mock := &MockUnsafeNotificationServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeNotificationServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeNotificationServiceServer) EXPECT() *MockUnsafeNotificationServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedNotificationServiceServer mocks base method.
+func (m *MockUnsafeNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationServiceServer indicates an expected call of mustEmbedUnimplementedNotificationServiceServer.
+func (mr *MockUnsafeNotificationServiceServerMockRecorder) mustEmbedUnimplementedNotificationServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationServiceServer", reflect.TypeOf((*MockUnsafeNotificationServiceServer)(nil).mustEmbedUnimplementedNotificationServiceServer))
+}
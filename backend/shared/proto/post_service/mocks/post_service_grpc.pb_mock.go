@@ -216,6 +216,26 @@ func (mr *MockPostServiceClientMockRecorder) UpdatePost(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostServiceClient)(nil).UpdatePost), varargs...)
 }
 
+// VotePoll mocks base method.
+func (m *MockPostServiceClient) VotePoll(ctx context.Context, in *proto.VotePollRequest, opts ...grpc.CallOption) (*proto.VotePollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VotePoll", varargs...)
+	ret0, _ := ret[0].(*proto.VotePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockPostServiceClientMockRecorder) VotePoll(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockPostServiceClient)(nil).VotePoll), varargs...)
+}
+
 // MockPostServiceServer is a mock of PostServiceServer interface.
 type MockPostServiceServer struct {
 	ctrl     *gomock.Controller
@@ -374,6 +394,21 @@ func (mr *MockPostServiceServerMockRecorder) UpdatePost(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostServiceServer)(nil).UpdatePost), arg0, arg1)
 }
 
+// VotePoll mocks base method.
+func (m *MockPostServiceServer) VotePoll(arg0 context.Context, arg1 *proto.VotePollRequest) (*proto.VotePollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePoll", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VotePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePoll indicates an expected call of VotePoll.
+func (mr *MockPostServiceServerMockRecorder) VotePoll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePoll", reflect.TypeOf((*MockPostServiceServer)(nil).VotePoll), arg0, arg1)
+}
+
 // mustEmbedUnimplementedPostServiceServer mocks base method.
 func (m *MockPostServiceServer) mustEmbedUnimplementedPostServiceServer() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DeviceKeyServiceClient is the client API for DeviceKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceKeyServiceClient interface {
+	UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysRequest, opts ...grpc.CallOption) (*UploadDeviceKeysResponse, error)
+	RotateSignedPrekey(ctx context.Context, in *RotateSignedPrekeyRequest, opts ...grpc.CallOption) (*RotateSignedPrekeyResponse, error)
+	RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error)
+	GetUserDeviceKeys(ctx context.Context, in *GetUserDeviceKeysRequest, opts ...grpc.CallOption) (*GetUserDeviceKeysResponse, error)
+}
+
+type deviceKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceKeyServiceClient(cc grpc.ClientConnInterface) DeviceKeyServiceClient {
+	return &deviceKeyServiceClient{cc}
+}
+
+func (c *deviceKeyServiceClient) UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysRequest, opts ...grpc.CallOption) (*UploadDeviceKeysResponse, error) {
+	out := new(UploadDeviceKeysResponse)
+	err := c.cc.Invoke(ctx, "/user_service.DeviceKeyService/UploadDeviceKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyServiceClient) RotateSignedPrekey(ctx context.Context, in *RotateSignedPrekeyRequest, opts ...grpc.CallOption) (*RotateSignedPrekeyResponse, error) {
+	out := new(RotateSignedPrekeyResponse)
+	err := c.cc.Invoke(ctx, "/user_service.DeviceKeyService/RotateSignedPrekey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyServiceClient) RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error) {
+	out := new(RemoveDeviceResponse)
+	err := c.cc.Invoke(ctx, "/user_service.DeviceKeyService/RemoveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyServiceClient) GetUserDeviceKeys(ctx context.Context, in *GetUserDeviceKeysRequest, opts ...grpc.CallOption) (*GetUserDeviceKeysResponse, error) {
+	out := new(GetUserDeviceKeysResponse)
+	err := c.cc.Invoke(ctx, "/user_service.DeviceKeyService/GetUserDeviceKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceKeyServiceServer is the server API for DeviceKeyService service.
+// All implementations must embed UnimplementedDeviceKeyServiceServer
+// for forward compatibility
+type DeviceKeyServiceServer interface {
+	UploadDeviceKeys(context.Context, *UploadDeviceKeysRequest) (*UploadDeviceKeysResponse, error)
+	RotateSignedPrekey(context.Context, *RotateSignedPrekeyRequest) (*RotateSignedPrekeyResponse, error)
+	RemoveDevice(context.Context, *RemoveDeviceRequest) (*RemoveDeviceResponse, error)
+	GetUserDeviceKeys(context.Context, *GetUserDeviceKeysRequest) (*GetUserDeviceKeysResponse, error)
+	mustEmbedUnimplementedDeviceKeyServiceServer()
+}
+
+// UnimplementedDeviceKeyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceKeyServiceServer struct {
+}
+
+func (UnimplementedDeviceKeyServiceServer) UploadDeviceKeys(context.Context, *UploadDeviceKeysRequest) (*UploadDeviceKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDeviceKeys not implemented")
+}
+func (UnimplementedDeviceKeyServiceServer) RotateSignedPrekey(context.Context, *RotateSignedPrekeyRequest) (*RotateSignedPrekeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSignedPrekey not implemented")
+}
+func (UnimplementedDeviceKeyServiceServer) RemoveDevice(context.Context, *RemoveDeviceRequest) (*RemoveDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDevice not implemented")
+}
+func (UnimplementedDeviceKeyServiceServer) GetUserDeviceKeys(context.Context, *GetUserDeviceKeysRequest) (*GetUserDeviceKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDeviceKeys not implemented")
+}
+func (UnimplementedDeviceKeyServiceServer) mustEmbedUnimplementedDeviceKeyServiceServer() {}
+
+// UnsafeDeviceKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceKeyServiceServer will
+// result in compilation errors.
+type UnsafeDeviceKeyServiceServer interface {
+	mustEmbedUnimplementedDeviceKeyServiceServer()
+}
+
+func RegisterDeviceKeyServiceServer(s grpc.ServiceRegistrar, srv DeviceKeyServiceServer) {
+	s.RegisterService(&DeviceKeyService_ServiceDesc, srv)
+}
+
+func _DeviceKeyService_UploadDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDeviceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyServiceServer).UploadDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.DeviceKeyService/UploadDeviceKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyServiceServer).UploadDeviceKeys(ctx, req.(*UploadDeviceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyService_RotateSignedPrekey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSignedPrekeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyServiceServer).RotateSignedPrekey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.DeviceKeyService/RotateSignedPrekey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyServiceServer).RotateSignedPrekey(ctx, req.(*RotateSignedPrekeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyService_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyServiceServer).RemoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.DeviceKeyService/RemoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyServiceServer).RemoveDevice(ctx, req.(*RemoveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyService_GetUserDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDeviceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyServiceServer).GetUserDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.DeviceKeyService/GetUserDeviceKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyServiceServer).GetUserDeviceKeys(ctx, req.(*GetUserDeviceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceKeyService_ServiceDesc is the grpc.ServiceDesc for DeviceKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.DeviceKeyService",
+	HandlerType: (*DeviceKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadDeviceKeys",
+			Handler:    _DeviceKeyService_UploadDeviceKeys_Handler,
+		},
+		{
+			MethodName: "RotateSignedPrekey",
+			Handler:    _DeviceKeyService_RotateSignedPrekey_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _DeviceKeyService_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "GetUserDeviceKeys",
+			Handler:    _DeviceKeyService_GetUserDeviceKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "device_key_service.proto",
+}
@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//shared/proto/user_service/device_key_service_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	user_service "quickflow/shared/proto/user_service"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockDeviceKeyServiceClient is a mock of DeviceKeyServiceClient interface.
+type MockDeviceKeyServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceKeyServiceClientMockRecorder
+}
+
+// MockDeviceKeyServiceClientMockRecorder is the mock recorder for MockDeviceKeyServiceClient.
+type MockDeviceKeyServiceClientMockRecorder struct {
+	mock *MockDeviceKeyServiceClient
+}
+
+// NewMockDeviceKeyServiceClient creates a new mock instance.
+func NewMockDeviceKeyServiceClient(ctrl *gomock.Controller) *MockDeviceKeyServiceClient {
+	mock := &MockDeviceKeyServiceClient{ctrl: ctrl}
+	mock.recorder = &MockDeviceKeyServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceKeyServiceClient) EXPECT() *MockDeviceKeyServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetUserDeviceKeys mocks base method.
+func (m *MockDeviceKeyServiceClient) GetUserDeviceKeys(ctx context.Context, in *user_service.GetUserDeviceKeysRequest, opts ...grpc.CallOption) (*user_service.GetUserDeviceKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDeviceKeys", varargs...)
+	ret0, _ := ret[0].(*user_service.GetUserDeviceKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDeviceKeys indicates an expected call of GetUserDeviceKeys.
+func (mr *MockDeviceKeyServiceClientMockRecorder) GetUserDeviceKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDeviceKeys", reflect.TypeOf((*MockDeviceKeyServiceClient)(nil).GetUserDeviceKeys), varargs...)
+}
+
+// RemoveDevice mocks base method.
+func (m *MockDeviceKeyServiceClient) RemoveDevice(ctx context.Context, in *user_service.RemoveDeviceRequest, opts ...grpc.CallOption) (*user_service.RemoveDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDevice", varargs...)
+	ret0, _ := ret[0].(*user_service.RemoveDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDevice indicates an expected call of RemoveDevice.
+func (mr *MockDeviceKeyServiceClientMockRecorder) RemoveDevice(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDevice", reflect.TypeOf((*MockDeviceKeyServiceClient)(nil).RemoveDevice), varargs...)
+}
+
+// RotateSignedPrekey mocks base method.
+func (m *MockDeviceKeyServiceClient) RotateSignedPrekey(ctx context.Context, in *user_service.RotateSignedPrekeyRequest, opts ...grpc.CallOption) (*user_service.RotateSignedPrekeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateSignedPrekey", varargs...)
+	ret0, _ := ret[0].(*user_service.RotateSignedPrekeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSignedPrekey indicates an expected call of RotateSignedPrekey.
+func (mr *MockDeviceKeyServiceClientMockRecorder) RotateSignedPrekey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSignedPrekey", reflect.TypeOf((*MockDeviceKeyServiceClient)(nil).RotateSignedPrekey), varargs...)
+}
+
+// UploadDeviceKeys mocks base method.
+func (m *MockDeviceKeyServiceClient) UploadDeviceKeys(ctx context.Context, in *user_service.UploadDeviceKeysRequest, opts ...grpc.CallOption) (*user_service.UploadDeviceKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadDeviceKeys", varargs...)
+	ret0, _ := ret[0].(*user_service.UploadDeviceKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadDeviceKeys indicates an expected call of UploadDeviceKeys.
+func (mr *MockDeviceKeyServiceClientMockRecorder) UploadDeviceKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDeviceKeys", reflect.TypeOf((*MockDeviceKeyServiceClient)(nil).UploadDeviceKeys), varargs...)
+}
+
+// MockDeviceKeyServiceServer is a mock of DeviceKeyServiceServer interface.
+type MockDeviceKeyServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceKeyServiceServerMockRecorder
+}
+
+// MockDeviceKeyServiceServerMockRecorder is the mock recorder for MockDeviceKeyServiceServer.
+type MockDeviceKeyServiceServerMockRecorder struct {
+	mock *MockDeviceKeyServiceServer
+}
+
+// NewMockDeviceKeyServiceServer creates a new mock instance.
+func NewMockDeviceKeyServiceServer(ctrl *gomock.Controller) *MockDeviceKeyServiceServer {
+	mock := &MockDeviceKeyServiceServer{ctrl: ctrl}
+	mock.recorder = &MockDeviceKeyServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceKeyServiceServer) EXPECT() *MockDeviceKeyServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetUserDeviceKeys mocks base method.
+func (m *MockDeviceKeyServiceServer) GetUserDeviceKeys(arg0 context.Context, arg1 *user_service.GetUserDeviceKeysRequest) (*user_service.GetUserDeviceKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDeviceKeys", arg0, arg1)
+	ret0, _ := ret[0].(*user_service.GetUserDeviceKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDeviceKeys indicates an expected call of GetUserDeviceKeys.
+func (mr *MockDeviceKeyServiceServerMockRecorder) GetUserDeviceKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDeviceKeys", reflect.TypeOf((*MockDeviceKeyServiceServer)(nil).GetUserDeviceKeys), arg0, arg1)
+}
+
+// RemoveDevice mocks base method.
+func (m *MockDeviceKeyServiceServer) RemoveDevice(arg0 context.Context, arg1 *user_service.RemoveDeviceRequest) (*user_service.RemoveDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDevice", arg0, arg1)
+	ret0, _ := ret[0].(*user_service.RemoveDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDevice indicates an expected call of RemoveDevice.
+func (mr *MockDeviceKeyServiceServerMockRecorder) RemoveDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDevice", reflect.TypeOf((*MockDeviceKeyServiceServer)(nil).RemoveDevice), arg0, arg1)
+}
+
+// RotateSignedPrekey mocks base method.
+func (m *MockDeviceKeyServiceServer) RotateSignedPrekey(arg0 context.Context, arg1 *user_service.RotateSignedPrekeyRequest) (*user_service.RotateSignedPrekeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSignedPrekey", arg0, arg1)
+	ret0, _ := ret[0].(*user_service.RotateSignedPrekeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSignedPrekey indicates an expected call of RotateSignedPrekey.
+func (mr *MockDeviceKeyServiceServerMockRecorder) RotateSignedPrekey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSignedPrekey", reflect.TypeOf((*MockDeviceKeyServiceServer)(nil).RotateSignedPrekey), arg0, arg1)
+}
+
+// UploadDeviceKeys mocks base method.
+func (m *MockDeviceKeyServiceServer) UploadDeviceKeys(arg0 context.Context, arg1 *user_service.UploadDeviceKeysRequest) (*user_service.UploadDeviceKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadDeviceKeys", arg0, arg1)
+	ret0, _ := ret[0].(*user_service.UploadDeviceKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadDeviceKeys indicates an expected call of UploadDeviceKeys.
+func (mr *MockDeviceKeyServiceServerMockRecorder) UploadDeviceKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDeviceKeys", reflect.TypeOf((*MockDeviceKeyServiceServer)(nil).UploadDeviceKeys), arg0, arg1)
+}
+
+// mustEmbedUnimplementedDeviceKeyServiceServer mocks base method.
+func (m *MockDeviceKeyServiceServer) mustEmbedUnimplementedDeviceKeyServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedDeviceKeyServiceServer")
+}
+
+// mustEmbedUnimplementedDeviceKeyServiceServer indicates an expected call of mustEmbedUnimplementedDeviceKeyServiceServer.
+func (mr *MockDeviceKeyServiceServerMockRecorder) mustEmbedUnimplementedDeviceKeyServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedDeviceKeyServiceServer", reflect.TypeOf((*MockDeviceKeyServiceServer)(nil).mustEmbedUnimplementedDeviceKeyServiceServer))
+}
+
+// MockUnsafeDeviceKeyServiceServer is a mock of UnsafeDeviceKeyServiceServer interface.
+type MockUnsafeDeviceKeyServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeDeviceKeyServiceServerMockRecorder
+}
+
+// MockUnsafeDeviceKeyServiceServerMockRecorder is the mock recorder for MockUnsafeDeviceKeyServiceServer.
+type MockUnsafeDeviceKeyServiceServerMockRecorder struct {
+	mock *MockUnsafeDeviceKeyServiceServer
+}
+
+// NewMockUnsafeDeviceKeyServiceServer creates a new mock instance.
+func NewMockUnsafeDeviceKeyServiceServer(ctrl *gomock.Controller) *MockUnsafeDeviceKeyServiceServer {
+	mock := &MockUnsafeDeviceKeyServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeDeviceKeyServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeDeviceKeyServiceServer) EXPECT() *MockUnsafeDeviceKeyServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedDeviceKeyServiceServer mocks base method.
+func (m *MockUnsafeDeviceKeyServiceServer) mustEmbedUnimplementedDeviceKeyServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedDeviceKeyServiceServer")
+}
+
+// mustEmbedUnimplementedDeviceKeyServiceServer indicates an expected call of mustEmbedUnimplementedDeviceKeyServiceServer.
+func (mr *MockUnsafeDeviceKeyServiceServerMockRecorder) mustEmbedUnimplementedDeviceKeyServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedDeviceKeyServiceServer", reflect.TypeOf((*MockUnsafeDeviceKeyServiceServer)(nil).mustEmbedUnimplementedDeviceKeyServiceServer))
+}
@@ -0,0 +1,12 @@
+// Package testutil holds helpers shared by tests of the services
+package testutil
+
+import "database/sql/driver"
+
+// PassThroughConverter lets uuid slices through to the query like the pgx driver does,
+// use it with sqlmock.ValueConverterOption
+type PassThroughConverter struct{}
+
+func (PassThroughConverter) ConvertValue(v interface{}) (driver.Value, error) {
+	return v, nil
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	dto "quickflow/shared/client/user_service"
+	"quickflow/shared/logger"
+	shared_models "quickflow/shared/models"
+	pb "quickflow/shared/proto/user_service"
+	user_errors "quickflow/user_service/internal/errors"
+)
+
+type DeviceKeyUseCase interface {
+	UploadDeviceKeys(ctx context.Context, keys shared_models.DeviceKeys) error
+	RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey shared_models.SignedPrekey) error
+	RemoveDevice(ctx context.Context, userId uuid.UUID, deviceId string) error
+	GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]shared_models.DeviceKeys, error)
+}
+
+type DeviceKeyServiceServer struct {
+	pb.UnimplementedDeviceKeyServiceServer
+	deviceKeyUC DeviceKeyUseCase
+}
+
+func NewDeviceKeyServiceServer(deviceKeyUC DeviceKeyUseCase) *DeviceKeyServiceServer {
+	return &DeviceKeyServiceServer{deviceKeyUC: deviceKeyUC}
+}
+
+func (d *DeviceKeyServiceServer) UploadDeviceKeys(ctx context.Context, req *pb.UploadDeviceKeysRequest) (*pb.UploadDeviceKeysResponse, error) {
+	logger.Info(ctx, "UploadDeviceKeys called")
+
+	if req.GetKeys() == nil {
+		return nil, user_errors.ErrDeviceKeysValidation
+	}
+
+	keys, err := dto.MapDeviceKeysDTOToModel(req.GetKeys())
+	if err != nil {
+		logger.Error(ctx, "invalid device keys: %v", err)
+		return nil, user_errors.ErrInvalidUserId
+	}
+
+	if err = d.deviceKeyUC.UploadDeviceKeys(ctx, keys); err != nil {
+		logger.Error(ctx, "failed to upload device keys: %v", err)
+		return nil, err
+	}
+
+	return &pb.UploadDeviceKeysResponse{Success: true}, nil
+}
+
+func (d *DeviceKeyServiceServer) RotateSignedPrekey(ctx context.Context, req *pb.RotateSignedPrekeyRequest) (*pb.RotateSignedPrekeyResponse, error) {
+	logger.Info(ctx, "RotateSignedPrekey called")
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, user_errors.ErrInvalidUserId
+	}
+	if req.GetSignedPrekey() == nil {
+		return nil, user_errors.ErrDeviceKeysValidation
+	}
+
+	err = d.deviceKeyUC.RotateSignedPrekey(ctx, userID, req.GetDeviceId(), dto.MapSignedPrekeyDTOToModel(req.GetSignedPrekey()))
+	if err != nil {
+		logger.Error(ctx, "failed to rotate signed prekey: %v", err)
+		return nil, err
+	}
+
+	return &pb.RotateSignedPrekeyResponse{Success: true}, nil
+}
+
+func (d *DeviceKeyServiceServer) RemoveDevice(ctx context.Context, req *pb.RemoveDeviceRequest) (*pb.RemoveDeviceResponse, error) {
+	logger.Info(ctx, "RemoveDevice called")
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, user_errors.ErrInvalidUserId
+	}
+
+	if err = d.deviceKeyUC.RemoveDevice(ctx, userID, req.GetDeviceId()); err != nil {
+		logger.Error(ctx, "failed to remove device: %v", err)
+		return nil, err
+	}
+
+	return &pb.RemoveDeviceResponse{Success: true}, nil
+}
+
+func (d *DeviceKeyServiceServer) GetUserDeviceKeys(ctx context.Context, req *pb.GetUserDeviceKeysRequest) (*pb.GetUserDeviceKeysResponse, error) {
+	logger.Info(ctx, "GetUserDeviceKeys called")
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, user_errors.ErrInvalidUserId
+	}
+
+	devices, err := d.deviceKeyUC.GetUserDeviceKeys(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, "failed to get user device keys: %v", err)
+		return nil, err
+	}
+
+	resp := &pb.GetUserDeviceKeysResponse{Devices: make([]*pb.DeviceKeys, 0, len(devices))}
+	for _, device := range devices {
+		resp.Devices = append(resp.Devices, dto.MapDeviceKeysToDTO(device))
+	}
+	return resp, nil
+}
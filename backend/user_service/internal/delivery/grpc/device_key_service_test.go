@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	shared_models "quickflow/shared/models"
+	pb "quickflow/shared/proto/user_service"
+	"quickflow/user_service/internal/delivery/grpc/mocks"
+	user_errors "quickflow/user_service/internal/errors"
+)
+
+func TestDeviceKeyServiceServer_UploadDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockDeviceKeyUseCase(ctrl)
+	server := NewDeviceKeyServiceServer(mockUC)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		req         *pb.UploadDeviceKeysRequest
+		mockSetup   func()
+		expectedErr error
+	}{
+		{
+			name: "success",
+			req: &pb.UploadDeviceKeysRequest{
+				Keys: &pb.DeviceKeys{
+					UserId:       userID.String(),
+					DeviceId:     "phone",
+					IdentityKey:  []byte("identity"),
+					SignedPrekey: &pb.SignedPrekey{Id: 1, PublicKey: []byte("prekey"), Signature: []byte("signature")},
+				},
+			},
+			mockSetup: func() {
+				mockUC.EXPECT().UploadDeviceKeys(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, keys shared_models.DeviceKeys) error {
+					assert.Equal(t, userID, keys.UserID)
+					assert.Equal(t, "phone", keys.DeviceID)
+					assert.Equal(t, 1, keys.SignedPrekey.ID)
+					return nil
+				})
+			},
+		},
+		{
+			name:        "nil keys",
+			req:         &pb.UploadDeviceKeysRequest{},
+			mockSetup:   func() {},
+			expectedErr: user_errors.ErrDeviceKeysValidation,
+		},
+		{
+			name:        "invalid user id",
+			req:         &pb.UploadDeviceKeysRequest{Keys: &pb.DeviceKeys{UserId: "invalid"}},
+			mockSetup:   func() {},
+			expectedErr: user_errors.ErrInvalidUserId,
+		},
+		{
+			name: "too many devices",
+			req:  &pb.UploadDeviceKeysRequest{Keys: &pb.DeviceKeys{UserId: userID.String(), DeviceId: "tablet"}},
+			mockSetup: func() {
+				mockUC.EXPECT().UploadDeviceKeys(ctx, gomock.Any()).Return(user_errors.ErrTooManyDevices)
+			},
+			expectedErr: user_errors.ErrTooManyDevices,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			resp, err := server.UploadDeviceKeys(ctx, tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, resp.Success)
+			}
+		})
+	}
+}
+
+func TestDeviceKeyServiceServer_GetUserDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockDeviceKeyUseCase(ctrl)
+	server := NewDeviceKeyServiceServer(mockUC)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockUC.EXPECT().GetUserDeviceKeys(ctx, userID).Return([]shared_models.DeviceKeys{
+		{UserID: userID, DeviceID: "phone", IdentityKey: []byte("identity")},
+		{UserID: userID, DeviceID: "laptop", IdentityKey: []byte("identity2")},
+	}, nil)
+
+	resp, err := server.GetUserDeviceKeys(ctx, &pb.GetUserDeviceKeysRequest{UserId: userID.String()})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Devices, 2)
+	assert.Equal(t, "laptop", resp.Devices[1].DeviceId)
+
+	_, err = server.GetUserDeviceKeys(ctx, &pb.GetUserDeviceKeysRequest{UserId: "invalid"})
+	assert.ErrorIs(t, err, user_errors.ErrInvalidUserId)
+}
+
+func TestDeviceKeyServiceServer_RemoveDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockDeviceKeyUseCase(ctrl)
+	server := NewDeviceKeyServiceServer(mockUC)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockUC.EXPECT().RemoveDevice(ctx, userID, "phone").Return(nil)
+	mockUC.EXPECT().RemoveDevice(ctx, userID, "laptop").Return(user_errors.ErrNotFound)
+
+	resp, err := server.RemoveDevice(ctx, &pb.RemoveDeviceRequest{UserId: userID.String(), DeviceId: "phone"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = server.RemoveDevice(ctx, &pb.RemoveDeviceRequest{UserId: userID.String(), DeviceId: "laptop"})
+	assert.ErrorIs(t, err, user_errors.ErrNotFound)
+
+	_, err = server.RemoveDevice(ctx, &pb.RemoveDeviceRequest{UserId: "invalid", DeviceId: "phone"})
+	assert.ErrorIs(t, err, user_errors.ErrInvalidUserId)
+}
@@ -53,7 +53,9 @@ func ErrorInterceptor(
 	case errors.Is(err, user_errors.ErrInvalidUserId),
 		errors.Is(err, user_errors.ErrInvalidProfileInfo),
 		errors.Is(err, user_errors.ErrUserValidation),
-		errors.Is(err, user_errors.ErrProfileValidation):
+		errors.Is(err, user_errors.ErrProfileValidation),
+		errors.Is(err, user_errors.ErrDeviceKeysValidation),
+		errors.Is(err, user_errors.ErrTooManyDevices):
 		return nil, withErrorInfo(codes.InvalidArgument, "INVALID_ARGUMENT", err.Error())
 
 	default:
@@ -89,6 +89,20 @@ func TestErrorInterceptor(t *testing.T) {
 			expectedReason: "INVALID_ARGUMENT",
 			wantErr:        true,
 		},
+		{
+			name:           "device keys validation error",
+			inputError:     user_errors.ErrDeviceKeysValidation,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_ARGUMENT",
+			wantErr:        true,
+		},
+		{
+			name:           "too many devices",
+			inputError:     user_errors.ErrTooManyDevices,
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "INVALID_ARGUMENT",
+			wantErr:        true,
+		},
 		{
 			name:           "unknown error",
 			inputError:     errors.New("some unknown error"),
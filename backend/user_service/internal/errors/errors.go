@@ -20,3 +20,9 @@ var (
 	ErrUserNotFound       = errors.New("user not found")
 	ErrProfileValidation  = errors.New("profile validation error")
 )
+
+// Error messages for device key directory
+var (
+	ErrDeviceKeysValidation = errors.New("device keys validation error")
+	ErrTooManyDevices       = errors.New("too many devices")
+)
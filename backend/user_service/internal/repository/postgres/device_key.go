@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"quickflow/shared/logger"
+	"quickflow/shared/models"
+	user_errors "quickflow/user_service/internal/errors"
+)
+
+const (
+	// uploading keys of a known device again only replaces its signed prekey,
+	// the identity key of the device can not be changed
+	upsertDeviceKeysQuery = `
+	insert into device_key (user_id, device_id, identity_key, signed_prekey_id, signed_prekey, signed_prekey_signature, created_at, updated_at)
+	values ($1, $2, $3, $4, $5, $6, $7, $7)
+	on conflict (user_id, device_id) do update
+	set signed_prekey_id = excluded.signed_prekey_id,
+	    signed_prekey = excluded.signed_prekey,
+	    signed_prekey_signature = excluded.signed_prekey_signature,
+	    updated_at = excluded.updated_at
+	where device_key.identity_key = excluded.identity_key
+`
+
+	rotateSignedPrekeyQuery = `
+	update device_key
+	set signed_prekey_id = $3, signed_prekey = $4, signed_prekey_signature = $5, updated_at = $6
+	where user_id = $1 and device_id = $2
+`
+
+	deleteDeviceQuery = `
+	delete from device_key
+	where user_id = $1 and device_id = $2
+`
+
+	getUserDeviceKeysQuery = `
+	select user_id, device_id, identity_key, signed_prekey_id, signed_prekey, signed_prekey_signature, created_at, updated_at
+	from device_key
+	where user_id = $1
+	order by created_at
+`
+)
+
+type PostgresDeviceKeyRepository struct {
+	connPool *sql.DB
+}
+
+// NewPostgresDeviceKeyRepository creates new storage of device public keys.
+func NewPostgresDeviceKeyRepository(db *sql.DB) *PostgresDeviceKeyRepository {
+	return &PostgresDeviceKeyRepository{connPool: db}
+}
+
+// SaveDeviceKeys registers the device or updates its signed prekey.
+// Returns ErrAlreadyExists if the device is registered with another identity key.
+func (d *PostgresDeviceKeyRepository) SaveDeviceKeys(ctx context.Context, keys models.DeviceKeys) error {
+	res, err := d.connPool.ExecContext(ctx, upsertDeviceKeysQuery,
+		keys.UserID, keys.DeviceID, keys.IdentityKey,
+		keys.SignedPrekey.ID, keys.SignedPrekey.PublicKey, keys.SignedPrekey.Signature, keys.UpdatedAt)
+	if err != nil {
+		logger.Error(ctx, "Unable to save keys of device %s of user %v: %v", keys.DeviceID, keys.UserID, err)
+		return fmt.Errorf("unable to save device keys: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return user_errors.ErrAlreadyExists
+	}
+	return nil
+}
+
+// RotateSignedPrekey replaces the signed prekey of the device, returns ErrNotFound for unknown devices
+func (d *PostgresDeviceKeyRepository) RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey models.SignedPrekey, now time.Time) error {
+	res, err := d.connPool.ExecContext(ctx, rotateSignedPrekeyQuery,
+		userId, deviceId, prekey.ID, prekey.PublicKey, prekey.Signature, now)
+	if err != nil {
+		logger.Error(ctx, "Unable to rotate signed prekey of device %s of user %v: %v", deviceId, userId, err)
+		return fmt.Errorf("unable to rotate signed prekey: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return user_errors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteDevice removes keys of the device, returns ErrNotFound for unknown devices
+func (d *PostgresDeviceKeyRepository) DeleteDevice(ctx context.Context, userId uuid.UUID, deviceId string) error {
+	res, err := d.connPool.ExecContext(ctx, deleteDeviceQuery, userId, deviceId)
+	if err != nil {
+		logger.Error(ctx, "Unable to delete device %s of user %v: %v", deviceId, userId, err)
+		return fmt.Errorf("unable to delete device: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return user_errors.ErrNotFound
+	}
+	return nil
+}
+
+// GetUserDeviceKeys returns keys of all devices of the user, the first registered first
+func (d *PostgresDeviceKeyRepository) GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]models.DeviceKeys, error) {
+	rows, err := d.connPool.QueryContext(ctx, getUserDeviceKeysQuery, userId)
+	if err != nil {
+		logger.Error(ctx, "Unable to get device keys of user %v: %v", userId, err)
+		return nil, fmt.Errorf("unable to get device keys: %w", err)
+	}
+	defer rows.Close()
+
+	var devices []models.DeviceKeys
+	for rows.Next() {
+		var keys models.DeviceKeys
+		if err = rows.Scan(&keys.UserID, &keys.DeviceID, &keys.IdentityKey,
+			&keys.SignedPrekey.ID, &keys.SignedPrekey.PublicKey, &keys.SignedPrekey.Signature,
+			&keys.CreatedAt, &keys.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("unable to scan device keys: %w", err)
+		}
+		devices = append(devices, keys)
+	}
+	return devices, rows.Err()
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quickflow/shared/models"
+	user_errors "quickflow/user_service/internal/errors"
+)
+
+func TestSaveDeviceKeys(t *testing.T) {
+	now := time.Now()
+	keys := models.DeviceKeys{
+		UserID:       uuid.New(),
+		DeviceID:     "phone",
+		IdentityKey:  []byte("identity"),
+		SignedPrekey: models.SignedPrekey{ID: 1, PublicKey: []byte("prekey"), Signature: []byte("signature")},
+		UpdatedAt:    now,
+	}
+
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{name: "New or known device", affected: 1},
+		{name: "Device with another identity key", affected: 0, wantErr: user_errors.ErrAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(`insert into device_key`).
+				WithArgs(keys.UserID, "phone", keys.IdentityKey, 1, keys.SignedPrekey.PublicKey, keys.SignedPrekey.Signature, now).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			repo := NewPostgresDeviceKeyRepository(db)
+			err = repo.SaveDeviceKeys(context.Background(), keys)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRotateSignedPrekey_UnknownDevice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	userId := uuid.New()
+	now := time.Now()
+	prekey := models.SignedPrekey{ID: 2, PublicKey: []byte("prekey"), Signature: []byte("signature")}
+
+	mock.ExpectExec(`update device_key`).
+		WithArgs(userId, "laptop", 2, prekey.PublicKey, prekey.Signature, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := NewPostgresDeviceKeyRepository(db)
+	err = repo.RotateSignedPrekey(context.Background(), userId, "laptop", prekey, now)
+
+	assert.ErrorIs(t, err, user_errors.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteDevice(t *testing.T) {
+	userId := uuid.New()
+
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{name: "Known device", affected: 1},
+		{name: "Unknown device", affected: 0, wantErr: user_errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(`delete from device_key`).
+				WithArgs(userId, "phone").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			repo := NewPostgresDeviceKeyRepository(db)
+			err = repo.DeleteDevice(context.Background(), userId, "phone")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetUserDeviceKeys(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`from device_key`).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "device_id", "identity_key", "signed_prekey_id",
+			"signed_prekey", "signed_prekey_signature", "created_at", "updated_at"}).
+			AddRow(userId, "phone", []byte("identity"), 3, []byte("prekey"), []byte("signature"), now, now))
+
+	repo := NewPostgresDeviceKeyRepository(db)
+	devices, err := repo.GetUserDeviceKeys(context.Background(), userId)
+
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, "phone", devices[0].DeviceID)
+	assert.Equal(t, []byte("identity"), devices[0].IdentityKey)
+	assert.Equal(t, 3, devices[0].SignedPrekey.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -58,6 +58,7 @@ func main() {
 	redisRepo := redis.NewRedisSessionRepository()
 	userUserCase := usecase.NewUserUseCase(userRepo, redisRepo, profileRepo)
 	profileUseCase := usecase.NewProfileService(profileRepo, userRepo, fileService)
+	deviceKeyUseCase := usecase.NewDeviceKeyService(postgres.NewPostgresDeviceKeyRepository(db))
 
 	userMetrics := metrics.NewMetrics("QuickFlow")
 
@@ -80,6 +81,7 @@ func main() {
 		grpc.MaxSendMsgSize(addr.MaxMessageSize))
 	proto.RegisterUserServiceServer(server, grpc2.NewUserServiceServer(userUserCase))
 	proto.RegisterProfileServiceServer(server, grpc2.NewProfileServiceServer(profileUseCase))
+	proto.RegisterDeviceKeyServiceServer(server, grpc2.NewDeviceKeyServiceServer(deviceKeyUseCase))
 	log.Printf("Server is listening on %s", listener.Addr().String())
 
 	if err = server.Serve(listener); err != nil {
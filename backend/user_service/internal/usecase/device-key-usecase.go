@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	shared_models "quickflow/shared/models"
+	user_errors "quickflow/user_service/internal/errors"
+	"quickflow/user_service/utils/validation"
+)
+
+// maxUserDevices limits the number of devices every message key is encrypted for,
+// a device has to be removed before another one can be registered
+const maxUserDevices = 10
+
+type DeviceKeyRepository interface {
+	SaveDeviceKeys(ctx context.Context, keys shared_models.DeviceKeys) error
+	RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey shared_models.SignedPrekey, now time.Time) error
+	GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]shared_models.DeviceKeys, error)
+	DeleteDevice(ctx context.Context, userId uuid.UUID, deviceId string) error
+}
+
+// DeviceKeyService is the directory of public keys of user devices for end-to-end encryption
+type DeviceKeyService struct {
+	deviceKeyRepo DeviceKeyRepository
+}
+
+// NewDeviceKeyService creates new device key directory.
+func NewDeviceKeyService(deviceKeyRepo DeviceKeyRepository) *DeviceKeyService {
+	return &DeviceKeyService{deviceKeyRepo: deviceKeyRepo}
+}
+
+// UploadDeviceKeys registers keys of a new device of the user.
+// Uploading keys of a registered device again is allowed only with the same identity key
+// and, as in RotateSignedPrekey, the signed prekey id must grow.
+func (d *DeviceKeyService) UploadDeviceKeys(ctx context.Context, keys shared_models.DeviceKeys) error {
+	if err := validation.ValidateDeviceKeys(keys); err != nil {
+		return fmt.Errorf("%w: validation.ValidateDeviceKeys: %w", user_errors.ErrDeviceKeysValidation, err)
+	}
+
+	devices, err := d.deviceKeyRepo.GetUserDeviceKeys(ctx, keys.UserID)
+	if err != nil {
+		return fmt.Errorf("d.deviceKeyRepo.GetUserDeviceKeys: %w", err)
+	}
+	idx := slices.IndexFunc(devices, func(device shared_models.DeviceKeys) bool {
+		return device.DeviceID == keys.DeviceID
+	})
+	if idx == -1 && len(devices) >= maxUserDevices {
+		return user_errors.ErrTooManyDevices
+	}
+	if idx != -1 && keys.SignedPrekey.ID <= devices[idx].SignedPrekey.ID {
+		return fmt.Errorf("%w: signed prekey id must grow", user_errors.ErrDeviceKeysValidation)
+	}
+
+	now := time.Now()
+	keys.CreatedAt, keys.UpdatedAt = now, now
+	if err = d.deviceKeyRepo.SaveDeviceKeys(ctx, keys); err != nil {
+		return fmt.Errorf("d.deviceKeyRepo.SaveDeviceKeys: %w", err)
+	}
+	return nil
+}
+
+// RotateSignedPrekey replaces the signed prekey of the device, the new prekey must have a greater id
+func (d *DeviceKeyService) RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey shared_models.SignedPrekey) error {
+	if err := validation.ValidateSignedPrekey(prekey); err != nil {
+		return fmt.Errorf("%w: validation.ValidateSignedPrekey: %w", user_errors.ErrDeviceKeysValidation, err)
+	}
+
+	devices, err := d.deviceKeyRepo.GetUserDeviceKeys(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("d.deviceKeyRepo.GetUserDeviceKeys: %w", err)
+	}
+	idx := slices.IndexFunc(devices, func(device shared_models.DeviceKeys) bool {
+		return device.DeviceID == deviceId
+	})
+	if idx == -1 {
+		return user_errors.ErrNotFound
+	}
+	if prekey.ID <= devices[idx].SignedPrekey.ID {
+		return fmt.Errorf("%w: signed prekey id must grow", user_errors.ErrDeviceKeysValidation)
+	}
+
+	if err = d.deviceKeyRepo.RotateSignedPrekey(ctx, userId, deviceId, prekey, time.Now()); err != nil {
+		return fmt.Errorf("d.deviceKeyRepo.RotateSignedPrekey: %w", err)
+	}
+	return nil
+}
+
+// RemoveDevice deletes keys of the device, e.g. a lost one, so that messages are no longer encrypted for it
+func (d *DeviceKeyService) RemoveDevice(ctx context.Context, userId uuid.UUID, deviceId string) error {
+	if err := d.deviceKeyRepo.DeleteDevice(ctx, userId, deviceId); err != nil {
+		return fmt.Errorf("d.deviceKeyRepo.DeleteDevice: %w", err)
+	}
+	return nil
+}
+
+// GetUserDeviceKeys returns public keys of all devices of the user
+func (d *DeviceKeyService) GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]shared_models.DeviceKeys, error) {
+	devices, err := d.deviceKeyRepo.GetUserDeviceKeys(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("d.deviceKeyRepo.GetUserDeviceKeys: %w", err)
+	}
+	return devices, nil
+}
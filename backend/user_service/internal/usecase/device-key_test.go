@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	shared_models "quickflow/shared/models"
+	user_errors "quickflow/user_service/internal/errors"
+	"quickflow/user_service/internal/usecase/mocks"
+)
+
+func TestDeviceKeyService_UploadDeviceKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	keys := shared_models.DeviceKeys{
+		UserID:       userID,
+		DeviceID:     "phone",
+		IdentityKey:  []byte("identity"),
+		SignedPrekey: shared_models.SignedPrekey{ID: 1, PublicKey: []byte("prekey"), Signature: []byte("signature")},
+	}
+	fullDirectory := make([]shared_models.DeviceKeys, maxUserDevices)
+	for i := range fullDirectory {
+		fullDirectory[i] = shared_models.DeviceKeys{UserID: userID, DeviceID: uuid.NewString()}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+		uc := NewDeviceKeyService(mockRepo)
+
+		mockRepo.EXPECT().GetUserDeviceKeys(ctx, userID).Return(nil, nil)
+		mockRepo.EXPECT().SaveDeviceKeys(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, saved shared_models.DeviceKeys) error {
+			assert.Equal(t, keys.IdentityKey, saved.IdentityKey)
+			assert.False(t, saved.CreatedAt.IsZero())
+			return nil
+		})
+
+		assert.NoError(t, uc.UploadDeviceKeys(ctx, keys))
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+		uc := NewDeviceKeyService(mockRepo)
+
+		invalid := keys
+		invalid.DeviceID = "my phone"
+
+		assert.ErrorIs(t, uc.UploadDeviceKeys(ctx, invalid), user_errors.ErrDeviceKeysValidation)
+	})
+
+	t.Run("too many devices", func(t *testing.T) {
+		mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+		uc := NewDeviceKeyService(mockRepo)
+
+		mockRepo.EXPECT().GetUserDeviceKeys(ctx, userID).Return(fullDirectory, nil)
+
+		assert.ErrorIs(t, uc.UploadDeviceKeys(ctx, keys), user_errors.ErrTooManyDevices)
+	})
+
+	t.Run("known device with full directory", func(t *testing.T) {
+		mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+		uc := NewDeviceKeyService(mockRepo)
+
+		known := append([]shared_models.DeviceKeys{}, fullDirectory...)
+		known[0].DeviceID = keys.DeviceID
+		mockRepo.EXPECT().GetUserDeviceKeys(ctx, userID).Return(known, nil)
+		mockRepo.EXPECT().SaveDeviceKeys(ctx, gomock.Any()).Return(user_errors.ErrAlreadyExists)
+
+		assert.ErrorIs(t, uc.UploadDeviceKeys(ctx, keys), user_errors.ErrAlreadyExists)
+	})
+
+	t.Run("known device with stale prekey", func(t *testing.T) {
+		mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+		uc := NewDeviceKeyService(mockRepo)
+
+		// the same prekey id is uploaded again
+		mockRepo.EXPECT().GetUserDeviceKeys(ctx, userID).Return([]shared_models.DeviceKeys{keys}, nil)
+
+		assert.ErrorIs(t, uc.UploadDeviceKeys(ctx, keys), user_errors.ErrDeviceKeysValidation)
+	})
+}
+
+func TestDeviceKeyService_RemoveDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		repoErr error
+	}{
+		{name: "success"},
+		{name: "unknown device", repoErr: user_errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+			uc := NewDeviceKeyService(mockRepo)
+
+			mockRepo.EXPECT().DeleteDevice(ctx, userID, "phone").Return(tt.repoErr)
+
+			err := uc.RemoveDevice(ctx, userID, "phone")
+			if tt.repoErr != nil {
+				assert.ErrorIs(t, err, tt.repoErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeviceKeyService_RotateSignedPrekey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	devices := []shared_models.DeviceKeys{
+		{UserID: userID, DeviceID: "phone", SignedPrekey: shared_models.SignedPrekey{ID: 5}},
+	}
+
+	tests := []struct {
+		name     string
+		deviceID string
+		prekeyID int
+		rotated  bool
+		wantErr  error
+	}{
+		{name: "success", deviceID: "phone", prekeyID: 6, rotated: true},
+		{name: "stale prekey", deviceID: "phone", prekeyID: 5, wantErr: user_errors.ErrDeviceKeysValidation},
+		{name: "unknown device", deviceID: "laptop", prekeyID: 6, wantErr: user_errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockDeviceKeyRepository(ctrl)
+			uc := NewDeviceKeyService(mockRepo)
+
+			prekey := shared_models.SignedPrekey{ID: tt.prekeyID, PublicKey: []byte("prekey"), Signature: []byte("signature")}
+			mockRepo.EXPECT().GetUserDeviceKeys(ctx, userID).Return(devices, nil)
+			if tt.rotated {
+				mockRepo.EXPECT().RotateSignedPrekey(ctx, userID, tt.deviceID, prekey, gomock.Any()).Return(nil)
+			}
+
+			err := uc.RotateSignedPrekey(ctx, userID, tt.deviceID, prekey)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
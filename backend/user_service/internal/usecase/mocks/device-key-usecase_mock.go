@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .//user_service/internal/usecase/device-key-usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "quickflow/shared/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockDeviceKeyRepository is a mock of DeviceKeyRepository interface.
+type MockDeviceKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceKeyRepositoryMockRecorder
+}
+
+// MockDeviceKeyRepositoryMockRecorder is the mock recorder for MockDeviceKeyRepository.
+type MockDeviceKeyRepositoryMockRecorder struct {
+	mock *MockDeviceKeyRepository
+}
+
+// NewMockDeviceKeyRepository creates a new mock instance.
+func NewMockDeviceKeyRepository(ctrl *gomock.Controller) *MockDeviceKeyRepository {
+	mock := &MockDeviceKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockDeviceKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceKeyRepository) EXPECT() *MockDeviceKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceKeyRepository) DeleteDevice(ctx context.Context, userId uuid.UUID, deviceId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", ctx, userId, deviceId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceKeyRepositoryMockRecorder) DeleteDevice(ctx, userId, deviceId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceKeyRepository)(nil).DeleteDevice), ctx, userId, deviceId)
+}
+
+// GetUserDeviceKeys mocks base method.
+func (m *MockDeviceKeyRepository) GetUserDeviceKeys(ctx context.Context, userId uuid.UUID) ([]models.DeviceKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDeviceKeys", ctx, userId)
+	ret0, _ := ret[0].([]models.DeviceKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDeviceKeys indicates an expected call of GetUserDeviceKeys.
+func (mr *MockDeviceKeyRepositoryMockRecorder) GetUserDeviceKeys(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDeviceKeys", reflect.TypeOf((*MockDeviceKeyRepository)(nil).GetUserDeviceKeys), ctx, userId)
+}
+
+// RotateSignedPrekey mocks base method.
+func (m *MockDeviceKeyRepository) RotateSignedPrekey(ctx context.Context, userId uuid.UUID, deviceId string, prekey models.SignedPrekey, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSignedPrekey", ctx, userId, deviceId, prekey, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSignedPrekey indicates an expected call of RotateSignedPrekey.
+func (mr *MockDeviceKeyRepositoryMockRecorder) RotateSignedPrekey(ctx, userId, deviceId, prekey, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSignedPrekey", reflect.TypeOf((*MockDeviceKeyRepository)(nil).RotateSignedPrekey), ctx, userId, deviceId, prekey, now)
+}
+
+// SaveDeviceKeys mocks base method.
+func (m *MockDeviceKeyRepository) SaveDeviceKeys(ctx context.Context, keys models.DeviceKeys) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDeviceKeys", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDeviceKeys indicates an expected call of SaveDeviceKeys.
+func (mr *MockDeviceKeyRepositoryMockRecorder) SaveDeviceKeys(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeviceKeys", reflect.TypeOf((*MockDeviceKeyRepository)(nil).SaveDeviceKeys), ctx, keys)
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"errors"
+	"unicode"
+
+	"quickflow/shared/models"
+)
+
+const (
+	maxDeviceIdLen = 64
+	// maxPublicKeyLen is enough for public keys and signatures of any curve in use
+	maxPublicKeyLen = 1024
+)
+
+// ValidateDeviceKeys checks that the device id is printable and all keys are present
+func ValidateDeviceKeys(keys models.DeviceKeys) error {
+	if err := ValidateDeviceId(keys.DeviceID); err != nil {
+		return err
+	}
+	if !validatePublicKey(keys.IdentityKey) {
+		return errors.New("invalid identity key")
+	}
+	return ValidateSignedPrekey(keys.SignedPrekey)
+}
+
+func ValidateDeviceId(deviceId string) error {
+	if len(deviceId) == 0 || len(deviceId) > maxDeviceIdLen {
+		return errors.New("invalid device id length")
+	}
+	for _, char := range deviceId {
+		if char > unicode.MaxASCII || !unicode.IsPrint(char) || unicode.IsSpace(char) {
+			return errors.New("device id must consist of printable ascii characters")
+		}
+	}
+	return nil
+}
+
+func ValidateSignedPrekey(prekey models.SignedPrekey) error {
+	if prekey.ID < 0 {
+		return errors.New("invalid signed prekey id")
+	}
+	if !validatePublicKey(prekey.PublicKey) || !validatePublicKey(prekey.Signature) {
+		return errors.New("invalid signed prekey")
+	}
+	return nil
+}
+
+func validatePublicKey(key []byte) bool {
+	return len(key) > 0 && len(key) <= maxPublicKeyLen
+}
@@ -0,0 +1,9 @@
+drop table if exists message_event;
+
+drop index if exists message_chat_seq_idx;
+
+alter table message
+    drop column if exists seq;
+
+alter table chat
+    drop column if exists last_seq;
@@ -0,0 +1,35 @@
+-- per chat sequence of events used by clients to resume after reconnect
+alter table chat
+    add column last_seq bigint not null default 0;
+
+alter table message
+    add column seq bigint;
+
+with numbered as (
+    select id, row_number() over (partition by chat_id order by created_at, id) as rn
+    from message
+)
+update message m
+set seq = n.rn
+from numbered n
+where m.id = n.id;
+
+update chat c
+set last_seq = coalesce((select max(m.seq) from message m where m.chat_id = c.id), 0);
+
+alter table message
+    alter column seq set not null;
+
+create unique index if not exists message_chat_seq_idx on message(chat_id, seq);
+
+-- deletions and reads do not leave a message row, so they are logged separately
+create table if not exists message_event(
+                                            id int generated always as identity primary key,
+                                            chat_id uuid references chat(id) on delete cascade,
+                                            seq bigint not null,
+                                            type text not null,
+                                            message_id uuid,
+                                            user_id uuid references "user"(id) on delete cascade,
+                                            created_at timestamptz not null default now(),
+                                            unique(chat_id, seq)
+);
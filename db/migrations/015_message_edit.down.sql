@@ -0,0 +1,2 @@
+alter table message
+    drop column if exists edited_at;
@@ -0,0 +1,3 @@
+-- set when the sender edits text or attachments of the message
+alter table message
+    add column if not exists edited_at timestamptz;
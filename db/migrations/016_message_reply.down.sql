@@ -0,0 +1,2 @@
+alter table message
+    drop column if exists reply_to_id;
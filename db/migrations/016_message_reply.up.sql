@@ -0,0 +1,3 @@
+-- no foreign key: replies to deleted messages keep the id and show a placeholder
+alter table message
+    add column if not exists reply_to_id uuid;
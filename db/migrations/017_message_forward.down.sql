@@ -0,0 +1,3 @@
+alter table message
+    drop column if exists forwarded_from_chat_id,
+    drop column if exists forwarded_from_sender_id;
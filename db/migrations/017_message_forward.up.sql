@@ -0,0 +1,4 @@
+-- author and chat of the original message, no foreign keys: the origin may be deleted later
+alter table message
+    add column if not exists forwarded_from_sender_id uuid,
+    add column if not exists forwarded_from_chat_id uuid;
@@ -0,0 +1,4 @@
+alter table message_event
+    drop column if exists reaction;
+
+drop table if exists message_reaction;
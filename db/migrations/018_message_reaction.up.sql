@@ -0,0 +1,11 @@
+create table if not exists message_reaction(
+                                               message_id uuid references message(id) on delete cascade,
+                                               user_id uuid references "user"(id) on delete cascade,
+                                               emoji text not null,
+                                               created_at timestamptz not null default now(),
+                                               primary key (message_id, user_id, emoji)
+);
+
+-- emoji of reaction events
+alter table message_event
+    add column if not exists reaction text;
@@ -0,0 +1,3 @@
+alter table message
+    drop column if exists system_user_id,
+    drop column if exists system_action;
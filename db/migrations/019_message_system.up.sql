@@ -0,0 +1,4 @@
+-- messages written by the messenger on group chat changes, user is the member the change is about
+alter table message
+    add column if not exists system_action text,
+    add column if not exists system_user_id uuid;
@@ -0,0 +1,2 @@
+alter table chat_user
+    drop column if exists role;
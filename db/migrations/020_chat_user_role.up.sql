@@ -0,0 +1,11 @@
+-- owner, admin or member of the chat, only group chats use roles other than member
+alter table chat_user
+    add column if not exists role text not null default 'member';
+
+-- creators of existing group chats become owners
+update chat_user cu
+set role = 'owner'
+from message m
+where m.chat_id = cu.chat_id
+  and m.sender_id = cu.user_id
+  and m.system_action = 'chat_created';
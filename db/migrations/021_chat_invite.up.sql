@@ -0,0 +1,12 @@
+-- links to join group chats, null expiry or usage limit means the link never runs out
+create table if not exists chat_invite(
+    token text primary key,
+    chat_id uuid not null references chat(id) on delete cascade,
+    creator_id uuid references "user"(id) on delete cascade,
+    created_at timestamptz not null default now(),
+    expires_at timestamptz,
+    usage_limit int check (usage_limit > 0),
+    usage_count int not null default 0
+);
+
+create index if not exists idx_chat_invite_chat_id on chat_invite(chat_id);
@@ -0,0 +1 @@
+drop table if exists chat_pinned_message;
@@ -0,0 +1,10 @@
+-- messages pinned in chats, a deleted message is unpinned with it
+create table if not exists chat_pinned_message(
+    chat_id uuid not null references chat(id) on delete cascade,
+    message_id uuid not null references message(id) on delete cascade,
+    pinned_by uuid references "user"(id) on delete set null,
+    pinned_at timestamptz not null default now(),
+    primary key (chat_id, message_id)
+);
+
+create index if not exists idx_chat_pinned_message_message_id on chat_pinned_message(message_id);
@@ -0,0 +1,3 @@
+drop index if exists idx_message_text_tsv;
+
+alter table message drop column if exists text_tsv;
@@ -0,0 +1,7 @@
+-- full-text search over message text, the russian configuration stems
+-- cyrillic words with the russian stemmer and latin ones with the english one
+alter table message
+    add column if not exists text_tsv tsvector
+        generated always as (to_tsvector('russian', coalesce(text, ''))) stored;
+
+create index if not exists idx_message_text_tsv on message using gin(text_tsv);
@@ -0,0 +1,4 @@
+alter table chat_user
+    drop column if exists muted_until,
+    drop column if exists archived,
+    drop column if exists pin_order;
@@ -0,0 +1,5 @@
+-- per-user chat list settings, pinned chats are ordered by pin_order
+alter table chat_user
+    add column if not exists muted_until timestamptz,
+    add column if not exists archived boolean not null default false,
+    add column if not exists pin_order int;
@@ -0,0 +1,5 @@
+drop index if exists idx_message_expires_at;
+
+alter table message drop column if exists expires_at;
+
+alter table chat drop column if exists message_ttl;
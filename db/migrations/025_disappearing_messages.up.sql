@@ -0,0 +1,9 @@
+-- messages sent while the chat has a ttl expire message_ttl seconds after creation,
+-- expired messages are deleted by the messenger reaper
+alter table chat
+    add column if not exists message_ttl int check (message_ttl > 0);
+
+alter table message
+    add column if not exists expires_at timestamptz;
+
+create index if not exists idx_message_expires_at on message(expires_at) where expires_at is not null;
@@ -0,0 +1,2 @@
+drop table if exists scheduled_message_file;
+drop table if exists scheduled_message;
@@ -0,0 +1,23 @@
+-- messages written now and sent later, the messenger dispatcher sends them once send_at comes.
+-- locked_until is the lease of the dispatcher replica that is sending the message
+create table if not exists scheduled_message(
+    id uuid primary key,
+    chat_id uuid not null references chat(id) on delete cascade,
+    sender_id uuid not null references "user"(id) on delete cascade,
+    text text,
+    reply_to_id uuid,
+    send_at timestamptz not null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    locked_until timestamptz
+);
+
+create index if not exists idx_scheduled_message_send_at on scheduled_message(send_at);
+create index if not exists idx_scheduled_message_chat_sender on scheduled_message(chat_id, sender_id);
+
+create table if not exists scheduled_message_file(
+    id int generated always as identity primary key,
+    scheduled_message_id uuid not null references scheduled_message(id) on delete cascade,
+    file_url text not null,
+    file_type text not null default 'image'
+);
@@ -0,0 +1,4 @@
+alter table chat_user
+    drop column if exists delivered_at,
+    drop column if exists last_delivered,
+    drop column if exists read_at;
@@ -0,0 +1,6 @@
+-- last_read and last_delivered hold creation time of the newest message the participant read or got,
+-- read_at and delivered_at hold the time it happened
+alter table chat_user
+    add column if not exists read_at timestamptz,
+    add column if not exists last_delivered timestamptz,
+    add column if not exists delivered_at timestamptz;
@@ -0,0 +1,3 @@
+drop index if exists idx_chat_export_pending;
+
+drop table if exists chat_export;
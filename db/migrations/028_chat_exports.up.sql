@@ -0,0 +1,14 @@
+-- chat history archives requested by users, the messenger exporter builds them in background.
+-- locked_until is the lease of the exporter replica that is building the archive
+create table if not exists chat_export(
+    id uuid primary key,
+    chat_id uuid not null references chat(id) on delete cascade,
+    user_id uuid not null references "user"(id) on delete cascade,
+    status text not null default 'pending',
+    file_url text,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    locked_until timestamptz
+);
+
+create index if not exists idx_chat_export_pending on chat_export(created_at) where status = 'pending';
@@ -0,0 +1,7 @@
+drop table if exists favourite_sticker;
+
+drop index if exists idx_recent_sticker_user;
+
+drop table if exists recent_sticker;
+
+drop table if exists user_sticker_pack;
@@ -0,0 +1,25 @@
+-- sticker packs added by the user to their library, position orders them in the sticker panel
+create table if not exists user_sticker_pack(
+    user_id uuid not null references "user"(id) on delete cascade,
+    pack_id uuid not null references sticker_pack(id) on delete cascade,
+    position int not null,
+    added_at timestamptz not null default now(),
+    primary key (user_id, pack_id)
+);
+
+-- stickers are identified by their url, the same way messages refer to them
+create table if not exists recent_sticker(
+    user_id uuid not null references "user"(id) on delete cascade,
+    sticker_url text not null,
+    used_at timestamptz not null default now(),
+    primary key (user_id, sticker_url)
+);
+
+create index if not exists idx_recent_sticker_user on recent_sticker(user_id, used_at desc);
+
+create table if not exists favourite_sticker(
+    user_id uuid not null references "user"(id) on delete cascade,
+    sticker_url text not null,
+    created_at timestamptz not null default now(),
+    primary key (user_id, sticker_url)
+);
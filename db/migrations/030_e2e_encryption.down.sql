@@ -0,0 +1,7 @@
+drop table if exists message_key_envelope;
+
+alter table message drop column if exists ciphertext;
+
+alter table chat drop column if exists encrypted;
+
+drop table if exists device_key;
@@ -0,0 +1,28 @@
+-- public keys of user devices for end-to-end encrypted chats, private keys never leave the devices
+create table if not exists device_key(
+    user_id uuid not null references "user"(id) on delete cascade,
+    device_id text not null check (length(device_id) > 0),
+    identity_key bytea not null,
+    signed_prekey_id int not null,
+    signed_prekey bytea not null,
+    signed_prekey_signature bytea not null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    primary key (user_id, device_id)
+);
+
+alter table chat
+    add column if not exists encrypted boolean not null default false;
+
+-- encrypted messages have no text, the ciphertext is stored as sent by the client
+alter table message
+    add column if not exists ciphertext bytea;
+
+-- the message key encrypted by the sender for every device of the recipients
+create table if not exists message_key_envelope(
+    message_id uuid not null references message(id) on delete cascade,
+    recipient_id uuid not null references "user"(id) on delete cascade,
+    device_id text not null,
+    envelope bytea not null,
+    primary key (message_id, recipient_id, device_id)
+);
@@ -0,0 +1,3 @@
+alter table files
+    drop column if exists waveform,
+    drop column if exists duration_ms;
@@ -0,0 +1,4 @@
+-- duration and waveform of audio uploaded as voice messages, null for other files
+alter table files
+    add column if not exists duration_ms int,
+    add column if not exists waveform bytea;
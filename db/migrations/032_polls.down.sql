@@ -0,0 +1,3 @@
+drop table if exists poll_vote;
+drop table if exists poll_option;
+drop table if exists poll;
@@ -0,0 +1,34 @@
+-- a poll is attached either to a post or to a message, never to both
+create table if not exists poll(
+    id uuid primary key,
+    post_id uuid unique references post(id) on delete cascade,
+    message_id uuid unique references message(id) on delete cascade,
+    question text not null,
+    multiple_choice boolean not null default false,
+    anonymous boolean not null default true,
+    results_visibility text not null default 'always'
+        check (results_visibility in ('always', 'after_vote', 'after_close')),
+    closes_at timestamptz,
+    created_at timestamptz not null default now(),
+    check (num_nonnulls(post_id, message_id) = 1)
+);
+
+-- options are numbered from 0 in the order they were given by the poll author
+create table if not exists poll_option(
+    poll_id uuid not null references poll(id) on delete cascade,
+    position int not null,
+    text text not null,
+    primary key (poll_id, position)
+);
+
+-- a user has one row per chosen option, single choice polls allow only one
+create table if not exists poll_vote(
+    poll_id uuid not null,
+    user_id uuid not null references "user"(id) on delete cascade,
+    position int not null,
+    voted_at timestamptz not null default now(),
+    primary key (poll_id, user_id, position),
+    foreign key (poll_id, position) references poll_option(poll_id, position) on delete cascade
+);
+
+create index if not exists idx_poll_vote_option on poll_vote(poll_id, position);
@@ -0,0 +1,5 @@
+drop index if exists idx_notification_unread;
+
+drop index if exists idx_notification_user;
+
+drop table if exists notification;
@@ -0,0 +1,17 @@
+-- notifications are kept until the user deletes their account, target_id is the post, comment, community or user the event is about
+create table if not exists notification(
+    id uuid primary key,
+    user_id uuid not null references "user"(id) on delete cascade,
+    actor_id uuid not null references "user"(id) on delete cascade,
+    type text not null
+        check (type in ('post_liked', 'comment_liked', 'post_commented', 'friend_request', 'friend_accepted',
+                        'community_role_changed', 'mention')),
+    target_id uuid not null,
+    content text not null default '',
+    is_read boolean not null default false,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists idx_notification_user on notification(user_id, created_at desc);
+
+create index if not exists idx_notification_unread on notification(user_id) where not is_read;
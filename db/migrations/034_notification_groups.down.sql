@@ -0,0 +1,10 @@
+drop index if exists idx_notification_group;
+
+drop index if exists idx_notification_user;
+
+create index if not exists idx_notification_user on notification(user_id, created_at desc);
+
+alter table notification
+    drop column if exists updated_at,
+    drop column if exists actor_count,
+    drop column if exists actor_ids;
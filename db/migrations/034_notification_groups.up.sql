@@ -0,0 +1,14 @@
+-- likes of the same post or comment are grouped into one unread notification,
+-- actor_id is the latest actor, actor_ids keeps a few latest ones and actor_count counts all of them
+alter table notification
+    add column if not exists actor_ids uuid[] not null default '{}',
+    add column if not exists actor_count int not null default 1,
+    add column if not exists updated_at timestamptz not null default now();
+
+update notification set actor_ids = array[actor_id], updated_at = created_at;
+
+drop index if exists idx_notification_user;
+
+create index if not exists idx_notification_user on notification(user_id, updated_at desc);
+
+create index if not exists idx_notification_group on notification(user_id, target_id, type) where not is_read;
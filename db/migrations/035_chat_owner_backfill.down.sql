@@ -0,0 +1,2 @@
+-- owners set by the backfill cannot be told apart from the real ones, nothing is reverted
+select 1;
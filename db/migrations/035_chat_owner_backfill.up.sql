@@ -0,0 +1,29 @@
+-- group chats created before chat_created messages existed got no owner in 020,
+-- the earliest member of every group chat without an owner becomes one
+update chat_user cu
+set role = 'owner'
+from (
+    select distinct on (cu.chat_id) cu.id
+    from chat_user cu
+        join chat c on c.id = cu.chat_id
+    where c.type = 1
+      and not exists (
+        select 1 from chat_user o where o.chat_id = cu.chat_id and o.role = 'owner'
+      )
+    order by cu.chat_id, cu.id
+) earliest
+where cu.id = earliest.id;
+
+do $$
+begin
+    if exists (
+        select 1
+        from chat c
+        where c.type = 1
+          and exists (select 1 from chat_user cu where cu.chat_id = c.id)
+          and not exists (select 1 from chat_user cu where cu.chat_id = c.id and cu.role = 'owner')
+    ) then
+        raise exception 'group chats without an owner are left after the backfill';
+    end if;
+end
+$$;
@@ -0,0 +1,9 @@
+drop index if exists idx_message_text_tsv;
+
+alter table message drop column if exists text_tsv;
+
+alter table message
+    add column text_tsv tsvector
+        generated always as (to_tsvector('russian', coalesce(text, ''))) stored;
+
+create index if not exists idx_message_text_tsv on message using gin(text_tsv);
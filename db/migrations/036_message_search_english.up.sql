@@ -0,0 +1,12 @@
+-- messages are indexed with both the russian and the english stemmer, so words of
+-- either language are found by any of their forms. A generated column can not be
+-- altered, it is added again and the index is rebuilt
+drop index if exists idx_message_text_tsv;
+
+alter table message drop column if exists text_tsv;
+
+alter table message
+    add column text_tsv tsvector
+        generated always as (to_tsvector('russian', coalesce(text, '')) || to_tsvector('english', coalesce(text, ''))) stored;
+
+create index if not exists idx_message_text_tsv on message using gin(text_tsv);
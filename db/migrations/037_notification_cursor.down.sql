@@ -0,0 +1,3 @@
+drop index if exists idx_notification_user;
+
+create index if not exists idx_notification_user on notification(user_id, updated_at desc);
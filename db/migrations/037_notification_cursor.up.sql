@@ -0,0 +1,7 @@
+-- notifications are paged by (updated_at, id), the cursor comes back from clients with milliseconds,
+-- so updated_at is stored with the same precision
+update notification set updated_at = date_trunc('milliseconds', updated_at);
+
+drop index if exists idx_notification_user;
+
+create index if not exists idx_notification_user on notification(user_id, updated_at desc, id desc);
@@ -0,0 +1,14 @@
+-- every actor of a grouped notification, actor_ids keeps only a few latest ones,
+-- so repeated actors are looked up here to count each of them once
+create table if not exists notification_actor(
+    notification_id uuid not null references notification(id) on delete cascade,
+    actor_id uuid not null references "user"(id) on delete cascade,
+    primary key (notification_id, actor_id)
+);
+
+insert into notification_actor (notification_id, actor_id)
+select n.id, a.actor_id
+from notification n
+cross join lateral unnest(n.actor_ids) as a(actor_id)
+join "user" u on u.id = a.actor_id
+on conflict do nothing;
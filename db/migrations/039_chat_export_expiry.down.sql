@@ -0,0 +1 @@
+drop index if exists idx_chat_export_ready;
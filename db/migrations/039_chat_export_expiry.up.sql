@@ -0,0 +1,2 @@
+-- ready archives are deleted by the messenger exporter some time after they are built
+create index if not exists idx_chat_export_ready on chat_export(updated_at) where status = 'ready';
@@ -30,7 +30,20 @@ create_and_set_public_policy() {
   mc anonymous set public $MINIO_ALIAS/"$bucket_name" || echo "Failed to set policy for $bucket_name"
 }
 
+# files of the private bucket are read only through the services, e.g. chat export archives
+create_private_bucket() {
+  local bucket_name=$1
+  if ! mc ls $MINIO_ALIAS/"$bucket_name" > /dev/null 2>&1; then
+    echo "Creating bucket: $bucket_name"
+    mc mb $MINIO_ALIAS/"$bucket_name"
+  fi
+
+  echo "Removing public access from bucket: $bucket_name"
+  mc anonymous set none $MINIO_ALIAS/"$bucket_name" || echo "Failed to set policy for $bucket_name"
+}
+
 create_and_set_public_policy "$MINIO_POSTS_BUCKET_NAME"
 create_and_set_public_policy "$MINIO_PROFILE_BUCKET_NAME"
 create_and_set_public_policy "$MINIO_ATTACHMENTS_BUCKET_NAME"
 create_and_set_public_policy "$MINIO_STICKERS_BUCKET_NAME"
+create_private_bucket "$MINIO_PRIVATE_BUCKET_NAME"
@@ -134,7 +134,10 @@ create table if not exists chat(
                                    name text check (length(name) > 0),
                                    avatar_url text check (length(name) > 0),
                                    created_at timestamptz not null default now(),
-                                   updated_at timestamptz not null default now()
+                                   updated_at timestamptz not null default now(),
+                                   last_seq bigint not null default 0,
+                                   message_ttl int check (message_ttl > 0),
+                                   encrypted boolean not null default false
 );
 
 create table if not exists chat_user(
@@ -142,18 +145,51 @@ create table if not exists chat_user(
                                         chat_id uuid references chat(id) on delete cascade,
                                         user_id uuid references "user"(id) on delete cascade,
                                         last_read timestamptz,
+                                        read_at timestamptz,
+                                        last_delivered timestamptz,
+                                        delivered_at timestamptz,
+                                        role text not null default 'member',
+                                        muted_until timestamptz,
+                                        archived boolean not null default false,
+                                        pin_order int,
                                         unique(chat_id, user_id)
 );
 
+create table if not exists chat_invite(
+                                          token text primary key,
+                                          chat_id uuid not null references chat(id) on delete cascade,
+                                          creator_id uuid references "user"(id) on delete cascade,
+                                          created_at timestamptz not null default now(),
+                                          expires_at timestamptz,
+                                          usage_limit int check (usage_limit > 0),
+                                          usage_count int not null default 0
+);
+
+create index if not exists idx_chat_invite_chat_id on chat_invite(chat_id);
+
 create table if not exists message(
                                       id uuid primary key,
                                       text text,
                                       sender_id uuid references "user"(id) on delete cascade,
                                       chat_id uuid references chat(id) on delete cascade,
                                       created_at timestamptz not null default now(),
-                                      updated_at timestamptz not null default now()
+                                      updated_at timestamptz not null default now(),
+                                      seq bigint not null,
+                                      edited_at timestamptz,
+                                      reply_to_id uuid,
+                                      forwarded_from_sender_id uuid,
+                                      forwarded_from_chat_id uuid,
+                                      system_action text,
+                                      system_user_id uuid,
+                                      text_tsv tsvector generated always as (to_tsvector('russian', coalesce(text, '')) || to_tsvector('english', coalesce(text, ''))) stored,
+                                      expires_at timestamptz,
+                                      ciphertext bytea,
+                                      unique(chat_id, seq)
 );
 
+create index if not exists idx_message_text_tsv on message using gin(text_tsv);
+create index if not exists idx_message_expires_at on message(expires_at) where expires_at is not null;
+
 create table if not exists message_file(
                                            id int generated always as identity primary key,
                                            message_id uuid references message(id) on delete cascade,
@@ -161,6 +197,81 @@ create table if not exists message_file(
                                            file_type text not null default 'image'
 );
 
+create table if not exists message_key_envelope(
+                                                   message_id uuid not null references message(id) on delete cascade,
+                                                   recipient_id uuid not null references "user"(id) on delete cascade,
+                                                   device_id text not null,
+                                                   envelope bytea not null,
+                                                   primary key (message_id, recipient_id, device_id)
+);
+
+create table if not exists message_event(
+                                            id int generated always as identity primary key,
+                                            chat_id uuid references chat(id) on delete cascade,
+                                            seq bigint not null,
+                                            type text not null,
+                                            message_id uuid,
+                                            user_id uuid references "user"(id) on delete cascade,
+                                            created_at timestamptz not null default now(),
+                                            reaction text,
+                                            unique(chat_id, seq)
+);
+
+create table if not exists message_reaction(
+                                               message_id uuid references message(id) on delete cascade,
+                                               user_id uuid references "user"(id) on delete cascade,
+                                               emoji text not null,
+                                               created_at timestamptz not null default now(),
+                                               primary key (message_id, user_id, emoji)
+);
+
+create table if not exists chat_pinned_message(
+                                                  chat_id uuid not null references chat(id) on delete cascade,
+                                                  message_id uuid not null references message(id) on delete cascade,
+                                                  pinned_by uuid references "user"(id) on delete set null,
+                                                  pinned_at timestamptz not null default now(),
+                                                  primary key (chat_id, message_id)
+);
+
+create index if not exists idx_chat_pinned_message_message_id on chat_pinned_message(message_id);
+
+create table if not exists scheduled_message(
+                                                id uuid primary key,
+                                                chat_id uuid not null references chat(id) on delete cascade,
+                                                sender_id uuid not null references "user"(id) on delete cascade,
+                                                text text,
+                                                reply_to_id uuid,
+                                                send_at timestamptz not null,
+                                                created_at timestamptz not null default now(),
+                                                updated_at timestamptz not null default now(),
+                                                locked_until timestamptz
+);
+
+create index if not exists idx_scheduled_message_send_at on scheduled_message(send_at);
+create index if not exists idx_scheduled_message_chat_sender on scheduled_message(chat_id, sender_id);
+
+create table if not exists scheduled_message_file(
+                                                     id int generated always as identity primary key,
+                                                     scheduled_message_id uuid not null references scheduled_message(id) on delete cascade,
+                                                     file_url text not null,
+                                                     file_type text not null default 'image'
+);
+
+create table if not exists chat_export(
+                                          id uuid primary key,
+                                          chat_id uuid not null references chat(id) on delete cascade,
+                                          user_id uuid not null references "user"(id) on delete cascade,
+                                          status text not null default 'pending',
+                                          file_url text,
+                                          created_at timestamptz not null default now(),
+                                          updated_at timestamptz not null default now(),
+                                          locked_until timestamptz
+);
+
+create index if not exists idx_chat_export_pending on chat_export(created_at) where status = 'pending';
+
+create index if not exists idx_chat_export_ready on chat_export(updated_at) where status = 'ready';
+
 create table if not exists community(
                                         id uuid primary key,
                                         owner_id uuid references "user"(id) on delete cascade,
@@ -205,6 +316,8 @@ create table if not exists files(
     file_url text not null,
     filename text not null,
     created_at timestamptz not null default now(),
+    duration_ms int,
+    waveform bytea,
     unique(file_url, filename)
 );
 
@@ -223,6 +336,102 @@ CREATE TABLE IF NOT EXISTS sticker (
                                       created_at TIMESTAMP DEFAULT NOW()
 );
 
+create table if not exists user_sticker_pack(
+                                                user_id uuid not null references "user"(id) on delete cascade,
+                                                pack_id uuid not null references sticker_pack(id) on delete cascade,
+                                                position int not null,
+                                                added_at timestamptz not null default now(),
+                                                primary key (user_id, pack_id)
+);
+
+create table if not exists recent_sticker(
+                                             user_id uuid not null references "user"(id) on delete cascade,
+                                             sticker_url text not null,
+                                             used_at timestamptz not null default now(),
+                                             primary key (user_id, sticker_url)
+);
+
+create index if not exists idx_recent_sticker_user on recent_sticker(user_id, used_at desc);
+
+create table if not exists favourite_sticker(
+                                                user_id uuid not null references "user"(id) on delete cascade,
+                                                sticker_url text not null,
+                                                created_at timestamptz not null default now(),
+                                                primary key (user_id, sticker_url)
+);
+
+create table if not exists device_key(
+                                         user_id uuid not null references "user"(id) on delete cascade,
+                                         device_id text not null check (length(device_id) > 0),
+                                         identity_key bytea not null,
+                                         signed_prekey_id int not null,
+                                         signed_prekey bytea not null,
+                                         signed_prekey_signature bytea not null,
+                                         created_at timestamptz not null default now(),
+                                         updated_at timestamptz not null default now(),
+                                         primary key (user_id, device_id)
+);
+
+create table if not exists poll(
+                                   id uuid primary key,
+                                   post_id uuid unique references post(id) on delete cascade,
+                                   message_id uuid unique references message(id) on delete cascade,
+                                   question text not null,
+                                   multiple_choice boolean not null default false,
+                                   anonymous boolean not null default true,
+                                   results_visibility text not null default 'always'
+                                       check (results_visibility in ('always', 'after_vote', 'after_close')),
+                                   closes_at timestamptz,
+                                   created_at timestamptz not null default now(),
+                                   check (num_nonnulls(post_id, message_id) = 1)
+);
+
+create table if not exists poll_option(
+                                          poll_id uuid not null references poll(id) on delete cascade,
+                                          position int not null,
+                                          text text not null,
+                                          primary key (poll_id, position)
+);
+
+create table if not exists poll_vote(
+                                        poll_id uuid not null,
+                                        user_id uuid not null references "user"(id) on delete cascade,
+                                        position int not null,
+                                        voted_at timestamptz not null default now(),
+                                        primary key (poll_id, user_id, position),
+                                        foreign key (poll_id, position) references poll_option(poll_id, position) on delete cascade
+);
+
+create index if not exists idx_poll_vote_option on poll_vote(poll_id, position);
+
+create table if not exists notification(
+                                           id uuid primary key,
+                                           user_id uuid not null references "user"(id) on delete cascade,
+                                           actor_id uuid not null references "user"(id) on delete cascade,
+                                           type text not null
+                                               check (type in ('post_liked', 'comment_liked', 'post_commented', 'friend_request', 'friend_accepted',
+                                                               'community_role_changed', 'mention')),
+                                           target_id uuid not null,
+                                           content text not null default '',
+                                           actor_ids uuid[] not null default '{}',
+                                           actor_count int not null default 1,
+                                           is_read boolean not null default false,
+                                           created_at timestamptz not null default now(),
+                                           updated_at timestamptz not null default now()
+);
+
+create index if not exists idx_notification_user on notification(user_id, updated_at desc, id desc);
+
+create index if not exists idx_notification_unread on notification(user_id) where not is_read;
+
+create index if not exists idx_notification_group on notification(user_id, target_id, type) where not is_read;
+
+create table if not exists notification_actor(
+                                                 notification_id uuid not null references notification(id) on delete cascade,
+                                                 actor_id uuid not null references "user"(id) on delete cascade,
+                                                 primary key (notification_id, actor_id)
+);
+
 create extension if not exists pg_trgm;
 SET pg_trgm.similarity_threshold = 0.3; -- for fuzzy search
 